@@ -18,22 +18,51 @@ import (
 	"catchup-feed/internal/common/pagination"
 	pgRepo "catchup-feed/internal/infra/adapter/persistence/postgres"
 	"catchup-feed/internal/infra/db"
+	"catchup-feed/internal/infra/embedder"
 	"catchup-feed/pkg/config"
 	"catchup-feed/pkg/ratelimit"
 	"catchup-feed/pkg/security/csp"
 
 	artUC "catchup-feed/internal/usecase/article"
+	bookmarkUC "catchup-feed/internal/usecase/bookmark"
+	bulkUC "catchup-feed/internal/usecase/bulk"
+	categoryUC "catchup-feed/internal/usecase/category"
+	digestUC "catchup-feed/internal/usecase/digest"
+	embeddingUC "catchup-feed/internal/usecase/embedding"
+	exportUC "catchup-feed/internal/usecase/export"
+	feedUC "catchup-feed/internal/usecase/feed"
+	opmlUC "catchup-feed/internal/usecase/opml"
+	readUC "catchup-feed/internal/usecase/readstate"
+	savedsearchUC "catchup-feed/internal/usecase/savedsearch"
 	srcUC "catchup-feed/internal/usecase/source"
+	statsUC "catchup-feed/internal/usecase/stats"
+	streamUC "catchup-feed/internal/usecase/stream"
+	tagUC "catchup-feed/internal/usecase/tag"
+	trashUC "catchup-feed/internal/usecase/trash"
 
 	hhttp "catchup-feed/internal/handler/http"
+	"catchup-feed/internal/handler/http/apiversion"
 	harticle "catchup-feed/internal/handler/http/article"
 	hauth "catchup-feed/internal/handler/http/auth"
+	hbookmark "catchup-feed/internal/handler/http/bookmark"
+	hbulk "catchup-feed/internal/handler/http/bulk"
+	hcategory "catchup-feed/internal/handler/http/category"
+	hdigest "catchup-feed/internal/handler/http/digest"
+	hexport "catchup-feed/internal/handler/http/export"
+	hfeed "catchup-feed/internal/handler/http/feed"
 	"catchup-feed/internal/handler/http/middleware"
+	hopml "catchup-feed/internal/handler/http/opml"
+	hreadstate "catchup-feed/internal/handler/http/readstate"
 	"catchup-feed/internal/handler/http/requestid"
+	hsavedsearch "catchup-feed/internal/handler/http/savedsearch"
 	hsrc "catchup-feed/internal/handler/http/source"
+	hstats "catchup-feed/internal/handler/http/stats"
+	hstream "catchup-feed/internal/handler/http/stream"
+	htag "catchup-feed/internal/handler/http/tag"
+	htrash "catchup-feed/internal/handler/http/trash"
 	authservice "catchup-feed/internal/service/auth"
 
-	_ "catchup-feed/docs" // swagger docs
+	_ "catchup-feed/docs" // swagger docs (one instance per API version)
 )
 
 // @title           Catchup Feed API
@@ -48,8 +77,11 @@ import (
 // @license.name  MIT
 // @license.url   https://opensource.org/licenses/MIT
 
+// The operational endpoints (/health, /ready, /live and /metrics) are not
+// documented and stay outside of the base path.
+
 // @host      localhost:8080
-// @BasePath  /
+// @BasePath  /v1
 
 // @securityDefinitions.apikey BearerAuth
 // @in header
@@ -153,12 +185,109 @@ type ServerComponents struct {
 	IPWindow    time.Duration
 	UserWindow  time.Duration
 	AuthLimiter *middleware.RateLimiter // Legacy rate limiter for cleanup
+	// StreamBroker wakes the open article streams; it runs until shutdown.
+	StreamBroker *streamUC.Broker
+	// Stats refreshes the article and source total gauges; it runs until shutdown.
+	Stats *statsUC.Service
+}
+
+// loadPublicBaseURL returns the PUBLIC_BASE_URL used for the links of the feeds.
+// It returns "" when unset and exits on an invalid URL.
+func loadPublicBaseURL(logger *slog.Logger) string {
+	s := os.Getenv("PUBLIC_BASE_URL")
+	if s == "" {
+		// X-Forwarded-Proto は信頼しないため、TLS 終端プロキシの背後では設定が必要
+		logger.Info("PUBLIC_BASE_URL is not set - feed links use the host of each request")
+		return ""
+	}
+	baseURL, err := hfeed.ParseBaseURL(s)
+	if err != nil {
+		logger.Error("invalid PUBLIC_BASE_URL", slog.Any("error", err))
+		os.Exit(1)
+	}
+	return baseURL
+}
+
+// createEmbedder creates the embedder configured by EMBEDDING_* environment variables.
+// It returns nil when embeddings are disabled and exits on invalid configuration.
+func createEmbedder(logger *slog.Logger) embeddingUC.Embedder {
+	cfg, err := embedder.LoadConfigFromEnv()
+	if err != nil {
+		logger.Error("invalid embedding configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+	emb, err := embedder.New(cfg)
+	if err != nil {
+		logger.Error("failed to create embedder", slog.Any("error", err))
+		os.Exit(1)
+	}
+	return emb
 }
 
 // setupServer configures and returns the HTTP handler with all routes and middleware.
 func setupServer(logger *slog.Logger, database *sql.DB, version string) *ServerComponents {
-	srcSvc := srcUC.Service{Repo: pgRepo.NewSourceRepo(database)}
-	artSvc := artUC.Service{Repo: pgRepo.NewArticleRepo(database)}
+	srcSvc := srcUC.Service{
+		Repo:        pgRepo.NewSourceRepo(database),
+		ArticleRepo: pgRepo.NewSourceArticleRepo(database),
+	}
+	readStateRepo := pgRepo.NewReadStateRepo(database)
+	artSvc := artUC.Service{
+		Repo:            pgRepo.NewArticleRepo(database),
+		ResummarizeRepo: pgRepo.NewResummarizeRepo(database),
+		ReadStateRepo:   readStateRepo,
+	}
+	tagSvc := tagUC.Service{
+		Repo:     pgRepo.NewTagRepo(database),
+		RuleRepo: pgRepo.NewTagRuleRepo(database),
+	}
+	// ダイジェストは worker が生成する。API は参照のみ
+	digestSvc := digestUC.Service{Repo: pgRepo.NewDigestRepo(database)}
+	readSvc := readUC.Service{Repo: readStateRepo}
+	bookmarkSvc := bookmarkUC.Service{
+		Repo:     pgRepo.NewBookmarkRepo(database),
+		ListRepo: pgRepo.NewReadingListRepo(database),
+	}
+	// 保存した検索の通知は worker がクロール後に送る。API は保存と実行のみ
+	searchSvc := savedsearchUC.Service{
+		Repo:     pgRepo.NewSavedSearchRepo(database),
+		Articles: artSvc.Repo,
+	}
+	feedSvc := feedUC.Service{
+		TokenRepo:   pgRepo.NewFeedTokenRepo(database),
+		ArticleRepo: artSvc.Repo,
+	}
+	exportSvc := exportUC.Service{Repo: pgRepo.NewArticleExportRepo(database)}
+	// 新着記事ストリーム。worker の INSERT を LISTEN/NOTIFY で受け取り、取りこぼしはポーリングで補う
+	streamSvc := streamUC.Service{
+		Repo:   pgRepo.NewArticleStreamRepo(database),
+		Broker: streamUC.NewBroker(pgRepo.NewArticleListener(database), 30*time.Second, logger),
+	}
+	// 削除した記事・ソースはゴミ箱に入る。保持期間を過ぎたものは worker が完全に削除する
+	trashSvc := trashUC.Service{Repo: pgRepo.NewTrashRepo(database)}
+	bulkSvc := bulkUC.Service{
+		Repo:       pgRepo.NewBulkRepo(database),
+		SourceRepo: srcSvc.Repo,
+	}
+	statsSvc := statsUC.NewService(pgRepo.NewStatsRepo(database))
+	// articles_total・sources_total は API のメトリクスレジストリ（handler/http）のゲージに反映する
+	statsSvc.UpdateTotals = func(sources, articles int) {
+		hhttp.UpdateSourcesTotal(sources)
+		hhttp.UpdateArticlesTotal(articles)
+	}
+	categorySvc := categoryUC.Service{
+		Repo:       pgRepo.NewCategoryRepo(database),
+		SourceRepo: srcSvc.Repo,
+	}
+	opmlSvc := opmlUC.Service{
+		SourceRepo:   srcSvc.Repo,
+		CategoryRepo: categorySvc.Repo,
+	}
+
+	// 意味検索・関連記事（EMBEDDING_PROVIDER 未設定時は無効）
+	if emb := createEmbedder(logger); emb != nil {
+		artSvc.Semantic = embeddingUC.NewService(emb, pgRepo.NewEmbeddingRepo(database))
+		logger.Info("Semantic search enabled", slog.String("embedding_model", emb.Model()))
+	}
 
 	// Load rate limiting configuration
 	rateLimitConfig, err := config.LoadRateLimitConfig()
@@ -295,17 +424,19 @@ func setupServer(logger *slog.Logger, database *sql.DB, version string) *ServerC
 	}
 
 	// Setup routes with rate limiting middleware
-	rootMux, authLimiter := setupRoutes(database, version, srcSvc, artSvc, ipExtractor, ipRateLimiter, userRateLimiter, logger)
+	rootMux, authLimiter := setupRoutes(database, version, srcSvc, artSvc, tagSvc, digestSvc, readSvc, bookmarkSvc, searchSvc, feedSvc, streamSvc, exportSvc, trashSvc, bulkSvc, statsSvc, categorySvc, opmlSvc, ipExtractor, ipRateLimiter, userRateLimiter, logger)
 	handler := applyMiddleware(logger, rootMux, ipRateLimiter)
 
 	// Return server components including stores for cleanup
 	return &ServerComponents{
-		Handler:     handler,
-		IPStore:     ipStore,
-		UserStore:   userStore,
-		IPWindow:    rateLimitConfig.DefaultIPWindow,
-		UserWindow:  rateLimitConfig.DefaultUserWindow,
-		AuthLimiter: authLimiter,
+		Handler:      handler,
+		IPStore:      ipStore,
+		UserStore:    userStore,
+		IPWindow:     rateLimitConfig.DefaultIPWindow,
+		UserWindow:   rateLimitConfig.DefaultUserWindow,
+		AuthLimiter:  authLimiter,
+		StreamBroker: streamSvc.Broker,
+		Stats:        statsSvc,
 	}
 }
 
@@ -315,6 +446,19 @@ func setupRoutes(
 	version string,
 	srcSvc srcUC.Service,
 	artSvc artUC.Service,
+	tagSvc tagUC.Service,
+	digestSvc digestUC.Service,
+	readSvc readUC.Service,
+	bookmarkSvc bookmarkUC.Service,
+	searchSvc savedsearchUC.Service,
+	feedSvc feedUC.Service,
+	streamSvc streamUC.Service,
+	exportSvc exportUC.Service,
+	trashSvc trashUC.Service,
+	bulkSvc bulkUC.Service,
+	statsSvc *statsUC.Service,
+	categorySvc categoryUC.Service,
+	opmlSvc opmlUC.Service,
 	ipExtractor middleware.IPExtractor,
 	ipRateLimiter *middleware.IPRateLimiter,
 	userRateLimiter *middleware.UserRateLimiter,
@@ -329,10 +473,13 @@ func setupRoutes(
 	// but limit of 100 req/min allows bursts naturally within the time window
 	searchRateLimiter := middleware.NewRateLimiter(100, 1*time.Minute, ipExtractor)
 
+	// レート制限: エクスポートは1回で多くの記事を読み出すため1分間に10リクエストまで
+	exportRateLimiter := middleware.NewRateLimiter(10, 1*time.Minute, ipExtractor)
+
 	// Initialize AuthService with MultiUserAuthProvider
 	weakPasswords := []string{"password", "123456", "admin", "test", "secret"}
 	authProvider := hauth.NewMultiUserAuthProvider(12, weakPasswords)
-	publicEndpoints := []string{"/auth/token", "/health", "/ready", "/live", "/metrics", "/swagger/"}
+	publicEndpoints := []string{"/auth/token", "/health", "/ready", "/live", "/metrics", "/swagger/", "/shared/lists/", "/feeds/"}
 	authService := authservice.NewAuthService(authProvider, publicEndpoints)
 
 	publicMux := http.NewServeMux()
@@ -344,15 +491,41 @@ func setupRoutes(
 	publicMux.Handle("/live", &hhttp.LiveHandler{})
 	publicMux.Handle("/metrics", hhttp.MetricsHandler())
 
-	// Swagger UI（認証不要）
-	publicMux.Handle("/swagger/", httpSwagger.WrapHandler)
+	// Swagger UI（認証不要）: API バージョンごとのドキュメントを /swagger/v1/ などで提供する
+	for _, v := range apiversion.Supported {
+		publicMux.Handle("/swagger/"+v.String()+"/", httpSwagger.Handler(httpSwagger.InstanceName(v.String())))
+	}
+	publicMux.Handle("/swagger/", http.RedirectHandler("/swagger/"+apiversion.Latest.String()+"/index.html", http.StatusFound))
+
+	// 共有リーディングリスト（認証不要。パス中の共有トークンで閲覧を許可する）
+	hbookmark.RegisterShared(publicMux, bookmarkSvc)
+
+	// 記事フィード（認証不要。URL のフィードトークンで配信を許可する）
+	hfeed.RegisterFeeds(publicMux, feedSvc, loadPublicBaseURL(logger))
 
 	// Load pagination configuration
 	paginationCfg := pagination.LoadFromEnv()
+	if len(paginationCfg.CursorSecret) == 0 {
+		// カーソルは再起動やレプリカ間で無効になる
+		logger.Warn("PAGINATION_CURSOR_SECRET is not set - cursor tokens are signed with a per-process secret")
+	}
 
 	privateMux := http.NewServeMux()
-	hsrc.Register(privateMux, srcSvc, searchRateLimiter)
+	hsrc.Register(privateMux, srcSvc, paginationCfg, searchRateLimiter)
 	harticle.Register(privateMux, artSvc, paginationCfg, logger, searchRateLimiter)
+	htag.Register(privateMux, tagSvc)
+	hdigest.Register(privateMux, digestSvc, paginationCfg)
+	hreadstate.Register(privateMux, readSvc)
+	hbookmark.Register(privateMux, bookmarkSvc, paginationCfg)
+	hsavedsearch.Register(privateMux, searchSvc, paginationCfg)
+	hfeed.Register(privateMux, feedSvc)
+	hstream.Register(privateMux, streamSvc)
+	hexport.Register(privateMux, exportSvc, exportRateLimiter)
+	htrash.Register(privateMux, trashSvc, paginationCfg)
+	hbulk.Register(privateMux, bulkSvc)
+	hstats.Register(privateMux, statsSvc)
+	hcategory.Register(privateMux, categorySvc)
+	hopml.Register(privateMux, opmlSvc)
 
 	// Apply authentication middleware
 	protected := hauth.Authz(privateMux)
@@ -362,14 +535,23 @@ func setupRoutes(
 		protected = userRateLimiter.Middleware()(protected)
 	}
 
+	// API は /v1 以下で提供する。バージョンなしの旧パスは Deprecation・Sunset ヘッダー付きの別名として残す
+	apiMux := http.NewServeMux()
+	apiMux.Handle("/auth/token", publicMux)
+	apiMux.Handle("/shared/lists/", publicMux)
+	apiMux.Handle("/feeds/", publicMux)
+	apiMux.Handle("/", protected)
+
+	// 運用エンドポイントと Swagger UI はバージョンなしのまま。フィードはフィードリーダーに
+	// 登録された URL のため、/v1 以下に加えてバージョンなしのパスでも非推奨にせず提供する
 	rootMux := http.NewServeMux()
-	rootMux.Handle("/auth/token", publicMux)
 	rootMux.Handle("/health", publicMux)
 	rootMux.Handle("/ready", publicMux)
 	rootMux.Handle("/live", publicMux)
 	rootMux.Handle("/metrics", publicMux)
 	rootMux.Handle("/swagger/", publicMux)
-	rootMux.Handle("/", protected)
+	rootMux.Handle("/feeds/", publicMux)
+	rootMux.Handle("/", apiversion.Handler(apiMux, apiversion.LoadConfigFromEnv()))
 
 	// Return auth rate limiter for cleanup management
 	return rootMux, authRateLimiter
@@ -489,6 +671,18 @@ func runServer(logger *slog.Logger, components *ServerComponents, version string
 			slog.Duration("interval", cleanupCfg.Interval))
 	}
 
+	// Start the article stream broker (stopped by cancel before shutdown, which also ends open streams)
+	if components.StreamBroker != nil {
+		go components.StreamBroker.Run(ctx)
+		logger.Info("article stream broker started")
+	}
+
+	// Keep the article and source total gauges up to date between /stats requests
+	if components.Stats != nil {
+		go components.Stats.RunGauges(ctx, 5*time.Minute)
+		logger.Info("stats gauge refresh started")
+	}
+
 	srv := &http.Server{
 		Addr:              ":8080",
 		Handler:           components.Handler,
@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	_ "github.com/jackc/pgx/v5/stdlib"
@@ -18,13 +19,19 @@ import (
 	hhttp "catchup-feed/internal/handler/http/respond"
 	pgRepo "catchup-feed/internal/infra/adapter/persistence/postgres"
 	"catchup-feed/internal/infra/db"
+	"catchup-feed/internal/infra/embedder"
 	"catchup-feed/internal/infra/fetcher"
 	"catchup-feed/internal/infra/notifier"
 	"catchup-feed/internal/infra/scraper"
 	"catchup-feed/internal/infra/summarizer"
 	workerPkg "catchup-feed/internal/infra/worker"
+	digestUC "catchup-feed/internal/usecase/digest"
+	embeddingUC "catchup-feed/internal/usecase/embedding"
 	fetchUC "catchup-feed/internal/usecase/fetch"
 	"catchup-feed/internal/usecase/notify"
+	savedsearchUC "catchup-feed/internal/usecase/savedsearch"
+	tagUC "catchup-feed/internal/usecase/tag"
+	trashUC "catchup-feed/internal/usecase/trash"
 )
 
 func waitForMigrations(logger *slog.Logger, db *sql.DB) {
@@ -116,7 +123,15 @@ func main() {
 	logger.Info("health check server started", slog.String("addr", healthAddr))
 
 	svc := setupFetchService(logger, database, notifyService)
-	startCronWorker(logger, svc, workerConfig, workerMetrics, healthServer)
+	digestConfig, digestSvc := setupDigestService(logger, database, svc, notifyService)
+	// 保存した検索の新着通知（クロールで記事が追加されたときに評価する）
+	searchSvc := &savedsearchUC.Service{
+		Repo:     pgRepo.NewSavedSearchRepo(database),
+		Notifier: notifyService,
+	}
+	// 保持期間を過ぎたゴミ箱の記事・ソースを完全に削除する
+	trashSvc := &trashUC.Service{Repo: pgRepo.NewTrashRepo(database)}
+	startCronWorker(logger, svc, digestSvc, digestConfig, searchSvc, trashSvc, workerConfig, workerMetrics, healthServer)
 }
 
 // initLogger initializes and returns a structured logger based on environment configuration.
@@ -186,7 +201,7 @@ func setupFetchService(logger *slog.Logger, database *sql.DB, notifyService noti
 		Threshold:   contentFetchConfig.Threshold,
 	}
 
-	return fetchUC.NewService(
+	svc := fetchUC.NewService(
 		srcRepo,
 		artRepo,
 		sum,
@@ -196,6 +211,85 @@ func setupFetchService(logger *slog.Logger, database *sql.DB, notifyService noti
 		notifyService,
 		fetchConfig,
 	)
+
+	// 再要約: クロール時の本文を保存し、API から登録された再要約ジョブを処理する
+	svc.ContentRepo = pgRepo.NewArticleContentRepo(database)
+	svc.ResummarizeRepo = pgRepo.NewResummarizeRepo(database)
+
+	// 要約に失敗して破棄した記事を記録する（/stats/sources の要約エラー率）
+	svc.FailureRepo = pgRepo.NewSummarizeFailureRepo(database)
+
+	// トピックタグ: タグ付けルール・フィードのカテゴリ（TAGGING_LLM_ENABLED=true の場合は要約のタグも）から付与する
+	useLLMTags := os.Getenv("TAGGING_LLM_ENABLED") == "true"
+	svc.Tagger = tagUC.NewTagger(pgRepo.NewTagRepo(database), pgRepo.NewTagRuleRepo(database), useLLMTags)
+	logger.Info("Article tagging enabled", slog.Bool("llm_tags", useLLMTags))
+
+	// 意味検索・関連記事: 要約済みの記事の埋め込みを計算して保存する（EMBEDDING_PROVIDER 未設定時は無効）
+	if emb := createEmbedder(logger); emb != nil {
+		svc.Indexer = embeddingUC.NewService(emb, pgRepo.NewEmbeddingRepo(database))
+		logger.Info("Article embeddings enabled", slog.String("embedding_model", emb.Model()))
+	}
+
+	// バッチ要約モード: 新着記事を要約待ちで保存し、Message Batches API でまとめて要約する
+	if summarizer.LoadBatchModeEnabled() {
+		batchSummarizer, ok := sum.(fetchUC.BatchSummarizer)
+		if !ok {
+			logger.Error("SUMMARIZER_BATCH_MODE requires SUMMARIZER_TYPE=claude")
+			os.Exit(1)
+		}
+		svc.BatchSummarizer = batchSummarizer
+		svc.SummaryBatchRepo = pgRepo.NewSummaryBatchRepo(database)
+		svc.StreamNotifier = pgRepo.NewArticleNotifier(database)
+		logger.Info("Batch summarization enabled")
+	}
+
+	return svc
+}
+
+// setupDigestService creates the digest service configured by DIGEST_* environment variables.
+// It returns a nil service when digests are disabled and exits on invalid configuration.
+func setupDigestService(logger *slog.Logger, database *sql.DB, svc fetchUC.Service, notifyService notify.Service) (workerPkg.DigestConfig, *digestUC.Service) {
+	cfg, err := workerPkg.LoadDigestConfigFromEnv()
+	if err != nil {
+		logger.Error("invalid digest configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+	if !cfg.Enabled {
+		logger.Info("Digests disabled")
+		return cfg, nil
+	}
+
+	digestSvc := &digestUC.Service{
+		Repo:     pgRepo.NewDigestRepo(database),
+		Notifier: notifyService,
+		Config:   cfg.Digest,
+	}
+	// 概要: 記事の要約と同じ要約器でダイジェスト全体の概要を書く
+	if cfg.Overview {
+		digestSvc.Summarizer = svc.Summarizer
+	}
+	logger.Info("Digests enabled",
+		slog.String("period", cfg.Digest.Period),
+		slog.String("group_by", cfg.Digest.GroupBy),
+		slog.Int("max_articles", cfg.Digest.MaxArticles),
+		slog.Bool("overview", cfg.Overview))
+	return cfg, digestSvc
+}
+
+// createEmbedder creates the embedder configured by EMBEDDING_* environment variables.
+// It returns nil when embeddings are disabled and exits on invalid configuration.
+func createEmbedder(logger *slog.Logger) embeddingUC.Embedder {
+	cfg, err := embedder.LoadConfigFromEnv()
+	if err != nil {
+		logger.Error("invalid embedding configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+	emb, err := embedder.New(cfg)
+	if err != nil {
+		logger.Error("failed to create embedder", slog.Any("error", err))
+		os.Exit(1)
+	}
+	return emb
 }
 
 // createSummarizer creates a summarizer based on the SUMMARIZER_TYPE environment variable.
@@ -205,6 +299,13 @@ func createSummarizer(logger *slog.Logger) fetchUC.Summarizer {
 		summarizerType = "claude"
 	}
 
+	// プロンプトテンプレートの読み込み（SUMMARIZER_PROMPT_DIR 未設定時は組み込みテンプレートのみ）
+	templates, err := summarizer.LoadPromptTemplatesFromEnv()
+	if err != nil {
+		logger.Error("Failed to load summarizer prompt templates", slog.Any("error", err))
+		os.Exit(1)
+	}
+
 	switch summarizerType {
 	case "claude":
 		apiKey := os.Getenv("ANTHROPIC_API_KEY")
@@ -213,7 +314,9 @@ func createSummarizer(logger *slog.Logger) fetchUC.Summarizer {
 			os.Exit(1)
 		}
 		logger.Info("Using Claude API for summarization", slog.String("type", "claude"))
-		return summarizer.NewClaude(apiKey)
+		claude := summarizer.NewClaude(apiKey)
+		claude.SetPromptTemplates(templates)
+		return claude
 	case "openai":
 		apiKey := os.Getenv("OPENAI_API_KEY")
 		if apiKey == "" {
@@ -229,7 +332,9 @@ func createSummarizer(logger *slog.Logger) fetchUC.Summarizer {
 		logger.Info("Using OpenAI API for summarization",
 			slog.String("type", "openai"),
 			slog.Int("character_limit", config.GetCharacterLimit()))
-		return summarizer.NewOpenAI(apiKey, config)
+		openai := summarizer.NewOpenAI(apiKey, config)
+		openai.SetPromptTemplates(templates)
+		return openai
 	default:
 		logger.Error("Invalid SUMMARIZER_TYPE",
 			slog.String("type", summarizerType),
@@ -373,7 +478,7 @@ func loadSlackConfig(logger *slog.Logger) notifier.SlackConfig {
 }
 
 // startCronWorker starts the cron scheduler and runs the crawl job periodically.
-func startCronWorker(logger *slog.Logger, svc fetchUC.Service, cfg *workerPkg.WorkerConfig, metrics *workerPkg.WorkerMetrics, healthServer *workerPkg.HealthServer) {
+func startCronWorker(logger *slog.Logger, svc fetchUC.Service, digestSvc *digestUC.Service, digestCfg workerPkg.DigestConfig, searchSvc *savedsearchUC.Service, trashSvc *trashUC.Service, cfg *workerPkg.WorkerConfig, metrics *workerPkg.WorkerMetrics, healthServer *workerPkg.HealthServer) {
 	// Load timezone
 	loc, err := time.LoadLocation(cfg.Timezone)
 	if err != nil {
@@ -382,13 +487,81 @@ func startCronWorker(logger *slog.Logger, svc fetchUC.Service, cfg *workerPkg.Wo
 	}
 	c := cron.New(cron.WithLocation(loc))
 
+	// crawling is true while a crawl is running; resummarize jobs wait for it to finish
+	var crawling atomic.Bool
 	_, err = c.AddFunc(cfg.CronSchedule, func() {
-		runCrawlJob(logger, svc, cfg, metrics)
+		crawling.Store(true)
+		defer crawling.Store(false)
+		runCrawlJob(logger, svc, searchSvc, cfg, metrics)
 	})
 	if err != nil {
 		logger.Error("failed to add cron job", slog.Any("error", err))
 		os.Exit(1)
 	}
+
+	// バッチ要約の結果を定期的に回収する（前回の回収が実行中ならスキップ）
+	if svc.BatchSummarizer != nil {
+		job := cron.NewChain(cron.SkipIfStillRunning(cron.DiscardLogger)).Then(cron.FuncJob(func() {
+			runBatchCollectJob(logger, svc, searchSvc, cfg)
+		}))
+		if _, err := c.AddJob("@every "+cfg.BatchPollInterval.String(), job); err != nil {
+			logger.Error("failed to add batch collect job", slog.Any("error", err))
+			os.Exit(1)
+		}
+		logger.Info("batch collect job scheduled", slog.Duration("interval", cfg.BatchPollInterval))
+	}
+
+	// 再要約ジョブを毎分、上限件数まで処理する（クロール中と前回の処理が実行中ならスキップ）
+	resummarizeJob := cron.NewChain(cron.SkipIfStillRunning(cron.DiscardLogger)).Then(cron.FuncJob(func() {
+		if crawling.Load() {
+			return
+		}
+		runResummarizeJob(logger, svc, cfg)
+	}))
+	if _, err := c.AddJob("@every 1m", resummarizeJob); err != nil {
+		logger.Error("failed to add resummarize job", slog.Any("error", err))
+		os.Exit(1)
+	}
+	logger.Info("resummarize job scheduled", slog.Int("articles_per_minute", cfg.ResummarizePerMinute))
+
+	// 埋め込みが未計算の記事（有効化・モデル変更前の記事）を毎分、上限件数まで補完する
+	if svc.Indexer != nil {
+		backfillJob := cron.NewChain(cron.SkipIfStillRunning(cron.DiscardLogger)).Then(cron.FuncJob(func() {
+			if crawling.Load() {
+				return
+			}
+			runEmbeddingBackfillJob(logger, svc, cfg)
+		}))
+		if _, err := c.AddJob("@every 1m", backfillJob); err != nil {
+			logger.Error("failed to add embedding backfill job", slog.Any("error", err))
+			os.Exit(1)
+		}
+		logger.Info("embedding backfill job scheduled", slog.Int("articles_per_minute", embeddingBackfillPerMinute))
+	}
+
+	// 前回のダイジェスト以降の新着記事をまとめて通知する（前回の生成が実行中ならスキップ）
+	if digestSvc != nil {
+		digestJob := cron.NewChain(cron.SkipIfStillRunning(cron.DiscardLogger)).Then(cron.FuncJob(func() {
+			runDigestJob(logger, digestSvc, cfg)
+		}))
+		if _, err := c.AddJob(digestCfg.Schedule, digestJob); err != nil {
+			logger.Error("failed to add digest job", slog.Any("error", err))
+			os.Exit(1)
+		}
+		logger.Info("digest job scheduled",
+			slog.String("schedule", digestCfg.Schedule),
+			slog.String("period", digestCfg.Digest.Period))
+	}
+
+	// ゴミ箱の保持期間を過ぎた記事・ソースを毎時完全に削除する（前回の削除が実行中ならスキップ）
+	purgeJob := cron.NewChain(cron.SkipIfStillRunning(cron.DiscardLogger)).Then(cron.FuncJob(func() {
+		runTrashPurgeJob(logger, trashSvc, cfg)
+	}))
+	if _, err := c.AddJob("@hourly", purgeJob); err != nil {
+		logger.Error("failed to add trash purge job", slog.Any("error", err))
+		os.Exit(1)
+	}
+	logger.Info("trash purge job scheduled", slog.Int("retention_days", cfg.TrashRetentionDays))
 	c.Start()
 
 	// Mark as ready after cron is set up
@@ -399,8 +572,9 @@ func startCronWorker(logger *slog.Logger, svc fetchUC.Service, cfg *workerPkg.Wo
 	select {}
 }
 
-// runCrawlJob executes a single crawl job with timeout and error handling.
-func runCrawlJob(logger *slog.Logger, svc fetchUC.Service, cfg *workerPkg.WorkerConfig, metrics *workerPkg.WorkerMetrics) {
+// runCrawlJob executes a single crawl job with timeout and error handling, then
+// alerts the subscribers of saved searches about the inserted articles.
+func runCrawlJob(logger *slog.Logger, svc fetchUC.Service, searchSvc *savedsearchUC.Service, cfg *workerPkg.WorkerConfig, metrics *workerPkg.WorkerMetrics) {
 	startTime := time.Now()
 	metrics.RecordJobRun("started")
 	logger.Info("crawl started")
@@ -430,7 +604,106 @@ func runCrawlJob(logger *slog.Logger, svc fetchUC.Service, cfg *workerPkg.Worker
 		slog.Int64("inserted", stats.Inserted),
 		slog.Int64("duplicated", stats.Duplicated),
 		slog.Int64("summarize_errors", stats.SummarizeError),
+		slog.Int64("pending_summaries", stats.PendingSummaries),
 		slog.Duration("duration", stats.Duration),
 	)
+
+	if searchSvc != nil && stats.Inserted > 0 {
+		runSearchAlertJob(logger, searchSvc, cfg)
+	}
+}
+
+// runSearchAlertJob sends the alerts of the subscribed saved searches matching newly
+// inserted or newly summarized articles.
+func runSearchAlertJob(logger *slog.Logger, searchSvc *savedsearchUC.Service, cfg *workerPkg.WorkerConfig) {
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.CrawlTimeout)
+	defer cancel()
+
+	stats, err := searchSvc.EvaluateAlerts(ctx, time.Now())
+	if err != nil {
+		logger.Error("search alerts failed", slog.Any("error", hhttp.SanitizeError(err)))
+		return
+	}
+	if stats.Evaluated > 0 {
+		logger.Info("search alerts evaluated",
+			slog.Int("evaluated", stats.Evaluated),
+			slog.Int("alerted", stats.Alerted),
+			slog.Int("failed", stats.Failed))
+	}
 }
 
+// runBatchCollectJob applies the results of finished summary batches, then alerts
+// the subscribers of saved searches about the articles summarized by them.
+// Pending articles are not evaluated by the alerts of the crawl that inserted them.
+func runBatchCollectJob(logger *slog.Logger, svc fetchUC.Service, searchSvc *savedsearchUC.Service, cfg *workerPkg.WorkerConfig) {
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.CrawlTimeout)
+	defer cancel()
+
+	stats, err := svc.CollectSummaryBatches(ctx)
+	if err != nil {
+		logger.Error("batch collect failed", slog.Any("error", hhttp.SanitizeError(err)))
+	}
+	// 失敗しても、それまでに保存した要約はアラートの対象にする
+	if searchSvc != nil && stats != nil && stats.Summarized > 0 {
+		runSearchAlertJob(logger, searchSvc, cfg)
+	}
+}
+
+// runResummarizeJob re-summarizes up to cfg.ResummarizePerMinute articles of the pending resummarize jobs.
+func runResummarizeJob(logger *slog.Logger, svc fetchUC.Service, cfg *workerPkg.WorkerConfig) {
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.CrawlTimeout)
+	defer cancel()
+
+	if _, err := svc.RunResummarizeJobs(ctx, cfg.ResummarizePerMinute); err != nil {
+		logger.Error("resummarize failed", slog.Any("error", hhttp.SanitizeError(err)))
+	}
+}
+
+// embeddingBackfillPerMinute is the number of articles embedded per run of the backfill job.
+const embeddingBackfillPerMinute = 100
+
+// runEmbeddingBackfillJob embeds up to embeddingBackfillPerMinute articles that have no embedding yet.
+func runEmbeddingBackfillJob(logger *slog.Logger, svc fetchUC.Service, cfg *workerPkg.WorkerConfig) {
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.CrawlTimeout)
+	defer cancel()
+
+	if _, err := svc.BackfillEmbeddings(ctx, embeddingBackfillPerMinute); err != nil {
+		logger.Error("embedding backfill failed", slog.Any("error", hhttp.SanitizeError(err)))
+	}
+}
+
+// runDigestJob generates and delivers the digest of the articles fetched since the previous one.
+func runDigestJob(logger *slog.Logger, digestSvc *digestUC.Service, cfg *workerPkg.WorkerConfig) {
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.CrawlTimeout)
+	defer cancel()
+
+	digest, err := digestSvc.Generate(ctx, time.Now())
+	if err != nil {
+		logger.Error("digest failed", slog.Any("error", hhttp.SanitizeError(err)))
+		return
+	}
+	if digest != nil {
+		logger.Info("digest completed",
+			slog.Int64("digest_id", digest.ID),
+			slog.Int("articles", digest.ArticleCount))
+	}
+}
+
+// runTrashPurgeJob permanently deletes the articles and sources that have been in
+// the trash for longer than cfg.TrashRetentionDays.
+func runTrashPurgeJob(logger *slog.Logger, trashSvc *trashUC.Service, cfg *workerPkg.WorkerConfig) {
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.CrawlTimeout)
+	defer cancel()
+
+	retention := time.Duration(cfg.TrashRetentionDays) * 24 * time.Hour
+	result, err := trashSvc.Purge(ctx, time.Now(), retention)
+	if err != nil {
+		logger.Error("trash purge failed", slog.Any("error", hhttp.SanitizeError(err)))
+		return
+	}
+	if result.Articles > 0 || result.Sources > 0 {
+		logger.Info("trash purged",
+			slog.Int64("articles", result.Articles),
+			slog.Int64("sources", result.Sources))
+	}
+}
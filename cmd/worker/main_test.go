@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	workerPkg "catchup-feed/internal/infra/worker"
+	"catchup-feed/internal/repository"
+	fetchUC "catchup-feed/internal/usecase/fetch"
+	"catchup-feed/internal/usecase/notify"
+	savedsearchUC "catchup-feed/internal/usecase/savedsearch"
+)
+
+/* ───────── モック ───────── */
+
+// stubBatchSummarizer returns the results of one batch, finished when done is true.
+type stubBatchSummarizer struct {
+	fetchUC.BatchSummarizer
+	done bool
+}
+
+func (s *stubBatchSummarizer) SummaryBatchResults(context.Context, string) (map[string]fetchUC.BatchSummaryResult, bool, error) {
+	if !s.done {
+		return nil, false, nil
+	}
+	return map[string]fetchUC.BatchSummaryResult{
+		"article-1": {Result: &fetchUC.SummaryResult{Summary: "Go 1.25 の要約"}},
+	}, true, nil
+}
+
+type stubSummaryBatchRepo struct {
+	repository.SummaryBatchRepository
+}
+
+func (stubSummaryBatchRepo) ListPendingBatchIDs(context.Context) ([]string, error) {
+	return []string{"msgbatch_1"}, nil
+}
+
+func (stubSummaryBatchRepo) ListPendingByBatch(context.Context, string) ([]*entity.Article, error) {
+	return []*entity.Article{{
+		ID: 1, SourceID: 1, Title: "Go 1.25", URL: "https://go.dev/blog/go1.25",
+		SummaryStatus: entity.SummaryStatusPending, SummaryBatchID: "msgbatch_1",
+	}}, nil
+}
+
+type stubArticleRepo struct {
+	repository.ArticleRepository
+}
+
+func (stubArticleRepo) Update(context.Context, *entity.Article) error { return nil }
+
+type stubSourceRepo struct {
+	repository.SourceRepository
+}
+
+func (stubSourceRepo) Get(_ context.Context, id int64) (*entity.Source, error) {
+	return &entity.Source{ID: id, Name: "Go Blog"}, nil
+}
+
+// stubSavedSearchRepo has one subscribed search matching the summarized article.
+type stubSavedSearchRepo struct {
+	repository.SavedSearchRepository
+	evaluated []int64 // MarkEvaluatedに渡されたLastArticleID
+}
+
+func (s *stubSavedSearchRepo) ListSubscribed(context.Context) ([]*entity.SavedSearch, error) {
+	return []*entity.SavedSearch{{ID: 1, UserID: "admin", Name: "Go", Keyword: "go", Subscribed: true}}, nil
+}
+
+func (s *stubSavedSearchRepo) LatestArticleID(context.Context) (int64, error) {
+	return 1, nil
+}
+
+func (s *stubSavedSearchRepo) ListNewMatches(context.Context, []string, repository.ArticleSearchFilters, int64, int64, int) ([]repository.ArticleWithSource, error) {
+	return []repository.ArticleWithSource{{
+		Article:    &entity.Article{ID: 1, Title: "Go 1.25", URL: "https://go.dev/blog/go1.25", Summary: "Go 1.25 の要約"},
+		SourceName: "Go Blog",
+	}}, nil
+}
+
+func (s *stubSavedSearchRepo) MarkEvaluated(_ context.Context, _, lastArticleID int64, _ *time.Time) error {
+	s.evaluated = append(s.evaluated, lastArticleID)
+	return nil
+}
+
+type stubAlertNotifier struct {
+	alerts []*entity.SearchAlert
+}
+
+func (s *stubAlertNotifier) NotifySearchAlert(_ context.Context, alert *entity.SearchAlert) error {
+	s.alerts = append(s.alerts, alert)
+	return nil
+}
+
+/* ───────── テストケース ───────── */
+
+// TestRunBatchCollectJob_SearchAlerts checks that articles summarized by a batch are
+// evaluated by the saved search alerts, which skipped them while they were pending.
+func TestRunBatchCollectJob_SearchAlerts(t *testing.T) {
+	tests := []struct {
+		name       string
+		done       bool
+		wantAlerts int
+	}{
+		{name: "batch finished", done: true, wantAlerts: 1},
+		{name: "batch in progress", done: false, wantAlerts: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := fetchUC.Service{
+				SourceRepo:       stubSourceRepo{},
+				ArticleRepo:      stubArticleRepo{},
+				NotifyService:    notify.NewService(nil, 1),
+				BatchSummarizer:  &stubBatchSummarizer{done: tt.done},
+				SummaryBatchRepo: stubSummaryBatchRepo{},
+			}
+			searchRepo := &stubSavedSearchRepo{}
+			notifier := &stubAlertNotifier{}
+			searchSvc := &savedsearchUC.Service{Repo: searchRepo, Notifier: notifier}
+			cfg := &workerPkg.WorkerConfig{CrawlTimeout: time.Minute}
+
+			runBatchCollectJob(slog.New(slog.NewTextHandler(io.Discard, nil)), svc, searchSvc, cfg)
+
+			if len(notifier.alerts) != tt.wantAlerts {
+				t.Fatalf("alerts = %d, want %d", len(notifier.alerts), tt.wantAlerts)
+			}
+			if tt.wantAlerts > 0 && (len(searchRepo.evaluated) != 1 || searchRepo.evaluated[0] != 1) {
+				t.Errorf("evaluated up to %v, want the summarized article 1", searchRepo.evaluated)
+			}
+		})
+	}
+}
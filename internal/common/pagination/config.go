@@ -13,6 +13,10 @@ type Config struct {
 	DefaultPage  int // Default page number (typically 1)
 	DefaultLimit int // Default items per page (typically 20)
 	MaxLimit     int // Maximum allowed items per page (typically 100)
+
+	// CursorSecret signs cursor tokens for keyset pagination.
+	// When empty, a random per-process secret is used (see EncodeCursor).
+	CursorSecret []byte
 }
 
 // DefaultConfig returns the default pagination configuration.
@@ -30,6 +34,7 @@ func DefaultConfig() Config {
 //   - PAGINATION_DEFAULT_PAGE: Default page number
 //   - PAGINATION_DEFAULT_LIMIT: Default items per page
 //   - PAGINATION_MAX_LIMIT: Maximum items per page
+//   - PAGINATION_CURSOR_SECRET: Secret used to sign cursor tokens
 //
 // Falls back to DefaultConfig() if environment variables are not set.
 func LoadFromEnv() Config {
@@ -37,6 +42,7 @@ func LoadFromEnv() Config {
 		DefaultPage:  getEnvAsInt("PAGINATION_DEFAULT_PAGE", 1),
 		DefaultLimit: getEnvAsInt("PAGINATION_DEFAULT_LIMIT", 20),
 		MaxLimit:     getEnvAsInt("PAGINATION_MAX_LIMIT", 100),
+		CursorSecret: []byte(os.Getenv("PAGINATION_CURSOR_SECRET")),
 	}
 }
 
@@ -0,0 +1,77 @@
+package pagination_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/common/pagination"
+)
+
+func TestConfig_CursorRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	config := pagination.Config{CursorSecret: []byte("test-secret")}
+	cur := pagination.Cursor{
+		PublishedAt: time.Date(2025, 3, 1, 12, 30, 0, 123456000, time.UTC),
+		ID:          42,
+	}
+
+	token := config.EncodeCursor(cur)
+	got, err := config.DecodeCursor(token)
+	if err != nil {
+		t.Fatalf("DecodeCursor() error = %v", err)
+	}
+	if !got.PublishedAt.Equal(cur.PublishedAt) || got.ID != cur.ID {
+		t.Errorf("DecodeCursor() = %+v, want %+v", got, cur)
+	}
+}
+
+func TestConfig_CursorWithoutSecret(t *testing.T) {
+	t.Parallel()
+
+	// 未設定時はプロセス単位のシークレットで署名される
+	var config pagination.Config
+	cur := pagination.Cursor{PublishedAt: time.Unix(1700000000, 0).UTC(), ID: 7}
+
+	got, err := config.DecodeCursor(config.EncodeCursor(cur))
+	if err != nil {
+		t.Fatalf("DecodeCursor() error = %v", err)
+	}
+	if got.ID != 7 {
+		t.Errorf("DecodeCursor() ID = %d, want 7", got.ID)
+	}
+}
+
+func TestConfig_DecodeCursor_Invalid(t *testing.T) {
+	t.Parallel()
+
+	config := pagination.Config{CursorSecret: []byte("test-secret")}
+	valid := config.EncodeCursor(pagination.Cursor{PublishedAt: time.Unix(1700000000, 0), ID: 42})
+
+	raw, _ := base64.RawURLEncoding.DecodeString(valid)
+	tampered := append([]byte(nil), raw...)
+	tampered[16] ^= 0x01 // ID を書き換える
+	otherSecret := pagination.Config{CursorSecret: []byte("other-secret")}.EncodeCursor(pagination.Cursor{PublishedAt: time.Unix(1700000000, 0), ID: 42})
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "not base64", token: "!!!"},
+		{name: "too short", token: base64.RawURLEncoding.EncodeToString(raw[:10])},
+		{name: "tampered payload", token: base64.RawURLEncoding.EncodeToString(tampered)},
+		{name: "signed with another secret", token: otherSecret},
+		{name: "plain base64 without signature", token: base64.RawURLEncoding.EncodeToString([]byte("2025-01-01T00:00:00Z|42"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := config.DecodeCursor(tt.token)
+			if !errors.Is(err, pagination.ErrInvalidCursor) {
+				t.Errorf("DecodeCursor() error = %v, want ErrInvalidCursor", err)
+			}
+		})
+	}
+}
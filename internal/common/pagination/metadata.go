@@ -1,9 +1,13 @@
 package pagination
 
 // Metadata contains pagination metadata included in API responses.
+//
+// For keyset pagination, Total is -1 (not counted), Page and TotalPages are 0,
+// and NextCursor holds the token of the next page (empty on the last page).
 type Metadata struct {
-	Total      int64 `json:"total"`       // Total number of items across all pages
-	Page       int   `json:"page"`        // Current page number (1-based)
-	Limit      int   `json:"limit"`       // Items per page
-	TotalPages int   `json:"total_pages"` // Calculated total number of pages
+	Total      int64  `json:"total"`                 // Total number of items across all pages
+	Page       int    `json:"page"`                  // Current page number (1-based)
+	Limit      int    `json:"limit"`                 // Items per page
+	TotalPages int    `json:"total_pages"`           // Calculated total number of pages
+	NextCursor string `json:"next_cursor,omitempty"` // Cursor of the next page (keyset pagination only)
 }
@@ -10,6 +10,12 @@ import (
 type Params struct {
 	Page  int // 1-based page number
 	Limit int // Items per page
+
+	// Keyset selects keyset (cursor) pagination instead of offset pagination.
+	Keyset bool
+	// Cursor is the decoded position to continue after (keyset pagination only).
+	// Nil requests the first page.
+	Cursor *Cursor
 }
 
 // ParseQueryParams parses pagination parameters from HTTP request query string.
@@ -18,8 +24,11 @@ type Params struct {
 // Query parameters:
 //   - page: Page number (must be positive integer)
 //   - limit: Items per page (must be between 1 and config.MaxLimit)
+//   - pagination: "offset" (default) or "cursor" to select keyset pagination
+//   - cursor: next_cursor token of the previous page (implies pagination=cursor)
 //
-// Returns an error if parameters are invalid.
+// Returns an error if parameters are invalid, if the cursor token is invalid or
+// has been tampered with, or if page is combined with keyset pagination.
 func ParseQueryParams(r *http.Request, config Config) (Params, error) {
 	params := Params{
 		Page:  config.DefaultPage,
@@ -44,5 +53,28 @@ func ParseQueryParams(r *http.Request, config Config) (Params, error) {
 		params.Limit = limit
 	}
 
+	// Parse pagination mode and cursor parameters
+	switch mode := r.URL.Query().Get("pagination"); mode {
+	case "", "offset":
+	case "cursor":
+		params.Keyset = true
+	default:
+		return params, fmt.Errorf("invalid query parameter: pagination must be 'offset' or 'cursor'")
+	}
+	if token := r.URL.Query().Get("cursor"); token != "" {
+		if r.URL.Query().Get("pagination") == "offset" {
+			return params, fmt.Errorf("invalid query parameter: cursor cannot be used with pagination=offset")
+		}
+		cur, err := config.DecodeCursor(token)
+		if err != nil {
+			return params, fmt.Errorf("invalid query parameter: cursor is invalid or has been tampered with")
+		}
+		params.Keyset = true
+		params.Cursor = &cur
+	}
+	if params.Keyset && r.URL.Query().Get("page") != "" {
+		return params, fmt.Errorf("invalid query parameter: page cannot be used with cursor pagination")
+	}
+
 	return params, nil
 }
@@ -3,7 +3,9 @@ package pagination_test
 import (
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"catchup-feed/internal/common/pagination"
 )
@@ -192,3 +194,59 @@ func TestParseQueryParams_ErrorMessages(t *testing.T) {
 		})
 	}
 }
+
+func TestParseQueryParams_Cursor(t *testing.T) {
+	t.Parallel()
+
+	config := pagination.Config{
+		DefaultPage:  1,
+		DefaultLimit: 20,
+		MaxLimit:     100,
+		CursorSecret: []byte("test-secret"),
+	}
+	cur := pagination.Cursor{PublishedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), ID: 9}
+	token := config.EncodeCursor(cur)
+
+	tests := []struct {
+		name              string
+		query             string
+		wantKeyset        bool
+		wantCursor        *pagination.Cursor
+		wantErrorContains string
+	}{
+		{name: "offset by default", query: "page=2"},
+		{name: "explicit offset", query: "pagination=offset"},
+		{name: "first keyset page", query: "pagination=cursor&limit=10", wantKeyset: true},
+		{name: "cursor implies keyset", query: "cursor=" + token, wantKeyset: true, wantCursor: &cur},
+		{name: "unknown mode", query: "pagination=seek", wantErrorContains: "pagination must be"},
+		{name: "tampered cursor", query: "cursor=" + token[:len(token)-2] + "AA", wantErrorContains: "cursor is invalid"},
+		{name: "cursor with offset mode", query: "pagination=offset&cursor=" + token, wantErrorContains: "cannot be used with pagination=offset"},
+		{name: "page with cursor", query: "page=2&cursor=" + token, wantErrorContains: "page cannot be used"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/?"+tt.query, nil)
+			got, err := pagination.ParseQueryParams(req, config)
+
+			if tt.wantErrorContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrorContains) {
+					t.Fatalf("ParseQueryParams() error = %v, want error containing %q", err, tt.wantErrorContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseQueryParams() error = %v", err)
+			}
+			if got.Keyset != tt.wantKeyset {
+				t.Errorf("ParseQueryParams() Keyset = %v, want %v", got.Keyset, tt.wantKeyset)
+			}
+			if (got.Cursor == nil) != (tt.wantCursor == nil) {
+				t.Fatalf("ParseQueryParams() Cursor = %v, want %v", got.Cursor, tt.wantCursor)
+			}
+			if tt.wantCursor != nil && (!got.Cursor.PublishedAt.Equal(tt.wantCursor.PublishedAt) || got.Cursor.ID != tt.wantCursor.ID) {
+				t.Errorf("ParseQueryParams() Cursor = %+v, want %+v", *got.Cursor, *tt.wantCursor)
+			}
+		})
+	}
+}
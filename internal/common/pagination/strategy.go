@@ -46,11 +46,30 @@ func (s OffsetStrategy) BuildMetadata(params Params, total int64, hasMore bool)
 	}
 }
 
-// CursorStrategy is planned for future implementation.
-// Design considerations documented in:
-// - docs/designs/security-and-quality-improvements.md (Section 14)
-//
-// Future implementation will use:
-// - Opaque cursors: base64(published_at + article_id)
-// - No total count (performance optimization)
-// - hasMore flag instead of total_pages
+// KeysetStrategy implements keyset (cursor) pagination over (published_at DESC, id DESC).
+// Pages continue after the position in Params.Cursor instead of skipping rows with
+// OFFSET, and no total count is computed.
+type KeysetStrategy struct{}
+
+// CalculateQuery returns the limit for keyset pagination.
+// One extra row is requested so that the caller can tell whether a next page exists.
+func (s KeysetStrategy) CalculateQuery(params Params) QueryParams {
+	return QueryParams{
+		Offset: 0,
+		Limit:  params.Limit + 1,
+		Cursor: nil,
+		After:  nil,
+	}
+}
+
+// BuildMetadata constructs pagination metadata for keyset pagination.
+// Total is reported as -1 because it is not counted; the caller sets NextCursor
+// from the last item when hasMore is true.
+func (s KeysetStrategy) BuildMetadata(params Params, total int64, hasMore bool) Metadata {
+	return Metadata{
+		Total:      -1,
+		Page:       0,
+		Limit:      params.Limit,
+		TotalPages: 0,
+	}
+}
@@ -102,3 +102,21 @@ func BenchmarkOffsetStrategy_CalculateQuery(b *testing.B) {
 		strategy.CalculateQuery(params)
 	}
 }
+
+func TestKeysetStrategy(t *testing.T) {
+	t.Parallel()
+
+	strategy := pagination.KeysetStrategy{}
+	params := pagination.Params{Page: 1, Limit: 20, Keyset: true}
+
+	query := strategy.CalculateQuery(params)
+	if query.Limit != 21 || query.Offset != 0 {
+		t.Errorf("CalculateQuery() = %+v, want Limit=21 Offset=0", query)
+	}
+
+	meta := strategy.BuildMetadata(params, 0, true)
+	want := pagination.Metadata{Total: -1, Page: 0, Limit: 20, TotalPages: 0}
+	if meta != want {
+		t.Errorf("BuildMetadata() = %+v, want %+v", meta, want)
+	}
+}
@@ -5,6 +5,10 @@ package entity
 
 import "time"
 
+// SummaryStatusPending marks an article whose summary is being generated by a
+// batch summarization request. Articles summarized synchronously have an empty status.
+const SummaryStatusPending = "pending"
+
 // Article represents a news article entity in the system.
 // It contains the article's metadata, content summary, and relationships to sources.
 type Article struct {
@@ -15,4 +19,30 @@ type Article struct {
 	Summary     string
 	PublishedAt time.Time
 	CreatedAt   time.Time
+
+	// Structured holds the optional structured summary (TL;DR, key points, tags, reading time).
+	// It is nil when the summarizer ran in plain-prose mode or the structured output was rejected.
+	Structured *StructuredSummary
+
+	// PromptVersion identifies the prompt template that produced Summary
+	// (e.g. "default@3f2a9c1b"). Empty for articles summarized before templates existed.
+	PromptVersion string
+
+	// SummaryModel is the AI model that produced Summary (e.g. "claude-sonnet-4-5-20250929").
+	// Empty for articles summarized before the model was recorded.
+	SummaryModel string
+
+	// SummaryStatus is SummaryStatusPending while the summary is awaited from a
+	// batch request, and empty once Summary has been filled in.
+	SummaryStatus string
+
+	// SummaryBatchID is the ID of the batch request that summarizes a pending article.
+	// It is empty for articles summarized synchronously and for pending articles
+	// that have not been submitted yet.
+	SummaryBatchID string
+
+	// InjectionFlags lists the prompt-injection detections (InjectionFlag* constants)
+	// of the summarization that produced Summary. It is empty when nothing suspicious
+	// was found in the feed content or the generated summary.
+	InjectionFlags []string
 }
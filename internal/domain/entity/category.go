@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxCategoryNameLength is the maximum length (in runes) of a source category name.
+const MaxCategoryNameLength = 100
+
+// Category groups sources (e.g. "Go", "Security", "Company blogs").
+// A source can belong to any number of categories.
+type Category struct {
+	ID        int64
+	Name      string
+	CreatedAt time.Time
+}
+
+// NormalizeCategoryName trims the name and collapses inner whitespace to single
+// spaces. Unlike tag names, the case is kept for display.
+func NormalizeCategoryName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// Validate normalizes the name and checks that it is not empty or too long.
+func (c *Category) Validate() error {
+	c.Name = NormalizeCategoryName(c.Name)
+	if c.Name == "" {
+		return &ValidationError{Field: "name", Message: "is required"}
+	}
+	if utf8.RuneCountInString(c.Name) > MaxCategoryNameLength {
+		return &ValidationError{Field: "name", Message: fmt.Sprintf("is too long (max %d characters)", MaxCategoryNameLength)}
+	}
+	return nil
+}
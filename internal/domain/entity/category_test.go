@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategory_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		category Category
+		wantName string
+		wantErr  bool
+	}{
+		{name: "valid", category: Category{Name: "Go"}, wantName: "Go"},
+		{name: "whitespace collapsed, case kept", category: Category{Name: "  Company \t blogs "}, wantName: "Company blogs"},
+		{name: "name at limit", category: Category{Name: strings.Repeat("あ", MaxCategoryNameLength)}, wantName: strings.Repeat("あ", MaxCategoryNameLength)},
+		{name: "empty name", category: Category{Name: "  "}, wantErr: true},
+		{name: "name too long", category: Category{Name: strings.Repeat("a", MaxCategoryNameLength+1)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.category.Validate()
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantName, tt.category.Name)
+				return
+			}
+			var vErr *ValidationError
+			if assert.True(t, errors.As(err, &vErr), "expected ValidationError, got %v", err) {
+				assert.Equal(t, "name", vErr.Field)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package entity
+
+import "time"
+
+// Digest periods.
+const (
+	// DigestDaily is a digest of the articles fetched during one day.
+	DigestDaily = "daily"
+	// DigestWeekly is a digest of the articles fetched during one week.
+	DigestWeekly = "weekly"
+)
+
+// Digest groupings.
+const (
+	// DigestGroupBySource groups digest articles by their feed source.
+	DigestGroupBySource = "source"
+	// DigestGroupByTag groups digest articles by topic tag.
+	DigestGroupByTag = "tag"
+)
+
+// Digest is a periodic catch-up message listing the articles fetched since the
+// previous digest of the same period. The grouped articles are a snapshot taken
+// when the digest was generated, so later edits or deletions do not change it.
+type Digest struct {
+	ID      int64
+	Period  string
+	GroupBy string
+	Title   string
+	// Overview is the editor's overview written by the summarizer (empty if disabled or failed).
+	Overview string
+
+	// PeriodStart and PeriodEnd bound the fetch time (created_at) of the included
+	// articles: PeriodStart < created_at <= PeriodEnd.
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+
+	// ArticleCount is the number of articles in Groups.
+	ArticleCount int
+	Groups       []DigestGroup
+
+	// PendingArticleIDs are the articles of the period, or carried over from the
+	// previous digest, that were still waiting for their batch summary. The next
+	// digest of the same period includes them once they are summarized.
+	PendingArticleIDs []int64
+
+	CreatedAt time.Time
+}
+
+// DigestGroup is a named group of digest articles (a source name or a tag).
+type DigestGroup struct {
+	Name     string          `json:"name"`
+	Articles []DigestArticle `json:"articles"`
+}
+
+// DigestArticle is the snapshot of an article included in a digest.
+type DigestArticle struct {
+	ID          int64     `json:"id"`
+	Title       string    `json:"title"`
+	URL         string    `json:"url"`
+	SourceName  string    `json:"source_name"`
+	Summary     string    `json:"summary"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// ValidDigestPeriod reports whether p is a supported digest period.
+func ValidDigestPeriod(p string) bool {
+	return p == DigestDaily || p == DigestWeekly
+}
+
+// ValidDigestGroupBy reports whether g is a supported digest grouping.
+func ValidDigestGroupBy(g string) bool {
+	return g == DigestGroupBySource || g == DigestGroupByTag
+}
+
+// DigestPeriodLength returns the time span covered by a digest period.
+// It is used as the look-back window when no earlier digest exists.
+func DigestPeriodLength(p string) time.Duration {
+	if p == DigestWeekly {
+		return 7 * 24 * time.Hour
+	}
+	return 24 * time.Hour
+}
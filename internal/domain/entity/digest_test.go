@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidDigestPeriod(t *testing.T) {
+	for p, want := range map[string]bool{
+		DigestDaily:  true,
+		DigestWeekly: true,
+		"monthly":    false,
+		"":           false,
+	} {
+		assert.Equal(t, want, ValidDigestPeriod(p), p)
+	}
+}
+
+func TestValidDigestGroupBy(t *testing.T) {
+	for g, want := range map[string]bool{
+		DigestGroupBySource: true,
+		DigestGroupByTag:    true,
+		"category":          false,
+		"":                  false,
+	} {
+		assert.Equal(t, want, ValidDigestGroupBy(g), g)
+	}
+}
+
+func TestDigestPeriodLength(t *testing.T) {
+	assert.Equal(t, 24*time.Hour, DigestPeriodLength(DigestDaily))
+	assert.Equal(t, 7*24*time.Hour, DigestPeriodLength(DigestWeekly))
+}
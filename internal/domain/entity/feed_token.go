@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// FeedToken authenticates the outbound article feeds (Atom, RSS and JSON Feed) of
+// a user (the JWT subject). Feed readers cannot send bearer tokens, so the token
+// is passed in the feed URL. Each user has at most one token.
+type FeedToken struct {
+	UserID    string
+	Token     string
+	CreatedAt time.Time
+}
@@ -0,0 +1,40 @@
+package entity
+
+import "sort"
+
+// Prompt-injection detections recorded in Article.InjectionFlags.
+//
+// Input flags mean that text addressed to the model was found in the feed content
+// and removed before summarization. Output flags mean that the generated summary
+// looked hijacked and the offending parts were removed before it was stored.
+const (
+	// InjectionFlagInstructionOverride: the content tells the model to ignore or replace its instructions.
+	InjectionFlagInstructionOverride = "instruction_override"
+	// InjectionFlagRoleOverride: the content assigns the model a new role or system prompt.
+	InjectionFlagRoleOverride = "role_override"
+	// InjectionFlagChatMarkup: the content contains chat-template markup such as <|im_start|> or [INST].
+	InjectionFlagChatMarkup = "chat_markup"
+	// InjectionFlagDelimiterEscape: the content tries to close the block that isolates it in the prompt.
+	InjectionFlagDelimiterEscape = "delimiter_escape"
+
+	// InjectionFlagUnknownURL: the summary contains a URL that does not appear in the content.
+	InjectionFlagUnknownURL = "unknown_url"
+	// InjectionFlagEchoedInstruction: the summary repeats instructions instead of summarizing.
+	InjectionFlagEchoedInstruction = "echoed_instruction"
+)
+
+// MergeInjectionFlags returns the sorted union of the given flag lists, or nil when all are empty.
+func MergeInjectionFlags(lists ...[]string) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, list := range lists {
+		for _, f := range list {
+			if f != "" && !seen[f] {
+				seen[f] = true
+				out = append(out, f)
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Reading list constraints.
+const (
+	// MaxReadingListNameLength is the maximum length (in runes) of a reading list name.
+	MaxReadingListNameLength = 100
+	// MaxReadingListDescriptionLength is the maximum length (in runes) of a reading list description.
+	MaxReadingListDescriptionLength = 1000
+	// MaxReadingListNoteLength is the maximum length (in runes) of the note of a list item.
+	MaxReadingListNoteLength = 1000
+)
+
+// ReadingList is a named, ordered collection of articles owned by a user
+// (the JWT subject), e.g. "to read at weekly sync".
+type ReadingList struct {
+	ID          int64
+	UserID      string
+	Name        string
+	Description string
+	// ShareToken grants read-only access to the list without login.
+	// It is empty when the list is not shared.
+	ShareToken string
+	// ItemCount is the number of articles in the list (filled when listing).
+	ItemCount int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Validate checks that the list has an owner, a non-empty name and that the name
+// and description are within their length limits.
+func (l *ReadingList) Validate() error {
+	if l.UserID == "" {
+		return &ValidationError{Field: "user_id", Message: "is required"}
+	}
+	if strings.TrimSpace(l.Name) == "" {
+		return &ValidationError{Field: "name", Message: "is required"}
+	}
+	if utf8.RuneCountInString(l.Name) > MaxReadingListNameLength {
+		return &ValidationError{Field: "name", Message: fmt.Sprintf("is too long (max %d characters)", MaxReadingListNameLength)}
+	}
+	if utf8.RuneCountInString(l.Description) > MaxReadingListDescriptionLength {
+		return &ValidationError{Field: "description", Message: fmt.Sprintf("is too long (max %d characters)", MaxReadingListDescriptionLength)}
+	}
+	return nil
+}
+
+// ReadingListItem is an article in a reading list. Items are ordered by Position
+// (ascending); new items are appended at the end.
+type ReadingListItem struct {
+	ListID    int64
+	ArticleID int64
+	Position  int
+	Note      string
+	AddedAt   time.Time
+}
+
+// ValidateReadingListNote validates the note of a reading list item.
+func ValidateReadingListNote(note string) error {
+	if utf8.RuneCountInString(note) > MaxReadingListNoteLength {
+		return &ValidationError{Field: "note", Message: fmt.Sprintf("is too long (max %d characters)", MaxReadingListNoteLength)}
+	}
+	return nil
+}
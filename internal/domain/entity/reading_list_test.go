@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadingList_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		list      ReadingList
+		wantField string
+	}{
+		{name: "valid", list: ReadingList{UserID: "alice", Name: "週次ミーティングで読む"}},
+		{name: "with description", list: ReadingList{UserID: "alice", Name: "go", Description: "Go の記事"}},
+		{name: "name at limit", list: ReadingList{UserID: "alice", Name: strings.Repeat("あ", MaxReadingListNameLength)}},
+		{name: "no user", list: ReadingList{Name: "go"}, wantField: "user_id"},
+		{name: "empty name", list: ReadingList{UserID: "alice", Name: "  "}, wantField: "name"},
+		{name: "name too long", list: ReadingList{UserID: "alice", Name: strings.Repeat("a", MaxReadingListNameLength+1)}, wantField: "name"},
+		{name: "description too long", list: ReadingList{UserID: "alice", Name: "go", Description: strings.Repeat("a", MaxReadingListDescriptionLength+1)}, wantField: "description"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.list.Validate()
+			if tt.wantField == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var vErr *ValidationError
+			if assert.True(t, errors.As(err, &vErr), "expected ValidationError, got %v", err) {
+				assert.Equal(t, tt.wantField, vErr.Field)
+			}
+		})
+	}
+}
+
+func TestValidateReadingListNote(t *testing.T) {
+	assert.NoError(t, ValidateReadingListNote(""))
+	assert.NoError(t, ValidateReadingListNote(strings.Repeat("あ", MaxReadingListNoteLength)))
+
+	var vErr *ValidationError
+	err := ValidateReadingListNote(strings.Repeat("a", MaxReadingListNoteLength+1))
+	if assert.True(t, errors.As(err, &vErr), "expected ValidationError, got %v", err) {
+		assert.Equal(t, "note", vErr.Field)
+	}
+}
@@ -0,0 +1,71 @@
+package entity
+
+import "time"
+
+// Resummarize job statuses.
+const (
+	// ResummarizeQueued is a job waiting to be picked up by the worker.
+	ResummarizeQueued = "queued"
+	// ResummarizeRunning is a job the worker has started; it resumes after LastArticleID.
+	ResummarizeRunning = "running"
+	// ResummarizeCompleted is a job whose target articles have all been processed.
+	ResummarizeCompleted = "completed"
+	// ResummarizeFailed is a job aborted because its target articles could not be selected (see Error).
+	ResummarizeFailed = "failed"
+)
+
+// ResummarizeFilter selects the articles a resummarize job regenerates.
+// Nil or empty fields do not restrict the selection.
+type ResummarizeFilter struct {
+	ArticleID    *int64     // Regenerate a single article
+	SourceID     *int64     // Articles of this source
+	From         *time.Time // Articles published >= this time
+	To           *time.Time // Articles published <= this time
+	SummaryModel string     // Articles summarized by this model
+}
+
+// Validate checks that the filter describes a consistent selection.
+func (f ResummarizeFilter) Validate() error {
+	if f.ArticleID != nil && *f.ArticleID <= 0 {
+		return &ValidationError{Field: "article_id", Message: "must be positive"}
+	}
+	if f.SourceID != nil && *f.SourceID <= 0 {
+		return &ValidationError{Field: "source_id", Message: "must be positive"}
+	}
+	if f.From != nil && f.To != nil && f.From.After(*f.To) {
+		return &ValidationError{Field: "from", Message: "must be on or before to"}
+	}
+	return nil
+}
+
+// ResummarizeJob tracks the regeneration of existing article summaries with the
+// currently configured summarizer and prompt templates. Jobs are requested through
+// the API and processed by the worker in rate-limited steps, in ascending article ID order.
+type ResummarizeJob struct {
+	ID     int64
+	Status string
+	Filter ResummarizeFilter
+
+	// Total is the number of articles matched by Filter when the job started.
+	Total int
+	// Processed counts articles handled so far (Succeeded + Failed).
+	Processed int
+	Succeeded int
+	Failed    int
+	// LastArticleID is the ID of the last processed article; processing resumes after it.
+	LastArticleID int64
+
+	// Error describes why the job failed.
+	Error string
+	// RequestedBy is the JWT subject of the user who requested the job.
+	RequestedBy string
+
+	CreatedAt  time.Time
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+}
+
+// Done reports whether the job has reached a final status.
+func (j *ResummarizeJob) Done() bool {
+	return j.Status == ResummarizeCompleted || j.Status == ResummarizeFailed
+}
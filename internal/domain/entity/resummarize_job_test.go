@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResummarizeFilter_Validate(t *testing.T) {
+	id := func(v int64) *int64 { return &v }
+	jan := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		filter    ResummarizeFilter
+		wantField string
+	}{
+		{name: "empty", filter: ResummarizeFilter{}},
+		{name: "article", filter: ResummarizeFilter{ArticleID: id(1)}},
+		{name: "source and range", filter: ResummarizeFilter{SourceID: id(2), From: &jan, To: &feb}},
+		{name: "same day range", filter: ResummarizeFilter{From: &jan, To: &jan}},
+		{name: "zero article id", filter: ResummarizeFilter{ArticleID: id(0)}, wantField: "article_id"},
+		{name: "negative source id", filter: ResummarizeFilter{SourceID: id(-1)}, wantField: "source_id"},
+		{name: "from after to", filter: ResummarizeFilter{From: &feb, To: &jan}, wantField: "from"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.wantField == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var ve *ValidationError
+			if assert.True(t, errors.As(err, &ve)) {
+				assert.Equal(t, tt.wantField, ve.Field)
+			}
+		})
+	}
+}
+
+func TestResummarizeJob_Done(t *testing.T) {
+	for status, want := range map[string]bool{
+		ResummarizeQueued:    false,
+		ResummarizeRunning:   false,
+		ResummarizeCompleted: true,
+		ResummarizeFailed:    true,
+	} {
+		job := ResummarizeJob{Status: status}
+		assert.Equal(t, want, job.Done(), status)
+	}
+}
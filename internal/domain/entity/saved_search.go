@@ -0,0 +1,126 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Saved search constraints.
+const (
+	// MaxSavedSearchNameLength is the maximum length (in runes) of a saved search name.
+	MaxSavedSearchNameLength = 100
+	// MaxSavedSearchTags is the maximum number of tag filters of a saved search.
+	MaxSavedSearchTags = 10
+	// MaxSearchAlertArticles is the maximum number of articles listed in one search alert.
+	MaxSearchAlertArticles = 20
+)
+
+// Notification channels of saved search alerts.
+const (
+	// AlertChannelAll delivers alerts to every enabled notification channel.
+	AlertChannelAll = ""
+	// AlertChannelDiscord delivers alerts to Discord only.
+	AlertChannelDiscord = "discord"
+	// AlertChannelSlack delivers alerts to Slack only.
+	AlertChannelSlack = "slack"
+)
+
+// SavedSearch is an article search (keywords and filters, as accepted by
+// /articles/search) saved by a user (the JWT subject). A subscribed search is
+// evaluated against newly fetched articles after each crawl and the matches are
+// sent as an alert.
+type SavedSearch struct {
+	ID     int64
+	UserID string
+	Name   string
+	// Keyword is the space-separated keywords; articles must contain all of them.
+	Keyword  string
+	SourceID *int64
+	From     *time.Time
+	To       *time.Time
+	Tags     []string
+
+	// Subscribed enables alerts about new articles matching the search.
+	Subscribed bool
+	// Channel is the notification channel of the alerts (AlertChannelAll for every enabled channel).
+	Channel string
+	// LastArticleID is the ID of the newest article the search has been evaluated
+	// against; alerts only consider articles with larger IDs.
+	LastArticleID int64
+	// LastAlertedAt is when the last alert was sent (nil if never).
+	LastAlertedAt *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Validate checks that the search has an owner, a non-empty name within its length
+// limit, at least one keyword or filter, a valid date range and tag count, and a
+// supported alert channel. Keywords are validated by the use case.
+func (s *SavedSearch) Validate() error {
+	if s.UserID == "" {
+		return &ValidationError{Field: "user_id", Message: "is required"}
+	}
+	if strings.TrimSpace(s.Name) == "" {
+		return &ValidationError{Field: "name", Message: "is required"}
+	}
+	if utf8.RuneCountInString(s.Name) > MaxSavedSearchNameLength {
+		return &ValidationError{Field: "name", Message: fmt.Sprintf("is too long (max %d characters)", MaxSavedSearchNameLength)}
+	}
+	if strings.TrimSpace(s.Keyword) == "" && s.SourceID == nil && s.From == nil && s.To == nil && len(s.Tags) == 0 {
+		return &ValidationError{Field: "keyword", Message: "or a filter is required"}
+	}
+	if s.SourceID != nil && *s.SourceID <= 0 {
+		return &ValidationError{Field: "source_id", Message: "must be positive"}
+	}
+	if s.From != nil && s.To != nil && s.From.After(*s.To) {
+		return &ValidationError{Field: "from", Message: "must be before or equal to to"}
+	}
+	if len(s.Tags) > MaxSavedSearchTags {
+		return &ValidationError{Field: "tags", Message: fmt.Sprintf("must be at most %d tags", MaxSavedSearchTags)}
+	}
+	if !ValidAlertChannel(s.Channel) {
+		return &ValidationError{Field: "channel", Message: fmt.Sprintf("must be %q, %q or empty", AlertChannelDiscord, AlertChannelSlack)}
+	}
+	return nil
+}
+
+// ValidAlertChannel reports whether c is a supported alert channel.
+func ValidAlertChannel(c string) bool {
+	return c == AlertChannelAll || c == AlertChannelDiscord || c == AlertChannelSlack
+}
+
+// SearchAlert notifies the owner of a saved search about new matching articles.
+type SearchAlert struct {
+	SearchID   int64
+	SearchName string
+	// Channel is the channel to deliver the alert to (AlertChannelAll for every enabled channel).
+	Channel string
+	// Articles are the new matches, newest first (at most MaxSearchAlertArticles).
+	Articles []DigestArticle
+}
+
+// Digest returns the alert as an unsaved digest grouped by source, so that alerts
+// are delivered in the same message format as digests.
+func (a *SearchAlert) Digest(now time.Time) *Digest {
+	var groups []DigestGroup
+	index := make(map[string]int)
+	for _, art := range a.Articles {
+		i, ok := index[art.SourceName]
+		if !ok {
+			i = len(groups)
+			index[art.SourceName] = i
+			groups = append(groups, DigestGroup{Name: art.SourceName})
+		}
+		groups[i].Articles = append(groups[i].Articles, art)
+	}
+	return &Digest{
+		GroupBy:      DigestGroupBySource,
+		Title:        fmt.Sprintf("保存した検索「%s」の新着記事", a.SearchName),
+		PeriodEnd:    now,
+		ArticleCount: len(a.Articles),
+		Groups:       groups,
+	}
+}
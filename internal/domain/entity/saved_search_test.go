@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavedSearch_Validate(t *testing.T) {
+	sourceID := int64(3)
+	badSourceID := int64(0)
+	from := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	tests := []struct {
+		name      string
+		search    SavedSearch
+		wantField string
+	}{
+		{name: "keyword", search: SavedSearch{UserID: "alice", Name: "Go", Keyword: "go release"}},
+		{name: "filters only", search: SavedSearch{UserID: "alice", Name: "Go Blog", SourceID: &sourceID, Tags: []string{"go"}}},
+		{name: "date range", search: SavedSearch{UserID: "alice", Name: "June", From: &from, To: &to}},
+		{name: "slack alerts", search: SavedSearch{UserID: "alice", Name: "Go", Keyword: "go", Subscribed: true, Channel: AlertChannelSlack}},
+		{name: "no user", search: SavedSearch{Name: "Go", Keyword: "go"}, wantField: "user_id"},
+		{name: "empty name", search: SavedSearch{UserID: "alice", Name: " ", Keyword: "go"}, wantField: "name"},
+		{name: "name too long", search: SavedSearch{UserID: "alice", Name: strings.Repeat("a", MaxSavedSearchNameLength+1), Keyword: "go"}, wantField: "name"},
+		{name: "no criteria", search: SavedSearch{UserID: "alice", Name: "everything", Keyword: "  "}, wantField: "keyword"},
+		{name: "invalid source", search: SavedSearch{UserID: "alice", Name: "Go", SourceID: &badSourceID}, wantField: "source_id"},
+		{name: "reversed dates", search: SavedSearch{UserID: "alice", Name: "Go", From: &to, To: &from}, wantField: "from"},
+		{name: "too many tags", search: SavedSearch{UserID: "alice", Name: "Go", Tags: make([]string, MaxSavedSearchTags+1)}, wantField: "tags"},
+		{name: "unknown channel", search: SavedSearch{UserID: "alice", Name: "Go", Keyword: "go", Channel: "email"}, wantField: "channel"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.search.Validate()
+			if tt.wantField == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var vErr *ValidationError
+			if assert.True(t, errors.As(err, &vErr), "expected ValidationError, got %v", err) {
+				assert.Equal(t, tt.wantField, vErr.Field)
+			}
+		})
+	}
+}
+
+func TestSearchAlert_Digest(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	alert := &SearchAlert{
+		SearchName: "Go リリース",
+		Articles: []DigestArticle{
+			{ID: 3, Title: "Go 1.25", SourceName: "Go Blog"},
+			{ID: 2, Title: "Go 1.25 RC", SourceName: "Hacker News"},
+			{ID: 1, Title: "Go 1.25 beta", SourceName: "Go Blog"},
+		},
+	}
+
+	d := alert.Digest(now)
+
+	assert.Equal(t, "保存した検索「Go リリース」の新着記事", d.Title)
+	assert.Equal(t, DigestGroupBySource, d.GroupBy)
+	assert.Equal(t, 3, d.ArticleCount)
+	assert.True(t, d.PeriodEnd.Equal(now))
+	require.Len(t, d.Groups, 2)
+	assert.Equal(t, "Go Blog", d.Groups[0].Name)
+	assert.Equal(t, []int64{3, 1}, []int64{d.Groups[0].Articles[0].ID, d.Groups[0].Articles[1].ID})
+	assert.Equal(t, "Hacker News", d.Groups[1].Name)
+}
+
+func TestValidAlertChannel(t *testing.T) {
+	assert.True(t, ValidAlertChannel(AlertChannelAll))
+	assert.True(t, ValidAlertChannel(AlertChannelDiscord))
+	assert.True(t, ValidAlertChannel(AlertChannelSlack))
+	assert.False(t, ValidAlertChannel("Slack"))
+}
@@ -10,13 +10,14 @@ import (
 // It contains the feed URL, metadata, and crawling status information.
 // For web scraping sources, it also includes the source type and configuration.
 type Source struct {
-	ID            int64
-	Name          string
-	FeedURL       string
-	LastCrawledAt *time.Time
-	Active        bool
-	SourceType    string          `json:"source_type"`    // RSS, Webflow, NextJS, Remix
-	ScraperConfig *ScraperConfig  `json:"scraper_config"` // Configuration for web scrapers
+	ID             int64
+	Name           string
+	FeedURL        string
+	LastCrawledAt  *time.Time
+	Active         bool
+	SourceType     string         `json:"source_type"`     // RSS, Webflow, NextJS, Remix
+	ScraperConfig  *ScraperConfig `json:"scraper_config"`  // Configuration for web scrapers
+	PromptTemplate string         `json:"prompt_template"` // Summary prompt template name (empty = default)
 }
 
 // ScraperConfig holds configuration for web scraping sources.
@@ -66,5 +67,9 @@ func (s *Source) Validate() error {
 		return errors.New("scraper_config is required for non-RSS sources")
 	}
 
+	if err := ValidatePromptTemplateName(s.PromptTemplate); err != nil {
+		return err
+	}
+
 	return nil
 }
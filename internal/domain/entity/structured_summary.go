@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Structured summary constraints.
+const (
+	// MinKeyPoints is the minimum number of bullet key points in a structured summary.
+	MinKeyPoints = 3
+	// MaxKeyPoints is the maximum number of bullet key points in a structured summary.
+	MaxKeyPoints = 5
+	// MaxSummaryTags is the maximum number of topic tags in a structured summary.
+	MaxSummaryTags = 10
+	// MaxTLDRLength is the maximum length (in runes) of the one-line TL;DR.
+	MaxTLDRLength = 200
+)
+
+// StructuredSummary is the machine-readable form of an article summary.
+// It is produced by the summarizer in structured mode alongside the prose summary
+// so that clients can render a TL;DR, bullet points and tags instead of one text blob.
+type StructuredSummary struct {
+	TLDR               string   `json:"tldr"`
+	KeyPoints          []string `json:"key_points"`
+	Tags               []string `json:"tags"`
+	ReadingTimeMinutes int      `json:"reading_time_minutes"`
+}
+
+// Validate checks that the structured summary satisfies the output contract:
+// a non-empty single-line TL;DR, 3-5 non-empty key points, at most 10 tags
+// and a positive reading time.
+func (s *StructuredSummary) Validate() error {
+	tldr := strings.TrimSpace(s.TLDR)
+	if tldr == "" {
+		return &ValidationError{Field: "tldr", Message: "is required"}
+	}
+	if strings.ContainsAny(tldr, "\r\n") {
+		return &ValidationError{Field: "tldr", Message: "must be a single line"}
+	}
+	if utf8.RuneCountInString(tldr) > MaxTLDRLength {
+		return &ValidationError{Field: "tldr", Message: fmt.Sprintf("must not exceed %d characters", MaxTLDRLength)}
+	}
+
+	if len(s.KeyPoints) < MinKeyPoints || len(s.KeyPoints) > MaxKeyPoints {
+		return &ValidationError{
+			Field:   "key_points",
+			Message: fmt.Sprintf("must contain %d-%d items (got %d)", MinKeyPoints, MaxKeyPoints, len(s.KeyPoints)),
+		}
+	}
+	for i, p := range s.KeyPoints {
+		if strings.TrimSpace(p) == "" {
+			return &ValidationError{Field: "key_points", Message: fmt.Sprintf("item %d is empty", i)}
+		}
+	}
+
+	if len(s.Tags) > MaxSummaryTags {
+		return &ValidationError{
+			Field:   "tags",
+			Message: fmt.Sprintf("must not contain more than %d items (got %d)", MaxSummaryTags, len(s.Tags)),
+		}
+	}
+	for i, tag := range s.Tags {
+		if strings.TrimSpace(tag) == "" {
+			return &ValidationError{Field: "tags", Message: fmt.Sprintf("item %d is empty", i)}
+		}
+	}
+
+	if s.ReadingTimeMinutes <= 0 {
+		return &ValidationError{Field: "reading_time_minutes", Message: "must be positive"}
+	}
+
+	return nil
+}
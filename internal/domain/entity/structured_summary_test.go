@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validStructuredSummary() StructuredSummary {
+	return StructuredSummary{
+		TLDR:               "Go 1.24 adds generic type aliases",
+		KeyPoints:          []string{"point 1", "point 2", "point 3"},
+		Tags:               []string{"go", "release"},
+		ReadingTimeMinutes: 4,
+	}
+}
+
+func TestStructuredSummary_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		mutate    func(s *StructuredSummary)
+		wantField string
+	}{
+		{name: "valid", mutate: func(_ *StructuredSummary) {}},
+		{name: "five key points", mutate: func(s *StructuredSummary) {
+			s.KeyPoints = []string{"a", "b", "c", "d", "e"}
+		}},
+		{name: "no tags", mutate: func(s *StructuredSummary) { s.Tags = nil }},
+		{name: "empty tldr", mutate: func(s *StructuredSummary) { s.TLDR = "  " }, wantField: "tldr"},
+		{name: "multi-line tldr", mutate: func(s *StructuredSummary) { s.TLDR = "line1\nline2" }, wantField: "tldr"},
+		{name: "tldr too long", mutate: func(s *StructuredSummary) {
+			s.TLDR = strings.Repeat("あ", MaxTLDRLength+1)
+		}, wantField: "tldr"},
+		{name: "too few key points", mutate: func(s *StructuredSummary) { s.KeyPoints = []string{"a", "b"} }, wantField: "key_points"},
+		{name: "too many key points", mutate: func(s *StructuredSummary) {
+			s.KeyPoints = []string{"a", "b", "c", "d", "e", "f"}
+		}, wantField: "key_points"},
+		{name: "blank key point", mutate: func(s *StructuredSummary) { s.KeyPoints[1] = " " }, wantField: "key_points"},
+		{name: "too many tags", mutate: func(s *StructuredSummary) {
+			s.Tags = strings.Split("a,b,c,d,e,f,g,h,i,j,k", ",")
+		}, wantField: "tags"},
+		{name: "blank tag", mutate: func(s *StructuredSummary) { s.Tags = []string{""} }, wantField: "tags"},
+		{name: "zero reading time", mutate: func(s *StructuredSummary) { s.ReadingTimeMinutes = 0 }, wantField: "reading_time_minutes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := validStructuredSummary()
+			tt.mutate(&s)
+
+			err := s.Validate()
+			if tt.wantField == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var vErr *ValidationError
+			if assert.True(t, errors.As(err, &vErr), "expected ValidationError, got %v", err) {
+				assert.Equal(t, tt.wantField, vErr.Field)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Tag origins record which mechanism attached a tag to an article.
+const (
+	// TagOriginRule is a tag attached by a keyword or regex tag rule.
+	TagOriginRule = "rule"
+	// TagOriginLLM is a tag suggested by the summarizer in the structured summary.
+	TagOriginLLM = "llm"
+	// TagOriginFeed is a tag taken from the categories of the feed item.
+	TagOriginFeed = "feed"
+	// TagOriginManual is a tag attached by an administrator through the API.
+	TagOriginManual = "manual"
+)
+
+// Tag rule match types.
+const (
+	// TagMatchKeyword matches when the pattern occurs in the text, ignoring case.
+	TagMatchKeyword = "keyword"
+	// TagMatchRegex matches when the regular expression matches the text.
+	TagMatchRegex = "regex"
+)
+
+// Tag constraints.
+const (
+	// MaxTagNameLength is the maximum length (in runes) of a normalized tag name.
+	MaxTagNameLength = 50
+	// MaxTagRulePatternLength is the maximum length (in runes) of a tag rule pattern.
+	MaxTagRulePatternLength = 500
+)
+
+// NormalizeTagName returns the canonical form of a tag name: trimmed, lower-cased
+// and with inner whitespace collapsed to single spaces. It returns "" when the
+// name is empty or longer than MaxTagNameLength, in which case it must not be stored.
+func NormalizeTagName(name string) string {
+	n := strings.ToLower(strings.Join(strings.Fields(name), " "))
+	if utf8.RuneCountInString(n) > MaxTagNameLength {
+		return ""
+	}
+	return n
+}
+
+// NormalizeTagNames normalizes names and drops empty results and duplicates,
+// keeping the order of first occurrence.
+func NormalizeTagNames(names []string) []string {
+	var out []string
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		n := NormalizeTagName(name)
+		if n == "" || seen[n] {
+			continue
+		}
+		seen[n] = true
+		out = append(out, n)
+	}
+	return out
+}
+
+// TagRule attaches Tag to every article whose title, summary or content matches Pattern.
+type TagRule struct {
+	ID        int64
+	Tag       string
+	Pattern   string
+	MatchType string
+	CreatedAt time.Time
+}
+
+// Validate checks that the rule has a valid tag name, a supported match type and
+// a non-empty pattern that compiles when MatchType is TagMatchRegex.
+func (r *TagRule) Validate() error {
+	if strings.TrimSpace(r.Tag) == "" {
+		return &ValidationError{Field: "tag", Message: "is required"}
+	}
+	if NormalizeTagName(r.Tag) == "" {
+		return &ValidationError{Field: "tag", Message: fmt.Sprintf("is too long (max %d characters)", MaxTagNameLength)}
+	}
+	if strings.TrimSpace(r.Pattern) == "" {
+		return &ValidationError{Field: "pattern", Message: "is required"}
+	}
+	if utf8.RuneCountInString(r.Pattern) > MaxTagRulePatternLength {
+		return &ValidationError{Field: "pattern", Message: fmt.Sprintf("is too long (max %d characters)", MaxTagRulePatternLength)}
+	}
+	switch r.MatchType {
+	case TagMatchKeyword:
+	case TagMatchRegex:
+		if _, err := regexp.Compile(r.Pattern); err != nil {
+			return &ValidationError{Field: "pattern", Message: "must be a valid regular expression"}
+		}
+	default:
+		return &ValidationError{Field: "match_type", Message: fmt.Sprintf("must be %q or %q", TagMatchKeyword, TagMatchRegex)}
+	}
+	return nil
+}
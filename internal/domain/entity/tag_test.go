@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTagName(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"Go", "go"},
+		{"  Machine   Learning ", "machine learning"},
+		{"生成AI", "生成ai"},
+		{"   ", ""},
+		{strings.Repeat("a", MaxTagNameLength), strings.Repeat("a", MaxTagNameLength)},
+		{strings.Repeat("a", MaxTagNameLength+1), ""},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, NormalizeTagName(tt.input), tt.input)
+	}
+}
+
+func TestNormalizeTagNames(t *testing.T) {
+	got := NormalizeTagNames([]string{"Go", "go ", "", "Rust", "GO", "rust"})
+	assert.Equal(t, []string{"go", "rust"}, got)
+	assert.Nil(t, NormalizeTagNames(nil))
+}
+
+func TestTagRule_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		rule      TagRule
+		wantField string
+	}{
+		{name: "keyword", rule: TagRule{Tag: "go", Pattern: "golang", MatchType: TagMatchKeyword}},
+		{name: "regex", rule: TagRule{Tag: "go", Pattern: `\bgo(lang)?\b`, MatchType: TagMatchRegex}},
+		{name: "keyword with regex syntax", rule: TagRule{Tag: "c++", Pattern: "c++", MatchType: TagMatchKeyword}},
+		{name: "empty tag", rule: TagRule{Tag: " ", Pattern: "x", MatchType: TagMatchKeyword}, wantField: "tag"},
+		{name: "tag too long", rule: TagRule{Tag: strings.Repeat("a", MaxTagNameLength+1), Pattern: "x", MatchType: TagMatchKeyword}, wantField: "tag"},
+		{name: "empty pattern", rule: TagRule{Tag: "go", Pattern: "", MatchType: TagMatchKeyword}, wantField: "pattern"},
+		{name: "pattern too long", rule: TagRule{Tag: "go", Pattern: strings.Repeat("a", MaxTagRulePatternLength+1), MatchType: TagMatchKeyword}, wantField: "pattern"},
+		{name: "invalid regex", rule: TagRule{Tag: "go", Pattern: "go(", MatchType: TagMatchRegex}, wantField: "pattern"},
+		{name: "unknown match type", rule: TagRule{Tag: "go", Pattern: "go", MatchType: "glob"}, wantField: "match_type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if tt.wantField == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var vErr *ValidationError
+			if assert.True(t, errors.As(err, &vErr), "expected ValidationError, got %v", err) {
+				assert.Equal(t, tt.wantField, vErr.Field)
+			}
+		})
+	}
+}
@@ -4,11 +4,32 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"regexp"
 )
 
 // maxURLLength defines the maximum allowed length for URLs to prevent DoS attacks.
 const maxURLLength = 2048
 
+// promptTemplateNamePattern restricts template names to file-name-safe identifiers.
+var promptTemplateNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+// ValidatePromptTemplateName validates a summary prompt template name.
+// An empty name is valid and selects the default template.
+// Returns a ValidationError if the name contains characters other than
+// lowercase letters, digits, '-' and '_', or is longer than 64 characters.
+func ValidatePromptTemplateName(name string) error {
+	if name == "" {
+		return nil
+	}
+	if !promptTemplateNamePattern.MatchString(name) {
+		return &ValidationError{
+			Field:   "prompt_template",
+			Message: "must be 1-64 characters of lowercase letters, digits, '-' or '_'",
+		}
+	}
+	return nil
+}
+
 // ValidateURL validates the format and safety of a URL.
 // It checks that the URL is well-formed, uses HTTP/HTTPS scheme, and has a valid host.
 // It also blocks private IP addresses to prevent SSRF attacks.
@@ -3,6 +3,7 @@ package entity
 import (
 	"errors"
 	"net"
+	"strings"
 	"testing"
 )
 
@@ -339,3 +340,36 @@ func TestIsPrivateIP(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePromptTemplateName(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "empty selects default", input: "", wantErr: false},
+		{name: "simple name", input: "default", wantErr: false},
+		{name: "hyphen and underscore", input: "release-notes_v2", wantErr: false},
+		{name: "max length", input: strings.Repeat("a", 64), wantErr: false},
+		{name: "too long", input: strings.Repeat("a", 65), wantErr: true},
+		{name: "uppercase", input: "Release", wantErr: true},
+		{name: "path traversal", input: "../etc/passwd", wantErr: true},
+		{name: "leading hyphen", input: "-notes", wantErr: true},
+		{name: "space", input: "release notes", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePromptTemplateName(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidatePromptTemplateName(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if err != nil {
+				var vErr *ValidationError
+				if !errors.As(err, &vErr) {
+					t.Fatalf("expected *ValidationError, got %T", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package apiversion
+
+import (
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Default deprecation schedule of the unversioned paths.
+var (
+	DefaultDeprecation = time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)
+	DefaultSunset      = time.Date(2027, time.May, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// Config holds the deprecation schedule of the unversioned paths.
+type Config struct {
+	// Deprecation is when the unversioned paths were deprecated (Deprecation header, RFC 9745).
+	Deprecation time.Time
+	// Sunset is when the unversioned paths will be removed (Sunset header, RFC 8594).
+	Sunset time.Time
+}
+
+// DefaultConfig returns the default deprecation schedule.
+func DefaultConfig() Config {
+	return Config{Deprecation: DefaultDeprecation, Sunset: DefaultSunset}
+}
+
+// LoadConfigFromEnv loads the deprecation schedule from environment variables.
+//
+// Environment variables (dates in YYYY-MM-DD, UTC):
+//   - API_LEGACY_DEPRECATION_DATE: When the unversioned paths were deprecated
+//   - API_LEGACY_SUNSET_DATE: When the unversioned paths will be removed
+//
+// Falls back to DefaultConfig() if environment variables are not set or cannot be parsed.
+func LoadConfigFromEnv() Config {
+	return Config{
+		Deprecation: getEnvAsDate("API_LEGACY_DEPRECATION_DATE", DefaultDeprecation),
+		Sunset:      getEnvAsDate("API_LEGACY_SUNSET_DATE", DefaultSunset),
+	}
+}
+
+// getEnvAsDate retrieves an environment variable and parses it as a date.
+// Returns the default value if the variable is not set or cannot be parsed.
+func getEnvAsDate(key string, defaultValue time.Time) time.Time {
+	valStr := os.Getenv(key)
+	if valStr == "" {
+		return defaultValue
+	}
+	val, err := time.Parse(time.DateOnly, valStr)
+	if err != nil {
+		return defaultValue
+	}
+	return val
+}
+
+// Handler serves next under the prefix of every supported version and, as deprecated
+// aliases of the Legacy version, on the unversioned paths.
+//
+// The version prefix is removed from the request path before next is called, so the
+// routes of next are registered without it; the version is added to the request
+// context (see FromContext). Paths with an unsupported version (e.g. /v9/articles)
+// return 404. Responses on unversioned paths carry the Deprecation and Sunset headers
+// of cfg and a Link to the same path under the Legacy version prefix.
+func Handler(next http.Handler, cfg Config) http.Handler {
+	deprecation := "@" + strconv.FormatInt(cfg.Deprecation.Unix(), 10)
+	sunset := cfg.Sunset.UTC().Format(http.TimeFormat)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v, rest, ok := splitVersion(r.URL.Path)
+		if !ok {
+			// 旧パス: v1 として処理し、移行先と廃止予定日を通知する
+			h := w.Header()
+			h.Set("Deprecation", deprecation)
+			h.Set("Sunset", sunset)
+			h.Add("Link", "<"+Legacy.Prefix()+r.URL.EscapedPath()+`>; rel="successor-version"`)
+			next.ServeHTTP(w, r.WithContext(WithVersion(r.Context(), Legacy)))
+			return
+		}
+		if !v.IsSupported() {
+			http.NotFound(w, r)
+			return
+		}
+
+		// http.StripPrefix と同様に、バージョンを除いたパスでリクエストを複製する
+		r2 := r.WithContext(WithVersion(r.Context(), v))
+		r2.URL = new(url.URL)
+		*r2.URL = *r.URL
+		r2.URL.Path = rest
+		if r.URL.RawPath != "" {
+			r2.URL.RawPath = strings.TrimPrefix(r.URL.RawPath, v.Prefix())
+			if r2.URL.RawPath == "" {
+				r2.URL.RawPath = "/"
+			}
+		}
+		next.ServeHTTP(w, r2)
+	})
+}
@@ -0,0 +1,90 @@
+package apiversion_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/handler/http/apiversion"
+)
+
+// echoHandler writes the path and API version it was called with.
+var echoHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("X-Path", r.URL.Path)
+	w.Header().Set("X-Version", apiversion.FromContext(r.Context()).String())
+})
+
+func TestHandler(t *testing.T) {
+	cfg := apiversion.Config{
+		Deprecation: time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC),
+		Sunset:      time.Date(2027, time.May, 1, 0, 0, 0, 0, time.UTC),
+	}
+	h := apiversion.Handler(echoHandler, cfg)
+
+	tests := []struct {
+		name           string
+		target         string
+		wantCode       int
+		wantPath       string
+		wantDeprecated bool
+		wantLink       string
+	}{
+		{name: "versioned", target: "/v1/articles/5?page=2", wantCode: http.StatusOK, wantPath: "/articles/5"},
+		{name: "version root", target: "/v1", wantCode: http.StatusOK, wantPath: "/"},
+		{name: "legacy alias", target: "/articles/5?page=2", wantCode: http.StatusOK, wantPath: "/articles/5",
+			wantDeprecated: true, wantLink: `</v1/articles/5>; rel="successor-version"`},
+		{name: "path starting with v", target: "/videos", wantCode: http.StatusOK, wantPath: "/videos",
+			wantDeprecated: true, wantLink: `</v1/videos>; rel="successor-version"`},
+		{name: "unsupported version", target: "/v9/articles", wantCode: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d", rr.Code, tt.wantCode)
+			}
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			if got := rr.Header().Get("X-Path"); got != tt.wantPath {
+				t.Errorf("path = %q, want %q", got, tt.wantPath)
+			}
+			if got := rr.Header().Get("X-Version"); got != "v1" {
+				t.Errorf("version = %q, want v1", got)
+			}
+
+			if !tt.wantDeprecated {
+				if rr.Header().Get("Deprecation") != "" || rr.Header().Get("Sunset") != "" {
+					t.Errorf("versioned path has deprecation headers: %v", rr.Header())
+				}
+				return
+			}
+			if got := rr.Header().Get("Deprecation"); got != "@1793491200" {
+				t.Errorf("Deprecation = %q, want @1793491200", got)
+			}
+			if got := rr.Header().Get("Sunset"); got != "Sat, 01 May 2027 00:00:00 GMT" {
+				t.Errorf("Sunset = %q", got)
+			}
+			if got := rr.Header().Get("Link"); got != tt.wantLink {
+				t.Errorf("Link = %q, want %q", got, tt.wantLink)
+			}
+		})
+	}
+}
+
+func TestLoadConfigFromEnv(t *testing.T) {
+	t.Setenv("API_LEGACY_DEPRECATION_DATE", "2026-12-01")
+	t.Setenv("API_LEGACY_SUNSET_DATE", "not-a-date")
+
+	cfg := apiversion.LoadConfigFromEnv()
+	if want := time.Date(2026, time.December, 1, 0, 0, 0, 0, time.UTC); !cfg.Deprecation.Equal(want) {
+		t.Errorf("Deprecation = %v, want %v", cfg.Deprecation, want)
+	}
+	if !cfg.Sunset.Equal(apiversion.DefaultSunset) {
+		t.Errorf("Sunset = %v, want default %v", cfg.Sunset, apiversion.DefaultSunset)
+	}
+}
@@ -0,0 +1,37 @@
+package apiversion
+
+import (
+	"context"
+	"fmt"
+)
+
+// Mapper maps a use case result to the response body of each API version.
+//
+// A version without its own mapping uses the mapping of the closest older version,
+// so a breaking response change only adds a mapping for the version that introduces
+// it and the responses of the older versions stay as they are. Every Mapper must
+// have a V1 mapping.
+//
+// The DTOs returned by the V1 mappings of the handler packages are the v1
+// representation and must not change incompatibly: a breaking change, such as
+// renaming or removing a field, gets a new DTO and a mapping for the API version
+// that introduces it.
+//
+//	var articleResponse = apiversion.Mapper[repository.ArticleWithSource]{
+//		apiversion.V1: func(a repository.ArticleWithSource) any { return toDTO(a) },
+//		apiversion.V2: func(a repository.ArticleWithSource) any { return toDTOV2(a) },
+//	}
+//
+//	respond.JSON(w, http.StatusOK, articleResponse.Map(r.Context(), item))
+type Mapper[T any] map[Version]func(T) any
+
+// Map maps v to the response body of the API version of ctx (see FromContext).
+func (m Mapper[T]) Map(ctx context.Context, v T) any {
+	version := FromContext(ctx)
+	for ver := version; ver >= V1; ver-- {
+		if f, ok := m[ver]; ok {
+			return f(v)
+		}
+	}
+	panic(fmt.Sprintf("apiversion: no response mapping for %s or an older version", version))
+}
@@ -0,0 +1,65 @@
+package apiversion
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMapper_Map(t *testing.T) {
+	m := Mapper[int]{
+		V1: func(n int) any { return n },
+		3:  func(n int) any { return -n },
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want any
+	}{
+		{name: "no version uses legacy", ctx: context.Background(), want: 7},
+		{name: "own mapping", ctx: WithVersion(context.Background(), V1), want: 7},
+		{name: "falls back to older version", ctx: WithVersion(context.Background(), 2), want: 7},
+		{name: "newer mapping", ctx: WithVersion(context.Background(), 4), want: -7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := m.Map(tt.ctx, 7); got != tt.want {
+				t.Errorf("Map = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapper_MapWithoutV1Panics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Map did not panic without a v1 mapping")
+		}
+	}()
+	Mapper[int]{}.Map(context.Background(), 1)
+}
+
+func TestSplitVersion(t *testing.T) {
+	tests := []struct {
+		path     string
+		wantVer  Version
+		wantRest string
+		wantOK   bool
+	}{
+		{path: "/v1/articles", wantVer: V1, wantRest: "/articles", wantOK: true},
+		{path: "/v1", wantVer: V1, wantRest: "/", wantOK: true},
+		{path: "/v12/", wantVer: 12, wantRest: "/", wantOK: true},
+		{path: "/articles"},
+		{path: "/v1x/articles"},
+		{path: "/v01/articles"},
+		{path: "/v"},
+		{path: "/"},
+	}
+	for _, tt := range tests {
+		v, rest, ok := splitVersion(tt.path)
+		if v != tt.wantVer || rest != tt.wantRest || ok != tt.wantOK {
+			t.Errorf("splitVersion(%q) = (%v, %q, %v), want (%v, %q, %v)",
+				tt.path, v, rest, ok, tt.wantVer, tt.wantRest, tt.wantOK)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Package apiversion serves the HTTP API under versioned path prefixes (/v1, ...)
+// and maps use case results to the response DTOs of each API version.
+//
+// The unversioned paths (/articles, /sources, ...) are kept as deprecated aliases
+// of the Legacy version; responses on them carry Deprecation and Sunset headers.
+package apiversion
+
+import (
+	"context"
+	"strconv"
+)
+
+// Version is a major version of the HTTP API.
+type Version int
+
+// API versions.
+const (
+	V1 Version = 1
+
+	// Latest is the newest API version.
+	Latest = V1
+
+	// Legacy is the version served on the deprecated unversioned paths.
+	// It must stay V1: clients of the unversioned paths expect the v1 responses.
+	Legacy = V1
+)
+
+// Supported lists the API versions served under their path prefix, oldest first.
+var Supported = []Version{V1}
+
+// String returns the name of the version as used in paths, e.g. "v1".
+func (v Version) String() string {
+	return "v" + strconv.Itoa(int(v))
+}
+
+// Prefix returns the path prefix of the version, e.g. "/v1".
+func (v Version) Prefix() string {
+	return "/" + v.String()
+}
+
+// IsSupported reports whether the version is served.
+func (v Version) IsSupported() bool {
+	for _, s := range Supported {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// contextKey is a custom type for context keys to avoid collisions.
+type contextKey string
+
+const versionKey contextKey = "api_version"
+
+// WithVersion adds the API version of the request to the context.
+func WithVersion(ctx context.Context, v Version) context.Context {
+	return context.WithValue(ctx, versionKey, v)
+}
+
+// FromContext returns the API version of the request.
+// Returns Legacy if the context has no version (e.g. in handler unit tests).
+func FromContext(ctx context.Context) Version {
+	if v, ok := ctx.Value(versionKey).(Version); ok {
+		return v
+	}
+	return Legacy
+}
+
+// splitVersion splits a path into its version prefix and the rest of the path.
+// "/v1/articles" returns (V1, "/articles", true) and "/v1" returns (V1, "/", true).
+// Paths without a version segment ("/articles", "/v1x", "/v01") return false.
+func splitVersion(path string) (Version, string, bool) {
+	if len(path) < 3 || path[0] != '/' || path[1] != 'v' || path[2] < '1' || path[2] > '9' {
+		return 0, "", false
+	}
+	end := 3
+	for end < len(path) && path[end] >= '0' && path[end] <= '9' {
+		end++
+	}
+	if end < len(path) && path[end] != '/' {
+		return 0, "", false
+	}
+	n, err := strconv.Atoi(path[2:end])
+	if err != nil {
+		return 0, "", false
+	}
+	rest := path[end:]
+	if rest == "" {
+		rest = "/"
+	}
+	return Version(n), rest, true
+}
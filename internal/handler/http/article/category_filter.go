@@ -0,0 +1,23 @@
+package article
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+// parseCategoryID returns the category_id query parameter, or nil when it is absent.
+func parseCategoryID(r *http.Request) (*int64, error) {
+	v := r.URL.Query().Get("category_id")
+	if v == "" {
+		return nil, nil
+	}
+	id, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid category_id: must be a valid integer")
+	}
+	if id <= 0 {
+		return nil, errors.New("invalid category_id: must be positive")
+	}
+	return &id, nil
+}
@@ -0,0 +1,61 @@
+package article_test
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/handler/http/article"
+	artUC "catchup-feed/internal/usecase/article"
+)
+
+func TestCategoryFilter(t *testing.T) {
+	t.Parallel()
+
+	for _, target := range []string{"/articles?category_id=4", "/articles/search?keyword=Go&category_id=4"} {
+		stub := &stubSearchPaginatedRepo{articlesWithSrc: taggedArticles(), totalCount: 1}
+		var h http.Handler = article.ListHandler{
+			Svc: artUC.Service{Repo: stub}, PaginationCfg: pagination.DefaultConfig(), Logger: slog.Default(),
+		}
+		if strings.HasPrefix(target, "/articles/search") {
+			h = article.SearchPaginatedHandler{Svc: artUC.Service{Repo: stub}, PaginationCfg: pagination.DefaultConfig()}
+		}
+
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, target, nil))
+
+		if rr.Code != http.StatusOK {
+			t.Fatalf("%s: status code = %d, want %d", target, rr.Code, http.StatusOK)
+		}
+		if stub.lastFilters.CategoryID == nil || *stub.lastFilters.CategoryID != 4 {
+			t.Errorf("%s: filters.CategoryID = %v, want 4", target, stub.lastFilters.CategoryID)
+		}
+	}
+}
+
+func TestCategoryFilter_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, target := range []string{
+		"/articles?category_id=abc",
+		"/articles/search?category_id=0",
+		"/articles/search?mode=semantic&keyword=go&category_id=4",
+	} {
+		stub := &stubSearchPaginatedRepo{}
+		var h http.Handler = article.ListHandler{
+			Svc: artUC.Service{Repo: stub}, PaginationCfg: pagination.DefaultConfig(), Logger: slog.Default(),
+		}
+		if strings.HasPrefix(target, "/articles/search") {
+			h = article.SearchPaginatedHandler{Svc: artUC.Service{Repo: stub}, PaginationCfg: pagination.DefaultConfig()}
+		}
+
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, target, nil))
+		if rr.Code != http.StatusBadRequest || !strings.Contains(rr.Body.String(), "category_id") {
+			t.Errorf("%s: got %d %s, want 400 category_id error", target, rr.Code, rr.Body.String())
+		}
+	}
+}
@@ -12,7 +12,7 @@ type DeleteHandler struct{ Svc artUC.Service }
 
 // ServeHTTP 記事削除
 // @Summary      記事削除
-// @Description  記事を削除します
+// @Description  記事をゴミ箱へ移します。保持期間内は POST /articles/{id}/restore で復元できます
 // @Tags         articles
 // @Security     BearerAuth
 // @Param        id path int true "記事ID"
@@ -2,7 +2,12 @@
 // It includes handlers for creating, listing, searching, updating, and deleting articles.
 package article
 
-import "time"
+import (
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
 
 // DTO represents the JSON structure for article data transfer.
 type DTO struct {
@@ -14,5 +19,78 @@ type DTO struct {
 	Summary     string    `json:"summary" example:"Go 1.23 がリリースされました。新機能には..."`
 	PublishedAt time.Time `json:"published_at" example:"2025-10-26T10:00:00Z"`
 	CreatedAt   time.Time `json:"created_at" example:"2025-10-26T12:00:00Z"`
-	UpdatedAt   time.Time `json:"updated_at" example:"2025-10-26T12:00:00Z"`
+
+	// UpdatedAt always equals CreatedAt: articles have no update time.
+	// It is kept so that the v1 response does not change.
+	UpdatedAt time.Time `json:"updated_at" example:"2025-10-26T12:00:00Z"`
+
+	// Structured is present only when the article was summarized in structured mode.
+	Structured *StructuredSummaryDTO `json:"structured_summary,omitempty"`
+
+	// PromptVersion identifies the prompt template that produced the summary.
+	PromptVersion string `json:"prompt_version,omitempty" example:"default@3f2a9c1b"`
+
+	// SummaryModel identifies the model that generated the summary.
+	SummaryModel string `json:"summary_model,omitempty" example:"claude-sonnet-4-5-20250929"`
+
+	// SummaryStatus is "pending" while the summary is awaited from a batch request.
+	SummaryStatus string `json:"summary_status,omitempty" example:"pending"`
+
+	// InjectionFlags lists the prompt-injection patterns detected when the summary was generated.
+	InjectionFlags []string `json:"injection_flags,omitempty" example:"instruction_override"`
+}
+
+// StructuredSummaryDTO represents the structured form of an article summary.
+type StructuredSummaryDTO struct {
+	TLDR               string   `json:"tldr" example:"Go 1.23 でイテレータが正式導入"`
+	KeyPoints          []string `json:"key_points" example:"range over func が正式機能に,新しい iter パッケージ,テレメトリのオプトイン"`
+	Tags               []string `json:"tags" example:"go,release"`
+	ReadingTimeMinutes int      `json:"reading_time_minutes" example:"4"`
+}
+
+// toStructuredDTO converts a structured summary entity to its DTO.
+// Returns nil when the article has no structured summary.
+func toStructuredDTO(s *entity.StructuredSummary) *StructuredSummaryDTO {
+	if s == nil {
+		return nil
+	}
+	tags := s.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return &StructuredSummaryDTO{
+		TLDR:               s.TLDR,
+		KeyPoints:          s.KeyPoints,
+		Tags:               tags,
+		ReadingTimeMinutes: s.ReadingTimeMinutes,
+	}
+}
+
+// toDTO converts an article with its source name to its DTO.
+func toDTO(item repository.ArticleWithSource) DTO {
+	return DTO{
+		ID:             item.Article.ID,
+		SourceID:       item.Article.SourceID,
+		SourceName:     item.SourceName,
+		Title:          item.Article.Title,
+		URL:            item.Article.URL,
+		Summary:        item.Article.Summary,
+		PublishedAt:    item.Article.PublishedAt,
+		CreatedAt:      item.Article.CreatedAt,
+		UpdatedAt:      item.Article.CreatedAt, // Database schema doesn't have updated_at column
+		Structured:     toStructuredDTO(item.Article.Structured),
+		PromptVersion:  item.Article.PromptVersion,
+		SummaryModel:   item.Article.SummaryModel,
+		SummaryStatus:  item.Article.SummaryStatus,
+		InjectionFlags: item.Article.InjectionFlags,
+	}
+}
+
+// toDTOs converts articles with their source names to DTOs.
+func toDTOs(items []repository.ArticleWithSource) []DTO {
+	out := make([]DTO, 0, len(items))
+	for _, item := range items {
+		out = append(out, toDTO(item))
+	}
+	return out
 }
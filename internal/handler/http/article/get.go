@@ -6,6 +6,7 @@ import (
 
 	"catchup-feed/internal/handler/http/pathutil"
 	"catchup-feed/internal/handler/http/respond"
+	"catchup-feed/internal/repository"
 	artUC "catchup-feed/internal/usecase/article"
 )
 
@@ -45,17 +46,6 @@ func (h GetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	out := DTO{
-		ID:          article.ID,
-		SourceID:    article.SourceID,
-		SourceName:  sourceName,
-		Title:       article.Title,
-		URL:         article.URL,
-		Summary:     article.Summary,
-		PublishedAt: article.PublishedAt,
-		CreatedAt:   article.CreatedAt,
-		UpdatedAt:   article.CreatedAt, // Database schema doesn't have updated_at column
-	}
-
-	respond.JSON(w, http.StatusOK, out)
+	item := repository.ArticleWithSource{Article: article, SourceName: sourceName}
+	respond.JSON(w, http.StatusOK, articleResponse.Map(r.Context(), item))
 }
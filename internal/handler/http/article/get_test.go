@@ -133,6 +133,87 @@ func TestGetHandler_Success(t *testing.T) {
 	}
 }
 
+func TestGetHandler_StructuredSummary(t *testing.T) {
+	now := time.Now()
+	stub := &stubGetRepo{
+		article: &entity.Article{
+			ID: 1, SourceID: 10, Title: "t", URL: "https://example.com/a",
+			Summary: "plain", PublishedAt: now, CreatedAt: now,
+			Structured: &entity.StructuredSummary{
+				TLDR:               "tldr",
+				KeyPoints:          []string{"a", "b", "c"},
+				ReadingTimeMinutes: 2,
+			},
+		},
+	}
+	handler := article.GetHandler{Svc: artUC.Service{Repo: stub}}
+
+	req := httptest.NewRequest(http.MethodGet, "/articles/1", nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", rr.Code, http.StatusOK)
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(rr.Body.Bytes(), &raw); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	var structured article.StructuredSummaryDTO
+	if err := json.Unmarshal(raw["structured_summary"], &structured); err != nil {
+		t.Fatalf("structured_summary missing or invalid: %v", err)
+	}
+	if structured.TLDR != "tldr" || len(structured.KeyPoints) != 3 || structured.ReadingTimeMinutes != 2 {
+		t.Errorf("unexpected structured_summary: %+v", structured)
+	}
+	if structured.Tags == nil {
+		t.Errorf("tags should be an empty array, not null")
+	}
+}
+
+func TestGetHandler_NoStructuredSummary(t *testing.T) {
+	stub := &stubGetRepo{
+		article: &entity.Article{ID: 1, Title: "t", URL: "https://example.com/a", Summary: "plain"},
+	}
+	handler := article.GetHandler{Svc: artUC.Service{Repo: stub}}
+
+	req := httptest.NewRequest(http.MethodGet, "/articles/1", nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(rr.Body.Bytes(), &raw); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if _, ok := raw["structured_summary"]; ok {
+		t.Errorf("structured_summary should be omitted for plain summaries")
+	}
+}
+
+func TestGetHandler_InjectionFlags(t *testing.T) {
+	stub := &stubGetRepo{
+		article: &entity.Article{
+			ID: 1, Title: "t", URL: "https://example.com/a", Summary: "plain",
+			InjectionFlags: []string{entity.InjectionFlagInstructionOverride, entity.InjectionFlagUnknownURL},
+		},
+	}
+	handler := article.GetHandler{Svc: artUC.Service{Repo: stub}}
+
+	req := httptest.NewRequest(http.MethodGet, "/articles/1", nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	var result article.DTO
+	if err := json.Unmarshal(rr.Body.Bytes(), &result); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	want := []string{"instruction_override", "unknown_url"}
+	if len(result.InjectionFlags) != 2 || result.InjectionFlags[0] != want[0] || result.InjectionFlags[1] != want[1] {
+		t.Errorf("InjectionFlags = %v, want %v", result.InjectionFlags, want)
+	}
+}
+
 func TestGetHandler_InvalidID(t *testing.T) {
 	tests := []struct {
 		name string
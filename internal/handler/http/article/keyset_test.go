@@ -0,0 +1,191 @@
+package article_test
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/article"
+	"catchup-feed/internal/repository"
+	artUC "catchup-feed/internal/usecase/article"
+)
+
+// stubKeysetRepo adds keyset pagination to stubArticleRepo.
+// articlesWithSrc must be in (published_at DESC, id DESC) order.
+type stubKeysetRepo struct {
+	stubArticleRepo
+	lastAfter   *repository.ArticleKeyset
+	lastFilters repository.ArticleSearchFilters
+	searched    bool
+}
+
+func (s *stubKeysetRepo) ListWithSourceAfter(_ context.Context, after *repository.ArticleKeyset, limit int) ([]repository.ArticleWithSource, error) {
+	s.lastAfter = after
+	if s.listErr != nil {
+		return nil, s.listErr
+	}
+	var out []repository.ArticleWithSource
+	for _, a := range s.articlesWithSrc {
+		if after != nil && !a.Article.PublishedAt.Before(after.PublishedAt) &&
+			!(a.Article.PublishedAt.Equal(after.PublishedAt) && a.Article.ID < after.ID) {
+			continue
+		}
+		if len(out) == limit {
+			break
+		}
+		out = append(out, a)
+	}
+	return out, nil
+}
+
+func (s *stubKeysetRepo) SearchWithFiltersAfter(ctx context.Context, _ []string, filters repository.ArticleSearchFilters, after *repository.ArticleKeyset, limit int) ([]repository.ArticleWithSource, error) {
+	s.searched, s.lastFilters = true, filters
+	return s.ListWithSourceAfter(ctx, after, limit)
+}
+
+func keysetStub() *stubKeysetRepo {
+	base := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	var items []repository.ArticleWithSource
+	for id := int64(3); id >= 1; id-- {
+		items = append(items, repository.ArticleWithSource{
+			Article:    &entity.Article{ID: id, Title: "t", PublishedAt: base.Add(time.Duration(id) * time.Hour)},
+			SourceName: "Test Source",
+		})
+	}
+	return &stubKeysetRepo{stubArticleRepo: stubArticleRepo{articlesWithSrc: items, totalCount: 3}}
+}
+
+func getPage(t *testing.T, h http.Handler, target string) (int, pagination.Response[article.DTO]) {
+	t.Helper()
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, target, nil))
+	var resp pagination.Response[article.DTO]
+	if rr.Code == http.StatusOK {
+		if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+	}
+	return rr.Code, resp
+}
+
+func TestListHandler_Keyset_FollowsNextCursor(t *testing.T) {
+	stub := keysetStub()
+	handler := article.ListHandler{
+		Svc:           artUC.Service{Repo: stub},
+		PaginationCfg: pagination.DefaultConfig(),
+		Logger:        slog.Default(),
+	}
+
+	code, first := getPage(t, handler, "/articles?pagination=cursor&limit=2")
+	if code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", code, http.StatusOK)
+	}
+	if len(first.Data) != 2 || first.Data[0].ID != 3 || first.Data[1].ID != 2 {
+		t.Fatalf("first page = %+v, want ids 3, 2", first.Data)
+	}
+	if first.Pagination.Total != -1 || first.Pagination.NextCursor == "" {
+		t.Fatalf("first page pagination = %+v, want total -1 and next_cursor", first.Pagination)
+	}
+
+	code, second := getPage(t, handler, "/articles?limit=2&cursor="+url.QueryEscape(first.Pagination.NextCursor))
+	if code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", code, http.StatusOK)
+	}
+	if len(second.Data) != 1 || second.Data[0].ID != 1 {
+		t.Fatalf("second page = %+v, want id 1", second.Data)
+	}
+	if second.Pagination.NextCursor != "" {
+		t.Errorf("last page next_cursor = %q, want empty", second.Pagination.NextCursor)
+	}
+}
+
+func TestListHandler_Keyset_WithTags(t *testing.T) {
+	stub := keysetStub()
+	handler := article.ListHandler{
+		Svc:           artUC.Service{Repo: stub},
+		PaginationCfg: pagination.DefaultConfig(),
+		Logger:        slog.Default(),
+	}
+
+	code, _ := getPage(t, handler, "/articles?pagination=cursor&tag=go")
+	if code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", code, http.StatusOK)
+	}
+	if !stub.searched || len(stub.lastFilters.Tags) != 1 || stub.lastFilters.Tags[0] != "go" {
+		t.Errorf("expected keyset search with tag filter, got searched=%v filters=%+v", stub.searched, stub.lastFilters)
+	}
+}
+
+func TestListHandler_Keyset_Errors(t *testing.T) {
+	cfg := pagination.DefaultConfig()
+	cfg.CursorSecret = []byte("secret")
+	foreign := pagination.Config{CursorSecret: []byte("other")}.EncodeCursor(pagination.Cursor{PublishedAt: time.Now(), ID: 1})
+
+	tests := []struct {
+		name   string
+		repo   repository.ArticleRepository
+		target string
+	}{
+		{name: "tampered cursor", repo: keysetStub(), target: "/articles?cursor=" + foreign},
+		{name: "page with cursor mode", repo: keysetStub(), target: "/articles?pagination=cursor&page=2"},
+		{name: "repository without keyset support", repo: &stubArticleRepo{}, target: "/articles?pagination=cursor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := article.ListHandler{
+				Svc:           artUC.Service{Repo: tt.repo},
+				PaginationCfg: cfg,
+				Logger:        slog.Default(),
+			}
+			if code, _ := getPage(t, handler, tt.target); code != http.StatusBadRequest {
+				t.Errorf("status code = %d, want %d", code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestSearchPaginated_Keyset(t *testing.T) {
+	stub := keysetStub()
+	handler := article.SearchPaginatedHandler{
+		Svc:           artUC.Service{Repo: stub},
+		PaginationCfg: pagination.DefaultConfig(),
+	}
+
+	code, first := getPage(t, handler, "/articles/search?keyword=go&pagination=cursor&limit=1")
+	if code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", code, http.StatusOK)
+	}
+	if !stub.searched || len(first.Data) != 1 || first.Data[0].ID != 3 || first.Pagination.NextCursor == "" {
+		t.Fatalf("first page = %+v, pagination = %+v", first.Data, first.Pagination)
+	}
+
+	code, second := getPage(t, handler, "/articles/search?keyword=go&limit=1&cursor="+url.QueryEscape(first.Pagination.NextCursor))
+	if code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", code, http.StatusOK)
+	}
+	if len(second.Data) != 1 || second.Data[0].ID != 2 {
+		t.Fatalf("second page = %+v, want id 2", second.Data)
+	}
+	if stub.lastAfter == nil || stub.lastAfter.ID != 3 {
+		t.Errorf("repository after = %+v, want id 3", stub.lastAfter)
+	}
+}
+
+func TestSearchPaginated_Keyset_SemanticModeRejected(t *testing.T) {
+	handler := article.SearchPaginatedHandler{
+		Svc:           artUC.Service{Repo: keysetStub()},
+		PaginationCfg: pagination.DefaultConfig(),
+	}
+
+	if code, _ := getPage(t, handler, "/articles/search?keyword=go&mode=semantic&pagination=cursor"); code != http.StatusBadRequest {
+		t.Errorf("status code = %d, want %d", code, http.StatusBadRequest)
+	}
+}
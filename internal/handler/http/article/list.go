@@ -1,14 +1,18 @@
 package article
 
 import (
+	"errors"
 	"log/slog"
 	"net/http"
+	"strconv"
 	"time"
 
 	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/handler/http/auth"
 	"catchup-feed/internal/handler/http/requestid"
 	"catchup-feed/internal/handler/http/respond"
 	"catchup-feed/internal/observability/logging"
+	"catchup-feed/internal/repository"
 	artUC "catchup-feed/internal/usecase/article"
 )
 
@@ -20,13 +24,18 @@ type ListHandler struct {
 
 // ServeHTTP 記事一覧取得
 // @Summary      記事一覧取得（ページネーション対応）
-// @Description  登録されている記事を取得します。ページネーションパラメータを指定して、ページ単位で記事を取得できます。
+// @Description  登録されている記事を取得します。ページネーションパラメータを指定して、ページ単位で記事を取得できます。tag を指定するとタグで、category_id を指定するとソースカテゴリで絞り込みます。unread=true の場合は認証ユーザーが未読の記事だけを返します。pagination=cursor の場合は総件数を数えず、レスポンスの next_cursor で次ページを取得します。
 // @Tags         articles
 // @Security     BearerAuth
 // @Produce      json
 // @Param        page   query    int  false  "ページ番号 (1-based)" default(1) minimum(1)
 // @Param        limit  query    int  false  "1ページあたりの件数" default(20) minimum(1) maximum(100)
-// @Success      200 {object} pagination.Response[DTO] "ページネーション付き記事一覧"
+// @Param        tag    query    []string  false  "タグでフィルタ（複数指定時はすべてのタグを持つ記事）" collectionFormat(multi)
+// @Param        category_id query int  false  "ソースカテゴリIDでフィルタ（カテゴリに属するソースの記事）"
+// @Param        unread query    bool  false  "true の場合は未読の記事のみ（pagination=cursor とは併用不可）"
+// @Param        pagination query string false "ページネーション方式（offset: ページ番号、cursor: カーソル）" Enums(offset, cursor)
+// @Param        cursor query    string  false  "前ページの next_cursor（指定時は pagination=cursor 扱い。page とは併用不可）"
+// @Success      200 {object} PaginatedResponse "ページネーション付き記事一覧"
 // @Header       200 {integer} X-RateLimit-Limit "Maximum number of requests allowed in the current window"
 // @Header       200 {integer} X-RateLimit-Remaining "Number of requests remaining in the current window"
 // @Header       200 {integer} X-RateLimit-Reset "Unix timestamp when the rate limit window resets"
@@ -59,14 +68,75 @@ func (h ListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	tags, err := parseTagFilters(r)
+	if err != nil {
+		logger.Warn("Invalid tag filter",
+			"error", err.Error(),
+			"request_id", reqID)
+		pagination.RecordError("validation")
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	categoryID, err := parseCategoryID(r)
+	if err != nil {
+		pagination.RecordError("validation")
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+	filters := repository.ArticleSearchFilters{Tags: tags, CategoryID: categoryID}
+
+	unread := false
+	if v := r.URL.Query().Get("unread"); v != "" {
+		unread, err = strconv.ParseBool(v)
+		if err != nil {
+			pagination.RecordError("validation")
+			respond.SafeError(w, http.StatusBadRequest, errors.New("invalid unread: must be true or false"))
+			return
+		}
+	}
+	if unread && params.Keyset {
+		pagination.RecordError("validation")
+		respond.SafeError(w, http.StatusBadRequest,
+			errors.New("invalid pagination: cursor pagination cannot be combined with unread=true"))
+		return
+	}
+
 	// Log request
 	logger.Info("Paginated article list request",
 		"page", params.Page,
 		"limit", params.Limit,
+		"keyset", params.Keyset,
+		"tags", tags,
+		"category_id", categoryID,
+		"unread", unread,
 		"request_id", reqID)
 
 	// Get paginated data from service
-	result, err := h.Svc.ListWithSourcePaginated(ctx, params)
+	// タグ・カテゴリ指定時はキーワードなしの絞り込み検索として取得する
+	var result *artUC.PaginatedResult
+	switch {
+	case unread:
+		result, err = h.Svc.ListUnreadPaginated(ctx, auth.UserFromContext(ctx), filters, params)
+	case params.Keyset && !filters.Empty():
+		result, err = h.Svc.SearchWithFiltersKeyset(ctx, nil, filters, params)
+	case params.Keyset:
+		result, err = h.Svc.ListWithSourceKeyset(ctx, params)
+	case !filters.Empty():
+		result, err = h.Svc.SearchWithFiltersPaginated(ctx, nil, filters, params.Page, params.Limit)
+	default:
+		result, err = h.Svc.ListWithSourcePaginated(ctx, params)
+	}
+	if errors.Is(err, artUC.ErrKeysetPaginationUnsupported) || errors.Is(err, artUC.ErrReadStateUnsupported) {
+		pagination.RecordError("validation")
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if errors.Is(err, artUC.ErrUserRequired) {
+		pagination.RecordError("validation")
+		respond.SafeError(w, http.StatusUnauthorized, err)
+		return
+	}
 	if err != nil {
 		logger.Error("Failed to list articles",
 			"error", err.Error(),
@@ -78,39 +148,38 @@ func (h ListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Convert to DTOs
-	dtos := make([]DTO, 0, len(result.Data))
-	for _, item := range result.Data {
-		dtos = append(dtos, DTO{
-			ID:          item.Article.ID,
-			SourceID:    item.Article.SourceID,
-			SourceName:  item.SourceName,
-			Title:       item.Article.Title,
-			URL:         item.Article.URL,
-			Summary:     item.Article.Summary,
-			PublishedAt: item.Article.PublishedAt,
-			CreatedAt:   item.Article.CreatedAt,
-			UpdatedAt:   item.Article.CreatedAt, // Database schema doesn't have updated_at column
-		})
-	}
-
 	// Build paginated response
-	response := pagination.NewResponse(dtos, result.Pagination)
+	response := pageResponse.Map(ctx, articlePage{
+		Data:       result.Data,
+		Pagination: withNextCursor(h.PaginationCfg, result),
+	})
 
 	// Record metrics
 	duration := time.Since(startTime)
 	pagination.RecordRequest(http.StatusOK, params.Page)
 	pagination.RecordDuration("handler", duration.Seconds())
-	pagination.UpdateTotalCount(result.Pagination.Total)
+	if !params.Keyset {
+		pagination.UpdateTotalCount(result.Pagination.Total)
+	}
 
 	// Log response
 	logger.Info("Paginated response",
 		"page", params.Page,
 		"limit", params.Limit,
-		"returned_count", len(dtos),
+		"returned_count", len(result.Data),
 		"duration_ms", duration.Milliseconds(),
 		"status", http.StatusOK,
 		"request_id", reqID)
 
 	respond.JSON(w, http.StatusOK, response)
 }
+
+// withNextCursor returns the pagination metadata of result with NextCursor set to
+// the signed token of result.Next (keyset pagination only).
+func withNextCursor(cfg pagination.Config, result *artUC.PaginatedResult) pagination.Metadata {
+	meta := result.Pagination
+	if result.Next != nil {
+		meta.NextCursor = cfg.EncodeCursor(*result.Next)
+	}
+	return meta
+}
@@ -0,0 +1,109 @@
+package article_test
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/article"
+	"catchup-feed/internal/handler/http/auth"
+	"catchup-feed/internal/repository"
+	artUC "catchup-feed/internal/usecase/article"
+)
+
+// stubUnreadRepo implements the unread queries of repository.ReadStateRepository.
+type stubUnreadRepo struct {
+	repository.ReadStateRepository
+	articles   []repository.ArticleWithSource
+	gotUser    string
+	gotFilters repository.ArticleSearchFilters
+}
+
+func (s *stubUnreadRepo) CountUnread(_ context.Context, userID string, filters repository.ArticleSearchFilters) (int64, error) {
+	s.gotUser, s.gotFilters = userID, filters
+	return int64(len(s.articles)), nil
+}
+
+func (s *stubUnreadRepo) ListUnread(_ context.Context, _ string, _ repository.ArticleSearchFilters, _, _ int) ([]repository.ArticleWithSource, error) {
+	return s.articles, nil
+}
+
+func newUnreadListHandler(readRepo repository.ReadStateRepository) article.ListHandler {
+	return article.ListHandler{
+		Svc: artUC.Service{Repo: &stubArticleRepo{}, ReadStateRepo: readRepo},
+		PaginationCfg: pagination.Config{
+			DefaultPage:  1,
+			DefaultLimit: 20,
+			MaxLimit:     100,
+		},
+		Logger: slog.Default(),
+	}
+}
+
+func TestListHandler_Unread(t *testing.T) {
+	now := time.Now()
+	readRepo := &stubUnreadRepo{articles: []repository.ArticleWithSource{{
+		Article:    &entity.Article{ID: 5, SourceID: 1, Title: "unread", URL: "https://example.com/5", PublishedAt: now, CreatedAt: now},
+		SourceName: "Test Source",
+	}}}
+	handler := newUnreadListHandler(readRepo)
+
+	req := httptest.NewRequest(http.MethodGet, "/articles?unread=true&tag=go", nil)
+	req = req.WithContext(auth.WithUser(req.Context(), "alice@example.com"))
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d: %s", rr.Code, http.StatusOK, rr.Body.String())
+	}
+	var result pagination.Response[article.DTO]
+	if err := json.NewDecoder(rr.Body).Decode(&result); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(result.Data) != 1 || result.Data[0].ID != 5 || result.Pagination.Total != 1 {
+		t.Errorf("result = %+v", result)
+	}
+	if readRepo.gotUser != "alice@example.com" {
+		t.Errorf("user = %q, want alice@example.com", readRepo.gotUser)
+	}
+	if len(readRepo.gotFilters.Tags) != 1 || readRepo.gotFilters.Tags[0] != "go" {
+		t.Errorf("filters = %+v, want tag go", readRepo.gotFilters)
+	}
+}
+
+func TestListHandler_Unread_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		user     string
+		readRepo repository.ReadStateRepository
+		wantCode int
+	}{
+		{name: "invalid value", target: "/articles?unread=maybe", user: "alice", readRepo: &stubUnreadRepo{}, wantCode: http.StatusBadRequest},
+		{name: "with cursor pagination", target: "/articles?unread=true&pagination=cursor", user: "alice", readRepo: &stubUnreadRepo{}, wantCode: http.StatusBadRequest},
+		{name: "read state unsupported", target: "/articles?unread=true", user: "alice", wantCode: http.StatusBadRequest},
+		{name: "no user", target: "/articles?unread=true", readRepo: &stubUnreadRepo{}, wantCode: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := newUnreadListHandler(tt.readRepo)
+
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.user != "" {
+				req = req.WithContext(auth.WithUser(req.Context(), tt.user))
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantCode {
+				t.Errorf("status code = %d, want %d: %s", rr.Code, tt.wantCode, rr.Body.String())
+			}
+		})
+	}
+}
@@ -12,7 +12,8 @@ import (
 
 // Register registers all article-related HTTP handlers with the given mux.
 // It sets up routes for listing, searching, creating, updating, and deleting articles.
-// Protected routes (create, update, delete) require authentication via the auth middleware.
+// Protected routes (create, update, delete, resummarize) require authentication via the auth middleware;
+// resummarize routes are admin-only because they are not viewer-readable GET endpoints.
 // Search endpoints are protected by rate limiting to prevent DoS attacks.
 func Register(mux *http.ServeMux, svc artUC.Service, paginationCfg pagination.Config, logger *slog.Logger, searchRateLimiter *middleware.RateLimiter) {
 	mux.Handle("GET    /articles", ListHandler{
@@ -26,8 +27,13 @@ func Register(mux *http.ServeMux, svc artUC.Service, paginationCfg pagination.Co
 		PaginationCfg: paginationCfg,
 	}))
 	mux.Handle("GET    /articles/", auth.Authz(GetHandler{svc}))
+	mux.Handle("GET    /articles/{id}/related", auth.Authz(RelatedHandler{svc}))
 
 	mux.Handle("POST   /articles", auth.Authz(CreateHandler{svc}))
 	mux.Handle("PUT    /articles/", auth.Authz(UpdateHandler{svc}))
 	mux.Handle("DELETE /articles/", auth.Authz(DeleteHandler{svc}))
+
+	mux.Handle("POST   /articles/resummarize", auth.Authz(BulkResummarizeHandler{svc}))
+	mux.Handle("POST   /articles/{id}/resummarize", auth.Authz(ResummarizeHandler{svc}))
+	mux.Handle("GET    /resummarize-jobs/", auth.Authz(ResummarizeJobHandler{svc}))
 }
@@ -0,0 +1,76 @@
+package article
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"catchup-feed/internal/handler/http/pathutil"
+	"catchup-feed/internal/handler/http/respond"
+	artUC "catchup-feed/internal/usecase/article"
+)
+
+const (
+	// defaultRelatedLimit is the number of related articles returned by default.
+	defaultRelatedLimit = 5
+	// maxRelatedLimit is the maximum value of the limit parameter of related articles.
+	maxRelatedLimit = 20
+)
+
+// RelatedDTO is an article similar to the requested article.
+type RelatedDTO struct {
+	DTO
+	// Similarity is the cosine similarity of the two articles' embeddings (higher is more similar).
+	Similarity float64 `json:"similarity" example:"0.82"`
+}
+
+type RelatedHandler struct{ Svc artUC.Service }
+
+// ServeHTTP 関連記事の取得
+// @Summary      関連記事の取得
+// @Description  タイトルと要約の埋め込みベクトルのコサイン類似度で、指定された記事に意味の近い記事を類似度順に返します。埋め込みが未計算の記事では空配列を返します
+// @Tags         articles
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path int true "記事ID"
+// @Param        limit query int false "件数（デフォルト: 5、最大: 20）"
+// @Success      200 {array} RelatedDTO "関連記事"
+// @Failure      400 {string} string "Bad request - invalid article ID or limit, or semantic search not enabled"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      404 {string} string "Not found - article not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /articles/{id}/related [get]
+func (h RelatedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(strings.TrimSuffix(r.URL.Path, "/related"), "/articles/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	limit := defaultRelatedLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		limit, err = strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > maxRelatedLimit {
+			respond.SafeError(w, http.StatusBadRequest,
+				fmt.Errorf("invalid limit: must be between 1 and %d", maxRelatedLimit))
+			return
+		}
+	}
+
+	related, err := h.Svc.Related(r.Context(), id, limit)
+	if err != nil {
+		code := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, artUC.ErrInvalidArticleID), errors.Is(err, artUC.ErrSemanticSearchDisabled):
+			code = http.StatusBadRequest
+		case errors.Is(err, artUC.ErrArticleNotFound):
+			code = http.StatusNotFound
+		}
+		respond.SafeError(w, code, err)
+		return
+	}
+
+	respond.JSON(w, http.StatusOK, relatedResponse.Map(r.Context(), related))
+}
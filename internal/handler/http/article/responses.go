@@ -0,0 +1,76 @@
+package article
+
+import (
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/handler/http/apiversion"
+	"catchup-feed/internal/pkg/search"
+	"catchup-feed/internal/repository"
+	"catchup-feed/internal/usecase/embedding"
+)
+
+// Response mappers of the article endpoints (see apiversion.Mapper).
+var (
+	// articleResponse maps a single article.
+	articleResponse = apiversion.Mapper[repository.ArticleWithSource]{
+		apiversion.V1: func(a repository.ArticleWithSource) any { return toDTO(a) },
+	}
+
+	// listResponse maps an unpaginated list of articles.
+	listResponse = apiversion.Mapper[[]repository.ArticleWithSource]{
+		apiversion.V1: func(items []repository.ArticleWithSource) any { return toDTOs(items) },
+	}
+
+	// pageResponse maps a page of articles of the list and search endpoints.
+	pageResponse = apiversion.Mapper[articlePage]{
+		apiversion.V1: func(p articlePage) any {
+			resp := PaginatedResponse{Data: toDTOs(p.Data), Pagination: p.Pagination}
+			if p.Facets != nil {
+				resp.Facets = toFacetsDTO(p.Facets)
+			}
+			return resp
+		},
+	}
+
+	// rankedResponse maps a page of ranked search results.
+	rankedResponse = apiversion.Mapper[rankedPage]{
+		apiversion.V1: func(p rankedPage) any {
+			out := make([]RankedDTO, 0, len(p.Data))
+			for _, item := range p.Data {
+				out = append(out, RankedDTO{
+					DTO:   toDTO(item.ArticleWithSource),
+					Score: item.Rank,
+					Highlights: HighlightsDTO{
+						Title:   search.Highlight(item.Article.Title, p.Terms, 0),
+						Summary: search.Highlight(item.Article.Summary, p.Terms, summarySnippetLength),
+					},
+				})
+			}
+			return RankedSearchResponse{Data: out, Pagination: p.Pagination}
+		},
+	}
+
+	// relatedResponse maps the articles related to an article.
+	relatedResponse = apiversion.Mapper[[]embedding.ScoredArticle]{
+		apiversion.V1: func(related []embedding.ScoredArticle) any {
+			out := make([]RelatedDTO, 0, len(related))
+			for _, a := range related {
+				out = append(out, RelatedDTO{DTO: toDTO(a.ArticleWithSource), Similarity: a.Score})
+			}
+			return out
+		},
+	}
+)
+
+// articlePage is a page of articles, with the facet counts of a search if requested.
+type articlePage struct {
+	Data       []repository.ArticleWithSource
+	Pagination pagination.Metadata
+	Facets     map[string][]repository.FacetBucket
+}
+
+// rankedPage is a page of ranked search results with the query terms to highlight.
+type rankedPage struct {
+	Data       []repository.RankedArticle
+	Pagination pagination.Metadata
+	Terms      []string
+}
@@ -0,0 +1,191 @@
+package article
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/auth"
+	"catchup-feed/internal/handler/http/pathutil"
+	"catchup-feed/internal/handler/http/respond"
+	artUC "catchup-feed/internal/usecase/article"
+)
+
+// ResummarizeJobDTO represents the JSON structure of a resummarize job.
+type ResummarizeJobDTO struct {
+	ID           int64      `json:"id" example:"1"`
+	Status       string     `json:"status" example:"running"`
+	ArticleID    *int64     `json:"article_id,omitempty" example:"42"`
+	SourceID     *int64     `json:"source_id,omitempty" example:"3"`
+	From         *time.Time `json:"from,omitempty" example:"2025-10-01T00:00:00Z"`
+	To           *time.Time `json:"to,omitempty" example:"2025-10-31T23:59:59Z"`
+	SummaryModel string     `json:"summary_model,omitempty" example:"gpt-3.5-turbo"`
+	Total        int        `json:"total" example:"120"`
+	Processed    int        `json:"processed" example:"40"`
+	Succeeded    int        `json:"succeeded" example:"39"`
+	Failed       int        `json:"failed" example:"1"`
+	Error        string     `json:"error,omitempty"`
+	RequestedBy  string     `json:"requested_by" example:"admin@example.com"`
+	CreatedAt    time.Time  `json:"created_at" example:"2025-10-26T12:00:00Z"`
+	StartedAt    *time.Time `json:"started_at,omitempty" example:"2025-10-26T12:01:00Z"`
+	FinishedAt   *time.Time `json:"finished_at,omitempty" example:"2025-10-26T12:45:00Z"`
+}
+
+func toResummarizeJobDTO(j *entity.ResummarizeJob) ResummarizeJobDTO {
+	return ResummarizeJobDTO{
+		ID:           j.ID,
+		Status:       j.Status,
+		ArticleID:    j.Filter.ArticleID,
+		SourceID:     j.Filter.SourceID,
+		From:         j.Filter.From,
+		To:           j.Filter.To,
+		SummaryModel: j.Filter.SummaryModel,
+		Total:        j.Total,
+		Processed:    j.Processed,
+		Succeeded:    j.Succeeded,
+		Failed:       j.Failed,
+		Error:        j.Error,
+		RequestedBy:  j.RequestedBy,
+		CreatedAt:    j.CreatedAt,
+		StartedAt:    j.StartedAt,
+		FinishedAt:   j.FinishedAt,
+	}
+}
+
+// resummarizeErrorCode maps RequestResummarize errors to HTTP status codes.
+func resummarizeErrorCode(err error) int {
+	var ve *entity.ValidationError
+	switch {
+	case errors.As(err, &ve):
+		return http.StatusBadRequest
+	case errors.Is(err, artUC.ErrArticleNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+type ResummarizeHandler struct{ Svc artUC.Service }
+
+// ServeHTTP 記事の再要約
+// @Summary      記事の再要約
+// @Description  指定された記事の要約を現在の要約モデル・プロンプトで再生成するジョブを登録します（管理者のみ）。ジョブはワーカーが順次処理します
+// @Tags         articles
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path int true "記事ID"
+// @Success      202 {object} ResummarizeJobDTO "登録されたジョブ"
+// @Failure      400 {string} string "Bad request - invalid article ID"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - admin role required"
+// @Failure      404 {string} string "Not found - article not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /articles/{id}/resummarize [post]
+func (h ResummarizeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(strings.TrimSuffix(r.URL.Path, "/resummarize"), "/articles/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	job, err := h.Svc.RequestResummarize(r.Context(), entity.ResummarizeFilter{ArticleID: &id}, auth.UserFromContext(r.Context()))
+	if err != nil {
+		respond.SafeError(w, resummarizeErrorCode(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusAccepted, toResummarizeJobDTO(job))
+}
+
+type BulkResummarizeHandler struct{ Svc artUC.Service }
+
+// ServeHTTP 記事の一括再要約
+// @Summary      記事の一括再要約
+// @Description  条件に一致する記事の要約を再生成するジョブを登録します（管理者のみ）。条件を省略した項目は絞り込みに使われません
+// @Tags         articles
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        filter body object true "対象記事の条件（source_id, from, to (RFC3339), summary_model）"
+// @Success      202 {object} ResummarizeJobDTO "登録されたジョブ"
+// @Failure      400 {string} string "Bad request - invalid filter"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - admin role required"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /articles/resummarize [post]
+func (h BulkResummarizeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		SourceID     *int64  `json:"source_id"`
+		From         *string `json:"from"`
+		To           *string `json:"to"`
+		SummaryModel string  `json:"summary_model"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	filter := entity.ResummarizeFilter{SourceID: req.SourceID, SummaryModel: req.SummaryModel}
+	for _, f := range []struct {
+		name string
+		in   *string
+		out  **time.Time
+	}{{"from", req.From, &filter.From}, {"to", req.To, &filter.To}} {
+		if f.in == nil {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, *f.in)
+		if err != nil {
+			respond.SafeError(w, http.StatusBadRequest,
+				errors.New(f.name+" must be in RFC3339 format"))
+			return
+		}
+		*f.out = &t
+	}
+
+	job, err := h.Svc.RequestResummarize(r.Context(), filter, auth.UserFromContext(r.Context()))
+	if err != nil {
+		respond.SafeError(w, resummarizeErrorCode(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusAccepted, toResummarizeJobDTO(job))
+}
+
+type ResummarizeJobHandler struct{ Svc artUC.Service }
+
+// ServeHTTP 再要約ジョブの進捗取得
+// @Summary      再要約ジョブの進捗取得
+// @Description  再要約ジョブの状態と進捗を取得します（管理者のみ）
+// @Tags         articles
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path int true "ジョブID"
+// @Success      200 {object} ResummarizeJobDTO "ジョブ"
+// @Failure      400 {string} string "Bad request - invalid job ID"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - admin role required"
+// @Failure      404 {string} string "Not found - job not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /resummarize-jobs/{id} [get]
+func (h ResummarizeJobHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(r.URL.Path, "/resummarize-jobs/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	job, err := h.Svc.GetResummarizeJob(r.Context(), id)
+	if err != nil {
+		code := http.StatusInternalServerError
+		if errors.Is(err, artUC.ErrInvalidResummarizeJobID) {
+			code = http.StatusBadRequest
+		} else if errors.Is(err, artUC.ErrResummarizeJobNotFound) {
+			code = http.StatusNotFound
+		}
+		respond.SafeError(w, code, err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, toResummarizeJobDTO(job))
+}
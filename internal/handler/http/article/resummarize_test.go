@@ -0,0 +1,194 @@
+package article_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/article"
+	artUC "catchup-feed/internal/usecase/article"
+)
+
+// stubResummarizeRepo はResummarizeRepositoryのモック実装
+type stubResummarizeRepo struct {
+	created   *entity.ResummarizeJob
+	job       *entity.ResummarizeJob
+	createErr error
+}
+
+func (s *stubResummarizeRepo) CreateJob(_ context.Context, job *entity.ResummarizeJob) error {
+	if s.createErr != nil {
+		return s.createErr
+	}
+	job.ID = 7
+	job.CreatedAt = time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	s.created = job
+	return nil
+}
+func (s *stubResummarizeRepo) GetJob(_ context.Context, id int64) (*entity.ResummarizeJob, error) {
+	if s.job != nil && s.job.ID == id {
+		return s.job, nil
+	}
+	return nil, nil
+}
+
+// 以下は未使用だが、インターフェース満たすために実装
+func (s *stubResummarizeRepo) NextJob(_ context.Context) (*entity.ResummarizeJob, error) {
+	return nil, nil
+}
+func (s *stubResummarizeRepo) UpdateJob(_ context.Context, _ *entity.ResummarizeJob) error {
+	return nil
+}
+func (s *stubResummarizeRepo) CountTargets(_ context.Context, _ entity.ResummarizeFilter) (int, error) {
+	return 0, nil
+}
+func (s *stubResummarizeRepo) ListTargets(_ context.Context, _ entity.ResummarizeFilter, _ int64, _ int) ([]*entity.Article, error) {
+	return nil, nil
+}
+
+func TestResummarizeHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		createErr  error
+		wantStatus int
+	}{
+		{"queued", "/articles/1/resummarize", nil, http.StatusAccepted},
+		{"invalid id", "/articles/abc/resummarize", nil, http.StatusBadRequest},
+		{"article not found", "/articles/2/resummarize", nil, http.StatusNotFound},
+		{"repository error", "/articles/1/resummarize", errors.New("db down"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobRepo := &stubResummarizeRepo{createErr: tt.createErr}
+			svc := artUC.Service{
+				Repo:            &stubUpdateRepo{article: &entity.Article{ID: 1}},
+				ResummarizeRepo: jobRepo,
+			}
+			handler := article.ResummarizeHandler{Svc: svc}
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, nil)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", rr.Code, tt.wantStatus, rr.Body.String())
+			}
+			if tt.wantStatus != http.StatusAccepted {
+				return
+			}
+
+			var got article.ResummarizeJobDTO
+			if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if got.ID != 7 || got.Status != entity.ResummarizeQueued || got.ArticleID == nil || *got.ArticleID != 1 {
+				t.Errorf("unexpected job: %+v", got)
+			}
+		})
+	}
+}
+
+func TestBulkResummarizeHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		check      func(t *testing.T, f entity.ResummarizeFilter)
+	}{
+		{
+			name:       "source and date range",
+			body:       `{"source_id":3,"from":"2026-01-01T00:00:00Z","to":"2026-01-31T23:59:59Z"}`,
+			wantStatus: http.StatusAccepted,
+			check: func(t *testing.T, f entity.ResummarizeFilter) {
+				if f.SourceID == nil || *f.SourceID != 3 || f.From == nil || f.To == nil || f.SummaryModel != "" {
+					t.Errorf("unexpected filter: %+v", f)
+				}
+			},
+		},
+		{
+			name:       "summary model",
+			body:       `{"summary_model":"gpt-3.5-turbo"}`,
+			wantStatus: http.StatusAccepted,
+			check: func(t *testing.T, f entity.ResummarizeFilter) {
+				if f.SummaryModel != "gpt-3.5-turbo" || f.SourceID != nil {
+					t.Errorf("unexpected filter: %+v", f)
+				}
+			},
+		},
+		{name: "invalid json", body: `{`, wantStatus: http.StatusBadRequest},
+		{name: "invalid date", body: `{"from":"2026-01-01"}`, wantStatus: http.StatusBadRequest},
+		{name: "from after to", body: `{"from":"2026-02-01T00:00:00Z","to":"2026-01-01T00:00:00Z"}`, wantStatus: http.StatusBadRequest},
+		{name: "invalid source id", body: `{"source_id":-1}`, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobRepo := &stubResummarizeRepo{}
+			handler := article.BulkResummarizeHandler{Svc: artUC.Service{Repo: &stubUpdateRepo{}, ResummarizeRepo: jobRepo}}
+
+			req := httptest.NewRequest(http.MethodPost, "/articles/resummarize", strings.NewReader(tt.body))
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", rr.Code, tt.wantStatus, rr.Body.String())
+			}
+			if tt.check != nil {
+				if jobRepo.created == nil {
+					t.Fatal("job not created")
+				}
+				tt.check(t, jobRepo.created.Filter)
+			} else if jobRepo.created != nil {
+				t.Error("job created for invalid request")
+			}
+		})
+	}
+}
+
+func TestResummarizeJobHandler(t *testing.T) {
+	started := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	jobRepo := &stubResummarizeRepo{job: &entity.ResummarizeJob{
+		ID: 7, Status: entity.ResummarizeRunning, Total: 10, Processed: 4, Succeeded: 3, Failed: 1,
+		RequestedBy: "admin@example.com", StartedAt: &started,
+	}}
+	handler := article.ResummarizeJobHandler{Svc: artUC.Service{Repo: &stubUpdateRepo{}, ResummarizeRepo: jobRepo}}
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+	}{
+		{"found", "/resummarize-jobs/7", http.StatusOK},
+		{"not found", "/resummarize-jobs/8", http.StatusNotFound},
+		{"invalid id", "/resummarize-jobs/x", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rr.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var got article.ResummarizeJobDTO
+			if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if got.Total != 10 || got.Processed != 4 || got.Failed != 1 || got.StartedAt == nil || got.FinishedAt != nil {
+				t.Errorf("unexpected job: %+v", got)
+			}
+		})
+	}
+}
@@ -105,15 +105,9 @@ func (h SearchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Convert to DTO
-	out := make([]DTO, 0, len(list))
+	items := make([]repository.ArticleWithSource, 0, len(list))
 	for _, e := range list {
-		out = append(out, DTO{
-			ID: e.ID, SourceID: e.SourceID, Title: e.Title,
-			URL: e.URL, Summary: e.Summary,
-			PublishedAt: e.PublishedAt, CreatedAt: e.CreatedAt,
-			UpdatedAt: e.CreatedAt, // Database schema doesn't have updated_at column
-		})
+		items = append(items, repository.ArticleWithSource{Article: e})
 	}
-	respond.JSON(w, http.StatusOK, out)
+	respond.JSON(w, http.StatusOK, listResponse.Map(r.Context(), items))
 }
@@ -0,0 +1,50 @@
+package article
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"catchup-feed/internal/repository"
+	artUC "catchup-feed/internal/usecase/article"
+)
+
+// FacetBucketDTO is the number of search results with one facet value.
+type FacetBucketDTO struct {
+	Value string `json:"value" example:"2025-06"`
+	Label string `json:"label,omitempty" example:"Go Blog"`
+	Count int64  `json:"count" example:"12"`
+}
+
+// parseFacets parses the comma separated facets query parameter.
+// Returns nil if the parameter is not set.
+func parseFacets(r *http.Request) ([]string, error) {
+	raw := r.URL.Query().Get("facets")
+	if raw == "" {
+		return nil, nil
+	}
+	var facets []string
+	for _, name := range strings.Split(raw, ",") {
+		name = strings.TrimSpace(name)
+		if !artUC.ValidFacet(name) {
+			return nil, fmt.Errorf("invalid facets: %q is not one of %q, %q or %q",
+				name, repository.FacetSource, repository.FacetMonth, repository.FacetTag)
+		}
+		facets = append(facets, name)
+	}
+	return facets, nil
+}
+
+// toFacetsDTO converts facet counts to their response form. Facets without buckets
+// are returned as empty arrays.
+func toFacetsDTO(facets map[string][]repository.FacetBucket) map[string][]FacetBucketDTO {
+	out := make(map[string][]FacetBucketDTO, len(facets))
+	for name, buckets := range facets {
+		dtos := make([]FacetBucketDTO, 0, len(buckets))
+		for _, b := range buckets {
+			dtos = append(dtos, FacetBucketDTO{Value: b.Value, Label: b.Label, Count: b.Count})
+		}
+		out[name] = dtos
+	}
+	return out
+}
@@ -0,0 +1,154 @@
+package article_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/handler/http/article"
+	"catchup-feed/internal/repository"
+	artUC "catchup-feed/internal/usecase/article"
+)
+
+// stubFacetRepo adds facet counts to stubArticleRepo.
+type stubFacetRepo struct {
+	stubArticleRepo
+	buckets      map[string][]repository.FacetBucket
+	facetErr     error
+	lastKeywords []string
+	lastFilters  repository.ArticleSearchFilters
+}
+
+func (s *stubFacetRepo) CountFacet(_ context.Context, keywords []string, filters repository.ArticleSearchFilters, facet string, _ int) ([]repository.FacetBucket, error) {
+	s.lastKeywords, s.lastFilters = keywords, filters
+	return s.buckets[facet], s.facetErr
+}
+
+func serveFacetSearch(t *testing.T, repo repository.ArticleRepository, q url.Values) *httptest.ResponseRecorder {
+	t.Helper()
+	handler := article.SearchPaginatedHandler{
+		Svc:           artUC.Service{Repo: repo},
+		PaginationCfg: pagination.DefaultConfig(),
+	}
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/articles/search?"+q.Encode(), nil))
+	return rr
+}
+
+func TestSearchPaginated_Facets(t *testing.T) {
+	stub := &stubFacetRepo{buckets: map[string][]repository.FacetBucket{
+		repository.FacetSource: {
+			{Value: "1", Label: "Go Blog", Count: 12},
+			{Value: "2", Label: "Zenn", Count: 4},
+		},
+		repository.FacetMonth: {{Value: "2025-06", Count: 16}},
+	}}
+
+	q := url.Values{}
+	q.Set("keyword", "go")
+	q.Add("tag", "release")
+	q.Set("facets", "source, month,tag")
+	rr := serveFacetSearch(t, stub, q)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d: %s", rr.Code, http.StatusOK, rr.Body.String())
+	}
+	var resp article.PaginatedResponse
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	want := map[string][]article.FacetBucketDTO{
+		"source": {{Value: "1", Label: "Go Blog", Count: 12}, {Value: "2", Label: "Zenn", Count: 4}},
+		"month":  {{Value: "2025-06", Count: 16}},
+		"tag":    {},
+	}
+	if diff := cmp.Diff(want, resp.Facets); diff != "" {
+		t.Errorf("facets mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"go"}, stub.lastKeywords); diff != "" {
+		t.Errorf("keywords mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"release"}, stub.lastFilters.Tags); diff != "" {
+		t.Errorf("tag filters mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestSearchPaginated_WithoutFacets(t *testing.T) {
+	q := url.Values{}
+	q.Set("keyword", "go")
+	rr := serveFacetSearch(t, &stubFacetRepo{}, q)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d: %s", rr.Code, http.StatusOK, rr.Body.String())
+	}
+	var raw map[string]json.RawMessage
+	if err := json.NewDecoder(rr.Body).Decode(&raw); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if _, ok := raw["facets"]; ok {
+		t.Errorf("facets present without facets parameter: %s", raw["facets"])
+	}
+}
+
+func TestSearchPaginated_FacetsErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		repo     repository.ArticleRepository
+		query    url.Values
+		wantCode int
+	}{
+		{
+			name:     "unknown facet",
+			repo:     &stubFacetRepo{},
+			query:    url.Values{"keyword": {"go"}, "facets": {"source,author"}},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "empty facet name",
+			repo:     &stubFacetRepo{},
+			query:    url.Values{"keyword": {"go"}, "facets": {"source,"}},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "ranked mode",
+			repo:     &stubFacetRepo{},
+			query:    url.Values{"keyword": {"go"}, "mode": {"ranked"}, "facets": {"source"}},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "semantic mode",
+			repo:     &stubFacetRepo{},
+			query:    url.Values{"keyword": {"go"}, "mode": {"semantic"}, "facets": {"month"}},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "unsupported repository",
+			repo:     &stubArticleRepo{},
+			query:    url.Values{"keyword": {"go"}, "facets": {"tag"}},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "repository error",
+			repo:     &stubFacetRepo{facetErr: errors.New("db down")},
+			query:    url.Values{"keyword": {"go"}, "facets": {"tag"}},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := serveFacetSearch(t, tt.repo, tt.query)
+			if rr.Code != tt.wantCode {
+				t.Errorf("status code = %d, want %d: %s", rr.Code, tt.wantCode, rr.Body.String())
+			}
+		})
+	}
+}
@@ -23,20 +23,28 @@ type SearchPaginatedHandler struct {
 type PaginatedResponse struct {
 	Data       []DTO               `json:"data"`
 	Pagination pagination.Metadata `json:"pagination"`
+	// Facets holds the result counts per facet value, keyed by the requested facet
+	Facets map[string][]FacetBucketDTO `json:"facets,omitempty"`
 }
 
 // ServeHTTP 記事検索（ページネーション付き）
 // @Summary      記事検索（ページネーション付き）
-// @Description  マルチキーワードで記事を検索します（AND論理）、ページネーション対応
+// @Description  マルチキーワードで記事を検索します（AND論理）、ページネーション対応。ソース・ソースカテゴリ・期間・タグで絞り込めます。facets を指定すると、同じ条件でのソース別・月別・タグ別の件数も返します。mode=ranked の場合はフレーズ（"..."）・OR・除外（-語）を使えるクエリで検索し、関連度順にハイライト付きで返します（レスポンスは RankedSearchResponse）。mode=semantic の場合は keyword を自然文として扱い、意味の近い記事を類似度順に返します（絞り込み不可、最大100件）
 // @Tags         articles
 // @Security     BearerAuth
 // @Produce      json
 // @Param        keyword query string false "検索キーワード（スペース区切り）"
+// @Param        mode query string false "検索モード（keyword: キーワード検索、ranked: 関連度順の全文検索、semantic: 意味検索）" Enums(keyword, ranked, semantic)
 // @Param        source_id query int false "ソースIDでフィルタ"
 // @Param        from query string false "公開日時の開始（ISO 8601）"
 // @Param        to query string false "公開日時の終了（ISO 8601）"
+// @Param        tag query []string false "タグでフィルタ（複数指定時はすべてのタグを持つ記事）" collectionFormat(multi)
+// @Param        category_id query int false "ソースカテゴリIDでフィルタ（カテゴリに属するソースの記事）"
 // @Param        page query int false "ページ番号（1-indexed、デフォルト: 1）"
 // @Param        limit query int false "1ページあたりの件数（デフォルト: 10、最大: 100）"
+// @Param        pagination query string false "ページネーション方式（offset: ページ番号、cursor: カーソル。mode=semantic では使用不可）" Enums(offset, cursor)
+// @Param        cursor query string false "前ページの next_cursor（指定時は pagination=cursor 扱い。page とは併用不可）"
+// @Param        facets query string false "件数を集計するファセット（カンマ区切り: source, month, tag。mode=keyword のみ）"
 // @Success      200 {object} PaginatedResponse "検索結果（ページネーション付き）" headers(X-RateLimit-Limit=integer,X-RateLimit-Remaining=integer,X-RateLimit-Reset=integer)
 // @Failure      400 {string} string "Bad request"
 // @Failure      401 {string} string "Authentication required"
@@ -51,10 +59,46 @@ func (h SearchPaginatedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	// Parse facets (keyword mode only: the counts use its search conditions)
+	facets, err := parseFacets(r)
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+	mode := r.URL.Query().Get("mode")
+	if facets != nil && mode != "" && mode != searchModeKeyword {
+		respond.SafeError(w, http.StatusBadRequest,
+			fmt.Errorf("invalid facets: only supported with mode=%s", searchModeKeyword))
+		return
+	}
+
+	ranked := false
+	switch mode {
+	case "", searchModeKeyword:
+	case searchModeRanked:
+		ranked = true
+	case searchModeSemantic:
+		h.serveSemantic(w, r, paginationParams)
+		return
+	default:
+		respond.SafeError(w, http.StatusBadRequest,
+			fmt.Errorf("invalid mode: must be %q, %q or %q", searchModeKeyword, searchModeRanked, searchModeSemantic))
+		return
+	}
+
 	// Parse keyword parameter (optional - allows browsing with filters only)
 	kw := r.URL.Query().Get("keyword")
 	var keywords []string
-	if kw != "" {
+	var query search.Query
+	if ranked {
+		// Ranked mode requires a query and supports phrases, OR and exclusions
+		query, err = search.ParseQuery(kw, search.DefaultMaxKeywordCount, search.DefaultMaxKeywordLength)
+		if err != nil {
+			respond.SafeError(w, http.StatusBadRequest,
+				fmt.Errorf("invalid keyword: %w", err))
+			return
+		}
+	} else if kw != "" {
 		// Parse and validate keywords
 		keywords, err = search.ParseKeywords(kw, search.DefaultMaxKeywordCount, search.DefaultMaxKeywordLength)
 		if err != nil {
@@ -108,6 +152,20 @@ func (h SearchPaginatedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		filters.To = to
 	}
 
+	// Parse tag filters (repeatable, AND logic)
+	filters.Tags, err = parseTagFilters(r)
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Parse category_id if provided
+	filters.CategoryID, err = parseCategoryID(r)
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
 	// Validate date range: from <= to
 	if filters.From != nil && filters.To != nil {
 		if filters.From.After(*filters.To) {
@@ -117,38 +175,51 @@ func (h SearchPaginatedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		}
 	}
 
+	if ranked {
+		h.serveRanked(w, r, query, filters, paginationParams)
+		return
+	}
+
 	// Execute search with filters and pagination
-	result, err := h.Svc.SearchWithFiltersPaginated(
-		r.Context(),
-		keywords,
-		filters,
-		paginationParams.Page,
-		paginationParams.Limit,
-	)
+	var result *artUC.PaginatedResult
+	if paginationParams.Keyset {
+		result, err = h.Svc.SearchWithFiltersKeyset(r.Context(), keywords, filters, paginationParams)
+	} else {
+		result, err = h.Svc.SearchWithFiltersPaginated(
+			r.Context(),
+			keywords,
+			filters,
+			paginationParams.Page,
+			paginationParams.Limit,
+		)
+	}
 	if err != nil {
-		respond.SafeError(w, http.StatusInternalServerError, err)
+		code := http.StatusInternalServerError
+		if errors.Is(err, artUC.ErrKeysetPaginationUnsupported) {
+			code = http.StatusBadRequest
+		}
+		respond.SafeError(w, code, err)
 		return
 	}
 
-	// Convert to DTO
-	out := make([]DTO, 0, len(result.Data))
-	for _, item := range result.Data {
-		out = append(out, DTO{
-			ID:          item.Article.ID,
-			SourceID:    item.Article.SourceID,
-			SourceName:  item.SourceName,
-			Title:       item.Article.Title,
-			URL:         item.Article.URL,
-			Summary:     item.Article.Summary,
-			PublishedAt: item.Article.PublishedAt,
-			CreatedAt:   item.Article.CreatedAt,
-			UpdatedAt:   item.Article.CreatedAt, // Database schema doesn't have updated_at column
-		})
+	// Count facets with the same keywords and filters
+	var facetCounts map[string][]repository.FacetBucket
+	if facets != nil {
+		facetCounts, err = h.Svc.SearchFacets(r.Context(), keywords, filters, facets)
+		if err != nil {
+			code := http.StatusInternalServerError
+			if errors.Is(err, artUC.ErrFacetsUnsupported) {
+				code = http.StatusBadRequest
+			}
+			respond.SafeError(w, code, err)
+			return
+		}
 	}
 
 	// Return paginated response
-	respond.JSON(w, http.StatusOK, PaginatedResponse{
-		Data:       out,
-		Pagination: result.Pagination,
-	})
+	respond.JSON(w, http.StatusOK, pageResponse.Map(r.Context(), articlePage{
+		Data:       result.Data,
+		Pagination: withNextCursor(h.PaginationCfg, result),
+		Facets:     facetCounts,
+	}))
 }
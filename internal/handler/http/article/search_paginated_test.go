@@ -23,6 +23,8 @@ type stubSearchPaginatedRepo struct {
 	totalCount      int64
 	searchErr       error
 	countErr        error
+	lastKeywords    []string
+	lastFilters     repository.ArticleSearchFilters
 }
 
 func (s *stubSearchPaginatedRepo) List(_ context.Context) ([]*entity.Article, error) {
@@ -84,7 +86,9 @@ func (s *stubSearchPaginatedRepo) CountArticlesWithFilters(_ context.Context, _
 	return s.totalCount, nil
 }
 
-func (s *stubSearchPaginatedRepo) SearchWithFiltersPaginated(_ context.Context, _ []string, _ repository.ArticleSearchFilters, offset, limit int) ([]repository.ArticleWithSource, error) {
+func (s *stubSearchPaginatedRepo) SearchWithFiltersPaginated(_ context.Context, keywords []string, filters repository.ArticleSearchFilters, offset, limit int) ([]repository.ArticleWithSource, error) {
+	s.lastKeywords = keywords
+	s.lastFilters = filters
 	if s.searchErr != nil {
 		return nil, s.searchErr
 	}
@@ -0,0 +1,65 @@
+package article
+
+import (
+	"errors"
+	"net/http"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/handler/http/respond"
+	"catchup-feed/internal/pkg/search"
+	"catchup-feed/internal/repository"
+	artUC "catchup-feed/internal/usecase/article"
+)
+
+// summarySnippetLength is the length (in runes) of the summary snippet of ranked results.
+const summarySnippetLength = 160
+
+// HighlightsDTO holds the fragments of an article that matched a ranked search.
+// Matches are wrapped in <mark></mark> and the rest of the text is HTML-escaped.
+type HighlightsDTO struct {
+	// Title is the whole title with matches marked; empty if the title did not match.
+	Title string `json:"title,omitempty" example:"<mark>Go</mark> 1.23 リリース"`
+	// Summary is a snippet of the summary around the first match; empty if the summary did not match.
+	Summary string `json:"summary,omitempty" example:"…<mark>Go</mark> 1.23 がリリースされました。新機能には…"`
+}
+
+// RankedDTO is an article found by a ranked search.
+type RankedDTO struct {
+	DTO
+	// Score is the relevance of the article to the query (higher is more relevant).
+	// Scores are only comparable within the results of one query.
+	Score      float64       `json:"score" example:"0.42"`
+	Highlights HighlightsDTO `json:"highlights"`
+}
+
+// RankedSearchResponse is the response of GET /articles/search?mode=ranked.
+type RankedSearchResponse struct {
+	Data       []RankedDTO         `json:"data"`
+	Pagination pagination.Metadata `json:"pagination"`
+}
+
+// serveRanked handles GET /articles/search?mode=ranked: articles matching query and
+// filters are returned most relevant first, with highlighted title and summary.
+func (h SearchPaginatedHandler) serveRanked(w http.ResponseWriter, r *http.Request, query search.Query, filters repository.ArticleSearchFilters, params pagination.Params) {
+	if params.Keyset {
+		respond.SafeError(w, http.StatusBadRequest,
+			errors.New("invalid pagination: cursor pagination cannot be combined with mode=ranked"))
+		return
+	}
+
+	result, err := h.Svc.SearchRanked(r.Context(), query, filters, params)
+	if err != nil {
+		code := http.StatusInternalServerError
+		if errors.Is(err, artUC.ErrRankedSearchUnsupported) {
+			code = http.StatusBadRequest
+		}
+		respond.SafeError(w, code, err)
+		return
+	}
+
+	respond.JSON(w, http.StatusOK, rankedResponse.Map(r.Context(), rankedPage{
+		Data:       result.Data,
+		Pagination: result.Pagination,
+		Terms:      query.TermTexts(),
+	}))
+}
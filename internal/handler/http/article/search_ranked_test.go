@@ -0,0 +1,144 @@
+package article_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/article"
+	"catchup-feed/internal/pkg/search"
+	"catchup-feed/internal/repository"
+	artUC "catchup-feed/internal/usecase/article"
+)
+
+// stubRankedRepo adds ranked search to stubArticleRepo.
+type stubRankedRepo struct {
+	stubArticleRepo
+	ranked      []repository.RankedArticle
+	searchErr   error
+	lastQuery   search.Query
+	lastFilters repository.ArticleSearchFilters
+	lastOffset  int
+}
+
+func (s *stubRankedRepo) SearchRanked(_ context.Context, q search.Query, filters repository.ArticleSearchFilters, offset, _ int) ([]repository.RankedArticle, error) {
+	s.lastQuery, s.lastFilters, s.lastOffset = q, filters, offset
+	return s.ranked, s.searchErr
+}
+
+func (s *stubRankedRepo) CountRanked(_ context.Context, _ search.Query, _ repository.ArticleSearchFilters) (int64, error) {
+	return s.totalCount, s.countErr
+}
+
+func TestSearchPaginated_Ranked(t *testing.T) {
+	now := time.Now()
+	stub := &stubRankedRepo{
+		stubArticleRepo: stubArticleRepo{totalCount: 21},
+		ranked: []repository.RankedArticle{
+			{
+				ArticleWithSource: repository.ArticleWithSource{
+					Article: &entity.Article{
+						ID: 7, SourceID: 2, Title: "Error handling in Go", URL: "https://example.com/7",
+						Summary: "Go 1.23 improves <error> wrapping.", PublishedAt: now, CreatedAt: now,
+					},
+					SourceName: "Go Blog",
+				},
+				Rank: 0.75,
+			},
+			{
+				ArticleWithSource: repository.ArticleWithSource{
+					Article:    &entity.Article{ID: 3, Title: "Release notes", Summary: "Rust and Go updates"},
+					SourceName: "News",
+				},
+				Rank: 0.1,
+			},
+		},
+	}
+	handler := article.SearchPaginatedHandler{
+		Svc:           artUC.Service{Repo: stub},
+		PaginationCfg: pagination.DefaultConfig(),
+	}
+
+	q := url.Values{}
+	q.Set("mode", "ranked")
+	q.Set("keyword", `"error handling" OR go -beta`)
+	q.Set("source_id", "2")
+	q.Set("page", "2")
+	q.Set("limit", "10")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/articles/search?"+q.Encode(), nil))
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d: %s", rr.Code, http.StatusOK, rr.Body.String())
+	}
+	var resp article.RankedSearchResponse
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if got := stub.lastQuery.String(); got != `"error handling" OR go -beta` {
+		t.Errorf("query = %q", got)
+	}
+	if stub.lastFilters.SourceID == nil || *stub.lastFilters.SourceID != 2 || stub.lastOffset != 10 {
+		t.Errorf("filters = %+v, offset = %d", stub.lastFilters, stub.lastOffset)
+	}
+	if resp.Pagination.Total != 21 || resp.Pagination.Page != 2 || resp.Pagination.TotalPages != 3 {
+		t.Errorf("pagination = %+v", resp.Pagination)
+	}
+	if len(resp.Data) != 2 {
+		t.Fatalf("len(data) = %d, want 2", len(resp.Data))
+	}
+
+	first := resp.Data[0]
+	if first.ID != 7 || first.SourceName != "Go Blog" || first.Score != 0.75 {
+		t.Errorf("data[0] = %+v", first)
+	}
+	if want := "<mark>Error handling</mark> in <mark>Go</mark>"; first.Highlights.Title != want {
+		t.Errorf("title highlight = %q, want %q", first.Highlights.Title, want)
+	}
+	if want := "<mark>Go</mark> 1.23 improves &lt;error&gt; wrapping."; first.Highlights.Summary != want {
+		t.Errorf("summary highlight = %q, want %q", first.Highlights.Summary, want)
+	}
+	if resp.Data[1].Highlights.Title != "" {
+		t.Errorf("unmatched title highlight = %q, want empty", resp.Data[1].Highlights.Title)
+	}
+}
+
+func TestSearchPaginated_Ranked_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		repo     repository.ArticleRepository
+		query    string
+		wantCode int
+	}{
+		{name: "missing keyword", repo: &stubRankedRepo{}, query: "mode=ranked", wantCode: http.StatusBadRequest},
+		{name: "only exclusions", repo: &stubRankedRepo{}, query: "mode=ranked&keyword=-beta", wantCode: http.StatusBadRequest},
+		{name: "dangling OR", repo: &stubRankedRepo{}, query: "mode=ranked&keyword=go+OR", wantCode: http.StatusBadRequest},
+		{name: "cursor pagination", repo: &stubRankedRepo{}, query: "mode=ranked&keyword=go&pagination=cursor", wantCode: http.StatusBadRequest},
+		{name: "invalid filter", repo: &stubRankedRepo{}, query: "mode=ranked&keyword=go&source_id=x", wantCode: http.StatusBadRequest},
+		{name: "repository without ranked search", repo: &stubArticleRepo{}, query: "mode=ranked&keyword=go", wantCode: http.StatusBadRequest},
+		{name: "database error", repo: &stubRankedRepo{searchErr: errors.New("db down")}, query: "mode=ranked&keyword=go", wantCode: http.StatusInternalServerError},
+		{name: "unknown mode", repo: &stubRankedRepo{}, query: "mode=fuzzy&keyword=go", wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := article.SearchPaginatedHandler{
+				Svc:           artUC.Service{Repo: tt.repo},
+				PaginationCfg: pagination.DefaultConfig(),
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/articles/search?"+tt.query, nil))
+			if rr.Code != tt.wantCode {
+				t.Errorf("status code = %d, want %d", rr.Code, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package article
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/handler/http/respond"
+	artUC "catchup-feed/internal/usecase/article"
+)
+
+// Search modes of GET /articles/search.
+const (
+	searchModeKeyword  = "keyword"
+	searchModeRanked   = "ranked"
+	searchModeSemantic = "semantic"
+)
+
+// maxSemanticQueryLength is the maximum length (in runes) of a semantic search query.
+const maxSemanticQueryLength = 500
+
+// semanticFilterParams are the filters of keyword search that semantic search does not support.
+var semanticFilterParams = []string{"source_id", "category_id", "from", "to", "tag"}
+
+// serveSemantic handles GET /articles/search?mode=semantic. The keyword parameter is
+// used as a natural-language query and the results are ranked by embedding similarity.
+func (h SearchPaginatedHandler) serveSemantic(w http.ResponseWriter, r *http.Request, params pagination.Params) {
+	query := strings.TrimSpace(r.URL.Query().Get("keyword"))
+	if query == "" {
+		respond.SafeError(w, http.StatusBadRequest,
+			errors.New("keyword is required when mode=semantic"))
+		return
+	}
+	if utf8.RuneCountInString(query) > maxSemanticQueryLength {
+		respond.SafeError(w, http.StatusBadRequest,
+			fmt.Errorf("invalid keyword: too long (max %d characters)", maxSemanticQueryLength))
+		return
+	}
+	if params.Keyset {
+		respond.SafeError(w, http.StatusBadRequest,
+			errors.New("invalid pagination: cursor pagination cannot be combined with mode=semantic"))
+		return
+	}
+	for _, name := range semanticFilterParams {
+		if r.URL.Query().Has(name) {
+			respond.SafeError(w, http.StatusBadRequest,
+				fmt.Errorf("invalid %s: filters cannot be combined with mode=semantic", name))
+			return
+		}
+	}
+
+	result, err := h.Svc.SemanticSearchPaginated(r.Context(), query, params.Page, params.Limit)
+	if err != nil {
+		code := http.StatusInternalServerError
+		if errors.Is(err, artUC.ErrSemanticSearchDisabled) {
+			code = http.StatusBadRequest
+		}
+		respond.SafeError(w, code, err)
+		return
+	}
+
+	respond.JSON(w, http.StatusOK, pageResponse.Map(r.Context(), articlePage{
+		Data:       result.Data,
+		Pagination: result.Pagination,
+	}))
+}
@@ -0,0 +1,177 @@
+package article_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/article"
+	"catchup-feed/internal/repository"
+	artUC "catchup-feed/internal/usecase/article"
+	"catchup-feed/internal/usecase/embedding"
+)
+
+// stubSemantic はSemanticSearcherのモック実装
+type stubSemantic struct {
+	results   []embedding.ScoredArticle
+	lastQuery string
+	lastLimit int
+	err       error
+}
+
+func (s *stubSemantic) Search(_ context.Context, query string, _, _ int) ([]embedding.ScoredArticle, int, error) {
+	s.lastQuery = query
+	return s.results, len(s.results), s.err
+}
+
+func (s *stubSemantic) Related(_ context.Context, _ int64, limit int) ([]embedding.ScoredArticle, error) {
+	s.lastLimit = limit
+	return s.results, s.err
+}
+
+func semanticResults() []embedding.ScoredArticle {
+	return []embedding.ScoredArticle{
+		{ArticleWithSource: repository.ArticleWithSource{
+			Article: &entity.Article{ID: 3, SourceID: 1, Title: "Go generics deep dive", Summary: "ジェネリクスの解説"}, SourceName: "Go Blog",
+		}, Score: 0.91},
+		{ArticleWithSource: repository.ArticleWithSource{
+			Article: &entity.Article{ID: 8, SourceID: 2, Title: "型パラメータ入門", Summary: "型パラメータの使い方"}, SourceName: "Tech Blog",
+		}, Score: 0.77},
+	}
+}
+
+func TestSearchPaginated_SemanticMode(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		semantic artUC.SemanticSearcher
+		wantCode int
+	}{
+		{name: "ranked results", query: "?mode=semantic&keyword=Go+%E3%82%B8%E3%82%A7%E3%83%8D%E3%83%AA%E3%82%AF%E3%82%B9",
+			semantic: &stubSemantic{results: semanticResults()}, wantCode: http.StatusOK},
+		{name: "keyword required", query: "?mode=semantic", semantic: &stubSemantic{}, wantCode: http.StatusBadRequest},
+		{name: "query too long", query: "?mode=semantic&keyword=" + strings.Repeat("a", 501),
+			semantic: &stubSemantic{}, wantCode: http.StatusBadRequest},
+		{name: "filters not supported", query: "?mode=semantic&keyword=go&source_id=1",
+			semantic: &stubSemantic{}, wantCode: http.StatusBadRequest},
+		{name: "tag filter not supported", query: "?mode=semantic&keyword=go&tag=go",
+			semantic: &stubSemantic{}, wantCode: http.StatusBadRequest},
+		{name: "unknown mode", query: "?mode=fuzzy&keyword=go", semantic: &stubSemantic{}, wantCode: http.StatusBadRequest},
+		{name: "disabled", query: "?mode=semantic&keyword=go", wantCode: http.StatusBadRequest},
+		{name: "embedder error", query: "?mode=semantic&keyword=go",
+			semantic: &stubSemantic{err: errors.New("api down")}, wantCode: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := article.SearchPaginatedHandler{
+				Svc:           artUC.Service{Repo: &stubSearchPaginatedRepo{}, Semantic: tt.semantic},
+				PaginationCfg: pagination.DefaultConfig(),
+			}
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/articles/search"+tt.query, nil))
+
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d (body %s)", rr.Code, tt.wantCode, rr.Body.String())
+			}
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+
+			var result article.PaginatedResponse
+			if err := json.NewDecoder(rr.Body).Decode(&result); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if len(result.Data) != 2 || result.Data[0].ID != 3 || result.Data[0].SourceName != "Go Blog" {
+				t.Errorf("data = %+v", result.Data)
+			}
+			if result.Pagination.Total != 2 {
+				t.Errorf("Pagination.Total = %d, want 2", result.Pagination.Total)
+			}
+			if got := tt.semantic.(*stubSemantic).lastQuery; got != "Go ジェネリクス" {
+				t.Errorf("query = %q, want the raw keyword", got)
+			}
+		})
+	}
+}
+
+func TestSearchPaginated_KeywordModeExplicit(t *testing.T) {
+	stub := &stubSearchPaginatedRepo{}
+	handler := article.SearchPaginatedHandler{
+		Svc:           artUC.Service{Repo: stub},
+		PaginationCfg: pagination.DefaultConfig(),
+	}
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/articles/search?mode=keyword&keyword=Go", nil))
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", rr.Code, http.StatusOK)
+	}
+	if len(stub.lastKeywords) != 1 || stub.lastKeywords[0] != "Go" {
+		t.Errorf("keywords = %v, want [Go]", stub.lastKeywords)
+	}
+}
+
+func TestRelatedHandler(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		semantic  *stubSemantic
+		wantCode  int
+		wantLimit int
+	}{
+		{name: "default limit", path: "/articles/1/related", semantic: &stubSemantic{results: semanticResults()},
+			wantCode: http.StatusOK, wantLimit: 5},
+		{name: "custom limit", path: "/articles/1/related?limit=2", semantic: &stubSemantic{results: semanticResults()},
+			wantCode: http.StatusOK, wantLimit: 2},
+		{name: "not embedded yet", path: "/articles/1/related", semantic: &stubSemantic{},
+			wantCode: http.StatusOK, wantLimit: 5},
+		{name: "invalid limit", path: "/articles/1/related?limit=21", semantic: &stubSemantic{}, wantCode: http.StatusBadRequest},
+		{name: "invalid id", path: "/articles/abc/related", semantic: &stubSemantic{}, wantCode: http.StatusBadRequest},
+		{name: "article not found", path: "/articles/2/related", semantic: &stubSemantic{}, wantCode: http.StatusNotFound},
+		{name: "disabled", path: "/articles/1/related", wantCode: http.StatusBadRequest},
+		{name: "index error", path: "/articles/1/related", semantic: &stubSemantic{err: errors.New("db down")},
+			wantCode: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := artUC.Service{Repo: &stubUpdateRepo{article: &entity.Article{ID: 1}}}
+			if tt.semantic != nil {
+				svc.Semantic = tt.semantic
+			}
+
+			rr := httptest.NewRecorder()
+			article.RelatedHandler{Svc: svc}.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d (body %s)", rr.Code, tt.wantCode, rr.Body.String())
+			}
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			if tt.semantic.lastLimit != tt.wantLimit {
+				t.Errorf("limit = %d, want %d", tt.semantic.lastLimit, tt.wantLimit)
+			}
+
+			var got []article.RelatedDTO
+			if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if len(got) != len(tt.semantic.results) {
+				t.Fatalf("len = %d, want %d", len(got), len(tt.semantic.results))
+			}
+			if len(got) > 0 && (got[0].ID != 3 || got[0].Similarity != 0.91 || got[0].Title == "") {
+				t.Errorf("related = %+v", got[0])
+			}
+		})
+	}
+}
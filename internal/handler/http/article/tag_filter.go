@@ -0,0 +1,13 @@
+package article
+
+import (
+	"net/http"
+
+	"catchup-feed/internal/handler/http/searchfilter"
+)
+
+// parseTagFilters returns the normalized tag names of the repeated tag= query
+// parameters. Articles must have all of them. Returns nil if none is given.
+func parseTagFilters(r *http.Request) ([]string, error) {
+	return searchfilter.ParseTags(r.URL.Query()["tag"])
+}
@@ -0,0 +1,115 @@
+package article_test
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/article"
+	"catchup-feed/internal/repository"
+	artUC "catchup-feed/internal/usecase/article"
+)
+
+func taggedArticles() []repository.ArticleWithSource {
+	now := time.Now()
+	return []repository.ArticleWithSource{{
+		Article: &entity.Article{
+			ID: 1, SourceID: 5, Title: "Go 1.24", URL: "https://example.com/go",
+			PublishedAt: now, CreatedAt: now,
+		},
+		SourceName: "Go Blog",
+	}}
+}
+
+func TestListHandler_TagFilter(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubSearchPaginatedRepo{articlesWithSrc: taggedArticles(), totalCount: 1}
+	handler := article.ListHandler{
+		Svc:           artUC.Service{Repo: stub},
+		PaginationCfg: pagination.DefaultConfig(),
+		Logger:        slog.Default(),
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/articles?tag=Go&tag=release&tag=go", nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", rr.Code, http.StatusOK)
+	}
+	if want := []string{"go", "release"}; !reflect.DeepEqual(stub.lastFilters.Tags, want) {
+		t.Errorf("filters.Tags = %v, want %v", stub.lastFilters.Tags, want)
+	}
+	if len(stub.lastKeywords) != 0 {
+		t.Errorf("keywords = %v, want none", stub.lastKeywords)
+	}
+
+	var result pagination.Response[article.DTO]
+	if err := json.NewDecoder(rr.Body).Decode(&result); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(result.Data) != 1 || result.Pagination.Total != 1 {
+		t.Errorf("result = %+v, want 1 tagged article", result)
+	}
+}
+
+func TestSearchPaginated_TagFilter(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubSearchPaginatedRepo{articlesWithSrc: taggedArticles(), totalCount: 1}
+	handler := article.SearchPaginatedHandler{
+		Svc:           artUC.Service{Repo: stub},
+		PaginationCfg: pagination.DefaultConfig(),
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/articles/search?keyword=Go&source_id=5&tag=Release", nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", rr.Code, http.StatusOK)
+	}
+	if want := []string{"release"}; !reflect.DeepEqual(stub.lastFilters.Tags, want) {
+		t.Errorf("filters.Tags = %v, want %v", stub.lastFilters.Tags, want)
+	}
+	if stub.lastFilters.SourceID == nil || *stub.lastFilters.SourceID != 5 {
+		t.Errorf("filters.SourceID = %v, want 5", stub.lastFilters.SourceID)
+	}
+}
+
+func TestTagFilter_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tooMany := "/articles?tag=" + strings.Repeat("a&tag=", 10) + "a"
+	longTag := "/articles?tag=" + strings.Repeat("a", entity.MaxTagNameLength+1)
+
+	for _, target := range []string{"/articles?tag=", "/articles?tag=+", tooMany, longTag} {
+		stub := &stubSearchPaginatedRepo{}
+		handlers := map[string]http.Handler{
+			"list": article.ListHandler{
+				Svc: artUC.Service{Repo: stub}, PaginationCfg: pagination.DefaultConfig(), Logger: slog.Default(),
+			},
+			"search": article.SearchPaginatedHandler{
+				Svc: artUC.Service{Repo: stub}, PaginationCfg: pagination.DefaultConfig(),
+			},
+		}
+		for name, h := range handlers {
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, target, nil))
+			if rr.Code != http.StatusBadRequest {
+				t.Errorf("%s %s: status code = %d, want %d", name, target, rr.Code, http.StatusBadRequest)
+			}
+			if !strings.Contains(rr.Body.String(), "invalid tag") {
+				t.Errorf("%s %s: body = %s, want invalid tag error", name, target, rr.Body.String())
+			}
+		}
+	}
+}
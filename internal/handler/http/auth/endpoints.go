@@ -10,6 +10,8 @@ import "strings"
 // - /metrics: Required for Prometheus scraping (typically accessed by monitoring systems)
 // - /swagger/: API documentation for developers
 // - /auth/token: Token generation endpoint (can't require token to get token)
+// - /shared/lists/: Read-only view of shared reading lists; the unguessable share token in the path is the credential
+// - /feeds/: Outbound article feeds for feed readers, which cannot send bearer tokens; the feed token in the query is the credential
 var PublicEndpoints = []string{
 	"/health",
 	"/ready",
@@ -17,6 +19,8 @@ var PublicEndpoints = []string{
 	"/metrics",
 	"/swagger/",
 	"/auth/token",
+	"/shared/lists/",
+	"/feeds/",
 }
 
 // IsPublicEndpoint checks if a given path is a public endpoint.
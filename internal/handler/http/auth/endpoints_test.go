@@ -79,6 +79,34 @@ func TestIsPublicEndpoint_ExhaustiveCoverage(t *testing.T) {
 			reason:   "Token generation endpoint (can't require token to get token)",
 		},
 
+		// Shared reading lists
+		{
+			name:     "shared reading list",
+			path:     "/shared/lists/q3Jx0bW8c2Jm9hZkXr1YV5n7uTzA4eKpL6sD2fGhQwE",
+			expected: true,
+			reason:   "Read-only view authorized by the share token in the path",
+		},
+		{
+			name:     "own reading list",
+			path:     "/me/lists/1",
+			expected: false,
+			reason:   "Protected resource - requires authentication",
+		},
+
+		// Outbound article feeds
+		{
+			name:     "atom feed with token",
+			path:     "/feeds/articles.atom?token=q3Jx0bW8c2Jm9hZkXr1YV5n7uTzA4eKpL6sD2fGhQwE",
+			expected: true,
+			reason:   "Feed readers cannot send bearer tokens - authorized by the feed token in the query",
+		},
+		{
+			name:     "own feed token",
+			path:     "/me/feed-token",
+			expected: false,
+			reason:   "Protected resource - requires authentication",
+		},
+
 		// Protected endpoints - Articles
 		{
 			name:     "articles list",
@@ -233,6 +261,8 @@ func TestPublicEndpointsList(t *testing.T) {
 		"/metrics",
 		"/swagger/",
 		"/auth/token",
+		"/shared/lists/",
+		"/feeds/",
 	}
 
 	if len(PublicEndpoints) != len(expectedEndpoints) {
@@ -276,6 +306,15 @@ func TestIsPublicEndpoint_PrefixMatching(t *testing.T) {
 		{"auth token exact", "/auth/token", true},
 		{"auth token with slash", "/auth/token/", true},
 
+		// Shared reading lists prefix matching
+		{"shared list", "/shared/lists/abc", true},
+		{"shared prefix only", "/shared", false},
+		{"shared other resource", "/shared/sources/abc", false},
+
+		// Article feeds prefix matching
+		{"json feed", "/feeds/articles.json", true},
+		{"feeds prefix only", "/feeds", false},
+
 		// Should NOT match other auth paths
 		{"auth only", "/auth", false},
 		{"auth with different suffix", "/auth/refresh", false},
@@ -20,6 +20,19 @@ type ctxKey string
 
 const ctxUser ctxKey = "user"
 
+// UserFromContext returns the JWT subject stored by Authz, or "" when the
+// request was not authenticated.
+func UserFromContext(ctx context.Context) string {
+	user, _ := ctx.Value(ctxUser).(string)
+	return user
+}
+
+// WithUser returns a copy of ctx carrying user as the JWT subject.
+// Authz uses it for authenticated requests.
+func WithUser(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, ctxUser, user)
+}
+
 // Authz is an authorization middleware that requires JWT authentication
 // for all HTTP methods on protected endpoints.
 //
@@ -96,8 +109,7 @@ func Authz(next http.Handler) http.Handler {
 			slog.String("user_email", user),
 			slog.String("role", role))
 
-		ctx := context.WithValue(r.Context(), ctxUser, user)
-		next.ServeHTTP(w, r.WithContext(ctx))
+		next.ServeHTTP(w, r.WithContext(WithUser(r.Context(), user)))
 	})
 }
 
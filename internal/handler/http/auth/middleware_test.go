@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -52,6 +53,8 @@ func TestAuthz_PublicEndpoints(t *testing.T) {
 		{"swagger ui", "GET", "/swagger/"},
 		{"swagger doc", "GET", "/swagger/index.html"},
 		{"auth token", "POST", "/auth/token"},
+		{"shared reading list", "GET", "/shared/lists/q3Jx0bW8c2Jm9hZkXr1YV5n7uTzA4eKpL6sD2fGhQwE"},
+		{"article feed", "GET", "/feeds/articles.rss?token=q3Jx0bW8c2Jm9hZkXr1YV5n7uTzA4eKpL6sD2fGhQwE"},
 	}
 
 	middleware := Authz(testSuccessHandler(t))
@@ -274,6 +277,23 @@ func TestAuthz_RoleBasedAuthorization(t *testing.T) {
 		{"viewer CANNOT DELETE articles", "viewer", "DELETE", "/articles/1", http.StatusForbidden},
 		{"viewer CANNOT POST sources", "viewer", "POST", "/sources", http.StatusForbidden},
 
+		// Resummarize endpoints - admin only
+		{"admin can resummarize article", "admin", "POST", "/articles/1/resummarize", http.StatusOK},
+		{"admin can GET resummarize job", "admin", "GET", "/resummarize-jobs/1", http.StatusOK},
+		{"viewer CANNOT resummarize article", "viewer", "POST", "/articles/1/resummarize", http.StatusForbidden},
+		{"viewer CANNOT bulk resummarize", "viewer", "POST", "/articles/resummarize", http.StatusForbidden},
+		{"viewer CANNOT GET resummarize job", "viewer", "GET", "/resummarize-jobs/1", http.StatusForbidden},
+
+		// Tag endpoints - tag list readable by viewers, rules admin only
+		{"viewer can GET tags", "viewer", "GET", "/tags", http.StatusOK},
+		{"admin can POST tag rule", "admin", "POST", "/tag-rules", http.StatusOK},
+		{"viewer CANNOT GET tag rules", "viewer", "GET", "/tag-rules", http.StatusForbidden},
+		{"viewer CANNOT DELETE tag rule", "viewer", "DELETE", "/tag-rules/1", http.StatusForbidden},
+
+		// Digest endpoints - read-only, generated by the worker
+		{"viewer can GET digests", "viewer", "GET", "/digests", http.StatusOK},
+		{"viewer can GET digest", "viewer", "GET", "/digests/1", http.StatusOK},
+
 		// Viewer role - cannot access other endpoints
 		{"viewer CANNOT access users", "viewer", "GET", "/users", http.StatusForbidden},
 		{"viewer CANNOT access admin", "viewer", "GET", "/admin", http.StatusForbidden},
@@ -312,6 +332,79 @@ func TestAuthz_RoleBasedAuthorization(t *testing.T) {
 	}
 }
 
+// TestUserFromContext verifies that Authz exposes the JWT subject to handlers.
+func TestUserFromContext(t *testing.T) {
+	secret := "test-secret-key-at-least-32-characters-long-for-testing"
+	t.Setenv("JWT_SECRET", secret)
+
+	claims := jwt.MapClaims{
+		"sub":  "admin@example.com",
+		"role": "admin",
+		"exp":  time.Now().Add(1 * time.Hour).Unix(),
+	}
+	tokenString, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("Failed to create test token: %v", err)
+	}
+
+	var got string
+	middleware := Authz(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = UserFromContext(r.Context())
+	}))
+	req := httptest.NewRequest(http.MethodPost, "/articles/resummarize", nil)
+	req.Header.Set("Authorization", "Bearer "+tokenString)
+	middleware.ServeHTTP(httptest.NewRecorder(), req)
+
+	if got != "admin@example.com" {
+		t.Errorf("UserFromContext() = %q, want %q", got, "admin@example.com")
+	}
+	if u := UserFromContext(httptest.NewRequest(http.MethodGet, "/", nil).Context()); u != "" {
+		t.Errorf("UserFromContext() without auth = %q, want empty", u)
+	}
+}
+
+// TestWithUser verifies that WithUser stores the subject read by UserFromContext.
+func TestWithUser(t *testing.T) {
+	ctx := WithUser(context.Background(), "viewer@example.com")
+	if got := UserFromContext(ctx); got != "viewer@example.com" {
+		t.Errorf("UserFromContext() = %q, want %q", got, "viewer@example.com")
+	}
+}
+
+// TestAuthz_ViewerUserPaths verifies that viewers may write their own state under /me
+// and receive their subject in the context.
+func TestAuthz_ViewerUserPaths(t *testing.T) {
+	secret := "test-secret-key-at-least-32-characters-long-for-testing"
+	t.Setenv("JWT_SECRET", secret)
+
+	claims := jwt.MapClaims{
+		"sub":  "viewer@example.com",
+		"role": "viewer",
+		"exp":  time.Now().Add(1 * time.Hour).Unix(),
+	}
+	tokenString, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("Failed to create test token: %v", err)
+	}
+
+	var got string
+	middleware := Authz(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = UserFromContext(r.Context())
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	req := httptest.NewRequest(http.MethodPut, "/me/read/articles/1", nil)
+	req.Header.Set("Authorization", "Bearer "+tokenString)
+	rr := httptest.NewRecorder()
+	middleware.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusNoContent)
+	}
+	if got != "viewer@example.com" {
+		t.Errorf("UserFromContext() = %q, want %q", got, "viewer@example.com")
+	}
+}
+
 // TestAuthz_ProtectedEndpoints_WithValidToken verifies that protected endpoints
 // are accessible with a valid JWT token.
 func TestAuthz_ProtectedEndpoints_WithValidToken(t *testing.T) {
@@ -480,6 +573,8 @@ func TestIsPublicEndpoint(t *testing.T) {
 		{"swagger doc", "/swagger/index.html", true},
 		{"swagger resource", "/swagger/swagger-ui.css", true},
 		{"auth token", "/auth/token", true},
+		{"shared reading list", "/shared/lists/abc", true},
+		{"article feed", "/feeds/articles.atom", true},
 
 		// Protected endpoints
 		{"articles list", "/articles", false},
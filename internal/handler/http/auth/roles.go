@@ -21,13 +21,19 @@ type Permission struct {
 	// AllowedPaths specifies which URL paths this role can access
 	// Supports wildcards: "/*" matches all paths, "/articles/*" matches all article endpoints
 	AllowedPaths []string
+
+	// UserPaths specifies URL paths where this role can use every method.
+	// They hold per-user state (e.g. read markers) that handlers scope to the
+	// JWT subject, so writing there only affects the caller.
+	UserPaths []string
 }
 
 // RolePermissions maps each role to its allowed permissions.
 //
 // Security Model:
 // - Admin: Full access to all endpoints and methods (including write operations)
-// - Viewer: Read-only access to specific resource endpoints (articles, sources, swagger)
+// - Viewer: Read-only access to specific resource endpoints (articles, sources, categories, tags, digests, stats, swagger)
+// - Both: Read-write access to their own per-user state under /me
 //
 // CORS Handling:
 // - OPTIONS method is included for both roles to support CORS preflight requests
@@ -48,8 +54,14 @@ var RolePermissions = map[string]Permission{
 			"/articles/*",
 			"/sources",
 			"/sources/*",
+			"/categories",
+			"/tags",
+			"/digests",
+			"/digests/*",
+			"/stats/*",
 			"/swagger/*",
 		},
+		UserPaths: []string{"/me/*"},
 	},
 }
 
@@ -61,11 +73,14 @@ var RolePermissions = map[string]Permission{
 // 2. Verify method is in AllowedMethods list
 // 3. Verify path matches at least one AllowedPaths pattern
 //
+// Paths matching UserPaths are allowed for every method.
+//
 // Example:
 //
 //	checkRolePermission("admin", "POST", "/articles")     // true
 //	checkRolePermission("viewer", "GET", "/articles/1")   // true
 //	checkRolePermission("viewer", "POST", "/articles")    // false (method not allowed)
+//	checkRolePermission("viewer", "PUT", "/me/read/articles/1") // true (user path)
 //	checkRolePermission("viewer", "GET", "/users")        // false (path not allowed)
 //	checkRolePermission("", "GET", "/articles")           // false (empty role)
 //	checkRolePermission("unknown", "GET", "/articles")    // false (role doesn't exist)
@@ -81,6 +96,11 @@ func checkRolePermission(role, method, path string) bool {
 		return false
 	}
 
+	// Per-user paths accept every method
+	if matchesPathPattern(path, perm.UserPaths) {
+		return true
+	}
+
 	// Check if method is allowed
 	methodAllowed := false
 	for _, allowedMethod := range perm.AllowedMethods {
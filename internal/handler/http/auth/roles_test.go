@@ -109,6 +109,85 @@ func TestCheckRolePermission_Viewer(t *testing.T) {
 			path:   "/sources",
 			want:   true,
 		},
+		{
+			name:   "viewer can GET /tags",
+			method: "GET",
+			path:   "/tags",
+			want:   true,
+		},
+		{
+			name:   "viewer can GET /digests",
+			method: "GET",
+			path:   "/digests",
+			want:   true,
+		},
+		{
+			name:   "viewer can GET /digests/1",
+			method: "GET",
+			path:   "/digests/1",
+			want:   true,
+		},
+		{
+			name:   "viewer can GET /stats/sources",
+			method: "GET",
+			path:   "/stats/sources",
+			want:   true,
+		},
+		{
+			name:   "viewer can GET /categories",
+			method: "GET",
+			path:   "/categories",
+			want:   true,
+		},
+		{
+			name:   "viewer cannot PUT /sources/1/categories",
+			method: "PUT",
+			path:   "/sources/1/categories",
+			want:   false,
+		},
+		{
+			name:   "viewer cannot POST /digests",
+			method: "POST",
+			path:   "/digests",
+			want:   false,
+		},
+		{
+			name:   "viewer cannot GET /tag-rules",
+			method: "GET",
+			path:   "/tag-rules",
+			want:   false,
+		},
+		// Per-user state under /me
+		{
+			name:   "viewer can GET /me/catchup",
+			method: "GET",
+			path:   "/me/catchup",
+			want:   true,
+		},
+		{
+			name:   "viewer can PUT /me/read/articles/1",
+			method: "PUT",
+			path:   "/me/read/articles/1",
+			want:   true,
+		},
+		{
+			name:   "viewer can DELETE /me/read/articles/1",
+			method: "DELETE",
+			path:   "/me/read/articles/1",
+			want:   true,
+		},
+		{
+			name:   "viewer can POST /me/read",
+			method: "POST",
+			path:   "/me/read",
+			want:   true,
+		},
+		{
+			name:   "viewer cannot POST /meta (not a user path)",
+			method: "POST",
+			path:   "/meta",
+			want:   false,
+		},
 		{
 			name:   "viewer can GET /sources/1",
 			method: "GET",
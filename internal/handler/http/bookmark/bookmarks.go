@@ -0,0 +1,129 @@
+package bookmark
+
+import (
+	"errors"
+	"net/http"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/auth"
+	"catchup-feed/internal/handler/http/pathutil"
+	"catchup-feed/internal/handler/http/respond"
+	bookmarkUC "catchup-feed/internal/usecase/bookmark"
+)
+
+type ListBookmarksHandler struct {
+	Svc           bookmarkUC.Service
+	PaginationCfg pagination.Config
+}
+
+// ServeHTTP ブックマーク一覧取得
+// @Summary      ブックマーク一覧取得（ページネーション対応）
+// @Description  認証ユーザー（JWT の sub）がブックマークした記事を、ブックマークした日時の新しい順に取得します
+// @Tags         bookmarks
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page   query    int  false  "ページ番号 (1-based)" default(1) minimum(1)
+// @Param        limit  query    int  false  "1ページあたりの件数" default(20) minimum(1) maximum(100)
+// @Success      200 {object} pagination.Response[BookmarkDTO] "ページネーション付きブックマーク一覧"
+// @Failure      400 {string} string "Invalid query parameters"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/bookmarks [get]
+func (h ListBookmarksHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	params, err := pagination.ParseQueryParams(r, h.PaginationCfg)
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if params.Keyset {
+		respond.SafeError(w, http.StatusBadRequest,
+			errors.New("invalid query parameter: cursor pagination is not supported for bookmarks"))
+		return
+	}
+
+	result, err := h.Svc.ListBookmarks(r.Context(), auth.UserFromContext(r.Context()), params)
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+
+	respond.JSON(w, http.StatusOK, bookmarksResponse.Map(r.Context(), result))
+}
+
+type AddBookmarkHandler struct{ Svc bookmarkUC.Service }
+
+// ServeHTTP 記事をブックマークする
+// @Summary      記事をブックマークする
+// @Description  認証ユーザー（JWT の sub）について、指定された記事をブックマークします。ブックマーク済みの記事に対しては何もしません
+// @Tags         bookmarks
+// @Security     BearerAuth
+// @Param        id path int true "記事ID"
+// @Success      204 "No Content"
+// @Failure      400 {string} string "Bad request - invalid article ID"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      404 {string} string "Not found - article not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/bookmarks/{id} [put]
+func (h AddBookmarkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(r.URL.Path, "/me/bookmarks/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.Svc.AddBookmark(r.Context(), auth.UserFromContext(r.Context()), id); err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type RemoveBookmarkHandler struct{ Svc bookmarkUC.Service }
+
+// ServeHTTP ブックマークを外す
+// @Summary      ブックマークを外す
+// @Description  認証ユーザー（JWT の sub）について、指定された記事のブックマークを外します
+// @Tags         bookmarks
+// @Security     BearerAuth
+// @Param        id path int true "記事ID"
+// @Success      204 "No Content"
+// @Failure      400 {string} string "Bad request - invalid article ID"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      404 {string} string "Not found - article not bookmarked"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/bookmarks/{id} [delete]
+func (h RemoveBookmarkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(r.URL.Path, "/me/bookmarks/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.Svc.RemoveBookmark(r.Context(), auth.UserFromContext(r.Context()), id); err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// errorStatus maps bookmark and reading list use case errors to HTTP status codes.
+func errorStatus(err error) int {
+	var ve *entity.ValidationError
+	switch {
+	case errors.Is(err, bookmarkUC.ErrUserRequired):
+		return http.StatusUnauthorized
+	case errors.As(err, &ve),
+		errors.Is(err, bookmarkUC.ErrInvalidArticleID),
+		errors.Is(err, bookmarkUC.ErrInvalidListID),
+		errors.Is(err, bookmarkUC.ErrInvalidOrder):
+		return http.StatusBadRequest
+	case errors.Is(err, bookmarkUC.ErrArticleNotFound),
+		errors.Is(err, bookmarkUC.ErrBookmarkNotFound),
+		errors.Is(err, bookmarkUC.ErrListNotFound),
+		errors.Is(err, bookmarkUC.ErrItemNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,131 @@
+// Package bookmark provides HTTP handlers for the bookmarks and reading lists of
+// the current user, and the read-only view of shared reading lists.
+package bookmark
+
+import (
+	"context"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/apiversion"
+	"catchup-feed/internal/repository"
+	bookmarkUC "catchup-feed/internal/usecase/bookmark"
+)
+
+// sharedListPath is the path prefix of shared reading lists (see auth.PublicEndpoints).
+const sharedListPath = "/shared/lists/"
+
+// ArticleDTO represents a bookmarked or listed article.
+type ArticleDTO struct {
+	ID          int64     `json:"id" example:"42"`
+	SourceID    int64     `json:"source_id" example:"1"`
+	SourceName  string    `json:"source_name" example:"Go Blog"`
+	Title       string    `json:"title" example:"Go 1.25 is released"`
+	URL         string    `json:"url" example:"https://go.dev/blog/go1.25"`
+	Summary     string    `json:"summary" example:"Go 1.25 の主な変更点を紹介しています。"`
+	PublishedAt time.Time `json:"published_at" example:"2025-11-14T18:00:00Z"`
+}
+
+// BookmarkDTO represents a bookmarked article.
+type BookmarkDTO struct {
+	ArticleDTO
+	BookmarkedAt time.Time `json:"bookmarked_at" example:"2025-11-15T09:00:00Z"`
+}
+
+// ListDTO represents a reading list of the current user.
+type ListDTO struct {
+	ID          int64  `json:"id" example:"1"`
+	Name        string `json:"name" example:"週次ミーティングで読む"`
+	Description string `json:"description" example:"金曜のミーティングで共有する記事"`
+	ItemCount   int    `json:"item_count" example:"3"`
+	// ShareToken is present while the list is shared.
+	ShareToken string    `json:"share_token,omitempty" example:"q3Jx0bW8c2Jm9hZkXr1YV5n7uTzA4eKpL6sD2fGhQwE"`
+	CreatedAt  time.Time `json:"created_at" example:"2025-11-01T12:00:00Z"`
+	UpdatedAt  time.Time `json:"updated_at" example:"2025-11-01T12:00:00Z"`
+}
+
+// ItemDTO represents an article in a reading list.
+type ItemDTO struct {
+	Position int        `json:"position" example:"1"`
+	Note     string     `json:"note" example:"パフォーマンス改善の節を読む"`
+	AddedAt  time.Time  `json:"added_at" example:"2025-11-15T09:00:00Z"`
+	Article  ArticleDTO `json:"article"`
+}
+
+// ListDetailDTO represents a reading list of the current user with its items.
+type ListDetailDTO struct {
+	ListDTO
+	Items []ItemDTO `json:"items"`
+}
+
+// SharedListDTO represents a shared reading list. It does not reveal the owner.
+type SharedListDTO struct {
+	Name        string    `json:"name" example:"週次ミーティングで読む"`
+	Description string    `json:"description" example:"金曜のミーティングで共有する記事"`
+	ItemCount   int       `json:"item_count" example:"3"`
+	Items       []ItemDTO `json:"items"`
+}
+
+// ShareDTO represents the share token of a reading list.
+type ShareDTO struct {
+	ShareToken string `json:"share_token" example:"q3Jx0bW8c2Jm9hZkXr1YV5n7uTzA4eKpL6sD2fGhQwE"`
+	// Path is the path of the read-only view, accessible without login.
+	Path string `json:"path" example:"/v1/shared/lists/q3Jx0bW8c2Jm9hZkXr1YV5n7uTzA4eKpL6sD2fGhQwE"`
+}
+
+// sharedListURLPath returns the path of the read-only view of a shared list under
+// the API version of the request, so a link shared from a deprecated unversioned
+// path keeps working after the unversioned paths are removed.
+func sharedListURLPath(ctx context.Context, token string) string {
+	return apiversion.FromContext(ctx).Prefix() + sharedListPath + token
+}
+
+func toArticleDTO(a repository.ArticleWithSource) ArticleDTO {
+	return ArticleDTO{
+		ID:          a.Article.ID,
+		SourceID:    a.Article.SourceID,
+		SourceName:  a.SourceName,
+		Title:       a.Article.Title,
+		URL:         a.Article.URL,
+		Summary:     a.Article.Summary,
+		PublishedAt: a.Article.PublishedAt,
+	}
+}
+
+func toListDTO(l *entity.ReadingList) ListDTO {
+	return ListDTO{
+		ID:          l.ID,
+		Name:        l.Name,
+		Description: l.Description,
+		ItemCount:   l.ItemCount,
+		ShareToken:  l.ShareToken,
+		CreatedAt:   l.CreatedAt,
+		UpdatedAt:   l.UpdatedAt,
+	}
+}
+
+func toItemDTOs(entries []repository.ReadingListEntry) []ItemDTO {
+	out := make([]ItemDTO, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, ItemDTO{
+			Position: e.Item.Position,
+			Note:     e.Item.Note,
+			AddedAt:  e.Item.AddedAt,
+			Article:  toArticleDTO(e.ArticleWithSource),
+		})
+	}
+	return out
+}
+
+func toListDetailDTO(l *bookmarkUC.ListWithItems) ListDetailDTO {
+	return ListDetailDTO{ListDTO: toListDTO(l.List), Items: toItemDTOs(l.Items)}
+}
+
+func toSharedListDTO(l *bookmarkUC.ListWithItems) SharedListDTO {
+	return SharedListDTO{
+		Name:        l.List.Name,
+		Description: l.List.Description,
+		ItemCount:   l.List.ItemCount,
+		Items:       toItemDTOs(l.Items),
+	}
+}
@@ -0,0 +1,503 @@
+package bookmark_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/apiversion"
+	"catchup-feed/internal/handler/http/auth"
+	"catchup-feed/internal/handler/http/bookmark"
+	"catchup-feed/internal/repository"
+	bookmarkUC "catchup-feed/internal/usecase/bookmark"
+)
+
+/* ───────── モック ───────── */
+
+type stubBookmarkRepo struct {
+	found     bool
+	bookmarks []repository.BookmarkedArticle
+	err       error
+	gotUser   string
+	gotID     int64
+	gotOffset int
+	gotLimit  int
+}
+
+func (s *stubBookmarkRepo) AddBookmark(_ context.Context, userID string, articleID int64) (bool, error) {
+	s.gotUser, s.gotID = userID, articleID
+	return s.found, s.err
+}
+func (s *stubBookmarkRepo) RemoveBookmark(_ context.Context, userID string, articleID int64) (bool, error) {
+	s.gotUser, s.gotID = userID, articleID
+	return s.found, s.err
+}
+func (s *stubBookmarkRepo) ListBookmarks(_ context.Context, userID string, offset, limit int) ([]repository.BookmarkedArticle, error) {
+	s.gotUser, s.gotOffset, s.gotLimit = userID, offset, limit
+	return s.bookmarks, s.err
+}
+func (s *stubBookmarkRepo) CountBookmarks(_ context.Context, _ string) (int64, error) {
+	return int64(len(s.bookmarks)), s.err
+}
+
+// stubListRepo holds a single reading list (ID 1) owned by "alice".
+type stubListRepo struct {
+	list       *entity.ReadingList
+	items      []repository.ReadingListEntry
+	itemFound  bool
+	err        error
+	gotToken   string
+	gotNote    string
+	gotOrder   []int64
+	gotArticle int64
+}
+
+func newStubListRepo() *stubListRepo {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	return &stubListRepo{
+		list: &entity.ReadingList{ID: 1, UserID: "alice", Name: "Weekly", CreatedAt: now, UpdatedAt: now},
+		items: []repository.ReadingListEntry{
+			{
+				Item: entity.ReadingListItem{ListID: 1, ArticleID: 30, Position: 1, Note: "read first", AddedAt: now},
+				ArticleWithSource: repository.ArticleWithSource{
+					Article:    &entity.Article{ID: 30, SourceID: 2, Title: "Go 1.25", URL: "https://go.dev/blog/go1.25", PublishedAt: now},
+					SourceName: "Go Blog",
+				},
+			},
+			{
+				Item: entity.ReadingListItem{ListID: 1, ArticleID: 31, Position: 2, AddedAt: now},
+				ArticleWithSource: repository.ArticleWithSource{
+					Article:    &entity.Article{ID: 31, SourceID: 2, Title: "Go 1.26", URL: "https://go.dev/blog/go1.26", PublishedAt: now},
+					SourceName: "Go Blog",
+				},
+			},
+		},
+		itemFound: true,
+	}
+}
+
+func (s *stubListRepo) owned(userID string, id int64) bool {
+	return s.list != nil && s.list.UserID == userID && s.list.ID == id
+}
+
+func (s *stubListRepo) ListLists(_ context.Context, userID string) ([]*entity.ReadingList, error) {
+	if s.err != nil || s.list == nil || s.list.UserID != userID {
+		return nil, s.err
+	}
+	return []*entity.ReadingList{s.list}, nil
+}
+func (s *stubListRepo) GetList(_ context.Context, userID string, id int64) (*entity.ReadingList, error) {
+	if s.err != nil || !s.owned(userID, id) {
+		return nil, s.err
+	}
+	l := *s.list
+	return &l, nil
+}
+func (s *stubListRepo) GetListByShareToken(_ context.Context, token string) (*entity.ReadingList, error) {
+	if s.list == nil || s.list.ShareToken == "" || s.list.ShareToken != token {
+		return nil, s.err
+	}
+	l := *s.list
+	return &l, nil
+}
+func (s *stubListRepo) CreateList(_ context.Context, list *entity.ReadingList) error {
+	list.ID = 2
+	return s.err
+}
+func (s *stubListRepo) UpdateList(_ context.Context, list *entity.ReadingList) (bool, error) {
+	return s.owned(list.UserID, list.ID), s.err
+}
+func (s *stubListRepo) DeleteList(_ context.Context, userID string, id int64) (bool, error) {
+	return s.owned(userID, id), s.err
+}
+func (s *stubListRepo) SetShareToken(_ context.Context, userID string, id int64, token string) (bool, error) {
+	s.gotToken = token
+	return s.owned(userID, id), s.err
+}
+func (s *stubListRepo) ListItems(_ context.Context, _ int64) ([]repository.ReadingListEntry, error) {
+	return s.items, s.err
+}
+func (s *stubListRepo) AddItem(_ context.Context, item *entity.ReadingListItem) (bool, error) {
+	s.gotArticle, s.gotNote = item.ArticleID, item.Note
+	item.Position = 3
+	return s.itemFound, s.err
+}
+func (s *stubListRepo) UpdateItemNote(_ context.Context, _, articleID int64, note string) (bool, error) {
+	s.gotArticle, s.gotNote = articleID, note
+	return s.itemFound, s.err
+}
+func (s *stubListRepo) RemoveItem(_ context.Context, _, articleID int64) (bool, error) {
+	s.gotArticle = articleID
+	return s.itemFound, s.err
+}
+func (s *stubListRepo) ReorderItems(_ context.Context, _ int64, articleIDs []int64) error {
+	s.gotOrder = articleIDs
+	return s.err
+}
+
+// newMux registers the bookmark routes the way cmd/api does.
+func newMux(repo *stubBookmarkRepo, listRepo *stubListRepo) *http.ServeMux {
+	svc := bookmarkUC.Service{Repo: repo, ListRepo: listRepo}
+	mux := http.NewServeMux()
+	bookmark.Register(mux, svc, pagination.DefaultConfig())
+	bookmark.RegisterShared(mux, svc)
+	return mux
+}
+
+// serve runs handler for a request made by user ("" for an unauthenticated request).
+func serve(handler http.Handler, method, target, body, user string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if user != "" {
+		req = req.WithContext(auth.WithUser(req.Context(), user))
+	}
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	return rr
+}
+
+/* ───────── ブックマーク ───────── */
+
+func TestAddBookmarkHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		repo     *stubBookmarkRepo
+		path     string
+		user     string
+		wantCode int
+	}{
+		{name: "bookmarked", repo: &stubBookmarkRepo{found: true}, path: "/me/bookmarks/7", user: "alice", wantCode: http.StatusNoContent},
+		{name: "article not found", repo: &stubBookmarkRepo{}, path: "/me/bookmarks/7", user: "alice", wantCode: http.StatusNotFound},
+		{name: "invalid id", repo: &stubBookmarkRepo{found: true}, path: "/me/bookmarks/abc", user: "alice", wantCode: http.StatusBadRequest},
+		{name: "no user", repo: &stubBookmarkRepo{found: true}, path: "/me/bookmarks/7", wantCode: http.StatusUnauthorized},
+		{name: "repository error", repo: &stubBookmarkRepo{err: errors.New("db down")}, path: "/me/bookmarks/7", user: "alice", wantCode: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := serve(newMux(tt.repo, newStubListRepo()), http.MethodPut, tt.path, "", tt.user)
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d: %s", rr.Code, tt.wantCode, rr.Body.String())
+			}
+			if rr.Code == http.StatusNoContent && (tt.repo.gotUser != "alice" || tt.repo.gotID != 7) {
+				t.Errorf("repo called with (%q, %d)", tt.repo.gotUser, tt.repo.gotID)
+			}
+		})
+	}
+}
+
+func TestRemoveBookmarkHandler(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		found    bool
+		wantCode int
+	}{
+		{name: "removed", found: true, wantCode: http.StatusNoContent},
+		{name: "not bookmarked", found: false, wantCode: http.StatusNotFound},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &stubBookmarkRepo{found: tt.found}
+			rr := serve(newMux(repo, newStubListRepo()), http.MethodDelete, "/me/bookmarks/7", "", "alice")
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d: %s", rr.Code, tt.wantCode, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestListBookmarksHandler(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &stubBookmarkRepo{bookmarks: []repository.BookmarkedArticle{{
+		ArticleWithSource: repository.ArticleWithSource{
+			Article:    &entity.Article{ID: 30, SourceID: 2, Title: "Go 1.25", URL: "https://go.dev/blog/go1.25", PublishedAt: now},
+			SourceName: "Go Blog",
+		},
+		BookmarkedAt: now,
+	}}}
+
+	rr := serve(newMux(repo, newStubListRepo()), http.MethodGet, "/me/bookmarks?page=2&limit=10", "", "alice")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rr.Code, http.StatusOK, rr.Body.String())
+	}
+	if repo.gotUser != "alice" || repo.gotOffset != 10 || repo.gotLimit != 10 {
+		t.Errorf("repo called with (%q, %d, %d)", repo.gotUser, repo.gotOffset, repo.gotLimit)
+	}
+	var resp pagination.Response[bookmark.BookmarkDTO]
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].ID != 30 || resp.Data[0].SourceName != "Go Blog" || !resp.Data[0].BookmarkedAt.Equal(now) {
+		t.Errorf("data = %+v", resp.Data)
+	}
+	if resp.Pagination.Total != 1 || resp.Pagination.Page != 2 {
+		t.Errorf("pagination = %+v", resp.Pagination)
+	}
+}
+
+func TestListBookmarksHandler_RejectsCursor(t *testing.T) {
+	rr := serve(newMux(&stubBookmarkRepo{}, newStubListRepo()), http.MethodGet, "/me/bookmarks?cursor=abc", "", "alice")
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d: %s", rr.Code, http.StatusBadRequest, rr.Body.String())
+	}
+}
+
+/* ───────── リーディングリスト ───────── */
+
+func TestCreateListHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		user     string
+		wantCode int
+	}{
+		{name: "created", body: `{"name":"  Later  ","description":"to read"}`, user: "alice", wantCode: http.StatusCreated},
+		{name: "empty name", body: `{"name":" "}`, user: "alice", wantCode: http.StatusBadRequest},
+		{name: "invalid json", body: `{"name":`, user: "alice", wantCode: http.StatusBadRequest},
+		{name: "no user", body: `{"name":"Later"}`, wantCode: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := serve(newMux(&stubBookmarkRepo{}, newStubListRepo()), http.MethodPost, "/me/lists", tt.body, tt.user)
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d: %s", rr.Code, tt.wantCode, rr.Body.String())
+			}
+			if rr.Code != http.StatusCreated {
+				return
+			}
+			var resp bookmark.ListDTO
+			if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.ID != 2 || resp.Name != "Later" || resp.Description != "to read" || resp.ShareToken != "" {
+				t.Errorf("response = %+v", resp)
+			}
+		})
+	}
+}
+
+func TestListListsHandler(t *testing.T) {
+	rr := serve(newMux(&stubBookmarkRepo{}, newStubListRepo()), http.MethodGet, "/me/lists", "", "alice")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rr.Code, http.StatusOK, rr.Body.String())
+	}
+	var resp []bookmark.ListDTO
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp) != 1 || resp[0].ID != 1 || resp[0].Name != "Weekly" {
+		t.Errorf("response = %+v", resp)
+	}
+}
+
+func TestGetListHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		user     string
+		wantCode int
+	}{
+		{name: "found", path: "/me/lists/1", user: "alice", wantCode: http.StatusOK},
+		{name: "other user", path: "/me/lists/1", user: "bob", wantCode: http.StatusNotFound},
+		{name: "unknown list", path: "/me/lists/9", user: "alice", wantCode: http.StatusNotFound},
+		{name: "invalid id", path: "/me/lists/abc", user: "alice", wantCode: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := serve(newMux(&stubBookmarkRepo{}, newStubListRepo()), http.MethodGet, tt.path, "", tt.user)
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d: %s", rr.Code, tt.wantCode, rr.Body.String())
+			}
+			if rr.Code != http.StatusOK {
+				return
+			}
+			var resp bookmark.ListDetailDTO
+			if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.ItemCount != 2 || len(resp.Items) != 2 {
+				t.Fatalf("response = %+v", resp)
+			}
+			first := resp.Items[0]
+			if first.Position != 1 || first.Note != "read first" || first.Article.ID != 30 || first.Article.SourceName != "Go Blog" {
+				t.Errorf("first item = %+v", first)
+			}
+		})
+	}
+}
+
+func TestUpdateAndDeleteListHandlers(t *testing.T) {
+	mux := newMux(&stubBookmarkRepo{}, newStubListRepo())
+
+	rr := serve(mux, http.MethodPut, "/me/lists/1", `{"name":"Renamed"}`, "alice")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("update status = %d, want %d: %s", rr.Code, http.StatusOK, rr.Body.String())
+	}
+	var resp bookmark.ListDTO
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Name != "Renamed" {
+		t.Errorf("name = %q, want Renamed", resp.Name)
+	}
+
+	if rr := serve(mux, http.MethodPut, "/me/lists/1", `{"name":"Renamed"}`, "bob"); rr.Code != http.StatusNotFound {
+		t.Errorf("update by other user status = %d, want %d", rr.Code, http.StatusNotFound)
+	}
+	if rr := serve(mux, http.MethodDelete, "/me/lists/1", "", "bob"); rr.Code != http.StatusNotFound {
+		t.Errorf("delete by other user status = %d, want %d", rr.Code, http.StatusNotFound)
+	}
+	if rr := serve(mux, http.MethodDelete, "/me/lists/1", "", "alice"); rr.Code != http.StatusNoContent {
+		t.Errorf("delete status = %d, want %d", rr.Code, http.StatusNoContent)
+	}
+}
+
+func TestAddItemHandler(t *testing.T) {
+	tests := []struct {
+		name      string
+		itemFound bool
+		body      string
+		wantCode  int
+	}{
+		{name: "added", itemFound: true, body: `{"article_id":32,"note":" skim "}`, wantCode: http.StatusCreated},
+		{name: "article not found", itemFound: false, body: `{"article_id":32}`, wantCode: http.StatusNotFound},
+		{name: "missing article", itemFound: true, body: `{"note":"x"}`, wantCode: http.StatusBadRequest},
+		{name: "note too long", itemFound: true, body: `{"article_id":32,"note":"` + strings.Repeat("a", entity.MaxReadingListNoteLength+1) + `"}`, wantCode: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listRepo := newStubListRepo()
+			listRepo.itemFound = tt.itemFound
+
+			rr := serve(newMux(&stubBookmarkRepo{}, listRepo), http.MethodPost, "/me/lists/1/items", tt.body, "alice")
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d: %s", rr.Code, tt.wantCode, rr.Body.String())
+			}
+			if rr.Code != http.StatusCreated {
+				return
+			}
+			var resp bookmark.ItemDTO
+			if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.Position != 3 || resp.Note != "skim" || resp.Article.ID != 32 {
+				t.Errorf("response = %+v", resp)
+			}
+		})
+	}
+}
+
+func TestUpdateAndRemoveItemHandlers(t *testing.T) {
+	listRepo := newStubListRepo()
+	mux := newMux(&stubBookmarkRepo{}, listRepo)
+
+	rr := serve(mux, http.MethodPut, "/me/lists/1/items/31", `{"note":"later"}`, "alice")
+	if rr.Code != http.StatusNoContent {
+		t.Fatalf("update status = %d, want %d: %s", rr.Code, http.StatusNoContent, rr.Body.String())
+	}
+	if listRepo.gotArticle != 31 || listRepo.gotNote != "later" {
+		t.Errorf("repo called with (%d, %q)", listRepo.gotArticle, listRepo.gotNote)
+	}
+
+	if rr := serve(mux, http.MethodDelete, "/me/lists/1/items/abc", "", "alice"); rr.Code != http.StatusBadRequest {
+		t.Errorf("remove with invalid id status = %d, want %d", rr.Code, http.StatusBadRequest)
+	}
+
+	listRepo.itemFound = false
+	if rr := serve(mux, http.MethodDelete, "/me/lists/1/items/99", "", "alice"); rr.Code != http.StatusNotFound {
+		t.Errorf("remove missing item status = %d, want %d", rr.Code, http.StatusNotFound)
+	}
+}
+
+func TestReorderItemsHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		wantCode int
+	}{
+		{name: "reordered", body: `{"article_ids":[31,30]}`, wantCode: http.StatusNoContent},
+		{name: "missing article", body: `{"article_ids":[31]}`, wantCode: http.StatusBadRequest},
+		{name: "unknown article", body: `{"article_ids":[31,99]}`, wantCode: http.StatusBadRequest},
+		{name: "duplicate article", body: `{"article_ids":[31,31]}`, wantCode: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listRepo := newStubListRepo()
+			rr := serve(newMux(&stubBookmarkRepo{}, listRepo), http.MethodPut, "/me/lists/1/order", tt.body, "alice")
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d: %s", rr.Code, tt.wantCode, rr.Body.String())
+			}
+			if rr.Code == http.StatusNoContent && (len(listRepo.gotOrder) != 2 || listRepo.gotOrder[0] != 31) {
+				t.Errorf("order = %v, want [31 30]", listRepo.gotOrder)
+			}
+		})
+	}
+}
+
+/* ───────── 共有 ───────── */
+
+func TestShareAndSharedListHandlers(t *testing.T) {
+	listRepo := newStubListRepo()
+	mux := newMux(&stubBookmarkRepo{}, listRepo)
+
+	rr := serve(mux, http.MethodPost, "/me/lists/1/share", "", "alice")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("share status = %d, want %d: %s", rr.Code, http.StatusOK, rr.Body.String())
+	}
+	var share bookmark.ShareDTO
+	if err := json.NewDecoder(rr.Body).Decode(&share); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if share.ShareToken == "" || share.ShareToken != listRepo.gotToken || share.Path != "/v1/shared/lists/"+share.ShareToken {
+		t.Fatalf("share = %+v, stored token %q", share, listRepo.gotToken)
+	}
+	listRepo.list.ShareToken = share.ShareToken
+
+	// 共有リストはログインなしで閲覧でき、所有者は含まれない
+	rr = serve(apiversion.Handler(mux, apiversion.DefaultConfig()), http.MethodGet, share.Path, "", "")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("shared status = %d, want %d: %s", rr.Code, http.StatusOK, rr.Body.String())
+	}
+	if strings.Contains(rr.Body.String(), "alice") {
+		t.Errorf("shared list reveals the owner: %s", rr.Body.String())
+	}
+	var shared bookmark.SharedListDTO
+	if err := json.NewDecoder(rr.Body).Decode(&shared); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if shared.Name != "Weekly" || shared.ItemCount != 2 || len(shared.Items) != 2 {
+		t.Errorf("shared = %+v", shared)
+	}
+
+	if rr := serve(mux, http.MethodGet, "/shared/lists/wrong-token", "", ""); rr.Code != http.StatusNotFound {
+		t.Errorf("wrong token status = %d, want %d", rr.Code, http.StatusNotFound)
+	}
+	if rr := serve(mux, http.MethodPost, "/me/lists/1/share", "", "bob"); rr.Code != http.StatusNotFound {
+		t.Errorf("share by other user status = %d, want %d", rr.Code, http.StatusNotFound)
+	}
+
+	rr = serve(mux, http.MethodDelete, "/me/lists/1/share", "", "alice")
+	if rr.Code != http.StatusNoContent {
+		t.Fatalf("unshare status = %d, want %d: %s", rr.Code, http.StatusNoContent, rr.Body.String())
+	}
+	if listRepo.gotToken != "" {
+		t.Errorf("unshare stored token %q, want empty", listRepo.gotToken)
+	}
+}
+
+func TestSharedListHandler_RepositoryError(t *testing.T) {
+	listRepo := newStubListRepo()
+	listRepo.err = errors.New("db down")
+
+	rr := serve(newMux(&stubBookmarkRepo{}, listRepo), http.MethodGet, "/shared/lists/token", "", "")
+	if rr.Code != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusInternalServerError)
+	}
+	if strings.Contains(rr.Body.String(), "db down") {
+		t.Errorf("internal error leaked: %s", rr.Body.String())
+	}
+}
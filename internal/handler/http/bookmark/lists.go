@@ -0,0 +1,389 @@
+package bookmark
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"catchup-feed/internal/handler/http/auth"
+	"catchup-feed/internal/handler/http/pathutil"
+	"catchup-feed/internal/handler/http/respond"
+	bookmarkUC "catchup-feed/internal/usecase/bookmark"
+)
+
+// listInput is the request body of creating and updating a reading list.
+type listInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type ListListsHandler struct{ Svc bookmarkUC.Service }
+
+// ServeHTTP リーディングリスト一覧取得
+// @Summary      リーディングリスト一覧取得
+// @Description  認証ユーザー（JWT の sub）のリーディングリストを作成順に取得します（記事は含みません）
+// @Tags         reading-lists
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {array} ListDTO "リーディングリスト一覧"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/lists [get]
+func (h ListListsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	lists, err := h.Svc.ListLists(r.Context(), auth.UserFromContext(r.Context()))
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	out := make([]ListDTO, 0, len(lists))
+	for _, l := range lists {
+		out = append(out, toListDTO(l))
+	}
+	respond.JSON(w, http.StatusOK, out)
+}
+
+type CreateListHandler struct{ Svc bookmarkUC.Service }
+
+// ServeHTTP リーディングリスト作成
+// @Summary      リーディングリスト作成
+// @Description  認証ユーザー（JWT の sub）のリーディングリストを作成します
+// @Tags         reading-lists
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        list body object true "リスト（name, description）"
+// @Success      201 {object} ListDTO "作成されたリスト"
+// @Failure      400 {string} string "Bad request - invalid list"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/lists [post]
+func (h CreateListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req listInput
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	list, err := h.Svc.CreateList(r.Context(), auth.UserFromContext(r.Context()),
+		bookmarkUC.ListInput{Name: req.Name, Description: req.Description})
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusCreated, toListDTO(list))
+}
+
+type GetListHandler struct{ Svc bookmarkUC.Service }
+
+// ServeHTTP リーディングリスト取得
+// @Summary      リーディングリスト取得
+// @Description  認証ユーザー（JWT の sub）のリーディングリストを、記事とメモを並び順で含めて取得します
+// @Tags         reading-lists
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path int true "リストID"
+// @Success      200 {object} ListDetailDTO "リストと記事"
+// @Failure      400 {string} string "Bad request - invalid list ID"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      404 {string} string "Not found - list not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/lists/{id} [get]
+func (h GetListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(r.URL.Path, "/me/lists/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	list, err := h.Svc.GetList(r.Context(), auth.UserFromContext(r.Context()), id)
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, listDetailResponse.Map(r.Context(), list))
+}
+
+type UpdateListHandler struct{ Svc bookmarkUC.Service }
+
+// ServeHTTP リーディングリスト更新
+// @Summary      リーディングリスト更新
+// @Description  認証ユーザー（JWT の sub）のリーディングリストの名前と説明を置き換えます
+// @Tags         reading-lists
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "リストID"
+// @Param        list body object true "リスト（name, description）"
+// @Success      200 {object} ListDTO "更新されたリスト"
+// @Failure      400 {string} string "Bad request - invalid list ID or list"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      404 {string} string "Not found - list not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/lists/{id} [put]
+func (h UpdateListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(r.URL.Path, "/me/lists/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+	var req listInput
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	list, err := h.Svc.UpdateList(r.Context(), auth.UserFromContext(r.Context()), id,
+		bookmarkUC.ListInput{Name: req.Name, Description: req.Description})
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, toListDTO(list))
+}
+
+type DeleteListHandler struct{ Svc bookmarkUC.Service }
+
+// ServeHTTP リーディングリスト削除
+// @Summary      リーディングリスト削除
+// @Description  認証ユーザー（JWT の sub）のリーディングリストを削除します。記事そのものは削除されません
+// @Tags         reading-lists
+// @Security     BearerAuth
+// @Param        id path int true "リストID"
+// @Success      204 "No Content"
+// @Failure      400 {string} string "Bad request - invalid list ID"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      404 {string} string "Not found - list not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/lists/{id} [delete]
+func (h DeleteListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(r.URL.Path, "/me/lists/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.Svc.DeleteList(r.Context(), auth.UserFromContext(r.Context()), id); err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type AddItemHandler struct{ Svc bookmarkUC.Service }
+
+// ServeHTTP リーディングリストに記事を追加
+// @Summary      リーディングリストに記事を追加
+// @Description  認証ユーザー（JWT の sub）のリーディングリストの末尾に記事を追加します。追加済みの記事では位置を変えずにメモだけを置き換えます
+// @Tags         reading-lists
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "リストID"
+// @Param        item body object true "記事（article_id, note）"
+// @Success      201 {object} ItemDTO "追加された記事（article は article_id のみ）"
+// @Failure      400 {string} string "Bad request - invalid list ID, article ID or note"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      404 {string} string "Not found - list or article not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/lists/{id}/items [post]
+func (h AddItemHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	listID, err := pathutil.ExtractID(strings.TrimSuffix(r.URL.Path, "/items"), "/me/lists/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+	var req struct {
+		ArticleID int64  `json:"article_id"`
+		Note      string `json:"note"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	item, err := h.Svc.AddItem(r.Context(), auth.UserFromContext(r.Context()), listID, req.ArticleID, req.Note)
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusCreated, itemResponse.Map(r.Context(), item))
+}
+
+type UpdateItemHandler struct{ Svc bookmarkUC.Service }
+
+// ServeHTTP リーディングリストの記事のメモを更新
+// @Summary      リーディングリストの記事のメモを更新
+// @Description  認証ユーザー（JWT の sub）のリーディングリストにある記事のメモを置き換えます
+// @Tags         reading-lists
+// @Security     BearerAuth
+// @Accept       json
+// @Param        id path int true "リストID"
+// @Param        article_id path int true "記事ID"
+// @Param        item body object true "メモ（note）"
+// @Success      204 "No Content"
+// @Failure      400 {string} string "Bad request - invalid ID or note"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      404 {string} string "Not found - list not found or article not in list"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/lists/{id}/items/{article_id} [put]
+func (h UpdateItemHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	listID, articleID, err := listItemIDs(r.URL.Path)
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+	var req struct {
+		Note string `json:"note"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.Svc.UpdateItemNote(r.Context(), auth.UserFromContext(r.Context()), listID, articleID, req.Note); err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type RemoveItemHandler struct{ Svc bookmarkUC.Service }
+
+// ServeHTTP リーディングリストから記事を削除
+// @Summary      リーディングリストから記事を削除
+// @Description  認証ユーザー（JWT の sub）のリーディングリストから記事を取り除きます
+// @Tags         reading-lists
+// @Security     BearerAuth
+// @Param        id path int true "リストID"
+// @Param        article_id path int true "記事ID"
+// @Success      204 "No Content"
+// @Failure      400 {string} string "Bad request - invalid ID"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      404 {string} string "Not found - list not found or article not in list"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/lists/{id}/items/{article_id} [delete]
+func (h RemoveItemHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	listID, articleID, err := listItemIDs(r.URL.Path)
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.Svc.RemoveItem(r.Context(), auth.UserFromContext(r.Context()), listID, articleID); err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type ReorderItemsHandler struct{ Svc bookmarkUC.Service }
+
+// ServeHTTP リーディングリストの並べ替え
+// @Summary      リーディングリストの並べ替え
+// @Description  認証ユーザー（JWT の sub）のリーディングリストの記事を article_ids の順に並べ替えます。article_ids にはリストのすべての記事を1回ずつ含める必要があります
+// @Tags         reading-lists
+// @Security     BearerAuth
+// @Accept       json
+// @Param        id path int true "リストID"
+// @Param        order body object true "新しい順序（article_ids）"
+// @Success      204 "No Content"
+// @Failure      400 {string} string "Bad request - invalid list ID or order"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      404 {string} string "Not found - list not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/lists/{id}/order [put]
+func (h ReorderItemsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	listID, err := pathutil.ExtractID(strings.TrimSuffix(r.URL.Path, "/order"), "/me/lists/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+	var req struct {
+		ArticleIDs []int64 `json:"article_ids"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.Svc.ReorderItems(r.Context(), auth.UserFromContext(r.Context()), listID, req.ArticleIDs); err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listItemIDs extracts the list and article IDs from /me/lists/{id}/items/{article_id}.
+func listItemIDs(path string) (int64, int64, error) {
+	listPart, articlePart, ok := strings.Cut(strings.TrimPrefix(path, "/me/lists/"), "/items/")
+	if !ok {
+		return 0, 0, pathutil.ErrInvalidID
+	}
+	listID, err := pathutil.ExtractID(listPart, "")
+	if err != nil {
+		return 0, 0, err
+	}
+	articleID, err := pathutil.ExtractID(articlePart, "")
+	if err != nil {
+		return 0, 0, err
+	}
+	return listID, articleID, nil
+}
+
+type ShareListHandler struct{ Svc bookmarkUC.Service }
+
+// ServeHTTP リーディングリストを共有
+// @Summary      リーディングリストを共有
+// @Description  認証ユーザー（JWT の sub）のリーディングリストに共有トークンを発行します。トークンを知っていれば誰でもログインせずに閲覧できます。共有済みのリストでは新しいトークンに置き換わり、以前のリンクは無効になります
+// @Tags         reading-lists
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path int true "リストID"
+// @Success      200 {object} ShareDTO "共有トークンと閲覧用パス"
+// @Failure      400 {string} string "Bad request - invalid list ID"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      404 {string} string "Not found - list not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/lists/{id}/share [post]
+func (h ShareListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(strings.TrimSuffix(r.URL.Path, "/share"), "/me/lists/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	token, err := h.Svc.ShareList(r.Context(), auth.UserFromContext(r.Context()), id)
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, ShareDTO{ShareToken: token, Path: sharedListURLPath(r.Context(), token)})
+}
+
+type UnshareListHandler struct{ Svc bookmarkUC.Service }
+
+// ServeHTTP リーディングリストの共有を解除
+// @Summary      リーディングリストの共有を解除
+// @Description  認証ユーザー（JWT の sub）のリーディングリストの共有トークンを無効にします。共有されていないリストに対しては何もしません
+// @Tags         reading-lists
+// @Security     BearerAuth
+// @Param        id path int true "リストID"
+// @Success      204 "No Content"
+// @Failure      400 {string} string "Bad request - invalid list ID"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      404 {string} string "Not found - list not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/lists/{id}/share [delete]
+func (h UnshareListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(strings.TrimSuffix(r.URL.Path, "/share"), "/me/lists/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.Svc.UnshareList(r.Context(), auth.UserFromContext(r.Context()), id); err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,36 @@
+package bookmark
+
+import (
+	"net/http"
+
+	"catchup-feed/internal/common/pagination"
+	bookmarkUC "catchup-feed/internal/usecase/bookmark"
+)
+
+// Register registers the bookmark and reading list HTTP handlers of the current
+// user with the given mux. All routes act on the JWT subject of the request, so
+// every role may use them (see auth.Permission.UserPaths).
+func Register(mux *http.ServeMux, svc bookmarkUC.Service, paginationCfg pagination.Config) {
+	mux.Handle("GET    /me/bookmarks", ListBookmarksHandler{Svc: svc, PaginationCfg: paginationCfg})
+	mux.Handle("PUT    /me/bookmarks/{id}", AddBookmarkHandler{svc})
+	mux.Handle("DELETE /me/bookmarks/{id}", RemoveBookmarkHandler{svc})
+
+	mux.Handle("GET    /me/lists", ListListsHandler{svc})
+	mux.Handle("POST   /me/lists", CreateListHandler{svc})
+	mux.Handle("GET    /me/lists/{id}", GetListHandler{svc})
+	mux.Handle("PUT    /me/lists/{id}", UpdateListHandler{svc})
+	mux.Handle("DELETE /me/lists/{id}", DeleteListHandler{svc})
+	mux.Handle("POST   /me/lists/{id}/items", AddItemHandler{svc})
+	mux.Handle("PUT    /me/lists/{id}/items/{article_id}", UpdateItemHandler{svc})
+	mux.Handle("DELETE /me/lists/{id}/items/{article_id}", RemoveItemHandler{svc})
+	mux.Handle("PUT    /me/lists/{id}/order", ReorderItemsHandler{svc})
+	mux.Handle("POST   /me/lists/{id}/share", ShareListHandler{svc})
+	mux.Handle("DELETE /me/lists/{id}/share", UnshareListHandler{svc})
+}
+
+// RegisterShared registers the read-only view of shared reading lists with the
+// given mux. It must be mounted outside the auth middleware: the share token in
+// the path is the only credential.
+func RegisterShared(mux *http.ServeMux, svc bookmarkUC.Service) {
+	mux.Handle("GET    "+sharedListPath+"{token}", SharedListHandler{svc})
+}
@@ -0,0 +1,44 @@
+package bookmark
+
+import (
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/apiversion"
+	bookmarkUC "catchup-feed/internal/usecase/bookmark"
+)
+
+// Response mappers of the bookmark and reading list endpoints that return articles (see apiversion.Mapper).
+var (
+	// bookmarksResponse maps a page of bookmarked articles.
+	bookmarksResponse = apiversion.Mapper[*bookmarkUC.BookmarkListResult]{
+		apiversion.V1: func(p *bookmarkUC.BookmarkListResult) any {
+			out := make([]BookmarkDTO, 0, len(p.Data))
+			for _, b := range p.Data {
+				out = append(out, BookmarkDTO{ArticleDTO: toArticleDTO(b.ArticleWithSource), BookmarkedAt: b.BookmarkedAt})
+			}
+			return pagination.NewResponse(out, p.Pagination)
+		},
+	}
+
+	// listDetailResponse maps a reading list of the current user with its items.
+	listDetailResponse = apiversion.Mapper[*bookmarkUC.ListWithItems]{
+		apiversion.V1: func(l *bookmarkUC.ListWithItems) any { return toListDetailDTO(l) },
+	}
+
+	// sharedListResponse maps a shared reading list.
+	sharedListResponse = apiversion.Mapper[*bookmarkUC.ListWithItems]{
+		apiversion.V1: func(l *bookmarkUC.ListWithItems) any { return toSharedListDTO(l) },
+	}
+
+	// itemResponse maps an article added to a reading list.
+	itemResponse = apiversion.Mapper[*entity.ReadingListItem]{
+		apiversion.V1: func(item *entity.ReadingListItem) any {
+			return ItemDTO{
+				Position: item.Position,
+				Note:     item.Note,
+				AddedAt:  item.AddedAt,
+				Article:  ArticleDTO{ID: item.ArticleID},
+			}
+		},
+	}
+)
@@ -0,0 +1,32 @@
+package bookmark
+
+import (
+	"net/http"
+	"strings"
+
+	"catchup-feed/internal/handler/http/respond"
+	bookmarkUC "catchup-feed/internal/usecase/bookmark"
+)
+
+type SharedListHandler struct{ Svc bookmarkUC.Service }
+
+// ServeHTTP 共有リーディングリスト取得
+// @Summary      共有リーディングリスト取得
+// @Description  共有トークンで公開されたリーディングリストを、記事とメモを並び順で含めて取得します。認証は不要で、所有者は公開されません
+// @Tags         reading-lists
+// @Produce      json
+// @Param        token path string true "共有トークン"
+// @Success      200 {object} SharedListDTO "共有リーディングリスト"
+// @Failure      404 {string} string "Not found - list not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /shared/lists/{token} [get]
+func (h SharedListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimPrefix(r.URL.Path, sharedListPath)
+
+	list, err := h.Svc.GetSharedList(r.Context(), token)
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, sharedListResponse.Map(r.Context(), list))
+}
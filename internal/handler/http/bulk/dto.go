@@ -0,0 +1,85 @@
+// Package bulk provides HTTP handlers for bulk operations on articles and sources.
+package bulk
+
+import (
+	"time"
+
+	"catchup-feed/internal/repository"
+	bulkUC "catchup-feed/internal/usecase/bulk"
+)
+
+// articleRequest is the request body of POST /articles/bulk.
+type articleRequest struct {
+	Action     string         `json:"action"`
+	IDs        []int64        `json:"ids"`
+	Filter     *articleFilter `json:"filter"`
+	SourceID   int64          `json:"source_id"`
+	AddTags    []string       `json:"add_tags"`
+	RemoveTags []string       `json:"remove_tags"`
+}
+
+// articleFilter selects articles with the criteria of /articles/search.
+type articleFilter struct {
+	Keyword  string     `json:"keyword"`
+	SourceID *int64     `json:"source_id"`
+	From     *time.Time `json:"from"`
+	To       *time.Time `json:"to"`
+	Tags     []string   `json:"tags"`
+}
+
+func (in articleRequest) toUsecase() bulkUC.ArticleRequest {
+	req := bulkUC.ArticleRequest{
+		Action:     in.Action,
+		IDs:        in.IDs,
+		SourceID:   in.SourceID,
+		AddTags:    in.AddTags,
+		RemoveTags: in.RemoveTags,
+	}
+	if in.Filter != nil {
+		req.Filter = &bulkUC.ArticleFilter{
+			Keyword: in.Filter.Keyword,
+			Filters: repository.ArticleSearchFilters{
+				SourceID: in.Filter.SourceID,
+				From:     in.Filter.From,
+				To:       in.Filter.To,
+				Tags:     in.Filter.Tags,
+			},
+		}
+	}
+	return req
+}
+
+// sourceRequest is the request body of POST /sources/bulk.
+type sourceRequest struct {
+	Action string  `json:"action"`
+	IDs    []int64 `json:"ids"`
+}
+
+// ResultDTO is the outcome of a bulk operation.
+type ResultDTO struct {
+	Action    string `json:"action" example:"delete"`
+	Succeeded int    `json:"succeeded" example:"2"`
+	Failed    int    `json:"failed" example:"1"`
+	// Items has one result per requested ID, in request order.
+	Items []ItemDTO `json:"items"`
+}
+
+// ItemDTO is the outcome of a bulk operation for one item.
+type ItemDTO struct {
+	ID int64 `json:"id" example:"42"`
+	// Status is "ok", "not_found" (missing or in the trash) or "invalid_id".
+	Status string `json:"status" example:"ok"`
+}
+
+func toResultDTO(r *bulkUC.Result) ResultDTO {
+	items := make([]ItemDTO, 0, len(r.Items))
+	for _, item := range r.Items {
+		items = append(items, ItemDTO{ID: item.ID, Status: item.Status})
+	}
+	return ResultDTO{
+		Action:    r.Action,
+		Succeeded: r.Succeeded,
+		Failed:    r.Failed,
+		Items:     items,
+	}
+}
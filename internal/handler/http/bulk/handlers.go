@@ -0,0 +1,87 @@
+package bulk
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/respond"
+	bulkUC "catchup-feed/internal/usecase/bulk"
+)
+
+type ArticlesHandler struct{ Svc bulkUC.Service }
+
+// ServeHTTP 記事の一括操作
+// @Summary      記事の一括操作
+// @Description  ID のリスト、または /articles/search と同じ条件（filter）で選んだ記事に、1つのトランザクションで操作を適用します（管理者のみ）。action は delete（ゴミ箱へ移動）、update_source（source_id のソースへ移動）、retag（add_tags を付け、remove_tags を外す）です。1回の対象は最大500件で、条件に一致する記事が500件を超える場合は何も変更しません。結果は ID ごとに ok / not_found / invalid_id で返します
+// @Tags         articles
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body object true "操作（action, ids または filter {keyword, source_id, from, to, tags}, source_id, add_tags, remove_tags）"
+// @Success      200 {object} ResultDTO "記事ごとの結果"
+// @Failure      400 {string} string "Bad request - invalid action, target or too many articles"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - admin role required"
+// @Failure      404 {string} string "Not found - target source not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /articles/bulk [post]
+func (h ArticlesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req articleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := h.Svc.Articles(r.Context(), req.toUsecase())
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, toResultDTO(result))
+}
+
+type SourcesHandler struct{ Svc bulkUC.Service }
+
+// ServeHTTP ソースの一括操作
+// @Summary      ソースの一括操作
+// @Description  ID を指定したソースに、1つのトランザクションで操作を適用します（管理者のみ）。action は activate、deactivate、delete（ソースと記事をゴミ箱へ移動）です。1回の対象は最大500件です。結果は ID ごとに ok / not_found / invalid_id で返します
+// @Tags         sources
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body object true "操作（action, ids）"
+// @Success      200 {object} ResultDTO "ソースごとの結果"
+// @Failure      400 {string} string "Bad request - invalid action or too many sources"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - admin role required"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /sources/bulk [post]
+func (h SourcesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req sourceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := h.Svc.Sources(r.Context(), bulkUC.SourceRequest{Action: req.Action, IDs: req.IDs})
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, toResultDTO(result))
+}
+
+// errorStatus maps bulk use case errors to HTTP status codes.
+func errorStatus(err error) int {
+	var ve *entity.ValidationError
+	switch {
+	case errors.As(err, &ve), errors.Is(err, bulkUC.ErrBatchTooLarge):
+		return http.StatusBadRequest
+	case errors.Is(err, bulkUC.ErrSourceNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,149 @@
+package bulk_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/bulk"
+	"catchup-feed/internal/repository"
+	bulkUC "catchup-feed/internal/usecase/bulk"
+)
+
+/* ───────── モック ───────── */
+
+type stubBulkRepo struct {
+	existing  map[int64]bool
+	found     []int64
+	gotFilter repository.ArticleSearchFilters
+	gotIDs    []int64
+}
+
+func (s *stubBulkRepo) applied(ids []int64) ([]int64, error) {
+	s.gotIDs = ids
+	var done []int64
+	for _, id := range ids {
+		if s.existing[id] {
+			done = append(done, id)
+		}
+	}
+	return done, nil
+}
+
+func (s *stubBulkRepo) FindArticleIDs(_ context.Context, _ []string, filters repository.ArticleSearchFilters, _ int) ([]int64, error) {
+	s.gotFilter = filters
+	return s.found, nil
+}
+func (s *stubBulkRepo) DeleteArticles(_ context.Context, ids []int64) ([]int64, error) {
+	return s.applied(ids)
+}
+func (s *stubBulkRepo) MoveArticles(_ context.Context, ids []int64, _ int64) ([]int64, error) {
+	return s.applied(ids)
+}
+func (s *stubBulkRepo) RetagArticles(_ context.Context, ids []int64, _, _ []string, _ string) ([]int64, error) {
+	return s.applied(ids)
+}
+func (s *stubBulkRepo) SetSourcesActive(_ context.Context, ids []int64, _ bool) ([]int64, error) {
+	return s.applied(ids)
+}
+func (s *stubBulkRepo) DeleteSources(_ context.Context, ids []int64) ([]int64, error) {
+	return s.applied(ids)
+}
+
+type stubSourceRepo struct {
+	repository.SourceRepository
+	source *entity.Source
+}
+
+func (s *stubSourceRepo) Get(context.Context, int64) (*entity.Source, error) {
+	return s.source, nil
+}
+
+func serve(h http.Handler, target, body string) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, target, strings.NewReader(body)))
+	return rr
+}
+
+/* ───────── テスト ───────── */
+
+func TestArticlesHandler(t *testing.T) {
+	repo := &stubBulkRepo{existing: map[int64]bool{1: true}}
+	h := bulk.ArticlesHandler{Svc: bulkUC.Service{Repo: repo}}
+
+	rr := serve(h, "/articles/bulk", `{"action":"delete","ids":[1,2]}`)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rr.Code, rr.Body)
+	}
+	var got bulk.ResultDTO
+	if err := json.NewDecoder(rr.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Action != "delete" || got.Succeeded != 1 || got.Failed != 1 || len(got.Items) != 2 ||
+		got.Items[0] != (bulk.ItemDTO{ID: 1, Status: "ok"}) || got.Items[1] != (bulk.ItemDTO{ID: 2, Status: "not_found"}) {
+		t.Errorf("result = %+v", got)
+	}
+}
+
+func TestArticlesHandler_Filter(t *testing.T) {
+	repo := &stubBulkRepo{found: []int64{9}, existing: map[int64]bool{9: true}}
+	h := bulk.ArticlesHandler{Svc: bulkUC.Service{Repo: repo}}
+
+	rr := serve(h, "/articles/bulk", `{"action":"retag","filter":{"source_id":3,"tags":["Spam"]},"add_tags":["junk"]}`)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rr.Code, rr.Body)
+	}
+	if repo.gotFilter.SourceID == nil || *repo.gotFilter.SourceID != 3 || len(repo.gotFilter.Tags) != 1 || repo.gotFilter.Tags[0] != "spam" {
+		t.Errorf("filter = %+v", repo.gotFilter)
+	}
+	if len(repo.gotIDs) != 1 || repo.gotIDs[0] != 9 {
+		t.Errorf("ids = %v, want [9]", repo.gotIDs)
+	}
+}
+
+func TestArticlesHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want int
+	}{
+		{"invalid json", `{`, http.StatusBadRequest},
+		{"unknown action", `{"action":"archive","ids":[1]}`, http.StatusBadRequest},
+		{"no target", `{"action":"delete"}`, http.StatusBadRequest},
+		{"too many ids", `{"action":"delete","ids":[` + strings.Repeat("1,", bulkUC.MaxBatchSize) + `1]}`, http.StatusBadRequest},
+		{"target source not found", `{"action":"update_source","ids":[1],"source_id":5}`, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := bulk.ArticlesHandler{Svc: bulkUC.Service{Repo: &stubBulkRepo{}, SourceRepo: &stubSourceRepo{}}}
+			if rr := serve(h, "/articles/bulk", tt.body); rr.Code != tt.want {
+				t.Errorf("status = %d, want %d: %s", rr.Code, tt.want, rr.Body)
+			}
+		})
+	}
+}
+
+func TestSourcesHandler(t *testing.T) {
+	repo := &stubBulkRepo{existing: map[int64]bool{4: true, 5: true}}
+	h := bulk.SourcesHandler{Svc: bulkUC.Service{Repo: repo}}
+
+	rr := serve(h, "/sources/bulk", `{"action":"deactivate","ids":[4,5]}`)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rr.Code, rr.Body)
+	}
+	var got bulk.ResultDTO
+	if err := json.NewDecoder(rr.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Succeeded != 2 || got.Failed != 0 {
+		t.Errorf("result = %+v", got)
+	}
+
+	if rr := serve(h, "/sources/bulk", `{"action":"pause","ids":[4]}`); rr.Code != http.StatusBadRequest {
+		t.Errorf("unknown action status = %d, want 400", rr.Code)
+	}
+}
@@ -0,0 +1,15 @@
+package bulk
+
+import (
+	"net/http"
+
+	"catchup-feed/internal/handler/http/auth"
+	bulkUC "catchup-feed/internal/usecase/bulk"
+)
+
+// Register registers the bulk operation HTTP handlers with the given mux.
+// Both routes are writes and therefore admin-only.
+func Register(mux *http.ServeMux, svc bulkUC.Service) {
+	mux.Handle("POST   /articles/bulk", auth.Authz(ArticlesHandler{svc}))
+	mux.Handle("POST   /sources/bulk", auth.Authz(SourcesHandler{svc}))
+}
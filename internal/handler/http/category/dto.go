@@ -0,0 +1,32 @@
+package category
+
+import (
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// DTO represents the JSON structure of a source category.
+type DTO struct {
+	ID        int64     `json:"id" example:"1"`
+	Name      string    `json:"name" example:"Security"`
+	CreatedAt time.Time `json:"created_at" example:"2025-10-26T12:00:00Z"`
+}
+
+// ListItemDTO is a category with the number of sources in it.
+type ListItemDTO struct {
+	DTO
+	SourceCount int64 `json:"source_count" example:"12"`
+}
+
+func toDTO(c *entity.Category) DTO {
+	return DTO{ID: c.ID, Name: c.Name, CreatedAt: c.CreatedAt}
+}
+
+func toDTOs(categories []*entity.Category) []DTO {
+	out := make([]DTO, 0, len(categories))
+	for _, c := range categories {
+		out = append(out, toDTO(c))
+	}
+	return out
+}
@@ -0,0 +1,229 @@
+package category
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/pathutil"
+	"catchup-feed/internal/handler/http/respond"
+	catUC "catchup-feed/internal/usecase/category"
+)
+
+type ListHandler struct{ Svc catUC.Service }
+
+// ServeHTTP カテゴリ一覧取得
+// @Summary      カテゴリ一覧取得
+// @Description  ソースのカテゴリを名前順に、各カテゴリのソース数とともに取得します
+// @Tags         categories
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {array} ListItemDTO "カテゴリ一覧"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - insufficient permissions"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /categories [get]
+func (h ListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	categories, err := h.Svc.List(r.Context())
+	if err != nil {
+		respond.SafeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	out := make([]ListItemDTO, 0, len(categories))
+	for _, c := range categories {
+		out = append(out, ListItemDTO{DTO: toDTO(c.Category), SourceCount: c.SourceCount})
+	}
+	respond.JSON(w, http.StatusOK, out)
+}
+
+type CreateHandler struct{ Svc catUC.Service }
+
+// ServeHTTP カテゴリ作成
+// @Summary      カテゴリ作成
+// @Description  ソースのカテゴリを作成します（管理者のみ）。名前の前後の空白は取り除かれます
+// @Tags         categories
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        category body object true "カテゴリ（name）"
+// @Success      201 {object} DTO "作成されたカテゴリ"
+// @Failure      400 {string} string "Bad request - invalid name"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - admin role required"
+// @Failure      409 {string} string "Conflict - category name already exists"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /categories [post]
+func (h CreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	c, err := h.Svc.Create(r.Context(), req.Name)
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusCreated, toDTO(c))
+}
+
+type RenameHandler struct{ Svc catUC.Service }
+
+// ServeHTTP カテゴリ名変更
+// @Summary      カテゴリ名変更
+// @Description  ソースのカテゴリの名前を変更します（管理者のみ）
+// @Tags         categories
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "カテゴリID"
+// @Param        category body object true "新しい名前（name）"
+// @Success      200 {object} DTO "変更後のカテゴリ"
+// @Failure      400 {string} string "Bad request - invalid ID or name"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - admin role required"
+// @Failure      404 {string} string "Not found - category not found"
+// @Failure      409 {string} string "Conflict - category name already exists"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /categories/{id} [put]
+func (h RenameHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(r.URL.Path, "/categories/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+	var req struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	c, err := h.Svc.Rename(r.Context(), id, req.Name)
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, toDTO(c))
+}
+
+type DeleteHandler struct{ Svc catUC.Service }
+
+// ServeHTTP カテゴリ削除
+// @Summary      カテゴリ削除
+// @Description  ソースのカテゴリを削除します（管理者のみ）。ソースへの割り当ても外れますが、ソース自体は残ります
+// @Tags         categories
+// @Security     BearerAuth
+// @Param        id path int true "カテゴリID"
+// @Success      204 "No Content"
+// @Failure      400 {string} string "Bad request - invalid ID"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - admin role required"
+// @Failure      404 {string} string "Not found - category not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /categories/{id} [delete]
+func (h DeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(r.URL.Path, "/categories/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.Svc.Delete(r.Context(), id); err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type SourceCategoriesHandler struct{ Svc catUC.Service }
+
+// ServeHTTP ソースのカテゴリ取得
+// @Summary      ソースのカテゴリ取得
+// @Description  指定されたソースが属するカテゴリを名前順に取得します
+// @Tags         categories
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path int true "ソースID"
+// @Success      200 {array} DTO "ソースのカテゴリ"
+// @Failure      400 {string} string "Bad request - invalid source ID"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - insufficient permissions"
+// @Failure      404 {string} string "Not found - source not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /sources/{id}/categories [get]
+func (h SourceCategoriesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	sourceID, err := pathutil.ExtractID(strings.TrimSuffix(r.URL.Path, "/categories"), "/sources/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	categories, err := h.Svc.SourceCategories(r.Context(), sourceID)
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, toDTOs(categories))
+}
+
+type SetSourceCategoriesHandler struct{ Svc catUC.Service }
+
+// ServeHTTP ソースのカテゴリ設定
+// @Summary      ソースのカテゴリ設定
+// @Description  指定されたソースのカテゴリを category_ids で置き換えます（管理者のみ）。空の配列を指定するとすべてのカテゴリから外します
+// @Tags         categories
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "ソースID"
+// @Param        categories body object true "カテゴリIDの一覧（category_ids）"
+// @Success      200 {array} DTO "設定後のソースのカテゴリ"
+// @Failure      400 {string} string "Bad request - invalid source ID or category IDs"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - admin role required"
+// @Failure      404 {string} string "Not found - source or category not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /sources/{id}/categories [put]
+func (h SetSourceCategoriesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	sourceID, err := pathutil.ExtractID(strings.TrimSuffix(r.URL.Path, "/categories"), "/sources/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+	var req struct {
+		CategoryIDs []int64 `json:"category_ids"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	categories, err := h.Svc.SetSourceCategories(r.Context(), sourceID, req.CategoryIDs)
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, toDTOs(categories))
+}
+
+// errorStatus maps category use case errors to HTTP status codes.
+func errorStatus(err error) int {
+	var ve *entity.ValidationError
+	switch {
+	case errors.As(err, &ve):
+		return http.StatusBadRequest
+	case errors.Is(err, catUC.ErrCategoryNotFound), errors.Is(err, catUC.ErrSourceNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, catUC.ErrDuplicateCategory):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
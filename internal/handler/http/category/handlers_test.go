@@ -0,0 +1,270 @@
+package category_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/category"
+	"catchup-feed/internal/repository"
+	catUC "catchup-feed/internal/usecase/category"
+)
+
+/* ───────── モック ───────── */
+
+type stubCategoryRepo struct {
+	categories []*entity.Category
+	members    map[int64][]int64
+	err        error
+}
+
+func newStub() *stubCategoryRepo {
+	return &stubCategoryRepo{
+		categories: []*entity.Category{{ID: 1, Name: "Go"}, {ID: 2, Name: "Security"}},
+		members:    map[int64][]int64{},
+	}
+}
+
+func (s *stubCategoryRepo) find(id int64) *entity.Category {
+	for _, c := range s.categories {
+		if c.ID == id {
+			return c
+		}
+	}
+	return nil
+}
+
+func (s *stubCategoryRepo) ListCategories(context.Context) ([]repository.CategoryCount, error) {
+	var out []repository.CategoryCount
+	for _, c := range s.categories {
+		out = append(out, repository.CategoryCount{Category: c, SourceCount: int64(len(s.members))})
+	}
+	return out, s.err
+}
+func (s *stubCategoryRepo) GetCategory(_ context.Context, id int64) (*entity.Category, error) {
+	return s.find(id), s.err
+}
+func (s *stubCategoryRepo) GetCategoryByName(_ context.Context, name string) (*entity.Category, error) {
+	for _, c := range s.categories {
+		if c.Name == name {
+			return c, s.err
+		}
+	}
+	return nil, s.err
+}
+func (s *stubCategoryRepo) CreateCategory(_ context.Context, c *entity.Category) error {
+	if s.err != nil {
+		return s.err
+	}
+	c.ID = int64(len(s.categories) + 1)
+	s.categories = append(s.categories, c)
+	return nil
+}
+func (s *stubCategoryRepo) RenameCategory(_ context.Context, id int64, name string) (bool, error) {
+	c := s.find(id)
+	if c != nil {
+		c.Name = name
+	}
+	return c != nil, s.err
+}
+func (s *stubCategoryRepo) DeleteCategory(_ context.Context, id int64) (bool, error) {
+	return s.find(id) != nil, s.err
+}
+func (s *stubCategoryRepo) ListSourceCategories(_ context.Context, sourceID int64) ([]*entity.Category, error) {
+	var out []*entity.Category
+	for _, id := range s.members[sourceID] {
+		out = append(out, s.find(id))
+	}
+	return out, s.err
+}
+func (s *stubCategoryRepo) SetSourceCategories(_ context.Context, sourceID int64, ids []int64) error {
+	s.members[sourceID] = ids
+	return s.err
+}
+
+type stubSourceRepo struct {
+	repository.SourceRepository
+}
+
+func (stubSourceRepo) Get(_ context.Context, id int64) (*entity.Source, error) {
+	if id == 5 {
+		return &entity.Source{ID: 5, Name: "Go Blog"}, nil
+	}
+	return nil, nil
+}
+
+func newService(repo *stubCategoryRepo) catUC.Service {
+	return catUC.Service{Repo: repo, SourceRepo: stubSourceRepo{}}
+}
+
+/* ───────── テストケース ───────── */
+
+func TestListHandler(t *testing.T) {
+	rr := httptest.NewRecorder()
+	category.ListHandler{Svc: newService(newStub())}.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/categories", nil))
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", rr.Code, http.StatusOK)
+	}
+	var got []category.ListItemDTO
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(got) != 2 || got[0].Name != "Go" {
+		t.Errorf("categories = %+v", got)
+	}
+}
+
+func TestListHandler_Error(t *testing.T) {
+	repo := newStub()
+	repo.err = errors.New("db down")
+
+	rr := httptest.NewRecorder()
+	category.ListHandler{Svc: newService(repo)}.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/categories", nil))
+
+	if rr.Code != http.StatusInternalServerError {
+		t.Fatalf("status code = %d, want %d", rr.Code, http.StatusInternalServerError)
+	}
+}
+
+func TestCreateHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		wantCode int
+	}{
+		{name: "created", body: `{"name":"  Company blogs "}`, wantCode: http.StatusCreated},
+		{name: "invalid json", body: `{`, wantCode: http.StatusBadRequest},
+		{name: "empty name", body: `{"name":" "}`, wantCode: http.StatusBadRequest},
+		{name: "duplicate", body: `{"name":"Go"}`, wantCode: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/categories", strings.NewReader(tt.body))
+			rr := httptest.NewRecorder()
+			category.CreateHandler{Svc: newService(newStub())}.ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d (body %s)", rr.Code, tt.wantCode, rr.Body.String())
+			}
+			if tt.wantCode != http.StatusCreated {
+				return
+			}
+			var got category.DTO
+			if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if got.ID == 0 || got.Name != "Company blogs" {
+				t.Errorf("category = %+v, want stored category with normalized name", got)
+			}
+		})
+	}
+}
+
+func TestRenameAndDeleteHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		wantCode int
+	}{
+		{name: "rename", method: http.MethodPut, path: "/categories/1", body: `{"name":"Golang"}`, wantCode: http.StatusOK},
+		{name: "rename to taken name", method: http.MethodPut, path: "/categories/1", body: `{"name":"Security"}`, wantCode: http.StatusConflict},
+		{name: "rename missing", method: http.MethodPut, path: "/categories/9", body: `{"name":"Rust"}`, wantCode: http.StatusNotFound},
+		{name: "rename invalid id", method: http.MethodPut, path: "/categories/abc", body: `{"name":"Rust"}`, wantCode: http.StatusBadRequest},
+		{name: "delete", method: http.MethodDelete, path: "/categories/2", wantCode: http.StatusNoContent},
+		{name: "delete missing", method: http.MethodDelete, path: "/categories/9", wantCode: http.StatusNotFound},
+		{name: "delete zero id", method: http.MethodDelete, path: "/categories/0", wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newService(newStub())
+			var h http.Handler = category.RenameHandler{Svc: svc}
+			if tt.method == http.MethodDelete {
+				h = category.DeleteHandler{Svc: svc}
+			}
+
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body)))
+
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d (body %s)", rr.Code, tt.wantCode, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestSetSourceCategoriesHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		body     string
+		wantCode int
+	}{
+		{name: "assigned", path: "/sources/5/categories", body: `{"category_ids":[2,1]}`, wantCode: http.StatusOK},
+		{name: "cleared", path: "/sources/5/categories", body: `{"category_ids":[]}`, wantCode: http.StatusOK},
+		{name: "unknown category", path: "/sources/5/categories", body: `{"category_ids":[7]}`, wantCode: http.StatusNotFound},
+		{name: "unknown source", path: "/sources/6/categories", body: `{"category_ids":[1]}`, wantCode: http.StatusNotFound},
+		{name: "invalid category id", path: "/sources/5/categories", body: `{"category_ids":[0]}`, wantCode: http.StatusBadRequest},
+		{name: "invalid source id", path: "/sources/abc/categories", body: `{"category_ids":[1]}`, wantCode: http.StatusBadRequest},
+		{name: "invalid json", path: "/sources/5/categories", body: `{`, wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStub()
+			rr := httptest.NewRecorder()
+			category.SetSourceCategoriesHandler{Svc: newService(repo)}.ServeHTTP(rr,
+				httptest.NewRequest(http.MethodPut, tt.path, strings.NewReader(tt.body)))
+
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d (body %s)", rr.Code, tt.wantCode, rr.Body.String())
+			}
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			var got []category.DTO
+			if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if len(got) != len(repo.members[5]) {
+				t.Errorf("categories = %+v, want %v", got, repo.members[5])
+			}
+		})
+	}
+}
+
+func TestSourceCategoriesHandler(t *testing.T) {
+	repo := newStub()
+	repo.members[5] = []int64{2}
+
+	rr := httptest.NewRecorder()
+	category.SourceCategoriesHandler{Svc: newService(repo)}.ServeHTTP(rr,
+		httptest.NewRequest(http.MethodGet, "/sources/5/categories", nil))
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", rr.Code, http.StatusOK)
+	}
+	var got []category.DTO
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(got) != 1 || got[0].Name != "Security" {
+		t.Errorf("categories = %+v", got)
+	}
+
+	rr = httptest.NewRecorder()
+	category.SourceCategoriesHandler{Svc: newService(repo)}.ServeHTTP(rr,
+		httptest.NewRequest(http.MethodGet, "/sources/6/categories", nil))
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("unknown source: status code = %d, want %d", rr.Code, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,21 @@
+package category
+
+import (
+	"net/http"
+
+	"catchup-feed/internal/handler/http/auth"
+	catUC "catchup-feed/internal/usecase/category"
+)
+
+// Register registers all source category HTTP handlers with the given mux.
+// The category list and the categories of a source are readable by viewers;
+// managing categories and assigning them to sources is admin-only.
+func Register(mux *http.ServeMux, svc catUC.Service) {
+	mux.Handle("GET    /categories", ListHandler{svc})
+	mux.Handle("GET    /sources/{id}/categories", SourceCategoriesHandler{svc})
+
+	mux.Handle("POST   /categories", auth.Authz(CreateHandler{svc}))
+	mux.Handle("PUT    /categories/", auth.Authz(RenameHandler{svc}))
+	mux.Handle("DELETE /categories/", auth.Authz(DeleteHandler{svc}))
+	mux.Handle("PUT    /sources/{id}/categories", auth.Authz(SetSourceCategoriesHandler{svc}))
+}
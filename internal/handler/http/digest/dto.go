@@ -0,0 +1,72 @@
+package digest
+
+import (
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// DTO represents the JSON structure of a digest.
+// Groups is omitted in the digest list.
+type DTO struct {
+	ID           int64      `json:"id" example:"1"`
+	Title        string     `json:"title" example:"デイリーダイジェスト 2025-11-15"`
+	Period       string     `json:"period" example:"daily"`
+	GroupBy      string     `json:"group_by" example:"source"`
+	Overview     string     `json:"overview" example:"今日は Go 1.25 のリリースに関する記事が中心でした。"`
+	PeriodStart  time.Time  `json:"period_start" example:"2025-11-14T08:00:00Z"`
+	PeriodEnd    time.Time  `json:"period_end" example:"2025-11-15T08:00:00Z"`
+	ArticleCount int        `json:"article_count" example:"12"`
+	Groups       []GroupDTO `json:"groups,omitempty"`
+	CreatedAt    time.Time  `json:"created_at" example:"2025-11-15T08:00:03Z"`
+}
+
+// GroupDTO represents a group of digest articles (a source name or a tag).
+type GroupDTO struct {
+	Name     string       `json:"name" example:"Go Blog"`
+	Articles []ArticleDTO `json:"articles"`
+}
+
+// ArticleDTO represents an article as it was when the digest was generated.
+type ArticleDTO struct {
+	ID          int64     `json:"id" example:"42"`
+	Title       string    `json:"title" example:"Go 1.25 is released"`
+	URL         string    `json:"url" example:"https://go.dev/blog/go1.25"`
+	SourceName  string    `json:"source_name" example:"Go Blog"`
+	Summary     string    `json:"summary" example:"Go 1.25 の主な変更点を紹介しています。"`
+	PublishedAt time.Time `json:"published_at" example:"2025-11-14T18:00:00Z"`
+}
+
+// toDTO converts a digest to its DTO, including the grouped articles if withGroups is true.
+func toDTO(d *entity.Digest, withGroups bool) DTO {
+	out := DTO{
+		ID:           d.ID,
+		Title:        d.Title,
+		Period:       d.Period,
+		GroupBy:      d.GroupBy,
+		Overview:     d.Overview,
+		PeriodStart:  d.PeriodStart,
+		PeriodEnd:    d.PeriodEnd,
+		ArticleCount: d.ArticleCount,
+		CreatedAt:    d.CreatedAt,
+	}
+	if !withGroups {
+		return out
+	}
+	out.Groups = make([]GroupDTO, 0, len(d.Groups))
+	for _, g := range d.Groups {
+		articles := make([]ArticleDTO, 0, len(g.Articles))
+		for _, a := range g.Articles {
+			articles = append(articles, ArticleDTO{
+				ID:          a.ID,
+				Title:       a.Title,
+				URL:         a.URL,
+				SourceName:  a.SourceName,
+				Summary:     a.Summary,
+				PublishedAt: a.PublishedAt,
+			})
+		}
+		out.Groups = append(out.Groups, GroupDTO{Name: g.Name, Articles: articles})
+	}
+	return out
+}
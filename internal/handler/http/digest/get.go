@@ -0,0 +1,48 @@
+package digest
+
+import (
+	"errors"
+	"net/http"
+
+	"catchup-feed/internal/handler/http/pathutil"
+	"catchup-feed/internal/handler/http/respond"
+	digestUC "catchup-feed/internal/usecase/digest"
+)
+
+type GetHandler struct{ Svc digestUC.Service }
+
+// ServeHTTP ダイジェスト詳細取得
+// @Summary      ダイジェスト詳細取得
+// @Description  指定されたIDのダイジェストを、グループ別の記事を含めて取得します
+// @Tags         digests
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path int true "ダイジェストID"
+// @Success      200 {object} DTO "ダイジェスト詳細"
+// @Failure      400 {string} string "Bad request - invalid digest ID"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - insufficient permissions"
+// @Failure      404 {string} string "Not found - digest not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /digests/{id} [get]
+func (h GetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(r.URL.Path, "/digests/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	digest, err := h.Svc.Get(r.Context(), id)
+	if err != nil {
+		code := http.StatusInternalServerError
+		if errors.Is(err, digestUC.ErrInvalidDigestID) {
+			code = http.StatusBadRequest
+		} else if errors.Is(err, digestUC.ErrDigestNotFound) {
+			code = http.StatusNotFound
+		}
+		respond.SafeError(w, code, err)
+		return
+	}
+
+	respond.JSON(w, http.StatusOK, digestResponse.Map(r.Context(), digest))
+}
@@ -0,0 +1,172 @@
+package digest_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/digest"
+	"catchup-feed/internal/repository"
+	digestUC "catchup-feed/internal/usecase/digest"
+)
+
+/* ───────── モック ───────── */
+
+type stubDigestRepo struct {
+	digests []*entity.Digest
+	err     error
+}
+
+func (s *stubDigestRepo) CreateDigest(_ context.Context, _ *entity.Digest) error {
+	return nil // テストでは未使用
+}
+func (s *stubDigestRepo) GetDigest(_ context.Context, id int64) (*entity.Digest, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	for _, d := range s.digests {
+		if d.ID == id {
+			return d, nil
+		}
+	}
+	return nil, nil
+}
+func (s *stubDigestRepo) LatestDigest(_ context.Context, _ string) (*entity.Digest, error) {
+	return nil, nil // テストでは未使用
+}
+func (s *stubDigestRepo) ListDigests(_ context.Context, offset, limit int) ([]*entity.Digest, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	end := min(offset+limit, len(s.digests))
+	if offset >= end {
+		return nil, nil
+	}
+	return s.digests[offset:end], nil
+}
+func (s *stubDigestRepo) CountDigests(_ context.Context) (int64, error) {
+	return int64(len(s.digests)), s.err
+}
+func (s *stubDigestRepo) ListDigestCandidates(_ context.Context, _, _ time.Time, _ []int64, _ int) ([]repository.DigestCandidate, error) {
+	return nil, nil // テストでは未使用
+}
+func (s *stubDigestRepo) ListPendingDigestArticleIDs(_ context.Context, _, _ time.Time, _ []int64) ([]int64, error) {
+	return nil, nil // テストでは未使用
+}
+
+func testDigests() []*entity.Digest {
+	end := time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC)
+	return []*entity.Digest{
+		{
+			ID: 2, Period: entity.DigestDaily, GroupBy: entity.DigestGroupBySource,
+			Title: "デイリーダイジェスト 2026-01-02", PeriodStart: end.Add(-24 * time.Hour), PeriodEnd: end,
+			ArticleCount: 1,
+			Groups: []entity.DigestGroup{{Name: "Go Blog", Articles: []entity.DigestArticle{
+				{ID: 10, Title: "Go 1.26", URL: "https://go.dev/blog/go1.26", SourceName: "Go Blog"},
+			}}},
+		},
+		{
+			ID: 1, Period: entity.DigestDaily, GroupBy: entity.DigestGroupBySource,
+			Title: "デイリーダイジェスト 2026-01-01", PeriodStart: end.Add(-48 * time.Hour), PeriodEnd: end.Add(-24 * time.Hour),
+		},
+	}
+}
+
+/* ───────── テストケース ───────── */
+
+func TestListHandler(t *testing.T) {
+	svc := digestUC.Service{Repo: &stubDigestRepo{digests: testDigests()}}
+
+	rr := httptest.NewRecorder()
+	digest.ListHandler{Svc: svc, PaginationCfg: pagination.DefaultConfig()}.
+		ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/digests?limit=1", nil))
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", rr.Code, http.StatusOK)
+	}
+	var got pagination.Response[digest.DTO]
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(got.Data) != 1 || got.Data[0].ID != 2 || got.Data[0].ArticleCount != 1 {
+		t.Errorf("digests = %+v", got.Data)
+	}
+	if got.Data[0].Groups != nil {
+		t.Errorf("list must omit groups, got %+v", got.Data[0].Groups)
+	}
+	if got.Pagination.Total != 2 || got.Pagination.TotalPages != 2 {
+		t.Errorf("pagination = %+v", got.Pagination)
+	}
+}
+
+func TestListHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		repoErr  error
+		wantCode int
+	}{
+		{name: "invalid page", path: "/digests?page=0", wantCode: http.StatusBadRequest},
+		{name: "cursor pagination", path: "/digests?pagination=cursor", wantCode: http.StatusBadRequest},
+		{name: "repository error", path: "/digests", repoErr: errors.New("db down"), wantCode: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := digestUC.Service{Repo: &stubDigestRepo{err: tt.repoErr}}
+
+			rr := httptest.NewRecorder()
+			digest.ListHandler{Svc: svc, PaginationCfg: pagination.DefaultConfig()}.
+				ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d", rr.Code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestGetHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		repoErr  error
+		wantCode int
+	}{
+		{name: "found", path: "/digests/2", wantCode: http.StatusOK},
+		{name: "not found", path: "/digests/3", wantCode: http.StatusNotFound},
+		{name: "invalid id", path: "/digests/abc", wantCode: http.StatusBadRequest},
+		{name: "zero id", path: "/digests/0", wantCode: http.StatusBadRequest},
+		{name: "repository error", path: "/digests/2", repoErr: errors.New("db down"), wantCode: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := digestUC.Service{Repo: &stubDigestRepo{digests: testDigests(), err: tt.repoErr}}
+
+			rr := httptest.NewRecorder()
+			digest.GetHandler{Svc: svc}.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d", rr.Code, tt.wantCode)
+			}
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			var got digest.DTO
+			if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if len(got.Groups) != 1 || got.Groups[0].Name != "Go Blog" ||
+				len(got.Groups[0].Articles) != 1 || got.Groups[0].Articles[0].URL != "https://go.dev/blog/go1.26" {
+				t.Errorf("digest = %+v, want grouped articles", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package digest
+
+import (
+	"errors"
+	"net/http"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/handler/http/respond"
+	digestUC "catchup-feed/internal/usecase/digest"
+)
+
+type ListHandler struct {
+	Svc           digestUC.Service
+	PaginationCfg pagination.Config
+}
+
+// ServeHTTP ダイジェスト一覧取得
+// @Summary      ダイジェスト一覧取得（ページネーション対応）
+// @Description  生成済みのダイジェストを新しい順に取得します。一覧ではグループ別の記事（groups）は省略されます。
+// @Tags         digests
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page   query    int  false  "ページ番号 (1-based)" default(1) minimum(1)
+// @Param        limit  query    int  false  "1ページあたりの件数" default(20) minimum(1) maximum(100)
+// @Success      200 {object} pagination.Response[DTO] "ページネーション付きダイジェスト一覧"
+// @Failure      400 {string} string "Invalid query parameters"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - insufficient permissions"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /digests [get]
+func (h ListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	params, err := pagination.ParseQueryParams(r, h.PaginationCfg)
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if params.Keyset {
+		respond.SafeError(w, http.StatusBadRequest,
+			errors.New("invalid query parameter: cursor pagination is not supported for digests"))
+		return
+	}
+
+	result, err := h.Svc.List(r.Context(), params)
+	if err != nil {
+		respond.SafeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	respond.JSON(w, http.StatusOK, listResponse.Map(r.Context(), result))
+}
@@ -0,0 +1,15 @@
+package digest
+
+import (
+	"net/http"
+
+	"catchup-feed/internal/common/pagination"
+	digestUC "catchup-feed/internal/usecase/digest"
+)
+
+// Register registers all digest-related HTTP handlers with the given mux.
+// Digests are generated by the worker; the API only reads them.
+func Register(mux *http.ServeMux, svc digestUC.Service, paginationCfg pagination.Config) {
+	mux.Handle("GET    /digests", ListHandler{Svc: svc, PaginationCfg: paginationCfg})
+	mux.Handle("GET    /digests/", GetHandler{svc})
+}
@@ -0,0 +1,27 @@
+package digest
+
+import (
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/apiversion"
+	digestUC "catchup-feed/internal/usecase/digest"
+)
+
+// Response mappers of the digest endpoints (see apiversion.Mapper).
+var (
+	// digestResponse maps a digest with its grouped articles.
+	digestResponse = apiversion.Mapper[*entity.Digest]{
+		apiversion.V1: func(d *entity.Digest) any { return toDTO(d, true) },
+	}
+
+	// listResponse maps a page of digests without their articles.
+	listResponse = apiversion.Mapper[*digestUC.ListResult]{
+		apiversion.V1: func(p *digestUC.ListResult) any {
+			out := make([]DTO, 0, len(p.Data))
+			for _, d := range p.Data {
+				out = append(out, toDTO(d, false))
+			}
+			return pagination.NewResponse(out, p.Pagination)
+		},
+	}
+)
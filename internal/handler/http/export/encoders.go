@@ -0,0 +1,265 @@
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"catchup-feed/internal/handler/http/apiversion"
+	"catchup-feed/internal/repository"
+)
+
+// Export formats.
+const (
+	FormatCSV      = "csv"
+	FormatNDJSON   = "ndjson"
+	FormatMarkdown = "md"
+)
+
+// formats maps each export format to its Content-Type and file extension.
+var formats = map[string]struct {
+	contentType string
+	ext         string
+}{
+	FormatCSV:      {"text/csv; charset=utf-8", "csv"},
+	FormatNDJSON:   {"application/x-ndjson; charset=utf-8", "ndjson"},
+	FormatMarkdown: {"text/markdown; charset=utf-8", "md"},
+}
+
+// encoder writes exported articles one at a time.
+type encoder interface {
+	encode(a repository.ArticleWithSource) error
+	// close writes what the encoder still holds.
+	close() error
+}
+
+// newEncoder returns the encoder of format. The NDJSON lines are mapped for the API
+// version of ctx (see articleLine).
+func newEncoder(ctx context.Context, format string, w io.Writer, loc *time.Location) encoder {
+	switch format {
+	case FormatCSV:
+		return newCSVEncoder(w)
+	case FormatNDJSON:
+		return &ndjsonEncoder{enc: json.NewEncoder(w), toLine: func(a repository.ArticleWithSource) any {
+			return articleLine.Map(ctx, a)
+		}}
+	default:
+		return &markdownEncoder{w: w, loc: loc}
+	}
+}
+
+/* ───────── CSV ───────── */
+
+var csvHeader = []string{
+	"id", "published_at", "source_id", "source_name", "title", "url", "summary",
+	"tldr", "key_points", "tags", "reading_time_minutes", "summary_status",
+}
+
+type csvEncoder struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func newCSVEncoder(w io.Writer) *csvEncoder {
+	return &csvEncoder{w: csv.NewWriter(w)}
+}
+
+func (e *csvEncoder) encode(a repository.ArticleWithSource) error {
+	if !e.wroteHeader {
+		if err := e.w.Write(csvHeader); err != nil {
+			return err
+		}
+		e.wroteHeader = true
+	}
+	var tldr, keyPoints, tags, readingTime string
+	if s := a.Article.Structured; s != nil {
+		tldr = s.TLDR
+		keyPoints = strings.Join(s.KeyPoints, "\n")
+		tags = strings.Join(s.Tags, ";")
+		readingTime = strconv.Itoa(s.ReadingTimeMinutes)
+	}
+	return e.w.Write([]string{
+		strconv.FormatInt(a.Article.ID, 10),
+		a.Article.PublishedAt.UTC().Format(time.RFC3339),
+		strconv.FormatInt(a.Article.SourceID, 10),
+		csvText(a.SourceName),
+		csvText(a.Article.Title),
+		csvText(a.Article.URL),
+		csvText(a.Article.Summary),
+		csvText(tldr),
+		csvText(keyPoints),
+		csvText(tags),
+		readingTime,
+		a.Article.SummaryStatus,
+	})
+}
+
+func (e *csvEncoder) close() error {
+	if !e.wroteHeader {
+		// 0件でもヘッダー行は出す
+		if err := e.w.Write(csvHeader); err != nil {
+			return err
+		}
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// csvText neutralizes feed-supplied text that a spreadsheet would evaluate as a
+// formula (CSV injection) by prefixing it with a single quote.
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+/* ───────── NDJSON ───────── */
+
+// ArticleDTO is an exported article (one NDJSON line).
+type ArticleDTO struct {
+	ID            int64                 `json:"id" example:"42"`
+	SourceID      int64                 `json:"source_id" example:"1"`
+	SourceName    string                `json:"source_name" example:"Go Blog"`
+	Title         string                `json:"title" example:"Go 1.25 is released"`
+	URL           string                `json:"url" example:"https://go.dev/blog/go1.25"`
+	Summary       string                `json:"summary" example:"Go 1.25 の主な変更点を紹介しています。"`
+	Structured    *StructuredSummaryDTO `json:"structured,omitempty"`
+	SummaryStatus string                `json:"summary_status,omitempty" example:"pending"`
+	SummaryModel  string                `json:"summary_model,omitempty" example:"claude-sonnet-4-5"`
+	PromptVersion string                `json:"prompt_version,omitempty" example:"default@1"`
+	PublishedAt   time.Time             `json:"published_at" example:"2025-11-14T18:00:00Z"`
+	CreatedAt     time.Time             `json:"created_at" example:"2025-11-14T18:05:00Z"`
+}
+
+// StructuredSummaryDTO is the structured summary of an exported article.
+type StructuredSummaryDTO struct {
+	TLDR               string   `json:"tldr"`
+	KeyPoints          []string `json:"key_points"`
+	Tags               []string `json:"tags"`
+	ReadingTimeMinutes int      `json:"reading_time_minutes"`
+}
+
+// articleLine maps an exported article to its NDJSON line (see apiversion.Mapper).
+var articleLine = apiversion.Mapper[repository.ArticleWithSource]{
+	apiversion.V1: func(a repository.ArticleWithSource) any { return toArticleDTO(a) },
+}
+
+func toArticleDTO(a repository.ArticleWithSource) ArticleDTO {
+	dto := ArticleDTO{
+		ID:            a.Article.ID,
+		SourceID:      a.Article.SourceID,
+		SourceName:    a.SourceName,
+		Title:         a.Article.Title,
+		URL:           a.Article.URL,
+		Summary:       a.Article.Summary,
+		SummaryStatus: a.Article.SummaryStatus,
+		SummaryModel:  a.Article.SummaryModel,
+		PromptVersion: a.Article.PromptVersion,
+		PublishedAt:   a.Article.PublishedAt,
+		CreatedAt:     a.Article.CreatedAt,
+	}
+	if s := a.Article.Structured; s != nil {
+		dto.Structured = &StructuredSummaryDTO{
+			TLDR:               s.TLDR,
+			KeyPoints:          s.KeyPoints,
+			Tags:               s.Tags,
+			ReadingTimeMinutes: s.ReadingTimeMinutes,
+		}
+	}
+	return dto
+}
+
+type ndjsonEncoder struct {
+	enc *json.Encoder
+	// toLine maps an article to its line for the API version of the request.
+	toLine func(repository.ArticleWithSource) any
+}
+
+func (e *ndjsonEncoder) encode(a repository.ArticleWithSource) error {
+	// Encode は値ごとに改行を付ける
+	return e.enc.Encode(e.toLine(a))
+}
+
+func (e *ndjsonEncoder) close() error { return nil }
+
+/* ───────── Markdown ───────── */
+
+// markdownEncoder groups the articles by publication date, then by source, for
+// pasting into weekly notes. Articles arrive newest first, so only the articles
+// of the current date are held until the date changes.
+type markdownEncoder struct {
+	w   io.Writer
+	loc *time.Location
+
+	date     string
+	bySource map[string][]repository.ArticleWithSource
+}
+
+func (e *markdownEncoder) encode(a repository.ArticleWithSource) error {
+	date := a.Article.PublishedAt.In(e.loc).Format(time.DateOnly)
+	if date != e.date {
+		if err := e.flushDate(); err != nil {
+			return err
+		}
+		e.date = date
+		e.bySource = make(map[string][]repository.ArticleWithSource)
+	}
+	e.bySource[a.SourceName] = append(e.bySource[a.SourceName], a)
+	return nil
+}
+
+func (e *markdownEncoder) close() error {
+	return e.flushDate()
+}
+
+// flushDate writes the section of the current date.
+func (e *markdownEncoder) flushDate() error {
+	if len(e.bySource) == 0 {
+		return nil
+	}
+	sources := make([]string, 0, len(e.bySource))
+	for name := range e.bySource {
+		sources = append(sources, name)
+	}
+	sort.Strings(sources)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "## %s\n\n", e.date)
+	for _, name := range sources {
+		fmt.Fprintf(&b, "### %s\n\n", markdownText(name))
+		for _, a := range e.bySource[name] {
+			fmt.Fprintf(&b, "- [%s](%s)\n", markdownText(a.Article.Title), markdownURL(a.Article.URL))
+			summary := a.Article.Summary
+			if a.Article.Structured != nil && a.Article.Structured.TLDR != "" {
+				summary = a.Article.Structured.TLDR
+			}
+			if summary = strings.Join(strings.Fields(summary), " "); summary != "" {
+				fmt.Fprintf(&b, "  - %s\n", summary)
+			}
+		}
+		b.WriteString("\n")
+	}
+	e.bySource = nil
+	_, err := io.WriteString(e.w, b.String())
+	return err
+}
+
+var markdownReplacer = strings.NewReplacer("\\", "\\\\", "[", "\\[", "]", "\\]", "\r", " ", "\n", " ")
+
+// markdownText escapes the characters that would break a heading or link text
+// and flattens line breaks.
+func markdownText(s string) string {
+	return markdownReplacer.Replace(s)
+}
+
+// markdownURL escapes the characters that would end a link destination.
+func markdownURL(s string) string {
+	return strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29").Replace(s)
+}
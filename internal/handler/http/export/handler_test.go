@@ -0,0 +1,200 @@
+package export_test
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/export"
+	"catchup-feed/internal/repository"
+	exportUC "catchup-feed/internal/usecase/export"
+)
+
+/* ───────── モック ───────── */
+
+type stubExportRepo struct {
+	articles    []repository.ArticleWithSource
+	err         error
+	gotKeywords []string
+	gotFilters  repository.ArticleSearchFilters
+	gotLimit    int
+}
+
+func (s *stubExportRepo) EachArticle(_ context.Context, keywords []string, filters repository.ArticleSearchFilters, limit int, fn func(repository.ArticleWithSource) error) error {
+	s.gotKeywords, s.gotFilters, s.gotLimit = keywords, filters, limit
+	if s.err != nil {
+		return s.err
+	}
+	for _, a := range s.articles {
+		if err := fn(a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// testArticles returns articles newest first, as the repository does.
+func testArticles() []repository.ArticleWithSource {
+	jun2 := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+	jun1 := time.Date(2025, 6, 1, 20, 0, 0, 0, time.UTC)
+	return []repository.ArticleWithSource{
+		{
+			Article: &entity.Article{
+				ID: 3, SourceID: 1, Title: "Go 1.25 [released]", URL: "https://go.dev/blog/go1.25", Summary: "Go 1.25 の要約",
+				PublishedAt: jun2, CreatedAt: jun2,
+				Structured: &entity.StructuredSummary{TLDR: "Go 1.25 が出た", KeyPoints: []string{"a", "b", "c"}, Tags: []string{"go", "release"}, ReadingTimeMinutes: 4},
+			},
+			SourceName: "Go Blog",
+		},
+		{
+			Article:    &entity.Article{ID: 2, SourceID: 2, Title: "=HYPERLINK(\"x\")", URL: "https://example.com/a", Summary: "要約\n2行目", PublishedAt: jun2.Add(-time.Hour), CreatedAt: jun2},
+			SourceName: "Example",
+		},
+		{
+			Article:    &entity.Article{ID: 1, SourceID: 1, Title: "Older", URL: "https://go.dev/blog/older", Summary: "古い要約", PublishedAt: jun1, CreatedAt: jun1},
+			SourceName: "Go Blog",
+		},
+	}
+}
+
+func serve(repo *stubExportRepo, target string) *httptest.ResponseRecorder {
+	h := export.Handler{Svc: exportUC.Service{Repo: repo}}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec
+}
+
+/* ───────── テスト ───────── */
+
+func TestHandler_CSV(t *testing.T) {
+	rec := serve(&stubExportRepo{articles: testArticles()}, "/articles/export?format=csv")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rec.Code, rec.Body.String())
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "text/csv; charset=utf-8" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	if cd := rec.Header().Get("Content-Disposition"); !strings.HasPrefix(cd, `attachment; filename="articles-`) || !strings.HasSuffix(cd, `.csv"`) {
+		t.Errorf("Content-Disposition = %q", cd)
+	}
+
+	records, err := csv.NewReader(rec.Body).ReadAll()
+	if err != nil {
+		t.Fatalf("parse csv: %v", err)
+	}
+	if len(records) != 4 || records[0][0] != "id" {
+		t.Fatalf("records = %v, want header and 3 rows", records)
+	}
+	first := records[1]
+	if first[0] != "3" || first[1] != "2025-06-02T09:00:00Z" || first[3] != "Go Blog" || first[7] != "Go 1.25 が出た" ||
+		first[8] != "a\nb\nc" || first[9] != "go;release" || first[10] != "4" {
+		t.Errorf("first row = %q", first)
+	}
+	if records[2][4] != `'=HYPERLINK("x")` {
+		t.Errorf("title = %q, want the formula neutralized", records[2][4])
+	}
+}
+
+func TestHandler_CSV_Empty(t *testing.T) {
+	rec := serve(&stubExportRepo{}, "/articles/export?format=csv")
+	if got := rec.Body.String(); !strings.HasPrefix(got, "id,published_at,") || strings.Count(got, "\n") != 1 {
+		t.Errorf("body = %q, want only the header row", got)
+	}
+}
+
+func TestHandler_NDJSON(t *testing.T) {
+	repo := &stubExportRepo{articles: testArticles()}
+	rec := serve(repo, "/articles/export?format=ndjson&keyword=go&source_id=1&tag=Go&limit=500")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rec.Code, rec.Body.String())
+	}
+	if len(repo.gotKeywords) != 1 || repo.gotFilters.SourceID == nil || *repo.gotFilters.SourceID != 1 ||
+		len(repo.gotFilters.Tags) != 1 || repo.gotFilters.Tags[0] != "go" || repo.gotLimit != 500 {
+		t.Errorf("keywords = %v, filters = %+v, limit = %d", repo.gotKeywords, repo.gotFilters, repo.gotLimit)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(rec.Body.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("lines = %d, want 3", len(lines))
+	}
+	var got export.ArticleDTO
+	if err := json.Unmarshal([]byte(lines[0]), &got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if got.ID != 3 || got.SourceName != "Go Blog" || got.Structured == nil || got.Structured.TLDR != "Go 1.25 が出た" {
+		t.Errorf("first line = %+v", got)
+	}
+}
+
+func TestHandler_Markdown(t *testing.T) {
+	rec := serve(&stubExportRepo{articles: testArticles()}, "/articles/export?format=md")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+
+	want := `## 2025-06-02
+
+### Example
+
+- [=HYPERLINK("x")](https://example.com/a)
+  - 要約 2行目
+
+### Go Blog
+
+- [Go 1.25 \[released\]](https://go.dev/blog/go1.25)
+  - Go 1.25 が出た
+
+## 2025-06-01
+
+### Go Blog
+
+- [Older](https://go.dev/blog/older)
+  - 古い要約
+
+`
+	if got := rec.Body.String(); got != want {
+		t.Errorf("markdown =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestHandler_Markdown_TimeZone(t *testing.T) {
+	// 2025-06-01T20:00Z は東京では 6月2日
+	rec := serve(&stubExportRepo{articles: testArticles()[2:]}, "/articles/export?format=md&tz=Asia/Tokyo")
+	if !strings.HasPrefix(rec.Body.String(), "## 2025-06-02\n") {
+		t.Errorf("markdown = %q, want the date in Asia/Tokyo", rec.Body.String())
+	}
+}
+
+func TestHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		repo   *stubExportRepo
+		target string
+		want   int
+	}{
+		{name: "missing format", repo: &stubExportRepo{}, target: "/articles/export", want: http.StatusBadRequest},
+		{name: "unknown format", repo: &stubExportRepo{}, target: "/articles/export?format=xlsx", want: http.StatusBadRequest},
+		{name: "invalid source_id", repo: &stubExportRepo{}, target: "/articles/export?format=csv&source_id=x", want: http.StatusBadRequest},
+		{name: "invalid limit", repo: &stubExportRepo{}, target: "/articles/export?format=csv&limit=-1", want: http.StatusBadRequest},
+		{name: "invalid tz", repo: &stubExportRepo{}, target: "/articles/export?format=md&tz=Mars/Olympus", want: http.StatusBadRequest},
+		{name: "query failure", repo: &stubExportRepo{err: errors.New("db down")}, target: "/articles/export?format=ndjson", want: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(tt.repo, tt.target)
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+			if rec.Header().Get("Content-Disposition") != "" {
+				t.Error("error response must not be an attachment")
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package export
+
+import (
+	"net/http"
+
+	"catchup-feed/internal/handler/http/middleware"
+	exportUC "catchup-feed/internal/usecase/export"
+)
+
+// Register registers the article export handler with the given mux. Exports are
+// read-only, so both admins and viewers may use them (see auth.RolePermissions);
+// they are rate limited per IP because each one reads many articles.
+func Register(mux *http.ServeMux, svc exportUC.Service, exportRateLimiter *middleware.RateLimiter) {
+	mux.Handle("GET    /articles/export", exportRateLimiter.Middleware(Handler{svc}))
+}
@@ -0,0 +1,46 @@
+// Package feed provides HTTP handlers for the outbound article feeds (Atom, RSS
+// and JSON Feed) and the feed token of the current user.
+package feed
+
+import (
+	"net/url"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// Feed paths. They are mounted outside the auth middleware (see auth.PublicEndpoints):
+// the token query parameter is the only credential.
+const (
+	AtomPath = "/feeds/articles.atom"
+	RSSPath  = "/feeds/articles.rss"
+	JSONPath = "/feeds/articles.json"
+)
+
+// TokenDTO represents the feed token of the current user.
+type TokenDTO struct {
+	Token     string    `json:"token" example:"q3Jx0bW8c2Jm9hZkXr1YV5n7uTzA4eKpL6sD2fGhQwE"`
+	CreatedAt time.Time `json:"created_at" example:"2025-11-01T12:00:00Z"`
+	// Feeds are the paths of the feeds with the token, to add to a feed reader.
+	Feeds FeedPathsDTO `json:"feeds"`
+}
+
+// FeedPathsDTO lists the feed paths of a token in each format.
+type FeedPathsDTO struct {
+	Atom string `json:"atom" example:"/feeds/articles.atom?token=q3Jx0bW8c2Jm9hZkXr1YV5n7uTzA4eKpL6sD2fGhQwE"`
+	RSS  string `json:"rss" example:"/feeds/articles.rss?token=q3Jx0bW8c2Jm9hZkXr1YV5n7uTzA4eKpL6sD2fGhQwE"`
+	JSON string `json:"json" example:"/feeds/articles.json?token=q3Jx0bW8c2Jm9hZkXr1YV5n7uTzA4eKpL6sD2fGhQwE"`
+}
+
+func toTokenDTO(t *entity.FeedToken) TokenDTO {
+	q := "?token=" + url.QueryEscape(t.Token)
+	return TokenDTO{
+		Token:     t.Token,
+		CreatedAt: t.CreatedAt,
+		Feeds: FeedPathsDTO{
+			Atom: AtomPath + q,
+			RSS:  RSSPath + q,
+			JSON: JSONPath + q,
+		},
+	}
+}
@@ -0,0 +1,183 @@
+package feed
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"catchup-feed/internal/handler/http/respond"
+	"catchup-feed/internal/handler/http/searchfilter"
+	feedUC "catchup-feed/internal/usecase/feed"
+)
+
+// FeedHandler serves the article feed in one format.
+type FeedHandler struct {
+	Svc    feedUC.Service
+	Format string
+	// BaseURL is the public scheme and host of the API (see ParseBaseURL). When
+	// empty, the links of the feed use the scheme and host of the request.
+	BaseURL string
+}
+
+// ServeHTTP 記事フィード
+// @Summary      記事フィード（Atom / RSS / JSON Feed）
+// @Description  要約済みの記事を新しい順に Atom、RSS 2.0 または JSON Feed 1.1 で配信します。フィードリーダーは Bearer トークンを送れないため、/me/feed-token で発行したトークンを token クエリで渡します。/articles/search と同じ絞り込みに対応し、ETag と Last-Modified による条件付き GET で未変更なら 304 を返します
+// @Tags         feeds
+// @Produce      xml
+// @Produce      json
+// @Param        token query string true "フィードトークン"
+// @Param        keyword query string false "検索キーワード（スペース区切り、AND）"
+// @Param        source_id query int false "ソースIDでフィルタ"
+// @Param        tag query []string false "タグでフィルタ（複数指定時はすべてを持つ記事）" collectionFormat(multi)
+// @Param        from query string false "公開日時の開始（ISO 8601）"
+// @Param        to query string false "公開日時の終了（ISO 8601）"
+// @Param        limit query int false "記事数（デフォルト 50、最大 100）"
+// @Success      200 {string} string "フィード" headers(ETag=string,Last-Modified=string)
+// @Success      304 "Not Modified"
+// @Failure      400 {string} string "Bad request - invalid filter"
+// @Failure      401 {string} string "Invalid feed token"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /feeds/articles.atom [get]
+// @Router       /feeds/articles.rss [get]
+// @Router       /feeds/articles.json [get]
+func (h FeedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+
+	q, err := parseQuery(params)
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	articles, err := h.Svc.Articles(r.Context(), params.Get("token"), q)
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+
+	base := h.baseURL(r)
+	doc := newDocument(base+feedID(r.URL.Path, params), base+selfLink(r.URL.Path, params), base, articles)
+	body, err := doc.render(h.Format)
+	if err != nil {
+		respond.SafeError(w, http.StatusInternalServerError, fmt.Errorf("render feed: %w", err))
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	w.Header().Set("ETag", etag)
+	if !doc.Updated.IsZero() {
+		w.Header().Set("Last-Modified", doc.Updated.Format(http.TimeFormat))
+	}
+	// トークン入りの URL をキャッシュ共有させない
+	w.Header().Set("Cache-Control", "private, no-cache")
+	w.Header().Set("Vary", "Accept-Encoding")
+
+	if notModified(r, etag, doc.Updated) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentTypes[h.Format])
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+// parseQuery builds the feed query from the same filters as /articles/search.
+func parseQuery(params url.Values) (feedUC.Query, error) {
+	f, err := searchfilter.Parse(params)
+	if err != nil {
+		return feedUC.Query{}, err
+	}
+	limit, err := searchfilter.ParseLimit(params)
+	if err != nil {
+		return feedUC.Query{}, err
+	}
+	return feedUC.Query{Keywords: f.Keywords, Filters: f.Filters, Limit: limit}, nil
+}
+
+// feedID returns the path and filters of the feed without the token and limit,
+// so that the feed ID stays the same when the token is rotated.
+func feedID(path string, params url.Values) string {
+	id := url.Values{}
+	for _, key := range []string{"keyword", "source_id", "tag", "from", "to"} {
+		for _, v := range params[key] {
+			id.Add(key, v)
+		}
+	}
+	if len(id) == 0 {
+		return path
+	}
+	return path + "?" + id.Encode()
+}
+
+// selfLink returns the path and query of the feed without the token. The feed
+// document is shared with readers and aggregators, so its self link must not
+// carry the token.
+func selfLink(path string, params url.Values) string {
+	self := url.Values{}
+	for key, values := range params {
+		if key != "token" {
+			self[key] = values
+		}
+	}
+	if len(self) == 0 {
+		return path
+	}
+	return path + "?" + self.Encode()
+}
+
+// baseURL returns the configured public base URL, or the scheme and host the
+// request was sent to. X-Forwarded-Proto is not honored: any client can send it,
+// so deployments behind a TLS-terminating proxy configure the base URL instead.
+func (h FeedHandler) baseURL(r *http.Request) string {
+	if h.BaseURL != "" {
+		return h.BaseURL
+	}
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+// ParseBaseURL validates the public base URL of the API, e.g.
+// "https://catchup.example.com", and returns it without the trailing slash.
+// The URL must be an absolute http or https URL without query and fragment.
+func ParseBaseURL(s string) (string, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid base URL: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", errors.New("invalid base URL: must be an absolute http or https URL")
+	}
+	if u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return "", errors.New("invalid base URL: must not have user info, query or fragment")
+	}
+	return strings.TrimSuffix(s, "/"), nil
+}
+
+// notModified reports whether the conditional headers of r match the feed.
+// If-None-Match takes precedence over If-Modified-Since (RFC 9110 13.2.2).
+func notModified(r *http.Request, etag string, updated time.Time) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, tag := range strings.Split(inm, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == etag || tag == "*" {
+				return true
+			}
+		}
+		return false
+	}
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" && !updated.IsZero() {
+		t, err := http.ParseTime(ims)
+		return err == nil && !updated.Truncate(time.Second).After(t)
+	}
+	return false
+}
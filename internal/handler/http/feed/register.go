@@ -0,0 +1,26 @@
+package feed
+
+import (
+	"net/http"
+
+	feedUC "catchup-feed/internal/usecase/feed"
+)
+
+// Register registers the feed token handlers of the current user with the given
+// mux. All routes act on the JWT subject of the request, so every role may use
+// them (see auth.Permission.UserPaths).
+func Register(mux *http.ServeMux, svc feedUC.Service) {
+	mux.Handle("GET    /me/feed-token", GetTokenHandler{svc})
+	mux.Handle("POST   /me/feed-token", RotateTokenHandler{svc})
+	mux.Handle("DELETE /me/feed-token", RevokeTokenHandler{svc})
+}
+
+// RegisterFeeds registers the article feeds with the given mux. It must be
+// mounted outside the auth middleware: feed readers cannot send bearer tokens,
+// so the feed token in the URL is the only credential. baseURL is the public base
+// URL of the feed links, or empty to use the host of each request.
+func RegisterFeeds(mux *http.ServeMux, svc feedUC.Service, baseURL string) {
+	mux.Handle("GET    "+AtomPath, FeedHandler{Svc: svc, Format: FormatAtom, BaseURL: baseURL})
+	mux.Handle("GET    "+RSSPath, FeedHandler{Svc: svc, Format: FormatRSS, BaseURL: baseURL})
+	mux.Handle("GET    "+JSONPath, FeedHandler{Svc: svc, Format: FormatJSON, BaseURL: baseURL})
+}
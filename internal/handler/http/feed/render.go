@@ -0,0 +1,274 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strconv"
+	"time"
+
+	"catchup-feed/internal/repository"
+)
+
+// Feed formats.
+const (
+	FormatAtom = "atom"
+	FormatRSS  = "rss"
+	FormatJSON = "json"
+)
+
+// contentTypes are the Content-Type headers of the feed formats.
+var contentTypes = map[string]string{
+	FormatAtom: "application/atom+xml; charset=utf-8",
+	FormatRSS:  "application/rss+xml; charset=utf-8",
+	FormatJSON: "application/feed+json; charset=utf-8",
+}
+
+const feedTitle = "catchup-feed"
+
+// document is a feed independent of its format.
+type document struct {
+	// ID identifies the feed (its URL without the token and limit).
+	ID string
+	// SelfURL is the URL the feed was requested with.
+	SelfURL string
+	// BaseURL is the scheme and host of the API, used for entry IDs.
+	BaseURL string
+	// Updated is the latest Updated of the entries (zero for an empty feed).
+	Updated time.Time
+	Entries []entry
+}
+
+// entry is an article of a feed.
+type entry struct {
+	ID         int64
+	Title      string
+	URL        string
+	SourceName string
+	Summary    string
+	// TLDR is the one-line summary of a structured summary (may be empty).
+	TLDR      string
+	Published time.Time
+	// Updated is when the article was stored with its summary in catchup-feed, or
+	// its publication time if later.
+	Updated time.Time
+}
+
+// newDocument builds the feed of articles (newest first).
+func newDocument(id, selfURL, baseURL string, articles []repository.ArticleWithSource) document {
+	d := document{ID: id, SelfURL: selfURL, BaseURL: baseURL, Entries: make([]entry, 0, len(articles))}
+	for _, a := range articles {
+		e := entry{
+			ID:         a.Article.ID,
+			Title:      a.Article.Title,
+			URL:        a.Article.URL,
+			SourceName: a.SourceName,
+			Summary:    a.Article.Summary,
+			Published:  a.Article.PublishedAt.UTC(),
+			Updated:    a.Article.CreatedAt.UTC(),
+		}
+		if a.Article.Structured != nil {
+			e.TLDR = a.Article.Structured.TLDR
+		}
+		if e.Published.After(e.Updated) {
+			e.Updated = e.Published
+		}
+		if e.Updated.After(d.Updated) {
+			d.Updated = e.Updated
+		}
+		d.Entries = append(d.Entries, e)
+	}
+	return d
+}
+
+// entryID returns the permanent ID (GUID) of an article entry: the API URL of
+// the article, which stays the same when the feed token changes.
+func (d document) entryID(e entry) string {
+	return d.BaseURL + "/articles/" + strconv.FormatInt(e.ID, 10)
+}
+
+// render encodes the document in the given format.
+func (d document) render(format string) ([]byte, error) {
+	switch format {
+	case FormatAtom:
+		return d.renderAtom()
+	case FormatRSS:
+		return d.renderRSS()
+	default:
+		return d.renderJSON()
+	}
+}
+
+/* ───────── Atom (RFC 4287) ───────── */
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomText struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	ID        string     `xml:"id"`
+	Title     atomText   `xml:"title"`
+	Links     []atomLink `xml:"link"`
+	Published string     `xml:"published"`
+	Updated   string     `xml:"updated"`
+	Author    atomAuthor `xml:"author"`
+	Summary   atomText   `xml:"summary"`
+}
+
+func (d document) renderAtom() ([]byte, error) {
+	updated := d.Updated
+	if updated.IsZero() {
+		updated = time.Unix(0, 0).UTC()
+	}
+	f := atomFeed{
+		ID:      d.ID,
+		Title:   feedTitle,
+		Updated: updated.Format(time.RFC3339),
+		Links:   []atomLink{{Rel: "self", Type: contentTypes[FormatAtom], Href: d.SelfURL}},
+	}
+	for _, e := range d.Entries {
+		f.Entries = append(f.Entries, atomEntry{
+			ID:        d.entryID(e),
+			Title:     atomText{Type: "text", Body: e.Title},
+			Links:     []atomLink{{Rel: "alternate", Href: e.URL}},
+			Published: e.Published.Format(time.RFC3339),
+			Updated:   e.Updated.Format(time.RFC3339),
+			Author:    atomAuthor{Name: e.SourceName},
+			Summary:   atomText{Type: "text", Body: e.Summary},
+		})
+	}
+	return marshalXML(f)
+}
+
+/* ───────── RSS 2.0 ───────── */
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+	Category    string  `xml:"category,omitempty"`
+	Description string  `xml:"description"`
+}
+
+func (d document) renderRSS() ([]byte, error) {
+	f := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:       feedTitle,
+			Link:        d.ID,
+			Description: "AI による要約付きの新着記事",
+		},
+	}
+	if !d.Updated.IsZero() {
+		f.Channel.LastBuildDate = d.Updated.Format(time.RFC1123Z)
+	}
+	for _, e := range d.Entries {
+		f.Channel.Items = append(f.Channel.Items, rssItem{
+			Title: e.Title,
+			Link:  e.URL,
+			// 記事の API URL は認証が必要なため、パーマリンクとしては扱わない
+			GUID:        rssGUID{IsPermaLink: false, Value: d.entryID(e)},
+			PubDate:     e.Published.Format(time.RFC1123Z),
+			Category:    e.SourceName,
+			Description: e.Summary,
+		})
+	}
+	return marshalXML(f)
+}
+
+func marshalXML(v any) ([]byte, error) {
+	b, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(b, '\n')...), nil
+}
+
+/* ───────── JSON Feed 1.1 ───────── */
+
+type jsonFeed struct {
+	Version string         `json:"version"`
+	Title   string         `json:"title"`
+	FeedURL string         `json:"feed_url"`
+	Items   []jsonFeedItem `json:"items"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+type jsonFeedItem struct {
+	ID            string           `json:"id"`
+	URL           string           `json:"url"`
+	Title         string           `json:"title"`
+	ContentText   string           `json:"content_text"`
+	Summary       string           `json:"summary,omitempty"`
+	DatePublished string           `json:"date_published"`
+	DateModified  string           `json:"date_modified"`
+	Authors       []jsonFeedAuthor `json:"authors"`
+}
+
+func (d document) renderJSON() ([]byte, error) {
+	f := jsonFeed{
+		Version: "https://jsonfeed.org/version/1.1",
+		Title:   feedTitle,
+		FeedURL: d.SelfURL,
+		Items:   make([]jsonFeedItem, 0, len(d.Entries)),
+	}
+	for _, e := range d.Entries {
+		f.Items = append(f.Items, jsonFeedItem{
+			ID:            d.entryID(e),
+			URL:           e.URL,
+			Title:         e.Title,
+			ContentText:   e.Summary,
+			Summary:       e.TLDR,
+			DatePublished: e.Published.Format(time.RFC3339),
+			DateModified:  e.Updated.Format(time.RFC3339),
+			Authors:       []jsonFeedAuthor{{Name: e.SourceName}},
+		})
+	}
+	b, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
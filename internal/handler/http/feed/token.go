@@ -0,0 +1,85 @@
+package feed
+
+import (
+	"errors"
+	"net/http"
+
+	"catchup-feed/internal/handler/http/auth"
+	"catchup-feed/internal/handler/http/respond"
+	feedUC "catchup-feed/internal/usecase/feed"
+)
+
+type GetTokenHandler struct{ Svc feedUC.Service }
+
+// ServeHTTP フィードトークン取得
+// @Summary      フィードトークン取得
+// @Description  認証ユーザー（JWT の sub）のフィードトークンと、トークン付きのフィードのパスを取得します
+// @Tags         feeds
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} TokenDTO "フィードトークン"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      404 {string} string "Not found - feed token not issued"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/feed-token [get]
+func (h GetTokenHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token, err := h.Svc.GetToken(r.Context(), auth.UserFromContext(r.Context()))
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, toTokenDTO(token))
+}
+
+type RotateTokenHandler struct{ Svc feedUC.Service }
+
+// ServeHTTP フィードトークン発行
+// @Summary      フィードトークン発行
+// @Description  認証ユーザー（JWT の sub）のフィードトークンを発行します。発行済みの場合は新しいトークンに置き換え、以前のトークンのフィードは使えなくなります
+// @Tags         feeds
+// @Security     BearerAuth
+// @Produce      json
+// @Success      201 {object} TokenDTO "発行されたフィードトークン"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/feed-token [post]
+func (h RotateTokenHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token, err := h.Svc.RotateToken(r.Context(), auth.UserFromContext(r.Context()))
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusCreated, toTokenDTO(token))
+}
+
+type RevokeTokenHandler struct{ Svc feedUC.Service }
+
+// ServeHTTP フィードトークン無効化
+// @Summary      フィードトークン無効化
+// @Description  認証ユーザー（JWT の sub）のフィードトークンを削除し、そのトークンのフィードを使えなくします
+// @Tags         feeds
+// @Security     BearerAuth
+// @Success      204 "No Content"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      404 {string} string "Not found - feed token not issued"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/feed-token [delete]
+func (h RevokeTokenHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := h.Svc.RevokeToken(r.Context(), auth.UserFromContext(r.Context())); err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// errorStatus maps feed use case errors to HTTP status codes.
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, feedUC.ErrUserRequired), errors.Is(err, feedUC.ErrInvalidToken):
+		return http.StatusUnauthorized
+	case errors.Is(err, feedUC.ErrTokenNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
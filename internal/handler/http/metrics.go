@@ -143,6 +143,12 @@ func (rw *responseWriter) Write(b []byte) (int, error) {
 	return size, err
 }
 
+// Unwrap returns the underlying http.ResponseWriter (for http.ResponseController support,
+// e.g. flushing Server-Sent Events).
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 // MetricsMiddleware records HTTP request metrics including duration, size, and status codes.
 // It uses path normalization to prevent label cardinality explosion from ID-containing paths.
 // The middleware tracks:
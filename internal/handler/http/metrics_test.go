@@ -278,6 +278,20 @@ func TestResponseWriter(t *testing.T) {
 	}
 }
 
+// TestResponseWriter_Flush verifies that the wrapper supports http.ResponseController,
+// which streaming handlers (Server-Sent Events) use to flush.
+func TestResponseWriter_Flush(t *testing.T) {
+	w := httptest.NewRecorder()
+	rw := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+	if err := http.NewResponseController(rw).Flush(); err != nil {
+		t.Fatalf("Flush err=%v", err)
+	}
+	if !w.Flushed {
+		t.Error("underlying writer was not flushed")
+	}
+}
+
 // TestMetricsMiddleware_Integration is an integration test that verifies
 // the complete metrics flow with path normalization.
 func TestMetricsMiddleware_Integration(t *testing.T) {
@@ -5,7 +5,10 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
+	"net/url"
+	"regexp"
 	"runtime/debug"
+	"strings"
 	"sync"
 	"time"
 
@@ -46,8 +49,8 @@ func Logging(logger *slog.Logger) func(http.Handler) http.Handler {
 				slog.String("request_id", reqID),
 				slog.String("trace_id", traceID),
 				slog.String("method", r.Method),
-				slog.String("path", r.URL.Path),
-				slog.String("query", r.URL.RawQuery),
+				slog.String("path", redactPath(r.URL.Path)),
+				slog.String("query", redactQuery(r.URL.RawQuery)),
 				slog.String("remote_addr", r.RemoteAddr),
 				slog.String("user_agent", r.Header.Get("User-Agent")),
 				slog.Int("status", wrapped.StatusCode()),
@@ -59,6 +62,35 @@ func Logging(logger *slog.Logger) func(http.Handler) http.Handler {
 	}
 }
 
+// sensitiveQueryParams are the query parameters whose values are not logged,
+// e.g. the feed token that authenticates /feeds/ requests.
+var sensitiveQueryParams = map[string]bool{"token": true}
+
+// redactQuery masks the values of sensitive query parameters in a raw query.
+// The other parameters are kept as sent.
+func redactQuery(rawQuery string) string {
+	if rawQuery == "" {
+		return ""
+	}
+	params := strings.Split(rawQuery, "&")
+	for i, p := range params {
+		key, _, _ := strings.Cut(p, "=")
+		if name, err := url.QueryUnescape(key); err == nil && sensitiveQueryParams[name] {
+			params[i] = key + "=****"
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// sharedListPath matches the share token of /shared/lists/{token}, with or without
+// the API version prefix. The token is the credential of the shared reading list.
+var sharedListPath = regexp.MustCompile(`^((?:/v[1-9][0-9]*)?/shared/lists/)[^/]+`)
+
+// redactPath masks the credentials in a request path, like redactQuery.
+func redactPath(path string) string {
+	return sharedListPath.ReplaceAllString(path, "${1}****")
+}
+
 // Recover returns middleware that catches panics and logs them with structured logging.
 // It prevents the server from crashing and returns a 500 Internal Server Error response.
 func Recover(logger *slog.Logger) func(http.Handler) http.Handler {
@@ -83,7 +115,7 @@ func Recover(logger *slog.Logger) func(http.Handler) http.Handler {
 					logger.Error("panic recovered",
 						slog.String("request_id", reqID),
 						slog.String("method", r.Method),
-						slog.String("path", r.URL.Path),
+						slog.String("path", redactPath(r.URL.Path)),
 						slog.Any("panic", rec),
 						slog.String("stack", stack),
 					)
@@ -374,6 +374,83 @@ func TestLogging(t *testing.T) {
 	}
 }
 
+func TestLogging_RedactsToken(t *testing.T) {
+	var buf strings.Builder
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	handler := Logging(logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/feeds/articles.atom?keyword=go&token=secret-feed-token&tag=Go", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	out := buf.String()
+	if strings.Contains(out, "secret-feed-token") {
+		t.Errorf("log contains the feed token: %s", out)
+	}
+	if !strings.Contains(out, "query=\"keyword=go&token=****&tag=Go\"") {
+		t.Errorf("log = %s, want the query with the token masked", out)
+	}
+}
+
+func TestRedactQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{query: "", want: ""},
+		{query: "page=1&limit=10", want: "page=1&limit=10"},
+		{query: "token=abc", want: "token=****"},
+		{query: "token=abc&token=def", want: "token=****&token=****"},
+		{query: "%74oken=abc", want: "%74oken=****"},
+		{query: "token", want: "token=****"},
+		{query: "tokens=abc", want: "tokens=abc"},
+	}
+	for _, tt := range tests {
+		if got := redactQuery(tt.query); got != tt.want {
+			t.Errorf("redactQuery(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestLogging_RedactsShareToken(t *testing.T) {
+	var buf strings.Builder
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	handler := Logging(logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/shared/lists/secret-share-token", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	out := buf.String()
+	if strings.Contains(out, "secret-share-token") {
+		t.Errorf("log contains the share token: %s", out)
+	}
+	if !strings.Contains(out, "path=/v1/shared/lists/****") {
+		t.Errorf("log = %s, want the path with the token masked", out)
+	}
+}
+
+func TestRedactPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/articles/1", want: "/articles/1"},
+		{path: "/shared/lists/abc", want: "/shared/lists/****"},
+		{path: "/v1/shared/lists/abc", want: "/v1/shared/lists/****"},
+		{path: "/v1/shared/lists/abc/extra", want: "/v1/shared/lists/****/extra"},
+		{path: "/shared/lists/", want: "/shared/lists/"},
+		{path: "/me/shared/lists/abc", want: "/me/shared/lists/abc"},
+	}
+	for _, tt := range tests {
+		if got := redactPath(tt.path); got != tt.want {
+			t.Errorf("redactPath(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
 func TestRecover(t *testing.T) {
 	logger := slog.Default()
 
@@ -0,0 +1,104 @@
+// Package opml provides the HTTP handlers of the OPML import and export of sources.
+package opml
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+	"time"
+
+	opmlUC "catchup-feed/internal/usecase/opml"
+)
+
+// document is an OPML 2.0 document (http://opml.org/spec2.opml).
+type document struct {
+	XMLName xml.Name  `xml:"opml"`
+	Version string    `xml:"version,attr"`
+	Title   string    `xml:"head>title"`
+	Created string    `xml:"head>dateCreated,omitempty"`
+	Body    []outline `xml:"body>outline"`
+}
+
+// outline is a feed (with xmlUrl) or a folder of outlines.
+type outline struct {
+	Text     string    `xml:"text,attr"`
+	Title    string    `xml:"title,attr,omitempty"`
+	Type     string    `xml:"type,attr,omitempty"`
+	XMLURL   string    `xml:"xmlUrl,attr,omitempty"`
+	Outlines []outline `xml:"outline"`
+}
+
+// name returns the title of the outline, or its text if it has none.
+func (o outline) name() string {
+	if t := strings.TrimSpace(o.Title); t != "" {
+		return t
+	}
+	return strings.TrimSpace(o.Text)
+}
+
+// decodeFeeds reads the feed outlines of a UTF-8 OPML document. A feed in a folder
+// belongs to the category named after the innermost folder; feeds outside any
+// folder have no category.
+func decodeFeeds(r io.Reader) ([]opmlUC.Feed, error) {
+	var doc document
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+	var feeds []opmlUC.Feed
+	collectFeeds(doc.Body, "", &feeds)
+	return feeds, nil
+}
+
+func collectFeeds(outlines []outline, folder string, feeds *[]opmlUC.Feed) {
+	for _, o := range outlines {
+		if o.XMLURL != "" {
+			f := opmlUC.Feed{Title: o.name(), FeedURL: o.XMLURL}
+			if folder != "" {
+				f.Categories = []string{folder}
+			}
+			*feeds = append(*feeds, f)
+			continue
+		}
+		sub := folder
+		if name := o.name(); name != "" {
+			sub = name
+		}
+		collectFeeds(o.Outlines, sub, feeds)
+	}
+}
+
+// encodeSubscriptions writes the sources as an OPML document with a folder per
+// category, followed by the sources without a category.
+func encodeSubscriptions(w io.Writer, subs *opmlUC.Subscriptions, now time.Time) error {
+	doc := document{
+		Version: "2.0",
+		Title:   "catchup-feed sources",
+		Created: now.UTC().Format(time.RFC1123Z),
+		Body:    make([]outline, 0, len(subs.Folders)+len(subs.Unfiled)),
+	}
+	for _, f := range subs.Folders {
+		folder := outline{Text: f.Category.Name, Title: f.Category.Name, Outlines: make([]outline, 0, len(f.Sources))}
+		for _, src := range f.Sources {
+			folder.Outlines = append(folder.Outlines, feedOutline(src.Name, src.FeedURL))
+		}
+		doc.Body = append(doc.Body, folder)
+	}
+	for _, src := range subs.Unfiled {
+		doc.Body = append(doc.Body, feedOutline(src.Name, src.FeedURL))
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func feedOutline(name, feedURL string) outline {
+	return outline{Text: name, Title: name, Type: "rss", XMLURL: feedURL}
+}
@@ -0,0 +1,46 @@
+package opml
+
+import opmlUC "catchup-feed/internal/usecase/opml"
+
+// ImportResultDTO is the outcome of an OPML import.
+type ImportResultDTO struct {
+	Created           int `json:"created" example:"12"`
+	Existing          int `json:"existing" example:"3"`
+	Failed            int `json:"failed" example:"1"`
+	CategoriesCreated int `json:"categories_created" example:"2"`
+	// Items has one result per feed URL, in document order.
+	Items []ImportItemDTO `json:"items"`
+}
+
+// ImportItemDTO is the outcome of the import of one feed.
+type ImportItemDTO struct {
+	FeedURL string `json:"feed_url" example:"https://go.dev/blog/feed.atom"`
+	// Status is "created", "existing", "invalid" (invalid feed URL) or "failed".
+	Status string `json:"status" example:"created"`
+	// Categories are the categories the source was added to (the folders of the feed).
+	Categories []string `json:"categories" example:"Go"`
+	Error      string   `json:"error,omitempty"`
+}
+
+func toImportResultDTO(r *opmlUC.ImportResult) ImportResultDTO {
+	items := make([]ImportItemDTO, 0, len(r.Items))
+	for _, item := range r.Items {
+		categories := item.Categories
+		if categories == nil {
+			categories = []string{}
+		}
+		items = append(items, ImportItemDTO{
+			FeedURL:    item.FeedURL,
+			Status:     item.Status,
+			Categories: categories,
+			Error:      item.Error,
+		})
+	}
+	return ImportResultDTO{
+		Created:           r.Created,
+		Existing:          r.Existing,
+		Failed:            r.Failed,
+		CategoriesCreated: r.CategoriesCreated,
+		Items:             items,
+	}
+}
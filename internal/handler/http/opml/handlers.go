@@ -0,0 +1,95 @@
+package opml
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"catchup-feed/internal/handler/http/respond"
+	opmlUC "catchup-feed/internal/usecase/opml"
+)
+
+// maxDocumentSize is the maximum size of an imported OPML document.
+const maxDocumentSize = 1 << 20
+
+type ExportHandler struct{ Svc opmlUC.Service }
+
+// ServeHTTP ソースの OPML エクスポート
+// @Summary      ソースの OPML エクスポート
+// @Description  すべてのソースを OPML 2.0 でダウンロードします。カテゴリごとのフォルダ（outline）にソースを入れ、複数のカテゴリに属するソースは各フォルダに含めます。カテゴリのないソースはフォルダの後に並べます
+// @Tags         sources
+// @Security     BearerAuth
+// @Produce      xml
+// @Success      200 {string} string "OPML 文書"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - insufficient permissions"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /sources/opml [get]
+func (h ExportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	subs, err := h.Svc.Export(r.Context())
+	if err != nil {
+		respond.SafeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := encodeSubscriptions(&buf, subs, time.Now()); err != nil {
+		respond.SafeError(w, http.StatusInternalServerError, fmt.Errorf("encode OPML: %w", err))
+		return
+	}
+	w.Header().Set("Content-Type", "text/x-opml; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="catchup-feed-sources.opml"`)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
+
+type ImportHandler struct{ Svc opmlUC.Service }
+
+// ServeHTTP ソースの OPML インポート
+// @Summary      ソースの OPML インポート
+// @Description  OPML（UTF-8、最大1MB・1,000フィード）のフィードをソースとして登録します（管理者のみ）。フォルダ（xmlUrl のない outline）は同名のカテゴリに対応し、ないカテゴリは作成します。入れ子のフォルダでは一番内側のフォルダがカテゴリになります。登録済みの URL のソースは名前・設定を変えず、カテゴリだけを追加します。結果はフィードごとに created / existing / invalid / failed で返します
+// @Tags         sources
+// @Security     BearerAuth
+// @Accept       xml
+// @Produce      json
+// @Param        document body string true "OPML 文書"
+// @Success      200 {object} ImportResultDTO "フィードごとの結果"
+// @Failure      400 {string} string "Bad request - invalid OPML document"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - admin role required"
+// @Failure      413 {string} string "Request entity too large"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /sources/opml [post]
+func (h ImportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxDocumentSize)
+	feeds, err := decodeFeeds(r.Body)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			respond.SafeError(w, http.StatusRequestEntityTooLarge,
+				fmt.Errorf("invalid OPML document: must be at most %d bytes", maxDocumentSize))
+			return
+		}
+		respond.SafeError(w, http.StatusBadRequest, fmt.Errorf("invalid OPML document: %w", err))
+		return
+	}
+
+	result, err := h.Svc.Import(r.Context(), feeds)
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, toImportResultDTO(result))
+}
+
+// errorStatus maps OPML use case errors to HTTP status codes.
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, opmlUC.ErrNoFeeds), errors.Is(err, opmlUC.ErrTooManyFeeds):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
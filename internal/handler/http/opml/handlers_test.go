@@ -0,0 +1,216 @@
+package opml_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/opml"
+	"catchup-feed/internal/repository"
+	opmlUC "catchup-feed/internal/usecase/opml"
+)
+
+/* ───────── モック ───────── */
+
+type stubSourceRepo struct {
+	repository.SourceRepository
+	sources []*entity.Source
+	members map[int64][]int64
+}
+
+func (s *stubSourceRepo) List(context.Context) ([]*entity.Source, error) {
+	return s.sources, nil
+}
+
+func (s *stubSourceRepo) SearchWithFilters(_ context.Context, _ []string, f repository.SourceSearchFilters) ([]*entity.Source, error) {
+	var out []*entity.Source
+	for _, src := range s.sources {
+		for _, id := range s.members[src.ID] {
+			if id == *f.CategoryID {
+				out = append(out, src)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (s *stubSourceRepo) Create(_ context.Context, src *entity.Source) error {
+	cp := *src
+	cp.ID = int64(len(s.sources) + 1)
+	s.sources = append(s.sources, &cp)
+	return nil
+}
+
+type stubCategoryRepo struct {
+	repository.CategoryRepository
+	categories []*entity.Category
+	members    map[int64][]int64
+}
+
+func (s *stubCategoryRepo) ListCategories(context.Context) ([]repository.CategoryCount, error) {
+	var out []repository.CategoryCount
+	for _, c := range s.categories {
+		out = append(out, repository.CategoryCount{Category: c, SourceCount: 1})
+	}
+	return out, nil
+}
+
+func (s *stubCategoryRepo) GetCategoryByName(_ context.Context, name string) (*entity.Category, error) {
+	for _, c := range s.categories {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *stubCategoryRepo) CreateCategory(_ context.Context, c *entity.Category) error {
+	c.ID = int64(len(s.categories) + 1)
+	s.categories = append(s.categories, c)
+	return nil
+}
+
+func (s *stubCategoryRepo) ListSourceCategories(context.Context, int64) ([]*entity.Category, error) {
+	return nil, nil
+}
+
+func (s *stubCategoryRepo) SetSourceCategories(_ context.Context, sourceID int64, ids []int64) error {
+	s.members[sourceID] = ids
+	return nil
+}
+
+func newService() (opmlUC.Service, *stubSourceRepo, *stubCategoryRepo) {
+	members := map[int64][]int64{}
+	src := &stubSourceRepo{members: members}
+	cat := &stubCategoryRepo{members: members}
+	return opmlUC.Service{SourceRepo: src, CategoryRepo: cat}, src, cat
+}
+
+/* ───────── テストケース ───────── */
+
+func TestExportHandler(t *testing.T) {
+	svc, src, cat := newService()
+	cat.categories = []*entity.Category{{ID: 1, Name: "Go & Rust"}}
+	src.sources = []*entity.Source{
+		{ID: 1, Name: "The Go Blog", FeedURL: "https://go.dev/blog/feed.atom"},
+		{ID: 2, Name: "Hacker News", FeedURL: "https://news.ycombinator.com/rss"},
+	}
+	src.members[1] = []int64{1}
+
+	rr := httptest.NewRecorder()
+	opml.ExportHandler{Svc: svc}.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/sources/opml", nil))
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", rr.Code, http.StatusOK)
+	}
+	if ct := rr.Header().Get("Content-Type"); ct != "text/x-opml; charset=utf-8" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	if cd := rr.Header().Get("Content-Disposition"); !strings.Contains(cd, "attachment") {
+		t.Errorf("Content-Disposition = %q, want attachment", cd)
+	}
+
+	var doc struct {
+		Version  string `xml:"version,attr"`
+		Outlines []struct {
+			Text     string `xml:"text,attr"`
+			XMLURL   string `xml:"xmlUrl,attr"`
+			Outlines []struct {
+				Text   string `xml:"text,attr"`
+				XMLURL string `xml:"xmlUrl,attr"`
+			} `xml:"outline"`
+		} `xml:"body>outline"`
+	}
+	if err := xml.Unmarshal(rr.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("decode: %v\n%s", err, rr.Body.String())
+	}
+	if doc.Version != "2.0" || len(doc.Outlines) != 2 {
+		t.Fatalf("document = %+v, want version 2.0 with a folder and an unfiled feed", doc)
+	}
+	folder := doc.Outlines[0]
+	if folder.Text != "Go & Rust" || folder.XMLURL != "" || len(folder.Outlines) != 1 ||
+		folder.Outlines[0].XMLURL != "https://go.dev/blog/feed.atom" {
+		t.Errorf("folder = %+v, want Go & Rust with the Go Blog feed", folder)
+	}
+	if doc.Outlines[1].XMLURL != "https://news.ycombinator.com/rss" {
+		t.Errorf("unfiled = %+v, want Hacker News", doc.Outlines[1])
+	}
+}
+
+func TestImportHandler(t *testing.T) {
+	body := `<?xml version="1.0" encoding="UTF-8"?>
+<opml version="2.0">
+  <head><title>Subscriptions</title></head>
+  <body>
+    <outline text="Tech">
+      <outline text="Go">
+        <outline text="The Go Blog" type="rss" xmlUrl="https://go.dev/blog/feed.atom"/>
+      </outline>
+      <outline title="Security" text="sec">
+        <outline text="Krebs" xmlUrl="https://krebsonsecurity.com/feed/"/>
+      </outline>
+    </outline>
+    <outline text="Hacker News" xmlUrl="https://news.ycombinator.com/rss"/>
+  </body>
+</opml>`
+	svc, src, _ := newService()
+
+	rr := httptest.NewRecorder()
+	opml.ImportHandler{Svc: svc}.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/sources/opml", strings.NewReader(body)))
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d (body %s)", rr.Code, http.StatusOK, rr.Body.String())
+	}
+	var got opml.ImportResultDTO
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if got.Created != 3 || got.CategoriesCreated != 2 || len(got.Items) != 3 {
+		t.Fatalf("result = %+v, want 3 created sources and 2 categories", got)
+	}
+	// 入れ子のフォルダでは一番内側のフォルダがカテゴリになる
+	wantCategories := []string{"Go", "Security", ""}
+	for i, want := range wantCategories {
+		if strings.Join(got.Items[i].Categories, ",") != want {
+			t.Errorf("item[%d] categories = %v, want %q", i, got.Items[i].Categories, want)
+		}
+	}
+	if got.Items[2].Categories == nil {
+		t.Error("categories must be an empty array, not null")
+	}
+	if len(src.sources) != 3 || src.sources[0].Name != "The Go Blog" {
+		t.Errorf("sources = %+v", src.sources)
+	}
+}
+
+func TestImportHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		wantCode int
+	}{
+		{name: "not xml", body: `{"feeds":[]}`, wantCode: http.StatusBadRequest},
+		{name: "no feeds", body: `<opml version="2.0"><body><outline text="Empty"/></body></opml>`, wantCode: http.StatusBadRequest},
+		{name: "too large", body: `<opml version="2.0"><body>` + strings.Repeat(" ", 1<<20) + `</body></opml>`, wantCode: http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, _ := newService()
+			rr := httptest.NewRecorder()
+			opml.ImportHandler{Svc: svc}.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/sources/opml", strings.NewReader(tt.body)))
+
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d (body %s)", rr.Code, tt.wantCode, rr.Body.String())
+			}
+			if !strings.Contains(rr.Body.String(), "invalid OPML document") {
+				t.Errorf("body = %q, want the reason", rr.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package opml
+
+import (
+	"net/http"
+
+	"catchup-feed/internal/handler/http/auth"
+	opmlUC "catchup-feed/internal/usecase/opml"
+)
+
+// Register registers the OPML import and export HTTP handlers with the given mux.
+// The export is readable by viewers; the import creates sources and is admin-only.
+func Register(mux *http.ServeMux, svc opmlUC.Service) {
+	mux.Handle("GET    /sources/opml", ExportHandler{svc})
+	mux.Handle("POST   /sources/opml", auth.Authz(ImportHandler{svc}))
+}
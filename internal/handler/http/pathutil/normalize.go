@@ -19,17 +19,44 @@ var pathPatterns = []*PathPattern{
 	{Pattern: regexp.MustCompile(`^/articles/\d+$`), Template: "/articles/:id"},
 	{Pattern: regexp.MustCompile(`^/articles/\d+/comments$`), Template: "/articles/:id/comments"},
 	{Pattern: regexp.MustCompile(`^/articles/\d+/related$`), Template: "/articles/:id/related"},
+	{Pattern: regexp.MustCompile(`^/articles/\d+/restore$`), Template: "/articles/:id/restore"},
 
 	// Source routes with IDs
 	{Pattern: regexp.MustCompile(`^/sources/\d+$`), Template: "/sources/:id"},
 	{Pattern: regexp.MustCompile(`^/sources/\d+/articles$`), Template: "/sources/:id/articles"},
 	{Pattern: regexp.MustCompile(`^/sources/\d+/stats$`), Template: "/sources/:id/stats"},
+	{Pattern: regexp.MustCompile(`^/sources/\d+/restore$`), Template: "/sources/:id/restore"},
+	{Pattern: regexp.MustCompile(`^/sources/\d+/categories$`), Template: "/sources/:id/categories"},
+
+	// Source category routes with IDs
+	{Pattern: regexp.MustCompile(`^/categories/\d+$`), Template: "/categories/:id"},
+
+	// Read state routes of the current user
+	{Pattern: regexp.MustCompile(`^/me/read/articles/\d+$`), Template: "/me/read/articles/:id"},
+
+	// Bookmark and reading list routes of the current user
+	{Pattern: regexp.MustCompile(`^/me/bookmarks/\d+$`), Template: "/me/bookmarks/:id"},
+	{Pattern: regexp.MustCompile(`^/me/lists/\d+$`), Template: "/me/lists/:id"},
+	{Pattern: regexp.MustCompile(`^/me/lists/\d+/items$`), Template: "/me/lists/:id/items"},
+	{Pattern: regexp.MustCompile(`^/me/lists/\d+/items/\d+$`), Template: "/me/lists/:id/items/:article_id"},
+	{Pattern: regexp.MustCompile(`^/me/lists/\d+/order$`), Template: "/me/lists/:id/order"},
+	{Pattern: regexp.MustCompile(`^/me/lists/\d+/share$`), Template: "/me/lists/:id/share"},
+
+	// Saved search routes of the current user
+	{Pattern: regexp.MustCompile(`^/me/searches/\d+$`), Template: "/me/searches/:id"},
+	{Pattern: regexp.MustCompile(`^/me/searches/\d+/run$`), Template: "/me/searches/:id/run"},
+
+	// Shared reading lists (the token must never become a metrics label)
+	{Pattern: regexp.MustCompile(`^/shared/lists/[^/]+$`), Template: "/shared/lists/:token"},
 
 	// User routes with IDs (if applicable in the future)
 	{Pattern: regexp.MustCompile(`^/users/\d+$`), Template: "/users/:id"},
 	{Pattern: regexp.MustCompile(`^/users/\d+/profile$`), Template: "/users/:id/profile"},
 }
 
+// versionPrefix matches the API version prefix of a path, e.g. "/v1/" (see apiversion).
+var versionPrefix = regexp.MustCompile(`^/v[1-9][0-9]*(?:/|$)`)
+
 // NormalizePath normalizes dynamic URL paths to prevent metrics label cardinality explosion.
 // It converts paths with IDs (e.g., /articles/123) to template format (e.g., /articles/:id).
 // Static paths and search endpoints remain unchanged.
@@ -52,6 +79,10 @@ var pathPatterns = []*PathPattern{
 //
 //	NormalizePath("/articles/123?page=1")   // "/articles/:id"
 //	NormalizePath("/articles/123/")         // "/articles/:id"
+//
+// The API version prefix is kept:
+//
+//	NormalizePath("/v1/articles/123")       // "/v1/articles/:id"
 func NormalizePath(path string) string {
 	// Strip query parameters if present
 	if idx := strings.IndexByte(path, '?'); idx != -1 {
@@ -63,6 +94,16 @@ func NormalizePath(path string) string {
 		path = path[:len(path)-1]
 	}
 
+	// Match the path without its API version prefix and put the prefix back
+	if loc := versionPrefix.FindStringIndex(path); loc != nil && loc[1] < len(path) {
+		prefix := path[:loc[1]-1]
+		return prefix + normalizeUnversioned(path[len(prefix):])
+	}
+	return normalizeUnversioned(path)
+}
+
+// normalizeUnversioned matches a path without API version prefix against the known patterns.
+func normalizeUnversioned(path string) string {
 	// Try to match against known patterns
 	for _, p := range pathPatterns {
 		if p.Pattern.MatchString(path) {
@@ -46,6 +46,103 @@ func TestNormalizePath(t *testing.T) {
 			path:     "/articles/456/related",
 			expected: "/articles/:id/related",
 		},
+		{
+			name:     "article restore",
+			path:     "/articles/456/restore",
+			expected: "/articles/:id/restore",
+		},
+		{
+			name:     "source restore",
+			path:     "/sources/3/restore",
+			expected: "/sources/:id/restore",
+		},
+		{
+			name:     "source categories",
+			path:     "/sources/3/categories",
+			expected: "/sources/:id/categories",
+		},
+		{
+			name:     "category",
+			path:     "/categories/7",
+			expected: "/categories/:id",
+		},
+		{
+			name:     "read marker",
+			path:     "/me/read/articles/42",
+			expected: "/me/read/articles/:id",
+		},
+		{
+			name:     "bookmark",
+			path:     "/me/bookmarks/42",
+			expected: "/me/bookmarks/:id",
+		},
+		{
+			name:     "reading list",
+			path:     "/me/lists/3",
+			expected: "/me/lists/:id",
+		},
+		{
+			name:     "reading list items",
+			path:     "/me/lists/3/items",
+			expected: "/me/lists/:id/items",
+		},
+		{
+			name:     "reading list item",
+			path:     "/me/lists/3/items/42",
+			expected: "/me/lists/:id/items/:article_id",
+		},
+		{
+			name:     "reading list order",
+			path:     "/me/lists/3/order",
+			expected: "/me/lists/:id/order",
+		},
+		{
+			name:     "reading list share",
+			path:     "/me/lists/3/share",
+			expected: "/me/lists/:id/share",
+		},
+		{
+			name:     "saved search",
+			path:     "/me/searches/5",
+			expected: "/me/searches/:id",
+		},
+		{
+			name:     "saved search run",
+			path:     "/me/searches/5/run",
+			expected: "/me/searches/:id/run",
+		},
+		{
+			name:     "shared reading list",
+			path:     "/shared/lists/q3Jx0bW8c2Jm9hZkXr1YV5n7uTzA4eKpL6sD2fGhQwE",
+			expected: "/shared/lists/:token",
+		},
+
+		// Versioned routes (the version prefix is kept)
+		{
+			name:     "versioned article with ID",
+			path:     "/v1/articles/123",
+			expected: "/v1/articles/:id",
+		},
+		{
+			name:     "versioned source categories with trailing slash",
+			path:     "/v1/sources/5/categories/",
+			expected: "/v1/sources/:id/categories",
+		},
+		{
+			name:     "versioned static path",
+			path:     "/v1/articles/search?q=go",
+			expected: "/v1/articles/search",
+		},
+		{
+			name:     "version root",
+			path:     "/v1",
+			expected: "/v1",
+		},
+		{
+			name:     "path starting with v is not a version",
+			path:     "/videos/123",
+			expected: "/videos/123",
+		},
 
 		// Source routes with IDs (should be normalized)
 		{
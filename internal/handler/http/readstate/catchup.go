@@ -0,0 +1,45 @@
+package readstate
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"catchup-feed/internal/handler/http/auth"
+	"catchup-feed/internal/handler/http/respond"
+	readUC "catchup-feed/internal/usecase/readstate"
+)
+
+type CatchupHandler struct{ Svc readUC.Service }
+
+// ServeHTTP 未読記事のまとめ（前回から追いつく）
+// @Summary      未読記事のまとめ
+// @Description  認証ユーザー（JWT の sub）の未読記事数と、ソースごとの新しい未読記事を返します。ソースは未読の多い順です
+// @Tags         read-state
+// @Security     BearerAuth
+// @Produce      json
+// @Param        per_source query int false "ソースごとの記事数（デフォルト: 5、最大: 20）"
+// @Success      200 {object} CatchupDTO "未読の状況"
+// @Failure      400 {string} string "Bad request - invalid per_source"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/catchup [get]
+func (h CatchupHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	perSource := 0
+	if v := r.URL.Query().Get("per_source"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > readUC.MaxCatchupPerSource {
+			respond.SafeError(w, http.StatusBadRequest,
+				errors.New("invalid per_source: must be an integer between 1 and "+strconv.Itoa(readUC.MaxCatchupPerSource)))
+			return
+		}
+		perSource = n
+	}
+
+	catchup, err := h.Svc.Catchup(r.Context(), auth.UserFromContext(r.Context()), perSource)
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, catchupResponse.Map(r.Context(), catchup))
+}
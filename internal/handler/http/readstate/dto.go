@@ -0,0 +1,67 @@
+package readstate
+
+import (
+	"time"
+
+	"catchup-feed/internal/repository"
+	readUC "catchup-feed/internal/usecase/readstate"
+)
+
+// CatchupDTO represents the unread state of the current user.
+type CatchupDTO struct {
+	TotalUnread int64              `json:"total_unread" example:"16"`
+	Sources     []SourceCatchupDTO `json:"sources"`
+}
+
+// SourceCatchupDTO represents the unread articles of one source.
+type SourceCatchupDTO struct {
+	SourceID    int64        `json:"source_id" example:"1"`
+	SourceName  string       `json:"source_name" example:"Go Blog"`
+	UnreadCount int64        `json:"unread_count" example:"12"`
+	Articles    []ArticleDTO `json:"articles"`
+}
+
+// ArticleDTO represents an unread article in the catch-up view.
+type ArticleDTO struct {
+	ID          int64     `json:"id" example:"42"`
+	Title       string    `json:"title" example:"Go 1.25 is released"`
+	URL         string    `json:"url" example:"https://go.dev/blog/go1.25"`
+	Summary     string    `json:"summary" example:"Go 1.25 の主な変更点を紹介しています。"`
+	PublishedAt time.Time `json:"published_at" example:"2025-11-14T18:00:00Z"`
+}
+
+// MarkAllReadDTO represents the result of marking many articles read.
+type MarkAllReadDTO struct {
+	Marked int64 `json:"marked" example:"24"`
+}
+
+// toCatchupDTO converts the catch-up view to its DTO.
+func toCatchupDTO(c *readUC.Catchup) CatchupDTO {
+	out := CatchupDTO{
+		TotalUnread: c.TotalUnread,
+		Sources:     make([]SourceCatchupDTO, 0, len(c.Sources)),
+	}
+	for _, s := range c.Sources {
+		out.Sources = append(out.Sources, SourceCatchupDTO{
+			SourceID:    s.SourceID,
+			SourceName:  s.SourceName,
+			UnreadCount: s.UnreadCount,
+			Articles:    toArticleDTOs(s.Articles),
+		})
+	}
+	return out
+}
+
+func toArticleDTOs(articles []repository.ArticleWithSource) []ArticleDTO {
+	out := make([]ArticleDTO, 0, len(articles))
+	for _, a := range articles {
+		out = append(out, ArticleDTO{
+			ID:          a.Article.ID,
+			Title:       a.Article.Title,
+			URL:         a.Article.URL,
+			Summary:     a.Article.Summary,
+			PublishedAt: a.Article.PublishedAt,
+		})
+	}
+	return out
+}
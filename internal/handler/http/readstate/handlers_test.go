@@ -0,0 +1,222 @@
+package readstate_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/auth"
+	"catchup-feed/internal/handler/http/readstate"
+	"catchup-feed/internal/repository"
+	readUC "catchup-feed/internal/usecase/readstate"
+)
+
+/* ───────── モック ───────── */
+
+type stubReadStateRepo struct {
+	found     bool
+	marked    int64
+	counts    []repository.SourceUnreadCount
+	articles  []repository.ArticleWithSource
+	err       error
+	gotUser   string
+	gotID     int64
+	gotFilter repository.MarkReadFilter
+	gotPerSrc int
+	unmarked  bool
+}
+
+func (s *stubReadStateRepo) MarkRead(_ context.Context, userID string, articleID int64) (bool, error) {
+	s.gotUser, s.gotID = userID, articleID
+	return s.found, s.err
+}
+func (s *stubReadStateRepo) MarkUnread(_ context.Context, userID string, articleID int64) error {
+	s.gotUser, s.gotID, s.unmarked = userID, articleID, true
+	return s.err
+}
+func (s *stubReadStateRepo) MarkAllRead(_ context.Context, userID string, filter repository.MarkReadFilter) (int64, error) {
+	s.gotUser, s.gotFilter = userID, filter
+	return s.marked, s.err
+}
+func (s *stubReadStateRepo) ListUnread(_ context.Context, _ string, _ repository.ArticleSearchFilters, _, _ int) ([]repository.ArticleWithSource, error) {
+	return nil, nil // テストでは未使用
+}
+func (s *stubReadStateRepo) CountUnread(_ context.Context, _ string, _ repository.ArticleSearchFilters) (int64, error) {
+	return 0, nil // テストでは未使用
+}
+func (s *stubReadStateRepo) UnreadCountsBySource(_ context.Context, userID string) ([]repository.SourceUnreadCount, error) {
+	s.gotUser = userID
+	return s.counts, s.err
+}
+func (s *stubReadStateRepo) ListUnreadPerSource(_ context.Context, _ string, perSource int) ([]repository.ArticleWithSource, error) {
+	s.gotPerSrc = perSource
+	return s.articles, s.err
+}
+
+// serve runs handler for a request made by user ("" for an unauthenticated request).
+func serve(handler http.Handler, method, target, body, user string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if user != "" {
+		req = req.WithContext(auth.WithUser(req.Context(), user))
+	}
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	return rr
+}
+
+/* ───────── テスト ───────── */
+
+func TestMarkReadHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		repo     *stubReadStateRepo
+		path     string
+		user     string
+		wantCode int
+	}{
+		{name: "marked", repo: &stubReadStateRepo{found: true}, path: "/me/read/articles/7", user: "alice", wantCode: http.StatusNoContent},
+		{name: "article not found", repo: &stubReadStateRepo{}, path: "/me/read/articles/7", user: "alice", wantCode: http.StatusNotFound},
+		{name: "invalid id", repo: &stubReadStateRepo{found: true}, path: "/me/read/articles/abc", user: "alice", wantCode: http.StatusBadRequest},
+		{name: "no user", repo: &stubReadStateRepo{found: true}, path: "/me/read/articles/7", wantCode: http.StatusUnauthorized},
+		{name: "repository error", repo: &stubReadStateRepo{err: errors.New("db down")}, path: "/me/read/articles/7", user: "alice", wantCode: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := readstate.MarkReadHandler{Svc: readUC.Service{Repo: tt.repo}}
+			rr := serve(h, http.MethodPut, tt.path, "", tt.user)
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d: %s", rr.Code, tt.wantCode, rr.Body.String())
+			}
+			if rr.Code == http.StatusNoContent && (tt.repo.gotUser != "alice" || tt.repo.gotID != 7) {
+				t.Errorf("repo called with (%q, %d)", tt.repo.gotUser, tt.repo.gotID)
+			}
+		})
+	}
+}
+
+func TestMarkUnreadHandler(t *testing.T) {
+	repo := &stubReadStateRepo{}
+	h := readstate.MarkUnreadHandler{Svc: readUC.Service{Repo: repo}}
+
+	rr := serve(h, http.MethodDelete, "/me/read/articles/7", "", "alice")
+	if rr.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d: %s", rr.Code, http.StatusNoContent, rr.Body.String())
+	}
+	if !repo.unmarked || repo.gotUser != "alice" || repo.gotID != 7 {
+		t.Errorf("repo called with (%q, %d)", repo.gotUser, repo.gotID)
+	}
+}
+
+func TestMarkAllReadHandler(t *testing.T) {
+	before := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		body       string
+		wantCode   int
+		wantFilter repository.MarkReadFilter
+	}{
+		{name: "everything (no body)", body: "", wantCode: http.StatusOK},
+		{name: "everything (empty object)", body: "{}", wantCode: http.StatusOK},
+		{
+			name:       "before and source",
+			body:       `{"before":"2025-06-01T00:00:00Z","source_id":3}`,
+			wantCode:   http.StatusOK,
+			wantFilter: repository.MarkReadFilter{Before: &before, SourceID: func() *int64 { id := int64(3); return &id }()},
+		},
+		{name: "invalid json", body: `{"before":`, wantCode: http.StatusBadRequest},
+		{name: "invalid source", body: `{"source_id":0}`, wantCode: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &stubReadStateRepo{marked: 24}
+			h := readstate.MarkAllReadHandler{Svc: readUC.Service{Repo: repo}}
+
+			rr := serve(h, http.MethodPost, "/me/read", tt.body, "alice")
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d: %s", rr.Code, tt.wantCode, rr.Body.String())
+			}
+			if rr.Code != http.StatusOK {
+				return
+			}
+
+			var resp readstate.MarkAllReadDTO
+			if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.Marked != 24 {
+				t.Errorf("marked = %d, want 24", resp.Marked)
+			}
+			got := repo.gotFilter
+			if (got.Before == nil) != (tt.wantFilter.Before == nil) ||
+				(got.Before != nil && !got.Before.Equal(*tt.wantFilter.Before)) {
+				t.Errorf("before = %v, want %v", got.Before, tt.wantFilter.Before)
+			}
+			if (got.SourceID == nil) != (tt.wantFilter.SourceID == nil) ||
+				(got.SourceID != nil && *got.SourceID != *tt.wantFilter.SourceID) {
+				t.Errorf("source_id = %v, want %v", got.SourceID, tt.wantFilter.SourceID)
+			}
+		})
+	}
+}
+
+func TestCatchupHandler(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &stubReadStateRepo{
+		counts: []repository.SourceUnreadCount{{SourceID: 2, SourceName: "Go Blog", Count: 12}},
+		articles: []repository.ArticleWithSource{{
+			Article:    &entity.Article{ID: 30, SourceID: 2, Title: "Go 1.25", URL: "https://go.dev/blog/go1.25", PublishedAt: now},
+			SourceName: "Go Blog",
+		}},
+	}
+	h := readstate.CatchupHandler{Svc: readUC.Service{Repo: repo}}
+
+	rr := serve(h, http.MethodGet, "/me/catchup?per_source=3", "", "alice")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rr.Code, http.StatusOK, rr.Body.String())
+	}
+	var resp readstate.CatchupDTO
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if repo.gotUser != "alice" || repo.gotPerSrc != 3 {
+		t.Errorf("repo called with user %q, perSource %d", repo.gotUser, repo.gotPerSrc)
+	}
+	if resp.TotalUnread != 12 || len(resp.Sources) != 1 {
+		t.Fatalf("response = %+v", resp)
+	}
+	src := resp.Sources[0]
+	if src.SourceName != "Go Blog" || src.UnreadCount != 12 || len(src.Articles) != 1 || src.Articles[0].ID != 30 {
+		t.Errorf("source = %+v", src)
+	}
+}
+
+func TestCatchupHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		user     string
+		repo     *stubReadStateRepo
+		wantCode int
+	}{
+		{name: "per_source not a number", target: "/me/catchup?per_source=abc", user: "alice", repo: &stubReadStateRepo{}, wantCode: http.StatusBadRequest},
+		{name: "per_source too large", target: "/me/catchup?per_source=21", user: "alice", repo: &stubReadStateRepo{}, wantCode: http.StatusBadRequest},
+		{name: "no user", target: "/me/catchup", repo: &stubReadStateRepo{}, wantCode: http.StatusUnauthorized},
+		{name: "repository error", target: "/me/catchup", user: "alice", repo: &stubReadStateRepo{err: errors.New("db down")}, wantCode: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := readstate.CatchupHandler{Svc: readUC.Service{Repo: tt.repo}}
+			rr := serve(h, http.MethodGet, tt.target, "", tt.user)
+			if rr.Code != tt.wantCode {
+				t.Errorf("status = %d, want %d: %s", rr.Code, tt.wantCode, rr.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package readstate
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"catchup-feed/internal/handler/http/auth"
+	"catchup-feed/internal/handler/http/pathutil"
+	"catchup-feed/internal/handler/http/respond"
+	"catchup-feed/internal/repository"
+	readUC "catchup-feed/internal/usecase/readstate"
+)
+
+type MarkReadHandler struct{ Svc readUC.Service }
+
+// ServeHTTP 記事を既読にする
+// @Summary      記事を既読にする
+// @Description  認証ユーザー（JWT の sub）について、指定された記事を既読にします。既読の記事に対しては既読日時を更新します
+// @Tags         read-state
+// @Security     BearerAuth
+// @Param        id path int true "記事ID"
+// @Success      204 "No Content"
+// @Failure      400 {string} string "Bad request - invalid article ID"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      404 {string} string "Not found - article not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/read/articles/{id} [put]
+func (h MarkReadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(r.URL.Path, "/me/read/articles/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.Svc.MarkRead(r.Context(), auth.UserFromContext(r.Context()), id); err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type MarkUnreadHandler struct{ Svc readUC.Service }
+
+// ServeHTTP 記事を未読に戻す
+// @Summary      記事を未読に戻す
+// @Description  認証ユーザー（JWT の sub）について、指定された記事の既読を取り消します
+// @Tags         read-state
+// @Security     BearerAuth
+// @Param        id path int true "記事ID"
+// @Success      204 "No Content"
+// @Failure      400 {string} string "Bad request - invalid article ID"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/read/articles/{id} [delete]
+func (h MarkUnreadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(r.URL.Path, "/me/read/articles/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.Svc.MarkUnread(r.Context(), auth.UserFromContext(r.Context()), id); err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type MarkAllReadHandler struct{ Svc readUC.Service }
+
+// ServeHTTP 記事をまとめて既読にする
+// @Summary      記事をまとめて既読にする
+// @Description  認証ユーザー（JWT の sub）について、before（ISO 8601）以前に公開された記事、source_id のソースの記事、または両方の条件を満たす記事を既読にします。条件を省略するとすべての記事を既読にします
+// @Tags         read-state
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        filter body object false "条件（before, source_id）"
+// @Success      200 {object} MarkAllReadDTO "新たに既読になった記事数"
+// @Failure      400 {string} string "Bad request - invalid body"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/read [post]
+func (h MarkAllReadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Before   *time.Time `json:"before"`
+		SourceID *int64     `json:"source_id"`
+	}
+	// 本文なしはすべての記事を既読にする
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	marked, err := h.Svc.MarkAllRead(r.Context(), auth.UserFromContext(r.Context()),
+		repository.MarkReadFilter{Before: req.Before, SourceID: req.SourceID})
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, MarkAllReadDTO{Marked: marked})
+}
+
+// errorStatus maps read state use case errors to HTTP status codes.
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, readUC.ErrUserRequired):
+		return http.StatusUnauthorized
+	case errors.Is(err, readUC.ErrInvalidArticleID), errors.Is(err, readUC.ErrInvalidSourceID):
+		return http.StatusBadRequest
+	case errors.Is(err, readUC.ErrArticleNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,17 @@
+package readstate
+
+import (
+	"net/http"
+
+	readUC "catchup-feed/internal/usecase/readstate"
+)
+
+// Register registers the read state HTTP handlers of the current user with the
+// given mux. All routes act on the JWT subject of the request, so every role may
+// use them (see auth.Permission.UserPaths).
+func Register(mux *http.ServeMux, svc readUC.Service) {
+	mux.Handle("GET    /me/catchup", CatchupHandler{svc})
+	mux.Handle("POST   /me/read", MarkAllReadHandler{svc})
+	mux.Handle("PUT    /me/read/articles/", MarkReadHandler{svc})
+	mux.Handle("DELETE /me/read/articles/", MarkUnreadHandler{svc})
+}
@@ -0,0 +1,14 @@
+package readstate
+
+import (
+	"catchup-feed/internal/handler/http/apiversion"
+	readUC "catchup-feed/internal/usecase/readstate"
+)
+
+// Response mappers of the read-state endpoints (see apiversion.Mapper).
+var (
+	// catchupResponse maps the catch-up view.
+	catchupResponse = apiversion.Mapper[*readUC.Catchup]{
+		apiversion.V1: func(c *readUC.Catchup) any { return toCatchupDTO(c) },
+	}
+)
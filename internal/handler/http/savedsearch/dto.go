@@ -0,0 +1,97 @@
+// Package savedsearch provides HTTP handlers for the saved article searches of
+// the current user.
+package savedsearch
+
+import (
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	savedsearchUC "catchup-feed/internal/usecase/savedsearch"
+)
+
+// searchInput is the request body of creating and updating a saved search.
+type searchInput struct {
+	Name       string     `json:"name"`
+	Keyword    string     `json:"keyword"`
+	SourceID   *int64     `json:"source_id"`
+	From       *time.Time `json:"from"`
+	To         *time.Time `json:"to"`
+	Tags       []string   `json:"tags"`
+	Subscribed bool       `json:"subscribed"`
+	Channel    string     `json:"channel"`
+}
+
+func (in searchInput) toUsecase() savedsearchUC.Input {
+	return savedsearchUC.Input{
+		Name:       in.Name,
+		Keyword:    in.Keyword,
+		SourceID:   in.SourceID,
+		From:       in.From,
+		To:         in.To,
+		Tags:       in.Tags,
+		Subscribed: in.Subscribed,
+		Channel:    in.Channel,
+	}
+}
+
+// SearchDTO represents a saved search of the current user.
+type SearchDTO struct {
+	ID         int64      `json:"id" example:"1"`
+	Name       string     `json:"name" example:"Go のリリース情報"`
+	Keyword    string     `json:"keyword" example:"go release"`
+	SourceID   *int64     `json:"source_id,omitempty" example:"1"`
+	From       *time.Time `json:"from,omitempty" example:"2025-01-01T00:00:00Z"`
+	To         *time.Time `json:"to,omitempty" example:"2025-12-31T23:59:59Z"`
+	Tags       []string   `json:"tags" example:"go"`
+	Subscribed bool       `json:"subscribed" example:"true"`
+	// Channel is "discord", "slack" or empty for every enabled channel.
+	Channel       string     `json:"channel" example:"slack"`
+	LastAlertedAt *time.Time `json:"last_alerted_at,omitempty" example:"2025-11-15T09:00:00Z"`
+	CreatedAt     time.Time  `json:"created_at" example:"2025-11-01T12:00:00Z"`
+	UpdatedAt     time.Time  `json:"updated_at" example:"2025-11-01T12:00:00Z"`
+}
+
+// ArticleDTO represents an article matching a saved search.
+type ArticleDTO struct {
+	ID          int64     `json:"id" example:"42"`
+	SourceID    int64     `json:"source_id" example:"1"`
+	SourceName  string    `json:"source_name" example:"Go Blog"`
+	Title       string    `json:"title" example:"Go 1.25 is released"`
+	URL         string    `json:"url" example:"https://go.dev/blog/go1.25"`
+	Summary     string    `json:"summary" example:"Go 1.25 の主な変更点を紹介しています。"`
+	PublishedAt time.Time `json:"published_at" example:"2025-11-14T18:00:00Z"`
+}
+
+func toSearchDTO(s *entity.SavedSearch) SearchDTO {
+	tags := s.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return SearchDTO{
+		ID:            s.ID,
+		Name:          s.Name,
+		Keyword:       s.Keyword,
+		SourceID:      s.SourceID,
+		From:          s.From,
+		To:            s.To,
+		Tags:          tags,
+		Subscribed:    s.Subscribed,
+		Channel:       s.Channel,
+		LastAlertedAt: s.LastAlertedAt,
+		CreatedAt:     s.CreatedAt,
+		UpdatedAt:     s.UpdatedAt,
+	}
+}
+
+func toArticleDTO(a repository.ArticleWithSource) ArticleDTO {
+	return ArticleDTO{
+		ID:          a.Article.ID,
+		SourceID:    a.Article.SourceID,
+		SourceName:  a.SourceName,
+		Title:       a.Article.Title,
+		URL:         a.Article.URL,
+		Summary:     a.Article.Summary,
+		PublishedAt: a.Article.PublishedAt,
+	}
+}
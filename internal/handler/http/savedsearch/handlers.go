@@ -0,0 +1,226 @@
+package savedsearch
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/auth"
+	"catchup-feed/internal/handler/http/pathutil"
+	"catchup-feed/internal/handler/http/respond"
+	savedsearchUC "catchup-feed/internal/usecase/savedsearch"
+)
+
+type ListHandler struct{ Svc savedsearchUC.Service }
+
+// ServeHTTP 保存した検索の一覧取得
+// @Summary      保存した検索の一覧取得
+// @Description  認証ユーザー（JWT の sub）の保存した検索を作成順に取得します
+// @Tags         saved-searches
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {array} SearchDTO "保存した検索の一覧"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/searches [get]
+func (h ListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	searches, err := h.Svc.List(r.Context(), auth.UserFromContext(r.Context()))
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	out := make([]SearchDTO, 0, len(searches))
+	for _, s := range searches {
+		out = append(out, toSearchDTO(s))
+	}
+	respond.JSON(w, http.StatusOK, out)
+}
+
+type CreateHandler struct{ Svc savedsearchUC.Service }
+
+// ServeHTTP 検索を保存する
+// @Summary      検索を保存する
+// @Description  キーワードと絞り込み条件（/articles/search と同じ）を名前を付けて保存します。subscribed を true にすると、以降のクロールで見つかった新着の一致記事を通知します（channel: discord / slack / 空ならすべて）
+// @Tags         saved-searches
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        search body object true "検索（name, keyword, source_id, from, to, tags, subscribed, channel）"
+// @Success      201 {object} SearchDTO "保存された検索"
+// @Failure      400 {string} string "Bad request - invalid search"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/searches [post]
+func (h CreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req searchInput
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	saved, err := h.Svc.Create(r.Context(), auth.UserFromContext(r.Context()), req.toUsecase())
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusCreated, toSearchDTO(saved))
+}
+
+type GetHandler struct{ Svc savedsearchUC.Service }
+
+// ServeHTTP 保存した検索の取得
+// @Summary      保存した検索の取得
+// @Description  認証ユーザー（JWT の sub）の保存した検索を取得します
+// @Tags         saved-searches
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path int true "保存した検索のID"
+// @Success      200 {object} SearchDTO "保存した検索"
+// @Failure      400 {string} string "Bad request - invalid saved search ID"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      404 {string} string "Not found - saved search not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/searches/{id} [get]
+func (h GetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(r.URL.Path, "/me/searches/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	saved, err := h.Svc.Get(r.Context(), auth.UserFromContext(r.Context()), id)
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, toSearchDTO(saved))
+}
+
+type UpdateHandler struct{ Svc savedsearchUC.Service }
+
+// ServeHTTP 保存した検索の更新
+// @Summary      保存した検索の更新
+// @Description  認証ユーザー（JWT の sub）の保存した検索の名前・条件・通知設定を置き換えます。通知を有効にし直した場合、それまでに取得された記事は通知しません
+// @Tags         saved-searches
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "保存した検索のID"
+// @Param        search body object true "検索（name, keyword, source_id, from, to, tags, subscribed, channel）"
+// @Success      200 {object} SearchDTO "更新された検索"
+// @Failure      400 {string} string "Bad request - invalid saved search ID or search"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      404 {string} string "Not found - saved search not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/searches/{id} [put]
+func (h UpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(r.URL.Path, "/me/searches/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+	var req searchInput
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	saved, err := h.Svc.Update(r.Context(), auth.UserFromContext(r.Context()), id, req.toUsecase())
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, toSearchDTO(saved))
+}
+
+type DeleteHandler struct{ Svc savedsearchUC.Service }
+
+// ServeHTTP 保存した検索の削除
+// @Summary      保存した検索の削除
+// @Description  認証ユーザー（JWT の sub）の保存した検索を削除します。通知も止まります
+// @Tags         saved-searches
+// @Security     BearerAuth
+// @Param        id path int true "保存した検索のID"
+// @Success      204 "No Content"
+// @Failure      400 {string} string "Bad request - invalid saved search ID"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      404 {string} string "Not found - saved search not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/searches/{id} [delete]
+func (h DeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(r.URL.Path, "/me/searches/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.Svc.Delete(r.Context(), auth.UserFromContext(r.Context()), id); err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type RunHandler struct {
+	Svc           savedsearchUC.Service
+	PaginationCfg pagination.Config
+}
+
+// ServeHTTP 保存した検索の実行
+// @Summary      保存した検索の実行（ページネーション対応）
+// @Description  認証ユーザー（JWT の sub）の保存した検索を実行し、一致する記事を公開日時の新しい順に取得します
+// @Tags         saved-searches
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id     path     int  true   "保存した検索のID"
+// @Param        page   query    int  false  "ページ番号 (1-based)" default(1) minimum(1)
+// @Param        limit  query    int  false  "1ページあたりの件数" default(20) minimum(1) maximum(100)
+// @Success      200 {object} pagination.Response[ArticleDTO] "ページネーション付き検索結果"
+// @Failure      400 {string} string "Bad request - invalid saved search ID or query parameters"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      404 {string} string "Not found - saved search not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /me/searches/{id}/run [get]
+func (h RunHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(strings.TrimSuffix(r.URL.Path, "/run"), "/me/searches/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+	params, err := pagination.ParseQueryParams(r, h.PaginationCfg)
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if params.Keyset {
+		respond.SafeError(w, http.StatusBadRequest,
+			errors.New("invalid query parameter: cursor pagination is not supported for saved searches"))
+		return
+	}
+
+	result, err := h.Svc.Run(r.Context(), auth.UserFromContext(r.Context()), id, params)
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+
+	respond.JSON(w, http.StatusOK, matchesResponse.Map(r.Context(), result))
+}
+
+// errorStatus maps saved search use case errors to HTTP status codes.
+func errorStatus(err error) int {
+	var ve *entity.ValidationError
+	switch {
+	case errors.Is(err, savedsearchUC.ErrUserRequired):
+		return http.StatusUnauthorized
+	case errors.As(err, &ve), errors.Is(err, savedsearchUC.ErrInvalidSearchID):
+		return http.StatusBadRequest
+	case errors.Is(err, savedsearchUC.ErrSearchNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,240 @@
+package savedsearch_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/auth"
+	"catchup-feed/internal/handler/http/savedsearch"
+	"catchup-feed/internal/repository"
+	savedsearchUC "catchup-feed/internal/usecase/savedsearch"
+)
+
+/* ───────── モック ───────── */
+
+// stubSearchRepo holds a single saved search (ID 1) owned by "alice".
+type stubSearchRepo struct {
+	search  *entity.SavedSearch
+	created *entity.SavedSearch
+}
+
+func newStubSearchRepo() *stubSearchRepo {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	return &stubSearchRepo{
+		search: &entity.SavedSearch{ID: 1, UserID: "alice", Name: "Go", Keyword: "go", CreatedAt: now, UpdatedAt: now},
+	}
+}
+
+func (s *stubSearchRepo) owned(userID string, id int64) bool {
+	return s.search != nil && s.search.UserID == userID && s.search.ID == id
+}
+
+func (s *stubSearchRepo) ListSearches(_ context.Context, userID string) ([]*entity.SavedSearch, error) {
+	if s.search == nil || s.search.UserID != userID {
+		return nil, nil
+	}
+	return []*entity.SavedSearch{s.search}, nil
+}
+func (s *stubSearchRepo) GetSearch(_ context.Context, userID string, id int64) (*entity.SavedSearch, error) {
+	if !s.owned(userID, id) {
+		return nil, nil
+	}
+	c := *s.search
+	return &c, nil
+}
+func (s *stubSearchRepo) CreateSearch(_ context.Context, search *entity.SavedSearch) error {
+	search.ID = 2
+	s.created = search
+	return nil
+}
+func (s *stubSearchRepo) UpdateSearch(_ context.Context, search *entity.SavedSearch) (bool, error) {
+	return s.owned(search.UserID, search.ID), nil
+}
+func (s *stubSearchRepo) DeleteSearch(_ context.Context, userID string, id int64) (bool, error) {
+	return s.owned(userID, id), nil
+}
+func (s *stubSearchRepo) ListSubscribed(context.Context) ([]*entity.SavedSearch, error) {
+	return nil, nil
+}
+func (s *stubSearchRepo) LatestArticleID(context.Context) (int64, error) {
+	return 130, nil
+}
+func (s *stubSearchRepo) ListNewMatches(context.Context, []string, repository.ArticleSearchFilters, int64, int64, int) ([]repository.ArticleWithSource, error) {
+	return nil, nil
+}
+func (s *stubSearchRepo) MarkEvaluated(context.Context, int64, int64, *time.Time) error {
+	return nil
+}
+
+type stubArticles struct {
+	gotKeywords []string
+	gotOffset   int
+	gotLimit    int
+}
+
+func (s *stubArticles) CountArticlesWithFilters(context.Context, []string, repository.ArticleSearchFilters) (int64, error) {
+	return 21, nil
+}
+func (s *stubArticles) SearchWithFiltersPaginated(_ context.Context, keywords []string, _ repository.ArticleSearchFilters, offset, limit int) ([]repository.ArticleWithSource, error) {
+	s.gotKeywords, s.gotOffset, s.gotLimit = keywords, offset, limit
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	return []repository.ArticleWithSource{
+		{Article: &entity.Article{ID: 30, SourceID: 2, Title: "Go 1.25", URL: "https://go.dev/blog/go1.25", PublishedAt: now}, SourceName: "Go Blog"},
+	}, nil
+}
+
+func newMux(repo *stubSearchRepo, articles *stubArticles) *http.ServeMux {
+	mux := http.NewServeMux()
+	savedsearch.Register(mux, savedsearchUC.Service{Repo: repo, Articles: articles}, pagination.DefaultConfig())
+	return mux
+}
+
+// serve runs handler for a request made by user ("" for an unauthenticated request).
+func serve(handler http.Handler, method, target, body, user string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if user != "" {
+		req = req.WithContext(auth.WithUser(req.Context(), user))
+	}
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	return rr
+}
+
+/* ───────── テスト ───────── */
+
+func TestCreateHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		user     string
+		wantCode int
+	}{
+		{name: "created", body: `{"name":"Go","keyword":"go release","tags":["Go"],"subscribed":true,"channel":"slack"}`, user: "alice", wantCode: http.StatusCreated},
+		{name: "filters only", body: `{"name":"Go Blog","source_id":2,"from":"2025-01-01T00:00:00Z"}`, user: "alice", wantCode: http.StatusCreated},
+		{name: "no criteria", body: `{"name":"Go"}`, user: "alice", wantCode: http.StatusBadRequest},
+		{name: "unknown channel", body: `{"name":"Go","keyword":"go","channel":"email"}`, user: "alice", wantCode: http.StatusBadRequest},
+		{name: "invalid json", body: `{`, user: "alice", wantCode: http.StatusBadRequest},
+		{name: "no user", body: `{"name":"Go","keyword":"go"}`, wantCode: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubSearchRepo()
+			rr := serve(newMux(repo, &stubArticles{}), http.MethodPost, "/me/searches", tt.body, tt.user)
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d: %s", rr.Code, tt.wantCode, rr.Body.String())
+			}
+			if tt.wantCode != http.StatusCreated {
+				return
+			}
+			var got savedsearch.SearchDTO
+			if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+				t.Fatal(err)
+			}
+			if got.ID != 2 || repo.created.UserID != "alice" || repo.created.LastArticleID != 130 {
+				t.Errorf("created = %+v", repo.created)
+			}
+		})
+	}
+}
+
+func TestCreateHandler_ValidationMessage(t *testing.T) {
+	rr := serve(newMux(newStubSearchRepo(), &stubArticles{}), http.MethodPost, "/me/searches",
+		`{"name":"Go","keyword":"go","channel":"email"}`, "alice")
+	if rr.Code != http.StatusBadRequest || !strings.Contains(rr.Body.String(), "channel") {
+		t.Errorf("status = %d, body = %s", rr.Code, rr.Body.String())
+	}
+}
+
+func TestListAndGetHandlers(t *testing.T) {
+	mux := newMux(newStubSearchRepo(), &stubArticles{})
+
+	rr := serve(mux, http.MethodGet, "/me/searches", "", "alice")
+	var list []savedsearch.SearchDTO
+	if err := json.Unmarshal(rr.Body.Bytes(), &list); err != nil {
+		t.Fatal(err)
+	}
+	if rr.Code != http.StatusOK || len(list) != 1 || list[0].Name != "Go" || list[0].Tags == nil {
+		t.Errorf("list: status = %d, body = %s", rr.Code, rr.Body.String())
+	}
+
+	rr = serve(mux, http.MethodGet, "/me/searches", "", "bob")
+	if rr.Code != http.StatusOK || strings.TrimSpace(rr.Body.String()) != "[]" {
+		t.Errorf("other user's list: status = %d, body = %s", rr.Code, rr.Body.String())
+	}
+
+	tests := []struct {
+		target   string
+		user     string
+		wantCode int
+	}{
+		{target: "/me/searches/1", user: "alice", wantCode: http.StatusOK},
+		{target: "/me/searches/1", user: "bob", wantCode: http.StatusNotFound},
+		{target: "/me/searches/abc", user: "alice", wantCode: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		if rr := serve(mux, http.MethodGet, tt.target, "", tt.user); rr.Code != tt.wantCode {
+			t.Errorf("GET %s as %s: status = %d, want %d", tt.target, tt.user, rr.Code, tt.wantCode)
+		}
+	}
+}
+
+func TestUpdateAndDeleteHandlers(t *testing.T) {
+	mux := newMux(newStubSearchRepo(), &stubArticles{})
+
+	rr := serve(mux, http.MethodPut, "/me/searches/1", `{"name":"Go 2","keyword":"go","subscribed":true}`, "alice")
+	var got savedsearch.SearchDTO
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if rr.Code != http.StatusOK || got.Name != "Go 2" || !got.Subscribed {
+		t.Errorf("update: status = %d, body = %s", rr.Code, rr.Body.String())
+	}
+	if rr := serve(mux, http.MethodPut, "/me/searches/1", `{"name":"Go","keyword":"go"}`, "bob"); rr.Code != http.StatusNotFound {
+		t.Errorf("update other user's search: status = %d, want 404", rr.Code)
+	}
+
+	if rr := serve(mux, http.MethodDelete, "/me/searches/1", "", "alice"); rr.Code != http.StatusNoContent {
+		t.Errorf("delete: status = %d, want 204", rr.Code)
+	}
+	if rr := serve(mux, http.MethodDelete, "/me/searches/2", "", "alice"); rr.Code != http.StatusNotFound {
+		t.Errorf("delete missing search: status = %d, want 404", rr.Code)
+	}
+}
+
+func TestRunHandler(t *testing.T) {
+	articles := &stubArticles{}
+	mux := newMux(newStubSearchRepo(), articles)
+
+	rr := serve(mux, http.MethodGet, "/me/searches/1/run?page=2&limit=10", "", "alice")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", rr.Code, rr.Body.String())
+	}
+	var got pagination.Response[savedsearch.ArticleDTO]
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Data) != 1 || got.Data[0].SourceName != "Go Blog" {
+		t.Errorf("Data = %+v", got.Data)
+	}
+	wantMeta := pagination.Metadata{Total: 21, Page: 2, Limit: 10, TotalPages: 3}
+	if got.Pagination != wantMeta {
+		t.Errorf("Pagination = %+v, want %+v", got.Pagination, wantMeta)
+	}
+	if len(articles.gotKeywords) != 1 || articles.gotOffset != 10 || articles.gotLimit != 10 {
+		t.Errorf("search called with (%v, %d, %d)", articles.gotKeywords, articles.gotOffset, articles.gotLimit)
+	}
+
+	if rr := serve(mux, http.MethodGet, "/me/searches/1/run", "", "bob"); rr.Code != http.StatusNotFound {
+		t.Errorf("other user's search: status = %d, want 404", rr.Code)
+	}
+	if rr := serve(mux, http.MethodGet, "/me/searches/1/run?cursor=abc", "", "alice"); rr.Code != http.StatusBadRequest {
+		t.Errorf("cursor: status = %d, want 400", rr.Code)
+	}
+}
@@ -0,0 +1,20 @@
+package savedsearch
+
+import (
+	"net/http"
+
+	"catchup-feed/internal/common/pagination"
+	savedsearchUC "catchup-feed/internal/usecase/savedsearch"
+)
+
+// Register registers the saved search HTTP handlers of the current user with the
+// given mux. All routes act on the JWT subject of the request, so every role may
+// use them (see auth.Permission.UserPaths).
+func Register(mux *http.ServeMux, svc savedsearchUC.Service, paginationCfg pagination.Config) {
+	mux.Handle("GET    /me/searches", ListHandler{svc})
+	mux.Handle("POST   /me/searches", CreateHandler{svc})
+	mux.Handle("GET    /me/searches/{id}", GetHandler{svc})
+	mux.Handle("PUT    /me/searches/{id}", UpdateHandler{svc})
+	mux.Handle("DELETE /me/searches/{id}", DeleteHandler{svc})
+	mux.Handle("GET    /me/searches/{id}/run", RunHandler{Svc: svc, PaginationCfg: paginationCfg})
+}
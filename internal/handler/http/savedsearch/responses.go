@@ -0,0 +1,21 @@
+package savedsearch
+
+import (
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/handler/http/apiversion"
+	savedsearchUC "catchup-feed/internal/usecase/savedsearch"
+)
+
+// Response mappers of the saved search endpoints that return articles (see apiversion.Mapper).
+var (
+	// matchesResponse maps a page of the articles matching a saved search.
+	matchesResponse = apiversion.Mapper[*savedsearchUC.RunResult]{
+		apiversion.V1: func(p *savedsearchUC.RunResult) any {
+			out := make([]ArticleDTO, 0, len(p.Data))
+			for _, a := range p.Data {
+				out = append(out, toArticleDTO(a))
+			}
+			return pagination.NewResponse(out, p.Pagination)
+		},
+	}
+)
@@ -0,0 +1,107 @@
+// Package searchfilter parses the article search filters shared by the endpoints
+// that select articles like /articles/search: the export, the stream and the feeds.
+package searchfilter
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/pkg/search"
+	"catchup-feed/internal/pkg/validation"
+	"catchup-feed/internal/repository"
+)
+
+// MaxTags is the maximum number of tag= query parameters per request.
+const MaxTags = 10
+
+// Query is the keywords and filters of an article search.
+type Query struct {
+	Keywords []string
+	Filters  repository.ArticleSearchFilters
+}
+
+// Parse builds the search query from the keyword, source_id, from, to and the
+// repeated tag query parameters. Every parameter is optional.
+//
+// The error messages start with "invalid", so they can be returned to clients
+// as they are (see respond.SafeError).
+func Parse(params url.Values) (Query, error) {
+	var q Query
+
+	if kw := params.Get("keyword"); kw != "" {
+		keywords, err := search.ParseKeywords(kw, search.DefaultMaxKeywordCount, search.DefaultMaxKeywordLength)
+		if err != nil {
+			return q, fmt.Errorf("invalid keyword: %w", err)
+		}
+		q.Keywords = keywords
+	}
+
+	if s := params.Get("source_id"); s != "" {
+		sourceID, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return q, errors.New("invalid source_id: must be a valid integer")
+		}
+		if sourceID <= 0 {
+			return q, errors.New("invalid source_id: must be positive")
+		}
+		q.Filters.SourceID = &sourceID
+	}
+
+	if s := params.Get("from"); s != "" {
+		from, err := validation.ParseDateISO8601(s)
+		if err != nil {
+			return q, fmt.Errorf("invalid from date: %w", err)
+		}
+		q.Filters.From = from
+	}
+	if s := params.Get("to"); s != "" {
+		to, err := validation.ParseDateISO8601(s)
+		if err != nil {
+			return q, fmt.Errorf("invalid to date: %w", err)
+		}
+		q.Filters.To = to
+	}
+	if q.Filters.From != nil && q.Filters.To != nil && q.Filters.From.After(*q.Filters.To) {
+		return q, errors.New("invalid date range: from date must be before or equal to to date")
+	}
+
+	tags, err := ParseTags(params["tag"])
+	if err != nil {
+		return q, err
+	}
+	q.Filters.Tags = tags
+	return q, nil
+}
+
+// ParseTags returns the normalized names of the values of the repeated tag= query
+// parameters. Articles must have all of them. Returns nil if none is given.
+func ParseTags(values []string) ([]string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	if len(values) > MaxTags {
+		return nil, fmt.Errorf("invalid tag: at most %d tags are allowed", MaxTags)
+	}
+	for _, v := range values {
+		if entity.NormalizeTagName(v) == "" {
+			return nil, errors.New("invalid tag: must be a non-empty tag name")
+		}
+	}
+	return entity.NormalizeTagNames(values), nil
+}
+
+// ParseLimit returns the value of the limit query parameter, or 0 if it is not given.
+func ParseLimit(params url.Values) (int, error) {
+	s := params.Get("limit")
+	if s == "" {
+		return 0, nil
+	}
+	limit, err := strconv.Atoi(s)
+	if err != nil || limit <= 0 {
+		return 0, errors.New("invalid limit: must be a positive integer")
+	}
+	return limit, nil
+}
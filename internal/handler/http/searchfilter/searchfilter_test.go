@@ -0,0 +1,99 @@
+package searchfilter_test
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"catchup-feed/internal/handler/http/searchfilter"
+)
+
+func TestParse(t *testing.T) {
+	params := url.Values{
+		"keyword":   {"go  generics"},
+		"source_id": {"3"},
+		"from":      {"2025-11-01"},
+		"to":        {"2025-11-30"},
+		"tag":       {" Go ", "release", "go"},
+	}
+
+	q, err := searchfilter.Parse(params)
+	if err != nil {
+		t.Fatalf("Parse err=%v", err)
+	}
+	if strings.Join(q.Keywords, ",") != "go,generics" {
+		t.Errorf("keywords = %v, want [go generics]", q.Keywords)
+	}
+	if q.Filters.SourceID == nil || *q.Filters.SourceID != 3 {
+		t.Errorf("source_id = %v, want 3", q.Filters.SourceID)
+	}
+	if q.Filters.From == nil || q.Filters.To == nil {
+		t.Errorf("from/to = %v/%v, want both set", q.Filters.From, q.Filters.To)
+	}
+	if strings.Join(q.Filters.Tags, ",") != "go,release" {
+		t.Errorf("tags = %v, want normalized and deduplicated [go release]", q.Filters.Tags)
+	}
+
+	empty, err := searchfilter.Parse(url.Values{})
+	if err != nil || empty.Keywords != nil || !empty.Filters.Empty() {
+		t.Errorf("Parse(empty) = %+v, %v, want no filters", empty, err)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tooManyTags := make([]string, searchfilter.MaxTags+1)
+	for i := range tooManyTags {
+		tooManyTags[i] = "t" + strings.Repeat("x", i)
+	}
+
+	tests := []struct {
+		name    string
+		params  url.Values
+		wantErr string
+	}{
+		{name: "source_id not a number", params: url.Values{"source_id": {"abc"}}, wantErr: "invalid source_id"},
+		{name: "source_id not positive", params: url.Values{"source_id": {"0"}}, wantErr: "invalid source_id"},
+		{name: "bad from", params: url.Values{"from": {"yesterday"}}, wantErr: "invalid from date"},
+		{name: "bad to", params: url.Values{"to": {"tomorrow"}}, wantErr: "invalid to date"},
+		{name: "from after to", params: url.Values{"from": {"2025-12-01"}, "to": {"2025-11-01"}}, wantErr: "invalid date range"},
+		{name: "empty tag", params: url.Values{"tag": {" "}}, wantErr: "invalid tag"},
+		{name: "too many tags", params: url.Values{"tag": tooManyTags}, wantErr: "invalid tag"},
+		{name: "too many keywords", params: url.Values{"keyword": {strings.Repeat("a ", 11)}}, wantErr: "invalid keyword"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := searchfilter.Parse(tt.params)
+			if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+				t.Errorf("Parse err = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{value: "", want: 0},
+		{value: "25", want: 25},
+		{value: "0", wantErr: true},
+		{value: "-1", wantErr: true},
+		{value: "ten", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			params := url.Values{}
+			if tt.value != "" {
+				params.Set("limit", tt.value)
+			}
+			got, err := searchfilter.ParseLimit(params)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseLimit(%q) = %d, %v, want %d (error %v)", tt.value, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
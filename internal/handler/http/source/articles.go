@@ -0,0 +1,59 @@
+package source
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/handler/http/pathutil"
+	"catchup-feed/internal/handler/http/respond"
+	srcUC "catchup-feed/internal/usecase/source"
+)
+
+type ArticlesHandler struct {
+	Svc           srcUC.Service
+	PaginationCfg pagination.Config
+}
+
+// ServeHTTP ソースの記事一覧取得
+// @Summary      ソースの記事一覧取得（ページネーション対応）
+// @Description  指定されたソースの記事を公開日時の新しい順に取得します
+// @Tags         sources
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id     path     int  true   "ソースID"
+// @Param        page   query    int  false  "ページ番号 (1-based)" default(1) minimum(1)
+// @Param        limit  query    int  false  "1ページあたりの件数" default(20) minimum(1) maximum(100)
+// @Success      200 {object} pagination.Response[ArticleDTO] "ページネーション付き記事一覧"
+// @Failure      400 {string} string "Invalid source ID or query parameters"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - insufficient permissions"
+// @Failure      404 {string} string "Not found - source not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /sources/{id}/articles [get]
+func (h ArticlesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(strings.TrimSuffix(r.URL.Path, "/articles"), "/sources/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	params, err := pagination.ParseQueryParams(r, h.PaginationCfg)
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if params.Keyset {
+		respond.SafeError(w, http.StatusBadRequest,
+			errors.New("invalid query parameter: cursor pagination is not supported for source articles"))
+		return
+	}
+
+	page, err := h.Svc.ListArticlesPaginated(r.Context(), id, params)
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, articlesResponse.Map(r.Context(), page))
+}
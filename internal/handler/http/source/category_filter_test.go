@@ -0,0 +1,49 @@
+package source_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/source"
+	srcUC "catchup-feed/internal/usecase/source"
+)
+
+func TestCategoryFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler func(srcUC.Service) http.Handler
+		target  string
+	}{
+		{name: "list", handler: func(svc srcUC.Service) http.Handler { return source.ListHandler{Svc: svc} },
+			target: "/sources?category_id=4"},
+		{name: "search", handler: func(svc srcUC.Service) http.Handler { return source.SearchHandler{Svc: svc} },
+			target: "/sources/search?category_id=4&active=true"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &stubSearchRepo{sources: []*entity.Source{{ID: 1, Name: "Go Blog"}}}
+			rr := httptest.NewRecorder()
+			tt.handler(srcUC.Service{Repo: stub}).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			if rr.Code != http.StatusOK {
+				t.Fatalf("status code = %d, want %d", rr.Code, http.StatusOK)
+			}
+			if stub.lastFilters.CategoryID == nil || *stub.lastFilters.CategoryID != 4 {
+				t.Errorf("filters.CategoryID = %v, want 4", stub.lastFilters.CategoryID)
+			}
+		})
+	}
+}
+
+func TestCategoryFilter_Invalid(t *testing.T) {
+	for _, target := range []string{"/sources?category_id=abc", "/sources?category_id=0"} {
+		rr := httptest.NewRecorder()
+		source.ListHandler{Svc: srcUC.Service{Repo: &stubSearchRepo{}}}.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, target, nil))
+		if rr.Code != http.StatusBadRequest {
+			t.Errorf("%s: status code = %d, want %d", target, rr.Code, http.StatusBadRequest)
+		}
+	}
+}
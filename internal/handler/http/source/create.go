@@ -27,8 +27,9 @@ type CreateHandler struct{ Svc srcUC.Service }
 // @Router       /sources [post]
 func (h CreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	var req struct {
-		Name    string `json:"name"`
-		FeedURL string `json:"feedURL"`
+		Name           string `json:"name"`
+		FeedURL        string `json:"feedURL"`
+		PromptTemplate string `json:"promptTemplate"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		respond.SafeError(w, http.StatusBadRequest, err)
@@ -41,6 +42,7 @@ func (h CreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	err := h.Svc.Create(r.Context(), srcUC.CreateInput{
 		Name: req.Name, FeedURL: req.FeedURL,
+		PromptTemplate: req.PromptTemplate,
 	})
 	if err != nil {
 		respond.SafeError(w, http.StatusBadRequest, err)
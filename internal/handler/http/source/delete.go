@@ -12,7 +12,7 @@ type DeleteHandler struct{ Svc srcUC.Service }
 
 // ServeHTTP ソース削除
 // @Summary      ソース削除
-// @Description  ソースを削除します
+// @Description  ソースとその記事をゴミ箱へ移します。保持期間内は POST /sources/{id}/restore で復元できます
 // @Tags         sources
 // @Security     BearerAuth
 // @Param        id path int true "ソースID"
@@ -1,15 +1,106 @@
 package source
 
-import "time"
+import (
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	srcUC "catchup-feed/internal/usecase/source"
+)
 
 type DTO struct {
-	ID            int64      `json:"id"`
-	Name          string     `json:"name"`
-	FeedURL       string     `json:"feed_url"`
-	URL           string     `json:"url"` // Mapped from FeedURL for frontend compatibility
-	SourceType    string     `json:"source_type"`
-	LastCrawledAt *time.Time `json:"last_crawled_at,omitempty"`
-	Active        bool       `json:"active"`
-	CreatedAt     time.Time  `json:"created_at"`
-	UpdatedAt     time.Time  `json:"updated_at"`
+	ID             int64      `json:"id"`
+	Name           string     `json:"name"`
+	FeedURL        string     `json:"feed_url"`
+	URL            string     `json:"url"` // Mapped from FeedURL for frontend compatibility
+	SourceType     string     `json:"source_type"`
+	LastCrawledAt  *time.Time `json:"last_crawled_at,omitempty"`
+	Active         bool       `json:"active"`
+	PromptTemplate string     `json:"prompt_template,omitempty"`
+
+	// CreatedAt and UpdatedAt are always zero: sources have no creation or update time.
+	// They are kept so that the v1 response does not change.
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// DetailDTO represents a source with its scraper configuration, crawl health,
+// article counts and newest articles.
+type DetailDTO struct {
+	DTO
+	ScraperConfig *entity.ScraperConfig `json:"scraper_config,omitempty"`
+	Health        HealthDTO             `json:"health"`
+	Articles      ArticleCountsDTO      `json:"articles"`
+	// RecentArticles are the newest articles of the source (see the recent query parameter).
+	RecentArticles []ArticleDTO `json:"recent_articles"`
+}
+
+// HealthDTO represents the crawl health of a source.
+type HealthDTO struct {
+	LastCrawledAt *time.Time `json:"last_crawled_at" example:"2025-10-26T12:30:00Z"`
+	// LastSuccessAt is when the newest article of the source was stored.
+	LastSuccessAt             *time.Time `json:"last_success_at" example:"2025-10-26T12:00:00Z"`
+	SummarizeFailuresLastWeek int64      `json:"summarize_failures_last_week" example:"1"`
+}
+
+// ArticleCountsDTO represents the article counts of a source.
+type ArticleCountsDTO struct {
+	Total    int64 `json:"total" example:"120"`
+	LastDay  int64 `json:"last_day" example:"2"`
+	LastWeek int64 `json:"last_week" example:"9"`
+}
+
+// ArticleDTO represents an article of a source.
+type ArticleDTO struct {
+	ID          int64     `json:"id" example:"42"`
+	Title       string    `json:"title" example:"Go 1.25 is released"`
+	URL         string    `json:"url" example:"https://go.dev/blog/go1.25"`
+	Summary     string    `json:"summary" example:"Go 1.25 がリリースされました。"`
+	PublishedAt time.Time `json:"published_at" example:"2025-10-26T10:00:00Z"`
+	CreatedAt   time.Time `json:"created_at" example:"2025-10-26T12:00:00Z"`
+}
+
+func toDTO(e *entity.Source) DTO {
+	return DTO{
+		ID:             e.ID,
+		Name:           e.Name,
+		FeedURL:        e.FeedURL,
+		URL:            e.FeedURL,
+		SourceType:     e.SourceType,
+		LastCrawledAt:  e.LastCrawledAt,
+		Active:         e.Active,
+		PromptTemplate: e.PromptTemplate,
+	}
+}
+
+func toArticleDTOs(arts []*entity.Article) []ArticleDTO {
+	out := make([]ArticleDTO, 0, len(arts))
+	for _, a := range arts {
+		out = append(out, ArticleDTO{
+			ID:          a.ID,
+			Title:       a.Title,
+			URL:         a.URL,
+			Summary:     a.Summary,
+			PublishedAt: a.PublishedAt,
+			CreatedAt:   a.CreatedAt,
+		})
+	}
+	return out
+}
+
+func toDetailDTO(d *srcUC.Detail) DetailDTO {
+	return DetailDTO{
+		DTO:           toDTO(d.Source),
+		ScraperConfig: d.Source.ScraperConfig,
+		Health: HealthDTO{
+			LastCrawledAt:             d.Source.LastCrawledAt,
+			LastSuccessAt:             d.Counts.LastArticleAt,
+			SummarizeFailuresLastWeek: d.Counts.SummarizeFailuresLastWeek,
+		},
+		Articles: ArticleCountsDTO{
+			Total:    d.Counts.Total,
+			LastDay:  d.Counts.LastDay,
+			LastWeek: d.Counts.LastWeek,
+		},
+		RecentArticles: toArticleDTOs(d.Recent),
+	}
 }
@@ -0,0 +1,70 @@
+package source
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/pathutil"
+	"catchup-feed/internal/handler/http/respond"
+	srcUC "catchup-feed/internal/usecase/source"
+)
+
+// defaultRecentArticles is the number of recent articles in a source detail
+// without the recent query parameter.
+const defaultRecentArticles = 5
+
+type GetHandler struct{ Svc srcUC.Service }
+
+// ServeHTTP ソース詳細取得
+// @Summary      ソース詳細取得
+// @Description  指定されたIDのソースを、スクレイパー設定、クロール状況（最終クロール日時・最後に記事を取り込んだ日時・直近7日の要約失敗数）、記事数（累計・直近24時間・直近7日）、最新の記事とともに取得します
+// @Tags         sources
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id      path   int  true   "ソースID"
+// @Param        recent  query  int  false  "最新の記事の件数" default(5) minimum(0) maximum(50)
+// @Success      200 {object} DetailDTO "ソース詳細"
+// @Failure      400 {string} string "Bad request - invalid source ID or recent"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - insufficient permissions"
+// @Failure      404 {string} string "Not found - source not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /sources/{id} [get]
+func (h GetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(r.URL.Path, "/sources/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	recent := defaultRecentArticles
+	if s := r.URL.Query().Get("recent"); s != "" {
+		if recent, err = strconv.Atoi(s); err != nil {
+			respond.SafeError(w, http.StatusBadRequest,
+				fmt.Errorf("invalid recent: must be between 0 and %d", srcUC.MaxRecentArticles))
+			return
+		}
+	}
+
+	detail, err := h.Svc.Detail(r.Context(), id, recent)
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, detailResponse.Map(r.Context(), detail))
+}
+
+func errorStatus(err error) int {
+	var ve *entity.ValidationError
+	switch {
+	case errors.As(err, &ve):
+		return http.StatusBadRequest
+	case errors.Is(err, srcUC.ErrSourceNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
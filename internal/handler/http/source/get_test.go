@@ -0,0 +1,146 @@
+package source_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/source"
+	"catchup-feed/internal/repository"
+	srcUC "catchup-feed/internal/usecase/source"
+)
+
+/* ───────── Get / Articles Handler テスト ───────── */
+
+type stubGetRepo struct {
+	stubCreateRepo
+	source *entity.Source
+}
+
+func (s *stubGetRepo) Get(_ context.Context, id int64) (*entity.Source, error) {
+	if s.source != nil && s.source.ID == id {
+		return s.source, nil
+	}
+	return nil, nil
+}
+
+type stubSourceArticleRepo struct {
+	articles  []*entity.Article
+	counts    repository.SourceArticleCounts
+	gotOffset int
+	gotLimit  int
+}
+
+func (s *stubSourceArticleRepo) ListBySource(_ context.Context, _ int64, offset, limit int) ([]*entity.Article, error) {
+	s.gotOffset, s.gotLimit = offset, limit
+	return s.articles, nil
+}
+
+func (s *stubSourceArticleRepo) CountBySource(context.Context, int64, time.Time, time.Time) (repository.SourceArticleCounts, error) {
+	return s.counts, nil
+}
+
+func newDetailMux(arts *stubSourceArticleRepo) *http.ServeMux {
+	crawled := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	svc := srcUC.Service{
+		Repo: &stubGetRepo{source: &entity.Source{
+			ID: 3, Name: "Example", FeedURL: "https://example.com", SourceType: "Webflow", Active: true,
+			LastCrawledAt: &crawled, ScraperConfig: &entity.ScraperConfig{ItemSelector: ".post"},
+		}},
+		ArticleRepo: arts,
+	}
+	mux := http.NewServeMux()
+	mux.Handle("GET /sources/", source.GetHandler{Svc: svc})
+	mux.Handle("GET /sources/{id}/articles", source.ArticlesHandler{Svc: svc, PaginationCfg: pagination.DefaultConfig()})
+	return mux
+}
+
+func get(h http.Handler, target string) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, target, nil))
+	return rr
+}
+
+func TestGetHandler(t *testing.T) {
+	last := time.Date(2025, 6, 1, 11, 0, 0, 0, time.UTC)
+	arts := &stubSourceArticleRepo{
+		articles: []*entity.Article{{ID: 9, SourceID: 3, Title: "Hello"}},
+		counts:   repository.SourceArticleCounts{Total: 40, LastDay: 2, LastWeek: 9, LastArticleAt: &last, SummarizeFailuresLastWeek: 1},
+	}
+
+	rr := get(newDetailMux(arts), "/sources/3?recent=3")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rr.Code, rr.Body)
+	}
+	var got source.DetailDTO
+	if err := json.NewDecoder(rr.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != 3 || got.SourceType != "Webflow" || got.ScraperConfig == nil || got.ScraperConfig.ItemSelector != ".post" {
+		t.Errorf("source = %+v", got)
+	}
+	if got.Health.LastCrawledAt == nil || got.Health.LastSuccessAt == nil || !got.Health.LastSuccessAt.Equal(last) ||
+		got.Health.SummarizeFailuresLastWeek != 1 {
+		t.Errorf("health = %+v", got.Health)
+	}
+	if got.Articles != (source.ArticleCountsDTO{Total: 40, LastDay: 2, LastWeek: 9}) {
+		t.Errorf("articles = %+v", got.Articles)
+	}
+	if len(got.RecentArticles) != 1 || got.RecentArticles[0].Title != "Hello" || arts.gotLimit != 3 {
+		t.Errorf("recent = %+v (limit %d)", got.RecentArticles, arts.gotLimit)
+	}
+}
+
+func TestGetHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		want   int
+	}{
+		{"invalid id", "/sources/abc", http.StatusBadRequest},
+		{"invalid recent", "/sources/3?recent=x", http.StatusBadRequest},
+		{"too many recent", "/sources/3?recent=51", http.StatusBadRequest},
+		{"not found", "/sources/4", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if rr := get(newDetailMux(&stubSourceArticleRepo{}), tt.target); rr.Code != tt.want {
+				t.Errorf("status = %d, want %d: %s", rr.Code, tt.want, rr.Body)
+			}
+		})
+	}
+}
+
+func TestArticlesHandler(t *testing.T) {
+	arts := &stubSourceArticleRepo{
+		articles: []*entity.Article{{ID: 9, SourceID: 3}, {ID: 8, SourceID: 3}},
+		counts:   repository.SourceArticleCounts{Total: 12},
+	}
+
+	rr := get(newDetailMux(arts), "/sources/3/articles?page=2&limit=10")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rr.Code, rr.Body)
+	}
+	var got pagination.Response[source.ArticleDTO]
+	if err := json.NewDecoder(rr.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if arts.gotOffset != 10 || arts.gotLimit != 10 {
+		t.Errorf("repo got offset=%d limit=%d, want 10, 10", arts.gotOffset, arts.gotLimit)
+	}
+	if len(got.Data) != 2 || got.Pagination.Total != 12 || got.Pagination.TotalPages != 2 {
+		t.Errorf("response = %+v", got)
+	}
+
+	if rr := get(newDetailMux(arts), "/sources/4/articles"); rr.Code != http.StatusNotFound {
+		t.Errorf("missing source status = %d, want 404", rr.Code)
+	}
+	if rr := get(newDetailMux(arts), "/sources/3/articles?pagination=cursor"); rr.Code != http.StatusBadRequest {
+		t.Errorf("cursor pagination status = %d, want 400", rr.Code)
+	}
+}
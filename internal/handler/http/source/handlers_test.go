@@ -337,6 +337,7 @@ type stubSearchRepo struct {
 	sources          []*entity.Source
 	searchErr        error
 	searchWithFilter error
+	lastFilters      repository.SourceSearchFilters
 }
 
 func (s *stubSearchRepo) Search(_ context.Context, _ string) ([]*entity.Source, error) {
@@ -344,6 +345,7 @@ func (s *stubSearchRepo) Search(_ context.Context, _ string) ([]*entity.Source,
 }
 
 func (s *stubSearchRepo) SearchWithFilters(_ context.Context, keywords []string, filters repository.SourceSearchFilters) ([]*entity.Source, error) {
+	s.lastFilters = filters
 	return s.sources, s.searchWithFilter
 }
 
@@ -3,7 +3,9 @@ package source
 import (
 	"net/http"
 
+	"catchup-feed/internal/domain/entity"
 	"catchup-feed/internal/handler/http/respond"
+	"catchup-feed/internal/repository"
 	srcUC "catchup-feed/internal/usecase/source"
 )
 
@@ -11,29 +13,34 @@ type ListHandler struct{ Svc srcUC.Service }
 
 // ServeHTTP ソース一覧取得
 // @Summary      ソース一覧取得
-// @Description  登録されているすべてのソースを取得します
+// @Description  登録されているすべてのソースを取得します。category_id を指定するとそのカテゴリのソースだけを返します
 // @Tags         sources
 // @Security     BearerAuth
 // @Produce      json
+// @Param        category_id query int false "ソースカテゴリIDでフィルタ"
 // @Success      200 {array} DTO "ソース一覧" headers(X-RateLimit-Limit=integer,X-RateLimit-Remaining=integer,X-RateLimit-Reset=integer)
+// @Failure      400 {string} string "Bad request - invalid category_id"
 // @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
 // @Failure      403 {string} string "Forbidden - insufficient permissions"
 // @Failure      429 {string} string "Too many requests - rate limit exceeded" headers(X-RateLimit-Limit=integer,X-RateLimit-Remaining=integer,X-RateLimit-Reset=integer,Retry-After=integer)
 // @Failure      500 {string} string "サーバーエラー"
 // @Router       /sources [get]
 func (h ListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	list, err := h.Svc.List(r.Context())
+	categoryID, err := parseCategoryID(r)
 	if err != nil {
-		respond.SafeError(w, http.StatusInternalServerError, err)
+		respond.SafeError(w, http.StatusBadRequest, err)
 		return
 	}
-	out := make([]DTO, 0, len(list))
-	for _, e := range list {
-		out = append(out, DTO{
-			ID: e.ID, Name: e.Name, FeedURL: e.FeedURL,
-			LastCrawledAt: e.LastCrawledAt,
-			Active:        e.Active,
-		})
+
+	var list []*entity.Source
+	if categoryID != nil {
+		list, err = h.Svc.SearchWithFilters(r.Context(), nil, repository.SourceSearchFilters{CategoryID: categoryID})
+	} else {
+		list, err = h.Svc.List(r.Context())
+	}
+	if err != nil {
+		respond.SafeError(w, http.StatusInternalServerError, err)
+		return
 	}
-	respond.JSON(w, http.StatusOK, out)
+	respond.JSON(w, http.StatusOK, listResponse.Map(r.Context(), list))
 }
@@ -3,19 +3,23 @@ package source
 import (
 	"net/http"
 
+	"catchup-feed/internal/common/pagination"
 	"catchup-feed/internal/handler/http/auth"
 	"catchup-feed/internal/handler/http/middleware"
 	srcUC "catchup-feed/internal/usecase/source"
 )
 
 // Register registers all source-related HTTP handlers with the given mux.
-// It sets up routes for listing, searching, creating, updating, and deleting sources.
+// It sets up routes for listing, searching, getting, creating, updating, and deleting sources
+// and for listing the articles of a source.
 // Protected routes (create, update, delete) require authentication via the auth middleware.
 // Search endpoints are protected by rate limiting to prevent DoS attacks.
-func Register(mux *http.ServeMux, svc srcUC.Service, searchRateLimiter *middleware.RateLimiter) {
+func Register(mux *http.ServeMux, svc srcUC.Service, paginationCfg pagination.Config, searchRateLimiter *middleware.RateLimiter) {
 	mux.Handle("GET    /sources", ListHandler{svc})
 	// Search endpoint with rate limiting (100 req/min per IP)
 	mux.Handle("GET    /sources/search", searchRateLimiter.Middleware(SearchHandler{svc}))
+	mux.Handle("GET    /sources/", GetHandler{svc})
+	mux.Handle("GET    /sources/{id}/articles", ArticlesHandler{Svc: svc, PaginationCfg: paginationCfg})
 
 	mux.Handle("POST   /sources", auth.Authz(CreateHandler{svc}))
 	mux.Handle("PUT    /sources/", auth.Authz(UpdateHandler{svc}))
@@ -0,0 +1,34 @@
+package source
+
+import (
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/apiversion"
+	srcUC "catchup-feed/internal/usecase/source"
+)
+
+// Response mappers of the source endpoints (see apiversion.Mapper).
+var (
+	// listResponse maps a list of sources.
+	listResponse = apiversion.Mapper[[]*entity.Source]{
+		apiversion.V1: func(sources []*entity.Source) any {
+			out := make([]DTO, 0, len(sources))
+			for _, e := range sources {
+				out = append(out, toDTO(e))
+			}
+			return out
+		},
+	}
+
+	// detailResponse maps the detail of a source.
+	detailResponse = apiversion.Mapper[*srcUC.Detail]{
+		apiversion.V1: func(d *srcUC.Detail) any { return toDetailDTO(d) },
+	}
+
+	// articlesResponse maps a page of the articles of a source.
+	articlesResponse = apiversion.Mapper[*srcUC.ArticlePage]{
+		apiversion.V1: func(p *srcUC.ArticlePage) any {
+			return pagination.NewResponse(toArticleDTOs(p.Data), p.Pagination)
+		},
+	}
+)
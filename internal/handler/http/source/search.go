@@ -3,7 +3,6 @@ package source
 import (
 	"net/http"
 	"net/url"
-	"time"
 
 	"catchup-feed/internal/handler/http/respond"
 	"catchup-feed/internal/pkg/search"
@@ -23,6 +22,7 @@ type SearchHandler struct{ Svc srcUC.Service }
 // @Param        keyword query string false "検索キーワード（スペース区切り）"
 // @Param        source_type query string false "ソースタイプでフィルタ（RSS, Webflow, NextJS, Remix）"
 // @Param        active query bool false "アクティブ状態でフィルタ"
+// @Param        category_id query int false "ソースカテゴリIDでフィルタ"
 // @Success      200 {array} DTO "検索結果" headers(X-RateLimit-Limit=integer,X-RateLimit-Remaining=integer,X-RateLimit-Reset=integer)
 // @Failure      400 {string} string "Bad request"
 // @Failure      401 {string} string "Authentication required"
@@ -71,6 +71,13 @@ func (h SearchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		filters.Active = active
 	}
 
+	// Parse category_id filter
+	filters.CategoryID, err = parseCategoryID(r)
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
 	// Execute search with filters
 	list, err := h.Svc.SearchWithFilters(r.Context(), keywords, filters)
 	if err != nil {
@@ -78,22 +85,7 @@ func (h SearchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Convert to DTO
-	out := make([]DTO, 0, len(list))
-	for _, e := range list {
-		out = append(out, DTO{
-			ID:            e.ID,
-			Name:          e.Name,
-			FeedURL:       e.FeedURL,
-			URL:           e.FeedURL, // Map FeedURL to URL for frontend compatibility
-			SourceType:    e.SourceType,
-			LastCrawledAt: e.LastCrawledAt,
-			Active:        e.Active,
-			CreatedAt:     time.Time{}, // Database schema doesn't have created_at column for sources
-			UpdatedAt:     time.Time{}, // Database schema doesn't have updated_at column for sources
-		})
-	}
-	respond.JSON(w, http.StatusOK, out)
+	respond.JSON(w, http.StatusOK, listResponse.Map(r.Context(), list))
 }
 
 func parseKeyword(u *url.URL) string {
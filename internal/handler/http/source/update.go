@@ -36,9 +36,10 @@ func (h UpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req struct {
-		Name   string `json:"name"`
-		Feed   string `json:"feedURL"`
-		Active *bool  `json:"active"`
+		Name           string  `json:"name"`
+		Feed           string  `json:"feedURL"`
+		Active         *bool   `json:"active"`
+		PromptTemplate *string `json:"promptTemplate"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		respond.SafeError(w, http.StatusBadRequest, err)
@@ -47,7 +48,7 @@ func (h UpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	err = h.Svc.Update(r.Context(), srcUC.UpdateInput{
 		ID: id, Name: req.Name, FeedURL: req.Feed,
-		Active: req.Active,
+		Active: req.Active, PromptTemplate: req.PromptTemplate,
 	})
 	if err != nil {
 		code := http.StatusBadRequest
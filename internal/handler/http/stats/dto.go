@@ -0,0 +1,81 @@
+package stats
+
+import (
+	"time"
+
+	statsUC "catchup-feed/internal/usecase/stats"
+)
+
+// SourceStatsDTO represents the activity of one source.
+type SourceStatsDTO struct {
+	SourceID           int64      `json:"source_id" example:"1"`
+	SourceName         string     `json:"source_name" example:"Go Blog"`
+	Active             bool       `json:"active" example:"true"`
+	ArticlesTotal      int64      `json:"articles_total" example:"120"`
+	ArticlesLastDay    int64      `json:"articles_last_day" example:"2"`
+	ArticlesLastWeek   int64      `json:"articles_last_week" example:"9"`
+	AvgSummaryLength   float64    `json:"avg_summary_length" example:"312.5"`
+	SummarizeFailures  int64      `json:"summarize_failures_last_week" example:"1"`
+	SummarizeErrorRate float64    `json:"summarize_error_rate" example:"0.1"`
+	LastSuccessAt      *time.Time `json:"last_success_at" example:"2025-10-26T12:00:00Z"`
+	LastCrawledAt      *time.Time `json:"last_crawled_at" example:"2025-10-26T12:30:00Z"`
+}
+
+// SourcesDTO represents the statistics of every source.
+type SourcesDTO struct {
+	Sources     []SourceStatsDTO `json:"sources"`
+	GeneratedAt time.Time        `json:"generated_at" example:"2025-10-26T12:30:00Z"`
+}
+
+// BucketDTO represents the number of articles ingested in one interval.
+type BucketDTO struct {
+	Start time.Time `json:"start" example:"2025-10-26T00:00:00Z"`
+	Count int64     `json:"count" example:"14"`
+}
+
+// SeriesDTO represents a time series of ingested articles.
+type SeriesDTO struct {
+	Interval    string      `json:"interval" example:"day"`
+	From        time.Time   `json:"from" example:"2025-09-27T00:00:00Z"`
+	To          time.Time   `json:"to" example:"2025-10-27T00:00:00Z"`
+	SourceID    *int64      `json:"source_id,omitempty" example:"1"`
+	Total       int64       `json:"total" example:"412"`
+	Buckets     []BucketDTO `json:"buckets"`
+	GeneratedAt time.Time   `json:"generated_at" example:"2025-10-26T12:30:00Z"`
+}
+
+func toSourcesDTO(r *statsUC.SourceReport) SourcesDTO {
+	out := SourcesDTO{Sources: make([]SourceStatsDTO, 0, len(r.Sources)), GeneratedAt: r.GeneratedAt}
+	for _, s := range r.Sources {
+		out.Sources = append(out.Sources, SourceStatsDTO{
+			SourceID:           s.SourceID,
+			SourceName:         s.SourceName,
+			Active:             s.Active,
+			ArticlesTotal:      s.ArticlesTotal,
+			ArticlesLastDay:    s.ArticlesLastDay,
+			ArticlesLastWeek:   s.ArticlesLastWeek,
+			AvgSummaryLength:   s.AvgSummaryLength,
+			SummarizeFailures:  s.SummarizeFailuresLastWeek,
+			SummarizeErrorRate: s.SummarizeErrorRate,
+			LastSuccessAt:      s.LastArticleAt,
+			LastCrawledAt:      s.LastCrawledAt,
+		})
+	}
+	return out
+}
+
+func toSeriesDTO(s *statsUC.Series) SeriesDTO {
+	out := SeriesDTO{
+		Interval:    s.Interval,
+		From:        s.From,
+		To:          s.To,
+		SourceID:    s.SourceID,
+		Total:       s.Total,
+		Buckets:     make([]BucketDTO, 0, len(s.Buckets)),
+		GeneratedAt: s.GeneratedAt,
+	}
+	for _, b := range s.Buckets {
+		out.Buckets = append(out.Buckets, BucketDTO{Start: b.Start, Count: b.Count})
+	}
+	return out
+}
@@ -0,0 +1,109 @@
+// Package stats provides the HTTP handlers of the source and article statistics.
+package stats
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/respond"
+	"catchup-feed/internal/pkg/validation"
+	statsUC "catchup-feed/internal/usecase/stats"
+)
+
+type SourcesHandler struct{ Svc *statsUC.Service }
+
+// ServeHTTP ソース別統計取得
+// @Summary      ソース別統計取得
+// @Description  ソースごとの記事数（累計・直近24時間・直近7日）、平均要約文字数、直近7日の要約エラー率、最後に記事を取り込んだ日時を取得します。集計結果は1分間キャッシュされます
+// @Tags         stats
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} SourcesDTO "ソース別統計"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - insufficient permissions"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /stats/sources [get]
+func (h SourcesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	report, err := h.Svc.Sources(r.Context())
+	if err != nil {
+		respond.SafeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, sourcesResponse.Map(r.Context(), report))
+}
+
+type ArticlesHandler struct{ Svc *statsUC.Service }
+
+// ServeHTTP 記事取り込み数の時系列取得
+// @Summary      記事取り込み数の時系列取得
+// @Description  期間内に取り込んだ記事数を区間（UTC、週は月曜始まり）ごとに取得します。期間は区間の境界に揃えられ、記事のない区間は0件になります。集計結果は1分間キャッシュされます
+// @Tags         stats
+// @Security     BearerAuth
+// @Produce      json
+// @Param        interval   query  string  false  "区間" Enums(hour, day, week) default(day)
+// @Param        from       query  string  false  "開始日時（ISO 8601、デフォルトは48時間・30日・12週前）"
+// @Param        to         query  string  false  "終了日時（ISO 8601、デフォルトは現在）"
+// @Param        source_id  query  int     false  "ソースIDでフィルタ"
+// @Success      200 {object} SeriesDTO "記事取り込み数の時系列"
+// @Failure      400 {string} string "Invalid query parameters"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - insufficient permissions"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /stats/articles [get]
+func (h ArticlesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q, err := parseSeriesQuery(r.URL.Query())
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	series, err := h.Svc.Articles(r.Context(), q)
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	respond.JSON(w, http.StatusOK, toSeriesDTO(series))
+}
+
+// parseSeriesQuery builds the series query from the query parameters.
+func parseSeriesQuery(params url.Values) (statsUC.SeriesQuery, error) {
+	q := statsUC.SeriesQuery{Interval: params.Get("interval")}
+
+	if s := params.Get("source_id"); s != "" {
+		sourceID, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return q, errors.New("invalid source_id: must be a valid integer")
+		}
+		q.SourceID = &sourceID
+	}
+
+	from, err := validation.ParseDateISO8601(params.Get("from"))
+	if err != nil {
+		return q, fmt.Errorf("invalid from date: %w", err)
+	}
+	if from != nil {
+		q.From = *from
+	}
+	to, err := validation.ParseDateISO8601(params.Get("to"))
+	if err != nil {
+		return q, fmt.Errorf("invalid to date: %w", err)
+	}
+	if to != nil {
+		q.To = *to
+	}
+	return q, nil
+}
+
+func errorStatus(err error) int {
+	var ve *entity.ValidationError
+	switch {
+	case errors.As(err, &ve), errors.Is(err, statsUC.ErrTooManyBuckets):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
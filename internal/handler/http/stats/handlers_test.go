@@ -0,0 +1,104 @@
+package stats_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/handler/http/stats"
+	"catchup-feed/internal/repository"
+	statsUC "catchup-feed/internal/usecase/stats"
+)
+
+/* ───────── モック ───────── */
+
+type stubStatsRepo struct {
+	sources  []repository.SourceStats
+	buckets  []repository.IngestionBucket
+	gotFrom  time.Time
+	gotTo    time.Time
+	gotSrcID *int64
+}
+
+func (s *stubStatsRepo) SourceStats(context.Context, time.Time, time.Time) ([]repository.SourceStats, error) {
+	return s.sources, nil
+}
+
+func (s *stubStatsRepo) IngestionSeries(_ context.Context, _ string, from, to time.Time, sourceID *int64) ([]repository.IngestionBucket, error) {
+	s.gotFrom, s.gotTo, s.gotSrcID = from, to, sourceID
+	return s.buckets, nil
+}
+
+func serve(h http.Handler, target string) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, target, nil))
+	return rr
+}
+
+/* ───────── テスト ───────── */
+
+func TestSourcesHandler(t *testing.T) {
+	last := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &stubStatsRepo{sources: []repository.SourceStats{
+		{SourceID: 1, SourceName: "Go Blog", ArticlesTotal: 40, ArticlesLastWeek: 3, SummarizeFailuresLastWeek: 1, LastArticleAt: &last},
+	}}
+
+	rr := serve(stats.SourcesHandler{Svc: statsUC.NewService(repo)}, "/stats/sources")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rr.Code, rr.Body)
+	}
+	var got stats.SourcesDTO
+	if err := json.NewDecoder(rr.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Sources) != 1 || got.Sources[0].SourceName != "Go Blog" || got.Sources[0].SummarizeErrorRate != 0.25 ||
+		got.Sources[0].LastSuccessAt == nil || !got.Sources[0].LastSuccessAt.Equal(last) {
+		t.Errorf("sources = %+v", got.Sources)
+	}
+}
+
+func TestArticlesHandler(t *testing.T) {
+	repo := &stubStatsRepo{buckets: []repository.IngestionBucket{
+		{Start: time.Date(2025, 6, 1, 1, 0, 0, 0, time.UTC), Count: 3},
+	}}
+
+	rr := serve(stats.ArticlesHandler{Svc: statsUC.NewService(repo)},
+		"/stats/articles?interval=hour&from=2025-06-01T00:00:00Z&to=2025-06-01T03:00:00Z&source_id=7")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rr.Code, rr.Body)
+	}
+	var got stats.SeriesDTO
+	if err := json.NewDecoder(rr.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if repo.gotSrcID == nil || *repo.gotSrcID != 7 || !repo.gotTo.Equal(time.Date(2025, 6, 1, 3, 0, 0, 0, time.UTC)) {
+		t.Errorf("repo got [%v, %v) source %v", repo.gotFrom, repo.gotTo, repo.gotSrcID)
+	}
+	if got.Interval != "hour" || got.Total != 3 || len(got.Buckets) != 3 || got.Buckets[1].Count != 3 {
+		t.Errorf("series = %+v", got)
+	}
+}
+
+func TestArticlesHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+	}{
+		{"invalid interval", "/stats/articles?interval=month"},
+		{"invalid from", "/stats/articles?from=yesterday"},
+		{"invalid source_id", "/stats/articles?source_id=abc"},
+		{"from after to", "/stats/articles?from=2025-06-10T00:00:00Z&to=2025-06-01T00:00:00Z"},
+		{"too many intervals", "/stats/articles?interval=hour&from=2025-01-01T00:00:00Z&to=2025-06-01T00:00:00Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := stats.ArticlesHandler{Svc: statsUC.NewService(&stubStatsRepo{})}
+			if rr := serve(h, tt.target); rr.Code != http.StatusBadRequest {
+				t.Errorf("status = %d, want 400: %s", rr.Code, rr.Body)
+			}
+		})
+	}
+}
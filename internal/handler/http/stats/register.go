@@ -0,0 +1,14 @@
+package stats
+
+import (
+	"net/http"
+
+	statsUC "catchup-feed/internal/usecase/stats"
+)
+
+// Register registers the statistics handlers with the given mux. Statistics are
+// read-only, so both admins and viewers may read them (see auth.RolePermissions).
+func Register(mux *http.ServeMux, svc *statsUC.Service) {
+	mux.Handle("GET    /stats/sources", SourcesHandler{svc})
+	mux.Handle("GET    /stats/articles", ArticlesHandler{svc})
+}
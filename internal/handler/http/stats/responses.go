@@ -0,0 +1,14 @@
+package stats
+
+import (
+	"catchup-feed/internal/handler/http/apiversion"
+	statsUC "catchup-feed/internal/usecase/stats"
+)
+
+// Response mappers of the statistics endpoints that return sources (see apiversion.Mapper).
+var (
+	// sourcesResponse maps the per-source statistics.
+	sourcesResponse = apiversion.Mapper[*statsUC.SourceReport]{
+		apiversion.V1: func(r *statsUC.SourceReport) any { return toSourcesDTO(r) },
+	}
+)
@@ -0,0 +1,36 @@
+// Package stream provides the HTTP handler of the real-time new-article stream
+// (Server-Sent Events).
+package stream
+
+import (
+	"time"
+
+	"catchup-feed/internal/repository"
+)
+
+// ArticleDTO is the data of an article event.
+type ArticleDTO struct {
+	ID            int64     `json:"id" example:"42"`
+	SourceID      int64     `json:"source_id" example:"1"`
+	SourceName    string    `json:"source_name" example:"Go Blog"`
+	Title         string    `json:"title" example:"Go 1.25 is released"`
+	URL           string    `json:"url" example:"https://go.dev/blog/go1.25"`
+	Summary       string    `json:"summary" example:"Go 1.25 の主な変更点を紹介しています。"`
+	SummaryStatus string    `json:"summary_status,omitempty" example:"pending"`
+	PublishedAt   time.Time `json:"published_at" example:"2025-11-14T18:00:00Z"`
+	CreatedAt     time.Time `json:"created_at" example:"2025-11-14T18:05:00Z"`
+}
+
+func toArticleDTO(a repository.ArticleWithSource) ArticleDTO {
+	return ArticleDTO{
+		ID:            a.Article.ID,
+		SourceID:      a.Article.SourceID,
+		SourceName:    a.SourceName,
+		Title:         a.Article.Title,
+		URL:           a.Article.URL,
+		Summary:       a.Article.Summary,
+		SummaryStatus: a.Article.SummaryStatus,
+		PublishedAt:   a.Article.PublishedAt,
+		CreatedAt:     a.Article.CreatedAt,
+	}
+}
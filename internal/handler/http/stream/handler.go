@@ -0,0 +1,165 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"catchup-feed/internal/handler/http/respond"
+	"catchup-feed/internal/handler/http/searchfilter"
+	"catchup-feed/internal/repository"
+	streamUC "catchup-feed/internal/usecase/stream"
+)
+
+const (
+	// DefaultHeartbeatInterval is the interval of the heartbeat comments that keep
+	// idle connections open through proxies.
+	DefaultHeartbeatInterval = 15 * time.Second
+
+	// retryMillis is the reconnection delay suggested to clients.
+	retryMillis = 5000
+)
+
+// Handler streams new articles as Server-Sent Events.
+type Handler struct {
+	Svc streamUC.Service
+	// HeartbeatInterval is DefaultHeartbeatInterval when zero.
+	HeartbeatInterval time.Duration
+}
+
+// ServeHTTP 新着記事ストリーム
+// @Summary      新着記事ストリーム（Server-Sent Events）
+// @Description  ワーカーが記事を追加するたびに、その記事を article イベントとして送信します。バッチ要約を待っている記事は、要約が保存された時点で送信します。イベントの id は読み出し位置の記事IDで、再接続時に Last-Event-ID を送ると、その後に追加された記事から再送します。接続を保つため15秒ごとにコメント行（ハートビート）を送ります。/articles/search と同じ絞り込みに対応します
+// @Tags         articles
+// @Security     BearerAuth
+// @Produce      text/event-stream
+// @Param        Last-Event-ID header int false "最後に受信した記事ID（再開位置）"
+// @Param        keyword query string false "検索キーワード（スペース区切り、AND）"
+// @Param        source_id query int false "ソースIDでフィルタ"
+// @Param        tag query []string false "タグでフィルタ（複数指定時はすべてを持つ記事）" collectionFormat(multi)
+// @Param        from query string false "公開日時の開始（ISO 8601）"
+// @Param        to query string false "公開日時の終了（ISO 8601）"
+// @Success      200 {object} ArticleDTO "article イベントのデータ"
+// @Failure      400 {string} string "Bad request - invalid filter or Last-Event-ID"
+// @Failure      401 {string} string "Authentication required"
+// @Failure      429 {string} string "Too many requests - rate limit exceeded"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /articles/stream [get]
+func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q, err := parseQuery(r.URL.Query())
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var lastEventID int64
+	if s := r.Header.Get("Last-Event-ID"); s != "" {
+		lastEventID, err = strconv.ParseInt(s, 10, 64)
+		if err != nil || lastEventID < 0 {
+			respond.SafeError(w, http.StatusBadRequest, streamUC.ErrInvalidEventID)
+			return
+		}
+	}
+
+	ctx := r.Context()
+	cursor, err := h.Svc.Start(ctx, lastEventID)
+	if err != nil {
+		respond.SafeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// 通知を取りこぼさないよう、最初の読み出しより前に購読する
+	wake, unsubscribe := h.Svc.Subscribe()
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// リバースプロキシ（nginx）のバッファリングを無効にする
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", retryMillis); err != nil {
+		return
+	}
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	interval := h.HeartbeatInterval
+	if interval <= 0 {
+		interval = DefaultHeartbeatInterval
+	}
+	heartbeat := time.NewTicker(interval)
+	defer heartbeat.Stop()
+
+	for {
+		// 要約が終わった要約待ちの記事と、再開時の取りこぼし分・通知された新着を、追いつくまで送る
+		for {
+			settled, err := h.Svc.Settled(ctx, q, cursor)
+			if err != nil {
+				// クライアントは Last-Event-ID で再接続して続きを受け取る
+				return
+			}
+			// 読み出し位置より前の記事なので、イベントの id は読み出し位置のままにする
+			for _, a := range settled {
+				if err := writeEvent(ctx, w, cursor.After, a); err != nil {
+					return
+				}
+			}
+			articles, err := h.Svc.Next(ctx, q, cursor)
+			if err != nil {
+				return
+			}
+			for _, a := range articles {
+				if err := writeEvent(ctx, w, a.Article.ID, a); err != nil {
+					return
+				}
+			}
+			if len(settled)+len(articles) > 0 {
+				if err := rc.Flush(); err != nil {
+					return
+				}
+			}
+			if len(settled) < streamUC.BatchSize && len(articles) < streamUC.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-wake:
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// writeEvent writes an article event with the given event ID.
+func writeEvent(ctx context.Context, w http.ResponseWriter, id int64, a repository.ArticleWithSource) error {
+	data, err := json.Marshal(articleEvent.Map(ctx, a))
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: article\ndata: %s\n\n", id, data)
+	return err
+}
+
+// parseQuery builds the stream query from the same filters as /articles/search.
+func parseQuery(params url.Values) (streamUC.Query, error) {
+	f, err := searchfilter.Parse(params)
+	if err != nil {
+		return streamUC.Query{}, err
+	}
+	return streamUC.Query{Keywords: f.Keywords, Filters: f.Filters}, nil
+}
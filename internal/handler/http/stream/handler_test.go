@@ -0,0 +1,307 @@
+package stream_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/stream"
+	"catchup-feed/internal/repository"
+	streamUC "catchup-feed/internal/usecase/stream"
+)
+
+/* ───────── モック ───────── */
+
+// stubStreamRepo holds articles in insertion (ID) order.
+type stubStreamRepo struct {
+	mu       sync.Mutex
+	articles []repository.ArticleWithSource
+	filters  repository.ArticleSearchFilters
+}
+
+func (s *stubStreamRepo) add(id int64, title string) {
+	s.addWithStatus(id, title, "")
+}
+
+func (s *stubStreamRepo) addWithStatus(id int64, title, status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.articles = append(s.articles, repository.ArticleWithSource{
+		Article:    &entity.Article{ID: id, SourceID: 1, Title: title, Summary: "要約", SummaryStatus: status},
+		SourceName: "Go Blog",
+	})
+}
+
+// settle stores the summary of a pending article.
+func (s *stubStreamRepo) settle(id int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, a := range s.articles {
+		if a.Article.ID == id {
+			a.Article.SummaryStatus = ""
+		}
+	}
+}
+
+func (s *stubStreamRepo) LatestArticleID(context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.articles) == 0 {
+		return 0, nil
+	}
+	return s.articles[len(s.articles)-1].Article.ID, nil
+}
+
+func (s *stubStreamRepo) ListArticlesAfter(_ context.Context, _ []string, filters repository.ArticleSearchFilters, afterID int64, limit int) ([]repository.ArticleWithSource, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.filters = filters
+	var out []repository.ArticleWithSource
+	for _, a := range s.articles {
+		if a.Article.ID > afterID && a.Article.SummaryStatus != entity.SummaryStatusPending && len(out) < limit {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubStreamRepo) ListPendingArticleIDs(_ context.Context, afterID int64) ([]int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ids []int64
+	for _, a := range s.articles {
+		if a.Article.ID > afterID && a.Article.SummaryStatus == entity.SummaryStatusPending {
+			ids = append(ids, a.Article.ID)
+		}
+	}
+	return ids, nil
+}
+
+func (s *stubStreamRepo) ListSettledArticles(_ context.Context, _ []string, _ repository.ArticleSearchFilters, ids []int64) ([]repository.ArticleWithSource, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []repository.ArticleWithSource
+	for _, a := range s.articles {
+		if slices.Contains(ids, a.Article.ID) && a.Article.SummaryStatus != entity.SummaryStatusPending {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+type event struct {
+	id      string
+	name    string
+	data    string
+	comment string
+}
+
+// readEvents reads n events (or comments) from an SSE body.
+func readEvents(t *testing.T, r *bufio.Reader, n int) []event {
+	t.Helper()
+	var events []event
+	var cur event
+	for len(events) < n {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatalf("read: %v (events so far: %+v)", err, events)
+		}
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "":
+			if cur != (event{}) {
+				events = append(events, cur)
+			}
+			cur = event{}
+		case strings.HasPrefix(line, ":"):
+			cur.comment = strings.TrimSpace(strings.TrimPrefix(line, ":"))
+		case strings.HasPrefix(line, "id: "):
+			cur.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			cur.name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			cur.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+	return events
+}
+
+func newServer(t *testing.T, repo *stubStreamRepo, heartbeat time.Duration) (*httptest.Server, *streamUC.Broker) {
+	t.Helper()
+	broker := streamUC.NewBroker(nil, time.Hour, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	mux := http.NewServeMux()
+	mux.Handle("GET /articles/stream", stream.Handler{
+		Svc:               streamUC.Service{Repo: repo, Broker: broker},
+		HeartbeatInterval: heartbeat,
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv, broker
+}
+
+func open(t *testing.T, url string, header http.Header) (*http.Response, *bufio.Reader) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp, bufio.NewReader(resp.Body)
+}
+
+// waitSubscribers waits until the stream has subscribed to the broker.
+func waitSubscribers(t *testing.T, b *streamUC.Broker, n int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for b.Subscribers() != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("Subscribers = %d, want %d", b.Subscribers(), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+/* ───────── テスト ───────── */
+
+func TestHandler_StreamsNewArticles(t *testing.T) {
+	repo := &stubStreamRepo{}
+	repo.add(1, "existing")
+	srv, broker := newServer(t, repo, time.Hour)
+
+	resp, r := open(t, srv.URL+"/articles/stream?source_id=1", nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	waitSubscribers(t, broker, 1)
+
+	repo.add(2, "Go 1.25")
+	repo.add(3, "Go 1.26")
+	broker.Notify()
+
+	events := readEvents(t, r, 2)
+	if events[0].id != "2" || events[0].name != "article" || events[1].id != "3" {
+		t.Fatalf("events = %+v, want articles 2 and 3 (existing article not sent)", events)
+	}
+	var got stream.ArticleDTO
+	if err := json.Unmarshal([]byte(events[0].data), &got); err != nil {
+		t.Fatalf("decode data: %v", err)
+	}
+	if got.ID != 2 || got.Title != "Go 1.25" || got.SourceName != "Go Blog" {
+		t.Errorf("data = %+v", got)
+	}
+
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.filters.SourceID == nil || *repo.filters.SourceID != 1 {
+		t.Errorf("filters = %+v, want source_id 1", repo.filters)
+	}
+}
+
+func TestHandler_ResumesFromLastEventID(t *testing.T) {
+	repo := &stubStreamRepo{}
+	for id := int64(1); id <= 3; id++ {
+		repo.add(id, "article")
+	}
+	srv, _ := newServer(t, repo, time.Hour)
+
+	_, r := open(t, srv.URL+"/articles/stream", http.Header{"Last-Event-Id": {"1"}})
+
+	events := readEvents(t, r, 2)
+	if events[0].id != "2" || events[1].id != "3" {
+		t.Errorf("events = %+v, want the missed articles 2 and 3", events)
+	}
+}
+
+func TestHandler_PendingArticleDoesNotBlock(t *testing.T) {
+	repo := &stubStreamRepo{}
+	srv, broker := newServer(t, repo, time.Hour)
+
+	_, r := open(t, srv.URL+"/articles/stream", nil)
+	waitSubscribers(t, broker, 1)
+
+	repo.addWithStatus(1, "pending", entity.SummaryStatusPending)
+	repo.add(2, "summarized")
+	broker.Notify()
+
+	// 要約待ちの記事の後の記事も届く
+	events := readEvents(t, r, 1)
+	if events[0].id != "2" {
+		t.Fatalf("events = %+v, want article 2 without waiting for 1", events)
+	}
+
+	// 要約が保存されたら（NotifyArticleSummarized の通知で）要約待ちだった記事を送る
+	repo.settle(1)
+	broker.Notify()
+
+	events = readEvents(t, r, 1)
+	var got stream.ArticleDTO
+	if err := json.Unmarshal([]byte(events[0].data), &got); err != nil {
+		t.Fatalf("decode data: %v", err)
+	}
+	if got.ID != 1 || events[0].id != "2" {
+		t.Errorf("event id %s with article %d, want article 1 keeping the resume position 2", events[0].id, got.ID)
+	}
+}
+
+func TestHandler_Heartbeat(t *testing.T) {
+	srv, _ := newServer(t, &stubStreamRepo{}, 10*time.Millisecond)
+
+	_, r := open(t, srv.URL+"/articles/stream", nil)
+
+	events := readEvents(t, r, 1)
+	if events[0].comment != "heartbeat" {
+		t.Errorf("event = %+v, want a heartbeat comment", events[0])
+	}
+}
+
+func TestHandler_UnsubscribesOnDisconnect(t *testing.T) {
+	srv, broker := newServer(t, &stubStreamRepo{}, time.Hour)
+
+	resp, _ := open(t, srv.URL+"/articles/stream", nil)
+	waitSubscribers(t, broker, 1)
+
+	_ = resp.Body.Close()
+	waitSubscribers(t, broker, 0)
+}
+
+func TestHandler_BadRequest(t *testing.T) {
+	srv, _ := newServer(t, &stubStreamRepo{}, time.Hour)
+
+	tests := []struct {
+		name   string
+		query  string
+		header http.Header
+	}{
+		{name: "invalid Last-Event-ID", header: http.Header{"Last-Event-Id": {"abc"}}},
+		{name: "negative Last-Event-ID", header: http.Header{"Last-Event-Id": {"-1"}}},
+		{name: "invalid source_id", query: "?source_id=0"},
+		{name: "invalid tag", query: "?tag=%20"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, _ := open(t, srv.URL+"/articles/stream"+tt.query, tt.header)
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("status = %d, want 400", resp.StatusCode)
+			}
+		})
+	}
+}
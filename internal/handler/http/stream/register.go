@@ -0,0 +1,14 @@
+package stream
+
+import (
+	"net/http"
+
+	streamUC "catchup-feed/internal/usecase/stream"
+)
+
+// Register registers the article stream handler with the given mux. It is
+// mounted behind the auth and user rate limit middleware like the other article
+// routes; an open stream counts as a single request.
+func Register(mux *http.ServeMux, svc streamUC.Service) {
+	mux.Handle("GET    /articles/stream", Handler{Svc: svc})
+}
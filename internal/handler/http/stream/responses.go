@@ -0,0 +1,14 @@
+package stream
+
+import (
+	"catchup-feed/internal/handler/http/apiversion"
+	"catchup-feed/internal/repository"
+)
+
+// Response mappers of the stream events (see apiversion.Mapper).
+var (
+	// articleEvent maps the data of an article event.
+	articleEvent = apiversion.Mapper[repository.ArticleWithSource]{
+		apiversion.V1: func(a repository.ArticleWithSource) any { return toArticleDTO(a) },
+	}
+)
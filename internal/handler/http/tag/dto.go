@@ -0,0 +1,32 @@
+package tag
+
+import (
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// TagDTO represents a tag with the number of articles it is attached to.
+type TagDTO struct {
+	Name         string `json:"name" example:"go"`
+	ArticleCount int64  `json:"article_count" example:"42"`
+}
+
+// RuleDTO represents the JSON structure of a tag rule.
+type RuleDTO struct {
+	ID        int64     `json:"id" example:"1"`
+	Tag       string    `json:"tag" example:"go"`
+	Pattern   string    `json:"pattern" example:"golang"`
+	MatchType string    `json:"match_type" example:"keyword"`
+	CreatedAt time.Time `json:"created_at" example:"2025-10-26T12:00:00Z"`
+}
+
+func toRuleDTO(r *entity.TagRule) RuleDTO {
+	return RuleDTO{
+		ID:        r.ID,
+		Tag:       r.Tag,
+		Pattern:   r.Pattern,
+		MatchType: r.MatchType,
+		CreatedAt: r.CreatedAt,
+	}
+}
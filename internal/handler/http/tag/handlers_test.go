@@ -0,0 +1,195 @@
+package tag_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/tag"
+	"catchup-feed/internal/repository"
+	tagUC "catchup-feed/internal/usecase/tag"
+)
+
+/* ───────── モック ───────── */
+
+type stubTagRepo struct {
+	counts []repository.TagCount
+	err    error
+}
+
+func (s *stubTagRepo) AddArticleTags(_ context.Context, _ int64, _ []string, _ string) error {
+	return nil // テストでは未使用
+}
+func (s *stubTagRepo) ListTagCounts(_ context.Context) ([]repository.TagCount, error) {
+	return s.counts, s.err
+}
+
+type stubRuleRepo struct {
+	rules []*entity.TagRule
+	err   error
+}
+
+func (s *stubRuleRepo) ListRules(_ context.Context) ([]*entity.TagRule, error) {
+	return s.rules, s.err
+}
+func (s *stubRuleRepo) CreateRule(_ context.Context, rule *entity.TagRule) error {
+	if s.err != nil {
+		return s.err
+	}
+	rule.ID = int64(len(s.rules) + 1)
+	rule.CreatedAt = time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	s.rules = append(s.rules, rule)
+	return nil
+}
+func (s *stubRuleRepo) DeleteRule(_ context.Context, id int64) (bool, error) {
+	if s.err != nil {
+		return false, s.err
+	}
+	for i, r := range s.rules {
+		if r.ID == id {
+			s.rules = append(s.rules[:i], s.rules[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+/* ───────── テストケース ───────── */
+
+func TestListHandler(t *testing.T) {
+	svc := tagUC.Service{Repo: &stubTagRepo{counts: []repository.TagCount{
+		{Name: "go", Count: 12}, {Name: "rust", Count: 3},
+	}}}
+
+	rr := httptest.NewRecorder()
+	tag.ListHandler{Svc: svc}.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/tags", nil))
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", rr.Code, http.StatusOK)
+	}
+	var got []tag.TagDTO
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(got) != 2 || got[0].Name != "go" || got[0].ArticleCount != 12 {
+		t.Errorf("tags = %+v", got)
+	}
+}
+
+func TestListHandler_Empty(t *testing.T) {
+	svc := tagUC.Service{Repo: &stubTagRepo{}}
+
+	rr := httptest.NewRecorder()
+	tag.ListHandler{Svc: svc}.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/tags", nil))
+
+	if rr.Code != http.StatusOK || strings.TrimSpace(rr.Body.String()) != "[]" {
+		t.Errorf("got %d %q, want 200 []", rr.Code, rr.Body.String())
+	}
+}
+
+func TestListHandler_Error(t *testing.T) {
+	svc := tagUC.Service{Repo: &stubTagRepo{err: errors.New("db down")}}
+
+	rr := httptest.NewRecorder()
+	tag.ListHandler{Svc: svc}.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/tags", nil))
+
+	if rr.Code != http.StatusInternalServerError {
+		t.Fatalf("status code = %d, want %d", rr.Code, http.StatusInternalServerError)
+	}
+}
+
+func TestListRulesHandler(t *testing.T) {
+	svc := tagUC.Service{RuleRepo: &stubRuleRepo{rules: []*entity.TagRule{
+		{ID: 1, Tag: "go", Pattern: "golang", MatchType: entity.TagMatchKeyword},
+	}}}
+
+	rr := httptest.NewRecorder()
+	tag.ListRulesHandler{Svc: svc}.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/tag-rules", nil))
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", rr.Code, http.StatusOK)
+	}
+	var got []tag.RuleDTO
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(got) != 1 || got[0].Pattern != "golang" || got[0].MatchType != "keyword" {
+		t.Errorf("rules = %+v", got)
+	}
+}
+
+func TestCreateRuleHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		repoErr  error
+		wantCode int
+	}{
+		{name: "keyword", body: `{"tag":"Go","pattern":"golang"}`, wantCode: http.StatusCreated},
+		{name: "regex", body: `{"tag":"ai","pattern":"\\bLLM\\b","match_type":"regex"}`, wantCode: http.StatusCreated},
+		{name: "invalid json", body: `{`, wantCode: http.StatusBadRequest},
+		{name: "missing pattern", body: `{"tag":"go"}`, wantCode: http.StatusBadRequest},
+		{name: "invalid regex", body: `{"tag":"go","pattern":"(","match_type":"regex"}`, wantCode: http.StatusBadRequest},
+		{name: "repository error", body: `{"tag":"go","pattern":"golang"}`, repoErr: errors.New("db down"),
+			wantCode: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := tagUC.Service{RuleRepo: &stubRuleRepo{err: tt.repoErr}}
+			req := httptest.NewRequest(http.MethodPost, "/tag-rules", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+			tag.CreateRuleHandler{Svc: svc}.ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d (body %s)", rr.Code, tt.wantCode, rr.Body.String())
+			}
+			if tt.wantCode != http.StatusCreated {
+				return
+			}
+			var got tag.RuleDTO
+			if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if got.ID == 0 || got.Tag != strings.ToLower(got.Tag) {
+				t.Errorf("rule = %+v, want stored rule with normalized tag", got)
+			}
+		})
+	}
+}
+
+func TestDeleteRuleHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		wantCode int
+	}{
+		{name: "deleted", path: "/tag-rules/1", wantCode: http.StatusNoContent},
+		{name: "not found", path: "/tag-rules/2", wantCode: http.StatusNotFound},
+		{name: "invalid id", path: "/tag-rules/abc", wantCode: http.StatusBadRequest},
+		{name: "zero id", path: "/tag-rules/0", wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := tagUC.Service{RuleRepo: &stubRuleRepo{rules: []*entity.TagRule{
+				{ID: 1, Tag: "go", Pattern: "golang", MatchType: entity.TagMatchKeyword},
+			}}}
+
+			rr := httptest.NewRecorder()
+			tag.DeleteRuleHandler{Svc: svc}.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, tt.path, nil))
+
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d", rr.Code, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package tag
+
+import (
+	"net/http"
+
+	"catchup-feed/internal/handler/http/respond"
+	tagUC "catchup-feed/internal/usecase/tag"
+)
+
+type ListHandler struct{ Svc tagUC.Service }
+
+// ServeHTTP タグ一覧取得
+// @Summary      タグ一覧取得
+// @Description  記事に付与されているタグを記事数の多い順に取得します
+// @Tags         tags
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {array} TagDTO "タグ一覧"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - insufficient permissions"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /tags [get]
+func (h ListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tags, err := h.Svc.ListTags(r.Context())
+	if err != nil {
+		respond.SafeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	out := make([]TagDTO, 0, len(tags))
+	for _, t := range tags {
+		out = append(out, TagDTO{Name: t.Name, ArticleCount: t.Count})
+	}
+	respond.JSON(w, http.StatusOK, out)
+}
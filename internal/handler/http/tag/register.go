@@ -0,0 +1,19 @@
+package tag
+
+import (
+	"net/http"
+
+	"catchup-feed/internal/handler/http/auth"
+	tagUC "catchup-feed/internal/usecase/tag"
+)
+
+// Register registers all tag-related HTTP handlers with the given mux.
+// The tag list is readable by viewers; tag rule management is admin-only
+// and requires authentication via the auth middleware.
+func Register(mux *http.ServeMux, svc tagUC.Service) {
+	mux.Handle("GET    /tags", ListHandler{svc})
+
+	mux.Handle("GET    /tag-rules", auth.Authz(ListRulesHandler{svc}))
+	mux.Handle("POST   /tag-rules", auth.Authz(CreateRuleHandler{svc}))
+	mux.Handle("DELETE /tag-rules/", auth.Authz(DeleteRuleHandler{svc}))
+}
@@ -0,0 +1,115 @@
+package tag
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/pathutil"
+	"catchup-feed/internal/handler/http/respond"
+	tagUC "catchup-feed/internal/usecase/tag"
+)
+
+type ListRulesHandler struct{ Svc tagUC.Service }
+
+// ServeHTTP タグ付けルール一覧取得
+// @Summary      タグ付けルール一覧取得
+// @Description  自動タグ付けに使うキーワード・正規表現ルールを取得します（管理者のみ）
+// @Tags         tags
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {array} RuleDTO "ルール一覧"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - admin role required"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /tag-rules [get]
+func (h ListRulesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rules, err := h.Svc.ListRules(r.Context())
+	if err != nil {
+		respond.SafeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	out := make([]RuleDTO, 0, len(rules))
+	for _, rule := range rules {
+		out = append(out, toRuleDTO(rule))
+	}
+	respond.JSON(w, http.StatusOK, out)
+}
+
+type CreateRuleHandler struct{ Svc tagUC.Service }
+
+// ServeHTTP タグ付けルール作成
+// @Summary      タグ付けルール作成
+// @Description  タイトル・要約・本文がパターンに一致した記事にタグを付けるルールを作成します（管理者のみ）。match_type は keyword（大文字小文字を区別しない部分一致、デフォルト）または regex です。ルールは作成後に取り込まれる記事と再要約された記事に適用されます
+// @Tags         tags
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        rule body object true "ルール（tag, pattern, match_type）"
+// @Success      201 {object} RuleDTO "作成されたルール"
+// @Failure      400 {string} string "Bad request - invalid rule"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - admin role required"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /tag-rules [post]
+func (h CreateRuleHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Tag       string `json:"tag"`
+		Pattern   string `json:"pattern"`
+		MatchType string `json:"match_type"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	rule, err := h.Svc.CreateRule(r.Context(), tagUC.CreateRuleInput{
+		Tag: req.Tag, Pattern: req.Pattern, MatchType: req.MatchType,
+	})
+	if err != nil {
+		code := http.StatusInternalServerError
+		var ve *entity.ValidationError
+		if errors.As(err, &ve) {
+			code = http.StatusBadRequest
+		}
+		respond.SafeError(w, code, err)
+		return
+	}
+	respond.JSON(w, http.StatusCreated, toRuleDTO(rule))
+}
+
+type DeleteRuleHandler struct{ Svc tagUC.Service }
+
+// ServeHTTP タグ付けルール削除
+// @Summary      タグ付けルール削除
+// @Description  タグ付けルールを削除します（管理者のみ）。ルールにより付与済みのタグは残ります
+// @Tags         tags
+// @Security     BearerAuth
+// @Param        id path int true "ルールID"
+// @Success      204 "No Content"
+// @Failure      400 {string} string "Bad request - invalid ID"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - admin role required"
+// @Failure      404 {string} string "Not found - rule not found"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /tag-rules/{id} [delete]
+func (h DeleteRuleHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(r.URL.Path, "/tag-rules/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.Svc.DeleteRule(r.Context(), id); err != nil {
+		code := http.StatusInternalServerError
+		if errors.Is(err, tagUC.ErrInvalidTagRuleID) {
+			code = http.StatusBadRequest
+		} else if errors.Is(err, tagUC.ErrTagRuleNotFound) {
+			code = http.StatusNotFound
+		}
+		respond.SafeError(w, code, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,59 @@
+// Package trash provides HTTP handlers for listing and restoring the articles and
+// sources in the trash.
+package trash
+
+import (
+	"time"
+
+	"catchup-feed/internal/repository"
+)
+
+// ArticleDTO represents an article in the trash.
+type ArticleDTO struct {
+	ID          int64     `json:"id" example:"42"`
+	SourceID    int64     `json:"source_id" example:"1"`
+	SourceName  string    `json:"source_name" example:"Go Blog"`
+	Title       string    `json:"title" example:"Go 1.25 is released"`
+	URL         string    `json:"url" example:"https://go.dev/blog/go1.25"`
+	PublishedAt time.Time `json:"published_at" example:"2025-11-14T18:00:00Z"`
+	DeletedAt   time.Time `json:"deleted_at" example:"2025-11-20T09:00:00Z"`
+	// SourceDeleted is true when the source is in the trash too. Such an article is
+	// restored by restoring its source.
+	SourceDeleted bool `json:"source_deleted" example:"false"`
+}
+
+// SourceDTO represents a source in the trash.
+type SourceDTO struct {
+	ID      int64  `json:"id" example:"1"`
+	Name    string `json:"name" example:"Go Blog"`
+	FeedURL string `json:"feed_url" example:"https://go.dev/blog/feed.atom"`
+	Active  bool   `json:"active" example:"true"`
+	// ArticleCount is the number of articles deleted with the source, which are
+	// restored with it.
+	ArticleCount int64     `json:"article_count" example:"12"`
+	DeletedAt    time.Time `json:"deleted_at" example:"2025-11-20T09:00:00Z"`
+}
+
+func toArticleDTO(a repository.TrashedArticle) ArticleDTO {
+	return ArticleDTO{
+		ID:            a.Article.ID,
+		SourceID:      a.Article.SourceID,
+		SourceName:    a.SourceName,
+		Title:         a.Article.Title,
+		URL:           a.Article.URL,
+		PublishedAt:   a.Article.PublishedAt,
+		DeletedAt:     a.DeletedAt,
+		SourceDeleted: a.SourceDeleted,
+	}
+}
+
+func toSourceDTO(s repository.TrashedSource) SourceDTO {
+	return SourceDTO{
+		ID:           s.Source.ID,
+		Name:         s.Source.Name,
+		FeedURL:      s.Source.FeedURL,
+		Active:       s.Source.Active,
+		ArticleCount: s.ArticleCount,
+		DeletedAt:    s.DeletedAt,
+	}
+}
@@ -0,0 +1,150 @@
+package trash
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/handler/http/pathutil"
+	"catchup-feed/internal/handler/http/respond"
+	trashUC "catchup-feed/internal/usecase/trash"
+)
+
+type ListArticlesHandler struct {
+	Svc           trashUC.Service
+	PaginationCfg pagination.Config
+}
+
+// ServeHTTP ゴミ箱の記事一覧取得
+// @Summary      ゴミ箱の記事一覧取得（ページネーション対応）
+// @Description  削除された記事を、削除日時の新しい順に取得します。保持期間を過ぎた記事は完全に削除されます
+// @Tags         trash
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page   query    int  false  "ページ番号 (1-based)" default(1) minimum(1)
+// @Param        limit  query    int  false  "1ページあたりの件数" default(20) minimum(1) maximum(100)
+// @Success      200 {object} pagination.Response[ArticleDTO] "ページネーション付きゴミ箱の記事一覧"
+// @Failure      400 {string} string "Invalid query parameters"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - admin role required"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /trash/articles [get]
+func (h ListArticlesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	params, err := pagination.ParseQueryParams(r, h.PaginationCfg)
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if params.Keyset {
+		respond.SafeError(w, http.StatusBadRequest,
+			errors.New("invalid query parameter: cursor pagination is not supported for the trash"))
+		return
+	}
+
+	result, err := h.Svc.ListArticles(r.Context(), params)
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+
+	respond.JSON(w, http.StatusOK, articlesResponse.Map(r.Context(), result))
+}
+
+type ListSourcesHandler struct{ Svc trashUC.Service }
+
+// ServeHTTP ゴミ箱のソース一覧取得
+// @Summary      ゴミ箱のソース一覧取得
+// @Description  削除されたソースを、削除日時の新しい順に、一緒に削除された記事の件数とともに取得します
+// @Tags         trash
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {array} SourceDTO "ゴミ箱のソース一覧"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - admin role required"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /trash/sources [get]
+func (h ListSourcesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	sources, err := h.Svc.ListSources(r.Context())
+	if err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+
+	respond.JSON(w, http.StatusOK, sourcesResponse.Map(r.Context(), sources))
+}
+
+type RestoreArticleHandler struct{ Svc trashUC.Service }
+
+// ServeHTTP 記事をゴミ箱から復元する
+// @Summary      記事をゴミ箱から復元する
+// @Description  ゴミ箱にある記事を復元します。ソースもゴミ箱にある場合は復元できません（ソースを復元してください）
+// @Tags         trash
+// @Security     BearerAuth
+// @Param        id path int true "記事ID"
+// @Success      204 "No Content"
+// @Failure      400 {string} string "Bad request - invalid article ID"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - admin role required"
+// @Failure      404 {string} string "Not found - article not in trash"
+// @Failure      409 {string} string "Conflict - source of the article is in the trash"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /articles/{id}/restore [post]
+func (h RestoreArticleHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(strings.TrimSuffix(r.URL.Path, "/restore"), "/articles/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.Svc.RestoreArticle(r.Context(), id); err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type RestoreSourceHandler struct{ Svc trashUC.Service }
+
+// ServeHTTP ソースをゴミ箱から復元する
+// @Summary      ソースをゴミ箱から復元する
+// @Description  ゴミ箱にあるソースを、一緒に削除された記事とともに復元します。ソースより前に個別に削除された記事はゴミ箱に残ります
+// @Tags         trash
+// @Security     BearerAuth
+// @Param        id path int true "ソースID"
+// @Success      204 "No Content"
+// @Failure      400 {string} string "Bad request - invalid source ID"
+// @Failure      401 {string} string "Authentication required - missing or invalid JWT token"
+// @Failure      403 {string} string "Forbidden - admin role required"
+// @Failure      404 {string} string "Not found - source not in trash"
+// @Failure      500 {string} string "サーバーエラー"
+// @Router       /sources/{id}/restore [post]
+func (h RestoreSourceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := pathutil.ExtractID(strings.TrimSuffix(r.URL.Path, "/restore"), "/sources/")
+	if err != nil {
+		respond.SafeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.Svc.RestoreSource(r.Context(), id); err != nil {
+		respond.SafeError(w, errorStatus(err), err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// errorStatus maps trash use case errors to HTTP status codes.
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, trashUC.ErrInvalidArticleID),
+		errors.Is(err, trashUC.ErrInvalidSourceID):
+		return http.StatusBadRequest
+	case errors.Is(err, trashUC.ErrArticleNotInTrash),
+		errors.Is(err, trashUC.ErrSourceNotInTrash):
+		return http.StatusNotFound
+	case errors.Is(err, trashUC.ErrSourceInTrash):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,160 @@
+package trash_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/handler/http/trash"
+	"catchup-feed/internal/repository"
+	trashUC "catchup-feed/internal/usecase/trash"
+)
+
+/* ───────── モック ───────── */
+
+type stubTrashRepo struct {
+	articles []repository.TrashedArticle
+	sources  []repository.TrashedSource
+	result   repository.RestoreResult
+	err      error
+	gotID    int64
+}
+
+func (s *stubTrashRepo) ListTrashedArticles(_ context.Context, _, _ int) ([]repository.TrashedArticle, error) {
+	return s.articles, s.err
+}
+func (s *stubTrashRepo) CountTrashedArticles(context.Context) (int64, error) {
+	return int64(len(s.articles)), s.err
+}
+func (s *stubTrashRepo) ListTrashedSources(context.Context) ([]repository.TrashedSource, error) {
+	return s.sources, s.err
+}
+func (s *stubTrashRepo) RestoreArticle(_ context.Context, id int64) (repository.RestoreResult, error) {
+	s.gotID = id
+	return s.result, s.err
+}
+func (s *stubTrashRepo) RestoreSource(_ context.Context, id int64) (repository.RestoreResult, error) {
+	s.gotID = id
+	return s.result, s.err
+}
+func (s *stubTrashRepo) Purge(context.Context, time.Time) (repository.PurgeResult, error) {
+	return repository.PurgeResult{}, s.err
+}
+
+func serve(h http.Handler, method, target string) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(method, target, nil))
+	return rr
+}
+
+/* ───────── テスト ───────── */
+
+func TestListArticlesHandler(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &stubTrashRepo{articles: []repository.TrashedArticle{{
+		ArticleWithSource: repository.ArticleWithSource{
+			Article:    &entity.Article{ID: 7, SourceID: 3, Title: "Go 1.25", URL: "https://go.dev/blog/go1.25", PublishedAt: now},
+			SourceName: "Go Blog",
+		},
+		DeletedAt:     now,
+		SourceDeleted: true,
+	}}}
+	h := trash.ListArticlesHandler{
+		Svc:           trashUC.Service{Repo: repo},
+		PaginationCfg: pagination.DefaultConfig(),
+	}
+
+	rr := serve(h, http.MethodGet, "/trash/articles?page=1&limit=10")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rr.Code, rr.Body)
+	}
+	var got pagination.Response[trash.ArticleDTO]
+	if err := json.NewDecoder(rr.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Data) != 1 || got.Data[0].ID != 7 || !got.Data[0].SourceDeleted || !got.Data[0].DeletedAt.Equal(now) {
+		t.Errorf("data = %+v", got.Data)
+	}
+	if got.Pagination.Total != 1 || got.Pagination.Limit != 10 {
+		t.Errorf("pagination = %+v", got.Pagination)
+	}
+
+	if rr := serve(h, http.MethodGet, "/trash/articles?cursor=abc"); rr.Code != http.StatusBadRequest {
+		t.Errorf("cursor pagination status = %d, want 400", rr.Code)
+	}
+}
+
+func TestListSourcesHandler(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &stubTrashRepo{sources: []repository.TrashedSource{{
+		Source:       &entity.Source{ID: 3, Name: "Go Blog", FeedURL: "https://go.dev/blog/feed.atom", Active: true},
+		DeletedAt:    now,
+		ArticleCount: 12,
+	}}}
+
+	rr := serve(trash.ListSourcesHandler{Svc: trashUC.Service{Repo: repo}}, http.MethodGet, "/trash/sources")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rr.Code, rr.Body)
+	}
+	var got []trash.SourceDTO
+	if err := json.NewDecoder(rr.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].ID != 3 || got[0].ArticleCount != 12 {
+		t.Errorf("sources = %+v", got)
+	}
+}
+
+func TestRestoreArticleHandler(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		repo   *stubTrashRepo
+		want   int
+	}{
+		{name: "restored", target: "/articles/7/restore", repo: &stubTrashRepo{result: repository.Restored}, want: http.StatusNoContent},
+		{name: "not in trash", target: "/articles/7/restore", repo: &stubTrashRepo{result: repository.RestoreNotFound}, want: http.StatusNotFound},
+		{name: "source in trash", target: "/articles/7/restore", repo: &stubTrashRepo{result: repository.RestoreSourceDeleted}, want: http.StatusConflict},
+		{name: "invalid id", target: "/articles/abc/restore", repo: &stubTrashRepo{}, want: http.StatusBadRequest},
+		{name: "repository error", target: "/articles/7/restore", repo: &stubTrashRepo{err: errors.New("db down")}, want: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := serve(trash.RestoreArticleHandler{Svc: trashUC.Service{Repo: tt.repo}}, http.MethodPost, tt.target)
+			if rr.Code != tt.want {
+				t.Fatalf("status = %d, want %d: %s", rr.Code, tt.want, rr.Body)
+			}
+			if tt.want == http.StatusNoContent && tt.repo.gotID != 7 {
+				t.Errorf("restored id = %d, want 7", tt.repo.gotID)
+			}
+		})
+	}
+}
+
+func TestRestoreSourceHandler(t *testing.T) {
+	tests := []struct {
+		name string
+		repo *stubTrashRepo
+		want int
+	}{
+		{name: "restored", repo: &stubTrashRepo{result: repository.Restored}, want: http.StatusNoContent},
+		{name: "not in trash", repo: &stubTrashRepo{result: repository.RestoreNotFound}, want: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := serve(trash.RestoreSourceHandler{Svc: trashUC.Service{Repo: tt.repo}}, http.MethodPost, "/sources/3/restore")
+			if rr.Code != tt.want {
+				t.Fatalf("status = %d, want %d: %s", rr.Code, tt.want, rr.Body)
+			}
+			if tt.repo.gotID != 3 {
+				t.Errorf("restored id = %d, want 3", tt.repo.gotID)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package trash
+
+import (
+	"net/http"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/handler/http/auth"
+	trashUC "catchup-feed/internal/usecase/trash"
+)
+
+// Register registers the trash HTTP handlers with the given mux.
+// All routes are admin-only: /trash is not a viewer path and restoring is a write.
+func Register(mux *http.ServeMux, svc trashUC.Service, paginationCfg pagination.Config) {
+	mux.Handle("GET    /trash/articles", auth.Authz(ListArticlesHandler{Svc: svc, PaginationCfg: paginationCfg}))
+	mux.Handle("GET    /trash/sources", auth.Authz(ListSourcesHandler{svc}))
+	mux.Handle("POST   /articles/{id}/restore", auth.Authz(RestoreArticleHandler{svc}))
+	mux.Handle("POST   /sources/{id}/restore", auth.Authz(RestoreSourceHandler{svc}))
+}
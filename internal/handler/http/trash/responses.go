@@ -0,0 +1,33 @@
+package trash
+
+import (
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/handler/http/apiversion"
+	"catchup-feed/internal/repository"
+	trashUC "catchup-feed/internal/usecase/trash"
+)
+
+// Response mappers of the trash endpoints (see apiversion.Mapper).
+var (
+	// articlesResponse maps a page of the articles in the trash.
+	articlesResponse = apiversion.Mapper[*trashUC.ArticleListResult]{
+		apiversion.V1: func(p *trashUC.ArticleListResult) any {
+			out := make([]ArticleDTO, 0, len(p.Data))
+			for _, a := range p.Data {
+				out = append(out, toArticleDTO(a))
+			}
+			return pagination.NewResponse(out, p.Pagination)
+		},
+	}
+
+	// sourcesResponse maps the sources in the trash.
+	sourcesResponse = apiversion.Mapper[[]repository.TrashedSource]{
+		apiversion.V1: func(sources []repository.TrashedSource) any {
+			out := make([]SourceDTO, 0, len(sources))
+			for _, s := range sources {
+				out = append(out, toSourceDTO(s))
+			}
+			return out
+		},
+	}
+)
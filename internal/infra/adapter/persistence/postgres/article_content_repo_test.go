@@ -0,0 +1,56 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	pg "catchup-feed/internal/infra/adapter/persistence/postgres"
+)
+
+func TestArticleContentRepo_SaveContent(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec("INSERT INTO article_contents").
+		WithArgs(int64(42), "本文").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := pg.NewArticleContentRepo(db)
+	if err := repo.SaveContent(context.Background(), 42, "本文"); err != nil {
+		t.Fatalf("SaveContent err=%v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestArticleContentRepo_GetContent(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery("FROM article_contents").
+		WithArgs(int64(42)).
+		WillReturnRows(sqlmock.NewRows([]string{"content"}).AddRow("本文"))
+	mock.ExpectQuery("FROM article_contents").
+		WithArgs(int64(43)).
+		WillReturnRows(sqlmock.NewRows([]string{"content"}))
+	mock.ExpectQuery("FROM article_contents").
+		WithArgs(int64(44)).
+		WillReturnError(errors.New("connection refused"))
+
+	repo := pg.NewArticleContentRepo(db)
+	got, err := repo.GetContent(context.Background(), 42)
+	if err != nil || got != "本文" {
+		t.Fatalf("GetContent(42) = (%q, %v)", got, err)
+	}
+	got, err = repo.GetContent(context.Background(), 43)
+	if err != nil || got != "" {
+		t.Fatalf("GetContent(43) = (%q, %v), want empty for missing content", got, err)
+	}
+	if _, err := repo.GetContent(context.Background(), 44); err == nil {
+		t.Fatal("expected error")
+	}
+}
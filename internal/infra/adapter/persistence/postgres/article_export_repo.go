@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"catchup-feed/internal/repository"
+)
+
+type ArticleExportRepo struct {
+	db           *sql.DB
+	queryBuilder *ArticleQueryBuilder
+}
+
+func NewArticleExportRepo(db *sql.DB) repository.ArticleExportRepository {
+	return &ArticleExportRepo{
+		db:           db,
+		queryBuilder: NewArticleQueryBuilder(),
+	}
+}
+
+func (repo *ArticleExportRepo) EachArticle(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters, limit int, fn func(repository.ArticleWithSource) error) error {
+	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters, "a")
+	whereClause = andCondition(whereClause, "a.deleted_at IS NULL")
+	args = append(args, limit)
+
+	// #nosec G201 -- whereClause is generated by QueryBuilder using numbered placeholders
+	query := fmt.Sprintf(`
+SELECT %s
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+%s
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT $%d`, articleWithSourceColumns, whereClause, len(args))
+
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("EachArticle: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	// 1行ずつ読み出して渡す。全件をメモリに載せない
+	for rows.Next() {
+		var row articleRow
+		var sourceName string
+		if err := rows.Scan(row.dest(&sourceName)...); err != nil {
+			return fmt.Errorf("EachArticle: Scan: %w", err)
+		}
+		if err := fn(repository.ArticleWithSource{Article: row.toEntity(), SourceName: sourceName}); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("EachArticle: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	pg "catchup-feed/internal/infra/adapter/persistence/postgres"
+	"catchup-feed/internal/repository"
+)
+
+func TestArticleExportRepo_EachArticle(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	sourceID := int64(3)
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE a.source_id = $1 AND a.deleted_at IS NULL
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT $2`)).
+		WithArgs(int64(3), 1000).
+		WillReturnRows(sqlmock.NewRows(articleWithSourceColumnNames).
+			AddRow(int64(2), int64(3), "Go 1.26", "https://go.dev/blog/go1.26", "summary", now, now, nil, "", "", "", "", "", "Go Blog").
+			AddRow(int64(1), int64(3), "Go 1.25", "https://go.dev/blog/go1.25", "summary", now, now, nil, "", "", "", "", "", "Go Blog"))
+
+	var ids []int64
+	err := pg.NewArticleExportRepo(db).EachArticle(context.Background(), nil,
+		repository.ArticleSearchFilters{SourceID: &sourceID}, 1000,
+		func(a repository.ArticleWithSource) error {
+			ids = append(ids, a.Article.ID)
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("EachArticle err=%v", err)
+	}
+	if len(ids) != 2 || ids[0] != 2 || ids[1] != 1 {
+		t.Errorf("ids = %v, want [2 1]", ids)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArticleExportRepo_EachArticle_StopsOnCallbackError(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`ORDER BY a.published_at DESC, a.id DESC
+LIMIT $1`)).
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows(articleWithSourceColumnNames).
+			AddRow(int64(2), int64(3), "Go 1.26", "https://go.dev/blog/go1.26", "summary", now, now, nil, "", "", "", "", "", "Go Blog").
+			AddRow(int64(1), int64(3), "Go 1.25", "https://go.dev/blog/go1.25", "summary", now, now, nil, "", "", "", "", "", "Go Blog"))
+
+	errClosed := errors.New("client disconnected")
+	calls := 0
+	err := pg.NewArticleExportRepo(db).EachArticle(context.Background(), nil, repository.ArticleSearchFilters{}, 10,
+		func(repository.ArticleWithSource) error {
+			calls++
+			return errClosed
+		})
+	if !errors.Is(err, errClosed) || calls != 1 {
+		t.Errorf("err = %v, calls = %d; want %v after 1 call", err, calls, errClosed)
+	}
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"catchup-feed/internal/pkg/search"
+	"catchup-feed/internal/repository"
+)
+
+// Compile-time check that ArticleRepo supports facet counts.
+var _ repository.ArticleFacetRepository = (*ArticleRepo)(nil)
+
+// CountFacet counts the articles matching keywords and filters per value of facet.
+// The WHERE clause is built by the same QueryBuilder as SearchWithFiltersPaginated,
+// so the counts add up to the search results.
+func (repo *ArticleRepo) CountFacet(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters, facet string, limit int) ([]repository.FacetBucket, error) {
+	// No keywords and no filters -> return empty result (same as the search)
+	if len(keywords) == 0 && filters.Empty() {
+		return []repository.FacetBucket{}, nil
+	}
+
+	// Apply search timeout to prevent long-running queries
+	ctx, cancel := context.WithTimeout(ctx, search.DefaultSearchTimeout)
+	defer cancel()
+
+	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters, "a")
+	whereClause = andCondition(whereClause, "a.deleted_at IS NULL")
+	args = append(args, limit)
+
+	var query string
+	switch facet {
+	case repository.FacetSource:
+		query = `
+SELECT s.id::text, s.name, COUNT(*)
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+%s
+GROUP BY s.id, s.name
+ORDER BY COUNT(*) DESC, s.name, s.id
+LIMIT $%d`
+	case repository.FacetMonth:
+		// 月の境界はタイムゾーンに依存するため UTC で集計する
+		whereClause = andCondition(whereClause, "a.published_at IS NOT NULL")
+		query = `
+SELECT to_char(a.published_at AT TIME ZONE 'UTC', 'YYYY-MM') AS month, '', COUNT(*)
+FROM articles a
+%s
+GROUP BY month
+ORDER BY month DESC
+LIMIT $%d`
+	case repository.FacetTag:
+		query = `
+SELECT ft.name, '', COUNT(*)
+FROM articles a
+INNER JOIN article_tags fat ON fat.article_id = a.id
+INNER JOIN tags ft ON ft.id = fat.tag_id
+%s
+GROUP BY ft.name
+ORDER BY COUNT(*) DESC, ft.name
+LIMIT $%d`
+	default:
+		return nil, fmt.Errorf("CountFacet: unknown facet %q", facet)
+	}
+	// #nosec G201 -- whereClause is generated by QueryBuilder using parameterized placeholders
+	query = fmt.Sprintf(query, whereClause, len(args))
+
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("CountFacet: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	buckets := make([]repository.FacetBucket, 0, limit)
+	for rows.Next() {
+		var b repository.FacetBucket
+		if err := rows.Scan(&b.Value, &b.Label, &b.Count); err != nil {
+			return nil, fmt.Errorf("CountFacet: Scan: %w", err)
+		}
+		buckets = append(buckets, b)
+	}
+	return buckets, rows.Err()
+}
+
+// andCondition appends cond to whereClause, which is empty or starts with WHERE.
+func andCondition(whereClause, cond string) string {
+	if whereClause == "" {
+		return "WHERE " + cond
+	}
+	return whereClause + " AND " + cond
+}
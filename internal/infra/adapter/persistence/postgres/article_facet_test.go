@@ -0,0 +1,191 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+
+	pg "catchup-feed/internal/infra/adapter/persistence/postgres"
+	"catchup-feed/internal/repository"
+)
+
+func newFacetRepo(t *testing.T) (repository.ArticleFacetRepository, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	repo, ok := pg.NewArticleRepo(db).(repository.ArticleFacetRepository)
+	if !ok {
+		t.Fatal("ArticleRepo does not implement ArticleFacetRepository")
+	}
+	return repo, mock
+}
+
+func TestArticleRepo_CountFacet(t *testing.T) {
+	t.Parallel()
+
+	sourceID := int64(10)
+	tests := []struct {
+		name    string
+		facet   string
+		filters repository.ArticleSearchFilters
+		query   string
+		args    []driver.Value
+		rows    *sqlmock.Rows
+		want    []repository.FacetBucket
+	}{
+		{
+			name:  "source",
+			facet: repository.FacetSource,
+			query: `
+SELECT s.id::text, s.name, COUNT(*)
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+WHERE (a.title ILIKE $1 OR a.summary ILIKE $1) AND a.deleted_at IS NULL
+GROUP BY s.id, s.name
+ORDER BY COUNT(*) DESC, s.name, s.id
+LIMIT $2`,
+			args: []driver.Value{"%go%", 20},
+			rows: sqlmock.NewRows([]string{"id", "name", "count"}).
+				AddRow("10", "Go Blog", 12).
+				AddRow("3", "Zenn", 4),
+			want: []repository.FacetBucket{
+				{Value: "10", Label: "Go Blog", Count: 12},
+				{Value: "3", Label: "Zenn", Count: 4},
+			},
+		},
+		{
+			name:    "month",
+			facet:   repository.FacetMonth,
+			filters: repository.ArticleSearchFilters{SourceID: &sourceID},
+			query: `
+SELECT to_char(a.published_at AT TIME ZONE 'UTC', 'YYYY-MM') AS month, '', COUNT(*)
+FROM articles a
+WHERE (a.title ILIKE $1 OR a.summary ILIKE $1) AND a.source_id = $2 AND a.deleted_at IS NULL AND a.published_at IS NOT NULL
+GROUP BY month
+ORDER BY month DESC
+LIMIT $3`,
+			args: []driver.Value{"%go%", sourceID, 20},
+			rows: sqlmock.NewRows([]string{"month", "label", "count"}).
+				AddRow("2025-06", "", 7).
+				AddRow("2025-05", "", 9),
+			want: []repository.FacetBucket{
+				{Value: "2025-06", Count: 7},
+				{Value: "2025-05", Count: 9},
+			},
+		},
+		{
+			name:    "tag",
+			facet:   repository.FacetTag,
+			filters: repository.ArticleSearchFilters{Tags: []string{"go"}},
+			query: `
+SELECT ft.name, '', COUNT(*)
+FROM articles a
+INNER JOIN article_tags fat ON fat.article_id = a.id
+INNER JOIN tags ft ON ft.id = fat.tag_id
+WHERE (a.title ILIKE $1 OR a.summary ILIKE $1) AND a.id IN (SELECT atg.article_id FROM article_tags atg INNER JOIN tags tg ON tg.id = atg.tag_id WHERE tg.name = $2) AND a.deleted_at IS NULL
+GROUP BY ft.name
+ORDER BY COUNT(*) DESC, ft.name
+LIMIT $3`,
+			args: []driver.Value{"%go%", "go", 20},
+			rows: sqlmock.NewRows([]string{"name", "label", "count"}).
+				AddRow("go", "", 16).
+				AddRow("generics", "", 5),
+			want: []repository.FacetBucket{
+				{Value: "go", Count: 16},
+				{Value: "generics", Count: 5},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo, mock := newFacetRepo(t)
+			mock.ExpectQuery(regexp.QuoteMeta(tt.query)).
+				WithArgs(tt.args...).
+				WillReturnRows(tt.rows)
+
+			got, err := repo.CountFacet(context.Background(), []string{"go"}, tt.filters, tt.facet, 20)
+			if err != nil {
+				t.Fatalf("CountFacet err=%v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("CountFacet mismatch (-want +got):\n%s", diff)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestArticleRepo_CountFacet_MonthWithoutConditions(t *testing.T) {
+	t.Parallel()
+
+	repo, mock := newFacetRepo(t)
+	tag := []string{"go"}
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE a.id IN (SELECT atg.article_id FROM article_tags atg INNER JOIN tags tg ON tg.id = atg.tag_id WHERE tg.name = $1) AND a.deleted_at IS NULL AND a.published_at IS NOT NULL`)).
+		WithArgs("go", 5).
+		WillReturnRows(sqlmock.NewRows([]string{"month", "label", "count"}))
+
+	got, err := repo.CountFacet(context.Background(), nil, repository.ArticleSearchFilters{Tags: tag}, repository.FacetMonth, 5)
+	if err != nil {
+		t.Fatalf("CountFacet err=%v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("CountFacet = %+v, want empty", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArticleRepo_CountFacet_NoCriteria(t *testing.T) {
+	t.Parallel()
+
+	repo, mock := newFacetRepo(t)
+
+	got, err := repo.CountFacet(context.Background(), nil, repository.ArticleSearchFilters{}, repository.FacetSource, 20)
+	if err != nil {
+		t.Fatalf("CountFacet err=%v", err)
+	}
+	if got == nil || len(got) != 0 {
+		t.Fatalf("CountFacet = %#v, want empty slice", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArticleRepo_CountFacet_UnknownFacet(t *testing.T) {
+	t.Parallel()
+
+	repo, _ := newFacetRepo(t)
+
+	_, err := repo.CountFacet(context.Background(), []string{"go"}, repository.ArticleSearchFilters{}, "author", 20)
+	if err == nil || err.Error() != `CountFacet: unknown facet "author"` {
+		t.Fatalf("CountFacet err=%v", err)
+	}
+}
+
+func TestArticleRepo_CountFacet_QueryError(t *testing.T) {
+	t.Parallel()
+
+	repo, mock := newFacetRepo(t)
+	mock.ExpectQuery("SELECT").WillReturnError(errors.New("db down"))
+
+	_, err := repo.CountFacet(context.Background(), []string{"go"}, repository.ArticleSearchFilters{}, repository.FacetTag, 20)
+	if err == nil || err.Error() != "CountFacet: db down" {
+		t.Fatalf("CountFacet err=%v", err)
+	}
+}
@@ -0,0 +1,145 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	pg "catchup-feed/internal/infra/adapter/persistence/postgres"
+	"catchup-feed/internal/repository"
+)
+
+func keysetRows(now time.Time, ids ...int64) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{
+		"id", "source_id", "title", "url",
+		"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
+	})
+	for _, id := range ids {
+		rows.AddRow(id, 10, "Article", "https://example.com", "Summary", now, now, nil, "", "", "", "", "", "Test Source")
+	}
+	return rows
+}
+
+func newKeysetRepo(t *testing.T) (repository.ArticleKeysetRepository, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	repo, ok := pg.NewArticleRepo(db).(repository.ArticleKeysetRepository)
+	if !ok {
+		t.Fatal("ArticleRepo does not implement ArticleKeysetRepository")
+	}
+	return repo, mock
+}
+
+func TestArticleRepo_ListWithSourceAfter_FirstPage(t *testing.T) {
+	t.Parallel()
+
+	repo, mock := newKeysetRepo(t)
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`INNER JOIN sources s ON a.source_id = s.id
+WHERE a.deleted_at IS NULL
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT $1`)).
+		WithArgs(3).
+		WillReturnRows(keysetRows(now, 5, 4, 3))
+
+	result, err := repo.ListWithSourceAfter(context.Background(), nil, 3)
+	if err != nil {
+		t.Fatalf("ListWithSourceAfter err=%v", err)
+	}
+	if len(result) != 3 || result[0].Article.ID != 5 || result[0].SourceName != "Test Source" {
+		t.Fatalf("ListWithSourceAfter result = %+v", result)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArticleRepo_ListWithSourceAfter_NextPage(t *testing.T) {
+	t.Parallel()
+
+	repo, mock := newKeysetRepo(t)
+	now := time.Now()
+	after := &repository.ArticleKeyset{PublishedAt: now, ID: 3}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE a.deleted_at IS NULL AND (a.published_at, a.id) < ($1, $2)
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT $3`)).
+		WithArgs(now, int64(3), 3).
+		WillReturnRows(keysetRows(now, 2, 1))
+
+	result, err := repo.ListWithSourceAfter(context.Background(), after, 3)
+	if err != nil {
+		t.Fatalf("ListWithSourceAfter err=%v", err)
+	}
+	if len(result) != 2 || result[1].Article.ID != 1 {
+		t.Fatalf("ListWithSourceAfter result = %+v", result)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArticleRepo_ListWithSourceAfter_QueryError(t *testing.T) {
+	t.Parallel()
+
+	repo, mock := newKeysetRepo(t)
+	mock.ExpectQuery("SELECT").WillReturnError(errors.New("db down"))
+
+	_, err := repo.ListWithSourceAfter(context.Background(), nil, 10)
+	if err == nil || !regexp.MustCompile(`^ListWithSourceAfter: db down$`).MatchString(err.Error()) {
+		t.Fatalf("ListWithSourceAfter err = %v", err)
+	}
+}
+
+func TestArticleRepo_SearchWithFiltersAfter(t *testing.T) {
+	t.Parallel()
+
+	repo, mock := newKeysetRepo(t)
+	now := time.Now()
+	sourceID := int64(10)
+	after := &repository.ArticleKeyset{PublishedAt: now, ID: 7}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE (a.title ILIKE $1 OR a.summary ILIKE $1) AND a.source_id = $2 AND a.deleted_at IS NULL AND (a.published_at, a.id) < ($3, $4)
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT $5`)).
+		WithArgs("%go%", sourceID, now, int64(7), 21).
+		WillReturnRows(keysetRows(now, 6))
+
+	result, err := repo.SearchWithFiltersAfter(context.Background(), []string{"go"}, repository.ArticleSearchFilters{SourceID: &sourceID}, after, 21)
+	if err != nil {
+		t.Fatalf("SearchWithFiltersAfter err=%v", err)
+	}
+	if len(result) != 1 || result[0].Article.ID != 6 {
+		t.Fatalf("SearchWithFiltersAfter result = %+v", result)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArticleRepo_SearchWithFiltersAfter_NoCriteria(t *testing.T) {
+	t.Parallel()
+
+	repo, mock := newKeysetRepo(t)
+
+	result, err := repo.SearchWithFiltersAfter(context.Background(), nil, repository.ArticleSearchFilters{}, nil, 20)
+	if err != nil {
+		t.Fatalf("SearchWithFiltersAfter err=%v", err)
+	}
+	if len(result) != 0 {
+		t.Fatalf("SearchWithFiltersAfter result length = %d, want 0", len(result))
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
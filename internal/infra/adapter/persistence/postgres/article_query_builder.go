@@ -20,7 +20,7 @@ func NewArticleQueryBuilder() *ArticleQueryBuilder {
 }
 
 // BuildWhereClause builds WHERE clause and arguments for article search.
-// It supports multi-keyword AND logic and optional filters (source_id, date range).
+// It supports multi-keyword AND logic and optional filters (source_id, category, date range, tags).
 // Returns empty string if no conditions are provided.
 // PostgreSQL-specific: Uses ILIKE for case-insensitive search and $N placeholders.
 func (qb *ArticleQueryBuilder) BuildWhereClause(keywords []string, filters repository.ArticleSearchFilters, tableAlias string) (clause string, args []interface{}) {
@@ -61,6 +61,21 @@ func (qb *ArticleQueryBuilder) BuildWhereClause(keywords []string, filters repos
 		paramIndex++
 	}
 
+	// Add category filter (articles of the sources in the category)
+	if filters.CategoryID != nil {
+		var col string
+		if tableAlias != "" {
+			col = tableAlias + ".source_id"
+		} else {
+			col = "source_id"
+		}
+		conditions = append(conditions, fmt.Sprintf(
+			"%s IN (SELECT scm.source_id FROM source_category_members scm WHERE scm.category_id = $%d)",
+			col, paramIndex))
+		args = append(args, *filters.CategoryID)
+		paramIndex++
+	}
+
 	// Add date range filters
 	if filters.From != nil {
 		var col string
@@ -82,6 +97,22 @@ func (qb *ArticleQueryBuilder) BuildWhereClause(keywords []string, filters repos
 		}
 		conditions = append(conditions, fmt.Sprintf("%s <= $%d", col, paramIndex))
 		args = append(args, *filters.To)
+		paramIndex++
+	}
+
+	// Add tag filters (the article must have every tag)
+	for _, tag := range filters.Tags {
+		var col string
+		if tableAlias != "" {
+			col = tableAlias + ".id"
+		} else {
+			col = "id"
+		}
+		conditions = append(conditions, fmt.Sprintf(
+			"%s IN (SELECT atg.article_id FROM article_tags atg INNER JOIN tags tg ON tg.id = atg.tag_id WHERE tg.name = $%d)",
+			col, paramIndex))
+		args = append(args, tag)
+		paramIndex++
 	}
 
 	// Return empty if no conditions
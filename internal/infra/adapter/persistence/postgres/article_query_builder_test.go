@@ -185,3 +185,56 @@ func TestArticleQueryBuilder_BuildWhereClause_OnlyToFilter(t *testing.T) {
 		t.Fatalf("len(args) = %d, want 1", len(args))
 	}
 }
+
+func TestArticleQueryBuilder_BuildWhereClause_WithTagFilters(t *testing.T) {
+	builder := postgres.NewArticleQueryBuilder()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	filters := repository.ArticleSearchFilters{
+		From: &from,
+		Tags: []string{"go", "release"},
+	}
+	clause, args := builder.BuildWhereClause([]string{"Go"}, filters, "a")
+
+	expectedClause := "WHERE (a.title ILIKE $1 OR a.summary ILIKE $1) AND a.published_at >= $2" +
+		" AND a.id IN (SELECT atg.article_id FROM article_tags atg INNER JOIN tags tg ON tg.id = atg.tag_id WHERE tg.name = $3)" +
+		" AND a.id IN (SELECT atg.article_id FROM article_tags atg INNER JOIN tags tg ON tg.id = atg.tag_id WHERE tg.name = $4)"
+	if clause != expectedClause {
+		t.Errorf("clause = %q, want %q", clause, expectedClause)
+	}
+	if len(args) != 4 {
+		t.Fatalf("len(args) = %d, want 4", len(args))
+	}
+	if args[2] != "go" || args[3] != "release" {
+		t.Errorf("tag args = %v, want [go release]", args[2:])
+	}
+}
+
+func TestArticleQueryBuilder_BuildWhereClause_TagsAfterToFilter(t *testing.T) {
+	builder := postgres.NewArticleQueryBuilder()
+	to := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+	filters := repository.ArticleSearchFilters{To: &to, Tags: []string{"go"}}
+	clause, _ := builder.BuildWhereClause(nil, filters, "")
+
+	expectedClause := "WHERE published_at <= $1" +
+		" AND id IN (SELECT atg.article_id FROM article_tags atg INNER JOIN tags tg ON tg.id = atg.tag_id WHERE tg.name = $2)"
+	if clause != expectedClause {
+		t.Errorf("clause = %q, want %q", clause, expectedClause)
+	}
+}
+
+func TestArticleQueryBuilder_BuildWhereClause_WithCategoryFilter(t *testing.T) {
+	builder := postgres.NewArticleQueryBuilder()
+	sourceID, categoryID := int64(1), int64(4)
+	filters := repository.ArticleSearchFilters{SourceID: &sourceID, CategoryID: &categoryID, Tags: []string{"go"}}
+	clause, args := builder.BuildWhereClause(nil, filters, "a")
+
+	expectedClause := "WHERE a.source_id = $1" +
+		" AND a.source_id IN (SELECT scm.source_id FROM source_category_members scm WHERE scm.category_id = $2)" +
+		" AND a.id IN (SELECT atg.article_id FROM article_tags atg INNER JOIN tags tg ON tg.id = atg.tag_id WHERE tg.name = $3)"
+	if clause != expectedClause {
+		t.Errorf("clause = %q, want %q", clause, expectedClause)
+	}
+	if len(args) != 3 || args[1] != int64(4) {
+		t.Errorf("args = %v, want category ID as second argument", args)
+	}
+}
@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"catchup-feed/internal/pkg/search"
+	"catchup-feed/internal/repository"
+)
+
+// Compile-time check that ArticleRepo supports ranked search.
+var _ repository.ArticleRankedSearchRepository = (*ArticleRepo)(nil)
+
+// SearchRanked searches articles matching q and filters and orders them by relevance.
+//
+// Matching uses ILIKE like SearchWithFiltersPaginated (served by the trigram GIN
+// indexes). Relevance is ts_rank over the title (weight A) and summary (weight B)
+// for space-separated languages, and pg_trgm word_similarity for queries containing
+// CJK text, which the 'simple' text search parser cannot split into words.
+func (repo *ArticleRepo) SearchRanked(ctx context.Context, q search.Query, filters repository.ArticleSearchFilters, offset, limit int) ([]repository.RankedArticle, error) {
+	// Apply search timeout to prevent long-running queries
+	ctx, cancel := context.WithTimeout(ctx, search.DefaultSearchTimeout)
+	defer cancel()
+
+	whereClause, args := repo.rankedWhereClause(q, filters)
+	rankExpr, rankArg := rankExpression(q, len(args)+1)
+	args = append(args, rankArg, limit, offset)
+
+	// #nosec G201 -- whereClause and rankExpr only contain numbered placeholders
+	query := fmt.Sprintf(`
+SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name,
+       %s AS relevance
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+%s
+ORDER BY relevance DESC, a.published_at DESC, a.id DESC
+LIMIT $%d OFFSET $%d`, rankExpr, whereClause, len(args)-1, len(args))
+
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SearchRanked: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	result := make([]repository.RankedArticle, 0, limit)
+	for rows.Next() {
+		var row articleRow
+		var item repository.RankedArticle
+		if err := rows.Scan(row.dest(&item.SourceName, &item.Rank)...); err != nil {
+			return nil, fmt.Errorf("SearchRanked: Scan: %w", err)
+		}
+		item.Article = row.toEntity()
+		result = append(result, item)
+	}
+	return result, rows.Err()
+}
+
+// CountRanked returns the number of articles matching q and filters.
+func (repo *ArticleRepo) CountRanked(ctx context.Context, q search.Query, filters repository.ArticleSearchFilters) (int64, error) {
+	// Apply search timeout to prevent long-running queries
+	ctx, cancel := context.WithTimeout(ctx, search.DefaultSearchTimeout)
+	defer cancel()
+
+	whereClause, args := repo.rankedWhereClause(q, filters)
+	query := "SELECT COUNT(*) FROM articles a " + whereClause
+
+	var count int64
+	if err := repo.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("CountRanked: %w", err)
+	}
+	return count, nil
+}
+
+// rankedWhereClause builds the WHERE clause for q and filters: every clause of q must
+// match the title or summary of the article, and no excluded term may match.
+func (repo *ArticleRepo) rankedWhereClause(q search.Query, filters repository.ArticleSearchFilters) (string, []interface{}) {
+	whereClause, args := repo.queryBuilder.BuildWhereClause(nil, filters, "a")
+	whereClause = andCondition(whereClause, "a.deleted_at IS NULL")
+
+	var conditions []string
+	if whereClause != "" {
+		conditions = append(conditions, strings.TrimPrefix(whereClause, "WHERE "))
+	}
+	match := func(t search.Term) string {
+		args = append(args, search.EscapeILIKE(t.Text))
+		return fmt.Sprintf("a.title ILIKE $%d OR COALESCE(a.summary, '') ILIKE $%d", len(args), len(args))
+	}
+	for _, clause := range q.Clauses {
+		terms := make([]string, len(clause))
+		for i, t := range clause {
+			terms[i] = match(t)
+		}
+		conditions = append(conditions, "("+strings.Join(terms, " OR ")+")")
+	}
+	for _, t := range q.Excluded {
+		conditions = append(conditions, "NOT ("+match(t)+")")
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// rankExpression returns the relevance expression for q using placeholder paramIndex,
+// and the argument of that placeholder.
+func rankExpression(q search.Query, paramIndex int) (string, interface{}) {
+	texts := q.TermTexts()
+	if search.ContainsCJK(strings.Join(texts, " ")) {
+		// 日本語は空白で分かち書きされないため、トライグラムの類似度で順位付けする
+		return fmt.Sprintf("(2 * word_similarity($%d, a.title) + word_similarity($%d, COALESCE(a.summary, ''))) / 3",
+			paramIndex, paramIndex), strings.Join(texts, " ")
+	}
+	return fmt.Sprintf("ts_rank(setweight(to_tsvector('simple', a.title), 'A') || setweight(to_tsvector('simple', COALESCE(a.summary, '')), 'B'), to_tsquery('simple', $%d))",
+		paramIndex), rankTSQuery(texts)
+}
+
+// rankTSQuery returns a tsquery matching any of texts. Each text is quoted so that
+// operators in user input are literal and phrases become <-> (followed by) chains.
+func rankTSQuery(texts []string) string {
+	quoted := make([]string, len(texts))
+	for i, t := range texts {
+		t = strings.ReplaceAll(t, `\`, `\\`)
+		quoted[i] = "'" + strings.ReplaceAll(t, "'", "''") + "'"
+	}
+	return strings.Join(quoted, " | ")
+}
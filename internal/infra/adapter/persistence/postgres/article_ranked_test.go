@@ -0,0 +1,127 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	pg "catchup-feed/internal/infra/adapter/persistence/postgres"
+	"catchup-feed/internal/pkg/search"
+	"catchup-feed/internal/repository"
+)
+
+func newRankedRepo(t *testing.T) (repository.ArticleRankedSearchRepository, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	repo, ok := pg.NewArticleRepo(db).(repository.ArticleRankedSearchRepository)
+	if !ok {
+		t.Fatal("ArticleRepo does not implement ArticleRankedSearchRepository")
+	}
+	return repo, mock
+}
+
+func rankedRows(now time.Time) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "source_id", "title", "url",
+		"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name", "relevance",
+	}).
+		AddRow(2, 10, "Go generics", "https://example.com/2", "Summary", now, now, nil, "", "", "", "", "", "Go Blog", 0.6).
+		AddRow(1, 10, "Rust", "https://example.com/1", "generics", now, now, nil, "", "", "", "", "", "Go Blog", 0.2)
+}
+
+func mustQuery(t *testing.T, input string) search.Query {
+	t.Helper()
+	q, err := search.ParseQuery(input, 10, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return q
+}
+
+func TestArticleRepo_SearchRanked_TSRank(t *testing.T) {
+	t.Parallel()
+
+	repo, mock := newRankedRepo(t)
+	now := time.Now()
+	sourceID := int64(10)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`ts_rank(setweight(to_tsvector('simple', a.title), 'A') || setweight(to_tsvector('simple', COALESCE(a.summary, '')), 'B'), to_tsquery('simple', $6)) AS relevance
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+WHERE a.source_id = $1 AND a.deleted_at IS NULL AND (a.title ILIKE $2 OR COALESCE(a.summary, '') ILIKE $2 OR a.title ILIKE $3 OR COALESCE(a.summary, '') ILIKE $3) AND (a.title ILIKE $4 OR COALESCE(a.summary, '') ILIKE $4) AND NOT (a.title ILIKE $5 OR COALESCE(a.summary, '') ILIKE $5)
+ORDER BY relevance DESC, a.published_at DESC, a.id DESC
+LIMIT $7 OFFSET $8`)).
+		WithArgs(sourceID, "%generics%", "%type parameters%", "%it's%", "%beta%", `'generics' | 'type parameters' | 'it''s'`, 20, 40).
+		WillReturnRows(rankedRows(now))
+
+	result, err := repo.SearchRanked(context.Background(), mustQuery(t, `generics OR "type parameters" it's -beta`),
+		repository.ArticleSearchFilters{SourceID: &sourceID}, 40, 20)
+	if err != nil {
+		t.Fatalf("SearchRanked err=%v", err)
+	}
+	if len(result) != 2 || result[0].Article.ID != 2 || result[0].Rank != 0.6 || result[0].SourceName != "Go Blog" {
+		t.Fatalf("SearchRanked result = %+v", result)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArticleRepo_SearchRanked_TrigramForJapanese(t *testing.T) {
+	t.Parallel()
+
+	repo, mock := newRankedRepo(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`(2 * word_similarity($2, a.title) + word_similarity($2, COALESCE(a.summary, ''))) / 3 AS relevance`)).
+		WithArgs("%型パラメータ%", "型パラメータ", 10, 0).
+		WillReturnRows(rankedRows(time.Now()))
+
+	if _, err := repo.SearchRanked(context.Background(), mustQuery(t, "型パラメータ"), repository.ArticleSearchFilters{}, 0, 10); err != nil {
+		t.Fatalf("SearchRanked err=%v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArticleRepo_SearchRanked_QueryError(t *testing.T) {
+	t.Parallel()
+
+	repo, mock := newRankedRepo(t)
+	mock.ExpectQuery("SELECT").WillReturnError(errors.New("db down"))
+
+	_, err := repo.SearchRanked(context.Background(), mustQuery(t, "go"), repository.ArticleSearchFilters{}, 0, 10)
+	if err == nil || err.Error() != "SearchRanked: db down" {
+		t.Fatalf("SearchRanked err = %v", err)
+	}
+}
+
+func TestArticleRepo_CountRanked(t *testing.T) {
+	t.Parallel()
+
+	repo, mock := newRankedRepo(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM articles a WHERE a.deleted_at IS NULL AND (a.title ILIKE $1 OR COALESCE(a.summary, '') ILIKE $1) AND NOT (a.title ILIKE $2 OR COALESCE(a.summary, '') ILIKE $2)`)).
+		WithArgs("%go%", "%beta%").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+	count, err := repo.CountRanked(context.Background(), mustQuery(t, "go -beta"), repository.ArticleSearchFilters{})
+	if err != nil {
+		t.Fatalf("CountRanked err=%v", err)
+	}
+	if count != 7 {
+		t.Errorf("CountRanked = %d, want 7", count)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
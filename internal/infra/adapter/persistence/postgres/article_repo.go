@@ -25,8 +25,9 @@ func NewArticleRepo(db *sql.DB) repository.ArticleRepository {
 
 func (repo *ArticleRepo) List(ctx context.Context) ([]*entity.Article, error) {
 	const query = `
-SELECT id, source_id, title, url, summary, published_at, created_at
+SELECT id, source_id, title, url, summary, published_at, created_at, summary_structured, prompt_version, summary_status, summary_batch_id, summary_model, injection_flags
 FROM articles
+WHERE deleted_at IS NULL
 ORDER BY published_at DESC`
 	rows, err := repo.db.QueryContext(ctx, query)
 	if err != nil {
@@ -37,21 +38,21 @@ ORDER BY published_at DESC`
 	// パフォーマンス最適化: メモリ再割り当てを削減するため事前割り当て
 	articles := make([]*entity.Article, 0, 100)
 	for rows.Next() {
-		var article entity.Article
-		if err := rows.Scan(&article.ID, &article.SourceID, &article.Title,
-			&article.URL, &article.Summary, &article.PublishedAt, &article.CreatedAt); err != nil {
+		var row articleRow
+		if err := rows.Scan(row.dest()...); err != nil {
 			return nil, fmt.Errorf("List: Scan: %w", err)
 		}
-		articles = append(articles, &article)
+		articles = append(articles, row.toEntity())
 	}
 	return articles, rows.Err()
 }
 
 func (repo *ArticleRepo) ListWithSource(ctx context.Context) ([]repository.ArticleWithSource, error) {
 	const query = `
-SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, s.name AS source_name
+SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name
 FROM articles a
 INNER JOIN sources s ON a.source_id = s.id
+WHERE a.deleted_at IS NULL
 ORDER BY a.published_at DESC`
 	rows, err := repo.db.QueryContext(ctx, query)
 	if err != nil {
@@ -62,14 +63,13 @@ ORDER BY a.published_at DESC`
 	// パフォーマンス最適化: メモリ再割り当てを削減するため事前割り当て
 	result := make([]repository.ArticleWithSource, 0, 100)
 	for rows.Next() {
-		var article entity.Article
+		var row articleRow
 		var sourceName string
-		if err := rows.Scan(&article.ID, &article.SourceID, &article.Title,
-			&article.URL, &article.Summary, &article.PublishedAt, &article.CreatedAt, &sourceName); err != nil {
+		if err := rows.Scan(row.dest(&sourceName)...); err != nil {
 			return nil, fmt.Errorf("ListWithSource: Scan: %w", err)
 		}
 		result = append(result, repository.ArticleWithSource{
-			Article:    &article,
+			Article:    row.toEntity(),
 			SourceName: sourceName,
 		})
 	}
@@ -80,9 +80,10 @@ ORDER BY a.published_at DESC`
 // Uses LIMIT and OFFSET for efficient pagination.
 func (repo *ArticleRepo) ListWithSourcePaginated(ctx context.Context, offset, limit int) ([]repository.ArticleWithSource, error) {
 	const query = `
-SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, s.name AS source_name
+SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name
 FROM articles a
 INNER JOIN sources s ON a.source_id = s.id
+WHERE a.deleted_at IS NULL
 ORDER BY a.published_at DESC
 LIMIT $1 OFFSET $2`
 
@@ -94,14 +95,13 @@ LIMIT $1 OFFSET $2`
 
 	result := make([]repository.ArticleWithSource, 0, limit)
 	for rows.Next() {
-		var article entity.Article
+		var row articleRow
 		var sourceName string
-		if err := rows.Scan(&article.ID, &article.SourceID, &article.Title,
-			&article.URL, &article.Summary, &article.PublishedAt, &article.CreatedAt, &sourceName); err != nil {
+		if err := rows.Scan(row.dest(&sourceName)...); err != nil {
 			return nil, fmt.Errorf("ListWithSourcePaginated: Scan: %w", err)
 		}
 		result = append(result, repository.ArticleWithSource{
-			Article:    &article,
+			Article:    row.toEntity(),
 			SourceName: sourceName,
 		})
 	}
@@ -110,7 +110,7 @@ LIMIT $1 OFFSET $2`
 
 // CountArticles returns the total number of articles in the database.
 func (repo *ArticleRepo) CountArticles(ctx context.Context) (int64, error) {
-	const query = `SELECT COUNT(*) FROM articles`
+	const query = `SELECT COUNT(*) FROM articles WHERE deleted_at IS NULL`
 	var count int64
 	err := repo.db.QueryRowContext(ctx, query).Scan(&count)
 	if err != nil {
@@ -121,50 +121,49 @@ func (repo *ArticleRepo) CountArticles(ctx context.Context) (int64, error) {
 
 func (repo *ArticleRepo) Get(ctx context.Context, id int64) (*entity.Article, error) {
 	const query = `
-SELECT id, source_id, title, url, summary, published_at, created_at
+SELECT id, source_id, title, url, summary, published_at, created_at, summary_structured, prompt_version, summary_status, summary_batch_id, summary_model, injection_flags
 FROM articles
-WHERE id = $1
+WHERE id = $1 AND deleted_at IS NULL
 LIMIT 1`
-	var article entity.Article
+	var row articleRow
 	err := repo.db.QueryRowContext(ctx, query, id).
-		Scan(&article.ID, &article.SourceID, &article.Title, &article.URL,
-			&article.Summary, &article.PublishedAt, &article.CreatedAt)
+		Scan(row.dest()...)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, fmt.Errorf("Get: %w", err)
 	}
-	return &article, nil
+	return row.toEntity(), nil
 }
 
 func (repo *ArticleRepo) GetWithSource(ctx context.Context, id int64) (*entity.Article, string, error) {
 	const query = `
-SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, s.name AS source_name
+SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name
 FROM articles a
 INNER JOIN sources s ON a.source_id = s.id
-WHERE a.id = $1
+WHERE a.id = $1 AND a.deleted_at IS NULL
 LIMIT 1`
-	var article entity.Article
+	var row articleRow
 	var sourceName string
 	err := repo.db.QueryRowContext(ctx, query, id).
-		Scan(&article.ID, &article.SourceID, &article.Title, &article.URL,
-			&article.Summary, &article.PublishedAt, &article.CreatedAt, &sourceName)
+		Scan(row.dest(&sourceName)...)
 	if err == sql.ErrNoRows {
 		return nil, "", nil
 	}
 	if err != nil {
 		return nil, "", fmt.Errorf("GetWithSource: %w", err)
 	}
-	return &article, sourceName, nil
+	return row.toEntity(), sourceName, nil
 }
 
 func (repo *ArticleRepo) Search(ctx context.Context, keyword string) ([]*entity.Article, error) {
 	const query = `
-SELECT id, source_id, title, url, summary, published_at, created_at
+SELECT id, source_id, title, url, summary, published_at, created_at, summary_structured, prompt_version, summary_status, summary_batch_id, summary_model, injection_flags
 FROM articles
-WHERE title   ILIKE $1
-    OR summary ILIKE $1
+WHERE (title   ILIKE $1
+    OR summary ILIKE $1)
+  AND deleted_at IS NULL
 ORDER BY published_at DESC`
 	param := "%" + keyword + "%"
 	rows, err := repo.db.QueryContext(ctx, query, param)
@@ -176,12 +175,11 @@ ORDER BY published_at DESC`
 	// パフォーマンス最適化: メモリ再割り当てを削減するため事前割り当て
 	articles := make([]*entity.Article, 0, 100)
 	for rows.Next() {
-		var article entity.Article
-		if err := rows.Scan(&article.ID, &article.SourceID, &article.Title,
-			&article.URL, &article.Summary, &article.PublishedAt, &article.CreatedAt); err != nil {
+		var row articleRow
+		if err := rows.Scan(row.dest()...); err != nil {
 			return nil, fmt.Errorf("Search: Scan: %w", err)
 		}
-		articles = append(articles, &article)
+		articles = append(articles, row.toEntity())
 	}
 	return articles, rows.Err()
 }
@@ -189,7 +187,7 @@ ORDER BY published_at DESC`
 func (repo *ArticleRepo) SearchWithFilters(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters) ([]*entity.Article, error) {
 	// Check if there are any search criteria (keywords or filters)
 	hasKeywords := len(keywords) > 0
-	hasFilters := filters.SourceID != nil || filters.From != nil || filters.To != nil
+	hasFilters := !filters.Empty()
 
 	// No keywords and no filters -> return empty result
 	if !hasKeywords && !hasFilters {
@@ -202,11 +200,12 @@ func (repo *ArticleRepo) SearchWithFilters(ctx context.Context, keywords []strin
 
 	// Build WHERE clause using QueryBuilder
 	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters, "")
+	whereClause = andCondition(whereClause, "deleted_at IS NULL")
 
 	// Construct final query
 	// #nosec G201 -- whereClause is generated by QueryBuilder using parameterized placeholders ($1, $2, etc.)
 	query := fmt.Sprintf(`
-SELECT id, source_id, title, url, summary, published_at, created_at
+SELECT id, source_id, title, url, summary, published_at, created_at, summary_structured, prompt_version, summary_status, summary_batch_id, summary_model, injection_flags
 FROM articles
 %s
 ORDER BY published_at DESC`, whereClause)
@@ -220,12 +219,11 @@ ORDER BY published_at DESC`, whereClause)
 	// パフォーマンス最適化: メモリ再割り当てを削減するため事前割り当て
 	articles := make([]*entity.Article, 0, 100)
 	for rows.Next() {
-		var article entity.Article
-		if err := rows.Scan(&article.ID, &article.SourceID, &article.Title,
-			&article.URL, &article.Summary, &article.PublishedAt, &article.CreatedAt); err != nil {
+		var row articleRow
+		if err := rows.Scan(row.dest()...); err != nil {
 			return nil, fmt.Errorf("SearchWithFilters: Scan: %w", err)
 		}
-		articles = append(articles, &article)
+		articles = append(articles, row.toEntity())
 	}
 	return articles, rows.Err()
 }
@@ -236,7 +234,7 @@ ORDER BY published_at DESC`, whereClause)
 func (repo *ArticleRepo) CountArticlesWithFilters(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters) (int64, error) {
 	// Check if there are any search criteria (keywords or filters)
 	hasKeywords := len(keywords) > 0
-	hasFilters := filters.SourceID != nil || filters.From != nil || filters.To != nil
+	hasFilters := !filters.Empty()
 
 	// No keywords and no filters -> return 0
 	if !hasKeywords && !hasFilters {
@@ -249,6 +247,7 @@ func (repo *ArticleRepo) CountArticlesWithFilters(ctx context.Context, keywords
 
 	// Build WHERE clause using QueryBuilder
 	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters, "")
+	whereClause = andCondition(whereClause, "deleted_at IS NULL")
 
 	// Construct COUNT query
 	query := "SELECT COUNT(*) FROM articles " + whereClause
@@ -268,7 +267,7 @@ func (repo *ArticleRepo) CountArticlesWithFilters(ctx context.Context, keywords
 func (repo *ArticleRepo) SearchWithFiltersPaginated(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters, offset, limit int) ([]repository.ArticleWithSource, error) {
 	// Check if there are any search criteria (keywords or filters)
 	hasKeywords := len(keywords) > 0
-	hasFilters := filters.SourceID != nil || filters.From != nil || filters.To != nil
+	hasFilters := !filters.Empty()
 
 	// No keywords and no filters -> return empty result
 	if !hasKeywords && !hasFilters {
@@ -281,6 +280,7 @@ func (repo *ArticleRepo) SearchWithFiltersPaginated(ctx context.Context, keyword
 
 	// Build WHERE clause using QueryBuilder with table alias 'a'
 	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters, "a")
+	whereClause = andCondition(whereClause, "a.deleted_at IS NULL")
 
 	// Calculate parameter index for LIMIT and OFFSET
 	paramIndex := len(args) + 1
@@ -292,7 +292,7 @@ func (repo *ArticleRepo) SearchWithFiltersPaginated(ctx context.Context, keyword
 	// #nosec G201 -- whereClause is generated by QueryBuilder using parameterized placeholders ($1, $2, etc.)
 	// paramIndex values are integers computed from len(args), not user input.
 	query := fmt.Sprintf(`
-SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, s.name AS source_name
+SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name
 FROM articles a
 INNER JOIN sources s ON a.source_id = s.id
 %s
@@ -307,14 +307,13 @@ LIMIT $%d OFFSET $%d`, whereClause, paramIndex, paramIndex+1)
 
 	result := make([]repository.ArticleWithSource, 0, limit)
 	for rows.Next() {
-		var article entity.Article
+		var row articleRow
 		var sourceName string
-		if err := rows.Scan(&article.ID, &article.SourceID, &article.Title,
-			&article.URL, &article.Summary, &article.PublishedAt, &article.CreatedAt, &sourceName); err != nil {
+		if err := rows.Scan(row.dest(&sourceName)...); err != nil {
 			return nil, fmt.Errorf("SearchWithFiltersPaginated: Scan: %w", err)
 		}
 		result = append(result, repository.ArticleWithSource{
-			Article:    &article,
+			Article:    row.toEntity(),
 			SourceName: sourceName,
 		})
 	}
@@ -322,15 +321,35 @@ LIMIT $%d OFFSET $%d`, whereClause, paramIndex, paramIndex+1)
 	return result, rows.Err()
 }
 
+// Create inserts the article and sets its ID. Unless the article is pending, it also
+// notifies ArticleCreatedChannel with the ID, which is delivered to listeners when
+// the insert commits. Pending articles are announced by ArticleNotifier once summarized.
 func (repo *ArticleRepo) Create(ctx context.Context, article *entity.Article) error {
-	const query = `
+	const insert = `
 INSERT INTO articles
-	   (source_id, title, url, summary, published_at, created_at)
-VALUES ($1, $2, $3, $4, $5, $6)`
-	_, err := repo.db.ExecContext(ctx, query,
+	   (source_id, title, url, summary, published_at, created_at, summary_structured, prompt_version,
+	    summary_status, summary_batch_id, summary_model, injection_flags)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING id`
+	const insertAndNotify = `
+WITH inserted AS (` + insert + `
+)
+SELECT inserted.id FROM inserted, pg_notify('` + ArticleCreatedChannel + `', inserted.id::text)`
+	query := insertAndNotify
+	if article.SummaryStatus == entity.SummaryStatusPending {
+		query = insert
+	}
+	structured, err := encodeStructuredSummary(article.Structured)
+	if err != nil {
+		return fmt.Errorf("Create: %w", err)
+	}
+	err = repo.db.QueryRowContext(ctx, query,
 		article.SourceID, article.Title, article.URL,
 		article.Summary, article.PublishedAt, article.CreatedAt,
-	)
+		structured, article.PromptVersion,
+		article.SummaryStatus, article.SummaryBatchID, article.SummaryModel,
+		encodeInjectionFlags(article.InjectionFlags),
+	).Scan(&article.ID)
 	if err != nil {
 		return fmt.Errorf("Create: %w", err)
 	}
@@ -344,11 +363,23 @@ UPDATE articles SET
        title        = $2,
        url          = $3,
        summary      = $4,
-       published_at = $5
-WHERE id = $6`
+       published_at = $5,
+       summary_structured = $6,
+       prompt_version     = $7,
+       summary_status     = $8,
+       summary_batch_id   = $9,
+       summary_model      = $10,
+       injection_flags    = $11
+WHERE id = $12`
+	structured, err := encodeStructuredSummary(article.Structured)
+	if err != nil {
+		return fmt.Errorf("Update: %w", err)
+	}
 	res, err := repo.db.ExecContext(ctx, query,
 		article.SourceID, article.Title, article.URL,
-		article.Summary, article.PublishedAt, article.ID,
+		article.Summary, article.PublishedAt, structured, article.PromptVersion,
+		article.SummaryStatus, article.SummaryBatchID, article.SummaryModel,
+		encodeInjectionFlags(article.InjectionFlags), article.ID,
 	)
 	if err != nil {
 		return fmt.Errorf("Update: %w", err)
@@ -359,8 +390,10 @@ WHERE id = $6`
 	return nil
 }
 
+// Delete moves the article to the trash. It is hidden from every other query
+// until it is restored or purged (see TrashRepo).
 func (repo *ArticleRepo) Delete(ctx context.Context, id int64) error {
-	const query = `DELETE FROM articles WHERE id = $1`
+	const query = `UPDATE articles SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`
 	res, err := repo.db.ExecContext(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("Delete: %w", err)
@@ -371,8 +404,13 @@ func (repo *ArticleRepo) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
+// ExistsByURL reports whether the URL has been fetched before. Articles in the
+// trash and purged articles (article_tombstones) count as fetched, so that the
+// crawler does not insert them again.
 func (repo *ArticleRepo) ExistsByURL(ctx context.Context, url string) (bool, error) {
-	const query = `SELECT EXISTS (SELECT 1 FROM articles WHERE url = $1)`
+	const query = `
+SELECT EXISTS (SELECT 1 FROM articles WHERE url = $1)
+    OR EXISTS (SELECT 1 FROM article_tombstones WHERE url = $1)`
 	var existsFlag bool
 	err := repo.db.QueryRowContext(ctx, query, url).Scan(&existsFlag)
 	if err != nil {
@@ -382,6 +420,7 @@ func (repo *ArticleRepo) ExistsByURL(ctx context.Context, url string) (bool, err
 }
 
 // ExistsByURLBatch はバッチでURL存在チェックを行い、N+1問題を解消する
+// ExistsByURL と同じく、ゴミ箱の記事と完全削除済みの記事も存在するものとして扱う
 func (repo *ArticleRepo) ExistsByURLBatch(ctx context.Context, urls []string) (map[string]bool, error) {
 	if len(urls) == 0 {
 		return make(map[string]bool), nil
@@ -398,7 +437,9 @@ func (repo *ArticleRepo) ExistsByURLBatch(ctx context.Context, urls []string) (m
 
 	// #nosec G201 -- placeholders are programmatically generated ($1, $2, etc.), not from user input
 	query := fmt.Sprintf(
-		`SELECT url FROM articles WHERE url IN (%s)`,
+		`SELECT url FROM articles WHERE url IN (%[1]s)
+UNION
+SELECT url FROM article_tombstones WHERE url IN (%[1]s)`,
 		strings.Join(placeholders, ", "),
 	)
 
@@ -21,10 +21,10 @@ import (
 func artRow(a *entity.Article) *sqlmock.Rows {
 	return sqlmock.NewRows([]string{
 		"id", "source_id", "title", "url",
-		"summary", "published_at", "created_at",
+		"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags",
 	}).AddRow(
 		a.ID, a.SourceID, a.Title, a.URL,
-		a.Summary, a.PublishedAt, a.CreatedAt,
+		a.Summary, a.PublishedAt, a.CreatedAt, nil, "", "", "", "", strings.Join(a.InjectionFlags, ","),
 	)
 }
 
@@ -88,7 +88,7 @@ func TestArticleRepo_Search(t *testing.T) {
 		WithArgs("%go%").
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags",
 		})) // 空集合で OK
 
 	repo := pg.NewArticleRepo(db)
@@ -105,19 +105,55 @@ func TestArticleRepo_Create(t *testing.T) {
 
 	now := time.Now()
 
-	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO articles")).
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO articles")).
 		WithArgs(int64(2), "title", "https://u",
-			"summary", now, now).
-		WillReturnResult(sqlmock.NewResult(1, 1))
+			"summary", now, now, nil, "", "", "", "", "").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(7)))
 
 	repo := pg.NewArticleRepo(db)
-	err := repo.Create(context.Background(), &entity.Article{
+	art := &entity.Article{
 		SourceID: 2, Title: "title", URL: "https://u",
 		Summary: "summary", PublishedAt: now, CreatedAt: now,
-	})
+	}
+	err := repo.Create(context.Background(), art)
 	if err != nil {
 		t.Fatalf("Create err=%v", err)
 	}
+	if art.ID != 7 {
+		t.Fatalf("ID = %d, want 7 (RETURNING id)", art.ID)
+	}
+}
+
+func TestArticleRepo_Create_NotifiesArticleCreated(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`pg_notify('article_created', inserted.id::text)`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(8)))
+
+	if err := pg.NewArticleRepo(db).Create(context.Background(), &entity.Article{SourceID: 2}); err != nil {
+		t.Fatalf("Create err=%v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArticleRepo_Create_PendingDoesNotNotify(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	// 要約待ちの記事は要約の保存時に通知する
+	mock.ExpectQuery(`^\s*INSERT INTO articles[^)]*\)\s*VALUES \([^)]*\)\s*RETURNING id$`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(9)))
+
+	art := &entity.Article{SourceID: 2, SummaryStatus: entity.SummaryStatusPending}
+	if err := pg.NewArticleRepo(db).Create(context.Background(), art); err != nil {
+		t.Fatalf("Create err=%v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
 }
 
 /* ─────────────────────────── 5. Update ─────────────────────────── */
@@ -130,7 +166,7 @@ func TestArticleRepo_Update(t *testing.T) {
 
 	mock.ExpectExec("UPDATE articles").
 		WithArgs(int64(2), "new", "https://u",
-			"sum", now, int64(1)).
+			"sum", now, nil, "", "", "", "", "", int64(1)).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
 	repo := pg.NewArticleRepo(db)
@@ -149,7 +185,7 @@ func TestArticleRepo_Delete(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer func() { _ = db.Close() }()
 
-	mock.ExpectExec("DELETE FROM articles").
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE articles SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL")).
 		WithArgs(int64(1)).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
@@ -166,7 +202,8 @@ func TestArticleRepo_ExistsByURL(t *testing.T) {
 	defer func() { _ = db.Close() }()
 
 	// PostgreSQLはSELECT EXISTSを使用し、常に1行返す（trueまたはfalse）
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT EXISTS (SELECT 1 FROM articles WHERE url = $1)")).
+	// ゴミ箱・完全削除済みの記事（article_tombstones）も取得済みとして扱う
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT EXISTS (SELECT 1 FROM articles WHERE url = $1)\n    OR EXISTS (SELECT 1 FROM article_tombstones WHERE url = $1)")).
 		WithArgs("https://u").
 		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
 
@@ -209,7 +246,7 @@ func TestArticleRepo_ExistsByURLBatch(t *testing.T) {
 	}
 
 	// article1とarticle3が存在する
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT url FROM articles WHERE url IN ($1, $2, $3)")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT url FROM articles WHERE url IN ($1, $2, $3)\nUNION\nSELECT url FROM article_tombstones WHERE url IN ($1, $2, $3)")).
 		WithArgs("https://example.com/article1", "https://example.com/article2", "https://example.com/article3").
 		WillReturnRows(sqlmock.NewRows([]string{"url"}).
 			AddRow("https://example.com/article1").
@@ -330,14 +367,14 @@ func TestArticleRepo_GetWithSource_Success(t *testing.T) {
 	}
 	wantSourceName := "Tech News"
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, s.name AS source_name")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name")).
 		WithArgs(int64(1)).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 		}).AddRow(
 			want.ID, want.SourceID, want.Title, want.URL,
-			want.Summary, want.PublishedAt, want.CreatedAt, wantSourceName,
+			want.Summary, want.PublishedAt, want.CreatedAt, nil, "", "", "", "", "", wantSourceName,
 		))
 
 	repo := pg.NewArticleRepo(db)
@@ -364,7 +401,7 @@ func TestArticleRepo_GetWithSource_NotFound(t *testing.T) {
 		WithArgs(int64(999)).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 		}))
 
 	repo := pg.NewArticleRepo(db)
@@ -439,10 +476,10 @@ func TestArticleRepo_GetWithSource_JoinWithSourceName(t *testing.T) {
 				WithArgs(tt.articleID).
 				WillReturnRows(sqlmock.NewRows([]string{
 					"id", "source_id", "title", "url",
-					"summary", "published_at", "created_at", "source_name",
+					"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 				}).AddRow(
 					tt.articleID, int64(10), "Test Title", "https://example.com",
-					"Test Summary", now, now, tt.sourceName,
+					"Test Summary", now, now, nil, "", "", "", "", "", tt.sourceName,
 				))
 
 			repo := pg.NewArticleRepo(db)
@@ -468,10 +505,10 @@ func TestArticleRepo_SearchWithFilters_SingleKeyword(t *testing.T) {
 		WithArgs("%Go%").
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags",
 		}).AddRow(
 			int64(1), int64(2), "Go 1.24 released", "https://example.com",
-			"New Go version", now, now,
+			"New Go version", now, now, nil, "", "", "", "", "",
 		))
 
 	repo := pg.NewArticleRepo(db)
@@ -493,10 +530,10 @@ func TestArticleRepo_SearchWithFilters_MultipleKeywords(t *testing.T) {
 		WithArgs("%Go%", "%release%").
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags",
 		}).AddRow(
 			int64(1), int64(2), "Go 1.24 released", "https://example.com",
-			"New Go version", now, now,
+			"New Go version", now, now, nil, "", "", "", "", "",
 		))
 
 	repo := pg.NewArticleRepo(db)
@@ -519,10 +556,10 @@ func TestArticleRepo_SearchWithFilters_WithSourceID(t *testing.T) {
 		WithArgs("%Go%", sourceID).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags",
 		}).AddRow(
 			int64(1), sourceID, "Go 1.24 released", "https://example.com",
-			"New Go version", now, now,
+			"New Go version", now, now, nil, "", "", "", "", "",
 		))
 
 	repo := pg.NewArticleRepo(db)
@@ -547,10 +584,10 @@ func TestArticleRepo_SearchWithFilters_WithDateRange(t *testing.T) {
 		WithArgs("%Go%", from, to).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags",
 		}).AddRow(
 			int64(1), int64(2), "Go 1.24 released", "https://example.com",
-			"New Go version", now, now,
+			"New Go version", now, now, nil, "", "", "", "", "",
 		))
 
 	repo := pg.NewArticleRepo(db)
@@ -576,10 +613,10 @@ func TestArticleRepo_SearchWithFilters_AllFilters(t *testing.T) {
 		WithArgs("%Go%", "%release%", sourceID, from, to).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags",
 		}).AddRow(
 			int64(1), sourceID, "Go 1.24 released", "https://example.com",
-			"New Go version", now, now,
+			"New Go version", now, now, nil, "", "", "", "", "",
 		))
 
 	repo := pg.NewArticleRepo(db)
@@ -622,10 +659,10 @@ func TestArticleRepo_SearchWithFilters_SpecialCharacters(t *testing.T) {
 		WithArgs("%100\\%%", "%my\\_var%", "%path\\\\file%").
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags",
 		}).AddRow(
 			int64(1), int64(2), "100% complete", "https://example.com",
-			"my_var in path\\file", now, now,
+			"my_var in path\\file", now, now, nil, "", "", "", "", "",
 		))
 
 	repo := pg.NewArticleRepo(db)
@@ -653,10 +690,10 @@ func TestArticleRepo_ListWithSourcePaginated(t *testing.T) {
 		WithArgs(2, 0).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 		}).
-			AddRow(1, 10, "Article 1", "https://example.com/1", "Summary 1", now, now, "Test Source").
-			AddRow(2, 10, "Article 2", "https://example.com/2", "Summary 2", now, now, "Test Source"))
+			AddRow(1, 10, "Article 1", "https://example.com/1", "Summary 1", now, now, nil, "", "", "", "", "", "Test Source").
+			AddRow(2, 10, "Article 2", "https://example.com/2", "Summary 2", now, now, nil, "", "", "", "", "", "Test Source"))
 
 	repo := pg.NewArticleRepo(db)
 	result, err := repo.ListWithSourcePaginated(context.Background(), 0, 2)
@@ -693,10 +730,10 @@ func TestArticleRepo_ListWithSourcePaginated_SecondPage(t *testing.T) {
 		WithArgs(20, 20).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 		}).
-			AddRow(21, 10, "Article 21", "https://example.com/21", "Summary 21", now, now, "Test Source").
-			AddRow(22, 10, "Article 22", "https://example.com/22", "Summary 22", now, now, "Test Source"))
+			AddRow(21, 10, "Article 21", "https://example.com/21", "Summary 21", now, now, nil, "", "", "", "", "", "Test Source").
+			AddRow(22, 10, "Article 22", "https://example.com/22", "Summary 22", now, now, nil, "", "", "", "", "", "Test Source"))
 
 	repo := pg.NewArticleRepo(db)
 	result, err := repo.ListWithSourcePaginated(context.Background(), 20, 20)
@@ -728,7 +765,7 @@ func TestArticleRepo_ListWithSourcePaginated_EmptyResult(t *testing.T) {
 		WithArgs(20, 1000).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 		}))
 
 	repo := pg.NewArticleRepo(db)
@@ -757,7 +794,7 @@ func TestArticleRepo_ListWithSourcePaginated_LargeOffset(t *testing.T) {
 		WithArgs(10, 9900).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 		}))
 
 	repo := pg.NewArticleRepo(db)
@@ -837,7 +874,7 @@ func TestArticleRepo_Get_NotFound(t *testing.T) {
 		WithArgs(int64(999)).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags",
 		}))
 
 	repo := pg.NewArticleRepo(db)
@@ -876,7 +913,7 @@ func TestArticleRepo_Update_NotFound(t *testing.T) {
 	now := time.Now()
 	mock.ExpectExec("UPDATE articles").
 		WithArgs(int64(2), "new", "https://u",
-			"sum", now, int64(999)).
+			"sum", now, nil, "", "", "", "", "", int64(999)).
 		WillReturnResult(sqlmock.NewResult(0, 0))
 
 	repo := pg.NewArticleRepo(db)
@@ -896,7 +933,7 @@ func TestArticleRepo_Delete_NotFound(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer func() { _ = db.Close() }()
 
-	mock.ExpectExec("DELETE FROM articles").
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE articles SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL")).
 		WithArgs(int64(999)).
 		WillReturnResult(sqlmock.NewResult(0, 0))
 
@@ -918,8 +955,8 @@ func TestArticleRepo_List_ScanError(t *testing.T) {
 	mock.ExpectQuery("FROM articles").
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at",
-		}).AddRow("invalid", 2, "title", "url", "summary", time.Now(), time.Now()))
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags",
+		}).AddRow("invalid", 2, "title", "url", "summary", time.Now(), time.Now(), nil, "", "", "", "", ""))
 
 	repo := pg.NewArticleRepo(db)
 	got, err := repo.List(context.Background())
@@ -939,8 +976,8 @@ func TestArticleRepo_ListWithSource_ScanError(t *testing.T) {
 	mock.ExpectQuery("FROM articles").
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
-		}).AddRow("invalid", 2, "title", "url", "summary", time.Now(), time.Now(), "source"))
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
+		}).AddRow("invalid", 2, "title", "url", "summary", time.Now(), time.Now(), nil, "", "", "", "", "", "source"))
 
 	repo := pg.NewArticleRepo(db)
 	got, err := repo.ListWithSource(context.Background())
@@ -963,8 +1000,8 @@ func TestArticleRepo_ListWithSourcePaginated_ScanError(t *testing.T) {
 		WithArgs(10, 0).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
-		}).AddRow("invalid", 2, "title", "url", "summary", time.Now(), time.Now(), "source"))
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
+		}).AddRow("invalid", 2, "title", "url", "summary", time.Now(), time.Now(), nil, "", "", "", "", "", "source"))
 
 	repo := pg.NewArticleRepo(db)
 	got, err := repo.ListWithSourcePaginated(context.Background(), 0, 10)
@@ -1002,9 +1039,9 @@ func TestArticleRepo_Create_DatabaseError(t *testing.T) {
 
 	now := time.Now()
 	dbError := errors.New("unique constraint violation")
-	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO articles")).
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO articles")).
 		WithArgs(int64(2), "title", "https://u",
-			"summary", now, now).
+			"summary", now, now, nil, "", "", "", "", "").
 		WillReturnError(dbError)
 
 	repo := pg.NewArticleRepo(db)
@@ -1137,10 +1174,10 @@ func TestArticleRepo_SearchWithFiltersPaginated_WithKeywords(t *testing.T) {
 		WithArgs("%Go%", 10, 0).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 		}).AddRow(
 			int64(1), int64(2), "Go 1.24", "https://example.com",
-			"New version", now, now, "Tech News",
+			"New version", now, now, nil, "", "", "", "", "", "Tech News",
 		))
 
 	repo := pg.NewArticleRepo(db)
@@ -1165,8 +1202,8 @@ func TestArticleRepo_SearchWithFiltersPaginated_ScanError(t *testing.T) {
 		WithArgs("%Go%", 10, 0).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
-		}).AddRow("invalid", 2, "title", "url", "summary", time.Now(), time.Now(), "source"))
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
+		}).AddRow("invalid", 2, "title", "url", "summary", time.Now(), time.Now(), nil, "", "", "", "", "", "source"))
 
 	repo := pg.NewArticleRepo(db)
 	result, err := repo.SearchWithFiltersPaginated(context.Background(), []string{"Go"}, repository.ArticleSearchFilters{}, 0, 10)
@@ -1177,3 +1214,158 @@ func TestArticleRepo_SearchWithFiltersPaginated_ScanError(t *testing.T) {
 		t.Errorf("SearchWithFiltersPaginated should return nil on error, got=%v", result)
 	}
 }
+
+/* ─────────────────────────── Structured summary ─────────────────────────── */
+
+func TestArticleRepo_Create_WithStructuredSummary(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Now()
+	structured := &entity.StructuredSummary{
+		TLDR:               "tldr",
+		KeyPoints:          []string{"a", "b", "c"},
+		Tags:               []string{"go"},
+		ReadingTimeMinutes: 3,
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO articles")).
+		WithArgs(int64(2), "title", "https://u", "summary", now, now,
+			`{"tldr":"tldr","key_points":["a","b","c"],"tags":["go"],"reading_time_minutes":3}`, "default@0123abcd", "", "", "", "").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)))
+
+	repo := pg.NewArticleRepo(db)
+	err := repo.Create(context.Background(), &entity.Article{
+		SourceID: 2, Title: "title", URL: "https://u",
+		Summary: "summary", PublishedAt: now, CreatedAt: now,
+		Structured: structured, PromptVersion: "default@0123abcd",
+	})
+	if err != nil {
+		t.Fatalf("Create err=%v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArticleRepo_Get_StructuredSummary(t *testing.T) {
+	now := time.Date(2025, 7, 19, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		raw  interface{}
+		want *entity.StructuredSummary
+	}{
+		{
+			name: "valid json",
+			raw:  []byte(`{"tldr":"t","key_points":["a","b","c"],"tags":["go"],"reading_time_minutes":2}`),
+			want: &entity.StructuredSummary{
+				TLDR: "t", KeyPoints: []string{"a", "b", "c"}, Tags: []string{"go"}, ReadingTimeMinutes: 2,
+			},
+		},
+		{name: "null", raw: nil, want: nil},
+		{name: "malformed json falls back to plain summary", raw: []byte(`{not json`), want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, _ := sqlmock.New()
+			defer func() { _ = db.Close() }()
+
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT id")).
+				WithArgs(int64(1)).
+				WillReturnRows(sqlmock.NewRows([]string{
+					"id", "source_id", "title", "url",
+					"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags",
+				}).AddRow(int64(1), int64(2), "t", "https://u", "sum", now, now, tt.raw, "", "", "", "", ""))
+
+			repo := pg.NewArticleRepo(db)
+			got, err := repo.Get(context.Background(), 1)
+			if err != nil {
+				t.Fatalf("Get err=%v", err)
+			}
+			if got.Summary != "sum" {
+				t.Errorf("Summary = %q, want %q", got.Summary, "sum")
+			}
+			if diff := cmp.Diff(tt.want, got.Structured); diff != "" {
+				t.Errorf("structured mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestArticleRepo_SearchWithFiltersPaginated_TagsOnly(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Now()
+	mock.ExpectQuery(`WHERE a\.id IN \(SELECT atg\.article_id FROM article_tags atg`).
+		WithArgs("go", 10, 0).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "source_id", "title", "url",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
+		}).AddRow(
+			int64(1), int64(2), "Go 1.24", "https://example.com",
+			"New version", now, now, nil, "", "", "", "", "", "Tech News",
+		))
+
+	repo := pg.NewArticleRepo(db)
+	filters := repository.ArticleSearchFilters{Tags: []string{"go"}}
+	result, err := repo.SearchWithFiltersPaginated(context.Background(), nil, filters, 0, 10)
+	if err != nil {
+		t.Fatalf("SearchWithFiltersPaginated err=%v", err)
+	}
+	if len(result) != 1 {
+		t.Fatalf("SearchWithFiltersPaginated len=%d, want 1", len(result))
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestArticleRepo_InjectionFlags(t *testing.T) {
+	now := time.Date(2025, 7, 19, 0, 0, 0, 0, time.UTC)
+	flags := []string{entity.InjectionFlagInstructionOverride, entity.InjectionFlagUnknownURL}
+
+	t.Run("create stores flags comma-separated", func(t *testing.T) {
+		db, mock, _ := sqlmock.New()
+		defer func() { _ = db.Close() }()
+
+		mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO articles")).
+			WithArgs(int64(2), "title", "https://u", "summary", now, now, nil, "", "", "", "",
+				"instruction_override,unknown_url").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)))
+
+		repo := pg.NewArticleRepo(db)
+		err := repo.Create(context.Background(), &entity.Article{
+			SourceID: 2, Title: "title", URL: "https://u",
+			Summary: "summary", PublishedAt: now, CreatedAt: now, InjectionFlags: flags,
+		})
+		if err != nil {
+			t.Fatalf("Create err=%v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("get splits flags", func(t *testing.T) {
+		db, mock, _ := sqlmock.New()
+		defer func() { _ = db.Close() }()
+
+		want := &entity.Article{ID: 1, SourceID: 2, Title: "t", URL: "https://u", Summary: "s",
+			PublishedAt: now, CreatedAt: now, InjectionFlags: flags}
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT")).
+			WithArgs(int64(1)).
+			WillReturnRows(artRow(want))
+
+		repo := pg.NewArticleRepo(db)
+		got, err := repo.Get(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("Get err=%v", err)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Fatalf("Get mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/stdlib"
+
+	"catchup-feed/internal/repository"
+)
+
+// ArticleCreatedChannel is the LISTEN/NOTIFY channel on which new articles are
+// announced by ID once their summary is stored: by ArticleRepo.Create for articles
+// summarized before insert, and by ArticleNotifier for pending articles, which the
+// streams that moved past them then read by ID.
+const ArticleCreatedChannel = "article_created"
+
+type ArticleStreamRepo struct {
+	db           *sql.DB
+	queryBuilder *ArticleQueryBuilder
+}
+
+func NewArticleStreamRepo(db *sql.DB) repository.ArticleStreamRepository {
+	return &ArticleStreamRepo{
+		db:           db,
+		queryBuilder: NewArticleQueryBuilder(),
+	}
+}
+
+func (repo *ArticleStreamRepo) LatestArticleID(ctx context.Context) (int64, error) {
+	const query = `SELECT COALESCE(MAX(id), 0) FROM articles`
+	var id int64
+	if err := repo.db.QueryRowContext(ctx, query).Scan(&id); err != nil {
+		return 0, fmt.Errorf("LatestArticleID: %w", err)
+	}
+	return id, nil
+}
+
+func (repo *ArticleStreamRepo) ListArticlesAfter(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters, afterID int64, limit int) ([]repository.ArticleWithSource, error) {
+	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters, "a")
+	whereClause = andCondition(whereClause, "a.deleted_at IS NULL")
+	args = append(args, afterID)
+	whereClause = andCondition(whereClause, fmt.Sprintf("a.id > $%d", len(args)))
+	whereClause = andCondition(whereClause, "a.summary_status <> 'pending'")
+	args = append(args, limit)
+
+	// #nosec G201 -- whereClause is generated by QueryBuilder using numbered placeholders
+	query := fmt.Sprintf(`
+SELECT %s
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+%s
+ORDER BY a.id ASC
+LIMIT $%d`, articleWithSourceColumns, whereClause, len(args))
+
+	return queryWithSource(ctx, repo.db, "ListArticlesAfter", query, args, limit)
+}
+
+func (repo *ArticleStreamRepo) ListPendingArticleIDs(ctx context.Context, afterID int64) ([]int64, error) {
+	const query = `
+SELECT id FROM articles
+WHERE id > $1 AND summary_status = 'pending' AND deleted_at IS NULL
+ORDER BY id`
+	rows, err := repo.db.QueryContext(ctx, query, afterID)
+	if err != nil {
+		return nil, fmt.Errorf("ListPendingArticleIDs: %w", err)
+	}
+	return scanIDs(rows, "ListPendingArticleIDs")
+}
+
+func (repo *ArticleStreamRepo) ListSettledArticles(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters, ids []int64) ([]repository.ArticleWithSource, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters, "a")
+	whereClause = andCondition(whereClause, "a.deleted_at IS NULL AND a.summary_status <> 'pending'")
+	list, idArgs := idList(ids, len(args)+1)
+	whereClause = andCondition(whereClause, "a.id IN ("+list+")")
+	args = append(args, idArgs...)
+
+	// #nosec G201 -- whereClause is generated by QueryBuilder using numbered placeholders
+	query := fmt.Sprintf(`
+SELECT %s
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+%s
+ORDER BY a.id ASC`, articleWithSourceColumns, whereClause)
+
+	return queryWithSource(ctx, repo.db, "ListSettledArticles", query, args, len(ids))
+}
+
+// ArticleNotifier announces pending articles on ArticleCreatedChannel once their
+// summary is stored.
+type ArticleNotifier struct {
+	db *sql.DB
+}
+
+func NewArticleNotifier(db *sql.DB) *ArticleNotifier {
+	return &ArticleNotifier{db: db}
+}
+
+// NotifyArticleSummarized notifies ArticleCreatedChannel with the article ID.
+func (n *ArticleNotifier) NotifyArticleSummarized(ctx context.Context, articleID int64) error {
+	const query = `SELECT pg_notify('` + ArticleCreatedChannel + `', $1::text)`
+	if _, err := n.db.ExecContext(ctx, query, articleID); err != nil {
+		return fmt.Errorf("NotifyArticleSummarized: %w", err)
+	}
+	return nil
+}
+
+// ArticleListener receives the notifications on ArticleCreatedChannel from other
+// processes (the worker) through LISTEN on a dedicated connection.
+type ArticleListener struct {
+	db *sql.DB
+}
+
+func NewArticleListener(db *sql.DB) *ArticleListener {
+	return &ArticleListener{db: db}
+}
+
+// Listen calls notify for each article inserted, until ctx is done or the
+// connection fails. It holds one connection of the pool while listening.
+func (l *ArticleListener) Listen(ctx context.Context, notify func()) error {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("Listen: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	err = conn.Raw(func(driverConn any) error {
+		c, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return errors.New("not a pgx connection")
+		}
+		pgConn := c.Conn()
+		if _, err := pgConn.Exec(ctx, "LISTEN "+ArticleCreatedChannel); err != nil {
+			return err
+		}
+		for {
+			if _, err := pgConn.WaitForNotification(ctx); err != nil {
+				// キャンセル後の接続は pgx が閉じるため、LISTEN 状態のままプールに戻らない
+				return err
+			}
+			notify()
+		}
+	})
+	if ctx.Err() != nil {
+		return nil
+	}
+	return fmt.Errorf("Listen: %w", err)
+}
@@ -0,0 +1,122 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	pg "catchup-feed/internal/infra/adapter/persistence/postgres"
+	"catchup-feed/internal/repository"
+)
+
+func TestArticleStreamRepo_LatestArticleID(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(MAX(id), 0) FROM articles`)).
+		WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(int64(130)))
+
+	got, err := pg.NewArticleStreamRepo(db).LatestArticleID(context.Background())
+	if err != nil || got != 130 {
+		t.Fatalf("LatestArticleID = %d, %v; want 130", got, err)
+	}
+}
+
+func TestArticleStreamRepo_ListArticlesAfter(t *testing.T) {
+	tests := []struct {
+		name     string
+		keywords []string
+		filters  repository.ArticleSearchFilters
+		where    string
+		args     []driver.Value
+	}{
+		{
+			name: "no filters",
+			// 要約待ちの記事は飛ばす（要約後に ListSettledArticles で読む）
+			where: `WHERE a.deleted_at IS NULL AND a.id > $1 AND a.summary_status <> 'pending'
+ORDER BY a.id ASC
+LIMIT $2`,
+			args: []driver.Value{int64(120), 100},
+		},
+		{
+			name:     "keyword and tag",
+			keywords: []string{"go"},
+			filters:  repository.ArticleSearchFilters{Tags: []string{"go"}},
+			where:    `AND a.id > $3`,
+			args:     []driver.Value{"%go%", "go", int64(120), 100},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, _ := sqlmock.New()
+			defer func() { _ = db.Close() }()
+
+			now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+			mock.ExpectQuery(regexp.QuoteMeta(tt.where)).
+				WithArgs(tt.args...).
+				WillReturnRows(sqlmock.NewRows(articleWithSourceColumnNames).
+					AddRow(int64(121), int64(3), "Go 1.25", "https://go.dev/blog/go1.25", "summary", now, now, nil, "", "", "", "", "", "Go Blog").
+					AddRow(int64(122), int64(3), "Go 1.26", "https://go.dev/blog/go1.26", "summary", now, now, nil, "", "", "", "", "", "Go Blog"))
+
+			got, err := pg.NewArticleStreamRepo(db).ListArticlesAfter(context.Background(), tt.keywords, tt.filters, 120, 100)
+			if err != nil {
+				t.Fatalf("ListArticlesAfter err=%v", err)
+			}
+			if len(got) != 2 || got[0].Article.ID != 121 || got[1].SourceName != "Go Blog" {
+				t.Errorf("ListArticlesAfter = %+v", got)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestArticleStreamRepo_PendingAndSettledArticles(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+	repo := pg.NewArticleStreamRepo(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE id > $1 AND summary_status = 'pending' AND deleted_at IS NULL`)).
+		WithArgs(int64(120)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(122)).AddRow(int64(125)))
+	pending, err := repo.ListPendingArticleIDs(context.Background(), 120)
+	if err != nil || len(pending) != 2 || pending[0] != 122 {
+		t.Fatalf("ListPendingArticleIDs = %v, %v; want [122 125]", pending, err)
+	}
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE a.source_id = $1 AND a.deleted_at IS NULL AND a.summary_status <> 'pending' AND a.id IN ($2, $3)
+ORDER BY a.id ASC`)).
+		WithArgs(int64(3), int64(122), int64(125)).
+		WillReturnRows(sqlmock.NewRows(articleWithSourceColumnNames).
+			AddRow(int64(122), int64(3), "Go 1.25", "https://go.dev/blog/go1.25", "summary", now, now, nil, "", "", "", "", "", "Go Blog"))
+	sourceID := int64(3)
+	got, err := repo.ListSettledArticles(context.Background(), nil, repository.ArticleSearchFilters{SourceID: &sourceID}, pending)
+	if err != nil || len(got) != 1 || got[0].Article.ID != 122 {
+		t.Fatalf("ListSettledArticles = %+v, %v; want article 122", got, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArticleNotifier_NotifyArticleSummarized(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_notify('article_created', $1::text)`)).
+		WithArgs(int64(42)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := pg.NewArticleNotifier(db).NotifyArticleSummarized(context.Background(), 42); err != nil {
+		t.Fatalf("NotifyArticleSummarized err=%v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,311 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+// articleWithSourceColumns lists the article columns in articleRow.dest order,
+// followed by the source name.
+const articleWithSourceColumns = `a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name`
+
+type BookmarkRepo struct {
+	db *sql.DB
+}
+
+func NewBookmarkRepo(db *sql.DB) repository.BookmarkRepository {
+	return &BookmarkRepo{db: db}
+}
+
+func (repo *BookmarkRepo) AddBookmark(ctx context.Context, userID string, articleID int64) (bool, error) {
+	// 記事が存在しない場合は SELECT が0行になる。既存のブックマークは日時を変えない
+	const query = `
+INSERT INTO bookmarks (user_id, article_id)
+SELECT $1, id FROM articles WHERE id = $2 AND deleted_at IS NULL
+ON CONFLICT (user_id, article_id) DO NOTHING`
+	res, err := repo.db.ExecContext(ctx, query, userID, articleID)
+	if err != nil {
+		return false, fmt.Errorf("AddBookmark: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("AddBookmark: RowsAffected: %w", err)
+	}
+	if n > 0 {
+		return true, nil
+	}
+
+	// 0行は記事がないか、既にブックマーク済み
+	var exists bool
+	if err := repo.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM articles WHERE id = $1 AND deleted_at IS NULL)`, articleID).
+		Scan(&exists); err != nil {
+		return false, fmt.Errorf("AddBookmark: %w", err)
+	}
+	return exists, nil
+}
+
+func (repo *BookmarkRepo) RemoveBookmark(ctx context.Context, userID string, articleID int64) (bool, error) {
+	const query = `DELETE FROM bookmarks WHERE user_id = $1 AND article_id = $2`
+	res, err := repo.db.ExecContext(ctx, query, userID, articleID)
+	if err != nil {
+		return false, fmt.Errorf("RemoveBookmark: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("RemoveBookmark: RowsAffected: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (repo *BookmarkRepo) ListBookmarks(ctx context.Context, userID string, offset, limit int) ([]repository.BookmarkedArticle, error) {
+	const query = `
+SELECT ` + articleWithSourceColumns + `, b.created_at
+FROM bookmarks b
+INNER JOIN articles a ON a.id = b.article_id
+INNER JOIN sources s ON a.source_id = s.id
+WHERE b.user_id = $1 AND a.deleted_at IS NULL
+ORDER BY b.created_at DESC, a.id DESC
+LIMIT $2 OFFSET $3`
+	rows, err := repo.db.QueryContext(ctx, query, userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("ListBookmarks: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	result := make([]repository.BookmarkedArticle, 0, limit)
+	for rows.Next() {
+		var row articleRow
+		var b repository.BookmarkedArticle
+		if err := rows.Scan(row.dest(&b.SourceName, &b.BookmarkedAt)...); err != nil {
+			return nil, fmt.Errorf("ListBookmarks: Scan: %w", err)
+		}
+		b.Article = row.toEntity()
+		result = append(result, b)
+	}
+	return result, rows.Err()
+}
+
+func (repo *BookmarkRepo) CountBookmarks(ctx context.Context, userID string) (int64, error) {
+	const query = `SELECT COUNT(*) FROM bookmarks WHERE user_id = $1`
+	var count int64
+	if err := repo.db.QueryRowContext(ctx, query, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("CountBookmarks: %w", err)
+	}
+	return count, nil
+}
+
+type ReadingListRepo struct {
+	db *sql.DB
+}
+
+func NewReadingListRepo(db *sql.DB) repository.ReadingListRepository {
+	return &ReadingListRepo{db: db}
+}
+
+const readingListColumns = `l.id, l.user_id, l.name, l.description, COALESCE(l.share_token, ''), l.created_at, l.updated_at`
+
+// readingListDest returns the Scan destinations in readingListColumns order.
+func readingListDest(l *entity.ReadingList) []any {
+	return []any{&l.ID, &l.UserID, &l.Name, &l.Description, &l.ShareToken, &l.CreatedAt, &l.UpdatedAt}
+}
+
+func (repo *ReadingListRepo) ListLists(ctx context.Context, userID string) ([]*entity.ReadingList, error) {
+	const query = `
+SELECT ` + readingListColumns + `, (SELECT COUNT(*) FROM reading_list_items i WHERE i.list_id = l.id)
+FROM reading_lists l
+WHERE l.user_id = $1
+ORDER BY l.id`
+	rows, err := repo.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("ListLists: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var lists []*entity.ReadingList
+	for rows.Next() {
+		var l entity.ReadingList
+		if err := rows.Scan(append(readingListDest(&l), &l.ItemCount)...); err != nil {
+			return nil, fmt.Errorf("ListLists: Scan: %w", err)
+		}
+		lists = append(lists, &l)
+	}
+	return lists, rows.Err()
+}
+
+func (repo *ReadingListRepo) GetList(ctx context.Context, userID string, id int64) (*entity.ReadingList, error) {
+	const query = `SELECT ` + readingListColumns + ` FROM reading_lists l WHERE l.id = $1 AND l.user_id = $2`
+	return repo.getList(ctx, "GetList", query, id, userID)
+}
+
+func (repo *ReadingListRepo) GetListByShareToken(ctx context.Context, token string) (*entity.ReadingList, error) {
+	const query = `SELECT ` + readingListColumns + ` FROM reading_lists l WHERE l.share_token = $1`
+	return repo.getList(ctx, "GetListByShareToken", query, token)
+}
+
+// getList returns the single list selected by query, or nil if there is none.
+func (repo *ReadingListRepo) getList(ctx context.Context, op, query string, args ...any) (*entity.ReadingList, error) {
+	var l entity.ReadingList
+	err := repo.db.QueryRowContext(ctx, query, args...).Scan(readingListDest(&l)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &l, nil
+}
+
+func (repo *ReadingListRepo) CreateList(ctx context.Context, list *entity.ReadingList) error {
+	const query = `
+INSERT INTO reading_lists (user_id, name, description)
+VALUES ($1, $2, $3)
+RETURNING id, created_at, updated_at`
+	err := repo.db.QueryRowContext(ctx, query, list.UserID, list.Name, list.Description).
+		Scan(&list.ID, &list.CreatedAt, &list.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("CreateList: %w", err)
+	}
+	return nil
+}
+
+func (repo *ReadingListRepo) UpdateList(ctx context.Context, list *entity.ReadingList) (bool, error) {
+	const query = `
+UPDATE reading_lists SET name = $1, description = $2, updated_at = now()
+WHERE id = $3 AND user_id = $4
+RETURNING updated_at`
+	err := repo.db.QueryRowContext(ctx, query, list.Name, list.Description, list.ID, list.UserID).
+		Scan(&list.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("UpdateList: %w", err)
+	}
+	return true, nil
+}
+
+func (repo *ReadingListRepo) DeleteList(ctx context.Context, userID string, id int64) (bool, error) {
+	const query = `DELETE FROM reading_lists WHERE id = $1 AND user_id = $2`
+	res, err := repo.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return false, fmt.Errorf("DeleteList: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("DeleteList: RowsAffected: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (repo *ReadingListRepo) SetShareToken(ctx context.Context, userID string, id int64, token string) (bool, error) {
+	// 共有しない場合は NULL にする（UNIQUE 制約は NULL 同士を区別しない）
+	const query = `UPDATE reading_lists SET share_token = NULLIF($1, '') WHERE id = $2 AND user_id = $3`
+	res, err := repo.db.ExecContext(ctx, query, token, id, userID)
+	if err != nil {
+		return false, fmt.Errorf("SetShareToken: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("SetShareToken: RowsAffected: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (repo *ReadingListRepo) ListItems(ctx context.Context, listID int64) ([]repository.ReadingListEntry, error) {
+	const query = `
+SELECT ` + articleWithSourceColumns + `, i.list_id, i.position, i.note, i.added_at
+FROM reading_list_items i
+INNER JOIN articles a ON a.id = i.article_id
+INNER JOIN sources s ON a.source_id = s.id
+WHERE i.list_id = $1 AND a.deleted_at IS NULL
+ORDER BY i.position, i.added_at`
+	rows, err := repo.db.QueryContext(ctx, query, listID)
+	if err != nil {
+		return nil, fmt.Errorf("ListItems: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var entries []repository.ReadingListEntry
+	for rows.Next() {
+		var row articleRow
+		var e repository.ReadingListEntry
+		if err := rows.Scan(row.dest(&e.SourceName, &e.Item.ListID, &e.Item.Position, &e.Item.Note, &e.Item.AddedAt)...); err != nil {
+			return nil, fmt.Errorf("ListItems: Scan: %w", err)
+		}
+		e.Article = row.toEntity()
+		e.Item.ArticleID = e.Article.ID
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+func (repo *ReadingListRepo) AddItem(ctx context.Context, item *entity.ReadingListItem) (bool, error) {
+	// 記事が存在しない場合は SELECT が0行になり、RETURNING も0行になる
+	const query = `
+INSERT INTO reading_list_items (list_id, article_id, position, note)
+SELECT $1, a.id, COALESCE((SELECT MAX(position) FROM reading_list_items WHERE list_id = $1), 0) + 1, $3
+FROM articles a WHERE a.id = $2 AND a.deleted_at IS NULL
+ON CONFLICT (list_id, article_id) DO UPDATE SET note = EXCLUDED.note
+RETURNING position, added_at`
+	err := repo.db.QueryRowContext(ctx, query, item.ListID, item.ArticleID, item.Note).
+		Scan(&item.Position, &item.AddedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("AddItem: %w", err)
+	}
+	return true, nil
+}
+
+func (repo *ReadingListRepo) UpdateItemNote(ctx context.Context, listID, articleID int64, note string) (bool, error) {
+	const query = `UPDATE reading_list_items SET note = $1 WHERE list_id = $2 AND article_id = $3`
+	res, err := repo.db.ExecContext(ctx, query, note, listID, articleID)
+	if err != nil {
+		return false, fmt.Errorf("UpdateItemNote: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("UpdateItemNote: RowsAffected: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (repo *ReadingListRepo) RemoveItem(ctx context.Context, listID, articleID int64) (bool, error) {
+	const query = `DELETE FROM reading_list_items WHERE list_id = $1 AND article_id = $2`
+	res, err := repo.db.ExecContext(ctx, query, listID, articleID)
+	if err != nil {
+		return false, fmt.Errorf("RemoveItem: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("RemoveItem: RowsAffected: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (repo *ReadingListRepo) ReorderItems(ctx context.Context, listID int64, articleIDs []int64) error {
+	const query = `UPDATE reading_list_items SET position = $1 WHERE list_id = $2 AND article_id = $3`
+
+	// 並び順が途中の状態で見えないよう、1トランザクションで更新する
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("ReorderItems: BeginTx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for i, articleID := range articleIDs {
+		if _, err := tx.ExecContext(ctx, query, i+1, listID, articleID); err != nil {
+			return fmt.Errorf("ReorderItems: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("ReorderItems: Commit: %w", err)
+	}
+	return nil
+}
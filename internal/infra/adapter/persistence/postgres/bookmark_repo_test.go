@@ -0,0 +1,406 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+
+	"catchup-feed/internal/domain/entity"
+	pg "catchup-feed/internal/infra/adapter/persistence/postgres"
+)
+
+var articleWithSourceColumnNames = []string{
+	"id", "source_id", "title", "url",
+	"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
+}
+
+var readingListColumnNames = []string{"id", "user_id", "name", "description", "share_token", "created_at", "updated_at"}
+
+func TestBookmarkRepo_AddBookmark(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		exists   bool
+		want     bool
+	}{
+		{name: "bookmarked", affected: 1, want: true},
+		{name: "already bookmarked", affected: 0, exists: true, want: true},
+		{name: "article not found", affected: 0, exists: false, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, _ := sqlmock.New()
+			defer func() { _ = db.Close() }()
+
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO bookmarks (user_id, article_id)
+SELECT $1, id FROM articles WHERE id = $2 AND deleted_at IS NULL
+ON CONFLICT (user_id, article_id) DO NOTHING`)).
+				WithArgs("alice", int64(7)).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+			if tt.affected == 0 {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS (SELECT 1 FROM articles WHERE id = $1 AND deleted_at IS NULL)`)).
+					WithArgs(int64(7)).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(tt.exists))
+			}
+
+			got, err := pg.NewBookmarkRepo(db).AddBookmark(context.Background(), "alice", 7)
+			if err != nil {
+				t.Fatalf("AddBookmark err=%v", err)
+			}
+			if got != tt.want {
+				t.Errorf("AddBookmark = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestBookmarkRepo_RemoveBookmark(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM bookmarks WHERE user_id = $1 AND article_id = $2`)).
+		WithArgs("alice", int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	got, err := pg.NewBookmarkRepo(db).RemoveBookmark(context.Background(), "alice", 7)
+	if err != nil || !got {
+		t.Fatalf("RemoveBookmark = %v, %v", got, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBookmarkRepo_ListBookmarks(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	bookmarkedAt := now.Add(time.Hour)
+	mock.ExpectQuery(`(?s)FROM bookmarks b.*WHERE b.user_id = \$1 AND a.deleted_at IS NULL\s+ORDER BY b.created_at DESC, a.id DESC\s+LIMIT \$2 OFFSET \$3`).
+		WithArgs("alice", 20, 40).
+		WillReturnRows(sqlmock.NewRows(append(articleWithSourceColumnNames, "created_at")).
+			AddRow(2, 10, "Go 1.25", "https://example.com/2", "Summary", now, now, nil, "", "", "", "", "", "Go Blog", bookmarkedAt))
+
+	got, err := pg.NewBookmarkRepo(db).ListBookmarks(context.Background(), "alice", 40, 20)
+	if err != nil {
+		t.Fatalf("ListBookmarks err=%v", err)
+	}
+	if len(got) != 1 || got[0].Article.ID != 2 || got[0].SourceName != "Go Blog" || !got[0].BookmarkedAt.Equal(bookmarkedAt) {
+		t.Errorf("ListBookmarks = %+v", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBookmarkRepo_CountBookmarks_Error(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM bookmarks WHERE user_id = $1`)).
+		WillReturnError(errors.New("db down"))
+
+	_, err := pg.NewBookmarkRepo(db).CountBookmarks(context.Background(), "alice")
+	if err == nil || err.Error() != "CountBookmarks: db down" {
+		t.Fatalf("CountBookmarks err=%v", err)
+	}
+}
+
+func TestReadingListRepo_ListLists(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`(?s)FROM reading_lists l\s+WHERE l.user_id = \$1\s+ORDER BY l.id`).
+		WithArgs("alice").
+		WillReturnRows(sqlmock.NewRows(append(readingListColumnNames, "count")).
+			AddRow(1, "alice", "weekly", "", "", now, now, 3).
+			AddRow(2, "alice", "go", "Go の記事", "tok", now, now, 0))
+
+	got, err := pg.NewReadingListRepo(db).ListLists(context.Background(), "alice")
+	if err != nil {
+		t.Fatalf("ListLists err=%v", err)
+	}
+	want := []*entity.ReadingList{
+		{ID: 1, UserID: "alice", Name: "weekly", ItemCount: 3, CreatedAt: now, UpdatedAt: now},
+		{ID: 2, UserID: "alice", Name: "go", Description: "Go の記事", ShareToken: "tok", CreatedAt: now, UpdatedAt: now},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListLists mismatch (-want +got):\n%s", diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadingListRepo_GetList(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM reading_lists l WHERE l.id = $1 AND l.user_id = $2`)).
+		WithArgs(int64(1), "alice").
+		WillReturnRows(sqlmock.NewRows(readingListColumnNames).AddRow(1, "alice", "weekly", "", "", now, now))
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM reading_lists l WHERE l.id = $1 AND l.user_id = $2`)).
+		WithArgs(int64(1), "bob").
+		WillReturnRows(sqlmock.NewRows(readingListColumnNames))
+
+	repo := pg.NewReadingListRepo(db)
+	got, err := repo.GetList(context.Background(), "alice", 1)
+	if err != nil || got == nil || got.Name != "weekly" {
+		t.Fatalf("GetList = %+v, %v", got, err)
+	}
+	// 他のユーザーのリストは見つからない
+	got, err = repo.GetList(context.Background(), "bob", 1)
+	if err != nil || got != nil {
+		t.Fatalf("GetList (other user) = %+v, %v", got, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadingListRepo_GetListByShareToken(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM reading_lists l WHERE l.share_token = $1`)).
+		WithArgs("tok").
+		WillReturnRows(sqlmock.NewRows(readingListColumnNames).AddRow(1, "alice", "weekly", "", "tok", now, now))
+
+	got, err := pg.NewReadingListRepo(db).GetListByShareToken(context.Background(), "tok")
+	if err != nil || got == nil || got.ID != 1 || got.ShareToken != "tok" {
+		t.Fatalf("GetListByShareToken = %+v, %v", got, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadingListRepo_CreateList(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO reading_lists (user_id, name, description)
+VALUES ($1, $2, $3)
+RETURNING id, created_at, updated_at`)).
+		WithArgs("alice", "weekly", "週次で読む").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(5, now, now))
+
+	list := &entity.ReadingList{UserID: "alice", Name: "weekly", Description: "週次で読む"}
+	if err := pg.NewReadingListRepo(db).CreateList(context.Background(), list); err != nil {
+		t.Fatalf("CreateList err=%v", err)
+	}
+	if list.ID != 5 || !list.CreatedAt.Equal(now) || !list.UpdatedAt.Equal(now) {
+		t.Errorf("list = %+v", list)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadingListRepo_UpdateList_NotFound(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE reading_lists SET name = $1, description = $2, updated_at = now()
+WHERE id = $3 AND user_id = $4`)).
+		WithArgs("weekly", "", int64(5), "bob").
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}))
+
+	found, err := pg.NewReadingListRepo(db).UpdateList(context.Background(),
+		&entity.ReadingList{ID: 5, UserID: "bob", Name: "weekly"})
+	if err != nil || found {
+		t.Fatalf("UpdateList = %v, %v", found, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadingListRepo_SetShareToken(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE reading_lists SET share_token = NULLIF($1, '') WHERE id = $2 AND user_id = $3`)).
+		WithArgs("", int64(5), "alice").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	found, err := pg.NewReadingListRepo(db).SetShareToken(context.Background(), "alice", 5, "")
+	if err != nil || !found {
+		t.Fatalf("SetShareToken = %v, %v", found, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadingListRepo_DeleteList(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM reading_lists WHERE id = $1 AND user_id = $2`)).
+		WithArgs(int64(5), "alice").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	found, err := pg.NewReadingListRepo(db).DeleteList(context.Background(), "alice", 5)
+	if err != nil || found {
+		t.Fatalf("DeleteList = %v, %v", found, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadingListRepo_ListItems(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`(?s)FROM reading_list_items i.*WHERE i.list_id = \$1 AND a.deleted_at IS NULL\s+ORDER BY i.position, i.added_at`).
+		WithArgs(int64(5)).
+		WillReturnRows(sqlmock.NewRows(append(articleWithSourceColumnNames, "list_id", "position", "note", "added_at")).
+			AddRow(2, 10, "Go 1.25", "https://example.com/2", "Summary", now, now, nil, "", "", "", "", "", "Go Blog", 5, 1, "まず読む", now).
+			AddRow(1, 11, "Zenn", "https://example.com/1", "Summary", now, now, nil, "", "", "", "", "", "Zenn", 5, 2, "", now))
+
+	got, err := pg.NewReadingListRepo(db).ListItems(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("ListItems err=%v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("ListItems returned %d entries, want 2", len(got))
+	}
+	wantItem := entity.ReadingListItem{ListID: 5, ArticleID: 2, Position: 1, Note: "まず読む", AddedAt: now}
+	if diff := cmp.Diff(wantItem, got[0].Item); diff != "" {
+		t.Errorf("first item mismatch (-want +got):\n%s", diff)
+	}
+	if got[0].SourceName != "Go Blog" || got[1].Article.ID != 1 || got[1].Item.Position != 2 {
+		t.Errorf("ListItems = %+v", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadingListRepo_AddItem(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		rows  *sqlmock.Rows
+		want  bool
+		wantP int
+	}{
+		{name: "appended", rows: sqlmock.NewRows([]string{"position", "added_at"}).AddRow(4, now), want: true, wantP: 4},
+		{name: "article not found", rows: sqlmock.NewRows([]string{"position", "added_at"}), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, _ := sqlmock.New()
+			defer func() { _ = db.Close() }()
+
+			mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO reading_list_items (list_id, article_id, position, note)
+SELECT $1, a.id, COALESCE((SELECT MAX(position) FROM reading_list_items WHERE list_id = $1), 0) + 1, $3
+FROM articles a WHERE a.id = $2 AND a.deleted_at IS NULL
+ON CONFLICT (list_id, article_id) DO UPDATE SET note = EXCLUDED.note
+RETURNING position, added_at`)).
+				WithArgs(int64(5), int64(7), "あとで").
+				WillReturnRows(tt.rows)
+
+			item := &entity.ReadingListItem{ListID: 5, ArticleID: 7, Note: "あとで"}
+			got, err := pg.NewReadingListRepo(db).AddItem(context.Background(), item)
+			if err != nil {
+				t.Fatalf("AddItem err=%v", err)
+			}
+			if got != tt.want || item.Position != tt.wantP {
+				t.Errorf("AddItem = %v (position %d), want %v (position %d)", got, item.Position, tt.want, tt.wantP)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestReadingListRepo_UpdateItemNote(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE reading_list_items SET note = $1 WHERE list_id = $2 AND article_id = $3`)).
+		WithArgs("要約を共有", int64(5), int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	found, err := pg.NewReadingListRepo(db).UpdateItemNote(context.Background(), 5, 7, "要約を共有")
+	if err != nil || !found {
+		t.Fatalf("UpdateItemNote = %v, %v", found, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadingListRepo_RemoveItem(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM reading_list_items WHERE list_id = $1 AND article_id = $2`)).
+		WithArgs(int64(5), int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	found, err := pg.NewReadingListRepo(db).RemoveItem(context.Background(), 5, 7)
+	if err != nil || !found {
+		t.Fatalf("RemoveItem = %v, %v", found, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadingListRepo_ReorderItems(t *testing.T) {
+	const update = `UPDATE reading_list_items SET position = $1 WHERE list_id = $2 AND article_id = $3`
+
+	t.Run("committed", func(t *testing.T) {
+		db, mock, _ := sqlmock.New()
+		defer func() { _ = db.Close() }()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(update)).WithArgs(1, int64(5), int64(9)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(update)).WithArgs(2, int64(5), int64(7)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		if err := pg.NewReadingListRepo(db).ReorderItems(context.Background(), 5, []int64{9, 7}); err != nil {
+			t.Fatalf("ReorderItems err=%v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("rolled back on error", func(t *testing.T) {
+		db, mock, _ := sqlmock.New()
+		defer func() { _ = db.Close() }()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(update)).WithArgs(1, int64(5), int64(9)).WillReturnError(errors.New("db down"))
+		mock.ExpectRollback()
+
+		err := pg.NewReadingListRepo(db).ReorderItems(context.Background(), 5, []int64{9, 7})
+		if err == nil || err.Error() != "ReorderItems: db down" {
+			t.Fatalf("ReorderItems err=%v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,218 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"catchup-feed/internal/repository"
+)
+
+type BulkRepo struct {
+	db           *sql.DB
+	queryBuilder *ArticleQueryBuilder
+}
+
+func NewBulkRepo(db *sql.DB) repository.BulkRepository {
+	return &BulkRepo{
+		db:           db,
+		queryBuilder: NewArticleQueryBuilder(),
+	}
+}
+
+func (repo *BulkRepo) FindArticleIDs(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters, limit int) ([]int64, error) {
+	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters, "a")
+	whereClause = andCondition(whereClause, "a.deleted_at IS NULL")
+	args = append(args, limit)
+
+	// #nosec G201 -- whereClause is generated by QueryBuilder using numbered placeholders
+	query := fmt.Sprintf(`
+SELECT a.id
+FROM articles a
+%s
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT $%d`, whereClause, len(args))
+
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("FindArticleIDs: %w", err)
+	}
+	return scanIDs(rows, "FindArticleIDs")
+}
+
+func (repo *BulkRepo) DeleteArticles(ctx context.Context, ids []int64) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	in, args := idList(ids, 1)
+	// #nosec G202 -- in only contains numbered placeholders
+	query := `UPDATE articles SET deleted_at = now() WHERE id IN (` + in + `) AND deleted_at IS NULL RETURNING id`
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("DeleteArticles: %w", err)
+	}
+	return scanIDs(rows, "DeleteArticles")
+}
+
+func (repo *BulkRepo) MoveArticles(ctx context.Context, ids []int64, sourceID int64) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	in, args := idList(ids, 2)
+	// #nosec G202 -- in only contains numbered placeholders
+	query := `
+UPDATE articles SET source_id = $1
+WHERE id IN (` + in + `) AND deleted_at IS NULL
+  AND EXISTS (SELECT 1 FROM sources WHERE id = $1 AND deleted_at IS NULL)
+RETURNING id`
+	rows, err := repo.db.QueryContext(ctx, query, append([]interface{}{sourceID}, args...)...)
+	if err != nil {
+		return nil, fmt.Errorf("MoveArticles: %w", err)
+	}
+	return scanIDs(rows, "MoveArticles")
+}
+
+func (repo *BulkRepo) RetagArticles(ctx context.Context, ids []int64, add, remove []string, origin string) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("RetagArticles: BeginTx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// 対象の記事を行ロックし、以降の文は存在する記事だけに適用する
+	in, args := idList(ids, 1)
+	// #nosec G202 -- in only contains numbered placeholders
+	rows, err := tx.QueryContext(ctx, `SELECT id FROM articles WHERE id IN (`+in+`) AND deleted_at IS NULL ORDER BY id FOR UPDATE`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("RetagArticles: select articles: %w", err)
+	}
+	found, err := scanIDs(rows, "RetagArticles")
+	if err != nil || len(found) == 0 {
+		return found, err
+	}
+	in, args = idList(found, 1)
+
+	if len(add) > 0 {
+		_, nameArgs := nameList(add, 1)
+		// #nosec G202 -- only numbered placeholders are concatenated
+		insertTags := `INSERT INTO tags (name) VALUES (` + strings.Join(placeholders(1, len(add)), "), (") + `) ON CONFLICT (name) DO NOTHING`
+		if _, err := tx.ExecContext(ctx, insertTags, nameArgs...); err != nil {
+			return nil, fmt.Errorf("RetagArticles: insert tags: %w", err)
+		}
+		names, tagArgs := nameList(add, len(args)+2)
+		// #nosec G202 -- in and names only contain numbered placeholders
+		insertArticleTags := fmt.Sprintf(`
+INSERT INTO article_tags (article_id, tag_id, origin)
+SELECT a.id, t.id, $%d
+FROM articles a
+CROSS JOIN tags t
+WHERE a.id IN (%s) AND t.name IN (%s)
+ON CONFLICT (article_id, tag_id) DO NOTHING`, len(args)+1, in, names)
+		if _, err := tx.ExecContext(ctx, insertArticleTags, append(append(args, origin), tagArgs...)...); err != nil {
+			return nil, fmt.Errorf("RetagArticles: insert article tags: %w", err)
+		}
+	}
+	if len(remove) > 0 {
+		names, nameArgs := nameList(remove, len(args)+1)
+		// #nosec G202 -- in and names only contain numbered placeholders
+		deleteArticleTags := `
+DELETE FROM article_tags
+WHERE article_id IN (` + in + `)
+  AND tag_id IN (SELECT id FROM tags WHERE name IN (` + names + `))`
+		if _, err := tx.ExecContext(ctx, deleteArticleTags, append(args, nameArgs...)...); err != nil {
+			return nil, fmt.Errorf("RetagArticles: delete article tags: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("RetagArticles: Commit: %w", err)
+	}
+	return found, nil
+}
+
+func (repo *BulkRepo) SetSourcesActive(ctx context.Context, ids []int64, active bool) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	in, args := idList(ids, 2)
+	// #nosec G202 -- in only contains numbered placeholders
+	query := `UPDATE sources SET active = $1 WHERE id IN (` + in + `) AND deleted_at IS NULL RETURNING id`
+	rows, err := repo.db.QueryContext(ctx, query, append([]interface{}{active}, args...)...)
+	if err != nil {
+		return nil, fmt.Errorf("SetSourcesActive: %w", err)
+	}
+	return scanIDs(rows, "SetSourcesActive")
+}
+
+func (repo *BulkRepo) DeleteSources(ctx context.Context, ids []int64) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	// SourceRepo.Delete と同じく、記事にはソースと同じ deleted_at を付ける
+	in, args := idList(ids, 1)
+	// #nosec G202 -- in only contains numbered placeholders
+	query := `
+WITH trashed AS (
+UPDATE sources SET deleted_at = now() WHERE id IN (` + in + `) AND deleted_at IS NULL
+RETURNING id, deleted_at
+), trashed_articles AS (
+UPDATE articles a SET deleted_at = t.deleted_at
+FROM trashed t
+WHERE a.source_id = t.id AND a.deleted_at IS NULL
+)
+SELECT id FROM trashed`
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("DeleteSources: %w", err)
+	}
+	return scanIDs(rows, "DeleteSources")
+}
+
+// placeholders returns n numbered placeholders starting at $start.
+func placeholders(start, n int) []string {
+	p := make([]string, n)
+	for i := range p {
+		p[i] = fmt.Sprintf("$%d", start+i)
+	}
+	return p
+}
+
+// idList returns the placeholders ("$start, $start+1, ...") and the arguments of
+// an IN list of ids.
+func idList(ids []int64, start int) (string, []interface{}) {
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	return strings.Join(placeholders(start, len(ids)), ", "), args
+}
+
+// nameList is idList for tag names.
+func nameList(names []string, start int) (string, []interface{}) {
+	args := make([]interface{}, len(names))
+	for i, name := range names {
+		args[i] = name
+	}
+	return strings.Join(placeholders(start, len(names)), ", "), args
+}
+
+// scanIDs reads and closes rows of a single ID column.
+func scanIDs(rows *sql.Rows, op string) ([]int64, error) {
+	defer func() { _ = rows.Close() }()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("%s: Scan: %w", op, err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows.Err: %w", op, err)
+	}
+	return ids, nil
+}
@@ -0,0 +1,180 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	pg "catchup-feed/internal/infra/adapter/persistence/postgres"
+	"catchup-feed/internal/repository"
+)
+
+func TestBulkRepo_FindArticleIDs(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	sourceID := int64(3)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT a.id
+FROM articles a
+WHERE (a.title ILIKE $1 OR a.summary ILIKE $1) AND a.source_id = $2 AND a.deleted_at IS NULL
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT $3`)).
+		WithArgs("%spam%", sourceID, 501).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(9)).AddRow(int64(7)))
+
+	got, err := pg.NewBulkRepo(db).FindArticleIDs(context.Background(), []string{"spam"},
+		repository.ArticleSearchFilters{SourceID: &sourceID}, 501)
+	if err != nil {
+		t.Fatalf("FindArticleIDs err=%v", err)
+	}
+	if !reflect.DeepEqual(got, []int64{9, 7}) {
+		t.Errorf("FindArticleIDs = %v, want [9 7]", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBulkRepo_DeleteArticles(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE articles SET deleted_at = now() WHERE id IN ($1, $2, $3) AND deleted_at IS NULL RETURNING id`)).
+		WithArgs(int64(1), int64(2), int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)).AddRow(int64(3)))
+
+	got, err := pg.NewBulkRepo(db).DeleteArticles(context.Background(), []int64{1, 2, 3})
+	if err != nil {
+		t.Fatalf("DeleteArticles err=%v", err)
+	}
+	if !reflect.DeepEqual(got, []int64{1, 3}) {
+		t.Errorf("DeleteArticles = %v, want [1 3]", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBulkRepo_MoveArticles(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE articles SET source_id = $1
+WHERE id IN ($2, $3) AND deleted_at IS NULL
+  AND EXISTS (SELECT 1 FROM sources WHERE id = $1 AND deleted_at IS NULL)
+RETURNING id`)).
+		WithArgs(int64(5), int64(1), int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)).AddRow(int64(2)))
+
+	got, err := pg.NewBulkRepo(db).MoveArticles(context.Background(), []int64{1, 2}, 5)
+	if err != nil || len(got) != 2 {
+		t.Fatalf("MoveArticles = %v, %v", got, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBulkRepo_RetagArticles(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM articles WHERE id IN ($1, $2, $3) AND deleted_at IS NULL ORDER BY id FOR UPDATE`)).
+		WithArgs(int64(1), int64(2), int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)).AddRow(int64(2)))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO tags (name) VALUES ($1), ($2) ON CONFLICT (name) DO NOTHING`)).
+		WithArgs("go", "release").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT a.id, t.id, $3
+FROM articles a
+CROSS JOIN tags t
+WHERE a.id IN ($1, $2) AND t.name IN ($4, $5)`)).
+		WithArgs(int64(1), int64(2), "manual", "go", "release").
+		WillReturnResult(sqlmock.NewResult(0, 4))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM article_tags
+WHERE article_id IN ($1, $2)
+  AND tag_id IN (SELECT id FROM tags WHERE name IN ($3))`)).
+		WithArgs(int64(1), int64(2), "spam").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	got, err := pg.NewBulkRepo(db).RetagArticles(context.Background(), []int64{1, 2, 3}, []string{"go", "release"}, []string{"spam"}, "manual")
+	if err != nil {
+		t.Fatalf("RetagArticles err=%v", err)
+	}
+	if !reflect.DeepEqual(got, []int64{1, 2}) {
+		t.Errorf("RetagArticles = %v, want [1 2]", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBulkRepo_RetagArticles_RollsBackOnError(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM articles`).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)))
+	mock.ExpectExec(`INSERT INTO tags`).
+		WithArgs("go").
+		WillReturnError(errors.New("disk full"))
+	mock.ExpectRollback()
+
+	if _, err := pg.NewBulkRepo(db).RetagArticles(context.Background(), []int64{1}, []string{"go"}, nil, "manual"); err == nil {
+		t.Fatal("RetagArticles should return error")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBulkRepo_SetSourcesActive(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE sources SET active = $1 WHERE id IN ($2, $3) AND deleted_at IS NULL RETURNING id`)).
+		WithArgs(false, int64(4), int64(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(4)))
+
+	got, err := pg.NewBulkRepo(db).SetSourcesActive(context.Background(), []int64{4, 5}, false)
+	if err != nil {
+		t.Fatalf("SetSourcesActive err=%v", err)
+	}
+	if !reflect.DeepEqual(got, []int64{4}) {
+		t.Errorf("SetSourcesActive = %v, want [4]", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBulkRepo_DeleteSources(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE sources SET deleted_at = now() WHERE id IN ($1, $2) AND deleted_at IS NULL
+RETURNING id, deleted_at
+), trashed_articles AS (
+UPDATE articles a SET deleted_at = t.deleted_at`)).
+		WithArgs(int64(4), int64(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(4)).AddRow(int64(5)))
+
+	got, err := pg.NewBulkRepo(db).DeleteSources(context.Background(), []int64{4, 5})
+	if err != nil {
+		t.Fatalf("DeleteSources err=%v", err)
+	}
+	if !reflect.DeepEqual(got, []int64{4, 5}) {
+		t.Errorf("DeleteSources = %v, want [4 5]", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
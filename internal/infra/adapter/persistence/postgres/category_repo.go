@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+type CategoryRepo struct {
+	db *sql.DB
+}
+
+func NewCategoryRepo(db *sql.DB) repository.CategoryRepository {
+	return &CategoryRepo{db: db}
+}
+
+func (repo *CategoryRepo) ListCategories(ctx context.Context) ([]repository.CategoryCount, error) {
+	const query = `
+SELECT c.id, c.name, c.created_at, COUNT(s.id)
+FROM source_categories c
+LEFT JOIN source_category_members m ON m.category_id = c.id
+LEFT JOIN sources s ON s.id = m.source_id AND s.deleted_at IS NULL
+GROUP BY c.id
+ORDER BY c.name`
+	rows, err := repo.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("ListCategories: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var counts []repository.CategoryCount
+	for rows.Next() {
+		var c entity.Category
+		var n int64
+		if err := rows.Scan(&c.ID, &c.Name, &c.CreatedAt, &n); err != nil {
+			return nil, fmt.Errorf("ListCategories: Scan: %w", err)
+		}
+		counts = append(counts, repository.CategoryCount{Category: &c, SourceCount: n})
+	}
+	return counts, rows.Err()
+}
+
+func (repo *CategoryRepo) GetCategory(ctx context.Context, id int64) (*entity.Category, error) {
+	const query = `SELECT id, name, created_at FROM source_categories WHERE id = $1`
+	return repo.getCategory(ctx, "GetCategory", query, id)
+}
+
+func (repo *CategoryRepo) GetCategoryByName(ctx context.Context, name string) (*entity.Category, error) {
+	const query = `SELECT id, name, created_at FROM source_categories WHERE name = $1`
+	return repo.getCategory(ctx, "GetCategoryByName", query, name)
+}
+
+// getCategory returns the category selected by query, or nil if none.
+func (repo *CategoryRepo) getCategory(ctx context.Context, op, query string, arg any) (*entity.Category, error) {
+	var c entity.Category
+	err := repo.db.QueryRowContext(ctx, query, arg).Scan(&c.ID, &c.Name, &c.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &c, nil
+}
+
+func (repo *CategoryRepo) CreateCategory(ctx context.Context, category *entity.Category) error {
+	const query = `INSERT INTO source_categories (name) VALUES ($1) RETURNING id, created_at`
+	if err := repo.db.QueryRowContext(ctx, query, category.Name).Scan(&category.ID, &category.CreatedAt); err != nil {
+		return fmt.Errorf("CreateCategory: %w", err)
+	}
+	return nil
+}
+
+func (repo *CategoryRepo) RenameCategory(ctx context.Context, id int64, name string) (bool, error) {
+	const query = `UPDATE source_categories SET name = $1 WHERE id = $2`
+	res, err := repo.db.ExecContext(ctx, query, name, id)
+	if err != nil {
+		return false, fmt.Errorf("RenameCategory: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("RenameCategory: RowsAffected: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (repo *CategoryRepo) DeleteCategory(ctx context.Context, id int64) (bool, error) {
+	// 割り当ては ON DELETE CASCADE で削除される
+	const query = `DELETE FROM source_categories WHERE id = $1`
+	res, err := repo.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return false, fmt.Errorf("DeleteCategory: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("DeleteCategory: RowsAffected: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (repo *CategoryRepo) ListSourceCategories(ctx context.Context, sourceID int64) ([]*entity.Category, error) {
+	const query = `
+SELECT c.id, c.name, c.created_at
+FROM source_categories c
+INNER JOIN source_category_members m ON m.category_id = c.id
+WHERE m.source_id = $1
+ORDER BY c.name`
+	rows, err := repo.db.QueryContext(ctx, query, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("ListSourceCategories: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var categories []*entity.Category
+	for rows.Next() {
+		var c entity.Category
+		if err := rows.Scan(&c.ID, &c.Name, &c.CreatedAt); err != nil {
+			return nil, fmt.Errorf("ListSourceCategories: Scan: %w", err)
+		}
+		categories = append(categories, &c)
+	}
+	return categories, rows.Err()
+}
+
+func (repo *CategoryRepo) SetSourceCategories(ctx context.Context, sourceID int64, categoryIDs []int64) error {
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("SetSourceCategories: BeginTx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM source_category_members WHERE source_id = $1`, sourceID); err != nil {
+		return fmt.Errorf("SetSourceCategories: delete: %w", err)
+	}
+	const insert = `
+INSERT INTO source_category_members (source_id, category_id) VALUES ($1, $2)
+ON CONFLICT (source_id, category_id) DO NOTHING`
+	for _, id := range categoryIDs {
+		if _, err := tx.ExecContext(ctx, insert, sourceID, id); err != nil {
+			return fmt.Errorf("SetSourceCategories: insert: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("SetSourceCategories: Commit: %w", err)
+	}
+	return nil
+}
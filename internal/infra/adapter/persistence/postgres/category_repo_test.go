@@ -0,0 +1,178 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+
+	"catchup-feed/internal/domain/entity"
+	pg "catchup-feed/internal/infra/adapter/persistence/postgres"
+	"catchup-feed/internal/repository"
+)
+
+func TestCategoryRepo_ListCategories(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`LEFT JOIN sources s ON s.id = m.source_id AND s.deleted_at IS NULL`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "created_at", "count"}).
+			AddRow(int64(2), "Go", now, int64(12)).
+			AddRow(int64(1), "Security", now, int64(0)))
+
+	got, err := pg.NewCategoryRepo(db).ListCategories(context.Background())
+	if err != nil {
+		t.Fatalf("ListCategories err=%v", err)
+	}
+	want := []repository.CategoryCount{
+		{Category: &entity.Category{ID: 2, Name: "Go", CreatedAt: now}, SourceCount: 12},
+		{Category: &entity.Category{ID: 1, Name: "Security", CreatedAt: now}, SourceCount: 0},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListCategories mismatch (-want +got):\n%s", diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCategoryRepo_GetCategory_NotFound(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM source_categories WHERE id = $1`)).
+		WithArgs(int64(9)).
+		WillReturnError(sql.ErrNoRows)
+
+	got, err := pg.NewCategoryRepo(db).GetCategory(context.Background(), 9)
+	if err != nil || got != nil {
+		t.Fatalf("GetCategory = %v, %v; want nil, nil", got, err)
+	}
+}
+
+func TestCategoryRepo_GetCategoryByName(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM source_categories WHERE name = $1`)).
+		WithArgs("Go").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "created_at"}).AddRow(int64(2), "Go", now))
+
+	got, err := pg.NewCategoryRepo(db).GetCategoryByName(context.Background(), "Go")
+	if err != nil {
+		t.Fatalf("GetCategoryByName err=%v", err)
+	}
+	if diff := cmp.Diff(&entity.Category{ID: 2, Name: "Go", CreatedAt: now}, got); diff != "" {
+		t.Errorf("GetCategoryByName mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestCategoryRepo_CreateCategory(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO source_categories (name) VALUES ($1) RETURNING id, created_at`)).
+		WithArgs("Go").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(int64(3), now))
+
+	c := &entity.Category{Name: "Go"}
+	if err := pg.NewCategoryRepo(db).CreateCategory(context.Background(), c); err != nil {
+		t.Fatalf("CreateCategory err=%v", err)
+	}
+	if c.ID != 3 || !c.CreatedAt.Equal(now) {
+		t.Errorf("category = %+v, want ID and CreatedAt set", c)
+	}
+}
+
+func TestCategoryRepo_RenameAndDelete(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE source_categories SET name = $1 WHERE id = $2`)).
+		WithArgs("Golang", int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM source_categories WHERE id = $1`)).
+		WithArgs(int64(9)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := pg.NewCategoryRepo(db)
+	if ok, err := repo.RenameCategory(context.Background(), 2, "Golang"); err != nil || !ok {
+		t.Errorf("RenameCategory = %v, %v; want true, nil", ok, err)
+	}
+	if ok, err := repo.DeleteCategory(context.Background(), 9); err != nil || ok {
+		t.Errorf("DeleteCategory = %v, %v; want false, nil", ok, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCategoryRepo_ListSourceCategories(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE m.source_id = $1`)).
+		WithArgs(int64(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "created_at"}).AddRow(int64(2), "Go", now))
+
+	got, err := pg.NewCategoryRepo(db).ListSourceCategories(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("ListSourceCategories err=%v", err)
+	}
+	if len(got) != 1 || got[0].Name != "Go" {
+		t.Errorf("ListSourceCategories = %+v", got)
+	}
+}
+
+func TestCategoryRepo_SetSourceCategories(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM source_category_members WHERE source_id = $1`)).
+		WithArgs(int64(5)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	for _, id := range []int64{1, 2} {
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO source_category_members (source_id, category_id) VALUES ($1, $2)`)).
+			WithArgs(int64(5), id).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectCommit()
+
+	if err := pg.NewCategoryRepo(db).SetSourceCategories(context.Background(), 5, []int64{1, 2}); err != nil {
+		t.Fatalf("SetSourceCategories err=%v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCategoryRepo_SetSourceCategories_Rollback(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM source_category_members`)).
+		WithArgs(int64(5)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO source_category_members`)).
+		WithArgs(int64(5), int64(1)).
+		WillReturnError(errors.New("db down"))
+	mock.ExpectRollback()
+
+	if err := pg.NewCategoryRepo(db).SetSourceCategories(context.Background(), 5, []int64{1}); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/pkg/vector"
+	"catchup-feed/internal/repository"
+)
+
+type EmbeddingRepo struct {
+	db *sql.DB
+}
+
+func NewEmbeddingRepo(db *sql.DB) repository.EmbeddingRepository {
+	return &EmbeddingRepo{db: db}
+}
+
+func (repo *EmbeddingRepo) SaveEmbedding(ctx context.Context, emb repository.ArticleEmbedding) error {
+	const query = `
+INSERT INTO article_embeddings (article_id, model, vector, updated_at)
+VALUES ($1, $2, $3, now())
+ON CONFLICT (article_id) DO UPDATE SET model = EXCLUDED.model, vector = EXCLUDED.vector, updated_at = EXCLUDED.updated_at`
+	if _, err := repo.db.ExecContext(ctx, query, emb.ArticleID, emb.Model, vector.Encode(emb.Vector)); err != nil {
+		return fmt.Errorf("SaveEmbedding: %w", err)
+	}
+	return nil
+}
+
+func (repo *EmbeddingRepo) ListEmbeddings(ctx context.Context, model string) ([]repository.ArticleEmbedding, error) {
+	const query = `SELECT article_id, vector FROM article_embeddings WHERE model = $1 ORDER BY article_id`
+	rows, err := repo.db.QueryContext(ctx, query, model)
+	if err != nil {
+		return nil, fmt.Errorf("ListEmbeddings: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var embs []repository.ArticleEmbedding
+	for rows.Next() {
+		emb := repository.ArticleEmbedding{Model: model}
+		var raw []byte
+		if err := rows.Scan(&emb.ArticleID, &raw); err != nil {
+			return nil, fmt.Errorf("ListEmbeddings: Scan: %w", err)
+		}
+		if emb.Vector, err = vector.Decode(raw); err != nil {
+			return nil, fmt.Errorf("ListEmbeddings: article %d: %w", emb.ArticleID, err)
+		}
+		embs = append(embs, emb)
+	}
+	return embs, rows.Err()
+}
+
+func (repo *EmbeddingRepo) ListArticlesWithoutEmbedding(ctx context.Context, model string, limit int) ([]*entity.Article, error) {
+	const query = `
+SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags
+FROM articles a
+LEFT JOIN article_embeddings e ON e.article_id = a.id AND e.model = $1
+WHERE e.article_id IS NULL AND a.summary_status = '' AND a.deleted_at IS NULL
+ORDER BY a.published_at DESC
+LIMIT $2`
+	rows, err := repo.db.QueryContext(ctx, query, model, limit)
+	if err != nil {
+		return nil, fmt.Errorf("ListArticlesWithoutEmbedding: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var articles []*entity.Article
+	for rows.Next() {
+		var row articleRow
+		if err := rows.Scan(row.dest()...); err != nil {
+			return nil, fmt.Errorf("ListArticlesWithoutEmbedding: Scan: %w", err)
+		}
+		articles = append(articles, row.toEntity())
+	}
+	return articles, rows.Err()
+}
+
+func (repo *EmbeddingRepo) ListWithSourceByIDs(ctx context.Context, ids []int64) ([]repository.ArticleWithSource, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = id
+	}
+
+	// #nosec G201 -- placeholders are programmatically generated ($1, $2, etc.), not from user input
+	query := fmt.Sprintf(`
+SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+WHERE a.id IN (%s) AND a.deleted_at IS NULL`, strings.Join(placeholders, ", "))
+
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ListWithSourceByIDs: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	result := make([]repository.ArticleWithSource, 0, len(ids))
+	for rows.Next() {
+		var row articleRow
+		var sourceName string
+		if err := rows.Scan(row.dest(&sourceName)...); err != nil {
+			return nil, fmt.Errorf("ListWithSourceByIDs: Scan: %w", err)
+		}
+		result = append(result, repository.ArticleWithSource{
+			Article:    row.toEntity(),
+			SourceName: sourceName,
+		})
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,84 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"catchup-feed/internal/domain/entity"
+	pg "catchup-feed/internal/infra/adapter/persistence/postgres"
+)
+
+func TestFeedTokenRepo_GetFeedTokenByToken(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("found", func(t *testing.T) {
+		db, mock, _ := sqlmock.New()
+		defer func() { _ = db.Close() }()
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT user_id, token, created_at FROM feed_tokens WHERE token = $1`)).
+			WithArgs("tok").
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "token", "created_at"}).AddRow("alice", "tok", now))
+
+		got, err := pg.NewFeedTokenRepo(db).GetFeedTokenByToken(context.Background(), "tok")
+		if err != nil {
+			t.Fatalf("GetFeedTokenByToken err=%v", err)
+		}
+		if got == nil || got.UserID != "alice" || !got.CreatedAt.Equal(now) {
+			t.Errorf("GetFeedTokenByToken = %+v", got)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, _ := sqlmock.New()
+		defer func() { _ = db.Close() }()
+
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM feed_tokens WHERE token = $1`)).
+			WithArgs("nope").
+			WillReturnError(sql.ErrNoRows)
+
+		got, err := pg.NewFeedTokenRepo(db).GetFeedTokenByToken(context.Background(), "nope")
+		if err != nil || got != nil {
+			t.Fatalf("GetFeedTokenByToken = %v, %v; want nil, nil", got, err)
+		}
+	})
+}
+
+func TestFeedTokenRepo_SetFeedToken(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`ON CONFLICT (user_id) DO UPDATE SET token = EXCLUDED.token`)).
+		WithArgs("alice", "tok").
+		WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+
+	token := &entity.FeedToken{UserID: "alice", Token: "tok"}
+	if err := pg.NewFeedTokenRepo(db).SetFeedToken(context.Background(), token); err != nil {
+		t.Fatalf("SetFeedToken err=%v", err)
+	}
+	if !token.CreatedAt.Equal(now) {
+		t.Errorf("CreatedAt = %v, want %v", token.CreatedAt, now)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFeedTokenRepo_DeleteFeedToken(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM feed_tokens WHERE user_id = $1`)).
+		WithArgs("alice").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	deleted, err := pg.NewFeedTokenRepo(db).DeleteFeedToken(context.Background(), "alice")
+	if err != nil || deleted {
+		t.Fatalf("DeleteFeedToken = %v, %v; want false, nil", deleted, err)
+	}
+}
@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"catchup-feed/internal/repository"
+)
+
+type ReadStateRepo struct {
+	db           *sql.DB
+	queryBuilder *ArticleQueryBuilder
+}
+
+func NewReadStateRepo(db *sql.DB) repository.ReadStateRepository {
+	return &ReadStateRepo{
+		db:           db,
+		queryBuilder: NewArticleQueryBuilder(),
+	}
+}
+
+func (repo *ReadStateRepo) MarkRead(ctx context.Context, userID string, articleID int64) (bool, error) {
+	// 記事が存在しない場合は SELECT が0行になり、何も挿入されない
+	const query = `
+INSERT INTO article_reads (user_id, article_id)
+SELECT $1, id FROM articles WHERE id = $2 AND deleted_at IS NULL
+ON CONFLICT (user_id, article_id) DO UPDATE SET read_at = EXCLUDED.read_at`
+	res, err := repo.db.ExecContext(ctx, query, userID, articleID)
+	if err != nil {
+		return false, fmt.Errorf("MarkRead: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("MarkRead: RowsAffected: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (repo *ReadStateRepo) MarkUnread(ctx context.Context, userID string, articleID int64) error {
+	const query = `DELETE FROM article_reads WHERE user_id = $1 AND article_id = $2`
+	if _, err := repo.db.ExecContext(ctx, query, userID, articleID); err != nil {
+		return fmt.Errorf("MarkUnread: %w", err)
+	}
+	return nil
+}
+
+func (repo *ReadStateRepo) MarkAllRead(ctx context.Context, userID string, filter repository.MarkReadFilter) (int64, error) {
+	args := []interface{}{userID}
+	conditions := []string{"a.deleted_at IS NULL"}
+	if filter.Before != nil {
+		args = append(args, *filter.Before)
+		conditions = append(conditions, fmt.Sprintf("a.published_at <= $%d", len(args)))
+	}
+	if filter.SourceID != nil {
+		args = append(args, *filter.SourceID)
+		conditions = append(conditions, fmt.Sprintf("a.source_id = $%d", len(args)))
+	}
+	whereClause := "WHERE " + strings.Join(conditions, " AND ")
+
+	// #nosec G201 -- whereClause only contains numbered placeholders
+	query := fmt.Sprintf(`
+INSERT INTO article_reads (user_id, article_id)
+SELECT $1, a.id FROM articles a
+%s
+ON CONFLICT (user_id, article_id) DO NOTHING`, whereClause)
+	res, err := repo.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("MarkAllRead: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("MarkAllRead: RowsAffected: %w", err)
+	}
+	return n, nil
+}
+
+func (repo *ReadStateRepo) ListUnread(ctx context.Context, userID string, filters repository.ArticleSearchFilters, offset, limit int) ([]repository.ArticleWithSource, error) {
+	whereClause, args := repo.unreadWhereClause(userID, filters)
+	args = append(args, limit, offset)
+
+	// #nosec G201 -- whereClause is generated by QueryBuilder using numbered placeholders
+	query := fmt.Sprintf(`
+SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+%s
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT $%d OFFSET $%d`, whereClause, len(args)-1, len(args))
+
+	return queryWithSource(ctx, repo.db, "ListUnread", query, args, limit)
+}
+
+func (repo *ReadStateRepo) CountUnread(ctx context.Context, userID string, filters repository.ArticleSearchFilters) (int64, error) {
+	whereClause, args := repo.unreadWhereClause(userID, filters)
+	query := "SELECT COUNT(*) FROM articles a " + whereClause
+
+	var count int64
+	if err := repo.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("CountUnread: %w", err)
+	}
+	return count, nil
+}
+
+func (repo *ReadStateRepo) UnreadCountsBySource(ctx context.Context, userID string) ([]repository.SourceUnreadCount, error) {
+	const query = `
+SELECT s.id, s.name, COUNT(*) AS unread_count
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+WHERE a.deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM article_reads r WHERE r.user_id = $1 AND r.article_id = a.id)
+GROUP BY s.id, s.name
+ORDER BY unread_count DESC, s.name`
+	rows, err := repo.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("UnreadCountsBySource: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var counts []repository.SourceUnreadCount
+	for rows.Next() {
+		var c repository.SourceUnreadCount
+		if err := rows.Scan(&c.SourceID, &c.SourceName, &c.Count); err != nil {
+			return nil, fmt.Errorf("UnreadCountsBySource: Scan: %w", err)
+		}
+		counts = append(counts, c)
+	}
+	return counts, rows.Err()
+}
+
+func (repo *ReadStateRepo) ListUnreadPerSource(ctx context.Context, userID string, perSource int) ([]repository.ArticleWithSource, error) {
+	// ソースごとに新しい順の連番を振り、先頭 perSource 件だけを返す
+	const query = `
+SELECT id, source_id, title, url, summary, published_at, created_at, summary_structured, prompt_version, summary_status, summary_batch_id, summary_model, injection_flags, source_name
+FROM (
+    SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name,
+           ROW_NUMBER() OVER (PARTITION BY a.source_id ORDER BY a.published_at DESC, a.id DESC) AS source_rank
+    FROM articles a
+    INNER JOIN sources s ON a.source_id = s.id
+    WHERE a.deleted_at IS NULL
+      AND NOT EXISTS (SELECT 1 FROM article_reads r WHERE r.user_id = $1 AND r.article_id = a.id)
+) unread
+WHERE source_rank <= $2
+ORDER BY source_id, published_at DESC, id DESC`
+	return queryWithSource(ctx, repo.db, "ListUnreadPerSource", query, []interface{}{userID, perSource}, 0)
+}
+
+// unreadWhereClause builds the WHERE clause selecting the articles matching filters
+// that userID has not read.
+func (repo *ReadStateRepo) unreadWhereClause(userID string, filters repository.ArticleSearchFilters) (string, []interface{}) {
+	whereClause, args := repo.queryBuilder.BuildWhereClause(nil, filters, "a")
+	whereClause = andCondition(whereClause, "a.deleted_at IS NULL")
+	args = append(args, userID)
+	return andCondition(whereClause, fmt.Sprintf(
+		"NOT EXISTS (SELECT 1 FROM article_reads r WHERE r.user_id = $%d AND r.article_id = a.id)", len(args))), args
+}
@@ -0,0 +1,231 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+
+	"catchup-feed/internal/domain/entity"
+	pg "catchup-feed/internal/infra/adapter/persistence/postgres"
+)
+
+var resummarizeJobColumns = []string{
+	"id", "status", "article_id", "source_id", "published_from", "published_to", "summary_model",
+	"total", "processed", "succeeded", "failed", "last_article_id", "error", "requested_by",
+	"created_at", "started_at", "finished_at",
+}
+
+func TestResummarizeRepo_CreateJob(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	sourceID := int64(3)
+	job := &entity.ResummarizeJob{
+		Status:      entity.ResummarizeQueued,
+		Filter:      entity.ResummarizeFilter{SourceID: &sourceID, SummaryModel: "old-model"},
+		RequestedBy: "admin",
+	}
+
+	mock.ExpectQuery("INSERT INTO resummarize_jobs").
+		WithArgs(entity.ResummarizeQueued, nil, &sourceID, nil, nil, "old-model", "admin").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(int64(9), now))
+
+	repo := pg.NewResummarizeRepo(db)
+	if err := repo.CreateJob(context.Background(), job); err != nil {
+		t.Fatalf("CreateJob err=%v", err)
+	}
+	if job.ID != 9 || !job.CreatedAt.Equal(now) {
+		t.Fatalf("ID/CreatedAt not set: %+v", job)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestResummarizeRepo_GetJob(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("FROM resummarize_jobs").
+		WithArgs(int64(9)).
+		WillReturnRows(sqlmock.NewRows(resummarizeJobColumns).AddRow(
+			int64(9), entity.ResummarizeRunning, int64(42), nil, now, nil, "",
+			10, 4, 3, 1, int64(57), "", "admin",
+			now, now, nil,
+		))
+
+	repo := pg.NewResummarizeRepo(db)
+	got, err := repo.GetJob(context.Background(), 9)
+	if err != nil {
+		t.Fatalf("GetJob err=%v", err)
+	}
+	articleID := int64(42)
+	want := &entity.ResummarizeJob{
+		ID: 9, Status: entity.ResummarizeRunning,
+		Filter: entity.ResummarizeFilter{ArticleID: &articleID, From: &now},
+		Total:  10, Processed: 4, Succeeded: 3, Failed: 1, LastArticleID: 57,
+		RequestedBy: "admin", CreatedAt: now, StartedAt: &now,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestResummarizeRepo_GetJob_NotFound(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery("FROM resummarize_jobs").
+		WithArgs(int64(9)).
+		WillReturnRows(sqlmock.NewRows(resummarizeJobColumns))
+
+	repo := pg.NewResummarizeRepo(db)
+	got, err := repo.GetJob(context.Background(), 9)
+	if err != nil || got != nil {
+		t.Fatalf("want (nil, nil), got (%v, %v)", got, err)
+	}
+}
+
+func TestResummarizeRepo_NextJob(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("WHERE status IN").
+		WithArgs(entity.ResummarizeRunning, entity.ResummarizeQueued).
+		WillReturnRows(sqlmock.NewRows(resummarizeJobColumns).AddRow(
+			int64(5), entity.ResummarizeQueued, nil, nil, nil, nil, "",
+			0, 0, 0, 0, int64(0), "", "admin",
+			now, nil, nil,
+		))
+
+	repo := pg.NewResummarizeRepo(db)
+	got, err := repo.NextJob(context.Background())
+	if err != nil {
+		t.Fatalf("NextJob err=%v", err)
+	}
+	if got == nil || got.ID != 5 || got.Status != entity.ResummarizeQueued {
+		t.Fatalf("unexpected job: %+v", got)
+	}
+}
+
+func TestResummarizeRepo_NextJob_None(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery("WHERE status IN").
+		WillReturnRows(sqlmock.NewRows(resummarizeJobColumns))
+
+	repo := pg.NewResummarizeRepo(db)
+	got, err := repo.NextJob(context.Background())
+	if err != nil || got != nil {
+		t.Fatalf("want (nil, nil), got (%v, %v)", got, err)
+	}
+}
+
+func TestResummarizeRepo_UpdateJob(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	job := &entity.ResummarizeJob{
+		ID: 9, Status: entity.ResummarizeCompleted,
+		Total: 2, Processed: 2, Succeeded: 2, LastArticleID: 12,
+		StartedAt: &now, FinishedAt: &now,
+	}
+	mock.ExpectExec("UPDATE resummarize_jobs SET").
+		WithArgs(entity.ResummarizeCompleted, 2, 2, 2, 0, int64(12), "", &now, &now, int64(9)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := pg.NewResummarizeRepo(db)
+	if err := repo.UpdateJob(context.Background(), job); err != nil {
+		t.Fatalf("UpdateJob err=%v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestResummarizeRepo_UpdateJob_NotFound(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec("UPDATE resummarize_jobs SET").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := pg.NewResummarizeRepo(db)
+	if err := repo.UpdateJob(context.Background(), &entity.ResummarizeJob{ID: 9}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestResummarizeRepo_CountTargets(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	sourceID := int64(3)
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM articles WHERE summary_status = \$1 AND deleted_at IS NULL AND source_id = \$2 AND published_at >= \$3 AND summary_model = \$4`).
+		WithArgs("", sourceID, from, "old-model").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+	repo := pg.NewResummarizeRepo(db)
+	got, err := repo.CountTargets(context.Background(), entity.ResummarizeFilter{
+		SourceID: &sourceID, From: &from, SummaryModel: "old-model",
+	})
+	if err != nil {
+		t.Fatalf("CountTargets err=%v", err)
+	}
+	if got != 7 {
+		t.Fatalf("count = %d, want 7", got)
+	}
+}
+
+func TestResummarizeRepo_ListTargets(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	articleID := int64(42)
+	mock.ExpectQuery(`WHERE summary_status = \$1 AND deleted_at IS NULL AND id = \$2 AND id > \$3\s+ORDER BY id\s+LIMIT \$4`).
+		WithArgs("", articleID, int64(0), 10).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "source_id", "title", "url",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags",
+		}).AddRow(
+			int64(42), int64(2), "title", "https://example.com/42",
+			"old summary", now, now, nil, "default@0123abcd", "", "", "old-model", "",
+		))
+
+	repo := pg.NewResummarizeRepo(db)
+	got, err := repo.ListTargets(context.Background(), entity.ResummarizeFilter{ArticleID: &articleID}, 0, 10)
+	if err != nil {
+		t.Fatalf("ListTargets err=%v", err)
+	}
+	want := []*entity.Article{{
+		ID: 42, SourceID: 2, Title: "title", URL: "https://example.com/42", Summary: "old summary",
+		PublishedAt: now, CreatedAt: now, PromptVersion: "default@0123abcd", SummaryModel: "old-model",
+	}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestResummarizeRepo_ListTargets_Error(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery("FROM articles").
+		WillReturnError(errors.New("connection refused"))
+
+	repo := pg.NewResummarizeRepo(db)
+	if _, err := repo.ListTargets(context.Background(), entity.ResummarizeFilter{}, 0, 10); err == nil {
+		t.Fatal("expected error")
+	}
+}
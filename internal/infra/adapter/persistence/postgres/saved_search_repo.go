@@ -0,0 +1,254 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+type SavedSearchRepo struct {
+	db           *sql.DB
+	queryBuilder *ArticleQueryBuilder
+}
+
+func NewSavedSearchRepo(db *sql.DB) repository.SavedSearchRepository {
+	return &SavedSearchRepo{
+		db:           db,
+		queryBuilder: NewArticleQueryBuilder(),
+	}
+}
+
+// Pending articles (batch summarization) keep the ID assigned at insert but are
+// summarized later, so saved searches, which remember the last article ID they
+// evaluated, would skip them. settledArticleCondition limits a query on articles
+// "a" to the articles before the first pending one, and latestSettledArticleIDQuery
+// returns the last such ID.
+const (
+	settledArticleCondition = `NOT EXISTS (
+	SELECT 1 FROM articles p
+	WHERE p.summary_status = 'pending' AND p.deleted_at IS NULL AND p.id <= a.id)`
+
+	latestSettledArticleIDQuery = `
+SELECT COALESCE(
+	(SELECT MIN(id) - 1 FROM articles WHERE summary_status = 'pending' AND deleted_at IS NULL),
+	(SELECT MAX(id) FROM articles),
+	0)`
+)
+
+const savedSearchColumns = `id, user_id, name, keyword, source_id, published_from, published_to, tags, subscribed, channel, last_article_id, last_alerted_at, created_at, updated_at`
+
+// savedSearchRow holds the scan destinations for a saved_searches row.
+type savedSearchRow struct {
+	search        entity.SavedSearch
+	sourceID      sql.NullInt64
+	from          sql.NullTime
+	to            sql.NullTime
+	tags          []byte
+	lastAlertedAt sql.NullTime
+}
+
+// dest returns the Scan destinations in savedSearchColumns order.
+func (r *savedSearchRow) dest() []any {
+	s := &r.search
+	return []any{
+		&s.ID, &s.UserID, &s.Name, &s.Keyword, &r.sourceID, &r.from, &r.to, &r.tags,
+		&s.Subscribed, &s.Channel, &s.LastArticleID, &r.lastAlertedAt, &s.CreatedAt, &s.UpdatedAt,
+	}
+}
+
+// toEntity converts the scanned row into a saved search entity.
+func (r *savedSearchRow) toEntity() (*entity.SavedSearch, error) {
+	s := r.search
+	if r.sourceID.Valid {
+		s.SourceID = &r.sourceID.Int64
+	}
+	if r.from.Valid {
+		s.From = &r.from.Time
+	}
+	if r.to.Valid {
+		s.To = &r.to.Time
+	}
+	if r.lastAlertedAt.Valid {
+		s.LastAlertedAt = &r.lastAlertedAt.Time
+	}
+	if err := json.Unmarshal(r.tags, &s.Tags); err != nil {
+		return nil, fmt.Errorf("decode tags of saved search %d: %w", s.ID, err)
+	}
+	return &s, nil
+}
+
+// savedSearchCriteria returns the nullable criteria columns of search
+// (source_id, published_from, published_to, tags) as query arguments.
+func savedSearchCriteria(search *entity.SavedSearch) ([]any, error) {
+	tags := search.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	b, err := json.Marshal(tags)
+	if err != nil {
+		return nil, fmt.Errorf("marshal tags: %w", err)
+	}
+	var sourceID sql.NullInt64
+	if search.SourceID != nil {
+		sourceID = sql.NullInt64{Int64: *search.SourceID, Valid: true}
+	}
+	var from, to sql.NullTime
+	if search.From != nil {
+		from = sql.NullTime{Time: *search.From, Valid: true}
+	}
+	if search.To != nil {
+		to = sql.NullTime{Time: *search.To, Valid: true}
+	}
+	return []any{sourceID, from, to, string(b)}, nil
+}
+
+func (repo *SavedSearchRepo) ListSearches(ctx context.Context, userID string) ([]*entity.SavedSearch, error) {
+	const query = `SELECT ` + savedSearchColumns + ` FROM saved_searches WHERE user_id = $1 ORDER BY id`
+	return repo.listSearches(ctx, "ListSearches", query, userID)
+}
+
+func (repo *SavedSearchRepo) GetSearch(ctx context.Context, userID string, id int64) (*entity.SavedSearch, error) {
+	const query = `SELECT ` + savedSearchColumns + ` FROM saved_searches WHERE id = $1 AND user_id = $2`
+	var row savedSearchRow
+	err := repo.db.QueryRowContext(ctx, query, id, userID).Scan(row.dest()...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("GetSearch: %w", err)
+	}
+	return row.toEntity()
+}
+
+func (repo *SavedSearchRepo) CreateSearch(ctx context.Context, search *entity.SavedSearch) error {
+	const query = `
+INSERT INTO saved_searches (user_id, name, keyword, source_id, published_from, published_to, tags, subscribed, channel, last_article_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id, created_at, updated_at`
+	criteria, err := savedSearchCriteria(search)
+	if err != nil {
+		return fmt.Errorf("CreateSearch: %w", err)
+	}
+	args := append([]any{search.UserID, search.Name, search.Keyword}, criteria...)
+	args = append(args, search.Subscribed, search.Channel, search.LastArticleID)
+	if err := repo.db.QueryRowContext(ctx, query, args...).
+		Scan(&search.ID, &search.CreatedAt, &search.UpdatedAt); err != nil {
+		return fmt.Errorf("CreateSearch: %w", err)
+	}
+	return nil
+}
+
+func (repo *SavedSearchRepo) UpdateSearch(ctx context.Context, search *entity.SavedSearch) (bool, error) {
+	const query = `
+UPDATE saved_searches
+SET name = $1, keyword = $2, source_id = $3, published_from = $4, published_to = $5, tags = $6,
+    subscribed = $7, channel = $8, last_article_id = $9, updated_at = now()
+WHERE id = $10 AND user_id = $11
+RETURNING updated_at`
+	criteria, err := savedSearchCriteria(search)
+	if err != nil {
+		return false, fmt.Errorf("UpdateSearch: %w", err)
+	}
+	args := append([]any{search.Name, search.Keyword}, criteria...)
+	args = append(args, search.Subscribed, search.Channel, search.LastArticleID, search.ID, search.UserID)
+	err = repo.db.QueryRowContext(ctx, query, args...).Scan(&search.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("UpdateSearch: %w", err)
+	}
+	return true, nil
+}
+
+func (repo *SavedSearchRepo) DeleteSearch(ctx context.Context, userID string, id int64) (bool, error) {
+	const query = `DELETE FROM saved_searches WHERE id = $1 AND user_id = $2`
+	res, err := repo.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return false, fmt.Errorf("DeleteSearch: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("DeleteSearch: RowsAffected: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (repo *SavedSearchRepo) ListSubscribed(ctx context.Context) ([]*entity.SavedSearch, error) {
+	const query = `SELECT ` + savedSearchColumns + ` FROM saved_searches WHERE subscribed ORDER BY id`
+	return repo.listSearches(ctx, "ListSubscribed", query)
+}
+
+// listSearches returns the saved searches selected by query.
+func (repo *SavedSearchRepo) listSearches(ctx context.Context, op, query string, args ...any) ([]*entity.SavedSearch, error) {
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var searches []*entity.SavedSearch
+	for rows.Next() {
+		var row savedSearchRow
+		if err := rows.Scan(row.dest()...); err != nil {
+			return nil, fmt.Errorf("%s: Scan: %w", op, err)
+		}
+		s, err := row.toEntity()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		searches = append(searches, s)
+	}
+	return searches, rows.Err()
+}
+
+func (repo *SavedSearchRepo) LatestArticleID(ctx context.Context) (int64, error) {
+	var id int64
+	if err := repo.db.QueryRowContext(ctx, latestSettledArticleIDQuery).Scan(&id); err != nil {
+		return 0, fmt.Errorf("LatestArticleID: %w", err)
+	}
+	return id, nil
+}
+
+func (repo *SavedSearchRepo) ListNewMatches(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters, afterID, uptoID int64, limit int) ([]repository.ArticleWithSource, error) {
+	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters, "a")
+	whereClause = andCondition(whereClause, "a.deleted_at IS NULL")
+	args = append(args, afterID, uptoID)
+	whereClause = andCondition(whereClause, fmt.Sprintf("a.id > $%d AND a.id <= $%d", len(args)-1, len(args)))
+	whereClause = andCondition(whereClause, settledArticleCondition)
+	args = append(args, limit)
+
+	// #nosec G201 -- whereClause is generated by QueryBuilder using numbered placeholders
+	query := fmt.Sprintf(`
+SELECT %s
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+%s
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT $%d`, articleWithSourceColumns, whereClause, len(args))
+
+	return queryWithSource(ctx, repo.db, "ListNewMatches", query, args, limit)
+}
+
+func (repo *SavedSearchRepo) MarkEvaluated(ctx context.Context, id, lastArticleID int64, alertedAt *time.Time) error {
+	// 通知しなかった場合は last_alerted_at を変えない。
+	// 要約待ちの記事を要約後に評価できるよう、既読位置はその手前までに抑える
+	const query = `
+UPDATE saved_searches
+SET last_article_id = LEAST($1, (` + latestSettledArticleIDQuery + `)), last_alerted_at = COALESCE($2, last_alerted_at)
+WHERE id = $3`
+	var alerted sql.NullTime
+	if alertedAt != nil {
+		alerted = sql.NullTime{Time: *alertedAt, Valid: true}
+	}
+	if _, err := repo.db.ExecContext(ctx, query, lastArticleID, alerted, id); err != nil {
+		return fmt.Errorf("MarkEvaluated: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+
+	"catchup-feed/internal/domain/entity"
+	pg "catchup-feed/internal/infra/adapter/persistence/postgres"
+	"catchup-feed/internal/repository"
+)
+
+var savedSearchColumnNames = []string{
+	"id", "user_id", "name", "keyword", "source_id", "published_from", "published_to", "tags",
+	"subscribed", "channel", "last_article_id", "last_alerted_at", "created_at", "updated_at",
+}
+
+func TestSavedSearchRepo_GetSearch(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	from := now.Add(-24 * time.Hour)
+	sourceID := int64(3)
+
+	tests := []struct {
+		name string
+		row  []driver.Value
+		want *entity.SavedSearch
+	}{
+		{
+			name: "keyword only",
+			row:  []driver.Value{int64(1), "alice", "Go", "go release", nil, nil, nil, []byte(`[]`), false, "", int64(0), nil, now, now},
+			want: &entity.SavedSearch{ID: 1, UserID: "alice", Name: "Go", Keyword: "go release", Tags: []string{}, CreatedAt: now, UpdatedAt: now},
+		},
+		{
+			name: "with filters and alerts",
+			row:  []driver.Value{int64(2), "alice", "Go Blog", "", int64(3), from, nil, []byte(`["go","release"]`), true, "slack", int64(120), now, now, now},
+			want: &entity.SavedSearch{
+				ID: 2, UserID: "alice", Name: "Go Blog", SourceID: &sourceID, From: &from, Tags: []string{"go", "release"},
+				Subscribed: true, Channel: "slack", LastArticleID: 120, LastAlertedAt: &now, CreatedAt: now, UpdatedAt: now,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, _ := sqlmock.New()
+			defer func() { _ = db.Close() }()
+
+			mock.ExpectQuery(regexp.QuoteMeta(`FROM saved_searches WHERE id = $1 AND user_id = $2`)).
+				WithArgs(tt.want.ID, "alice").
+				WillReturnRows(sqlmock.NewRows(savedSearchColumnNames).AddRow(tt.row...))
+
+			got, err := pg.NewSavedSearchRepo(db).GetSearch(context.Background(), "alice", tt.want.ID)
+			if err != nil {
+				t.Fatalf("GetSearch err=%v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetSearch mismatch (-want +got):\n%s", diff)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestSavedSearchRepo_GetSearch_NotFound(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM saved_searches WHERE id = $1 AND user_id = $2`)).
+		WithArgs(int64(9), "alice").
+		WillReturnError(sql.ErrNoRows)
+
+	got, err := pg.NewSavedSearchRepo(db).GetSearch(context.Background(), "alice", 9)
+	if err != nil || got != nil {
+		t.Fatalf("GetSearch = %v, %v; want nil, nil", got, err)
+	}
+}
+
+func TestSavedSearchRepo_CreateSearch(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	sourceID := int64(3)
+	search := &entity.SavedSearch{
+		UserID: "alice", Name: "Go", Keyword: "go", SourceID: &sourceID, Tags: []string{"go"},
+		Subscribed: true, Channel: "discord", LastArticleID: 120,
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO saved_searches`)).
+		WithArgs("alice", "Go", "go",
+			sql.NullInt64{Int64: 3, Valid: true}, sql.NullTime{}, sql.NullTime{}, `["go"]`,
+			true, "discord", int64(120)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(int64(5), now, now))
+
+	if err := pg.NewSavedSearchRepo(db).CreateSearch(context.Background(), search); err != nil {
+		t.Fatalf("CreateSearch err=%v", err)
+	}
+	if search.ID != 5 || !search.CreatedAt.Equal(now) {
+		t.Errorf("search = %+v", search)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSavedSearchRepo_UpdateSearch_NotFound(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE saved_searches`)).
+		WithArgs("Go", "go", sql.NullInt64{}, sql.NullTime{}, sql.NullTime{}, `[]`, false, "", int64(0), int64(9), "alice").
+		WillReturnError(sql.ErrNoRows)
+
+	found, err := pg.NewSavedSearchRepo(db).UpdateSearch(context.Background(),
+		&entity.SavedSearch{ID: 9, UserID: "alice", Name: "Go", Keyword: "go"})
+	if err != nil || found {
+		t.Fatalf("UpdateSearch = %v, %v; want false, nil", found, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSavedSearchRepo_DeleteSearch(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM saved_searches WHERE id = $1 AND user_id = $2`)).
+		WithArgs(int64(1), "alice").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	deleted, err := pg.NewSavedSearchRepo(db).DeleteSearch(context.Background(), "alice", 1)
+	if err != nil || !deleted {
+		t.Fatalf("DeleteSearch = %v, %v", deleted, err)
+	}
+}
+
+func TestSavedSearchRepo_ListSubscribed(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM saved_searches WHERE subscribed ORDER BY id`)).
+		WillReturnRows(sqlmock.NewRows(savedSearchColumnNames).
+			AddRow(int64(1), "alice", "Go", "go", nil, nil, nil, []byte(`[]`), true, "", int64(10), nil, now, now).
+			AddRow(int64(4), "bob", "Rust", "rust", nil, nil, nil, []byte(`[]`), true, "slack", int64(12), nil, now, now))
+
+	got, err := pg.NewSavedSearchRepo(db).ListSubscribed(context.Background())
+	if err != nil {
+		t.Fatalf("ListSubscribed err=%v", err)
+	}
+	if len(got) != 2 || got[0].UserID != "alice" || got[1].Channel != "slack" || got[1].LastArticleID != 12 {
+		t.Errorf("ListSubscribed = %+v", got)
+	}
+}
+
+func TestSavedSearchRepo_LatestArticleID(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`(SELECT MIN(id) - 1 FROM articles WHERE summary_status = 'pending' AND deleted_at IS NULL)`)).
+		WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(int64(130)))
+
+	got, err := pg.NewSavedSearchRepo(db).LatestArticleID(context.Background())
+	if err != nil || got != 130 {
+		t.Fatalf("LatestArticleID = %d, %v; want 130", got, err)
+	}
+}
+
+func TestSavedSearchRepo_ListNewMatches(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	sourceID := int64(3)
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE (a.title ILIKE $1 OR a.summary ILIKE $1) AND a.source_id = $2 AND a.deleted_at IS NULL AND a.id > $3 AND a.id <= $4 AND NOT EXISTS (
+	SELECT 1 FROM articles p
+	WHERE p.summary_status = 'pending' AND p.deleted_at IS NULL AND p.id <= a.id)
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT $5`)).
+		WithArgs("%go%", int64(3), int64(120), int64(130), 20).
+		WillReturnRows(sqlmock.NewRows(articleWithSourceColumnNames).
+			AddRow(int64(125), int64(3), "Go 1.25", "https://go.dev/blog/go1.25", "summary", now, now, nil, "", "", "", "", "", "Go Blog"))
+
+	got, err := pg.NewSavedSearchRepo(db).ListNewMatches(context.Background(),
+		[]string{"go"}, repository.ArticleSearchFilters{SourceID: &sourceID}, 120, 130, 20)
+	if err != nil {
+		t.Fatalf("ListNewMatches err=%v", err)
+	}
+	if len(got) != 1 || got[0].Article.ID != 125 || got[0].SourceName != "Go Blog" {
+		t.Errorf("ListNewMatches = %+v", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSavedSearchRepo_MarkEvaluated(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		alertedAt *time.Time
+		wantArg   sql.NullTime
+	}{
+		{name: "no alert", alertedAt: nil, wantArg: sql.NullTime{}},
+		{name: "alerted", alertedAt: &now, wantArg: sql.NullTime{Time: now, Valid: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, _ := sqlmock.New()
+			defer func() { _ = db.Close() }()
+
+			mock.ExpectExec(regexp.QuoteMeta(`SET last_article_id = LEAST($1, (
+SELECT COALESCE(
+	(SELECT MIN(id) - 1 FROM articles WHERE summary_status = 'pending' AND deleted_at IS NULL),`)).
+				WithArgs(int64(130), tt.wantArg, int64(1)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			if err := pg.NewSavedSearchRepo(db).MarkEvaluated(context.Background(), 1, 130, tt.alertedAt); err != nil {
+				t.Fatalf("MarkEvaluated err=%v", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+type SourceArticleRepo struct {
+	db *sql.DB
+}
+
+func NewSourceArticleRepo(db *sql.DB) repository.SourceArticleRepository {
+	return &SourceArticleRepo{db: db}
+}
+
+func (repo *SourceArticleRepo) ListBySource(ctx context.Context, sourceID int64, offset, limit int) ([]*entity.Article, error) {
+	const query = `
+SELECT id, source_id, title, url, summary, published_at, created_at, summary_structured, prompt_version, summary_status, summary_batch_id, summary_model, injection_flags
+FROM articles
+WHERE source_id = $1 AND deleted_at IS NULL
+ORDER BY published_at DESC, id DESC
+LIMIT $2 OFFSET $3`
+	rows, err := repo.db.QueryContext(ctx, query, sourceID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("ListBySource: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	articles := make([]*entity.Article, 0, limit)
+	for rows.Next() {
+		var row articleRow
+		if err := rows.Scan(row.dest()...); err != nil {
+			return nil, fmt.Errorf("ListBySource: %w", err)
+		}
+		articles = append(articles, row.toEntity())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ListBySource: %w", err)
+	}
+	return articles, nil
+}
+
+func (repo *SourceArticleRepo) CountBySource(ctx context.Context, sourceID int64, dayStart, weekStart time.Time) (repository.SourceArticleCounts, error) {
+	const query = `
+SELECT COUNT(*),
+       COUNT(*) FILTER (WHERE created_at >= $2),
+       COUNT(*) FILTER (WHERE created_at >= $3),
+       MAX(created_at),
+       (SELECT COUNT(*) FROM summarize_failures WHERE source_id = $1 AND failed_at >= $3)
+FROM articles
+WHERE source_id = $1 AND deleted_at IS NULL`
+	var c repository.SourceArticleCounts
+	var lastArticle sql.NullTime
+	err := repo.db.QueryRowContext(ctx, query, sourceID, dayStart, weekStart).
+		Scan(&c.Total, &c.LastDay, &c.LastWeek, &lastArticle, &c.SummarizeFailuresLastWeek)
+	if err != nil {
+		return c, fmt.Errorf("CountBySource: %w", err)
+	}
+	if lastArticle.Valid {
+		c.LastArticleAt = &lastArticle.Time
+	}
+	return c, nil
+}
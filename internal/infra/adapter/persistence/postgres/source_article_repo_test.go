@@ -0,0 +1,62 @@
+package postgres_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	pg "catchup-feed/internal/infra/adapter/persistence/postgres"
+)
+
+func TestSourceArticleRepo_ListBySource(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE source_id = $1 AND deleted_at IS NULL
+ORDER BY published_at DESC, id DESC
+LIMIT $2 OFFSET $3`)).
+		WithArgs(int64(3), 20, 40).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "source_id", "title", "url", "summary", "published_at", "created_at", "summary_structured",
+			"prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags",
+		}).AddRow(int64(7), int64(3), "Go 1.25", "https://go.dev/blog/go1.25", "summary", now, now, nil, "", "", "", "", ""))
+
+	got, err := pg.NewSourceArticleRepo(db).ListBySource(context.Background(), 3, 40, 20)
+	if err != nil {
+		t.Fatalf("ListBySource err=%v", err)
+	}
+	if len(got) != 1 || got[0].ID != 7 || got[0].SourceID != 3 {
+		t.Errorf("ListBySource = %+v", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSourceArticleRepo_CountBySource(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	dayStart, weekStart := now.Add(-24*time.Hour), now.Add(-7*24*time.Hour)
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE source_id = $1 AND deleted_at IS NULL`)).
+		WithArgs(int64(3), dayStart, weekStart).
+		WillReturnRows(sqlmock.NewRows([]string{"total", "day", "week", "max", "failures"}).
+			AddRow(int64(40), int64(2), int64(9), now, int64(1)))
+
+	got, err := pg.NewSourceArticleRepo(db).CountBySource(context.Background(), 3, dayStart, weekStart)
+	if err != nil {
+		t.Fatalf("CountBySource err=%v", err)
+	}
+	if got.Total != 40 || got.LastDay != 2 || got.LastWeek != 9 || got.SummarizeFailuresLastWeek != 1 ||
+		got.LastArticleAt == nil || !got.LastArticleAt.Equal(now) {
+		t.Errorf("CountBySource = %+v", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -19,14 +19,16 @@ func NewSourceRepo(db *sql.DB) repository.SourceRepository {
 	return &SourceRepo{db: db}
 }
 
-// scanSource is a helper function to scan a source row including scraper_config
-func scanSource(rows *sql.Rows) (*entity.Source, error) {
+// scanSource is a helper function to scan a source row including scraper_config.
+// extra destinations (e.g. deleted_at for trash queries) are scanned after the source columns.
+func scanSource(rows *sql.Rows, extra ...any) (*entity.Source, error) {
 	var source entity.Source
 	var scraperConfigJSON []byte
-	if err := rows.Scan(
+	dest := []any{
 		&source.ID, &source.Name, &source.FeedURL, &source.LastCrawledAt, &source.Active,
-		&source.SourceType, &scraperConfigJSON,
-	); err != nil {
+		&source.SourceType, &scraperConfigJSON, &source.PromptTemplate,
+	}
+	if err := rows.Scan(append(dest, extra...)...); err != nil {
 		return nil, err
 	}
 
@@ -44,15 +46,15 @@ func scanSource(rows *sql.Rows) (*entity.Source, error) {
 
 func (repo *SourceRepo) Get(ctx context.Context, id int64) (*entity.Source, error) {
 	const query = `
-SELECT id, name, feed_url, last_crawled_at, active, source_type, scraper_config
+SELECT id, name, feed_url, last_crawled_at, active, source_type, scraper_config, prompt_template
 FROM sources
-WHERE id = $1
+WHERE id = $1 AND deleted_at IS NULL
 LIMIT 1`
 	var source entity.Source
 	var scraperConfigJSON []byte
 	err := repo.db.QueryRowContext(ctx, query, id).Scan(
 		&source.ID, &source.Name, &source.FeedURL, &source.LastCrawledAt, &source.Active,
-		&source.SourceType, &scraperConfigJSON,
+		&source.SourceType, &scraperConfigJSON, &source.PromptTemplate,
 	)
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -75,8 +77,9 @@ LIMIT 1`
 
 func (repo *SourceRepo) List(ctx context.Context) ([]*entity.Source, error) {
 	const query = `
-SELECT id, name, feed_url, last_crawled_at, active, source_type, scraper_config
+SELECT id, name, feed_url, last_crawled_at, active, source_type, scraper_config, prompt_template
 FROM sources
+WHERE deleted_at IS NULL
 ORDER BY id ASC`
 	rows, err := repo.db.QueryContext(ctx, query)
 	if err != nil {
@@ -98,9 +101,9 @@ ORDER BY id ASC`
 
 func (repo *SourceRepo) ListActive(ctx context.Context) ([]*entity.Source, error) {
 	const query = `
-SELECT id, name, feed_url, last_crawled_at, active, source_type, scraper_config
+SELECT id, name, feed_url, last_crawled_at, active, source_type, scraper_config, prompt_template
 FROM sources
-WHERE active = TRUE
+WHERE active = TRUE AND deleted_at IS NULL
 ORDER BY id ASC`
 	rows, err := repo.db.QueryContext(ctx, query)
 	if err != nil {
@@ -122,10 +125,11 @@ ORDER BY id ASC`
 
 func (repo *SourceRepo) Search(ctx context.Context, kw string) ([]*entity.Source, error) {
 	const query = `
-SELECT id, name, feed_url, last_crawled_at, active, source_type, scraper_config
+SELECT id, name, feed_url, last_crawled_at, active, source_type, scraper_config, prompt_template
 FROM sources
-WHERE name     ILIKE $1
-OR feed_url ILIKE $1
+WHERE (name     ILIKE $1
+OR feed_url ILIKE $1)
+AND deleted_at IS NULL
 ORDER BY id ASC`
 	param := "%" + kw + "%"
 	rows, err := repo.db.QueryContext(ctx, query, param)
@@ -156,8 +160,8 @@ func (repo *SourceRepo) SearchWithFilters(
 	ctx, cancel := context.WithTimeout(ctx, search.DefaultSearchTimeout)
 	defer cancel()
 
-	// Build WHERE clause conditions
-	var conditions []string
+	// Build WHERE clause conditions (sources in the trash are never returned)
+	conditions := []string{"deleted_at IS NULL"}
 	var args []interface{}
 	paramIndex := 1
 
@@ -183,26 +187,25 @@ func (repo *SourceRepo) SearchWithFilters(
 	if filters.Active != nil {
 		conditions = append(conditions, fmt.Sprintf("active = $%d", paramIndex))
 		args = append(args, *filters.Active)
+		paramIndex++
+	}
+
+	// Add category filter if provided
+	if filters.CategoryID != nil {
+		conditions = append(conditions, fmt.Sprintf(
+			"id IN (SELECT source_id FROM source_category_members WHERE category_id = $%d)", paramIndex))
+		args = append(args, *filters.CategoryID)
 	}
 
 	// Build final query with dynamic WHERE clause
-	var query string
-	if len(conditions) > 0 {
-		// With filters or keywords
-		query = fmt.Sprintf(`
-SELECT id, name, feed_url, last_crawled_at, active, source_type, scraper_config
+	// (no keywords and no filters returns all sources: browse mode)
+	query := fmt.Sprintf(`
+SELECT id, name, feed_url, last_crawled_at, active, source_type, scraper_config, prompt_template
 FROM sources
 WHERE %s
 ORDER BY id ASC`,
-			strings.Join(conditions, "\n  AND "),
-		)
-	} else {
-		// No keywords, no filters - return all sources (browse mode)
-		query = `
-SELECT id, name, feed_url, last_crawled_at, active, source_type, scraper_config
-FROM sources
-ORDER BY id ASC`
-	}
+		strings.Join(conditions, "\n  AND "),
+	)
 
 	// Execute query
 	rows, err := repo.db.QueryContext(ctx, query, args...)
@@ -241,12 +244,12 @@ func (repo *SourceRepo) Create(ctx context.Context, source *entity.Source) error
 	}
 
 	const query = `
-INSERT INTO sources (name, feed_url, last_crawled_at, active, source_type, scraper_config)
-VALUES ($1, $2, $3, $4, $5, $6)`
+INSERT INTO sources (name, feed_url, last_crawled_at, active, source_type, scraper_config, prompt_template)
+VALUES ($1, $2, $3, $4, $5, $6, $7)`
 	_, err := repo.db.ExecContext(ctx, query,
 		source.Name, source.FeedURL,
 		source.LastCrawledAt, source.Active,
-		source.SourceType, scraperConfigJSON,
+		source.SourceType, scraperConfigJSON, source.PromptTemplate,
 	)
 	if err != nil {
 		return fmt.Errorf("Create: %w", err)
@@ -277,12 +280,13 @@ UPDATE sources SET
        last_crawled_at = $3,
        active          = $4,
        source_type     = $5,
-       scraper_config  = $6
-WHERE id = $7`
+       scraper_config  = $6,
+       prompt_template = $7
+WHERE id = $8 AND deleted_at IS NULL`
 	res, err := repo.db.ExecContext(ctx, query,
 		source.Name, source.FeedURL,
 		source.LastCrawledAt, source.Active,
-		source.SourceType, scraperConfigJSON, source.ID,
+		source.SourceType, scraperConfigJSON, source.PromptTemplate, source.ID,
 	)
 	if err != nil {
 		return fmt.Errorf("Update: %w", err)
@@ -293,13 +297,25 @@ WHERE id = $7`
 	return nil
 }
 
+// Delete moves the source and its articles to the trash. The articles get the
+// same deleted_at as the source, so that restoring the source restores exactly
+// the articles deleted with it (see TrashRepo.RestoreSource).
 func (repo *SourceRepo) Delete(ctx context.Context, id int64) error {
-	const query = `DELETE FROM sources WHERE id = $1`
-	res, err := repo.db.ExecContext(ctx, query, id)
-	if err != nil {
+	const query = `
+WITH trashed AS (
+UPDATE sources SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, deleted_at
+), trashed_articles AS (
+UPDATE articles a SET deleted_at = t.deleted_at
+FROM trashed t
+WHERE a.source_id = t.id AND a.deleted_at IS NULL
+)
+SELECT COUNT(*) FROM trashed`
+	var n int64
+	if err := repo.db.QueryRowContext(ctx, query, id).Scan(&n); err != nil {
 		return fmt.Errorf("Delete: %w", err)
 	}
-	if n, _ := res.RowsAffected(); n == 0 {
+	if n == 0 {
 		return fmt.Errorf("Delete: no rows affected")
 	}
 	return nil
@@ -21,11 +21,11 @@ func row(src *entity.Source) *sqlmock.Rows {
 	return sqlmock.NewRows([]string{
 		"id", "name", "feed_url",
 		"last_crawled_at", "active",
-		"source_type", "scraper_config",
+		"source_type", "scraper_config", "prompt_template",
 	}).AddRow(
 		src.ID, src.Name, src.FeedURL,
 		src.LastCrawledAt, src.Active,
-		src.SourceType, nil,
+		src.SourceType, nil, src.PromptTemplate,
 	)
 }
 
@@ -38,7 +38,7 @@ func TestSourceRepo_Get(t *testing.T) {
 	want := &entity.Source{
 		ID: 1, Name: "Qiita", FeedURL: "https://qiita.com/feed",
 		LastCrawledAt: &[]time.Time{time.Now()}[0], Active: true,
-		SourceType: "RSS",
+		SourceType: "RSS", PromptTemplate: "release-notes",
 	}
 
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id`)).
@@ -92,7 +92,7 @@ func TestSourceRepo_Search(t *testing.T) {
 		WithArgs("%go%").
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "name", "feed_url", "last_crawled_at", "active",
-			"source_type", "scraper_config",
+			"source_type", "scraper_config", "prompt_template",
 		})) // empty set OK
 
 	repo := postgres.NewSourceRepo(db)
@@ -113,14 +113,14 @@ func TestSourceRepo_Create(t *testing.T) {
 	now := time.Now()
 	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO sources`)).
 		WithArgs("Qiita", "https://qiita.com/feed",
-			&now, true, "RSS", []byte(nil)).
+			&now, true, "RSS", []byte(nil), "release-notes").
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	repo := postgres.NewSourceRepo(db)
 	err := repo.Create(context.Background(), &entity.Source{
 		Name: "Qiita", FeedURL: "https://qiita.com/feed",
 		LastCrawledAt: &now, Active: true,
-		SourceType: "RSS",
+		SourceType: "RSS", PromptTemplate: "release-notes",
 	})
 	if err != nil {
 		t.Fatalf("Create err=%v", err)
@@ -139,7 +139,7 @@ func TestSourceRepo_Update(t *testing.T) {
 	now := time.Now()
 	mock.ExpectExec(`UPDATE sources`).
 		WithArgs("Qiita", "https://qiita.com/feed",
-			&now, true, "RSS", []byte(nil), int64(1)).
+			&now, true, "RSS", []byte(nil), "", int64(1)).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
 	repo := postgres.NewSourceRepo(db)
@@ -162,9 +162,11 @@ func TestSourceRepo_Delete(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer func() { _ = db.Close() }()
 
-	mock.ExpectExec(`DELETE FROM sources`).
+	// ソースと、そのソースの記事を同じ deleted_at でゴミ箱に移す
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE sources SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`) +
+		`(?s).*` + regexp.QuoteMeta(`UPDATE articles a SET deleted_at = t.deleted_at`)).
 		WithArgs(int64(1)).
-		WillReturnResult(sqlmock.NewResult(0, 1))
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(1)))
 
 	repo := postgres.NewSourceRepo(db)
 	if err := repo.Delete(context.Background(), 1); err != nil {
@@ -184,10 +186,10 @@ func TestSourceRepo_ListActive(t *testing.T) {
 	now := time.Now()
 	rows := sqlmock.NewRows([]string{
 		"id", "name", "feed_url", "last_crawled_at", "active",
-		"source_type", "scraper_config",
+		"source_type", "scraper_config", "prompt_template",
 	}).
-		AddRow(1, "Qiita", "https://qiita.com/feed", now, true, "RSS", nil).
-		AddRow(2, "Zenn", "https://zenn.dev/feed", now, true, "RSS", nil)
+		AddRow(1, "Qiita", "https://qiita.com/feed", now, true, "RSS", nil, "").
+		AddRow(2, "Zenn", "https://zenn.dev/feed", now, true, "RSS", nil, "")
 
 	mock.ExpectQuery(`FROM sources`).
 		WillReturnRows(rows)
@@ -214,7 +216,7 @@ func TestSourceRepo_ListActive_Empty(t *testing.T) {
 
 	rows := sqlmock.NewRows([]string{
 		"id", "name", "feed_url", "last_crawled_at", "active",
-		"source_type", "scraper_config",
+		"source_type", "scraper_config", "prompt_template",
 	})
 
 	mock.ExpectQuery(`FROM sources`).
@@ -283,8 +285,8 @@ func TestSourceRepo_SearchWithFilters_SingleKeyword(t *testing.T) {
 	now := time.Now()
 	rows := sqlmock.NewRows([]string{
 		"id", "name", "feed_url", "last_crawled_at", "active",
-		"source_type", "scraper_config",
-	}).AddRow(1, "Go Blog", "https://go.dev/blog/feed", &now, true, "RSS", nil)
+		"source_type", "scraper_config", "prompt_template",
+	}).AddRow(1, "Go Blog", "https://go.dev/blog/feed", &now, true, "RSS", nil, "")
 
 	mock.ExpectQuery(`FROM sources`).
 		WithArgs("%Go%"). // EscapeILIKE wraps with %
@@ -313,8 +315,8 @@ func TestSourceRepo_SearchWithFilters_MultipleKeywords(t *testing.T) {
 	now := time.Now()
 	rows := sqlmock.NewRows([]string{
 		"id", "name", "feed_url", "last_crawled_at", "active",
-		"source_type", "scraper_config",
-	}).AddRow(1, "Go Blog", "https://go.dev/blog/feed", &now, true, "RSS", nil)
+		"source_type", "scraper_config", "prompt_template",
+	}).AddRow(1, "Go Blog", "https://go.dev/blog/feed", &now, true, "RSS", nil, "")
 
 	// Multiple keywords with AND logic
 	mock.ExpectQuery(`FROM sources`).
@@ -341,8 +343,8 @@ func TestSourceRepo_SearchWithFilters_WithSourceTypeFilter(t *testing.T) {
 	now := time.Now()
 	rows := sqlmock.NewRows([]string{
 		"id", "name", "feed_url", "last_crawled_at", "active",
-		"source_type", "scraper_config",
-	}).AddRow(2, "Webflow Blog", "https://webflow.com/blog", &now, true, "Webflow", nil)
+		"source_type", "scraper_config", "prompt_template",
+	}).AddRow(2, "Webflow Blog", "https://webflow.com/blog", &now, true, "Webflow", nil, "")
 
 	sourceType := "Webflow"
 	filters := repository.SourceSearchFilters{
@@ -376,8 +378,8 @@ func TestSourceRepo_SearchWithFilters_WithActiveFilter(t *testing.T) {
 	now := time.Now()
 	rows := sqlmock.NewRows([]string{
 		"id", "name", "feed_url", "last_crawled_at", "active",
-		"source_type", "scraper_config",
-	}).AddRow(1, "Active Blog", "https://active.com/feed", &now, true, "RSS", nil)
+		"source_type", "scraper_config", "prompt_template",
+	}).AddRow(1, "Active Blog", "https://active.com/feed", &now, true, "RSS", nil, "")
 
 	active := true
 	filters := repository.SourceSearchFilters{
@@ -411,8 +413,8 @@ func TestSourceRepo_SearchWithFilters_WithAllFilters(t *testing.T) {
 	now := time.Now()
 	rows := sqlmock.NewRows([]string{
 		"id", "name", "feed_url", "last_crawled_at", "active",
-		"source_type", "scraper_config",
-	}).AddRow(1, "Go Blog RSS", "https://go.dev/feed", &now, true, "RSS", nil)
+		"source_type", "scraper_config", "prompt_template",
+	}).AddRow(1, "Go Blog RSS", "https://go.dev/feed", &now, true, "RSS", nil, "")
 
 	sourceType := "RSS"
 	active := true
@@ -448,7 +450,7 @@ func TestSourceRepo_SearchWithFilters_EmptyKeywords(t *testing.T) {
 	// Updated behavior: empty keywords now executes query and returns all sources
 	rows := sqlmock.NewRows([]string{
 		"id", "name", "feed_url", "last_crawled_at", "active",
-		"source_type", "scraper_config",
+		"source_type", "scraper_config", "prompt_template",
 	}) // Empty result set for this test
 
 	mock.ExpectQuery(`FROM sources`).
@@ -474,8 +476,8 @@ func TestSourceRepo_SearchWithFilters_SpecialCharacters(t *testing.T) {
 	now := time.Now()
 	rows := sqlmock.NewRows([]string{
 		"id", "name", "feed_url", "last_crawled_at", "active",
-		"source_type", "scraper_config",
-	}).AddRow(1, "100% Go", "https://go100.com/feed", &now, true, "RSS", nil)
+		"source_type", "scraper_config", "prompt_template",
+	}).AddRow(1, "100% Go", "https://go100.com/feed", &now, true, "RSS", nil, "")
 
 	// EscapeILIKE should escape % as \%
 	mock.ExpectQuery(`FROM sources`).
@@ -502,8 +504,8 @@ func TestSourceRepo_SearchWithFilters_UnderscoreEscape(t *testing.T) {
 	now := time.Now()
 	rows := sqlmock.NewRows([]string{
 		"id", "name", "feed_url", "last_crawled_at", "active",
-		"source_type", "scraper_config",
-	}).AddRow(1, "my_blog", "https://myblog.com/feed", &now, true, "RSS", nil)
+		"source_type", "scraper_config", "prompt_template",
+	}).AddRow(1, "my_blog", "https://myblog.com/feed", &now, true, "RSS", nil, "")
 
 	// EscapeILIKE should escape _ as \_
 	mock.ExpectQuery(`FROM sources`).
@@ -530,8 +532,8 @@ func TestSourceRepo_SearchWithFilters_BackslashEscape(t *testing.T) {
 	now := time.Now()
 	rows := sqlmock.NewRows([]string{
 		"id", "name", "feed_url", "last_crawled_at", "active",
-		"source_type", "scraper_config",
-	}).AddRow(1, "path\\file", "https://example.com/feed", &now, true, "RSS", nil)
+		"source_type", "scraper_config", "prompt_template",
+	}).AddRow(1, "path\\file", "https://example.com/feed", &now, true, "RSS", nil, "")
 
 	// EscapeILIKE should escape \ as \\
 	mock.ExpectQuery(`FROM sources`).
@@ -560,7 +562,7 @@ func TestSourceRepo_Update_NoRowsAffected(t *testing.T) {
 	now := time.Now()
 	mock.ExpectExec(`UPDATE sources`).
 		WithArgs("Qiita", "https://qiita.com/feed",
-			&now, true, "RSS", []byte(nil), int64(999)).
+			&now, true, "RSS", []byte(nil), "", int64(999)).
 		WillReturnResult(sqlmock.NewResult(0, 0))
 
 	repo := postgres.NewSourceRepo(db)
@@ -581,9 +583,9 @@ func TestSourceRepo_Delete_NoRowsAffected(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer func() { _ = db.Close() }()
 
-	mock.ExpectExec(`DELETE FROM sources`).
+	mock.ExpectQuery(`UPDATE sources SET deleted_at`).
 		WithArgs(int64(999)).
-		WillReturnResult(sqlmock.NewResult(0, 0))
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(0)))
 
 	repo := postgres.NewSourceRepo(db)
 	err := repo.Delete(context.Background(), 999)
@@ -605,10 +607,10 @@ func TestSourceRepo_SearchWithFilters_EmptyKeywords_NoFilters(t *testing.T) {
 	now := time.Now()
 	rows := sqlmock.NewRows([]string{
 		"id", "name", "feed_url", "last_crawled_at", "active",
-		"source_type", "scraper_config",
+		"source_type", "scraper_config", "prompt_template",
 	}).
-		AddRow(1, "Tech Blog", "https://example.com/feed", &now, true, "RSS", nil).
-		AddRow(2, "News Site", "https://news.example.com/feed", &now, false, "Webflow", nil)
+		AddRow(1, "Tech Blog", "https://example.com/feed", &now, true, "RSS", nil, "").
+		AddRow(2, "News Site", "https://news.example.com/feed", &now, false, "Webflow", nil, "")
 
 	// No WHERE clause - returns all sources
 	mock.ExpectQuery(`FROM sources`).
@@ -635,8 +637,8 @@ func TestSourceRepo_SearchWithFilters_EmptyKeywords_SourceTypeFilter(t *testing.
 	now := time.Now()
 	rows := sqlmock.NewRows([]string{
 		"id", "name", "feed_url", "last_crawled_at", "active",
-		"source_type", "scraper_config",
-	}).AddRow(1, "RSS Blog", "https://example.com/feed", &now, true, "RSS", nil)
+		"source_type", "scraper_config", "prompt_template",
+	}).AddRow(1, "RSS Blog", "https://example.com/feed", &now, true, "RSS", nil, "")
 
 	sourceType := "RSS"
 	filters := repository.SourceSearchFilters{
@@ -672,10 +674,10 @@ func TestSourceRepo_SearchWithFilters_EmptyKeywords_ActiveFilter(t *testing.T) {
 	now := time.Now()
 	rows := sqlmock.NewRows([]string{
 		"id", "name", "feed_url", "last_crawled_at", "active",
-		"source_type", "scraper_config",
+		"source_type", "scraper_config", "prompt_template",
 	}).
-		AddRow(1, "Active Blog", "https://example.com/feed", &now, true, "RSS", nil).
-		AddRow(2, "Another Active", "https://example2.com/feed", &now, true, "Webflow", nil)
+		AddRow(1, "Active Blog", "https://example.com/feed", &now, true, "RSS", nil, "").
+		AddRow(2, "Another Active", "https://example2.com/feed", &now, true, "Webflow", nil, "")
 
 	active := true
 	filters := repository.SourceSearchFilters{
@@ -713,8 +715,8 @@ func TestSourceRepo_SearchWithFilters_EmptyKeywords_MultipleFilters(t *testing.T
 	now := time.Now()
 	rows := sqlmock.NewRows([]string{
 		"id", "name", "feed_url", "last_crawled_at", "active",
-		"source_type", "scraper_config",
-	}).AddRow(1, "Active RSS", "https://example.com/feed", &now, true, "RSS", nil)
+		"source_type", "scraper_config", "prompt_template",
+	}).AddRow(1, "Active RSS", "https://example.com/feed", &now, true, "RSS", nil, "")
 
 	sourceType := "RSS"
 	active := true
@@ -754,7 +756,7 @@ func TestSourceRepo_SearchWithFilters_EmptyKeywords_EmptyResult(t *testing.T) {
 
 	rows := sqlmock.NewRows([]string{
 		"id", "name", "feed_url", "last_crawled_at", "active",
-		"source_type", "scraper_config",
+		"source_type", "scraper_config", "prompt_template",
 	}) // No rows
 
 	sourceType := "NonExistent"
@@ -792,7 +794,7 @@ func TestSourceRepo_Get_NotFound(t *testing.T) {
 		WithArgs(int64(999)).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "name", "feed_url", "last_crawled_at", "active",
-			"source_type", "scraper_config",
+			"source_type", "scraper_config", "prompt_template",
 		}))
 
 	repo := postgres.NewSourceRepo(db)
@@ -835,8 +837,8 @@ func TestSourceRepo_Get_WithScraperConfig(t *testing.T) {
 		WithArgs(int64(1)).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "name", "feed_url", "last_crawled_at", "active",
-			"source_type", "scraper_config",
-		}).AddRow(1, "Test Source", "https://example.com/feed", &now, true, "Webflow", scraperConfigJSON))
+			"source_type", "scraper_config", "prompt_template",
+		}).AddRow(1, "Test Source", "https://example.com/feed", &now, true, "Webflow", scraperConfigJSON, ""))
 
 	repo := postgres.NewSourceRepo(db)
 	got, err := repo.Get(context.Background(), 1)
@@ -865,8 +867,8 @@ func TestSourceRepo_Get_InvalidScraperConfigJSON(t *testing.T) {
 		WithArgs(int64(1)).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "name", "feed_url", "last_crawled_at", "active",
-			"source_type", "scraper_config",
-		}).AddRow(1, "Test", "https://example.com", &now, true, "RSS", invalidJSON))
+			"source_type", "scraper_config", "prompt_template",
+		}).AddRow(1, "Test", "https://example.com", &now, true, "RSS", invalidJSON, ""))
 
 	repo := postgres.NewSourceRepo(db)
 	got, err := repo.Get(context.Background(), 1)
@@ -886,8 +888,8 @@ func TestSourceRepo_List_ScanError(t *testing.T) {
 	mock.ExpectQuery(`FROM sources`).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "name", "feed_url", "last_crawled_at", "active",
-			"source_type", "scraper_config",
-		}).AddRow("invalid", "name", "url", nil, true, "RSS", nil))
+			"source_type", "scraper_config", "prompt_template",
+		}).AddRow("invalid", "name", "url", nil, true, "RSS", nil, ""))
 
 	repo := postgres.NewSourceRepo(db)
 	got, err := repo.List(context.Background())
@@ -907,8 +909,8 @@ func TestSourceRepo_ListActive_ScanError(t *testing.T) {
 	mock.ExpectQuery(`FROM sources`).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "name", "feed_url", "last_crawled_at", "active",
-			"source_type", "scraper_config",
-		}).AddRow("invalid", "name", "url", nil, true, "RSS", nil))
+			"source_type", "scraper_config", "prompt_template",
+		}).AddRow("invalid", "name", "url", nil, true, "RSS", nil, ""))
 
 	repo := postgres.NewSourceRepo(db)
 	got, err := repo.ListActive(context.Background())
@@ -929,8 +931,8 @@ func TestSourceRepo_Search_ScanError(t *testing.T) {
 		WithArgs("%go%").
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "name", "feed_url", "last_crawled_at", "active",
-			"source_type", "scraper_config",
-		}).AddRow("invalid", "name", "url", nil, true, "RSS", nil))
+			"source_type", "scraper_config", "prompt_template",
+		}).AddRow("invalid", "name", "url", nil, true, "RSS", nil, ""))
 
 	repo := postgres.NewSourceRepo(db)
 	got, err := repo.Search(context.Background(), "go")
@@ -951,8 +953,8 @@ func TestSourceRepo_SearchWithFilters_ScanError(t *testing.T) {
 		WithArgs("%go%").
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "name", "feed_url", "last_crawled_at", "active",
-			"source_type", "scraper_config",
-		}).AddRow("invalid", "name", "url", nil, true, "RSS", nil))
+			"source_type", "scraper_config", "prompt_template",
+		}).AddRow("invalid", "name", "url", nil, true, "RSS", nil, ""))
 
 	repo := postgres.NewSourceRepo(db)
 	got, err := repo.SearchWithFilters(context.Background(), []string{"go"}, repository.SourceSearchFilters{})
@@ -972,7 +974,7 @@ func TestSourceRepo_Create_DatabaseError(t *testing.T) {
 	dbError := errors.New("unique constraint violation")
 	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO sources`)).
 		WithArgs("Qiita", "https://qiita.com/feed",
-			&now, true, "RSS", []byte(nil)).
+			&now, true, "RSS", []byte(nil), "release-notes").
 		WillReturnError(dbError)
 
 	repo := postgres.NewSourceRepo(db)
@@ -999,17 +1001,17 @@ func TestSourceRepo_Create_WithScraperConfig(t *testing.T) {
 
 	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO sources`)).
 		WithArgs("Webflow", "https://webflow.com/blog",
-			&now, true, "Webflow", expectedJSON).
+			&now, true, "Webflow", expectedJSON, "").
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	repo := postgres.NewSourceRepo(db)
 	err := repo.Create(context.Background(), &entity.Source{
-		Name:           "Webflow",
-		FeedURL:        "https://webflow.com/blog",
-		LastCrawledAt:  &now,
-		Active:         true,
-		SourceType:     "Webflow",
-		ScraperConfig:  scraperConfig,
+		Name:          "Webflow",
+		FeedURL:       "https://webflow.com/blog",
+		LastCrawledAt: &now,
+		Active:        true,
+		SourceType:    "Webflow",
+		ScraperConfig: scraperConfig,
 	})
 	if err != nil {
 		t.Fatalf("Create err=%v", err)
@@ -1024,7 +1026,7 @@ func TestSourceRepo_Update_DatabaseError(t *testing.T) {
 	dbError := errors.New("constraint violation")
 	mock.ExpectExec(`UPDATE sources`).
 		WithArgs("Qiita", "https://qiita.com/feed",
-			&now, true, "RSS", []byte(nil), int64(1)).
+			&now, true, "RSS", []byte(nil), "", int64(1)).
 		WillReturnError(dbError)
 
 	repo := postgres.NewSourceRepo(db)
@@ -1042,8 +1044,8 @@ func TestSourceRepo_Delete_DatabaseError(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer func() { _ = db.Close() }()
 
-	dbError := errors.New("foreign key constraint")
-	mock.ExpectExec(`DELETE FROM sources`).
+	dbError := errors.New("connection lost")
+	mock.ExpectQuery(`UPDATE sources SET deleted_at`).
 		WithArgs(int64(1)).
 		WillReturnError(dbError)
 
@@ -1070,3 +1072,33 @@ func TestSourceRepo_TouchCrawledAt_DatabaseError(t *testing.T) {
 		t.Fatal("TouchCrawledAt should return error for database error")
 	}
 }
+
+// TestSourceRepo_SearchWithFilters_CategoryFilter verifies the category filter follows the other filters
+func TestSourceRepo_SearchWithFilters_CategoryFilter(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	rows := sqlmock.NewRows([]string{
+		"id", "name", "feed_url", "last_crawled_at", "active",
+		"source_type", "scraper_config", "prompt_template",
+	}).AddRow(1, "Go Blog", "https://go.dev/blog/feed.atom", nil, true, "RSS", nil, "")
+
+	active := true
+	categoryID := int64(4)
+	mock.ExpectQuery(regexp.QuoteMeta("id IN (SELECT source_id FROM source_category_members WHERE category_id = $2)")).
+		WithArgs(true, int64(4)).
+		WillReturnRows(rows)
+
+	repo := postgres.NewSourceRepo(db)
+	filters := repository.SourceSearchFilters{Active: &active, CategoryID: &categoryID}
+	sources, err := repo.SearchWithFilters(context.Background(), nil, filters)
+	if err != nil {
+		t.Fatalf("SearchWithFilters err=%v", err)
+	}
+	if len(sources) != 1 {
+		t.Fatalf("expected 1 source, got %d", len(sources))
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
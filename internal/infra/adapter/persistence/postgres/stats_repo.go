@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"catchup-feed/internal/repository"
+)
+
+type StatsRepo struct {
+	db *sql.DB
+}
+
+func NewStatsRepo(db *sql.DB) repository.StatsRepository {
+	return &StatsRepo{db: db}
+}
+
+// NewSummarizeFailureRepo returns the repository the fetch service records failed
+// summarizations to. They are stored in the table StatsRepo aggregates.
+func NewSummarizeFailureRepo(db *sql.DB) repository.SummarizeFailureRepository {
+	return &StatsRepo{db: db}
+}
+
+func (repo *StatsRepo) SourceStats(ctx context.Context, dayStart, weekStart time.Time) ([]repository.SourceStats, error) {
+	const query = `
+SELECT s.id, s.name, s.active, s.last_crawled_at,
+       COUNT(a.id),
+       COUNT(a.id) FILTER (WHERE a.created_at >= $1),
+       COUNT(a.id) FILTER (WHERE a.created_at >= $2),
+       COALESCE(AVG(char_length(a.summary)) FILTER (WHERE a.summary <> ''), 0),
+       MAX(a.created_at),
+       (SELECT COUNT(*) FROM summarize_failures f WHERE f.source_id = s.id AND f.failed_at >= $2)
+FROM sources s
+LEFT JOIN articles a ON a.source_id = s.id AND a.deleted_at IS NULL
+WHERE s.deleted_at IS NULL
+GROUP BY s.id
+ORDER BY s.id`
+	rows, err := repo.db.QueryContext(ctx, query, dayStart, weekStart)
+	if err != nil {
+		return nil, fmt.Errorf("SourceStats: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var stats []repository.SourceStats
+	for rows.Next() {
+		var s repository.SourceStats
+		var lastCrawled, lastArticle sql.NullTime
+		if err := rows.Scan(&s.SourceID, &s.SourceName, &s.Active, &lastCrawled,
+			&s.ArticlesTotal, &s.ArticlesLastDay, &s.ArticlesLastWeek, &s.AvgSummaryLength,
+			&lastArticle, &s.SummarizeFailuresLastWeek); err != nil {
+			return nil, fmt.Errorf("SourceStats: %w", err)
+		}
+		if lastCrawled.Valid {
+			s.LastCrawledAt = &lastCrawled.Time
+		}
+		if lastArticle.Valid {
+			s.LastArticleAt = &lastArticle.Time
+		}
+		stats = append(stats, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("SourceStats: %w", err)
+	}
+	return stats, nil
+}
+
+func (repo *StatsRepo) IngestionSeries(ctx context.Context, interval string, from, to time.Time, sourceID *int64) ([]repository.IngestionBucket, error) {
+	// date_trunc の週は月曜始まり
+	query := `
+SELECT date_trunc($1, created_at AT TIME ZONE 'UTC') AS bucket, COUNT(*)
+FROM articles
+WHERE deleted_at IS NULL AND created_at >= $2 AND created_at < $3`
+	args := []interface{}{interval, from, to}
+	if sourceID != nil {
+		args = append(args, *sourceID)
+		query += fmt.Sprintf(" AND source_id = $%d", len(args))
+	}
+	query += `
+GROUP BY bucket
+ORDER BY bucket`
+
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("IngestionSeries: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var buckets []repository.IngestionBucket
+	for rows.Next() {
+		var b repository.IngestionBucket
+		if err := rows.Scan(&b.Start, &b.Count); err != nil {
+			return nil, fmt.Errorf("IngestionSeries: %w", err)
+		}
+		// bucket は time zone なしの UTC 時刻なので、壁時計のまま UTC として扱う
+		b.Start = time.Date(b.Start.Year(), b.Start.Month(), b.Start.Day(), b.Start.Hour(), 0, 0, 0, time.UTC)
+		buckets = append(buckets, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("IngestionSeries: %w", err)
+	}
+	return buckets, nil
+}
+
+func (repo *StatsRepo) RecordSummarizeFailure(ctx context.Context, sourceID int64, url string) error {
+	const query = `
+WITH pruned AS (DELETE FROM summarize_failures WHERE failed_at < now() - INTERVAL '30 days')
+INSERT INTO summarize_failures (source_id, url) VALUES ($1, $2)`
+	if _, err := repo.db.ExecContext(ctx, query, sourceID, url); err != nil {
+		return fmt.Errorf("RecordSummarizeFailure: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package postgres_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	pg "catchup-feed/internal/infra/adapter/persistence/postgres"
+	"catchup-feed/internal/repository"
+)
+
+func TestStatsRepo_SourceStats(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	dayStart, weekStart := now.Add(-24*time.Hour), now.Add(-7*24*time.Hour)
+	mock.ExpectQuery(regexp.QuoteMeta(`LEFT JOIN articles a ON a.source_id = s.id AND a.deleted_at IS NULL
+WHERE s.deleted_at IS NULL
+GROUP BY s.id`)).
+		WithArgs(dayStart, weekStart).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "active", "last_crawled_at", "total", "day", "week", "avg", "max", "failures"}).
+			AddRow(int64(1), "Go Blog", true, now, int64(40), int64(2), int64(9), 312.5, now, int64(1)).
+			AddRow(int64(2), "Empty", false, nil, int64(0), int64(0), int64(0), 0.0, nil, int64(0)))
+
+	got, err := pg.NewStatsRepo(db).SourceStats(context.Background(), dayStart, weekStart)
+	if err != nil {
+		t.Fatalf("SourceStats err=%v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("len = %d, want 2", len(got))
+	}
+	if s := got[0]; s.SourceName != "Go Blog" || s.ArticlesTotal != 40 || s.ArticlesLastDay != 2 || s.ArticlesLastWeek != 9 ||
+		s.AvgSummaryLength != 312.5 || s.SummarizeFailuresLastWeek != 1 || s.LastArticleAt == nil || s.LastCrawledAt == nil {
+		t.Errorf("stats[0] = %+v", s)
+	}
+	if s := got[1]; s.Active || s.LastArticleAt != nil || s.LastCrawledAt != nil {
+		t.Errorf("stats[1] = %+v", s)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStatsRepo_IngestionSeries(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	from := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 3)
+	sourceID := int64(3)
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE deleted_at IS NULL AND created_at >= $2 AND created_at < $3 AND source_id = $4
+GROUP BY bucket`)).
+		WithArgs(repository.StatsIntervalDay, from, to, sourceID).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket", "count"}).
+			AddRow(time.Date(2025, 6, 2, 0, 0, 0, 0, time.FixedZone("", 0)), int64(5)))
+
+	got, err := pg.NewStatsRepo(db).IngestionSeries(context.Background(), repository.StatsIntervalDay, from, to, &sourceID)
+	if err != nil {
+		t.Fatalf("IngestionSeries err=%v", err)
+	}
+	if len(got) != 1 || !got[0].Start.Equal(from.AddDate(0, 0, 1)) || got[0].Start.Location() != time.UTC || got[0].Count != 5 {
+		t.Errorf("IngestionSeries = %+v", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStatsRepo_RecordSummarizeFailure(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO summarize_failures (source_id, url) VALUES ($1, $2)`)).
+		WithArgs(int64(3), "https://example.com/a").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	if err := pg.NewSummarizeFailureRepo(db).RecordSummarizeFailure(context.Background(), 3, "https://example.com/a"); err != nil {
+		t.Fatalf("RecordSummarizeFailure err=%v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
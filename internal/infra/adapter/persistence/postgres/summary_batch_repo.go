@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+type SummaryBatchRepo struct {
+	db *sql.DB
+}
+
+func NewSummaryBatchRepo(db *sql.DB) repository.SummaryBatchRepository {
+	return &SummaryBatchRepo{db: db}
+}
+
+func (repo *SummaryBatchRepo) ListPendingBatchIDs(ctx context.Context) ([]string, error) {
+	const query = `
+SELECT DISTINCT summary_batch_id
+FROM articles
+WHERE summary_status = $1 AND summary_batch_id <> '' AND deleted_at IS NULL
+ORDER BY summary_batch_id`
+	rows, err := repo.db.QueryContext(ctx, query, entity.SummaryStatusPending)
+	if err != nil {
+		return nil, fmt.Errorf("ListPendingBatchIDs: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("ListPendingBatchIDs: Scan: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (repo *SummaryBatchRepo) ListPendingByBatch(ctx context.Context, batchID string) ([]*entity.Article, error) {
+	const query = `
+SELECT id, source_id, title, url, summary, published_at, created_at, summary_structured, prompt_version, summary_status, summary_batch_id, summary_model, injection_flags
+FROM articles
+WHERE summary_status = $1 AND summary_batch_id = $2 AND deleted_at IS NULL
+ORDER BY id`
+	rows, err := repo.db.QueryContext(ctx, query, entity.SummaryStatusPending, batchID)
+	if err != nil {
+		return nil, fmt.Errorf("ListPendingByBatch: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var articles []*entity.Article
+	for rows.Next() {
+		var row articleRow
+		if err := rows.Scan(row.dest()...); err != nil {
+			return nil, fmt.Errorf("ListPendingByBatch: Scan: %w", err)
+		}
+		articles = append(articles, row.toEntity())
+	}
+	return articles, rows.Err()
+}
+
+// DiscardPending deletes the pending article without moving it to the trash or
+// leaving a tombstone, so that ExistsByURLBatch no longer reports its URL.
+func (repo *SummaryBatchRepo) DiscardPending(ctx context.Context, id int64) error {
+	const query = `DELETE FROM articles WHERE id = $1 AND summary_status = $2`
+	res, err := repo.db.ExecContext(ctx, query, id, entity.SummaryStatusPending)
+	if err != nil {
+		return fmt.Errorf("DiscardPending: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("DiscardPending: no rows affected")
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+
+	"catchup-feed/internal/domain/entity"
+	pg "catchup-feed/internal/infra/adapter/persistence/postgres"
+)
+
+func TestSummaryBatchRepo_ListPendingBatchIDs(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery("SELECT DISTINCT summary_batch_id(.|\n)*deleted_at IS NULL").
+		WithArgs(entity.SummaryStatusPending).
+		WillReturnRows(sqlmock.NewRows([]string{"summary_batch_id"}).
+			AddRow("msgbatch_a").
+			AddRow("msgbatch_b"))
+
+	repo := pg.NewSummaryBatchRepo(db)
+	got, err := repo.ListPendingBatchIDs(context.Background())
+	if err != nil {
+		t.Fatalf("ListPendingBatchIDs err=%v", err)
+	}
+	if diff := cmp.Diff([]string{"msgbatch_a", "msgbatch_b"}, got); diff != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestSummaryBatchRepo_ListPendingBatchIDs_Error(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery("SELECT DISTINCT summary_batch_id").
+		WillReturnError(errors.New("connection refused"))
+
+	repo := pg.NewSummaryBatchRepo(db)
+	if _, err := repo.ListPendingBatchIDs(context.Background()); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestSummaryBatchRepo_ListPendingByBatch(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("FROM articles(.|\n)*deleted_at IS NULL").
+		WithArgs(entity.SummaryStatusPending, "msgbatch_a").
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "source_id", "title", "url",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags",
+		}).AddRow(
+			int64(7), int64(2), "title", "https://example.com/7",
+			"", now, now, nil, "default@0123abcd", entity.SummaryStatusPending, "msgbatch_a", "", "",
+		))
+
+	repo := pg.NewSummaryBatchRepo(db)
+	got, err := repo.ListPendingByBatch(context.Background(), "msgbatch_a")
+	if err != nil {
+		t.Fatalf("ListPendingByBatch err=%v", err)
+	}
+	want := []*entity.Article{{
+		ID: 7, SourceID: 2, Title: "title", URL: "https://example.com/7",
+		PublishedAt: now, CreatedAt: now, PromptVersion: "default@0123abcd",
+		SummaryStatus: entity.SummaryStatusPending, SummaryBatchID: "msgbatch_a",
+	}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestSummaryBatchRepo_DiscardPending(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	// ゴミ箱に移さず物理削除する
+	mock.ExpectExec("DELETE FROM articles").
+		WithArgs(int64(7), entity.SummaryStatusPending).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := pg.NewSummaryBatchRepo(db)
+	if err := repo.DiscardPending(context.Background(), 7); err != nil {
+		t.Fatalf("DiscardPending err=%v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestSummaryBatchRepo_DiscardPending_NotPending(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec("DELETE FROM articles").
+		WithArgs(int64(7), entity.SummaryStatusPending).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := pg.NewSummaryBatchRepo(db)
+	if err := repo.DiscardPending(context.Background(), 7); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestArticleRepo_Create_Pending(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Now()
+	mock.ExpectQuery("INSERT INTO articles").
+		WithArgs(int64(2), "title", "https://u", "", now, now, nil, "",
+			entity.SummaryStatusPending, "", "", "").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)))
+
+	repo := pg.NewArticleRepo(db)
+	err := repo.Create(context.Background(), &entity.Article{
+		SourceID: 2, Title: "title", URL: "https://u",
+		PublishedAt: now, CreatedAt: now,
+		SummaryStatus: entity.SummaryStatusPending,
+	})
+	if err != nil {
+		t.Fatalf("Create err=%v", err)
+	}
+}
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+type TagRepo struct {
+	db *sql.DB
+}
+
+func NewTagRepo(db *sql.DB) repository.TagRepository {
+	return &TagRepo{db: db}
+}
+
+func (repo *TagRepo) AddArticleTags(ctx context.Context, articleID int64, names []string, origin string) error {
+	const insertTag = `INSERT INTO tags (name) VALUES ($1) ON CONFLICT (name) DO NOTHING`
+	const insertArticleTag = `
+INSERT INTO article_tags (article_id, tag_id, origin)
+SELECT $1, id, $2 FROM tags WHERE name = $3
+ON CONFLICT (article_id, tag_id) DO NOTHING`
+
+	// 各文は冪等なため、途中で失敗しても再実行で整合する
+	for _, name := range names {
+		if _, err := repo.db.ExecContext(ctx, insertTag, name); err != nil {
+			return fmt.Errorf("AddArticleTags: insert tag: %w", err)
+		}
+		if _, err := repo.db.ExecContext(ctx, insertArticleTag, articleID, origin, name); err != nil {
+			return fmt.Errorf("AddArticleTags: insert article tag: %w", err)
+		}
+	}
+	return nil
+}
+
+func (repo *TagRepo) ListTagCounts(ctx context.Context) ([]repository.TagCount, error) {
+	const query = `
+SELECT t.name, COUNT(*) AS article_count
+FROM tags t
+INNER JOIN article_tags atg ON atg.tag_id = t.id
+INNER JOIN articles a ON a.id = atg.article_id AND a.deleted_at IS NULL
+GROUP BY t.name
+ORDER BY article_count DESC, t.name`
+	rows, err := repo.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("ListTagCounts: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var counts []repository.TagCount
+	for rows.Next() {
+		var c repository.TagCount
+		if err := rows.Scan(&c.Name, &c.Count); err != nil {
+			return nil, fmt.Errorf("ListTagCounts: Scan: %w", err)
+		}
+		counts = append(counts, c)
+	}
+	return counts, rows.Err()
+}
+
+type TagRuleRepo struct {
+	db *sql.DB
+}
+
+func NewTagRuleRepo(db *sql.DB) repository.TagRuleRepository {
+	return &TagRuleRepo{db: db}
+}
+
+func (repo *TagRuleRepo) ListRules(ctx context.Context) ([]*entity.TagRule, error) {
+	const query = `
+SELECT id, tag, pattern, match_type, created_at
+FROM tag_rules
+ORDER BY id`
+	rows, err := repo.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("ListRules: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var rules []*entity.TagRule
+	for rows.Next() {
+		var r entity.TagRule
+		if err := rows.Scan(&r.ID, &r.Tag, &r.Pattern, &r.MatchType, &r.CreatedAt); err != nil {
+			return nil, fmt.Errorf("ListRules: Scan: %w", err)
+		}
+		rules = append(rules, &r)
+	}
+	return rules, rows.Err()
+}
+
+func (repo *TagRuleRepo) CreateRule(ctx context.Context, rule *entity.TagRule) error {
+	const query = `
+INSERT INTO tag_rules (tag, pattern, match_type)
+VALUES ($1, $2, $3)
+RETURNING id, created_at`
+	err := repo.db.QueryRowContext(ctx, query, rule.Tag, rule.Pattern, rule.MatchType).
+		Scan(&rule.ID, &rule.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("CreateRule: %w", err)
+	}
+	return nil
+}
+
+func (repo *TagRuleRepo) DeleteRule(ctx context.Context, id int64) (bool, error) {
+	const query = `DELETE FROM tag_rules WHERE id = $1`
+	res, err := repo.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return false, fmt.Errorf("DeleteRule: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("DeleteRule: RowsAffected: %w", err)
+	}
+	return n > 0, nil
+}
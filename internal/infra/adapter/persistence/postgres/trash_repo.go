@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"catchup-feed/internal/repository"
+)
+
+type TrashRepo struct {
+	db *sql.DB
+}
+
+func NewTrashRepo(db *sql.DB) repository.TrashRepository {
+	return &TrashRepo{db: db}
+}
+
+func (repo *TrashRepo) ListTrashedArticles(ctx context.Context, offset, limit int) ([]repository.TrashedArticle, error) {
+	const query = `
+SELECT ` + articleWithSourceColumns + `, a.deleted_at, s.deleted_at IS NOT NULL
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+WHERE a.deleted_at IS NOT NULL
+ORDER BY a.deleted_at DESC, a.id DESC
+LIMIT $1 OFFSET $2`
+	rows, err := repo.db.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("ListTrashedArticles: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	result := make([]repository.TrashedArticle, 0, limit)
+	for rows.Next() {
+		var row articleRow
+		var t repository.TrashedArticle
+		if err := rows.Scan(row.dest(&t.SourceName, &t.DeletedAt, &t.SourceDeleted)...); err != nil {
+			return nil, fmt.Errorf("ListTrashedArticles: Scan: %w", err)
+		}
+		t.Article = row.toEntity()
+		result = append(result, t)
+	}
+	return result, rows.Err()
+}
+
+func (repo *TrashRepo) CountTrashedArticles(ctx context.Context) (int64, error) {
+	var count int64
+	if err := repo.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM articles WHERE deleted_at IS NOT NULL`).Scan(&count); err != nil {
+		return 0, fmt.Errorf("CountTrashedArticles: %w", err)
+	}
+	return count, nil
+}
+
+func (repo *TrashRepo) ListTrashedSources(ctx context.Context) ([]repository.TrashedSource, error) {
+	const query = `
+SELECT s.id, s.name, s.feed_url, s.last_crawled_at, s.active, s.source_type, s.scraper_config, s.prompt_template, s.deleted_at,
+       (SELECT COUNT(*) FROM articles a WHERE a.source_id = s.id AND a.deleted_at = s.deleted_at)
+FROM sources s
+WHERE s.deleted_at IS NOT NULL
+ORDER BY s.deleted_at DESC, s.id DESC`
+	rows, err := repo.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("ListTrashedSources: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var result []repository.TrashedSource
+	for rows.Next() {
+		var t repository.TrashedSource
+		source, err := scanSource(rows, &t.DeletedAt, &t.ArticleCount)
+		if err != nil {
+			return nil, fmt.Errorf("ListTrashedSources: %w", err)
+		}
+		t.Source = source
+		result = append(result, t)
+	}
+	return result, rows.Err()
+}
+
+func (repo *TrashRepo) RestoreArticle(ctx context.Context, id int64) (repository.RestoreResult, error) {
+	// ソースがゴミ箱にある記事は戻さない（ソースの復元で戻す）
+	const query = `
+WITH target AS (
+SELECT a.id, s.deleted_at IS NOT NULL AS source_deleted
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+WHERE a.id = $1 AND a.deleted_at IS NOT NULL
+), restored AS (
+UPDATE articles SET deleted_at = NULL
+WHERE id IN (SELECT id FROM target WHERE NOT source_deleted)
+)
+SELECT source_deleted FROM target`
+	var sourceDeleted bool
+	err := repo.db.QueryRowContext(ctx, query, id).Scan(&sourceDeleted)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.RestoreNotFound, nil
+	}
+	if err != nil {
+		return repository.RestoreNotFound, fmt.Errorf("RestoreArticle: %w", err)
+	}
+	if sourceDeleted {
+		return repository.RestoreSourceDeleted, nil
+	}
+	return repository.Restored, nil
+}
+
+func (repo *TrashRepo) RestoreSource(ctx context.Context, id int64) (repository.RestoreResult, error) {
+	// ソースと同時に削除された記事（deleted_at が同じ記事）だけを戻す
+	const query = `
+WITH target AS (
+SELECT id, deleted_at FROM sources WHERE id = $1 AND deleted_at IS NOT NULL
+), restored_articles AS (
+UPDATE articles a SET deleted_at = NULL
+FROM target t
+WHERE a.source_id = t.id AND a.deleted_at = t.deleted_at
+)
+UPDATE sources s SET deleted_at = NULL
+FROM target t
+WHERE s.id = t.id`
+	res, err := repo.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return repository.RestoreNotFound, fmt.Errorf("RestoreSource: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return repository.RestoreNotFound, fmt.Errorf("RestoreSource: RowsAffected: %w", err)
+	}
+	if n == 0 {
+		return repository.RestoreNotFound, nil
+	}
+	return repository.Restored, nil
+}
+
+func (repo *TrashRepo) Purge(ctx context.Context, before time.Time) (repository.PurgeResult, error) {
+	// 完全削除した記事の URL は article_tombstones に残し、クロールで再取得しない
+	const purgeArticles = `
+WITH purged AS (
+DELETE FROM articles
+WHERE deleted_at < $1
+   OR source_id IN (SELECT id FROM sources WHERE deleted_at < $1)
+RETURNING url
+), tombstones AS (
+INSERT INTO article_tombstones (url)
+SELECT url FROM purged WHERE url IS NOT NULL
+ON CONFLICT (url) DO NOTHING
+)
+SELECT COUNT(*) FROM purged`
+	const purgeSources = `DELETE FROM sources WHERE deleted_at < $1`
+
+	var result repository.PurgeResult
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return result, fmt.Errorf("Purge: BeginTx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := tx.QueryRowContext(ctx, purgeArticles, before).Scan(&result.Articles); err != nil {
+		return repository.PurgeResult{}, fmt.Errorf("Purge: articles: %w", err)
+	}
+	res, err := tx.ExecContext(ctx, purgeSources, before)
+	if err != nil {
+		return repository.PurgeResult{}, fmt.Errorf("Purge: sources: %w", err)
+	}
+	if result.Sources, err = res.RowsAffected(); err != nil {
+		return repository.PurgeResult{}, fmt.Errorf("Purge: RowsAffected: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return repository.PurgeResult{}, fmt.Errorf("Purge: Commit: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,179 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	pg "catchup-feed/internal/infra/adapter/persistence/postgres"
+	"catchup-feed/internal/repository"
+)
+
+func TestTrashRepo_ListTrashedArticles(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE a.deleted_at IS NOT NULL
+ORDER BY a.deleted_at DESC, a.id DESC
+LIMIT $1 OFFSET $2`)).
+		WithArgs(20, 40).
+		WillReturnRows(sqlmock.NewRows(append(articleWithSourceColumnNames, "deleted_at", "source_deleted")).
+			AddRow(int64(7), int64(3), "Go 1.25", "https://go.dev/blog/go1.25", "summary", now, now, nil, "", "", "", "", "", "Go Blog", now, true))
+
+	got, err := pg.NewTrashRepo(db).ListTrashedArticles(context.Background(), 40, 20)
+	if err != nil {
+		t.Fatalf("ListTrashedArticles err=%v", err)
+	}
+	if len(got) != 1 || got[0].Article.ID != 7 || got[0].SourceName != "Go Blog" || !got[0].DeletedAt.Equal(now) || !got[0].SourceDeleted {
+		t.Errorf("ListTrashedArticles = %+v", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTrashRepo_ListTrashedSources(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`(SELECT COUNT(*) FROM articles a WHERE a.source_id = s.id AND a.deleted_at = s.deleted_at)
+FROM sources s
+WHERE s.deleted_at IS NOT NULL`)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "name", "feed_url", "last_crawled_at", "active", "source_type", "scraper_config", "prompt_template", "deleted_at", "count",
+		}).AddRow(int64(3), "Go Blog", "https://go.dev/blog/feed.atom", nil, true, "RSS", nil, "", now, int64(12)))
+
+	got, err := pg.NewTrashRepo(db).ListTrashedSources(context.Background())
+	if err != nil {
+		t.Fatalf("ListTrashedSources err=%v", err)
+	}
+	if len(got) != 1 || got[0].Source.ID != 3 || got[0].Source.Name != "Go Blog" || !got[0].DeletedAt.Equal(now) || got[0].ArticleCount != 12 {
+		t.Errorf("ListTrashedSources = %+v", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTrashRepo_RestoreArticle(t *testing.T) {
+	tests := []struct {
+		name string
+		rows *sqlmock.Rows
+		want repository.RestoreResult
+	}{
+		{name: "restored", rows: sqlmock.NewRows([]string{"source_deleted"}).AddRow(false), want: repository.Restored},
+		{name: "source in trash", rows: sqlmock.NewRows([]string{"source_deleted"}).AddRow(true), want: repository.RestoreSourceDeleted},
+		{name: "not in trash", rows: sqlmock.NewRows([]string{"source_deleted"}), want: repository.RestoreNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, _ := sqlmock.New()
+			defer func() { _ = db.Close() }()
+
+			mock.ExpectQuery(regexp.QuoteMeta(`UPDATE articles SET deleted_at = NULL
+WHERE id IN (SELECT id FROM target WHERE NOT source_deleted)`)).
+				WithArgs(int64(7)).
+				WillReturnRows(tt.rows)
+
+			got, err := pg.NewTrashRepo(db).RestoreArticle(context.Background(), 7)
+			if err != nil {
+				t.Fatalf("RestoreArticle err=%v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RestoreArticle = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestTrashRepo_RestoreSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		want     repository.RestoreResult
+	}{
+		{name: "restored", affected: 1, want: repository.Restored},
+		{name: "not in trash", affected: 0, want: repository.RestoreNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, _ := sqlmock.New()
+			defer func() { _ = db.Close() }()
+
+			// ソースと同時に削除された記事だけを戻す
+			mock.ExpectExec(regexp.QuoteMeta(`WHERE a.source_id = t.id AND a.deleted_at = t.deleted_at`)).
+				WithArgs(int64(3)).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			got, err := pg.NewTrashRepo(db).RestoreSource(context.Background(), 3)
+			if err != nil {
+				t.Fatalf("RestoreSource err=%v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RestoreSource = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestTrashRepo_Purge(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	before := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO article_tombstones (url)
+SELECT url FROM purged WHERE url IS NOT NULL
+ON CONFLICT (url) DO NOTHING`)).
+		WithArgs(before).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(5)))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM sources WHERE deleted_at < $1`)).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	got, err := pg.NewTrashRepo(db).Purge(context.Background(), before)
+	if err != nil {
+		t.Fatalf("Purge err=%v", err)
+	}
+	if got != (repository.PurgeResult{Articles: 5, Sources: 1}) {
+		t.Errorf("Purge = %+v, want 5 articles and 1 source", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTrashRepo_Purge_RollsBackOnError(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	before := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`DELETE FROM articles`).
+		WithArgs(before).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(5)))
+	mock.ExpectExec(`DELETE FROM sources`).
+		WithArgs(before).
+		WillReturnError(errors.New("connection lost"))
+	mock.ExpectRollback()
+
+	if _, err := pg.NewTrashRepo(db).Purge(context.Background(), before); err == nil {
+		t.Fatal("Purge should return error")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,42 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"catchup-feed/internal/repository"
+)
+
+type ArticleContentRepo struct {
+	db *sql.DB
+}
+
+func NewArticleContentRepo(db *sql.DB) repository.ArticleContentRepository {
+	return &ArticleContentRepo{db: db}
+}
+
+func (repo *ArticleContentRepo) SaveContent(ctx context.Context, articleID int64, content string) error {
+	const query = `
+INSERT INTO article_contents (article_id, content, fetched_at)
+VALUES (?, ?, CURRENT_TIMESTAMP)
+ON CONFLICT (article_id) DO UPDATE SET content = EXCLUDED.content, fetched_at = EXCLUDED.fetched_at`
+	if _, err := repo.db.ExecContext(ctx, query, articleID, content); err != nil {
+		return fmt.Errorf("SaveContent: ExecContext: %w", err)
+	}
+	return nil
+}
+
+func (repo *ArticleContentRepo) GetContent(ctx context.Context, articleID int64) (string, error) {
+	const query = `SELECT content FROM article_contents WHERE article_id = ?`
+	var content string
+	err := repo.db.QueryRowContext(ctx, query, articleID).Scan(&content)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("GetContent: QueryRowContext: %w", err)
+	}
+	return content, nil
+}
@@ -0,0 +1,57 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"catchup-feed/internal/repository"
+)
+
+type ArticleExportRepo struct {
+	db           *sql.DB
+	queryBuilder *ArticleQueryBuilder
+}
+
+func NewArticleExportRepo(db *sql.DB) repository.ArticleExportRepository {
+	return &ArticleExportRepo{
+		db:           db,
+		queryBuilder: NewArticleQueryBuilder(),
+	}
+}
+
+func (repo *ArticleExportRepo) EachArticle(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters, limit int, fn func(repository.ArticleWithSource) error) error {
+	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters, "a")
+	args = append(args, limit)
+
+	// #nosec G202 -- whereClause is generated by QueryBuilder using parameterized placeholders (?)
+	query := `
+SELECT ` + articleWithSourceColumns + `
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+` + andCondition(whereClause, "a.deleted_at IS NULL") + `
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT ?`
+
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("EachArticle: QueryContext: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	// 1行ずつ読み出して渡す。全件をメモリに載せない
+	for rows.Next() {
+		var row articleRow
+		var sourceName string
+		if err := rows.Scan(row.dest(&sourceName)...); err != nil {
+			return fmt.Errorf("EachArticle: Scan: %w", err)
+		}
+		if err := fn(repository.ArticleWithSource{Article: row.toEntity(), SourceName: sourceName}); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("EachArticle: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package sqlite_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"catchup-feed/internal/infra/adapter/persistence/sqlite"
+	"catchup-feed/internal/repository"
+)
+
+func TestArticleExportRepo_EachArticle(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE (a.title LIKE ? OR a.summary LIKE ?) AND a.deleted_at IS NULL
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT ?`)).
+		WithArgs("%go%", "%go%", 1000).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	calls := 0
+	err := sqlite.NewArticleExportRepo(db).EachArticle(context.Background(), []string{"go"}, repository.ArticleSearchFilters{}, 1000,
+		func(repository.ArticleWithSource) error {
+			calls++
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("EachArticle err=%v", err)
+	}
+	if calls != 0 {
+		t.Errorf("calls = %d, want 0", calls)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
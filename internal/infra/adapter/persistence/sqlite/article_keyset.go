@@ -0,0 +1,102 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"catchup-feed/internal/pkg/search"
+	"catchup-feed/internal/repository"
+)
+
+// Compile-time check that ArticleRepo supports keyset pagination.
+var _ repository.ArticleKeysetRepository = (*ArticleRepo)(nil)
+
+// ListWithSourceAfter retrieves up to limit articles with source names that come after
+// the given position in (published_at DESC, id DESC) order.
+func (repo *ArticleRepo) ListWithSourceAfter(ctx context.Context, after *repository.ArticleKeyset, limit int) ([]repository.ArticleWithSource, error) {
+	whereClause, args := keysetCondition("WHERE a.deleted_at IS NULL", after)
+	args = append(args, limit)
+
+	// #nosec G202 -- whereClause only contains parameterized placeholders (?)
+	query := `
+SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+` + whereClause + `
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT ?`
+
+	return queryWithSource(ctx, repo.db, "ListWithSourceAfter", query, args, limit)
+}
+
+// SearchWithFiltersAfter searches articles like SearchWithFiltersPaginated, but pages
+// with a keyset position instead of OFFSET.
+func (repo *ArticleRepo) SearchWithFiltersAfter(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters, after *repository.ArticleKeyset, limit int) ([]repository.ArticleWithSource, error) {
+	// No keywords and no filters -> return empty result
+	if len(keywords) == 0 && filters.Empty() {
+		return []repository.ArticleWithSource{}, nil
+	}
+
+	// Apply search timeout to prevent long-running queries
+	ctx, cancel := context.WithTimeout(ctx, search.DefaultSearchTimeout)
+	defer cancel()
+
+	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters, "a")
+	whereClause, args = keysetCondition(andCondition(whereClause, "a.deleted_at IS NULL"), after, args...)
+	args = append(args, limit)
+
+	// #nosec G202 -- whereClause is generated by QueryBuilder and keysetCondition using parameterized placeholders (?)
+	query := `
+SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+` + whereClause + `
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT ?`
+
+	return queryWithSource(ctx, repo.db, "SearchWithFiltersAfter", query, args, limit)
+}
+
+// keysetCondition appends the keyset condition for after to whereClause (which is
+// empty or starts with WHERE). The comparison is spelled out instead of using row
+// values so that it also works on SQLite versions without row value support.
+// Returns whereClause and args unchanged when after is nil.
+func keysetCondition(whereClause string, after *repository.ArticleKeyset, args ...interface{}) (string, []interface{}) {
+	if after == nil {
+		return whereClause, args
+	}
+	const cond = "(a.published_at < ? OR (a.published_at = ? AND a.id < ?))"
+	if whereClause == "" {
+		whereClause = "WHERE " + cond
+	} else {
+		whereClause += " AND " + cond
+	}
+	return whereClause, append(args, after.PublishedAt, after.PublishedAt, after.ID)
+}
+
+// queryWithSource runs a query selecting article columns followed by the source name.
+func queryWithSource(ctx context.Context, db *sql.DB, op, query string, args []interface{}, limit int) ([]repository.ArticleWithSource, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: QueryContext: %w", op, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	result := make([]repository.ArticleWithSource, 0, limit)
+	for rows.Next() {
+		var row articleRow
+		var sourceName string
+		if err := rows.Scan(row.dest(&sourceName)...); err != nil {
+			return nil, fmt.Errorf("%s: Scan: %w", op, err)
+		}
+		result = append(result, repository.ArticleWithSource{
+			Article:    row.toEntity(),
+			SourceName: sourceName,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows.Err: %w", op, err)
+	}
+	return result, nil
+}
@@ -17,35 +17,54 @@ func NewArticleQueryBuilder() *ArticleQueryBuilder {
 }
 
 // BuildWhereClause builds WHERE clause and arguments for article search.
-// It supports multi-keyword AND logic and optional filters (source_id, date range).
+// It supports multi-keyword AND logic and optional filters (source_id, category, date range, tags).
 // Returns empty string if no conditions are provided.
-func (qb *ArticleQueryBuilder) BuildWhereClause(keywords []string, filters repository.ArticleSearchFilters) (clause string, args []interface{}) {
+// The column names are prefixed with tableAlias when it is not empty.
+func (qb *ArticleQueryBuilder) BuildWhereClause(keywords []string, filters repository.ArticleSearchFilters, tableAlias string) (clause string, args []interface{}) {
 	var conditions []string
+	col := func(name string) string {
+		if tableAlias == "" {
+			return name
+		}
+		return tableAlias + "." + name
+	}
 
 	// Add keyword conditions (multi-keyword AND logic)
 	// Each keyword searches in both title and summary
 	for _, keyword := range keywords {
 		likePattern := "%" + keyword + "%"
-		conditions = append(conditions, "(title LIKE ? OR summary LIKE ?)")
+		conditions = append(conditions, "("+col("title")+" LIKE ? OR "+col("summary")+" LIKE ?)")
 		args = append(args, likePattern, likePattern)
 	}
 
 	// Add source ID filter
 	if filters.SourceID != nil {
-		conditions = append(conditions, "source_id = ?")
+		conditions = append(conditions, col("source_id")+" = ?")
 		args = append(args, *filters.SourceID)
 	}
 
+	// Add category filter (articles of the sources in the category)
+	if filters.CategoryID != nil {
+		conditions = append(conditions, col("source_id")+" IN (SELECT scm.source_id FROM source_category_members scm WHERE scm.category_id = ?)")
+		args = append(args, *filters.CategoryID)
+	}
+
 	// Add date range filters
 	if filters.From != nil {
-		conditions = append(conditions, "published_at >= ?")
+		conditions = append(conditions, col("published_at")+" >= ?")
 		args = append(args, *filters.From)
 	}
 	if filters.To != nil {
-		conditions = append(conditions, "published_at <= ?")
+		conditions = append(conditions, col("published_at")+" <= ?")
 		args = append(args, *filters.To)
 	}
 
+	// Add tag filters (the article must have every tag)
+	for _, tag := range filters.Tags {
+		conditions = append(conditions, col("id")+" IN (SELECT atg.article_id FROM article_tags atg INNER JOIN tags tg ON tg.id = atg.tag_id WHERE tg.name = ?)")
+		args = append(args, tag)
+	}
+
 	// Return empty if no conditions
 	if len(conditions) == 0 {
 		return "", args
@@ -1,6 +1,7 @@
 package sqlite_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -16,7 +17,7 @@ func TestQueryBuilder_BuildWhereClause_KeywordOnly(t *testing.T) {
 	qb := sqlite.NewArticleQueryBuilder()
 
 	// Test single keyword
-	clause, args := qb.BuildWhereClause([]string{"golang"}, repository.ArticleSearchFilters{})
+	clause, args := qb.BuildWhereClause([]string{"golang"}, repository.ArticleSearchFilters{}, "")
 
 	expectedClause := "WHERE (title LIKE ? OR summary LIKE ?)"
 	if clause != expectedClause {
@@ -37,7 +38,7 @@ func TestQueryBuilder_BuildWhereClause_MultipleKeywords(t *testing.T) {
 	qb := sqlite.NewArticleQueryBuilder()
 
 	// Test multiple keywords (AND logic)
-	clause, args := qb.BuildWhereClause([]string{"golang", "testing"}, repository.ArticleSearchFilters{})
+	clause, args := qb.BuildWhereClause([]string{"golang", "testing"}, repository.ArticleSearchFilters{}, "")
 
 	expectedClause := "WHERE (title LIKE ? OR summary LIKE ?) AND (title LIKE ? OR summary LIKE ?)"
 	if clause != expectedClause {
@@ -66,7 +67,7 @@ func TestQueryBuilder_BuildWhereClause_SourceIDOnly(t *testing.T) {
 		SourceID: &sourceID,
 	}
 
-	clause, args := qb.BuildWhereClause([]string{}, filters)
+	clause, args := qb.BuildWhereClause([]string{}, filters, "")
 
 	expectedClause := "WHERE source_id = ?"
 	if clause != expectedClause {
@@ -94,7 +95,7 @@ func TestQueryBuilder_BuildWhereClause_DateRangeOnly(t *testing.T) {
 		To:   &to,
 	}
 
-	clause, args := qb.BuildWhereClause([]string{}, filters)
+	clause, args := qb.BuildWhereClause([]string{}, filters, "")
 
 	expectedClause := "WHERE published_at >= ? AND published_at <= ?"
 	if clause != expectedClause {
@@ -123,7 +124,7 @@ func TestQueryBuilder_BuildWhereClause_FromDateOnly(t *testing.T) {
 		From: &from,
 	}
 
-	clause, args := qb.BuildWhereClause([]string{}, filters)
+	clause, args := qb.BuildWhereClause([]string{}, filters, "")
 
 	expectedClause := "WHERE published_at >= ?"
 	if clause != expectedClause {
@@ -146,7 +147,7 @@ func TestQueryBuilder_BuildWhereClause_ToDateOnly(t *testing.T) {
 		To: &to,
 	}
 
-	clause, args := qb.BuildWhereClause([]string{}, filters)
+	clause, args := qb.BuildWhereClause([]string{}, filters, "")
 
 	expectedClause := "WHERE published_at <= ?"
 	if clause != expectedClause {
@@ -173,7 +174,7 @@ func TestQueryBuilder_BuildWhereClause_AllFilters(t *testing.T) {
 		To:       &to,
 	}
 
-	clause, args := qb.BuildWhereClause([]string{"golang", "api"}, filters)
+	clause, args := qb.BuildWhereClause([]string{"golang", "api"}, filters, "")
 
 	expectedClause := "WHERE (title LIKE ? OR summary LIKE ?) AND (title LIKE ? OR summary LIKE ?) AND source_id = ? AND published_at >= ? AND published_at <= ?"
 	if clause != expectedClause {
@@ -207,7 +208,7 @@ func TestQueryBuilder_BuildWhereClause_EmptyFilters(t *testing.T) {
 	qb := sqlite.NewArticleQueryBuilder()
 
 	// No keywords, no filters -> returns empty string
-	clause, args := qb.BuildWhereClause([]string{}, repository.ArticleSearchFilters{})
+	clause, args := qb.BuildWhereClause([]string{}, repository.ArticleSearchFilters{}, "")
 
 	if clause != "" {
 		t.Errorf("clause = %q, want empty string", clause)
@@ -241,7 +242,7 @@ func TestQueryBuilder_BuildWhereClause_SpecialCharacters(t *testing.T) {
 
 			qb := sqlite.NewArticleQueryBuilder()
 
-			clause, args := qb.BuildWhereClause([]string{tt.keyword}, repository.ArticleSearchFilters{})
+			clause, args := qb.BuildWhereClause([]string{tt.keyword}, repository.ArticleSearchFilters{}, "")
 
 			expectedClause := "WHERE (title LIKE ? OR summary LIKE ?)"
 			if clause != expectedClause {
@@ -264,7 +265,7 @@ func TestQueryBuilder_BuildWhereClause_EmptyKeyword(t *testing.T) {
 	qb := sqlite.NewArticleQueryBuilder()
 
 	// Empty string keyword should still generate WHERE clause
-	clause, args := qb.BuildWhereClause([]string{""}, repository.ArticleSearchFilters{})
+	clause, args := qb.BuildWhereClause([]string{""}, repository.ArticleSearchFilters{}, "")
 
 	expectedClause := "WHERE (title LIKE ? OR summary LIKE ?)"
 	if clause != expectedClause {
@@ -287,7 +288,7 @@ func TestQueryBuilder_BuildWhereClause_WhitespaceKeyword(t *testing.T) {
 	qb := sqlite.NewArticleQueryBuilder()
 
 	// Whitespace keyword
-	clause, args := qb.BuildWhereClause([]string{"   "}, repository.ArticleSearchFilters{})
+	clause, args := qb.BuildWhereClause([]string{"   "}, repository.ArticleSearchFilters{}, "")
 
 	expectedClause := "WHERE (title LIKE ? OR summary LIKE ?)"
 	if clause != expectedClause {
@@ -307,7 +308,7 @@ func TestQueryBuilder_BuildWhereClause_ManyKeywords(t *testing.T) {
 
 	// Test with 5 keywords
 	keywords := []string{"golang", "testing", "api", "database", "performance"}
-	clause, args := qb.BuildWhereClause(keywords, repository.ArticleSearchFilters{})
+	clause, args := qb.BuildWhereClause(keywords, repository.ArticleSearchFilters{}, "")
 
 	// Should have 5 conditions joined with AND
 	expectedClause := "WHERE (title LIKE ? OR summary LIKE ?) AND (title LIKE ? OR summary LIKE ?) AND (title LIKE ? OR summary LIKE ?) AND (title LIKE ? OR summary LIKE ?) AND (title LIKE ? OR summary LIKE ?)"
@@ -333,7 +334,7 @@ func TestQueryBuilder_BuildWhereClause_NilFilters(t *testing.T) {
 		To:       nil,
 	}
 
-	clause, args := qb.BuildWhereClause([]string{"test"}, filters)
+	clause, args := qb.BuildWhereClause([]string{"test"}, filters, "")
 
 	// Should only have keyword condition
 	expectedClause := "WHERE (title LIKE ? OR summary LIKE ?)"
@@ -345,3 +346,80 @@ func TestQueryBuilder_BuildWhereClause_NilFilters(t *testing.T) {
 		t.Fatalf("args length = %d, want 2", len(args))
 	}
 }
+
+func TestQueryBuilder_BuildWhereClause_TagFilters(t *testing.T) {
+	t.Parallel()
+
+	qb := sqlite.NewArticleQueryBuilder()
+
+	filters := repository.ArticleSearchFilters{Tags: []string{"go", "release"}}
+	clause, args := qb.BuildWhereClause([]string{"api"}, filters, "")
+
+	expectedClause := "WHERE (title LIKE ? OR summary LIKE ?)" +
+		" AND id IN (SELECT atg.article_id FROM article_tags atg INNER JOIN tags tg ON tg.id = atg.tag_id WHERE tg.name = ?)" +
+		" AND id IN (SELECT atg.article_id FROM article_tags atg INNER JOIN tags tg ON tg.id = atg.tag_id WHERE tg.name = ?)"
+	if clause != expectedClause {
+		t.Errorf("clause = %q, want %q", clause, expectedClause)
+	}
+
+	expectedArgs := []interface{}{"%api%", "%api%", "go", "release"}
+	if len(args) != len(expectedArgs) {
+		t.Fatalf("args length = %d, want %d", len(args), len(expectedArgs))
+	}
+	for i, arg := range args {
+		if arg != expectedArgs[i] {
+			t.Errorf("args[%d] = %v, want %v", i, arg, expectedArgs[i])
+		}
+	}
+}
+
+func TestQueryBuilder_BuildWhereClause_CategoryFilter(t *testing.T) {
+	t.Parallel()
+
+	qb := sqlite.NewArticleQueryBuilder()
+
+	categoryID := int64(4)
+	filters := repository.ArticleSearchFilters{CategoryID: &categoryID}
+	clause, args := qb.BuildWhereClause([]string{"api"}, filters, "")
+
+	expectedClause := "WHERE (title LIKE ? OR summary LIKE ?)" +
+		" AND source_id IN (SELECT scm.source_id FROM source_category_members scm WHERE scm.category_id = ?)"
+	if clause != expectedClause {
+		t.Errorf("clause = %q, want %q", clause, expectedClause)
+	}
+	if len(args) != 3 || args[2] != int64(4) {
+		t.Errorf("args = %v, want category ID as last argument", args)
+	}
+}
+
+func TestQueryBuilder_BuildWhereClause_WithTableAlias(t *testing.T) {
+	t.Parallel()
+
+	qb := sqlite.NewArticleQueryBuilder()
+
+	sourceID := int64(3)
+	categoryID := int64(4)
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 12, 31, 23, 59, 59, 0, time.UTC)
+	filters := repository.ArticleSearchFilters{
+		SourceID:   &sourceID,
+		CategoryID: &categoryID,
+		From:       &from,
+		To:         &to,
+		Tags:       []string{"go"},
+	}
+	clause, _ := qb.BuildWhereClause([]string{"api"}, filters, "a")
+
+	for _, want := range []string{
+		"(a.title LIKE ? OR a.summary LIKE ?)",
+		"a.source_id = ?",
+		"a.source_id IN (SELECT scm.source_id",
+		"a.published_at >= ?",
+		"a.published_at <= ?",
+		"a.id IN (SELECT atg.article_id",
+	} {
+		if !strings.Contains(clause, want) {
+			t.Errorf("clause = %q, want it to contain %q", clause, want)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"catchup-feed/internal/pkg/search"
+	"catchup-feed/internal/repository"
+)
+
+// Compile-time check that ArticleRepo supports ranked search.
+var _ repository.ArticleRankedSearchRepository = (*ArticleRepo)(nil)
+
+// SearchRanked searches articles matching q and filters and orders them by relevance.
+//
+// SQLite has no built-in ranking outside of FTS tables, so relevance is the number of
+// query terms found in the title (weight 2) and summary (weight 1).
+func (repo *ArticleRepo) SearchRanked(ctx context.Context, q search.Query, filters repository.ArticleSearchFilters, offset, limit int) ([]repository.RankedArticle, error) {
+	// Apply search timeout to prevent long-running queries
+	ctx, cancel := context.WithTimeout(ctx, search.DefaultSearchTimeout)
+	defer cancel()
+
+	// SELECT 句の順位式のプレースホルダが WHERE 句より前に来るため、引数も先に並べる
+	rankExpr, args := rankExpression(q)
+	whereClause, whereArgs := repo.rankedWhereClause(q, filters)
+	args = append(args, whereArgs...)
+	args = append(args, limit, offset)
+
+	// #nosec G202 -- rankExpr and whereClause only contain parameterized placeholders (?)
+	query := `
+SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name,
+       ` + rankExpr + ` AS relevance
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+` + whereClause + `
+ORDER BY relevance DESC, a.published_at DESC, a.id DESC
+LIMIT ? OFFSET ?`
+
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SearchRanked: QueryContext: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	result := make([]repository.RankedArticle, 0, limit)
+	for rows.Next() {
+		var row articleRow
+		var item repository.RankedArticle
+		if err := rows.Scan(row.dest(&item.SourceName, &item.Rank)...); err != nil {
+			return nil, fmt.Errorf("SearchRanked: Scan: %w", err)
+		}
+		item.Article = row.toEntity()
+		result = append(result, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("SearchRanked: rows.Err: %w", err)
+	}
+	return result, nil
+}
+
+// CountRanked returns the number of articles matching q and filters.
+func (repo *ArticleRepo) CountRanked(ctx context.Context, q search.Query, filters repository.ArticleSearchFilters) (int64, error) {
+	// Apply search timeout to prevent long-running queries
+	ctx, cancel := context.WithTimeout(ctx, search.DefaultSearchTimeout)
+	defer cancel()
+
+	whereClause, args := repo.rankedWhereClause(q, filters)
+	query := "SELECT COUNT(*) FROM articles a " + whereClause
+
+	var count int64
+	if err := repo.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("CountRanked: %w", err)
+	}
+	return count, nil
+}
+
+// rankedWhereClause builds the WHERE clause for q and filters: every clause of q must
+// match the title or summary of the article, and no excluded term may match.
+func (repo *ArticleRepo) rankedWhereClause(q search.Query, filters repository.ArticleSearchFilters) (string, []interface{}) {
+	whereClause, args := repo.queryBuilder.BuildWhereClause(nil, filters, "a")
+
+	conditions := []string{"a.deleted_at IS NULL"}
+	if whereClause != "" {
+		conditions = append(conditions, strings.TrimPrefix(whereClause, "WHERE "))
+	}
+	match := func(t search.Term) string {
+		pattern := search.EscapeILIKE(t.Text)
+		args = append(args, pattern, pattern)
+		return `a.title LIKE ? ESCAPE '\' OR COALESCE(a.summary, '') LIKE ? ESCAPE '\'`
+	}
+	for _, clause := range q.Clauses {
+		terms := make([]string, len(clause))
+		for i, t := range clause {
+			terms[i] = match(t)
+		}
+		conditions = append(conditions, "("+strings.Join(terms, " OR ")+")")
+	}
+	for _, t := range q.Excluded {
+		conditions = append(conditions, "NOT ("+match(t)+")")
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// rankExpression returns the relevance expression for q and its arguments.
+func rankExpression(q search.Query) (string, []interface{}) {
+	var terms []string
+	var args []interface{}
+	for _, t := range q.Terms() {
+		pattern := search.EscapeILIKE(t.Text)
+		terms = append(terms, `(CASE WHEN a.title LIKE ? ESCAPE '\' THEN 2 ELSE 0 END + CASE WHEN COALESCE(a.summary, '') LIKE ? ESCAPE '\' THEN 1 ELSE 0 END)`)
+		args = append(args, pattern, pattern)
+	}
+	return "CAST(" + strings.Join(terms, " + ") + " AS REAL)", args
+}
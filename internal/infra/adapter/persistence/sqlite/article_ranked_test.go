@@ -0,0 +1,104 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"catchup-feed/internal/infra/adapter/persistence/sqlite"
+	"catchup-feed/internal/pkg/search"
+	"catchup-feed/internal/repository"
+)
+
+func newRankedRepo(t *testing.T) (repository.ArticleRankedSearchRepository, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	repo, ok := sqlite.NewArticleRepo(db).(repository.ArticleRankedSearchRepository)
+	if !ok {
+		t.Fatal("ArticleRepo does not implement ArticleRankedSearchRepository")
+	}
+	return repo, mock
+}
+
+func mustQuery(t *testing.T, input string) search.Query {
+	t.Helper()
+	q, err := search.ParseQuery(input, 10, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return q
+}
+
+func TestArticleRepo_SearchRanked(t *testing.T) {
+	t.Parallel()
+
+	repo, mock := newRankedRepo(t)
+	now := time.Now()
+	from := now.Add(-24 * time.Hour)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`CAST((CASE WHEN a.title LIKE ? ESCAPE '\' THEN 2 ELSE 0 END + CASE WHEN COALESCE(a.summary, '') LIKE ? ESCAPE '\' THEN 1 ELSE 0 END) + (CASE WHEN a.title LIKE ? ESCAPE '\' THEN 2 ELSE 0 END + CASE WHEN COALESCE(a.summary, '') LIKE ? ESCAPE '\' THEN 1 ELSE 0 END) AS REAL) AS relevance
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+WHERE a.deleted_at IS NULL AND a.published_at >= ? AND (a.title LIKE ? ESCAPE '\' OR COALESCE(a.summary, '') LIKE ? ESCAPE '\' OR a.title LIKE ? ESCAPE '\' OR COALESCE(a.summary, '') LIKE ? ESCAPE '\') AND NOT (a.title LIKE ? ESCAPE '\' OR COALESCE(a.summary, '') LIKE ? ESCAPE '\')
+ORDER BY relevance DESC, a.published_at DESC, a.id DESC
+LIMIT ? OFFSET ?`)).
+		WithArgs("%go%", "%go%", `%100\%%`, `%100\%%`, from, "%go%", "%go%", `%100\%%`, `%100\%%`, "%beta%", "%beta%", 10, 0).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "source_id", "title", "url",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name", "relevance",
+		}).AddRow(1, 10, "Go", "https://example.com/1", "Summary", now, now, nil, "", "", "", "", "", "Go Blog", 3.0))
+
+	result, err := repo.SearchRanked(context.Background(), mustQuery(t, `go OR 100% -beta`),
+		repository.ArticleSearchFilters{From: &from}, 0, 10)
+	if err != nil {
+		t.Fatalf("SearchRanked err=%v", err)
+	}
+	if len(result) != 1 || result[0].Rank != 3 || result[0].SourceName != "Go Blog" {
+		t.Fatalf("SearchRanked result = %+v", result)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArticleRepo_SearchRanked_QueryError(t *testing.T) {
+	t.Parallel()
+
+	repo, mock := newRankedRepo(t)
+	mock.ExpectQuery("SELECT").WillReturnError(errors.New("db down"))
+
+	_, err := repo.SearchRanked(context.Background(), mustQuery(t, "go"), repository.ArticleSearchFilters{}, 0, 10)
+	if err == nil || err.Error() != "SearchRanked: QueryContext: db down" {
+		t.Fatalf("SearchRanked err = %v", err)
+	}
+}
+
+func TestArticleRepo_CountRanked(t *testing.T) {
+	t.Parallel()
+
+	repo, mock := newRankedRepo(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM articles a WHERE a.deleted_at IS NULL AND (a.title LIKE ? ESCAPE '\' OR COALESCE(a.summary, '') LIKE ? ESCAPE '\') AND (a.title LIKE ? ESCAPE '\' OR COALESCE(a.summary, '') LIKE ? ESCAPE '\')`)).
+		WithArgs("%error handling%", "%error handling%", "%go%", "%go%").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+
+	count, err := repo.CountRanked(context.Background(), mustQuery(t, `"error handling" go`), repository.ArticleSearchFilters{})
+	if err != nil {
+		t.Fatalf("CountRanked err=%v", err)
+	}
+	if count != 4 {
+		t.Errorf("CountRanked = %d, want 4", count)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"catchup-feed/internal/domain/entity"
 	"catchup-feed/internal/pkg/search"
@@ -30,8 +31,9 @@ func NewArticleRepo(db *sql.DB) repository.ArticleRepository {
 // List retrieves all articles ordered by published date (newest first).
 func (repo *ArticleRepo) List(ctx context.Context) ([]*entity.Article, error) {
 	const query = `
-SELECT id, source_id, title, url, summary, published_at, created_at
+SELECT id, source_id, title, url, summary, published_at, created_at, summary_structured, prompt_version, summary_status, summary_batch_id, summary_model, injection_flags
 FROM articles
+WHERE deleted_at IS NULL
 ORDER BY published_at DESC
 `
 
@@ -44,15 +46,12 @@ ORDER BY published_at DESC
 	// パフォーマンス最適化: メモリ再割り当てを削減するため事前割り当て
 	articles := make([]*entity.Article, 0, 100)
 	for rows.Next() {
-		var article entity.Article
-		err := rows.Scan(&article.ID,
-			&article.SourceID, &article.Title,
-			&article.URL, &article.Summary,
-			&article.PublishedAt, &article.CreatedAt)
+		var row articleRow
+		err := rows.Scan(row.dest()...)
 		if err != nil {
 			return nil, fmt.Errorf("List: Scan: %w", err)
 		}
-		articles = append(articles, &article)
+		articles = append(articles, row.toEntity())
 	}
 
 	if err := rows.Err(); err != nil {
@@ -65,9 +64,10 @@ ORDER BY published_at DESC
 // ListWithSource retrieves all articles with their source names.
 func (repo *ArticleRepo) ListWithSource(ctx context.Context) ([]repository.ArticleWithSource, error) {
 	const query = `
-SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, s.name AS source_name
+SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name
 FROM articles a
 INNER JOIN sources s ON a.source_id = s.id
+WHERE a.deleted_at IS NULL
 ORDER BY a.published_at DESC
 `
 
@@ -80,17 +80,14 @@ ORDER BY a.published_at DESC
 	// パフォーマンス最適化: メモリ再割り当てを削減するため事前割り当て
 	result := make([]repository.ArticleWithSource, 0, 100)
 	for rows.Next() {
-		var article entity.Article
+		var row articleRow
 		var sourceName string
-		err := rows.Scan(&article.ID,
-			&article.SourceID, &article.Title,
-			&article.URL, &article.Summary,
-			&article.PublishedAt, &article.CreatedAt, &sourceName)
+		err := rows.Scan(row.dest(&sourceName)...)
 		if err != nil {
 			return nil, fmt.Errorf("ListWithSource: Scan: %w", err)
 		}
 		result = append(result, repository.ArticleWithSource{
-			Article:    &article,
+			Article:    row.toEntity(),
 			SourceName: sourceName,
 		})
 	}
@@ -106,9 +103,10 @@ ORDER BY a.published_at DESC
 // Uses LIMIT and OFFSET for efficient pagination.
 func (repo *ArticleRepo) ListWithSourcePaginated(ctx context.Context, offset, limit int) ([]repository.ArticleWithSource, error) {
 	const query = `
-SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, s.name AS source_name
+SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name
 FROM articles a
 INNER JOIN sources s ON a.source_id = s.id
+WHERE a.deleted_at IS NULL
 ORDER BY a.published_at DESC
 LIMIT ? OFFSET ?
 `
@@ -121,17 +119,14 @@ LIMIT ? OFFSET ?
 
 	result := make([]repository.ArticleWithSource, 0, limit)
 	for rows.Next() {
-		var article entity.Article
+		var row articleRow
 		var sourceName string
-		err := rows.Scan(&article.ID,
-			&article.SourceID, &article.Title,
-			&article.URL, &article.Summary,
-			&article.PublishedAt, &article.CreatedAt, &sourceName)
+		err := rows.Scan(row.dest(&sourceName)...)
 		if err != nil {
 			return nil, fmt.Errorf("ListWithSourcePaginated: Scan: %w", err)
 		}
 		result = append(result, repository.ArticleWithSource{
-			Article:    &article,
+			Article:    row.toEntity(),
 			SourceName: sourceName,
 		})
 	}
@@ -145,7 +140,7 @@ LIMIT ? OFFSET ?
 
 // CountArticles returns the total number of articles in the database.
 func (repo *ArticleRepo) CountArticles(ctx context.Context) (int64, error) {
-	const query = `SELECT COUNT(*) FROM articles`
+	const query = `SELECT COUNT(*) FROM articles WHERE deleted_at IS NULL`
 	var count int64
 	err := repo.db.QueryRowContext(ctx, query).Scan(&count)
 	if err != nil {
@@ -156,54 +151,49 @@ func (repo *ArticleRepo) CountArticles(ctx context.Context) (int64, error) {
 
 func (repo *ArticleRepo) Get(ctx context.Context, id int64) (*entity.Article, error) {
 	const query = `
-SELECT id, source_id, title, url, summary, published_at, created_at
+SELECT id, source_id, title, url, summary, published_at, created_at, summary_structured, prompt_version, summary_status, summary_batch_id, summary_model, injection_flags
 FROM articles
-WHERE id = ?
+WHERE id = ? AND deleted_at IS NULL
 LIMIT 1
 `
-	var article entity.Article
-	err := repo.db.QueryRowContext(ctx, query, id).Scan(
-		&article.ID, &article.SourceID, &article.Title, &article.URL,
-		&article.Summary, &article.PublishedAt, &article.CreatedAt,
-	)
+	var row articleRow
+	err := repo.db.QueryRowContext(ctx, query, id).Scan(row.dest()...)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
 		return nil, fmt.Errorf("Get: QueryRowContext: %w", err)
 	}
-	return &article, nil
+	return row.toEntity(), nil
 }
 
 func (repo *ArticleRepo) GetWithSource(ctx context.Context, id int64) (*entity.Article, string, error) {
 	const query = `
-SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, s.name AS source_name
+SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name
 FROM articles a
 INNER JOIN sources s ON a.source_id = s.id
-WHERE a.id = ?
+WHERE a.id = ? AND a.deleted_at IS NULL
 LIMIT 1
 `
-	var article entity.Article
+	var row articleRow
 	var sourceName string
-	err := repo.db.QueryRowContext(ctx, query, id).Scan(
-		&article.ID, &article.SourceID, &article.Title, &article.URL,
-		&article.Summary, &article.PublishedAt, &article.CreatedAt, &sourceName,
-	)
+	err := repo.db.QueryRowContext(ctx, query, id).Scan(row.dest(&sourceName)...)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, "", nil
 		}
 		return nil, "", fmt.Errorf("GetWithSource: QueryRowContext: %w", err)
 	}
-	return &article, sourceName, nil
+	return row.toEntity(), sourceName, nil
 }
 
 func (repo *ArticleRepo) Search(ctx context.Context, keyword string) ([]*entity.Article, error) {
 	const query = `
-SELECT id, source_id, title, url, summary, published_at, created_at
+SELECT id, source_id, title, url, summary, published_at, created_at, summary_structured, prompt_version, summary_status, summary_batch_id, summary_model, injection_flags
 FROM articles
-WHERE title   LIKE ?
-OR summary    LIKE ?
+WHERE (title   LIKE ?
+OR summary    LIKE ?)
+AND deleted_at IS NULL
 ORDER BY published_at DESC
 `
 	param := "%" + keyword + "%"
@@ -216,15 +206,12 @@ ORDER BY published_at DESC
 	// パフォーマンス最適化: メモリ再割り当てを削減するため事前割り当て
 	articles := make([]*entity.Article, 0, 100)
 	for rows.Next() {
-		var article entity.Article
-		err := rows.Scan(&article.ID,
-			&article.SourceID, &article.Title,
-			&article.URL, &article.Summary,
-			&article.PublishedAt, &article.CreatedAt)
+		var row articleRow
+		err := rows.Scan(row.dest()...)
 		if err != nil {
 			return nil, fmt.Errorf("Search: Scan: %w", err)
 		}
-		articles = append(articles, &article)
+		articles = append(articles, row.toEntity())
 	}
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("Search: rows.Err: %w", err)
@@ -238,7 +225,7 @@ ORDER BY published_at DESC
 func (repo *ArticleRepo) SearchWithFilters(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters) ([]*entity.Article, error) {
 	// Check if there are any search criteria (keywords or filters)
 	hasKeywords := len(keywords) > 0
-	hasFilters := filters.SourceID != nil || filters.From != nil || filters.To != nil
+	hasFilters := !filters.Empty()
 
 	// No keywords and no filters -> return empty result
 	if !hasKeywords && !hasFilters {
@@ -250,12 +237,13 @@ func (repo *ArticleRepo) SearchWithFilters(ctx context.Context, keywords []strin
 	defer cancel()
 
 	// Build WHERE clause using shared QueryBuilder
-	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters)
+	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters, "")
+	whereClause = andCondition(whereClause, "deleted_at IS NULL")
 
 	// Construct final query
 	// #nosec G202 -- whereClause is generated by QueryBuilder using parameterized placeholders (?), not user input
 	query := `
-SELECT id, source_id, title, url, summary, published_at, created_at
+SELECT id, source_id, title, url, summary, published_at, created_at, summary_structured, prompt_version, summary_status, summary_batch_id, summary_model, injection_flags
 FROM articles
 ` + whereClause + `
 ORDER BY published_at DESC`
@@ -269,12 +257,11 @@ ORDER BY published_at DESC`
 	// パフォーマンス最適化: メモリ再割り当てを削減するため事前割り当て
 	articles := make([]*entity.Article, 0, 100)
 	for rows.Next() {
-		var article entity.Article
-		if err := rows.Scan(&article.ID, &article.SourceID, &article.Title,
-			&article.URL, &article.Summary, &article.PublishedAt, &article.CreatedAt); err != nil {
+		var row articleRow
+		if err := rows.Scan(row.dest()...); err != nil {
 			return nil, fmt.Errorf("SearchWithFilters: Scan: %w", err)
 		}
-		articles = append(articles, &article)
+		articles = append(articles, row.toEntity())
 	}
 	return articles, rows.Err()
 }
@@ -284,7 +271,7 @@ ORDER BY published_at DESC`
 func (repo *ArticleRepo) CountArticlesWithFilters(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters) (int64, error) {
 	// Check if there are any search criteria (keywords or filters)
 	hasKeywords := len(keywords) > 0
-	hasFilters := filters.SourceID != nil || filters.From != nil || filters.To != nil
+	hasFilters := !filters.Empty()
 
 	// No keywords and no filters -> return 0
 	if !hasKeywords && !hasFilters {
@@ -296,7 +283,8 @@ func (repo *ArticleRepo) CountArticlesWithFilters(ctx context.Context, keywords
 	defer cancel()
 
 	// Build WHERE clause using shared QueryBuilder
-	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters)
+	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters, "")
+	whereClause = andCondition(whereClause, "deleted_at IS NULL")
 
 	// Construct COUNT query
 	query := "SELECT COUNT(*) FROM articles " + whereClause
@@ -315,7 +303,7 @@ func (repo *ArticleRepo) CountArticlesWithFilters(ctx context.Context, keywords
 func (repo *ArticleRepo) SearchWithFiltersPaginated(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters, offset, limit int) ([]repository.ArticleWithSource, error) {
 	// Check if there are any search criteria (keywords or filters)
 	hasKeywords := len(keywords) > 0
-	hasFilters := filters.SourceID != nil || filters.From != nil || filters.To != nil
+	hasFilters := !filters.Empty()
 
 	// No keywords and no filters -> return empty result
 	if !hasKeywords && !hasFilters {
@@ -328,18 +316,13 @@ func (repo *ArticleRepo) SearchWithFiltersPaginated(ctx context.Context, keyword
 
 	// Build WHERE clause using shared QueryBuilder
 	// Note: We need to prefix 'a.' to column names for JOIN query
-	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters)
-	// Replace column names with table alias
-	whereClause = strings.ReplaceAll(whereClause, "title LIKE", "a.title LIKE")
-	whereClause = strings.ReplaceAll(whereClause, "summary LIKE", "a.summary LIKE")
-	whereClause = strings.ReplaceAll(whereClause, "source_id =", "a.source_id =")
-	whereClause = strings.ReplaceAll(whereClause, "published_at >=", "a.published_at >=")
-	whereClause = strings.ReplaceAll(whereClause, "published_at <=", "a.published_at <=")
+	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters, "a")
+	whereClause = andCondition(whereClause, "a.deleted_at IS NULL")
 
 	// Construct query with JOIN
 	// #nosec G202 -- whereClause is generated by QueryBuilder using parameterized placeholders (?), not user input
 	query := `
-SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, s.name AS source_name
+SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name
 FROM articles a
 INNER JOIN sources s ON a.source_id = s.id
 ` + whereClause + `
@@ -357,14 +340,13 @@ LIMIT ? OFFSET ?`
 
 	result := make([]repository.ArticleWithSource, 0, limit)
 	for rows.Next() {
-		var article entity.Article
+		var row articleRow
 		var sourceName string
-		if err := rows.Scan(&article.ID, &article.SourceID, &article.Title,
-			&article.URL, &article.Summary, &article.PublishedAt, &article.CreatedAt, &sourceName); err != nil {
+		if err := rows.Scan(row.dest(&sourceName)...); err != nil {
 			return nil, fmt.Errorf("SearchWithFiltersPaginated: Scan: %w", err)
 		}
 		result = append(result, repository.ArticleWithSource{
-			Article:    &article,
+			Article:    row.toEntity(),
 			SourceName: sourceName,
 		})
 	}
@@ -375,16 +357,29 @@ LIMIT ? OFFSET ?`
 func (repo *ArticleRepo) Create(ctx context.Context, article *entity.Article) error {
 	const query = `
 INSERT INTO articles
-(source_id, title, url, summary, published_at, created_at)
-VALUES (?, ?, ?, ?, ?, ?)
+(source_id, title, url, summary, published_at, created_at, summary_structured, prompt_version,
+ summary_status, summary_batch_id, summary_model, injection_flags)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
-	_, err := repo.db.ExecContext(ctx, query,
+	structured, err := encodeStructuredSummary(article.Structured)
+	if err != nil {
+		return fmt.Errorf("Create: %w", err)
+	}
+	res, err := repo.db.ExecContext(ctx, query,
 		article.SourceID, article.Title, article.URL,
 		article.Summary, article.PublishedAt, article.CreatedAt,
+		structured, article.PromptVersion,
+		article.SummaryStatus, article.SummaryBatchID, article.SummaryModel,
+		encodeInjectionFlags(article.InjectionFlags),
 	)
 	if err != nil {
 		return fmt.Errorf("Create: ExecContext: %w", err)
 	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("Create: LastInsertId: %w", err)
+	}
+	article.ID = id
 	return nil
 }
 
@@ -395,12 +390,24 @@ UPDATE articles SET
 	title 		 = ?,
 	url 		 = ?,
 	summary 	 = ?,
-	published_at = ?
+	published_at = ?,
+	summary_structured = ?,
+	prompt_version = ?,
+	summary_status = ?,
+	summary_batch_id = ?,
+	summary_model = ?,
+	injection_flags = ?
 WHERE id = ?
 `
+	structured, err := encodeStructuredSummary(article.Structured)
+	if err != nil {
+		return fmt.Errorf("Update: %w", err)
+	}
 	res, err := repo.db.ExecContext(ctx, query,
 		article.SourceID, article.Title, article.URL,
-		article.Summary, article.PublishedAt, article.ID,
+		article.Summary, article.PublishedAt, structured, article.PromptVersion,
+		article.SummaryStatus, article.SummaryBatchID, article.SummaryModel,
+		encodeInjectionFlags(article.InjectionFlags), article.ID,
 	)
 
 	if err != nil {
@@ -416,10 +423,11 @@ WHERE id = ?
 	return nil
 }
 
+// Delete moves the article to the trash. It is hidden from every other query
+// until it is restored or purged (see TrashRepo).
 func (repo *ArticleRepo) Delete(ctx context.Context, id int64) error {
-	const query = `DELETE FROM articles WHERE id = ?
-`
-	res, err := repo.db.ExecContext(ctx, query, id)
+	const query = `UPDATE articles SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL`
+	res, err := repo.db.ExecContext(ctx, query, time.Now().UTC(), id)
 
 	if err != nil {
 		return fmt.Errorf("Delete: ExecContext: %w", err)
@@ -434,8 +442,15 @@ func (repo *ArticleRepo) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
+// ExistsByURL reports whether the URL has been fetched before. Articles in the
+// trash and purged articles (article_tombstones) count as fetched, so that the
+// crawler does not insert them again.
 func (repo *ArticleRepo) ExistsByURL(ctx context.Context, url string) (bool, error) {
-	const query = `SELECT 1 FROM articles WHERE url = ? LIMIT 1`
+	const query = `
+SELECT 1 FROM articles WHERE url = ?1
+UNION ALL
+SELECT 1 FROM article_tombstones WHERE url = ?1
+LIMIT 1`
 	var existsFlag bool
 	err := repo.db.QueryRowContext(ctx, query, url).Scan(&existsFlag)
 	if err == sql.ErrNoRows {
@@ -448,6 +463,7 @@ func (repo *ArticleRepo) ExistsByURL(ctx context.Context, url string) (bool, err
 }
 
 // ExistsByURLBatch はバッチでURL存在チェックを行い、N+1問題を解消する
+// ExistsByURL と同じく、ゴミ箱の記事と完全削除済みの記事も存在するものとして扱う
 func (repo *ArticleRepo) ExistsByURLBatch(ctx context.Context, urls []string) (map[string]bool, error) {
 	if len(urls) == 0 {
 		return make(map[string]bool), nil
@@ -460,18 +476,19 @@ func (repo *ArticleRepo) ExistsByURLBatch(ctx context.Context, urls []string) (m
 		return nil, fmt.Errorf("ExistsByURLBatch: too many URLs (%d > %d)", len(urls), maxPlaceholders)
 	}
 
-	// 安全性確認: placeholdersは"?"のみを含むため、SQLインジェクションのリスクはない
+	// 安全性確認: placeholdersは"?N"のみを含むため、SQLインジェクションのリスクはない
 	// fmt.Sprintf使用は許容される（placeholders配列の内容が制御されているため）
+	// 番号付きプレースホルダにして、2つの IN で同じ引数を使う
 	placeholders := make([]string, len(urls))
 	args := make([]interface{}, len(urls))
 	for i, url := range urls {
-		placeholders[i] = "?" // 固定値のみ
+		placeholders[i] = fmt.Sprintf("?%d", i+1) // 固定値のみ
 		args[i] = url
 	}
 
 	// クエリ組み立て（placeholdersは制御された値のみ）
-	// #nosec G201 -- placeholders are programmatically generated ("?"), not from user input
-	query := fmt.Sprintf("SELECT url FROM articles WHERE url IN (%s)",
+	// #nosec G201 -- placeholders are programmatically generated ("?N"), not from user input
+	query := fmt.Sprintf("SELECT url FROM articles WHERE url IN (%[1]s) UNION SELECT url FROM article_tombstones WHERE url IN (%[1]s)",
 		strings.Join(placeholders, ","))
 
 	rows, err := repo.db.QueryContext(ctx, query, args...)
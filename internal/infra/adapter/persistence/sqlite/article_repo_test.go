@@ -3,6 +3,7 @@ package sqlite_test
 import (
 	"context"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,10 +20,10 @@ import (
 func artRow(a *entity.Article) *sqlmock.Rows {
 	return sqlmock.NewRows([]string{
 		"id", "source_id", "title", "url",
-		"summary", "published_at", "created_at",
+		"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags",
 	}).AddRow(
 		a.ID, a.SourceID, a.Title, a.URL,
-		a.Summary, a.PublishedAt, a.CreatedAt,
+		a.Summary, a.PublishedAt, a.CreatedAt, nil, "", "", "", "", strings.Join(a.InjectionFlags, ","),
 	)
 }
 
@@ -95,7 +96,7 @@ func TestArticleRepo_Search(t *testing.T) {
 		WithArgs("%go%", "%go%").
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags",
 		})) // 空集合で十分
 
 	repo := sqlite.NewArticleRepo(db)
@@ -119,17 +120,21 @@ func TestArticleRepo_Create(t *testing.T) {
 	now := time.Now()
 	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO articles")).
 		WithArgs(int64(2), "title", "https://u", "summary",
-			now, now).
-		WillReturnResult(sqlmock.NewResult(1, 1))
+			now, now, nil, "", "", "", "", "").
+		WillReturnResult(sqlmock.NewResult(7, 1))
 
 	repo := sqlite.NewArticleRepo(db)
-	err := repo.Create(context.Background(), &entity.Article{
+	art := &entity.Article{
 		SourceID: 2, Title: "title", URL: "https://u",
 		Summary: "summary", PublishedAt: now, CreatedAt: now,
-	})
+	}
+	err := repo.Create(context.Background(), art)
 	if err != nil {
 		t.Fatalf("Create err=%v", err)
 	}
+	if art.ID != 7 {
+		t.Fatalf("ID = %d, want 7 (LastInsertId)", art.ID)
+	}
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Fatal(err)
 	}
@@ -146,7 +151,7 @@ func TestArticleRepo_Update(t *testing.T) {
 	now := time.Now()
 
 	mock.ExpectExec("UPDATE articles").
-		WithArgs(int64(2), "new", "https://u", "sum", now, 1).
+		WithArgs(int64(2), "new", "https://u", "sum", now, nil, "", "", "", "", "", 1).
 		WillReturnResult(sqlmock.NewResult(0, 1)) // 1 行更新
 
 	repo := sqlite.NewArticleRepo(db)
@@ -170,8 +175,9 @@ func TestArticleRepo_Delete(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer func() { _ = db.Close() }()
 
-	mock.ExpectExec("DELETE FROM articles").
-		WithArgs(int64(1)).
+	// ゴミ箱へ移すだけで行は消さない
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE articles SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL")).
+		WithArgs(sqlmock.AnyArg(), int64(1)).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
 	repo := sqlite.NewArticleRepo(db)
@@ -248,8 +254,8 @@ func TestArticleRepo_ExistsByURLBatch(t *testing.T) {
 		"https://example.com/article3",
 	}
 
-	// article1とarticle3が存在する
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT url FROM articles WHERE url IN (?,?,?)")).
+	// article1とarticle3が存在する（完全削除済みの URL も含む）
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT url FROM articles WHERE url IN (?1,?2,?3) UNION SELECT url FROM article_tombstones WHERE url IN (?1,?2,?3)")).
 		WithArgs("https://example.com/article1", "https://example.com/article2", "https://example.com/article3").
 		WillReturnRows(sqlmock.NewRows([]string{"url"}).
 			AddRow("https://example.com/article1").
@@ -338,10 +344,10 @@ func TestArticleRepo_ListWithSourcePaginated(t *testing.T) {
 		WithArgs(2, 0).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 		}).
-			AddRow(1, 10, "Article 1", "https://example.com/1", "Summary 1", now, now, "Test Source").
-			AddRow(2, 10, "Article 2", "https://example.com/2", "Summary 2", now, now, "Test Source"))
+			AddRow(1, 10, "Article 1", "https://example.com/1", "Summary 1", now, now, nil, "", "", "", "", "", "Test Source").
+			AddRow(2, 10, "Article 2", "https://example.com/2", "Summary 2", now, now, nil, "", "", "", "", "", "Test Source"))
 
 	repo := sqlite.NewArticleRepo(db)
 	result, err := repo.ListWithSourcePaginated(context.Background(), 0, 2)
@@ -378,10 +384,10 @@ func TestArticleRepo_ListWithSourcePaginated_SecondPage(t *testing.T) {
 		WithArgs(20, 20).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 		}).
-			AddRow(21, 10, "Article 21", "https://example.com/21", "Summary 21", now, now, "Test Source").
-			AddRow(22, 10, "Article 22", "https://example.com/22", "Summary 22", now, now, "Test Source"))
+			AddRow(21, 10, "Article 21", "https://example.com/21", "Summary 21", now, now, nil, "", "", "", "", "", "Test Source").
+			AddRow(22, 10, "Article 22", "https://example.com/22", "Summary 22", now, now, nil, "", "", "", "", "", "Test Source"))
 
 	repo := sqlite.NewArticleRepo(db)
 	result, err := repo.ListWithSourcePaginated(context.Background(), 20, 20)
@@ -413,7 +419,7 @@ func TestArticleRepo_ListWithSourcePaginated_EmptyResult(t *testing.T) {
 		WithArgs(20, 1000).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 		}))
 
 	repo := sqlite.NewArticleRepo(db)
@@ -442,7 +448,7 @@ func TestArticleRepo_ListWithSourcePaginated_LargeOffset(t *testing.T) {
 		WithArgs(10, 9900).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 		}))
 
 	repo := sqlite.NewArticleRepo(db)
@@ -726,10 +732,10 @@ func TestArticleRepo_SearchWithFiltersPaginated_KeywordOnly(t *testing.T) {
 		WithArgs("%golang%", "%golang%", 10, 0).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 		}).
-			AddRow(1, 10, "Go 1.22 released", "https://example.com/1", "Summary 1", now, now, "Go Blog").
-			AddRow(2, 10, "Golang best practices", "https://example.com/2", "Summary 2", now, now, "Go Blog"))
+			AddRow(1, 10, "Go 1.22 released", "https://example.com/1", "Summary 1", now, now, nil, "", "", "", "", "", "Go Blog").
+			AddRow(2, 10, "Golang best practices", "https://example.com/2", "Summary 2", now, now, nil, "", "", "", "", "", "Go Blog"))
 
 	repo := sqlite.NewArticleRepo(db)
 	result, err := repo.SearchWithFiltersPaginated(context.Background(), []string{"golang"}, repository.ArticleSearchFilters{}, 0, 10)
@@ -767,9 +773,9 @@ func TestArticleRepo_SearchWithFiltersPaginated_MultipleKeywords(t *testing.T) {
 		WithArgs("%golang%", "%golang%", "%testing%", "%testing%", 10, 0).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 		}).
-			AddRow(1, 10, "Golang testing guide", "https://example.com/1", "Testing in Go", now, now, "Go Blog"))
+			AddRow(1, 10, "Golang testing guide", "https://example.com/1", "Testing in Go", now, now, nil, "", "", "", "", "", "Go Blog"))
 
 	repo := sqlite.NewArticleRepo(db)
 	result, err := repo.SearchWithFiltersPaginated(context.Background(), []string{"golang", "testing"}, repository.ArticleSearchFilters{}, 0, 10)
@@ -804,9 +810,9 @@ func TestArticleRepo_SearchWithFiltersPaginated_WithSourceID(t *testing.T) {
 		WithArgs("%golang%", "%golang%", int64(123), 10, 0).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 		}).
-			AddRow(1, 123, "Go article", "https://example.com/1", "Summary", now, now, "Specific Source"))
+			AddRow(1, 123, "Go article", "https://example.com/1", "Summary", now, now, nil, "", "", "", "", "", "Specific Source"))
 
 	repo := sqlite.NewArticleRepo(db)
 	result, err := repo.SearchWithFiltersPaginated(context.Background(), []string{"golang"}, filters, 0, 10)
@@ -847,9 +853,9 @@ func TestArticleRepo_SearchWithFiltersPaginated_WithDateRange(t *testing.T) {
 		WithArgs("%golang%", "%golang%", from, to, 10, 0).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 		}).
-			AddRow(1, 10, "Go article", "https://example.com/1", "Summary", now, now, "Go Blog"))
+			AddRow(1, 10, "Go article", "https://example.com/1", "Summary", now, now, nil, "", "", "", "", "", "Go Blog"))
 
 	repo := sqlite.NewArticleRepo(db)
 	result, err := repo.SearchWithFiltersPaginated(context.Background(), []string{"golang"}, filters, 0, 10)
@@ -888,9 +894,9 @@ func TestArticleRepo_SearchWithFiltersPaginated_AllFilters(t *testing.T) {
 		WithArgs("%golang%", "%golang%", "%api%", "%api%", int64(456), from, to, 10, 0).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 		}).
-			AddRow(1, 456, "Go API article", "https://example.com/1", "Summary", now, now, "API Source"))
+			AddRow(1, 456, "Go API article", "https://example.com/1", "Summary", now, now, nil, "", "", "", "", "", "API Source"))
 
 	repo := sqlite.NewArticleRepo(db)
 	result, err := repo.SearchWithFiltersPaginated(context.Background(), []string{"golang", "api"}, filters, 0, 10)
@@ -920,9 +926,9 @@ func TestArticleRepo_SearchWithFiltersPaginated_Pagination(t *testing.T) {
 		WithArgs("%golang%", "%golang%", 20, 20).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 		}).
-			AddRow(21, 10, "Article 21", "https://example.com/21", "Summary", now, now, "Go Blog"))
+			AddRow(21, 10, "Article 21", "https://example.com/21", "Summary", now, now, nil, "", "", "", "", "", "Go Blog"))
 
 	repo := sqlite.NewArticleRepo(db)
 	result, err := repo.SearchWithFiltersPaginated(context.Background(), []string{"golang"}, repository.ArticleSearchFilters{}, 20, 20)
@@ -972,7 +978,7 @@ func TestArticleRepo_SearchWithFiltersPaginated_NoResults(t *testing.T) {
 		WithArgs("%nonexistent%", "%nonexistent%", 10, 0).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 		}))
 
 	repo := sqlite.NewArticleRepo(db)
@@ -1001,7 +1007,7 @@ func TestArticleRepo_SearchWithFiltersPaginated_LargeOffset(t *testing.T) {
 		WithArgs("%golang%", "%golang%", 10, 1000).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "source_id", "title", "url",
-			"summary", "published_at", "created_at", "source_name",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
 		}))
 
 	repo := sqlite.NewArticleRepo(db)
@@ -1018,3 +1024,157 @@ func TestArticleRepo_SearchWithFiltersPaginated_LargeOffset(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+/* ────────────────────────────  Structured summary  ──────────────────────────── */
+
+func TestArticleRepo_Update_WithStructuredSummary(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Now()
+	mock.ExpectExec("UPDATE articles").
+		WithArgs(int64(2), "new", "https://u", "sum", now,
+			`{"tldr":"t","key_points":["a","b","c"],"tags":null,"reading_time_minutes":1}`, "release-notes@89abcdef", "", "", "", "", 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := sqlite.NewArticleRepo(db)
+	err := repo.Update(context.Background(), &entity.Article{
+		ID: 1, SourceID: 2, Title: "new", URL: "https://u",
+		Summary: "sum", PublishedAt: now,
+		Structured: &entity.StructuredSummary{
+			TLDR: "t", KeyPoints: []string{"a", "b", "c"}, ReadingTimeMinutes: 1,
+		},
+		PromptVersion: "release-notes@89abcdef",
+	})
+	if err != nil {
+		t.Fatalf("Update err=%v", err)
+	}
+}
+
+func TestArticleRepo_GetWithSource_MalformedStructuredSummary(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT a.id")).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "source_id", "title", "url",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
+		}).AddRow(int64(1), int64(2), "t", "https://u", "plain", now, now, []byte("[broken"), "", "", "", "", "", "src"))
+
+	repo := sqlite.NewArticleRepo(db)
+	got, _, err := repo.GetWithSource(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetWithSource err=%v", err)
+	}
+	if got.Structured != nil {
+		t.Errorf("Structured = %+v, want nil for malformed JSON", got.Structured)
+	}
+	if got.Summary != "plain" {
+		t.Errorf("Summary = %q, want plain", got.Summary)
+	}
+}
+
+func TestArticleRepo_SearchWithFiltersPaginated_TagsOnly(t *testing.T) {
+	t.Parallel()
+
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Now()
+
+	// タグ条件のサブクエリも記事テーブルの別名で参照される
+	mock.ExpectQuery(`WHERE a\.id IN \(SELECT atg\.article_id FROM article_tags atg`).
+		WithArgs("go", 10, 0).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "source_id", "title", "url",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
+		}).
+			AddRow(1, 10, "Go 1.22 released", "https://example.com/1", "Summary 1", now, now, nil, "", "", "", "", "", "Go Blog"))
+
+	repo := sqlite.NewArticleRepo(db)
+	filters := repository.ArticleSearchFilters{Tags: []string{"go"}}
+	result, err := repo.SearchWithFiltersPaginated(context.Background(), nil, filters, 0, 10)
+	if err != nil {
+		t.Fatalf("SearchWithFiltersPaginated err=%v", err)
+	}
+	if len(result) != 1 {
+		t.Fatalf("SearchWithFiltersPaginated result length = %d, want 1", len(result))
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestArticleRepo_SearchWithFiltersPaginated_CategoryAndTags(t *testing.T) {
+	t.Parallel()
+
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	// カテゴリ条件の source_id がタグ条件の id と取り違えられずに別名で参照される
+	mock.ExpectQuery(`WHERE a\.source_id IN \(SELECT scm\.source_id FROM source_category_members scm WHERE scm\.category_id = \?\) AND a\.id IN \(SELECT atg\.article_id`).
+		WithArgs(int64(4), "go", 10, 0).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "source_id", "title", "url",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
+		}))
+
+	repo := sqlite.NewArticleRepo(db)
+	categoryID := int64(4)
+	filters := repository.ArticleSearchFilters{CategoryID: &categoryID, Tags: []string{"go"}}
+	if _, err := repo.SearchWithFiltersPaginated(context.Background(), nil, filters, 0, 10); err != nil {
+		t.Fatalf("SearchWithFiltersPaginated err=%v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestArticleRepo_InjectionFlags(t *testing.T) {
+	now := time.Date(2025, 7, 19, 0, 0, 0, 0, time.UTC)
+	flags := []string{entity.InjectionFlagInstructionOverride, entity.InjectionFlagUnknownURL}
+
+	t.Run("create stores flags comma-separated", func(t *testing.T) {
+		db, mock, _ := sqlmock.New()
+		defer func() { _ = db.Close() }()
+
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO articles")).
+			WithArgs(int64(2), "title", "https://u", "summary", now, now, nil, "", "", "", "",
+				"instruction_override,unknown_url").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		repo := sqlite.NewArticleRepo(db)
+		err := repo.Create(context.Background(), &entity.Article{
+			SourceID: 2, Title: "title", URL: "https://u",
+			Summary: "summary", PublishedAt: now, CreatedAt: now, InjectionFlags: flags,
+		})
+		if err != nil {
+			t.Fatalf("Create err=%v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("get splits flags", func(t *testing.T) {
+		db, mock, _ := sqlmock.New()
+		defer func() { _ = db.Close() }()
+
+		want := &entity.Article{ID: 1, SourceID: 2, Title: "t", URL: "https://u", Summary: "s",
+			PublishedAt: now, CreatedAt: now, InjectionFlags: flags}
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT")).
+			WithArgs(int64(1)).
+			WillReturnRows(artRow(want))
+
+		repo := sqlite.NewArticleRepo(db)
+		got, err := repo.Get(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("Get err=%v", err)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Fatalf("Get mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,89 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// articleRow holds the scan destinations for a single articles row.
+// Nullable and encoded columns are scanned into intermediate fields and
+// converted by toEntity so that each query only has to list its columns once.
+type articleRow struct {
+	article        entity.Article
+	structured     []byte
+	promptVersion  sql.NullString
+	summaryStatus  sql.NullString
+	summaryBatchID sql.NullString
+	summaryModel   sql.NullString
+	injectionFlags sql.NullString
+}
+
+// dest returns the Scan destinations in the canonical article column order:
+// id, source_id, title, url, summary, published_at, created_at, summary_structured,
+// prompt_version, summary_status, summary_batch_id, summary_model, injection_flags.
+// extra destinations (e.g. source_name for JOIN queries) are appended at the end.
+func (r *articleRow) dest(extra ...any) []any {
+	d := []any{
+		&r.article.ID, &r.article.SourceID, &r.article.Title, &r.article.URL,
+		&r.article.Summary, &r.article.PublishedAt, &r.article.CreatedAt,
+		&r.structured, &r.promptVersion, &r.summaryStatus, &r.summaryBatchID, &r.summaryModel,
+		&r.injectionFlags,
+	}
+	return append(d, extra...)
+}
+
+// toEntity converts the scanned row into an article entity.
+func (r *articleRow) toEntity() *entity.Article {
+	a := r.article
+	a.Structured = decodeStructuredSummary(r.structured)
+	a.PromptVersion = r.promptVersion.String
+	a.SummaryStatus = r.summaryStatus.String
+	a.SummaryBatchID = r.summaryBatchID.String
+	a.SummaryModel = r.summaryModel.String
+	a.InjectionFlags = decodeInjectionFlags(r.injectionFlags.String)
+	return &a
+}
+
+// encodeStructuredSummary converts a structured summary into a value for the
+// summary_structured column. A nil summary is stored as NULL.
+func encodeStructuredSummary(s *entity.StructuredSummary) (any, error) {
+	if s == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, fmt.Errorf("marshal structured summary: %w", err)
+	}
+	return string(b), nil
+}
+
+// decodeStructuredSummary parses the summary_structured column.
+// NULL or malformed values yield nil so that the plain summary is used instead.
+func decodeStructuredSummary(b []byte) *entity.StructuredSummary {
+	if len(b) == 0 {
+		return nil
+	}
+	var s entity.StructuredSummary
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil
+	}
+	return &s
+}
+
+// encodeInjectionFlags converts prompt-injection flags into a value for the
+// injection_flags column, a comma-separated list ("" when nothing was detected).
+func encodeInjectionFlags(flags []string) string {
+	return strings.Join(flags, ",")
+}
+
+// decodeInjectionFlags parses the injection_flags column. An empty value yields nil.
+func decodeInjectionFlags(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
@@ -0,0 +1,82 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"catchup-feed/internal/repository"
+)
+
+// ArticleStreamRepo finds new articles for the article stream. SQLite has no
+// LISTEN/NOTIFY, so the stream polls it.
+type ArticleStreamRepo struct {
+	db           *sql.DB
+	queryBuilder *ArticleQueryBuilder
+}
+
+func NewArticleStreamRepo(db *sql.DB) repository.ArticleStreamRepository {
+	return &ArticleStreamRepo{
+		db:           db,
+		queryBuilder: NewArticleQueryBuilder(),
+	}
+}
+
+func (repo *ArticleStreamRepo) LatestArticleID(ctx context.Context) (int64, error) {
+	const query = `SELECT COALESCE(MAX(id), 0) FROM articles`
+	var id int64
+	if err := repo.db.QueryRowContext(ctx, query).Scan(&id); err != nil {
+		return 0, fmt.Errorf("LatestArticleID: QueryRowContext: %w", err)
+	}
+	return id, nil
+}
+
+func (repo *ArticleStreamRepo) ListArticlesAfter(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters, afterID int64, limit int) ([]repository.ArticleWithSource, error) {
+	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters, "a")
+	whereClause = andCondition(whereClause, "a.deleted_at IS NULL AND a.id > ?")
+	whereClause = andCondition(whereClause, "a.summary_status <> 'pending'")
+	args = append(args, afterID, limit)
+
+	// #nosec G202 -- whereClause is generated by QueryBuilder using parameterized placeholders (?)
+	query := `
+SELECT ` + articleWithSourceColumns + `
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+` + whereClause + `
+ORDER BY a.id ASC
+LIMIT ?`
+
+	return queryWithSource(ctx, repo.db, "ListArticlesAfter", query, args, limit)
+}
+
+func (repo *ArticleStreamRepo) ListPendingArticleIDs(ctx context.Context, afterID int64) ([]int64, error) {
+	const query = `
+SELECT id FROM articles
+WHERE id > ? AND summary_status = 'pending' AND deleted_at IS NULL
+ORDER BY id`
+	rows, err := repo.db.QueryContext(ctx, query, afterID)
+	if err != nil {
+		return nil, fmt.Errorf("ListPendingArticleIDs: QueryContext: %w", err)
+	}
+	return scanIDs(rows, "ListPendingArticleIDs")
+}
+
+func (repo *ArticleStreamRepo) ListSettledArticles(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters, ids []int64) ([]repository.ArticleWithSource, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters, "a")
+	list, idArgs := idList(ids)
+	whereClause = andCondition(whereClause, "a.deleted_at IS NULL AND a.summary_status <> 'pending' AND a.id IN ("+list+")")
+	args = append(args, idArgs...)
+
+	// #nosec G202 -- whereClause is generated by QueryBuilder using parameterized placeholders (?)
+	query := `
+SELECT ` + articleWithSourceColumns + `
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+` + whereClause + `
+ORDER BY a.id ASC`
+
+	return queryWithSource(ctx, repo.db, "ListSettledArticles", query, args, len(ids))
+}
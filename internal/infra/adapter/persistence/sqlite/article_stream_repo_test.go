@@ -0,0 +1,77 @@
+package sqlite_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"catchup-feed/internal/infra/adapter/persistence/sqlite"
+	"catchup-feed/internal/repository"
+)
+
+func TestArticleStreamRepo_ListArticlesAfter(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	sourceID := int64(3)
+	// 要約待ちの記事は飛ばす（要約後に ListSettledArticles で読む）
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE a.source_id = ? AND a.deleted_at IS NULL AND a.id > ? AND a.summary_status <> 'pending'
+ORDER BY a.id ASC
+LIMIT ?`)).
+		WithArgs(int64(3), int64(120), 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	got, err := sqlite.NewArticleStreamRepo(db).ListArticlesAfter(context.Background(),
+		nil, repository.ArticleSearchFilters{SourceID: &sourceID}, 120, 100)
+	if err != nil {
+		t.Fatalf("ListArticlesAfter err=%v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("ListArticlesAfter = %+v, want empty", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArticleStreamRepo_LatestArticleID(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(MAX(id), 0) FROM articles`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(41)))
+
+	got, err := sqlite.NewArticleStreamRepo(db).LatestArticleID(context.Background())
+	if err != nil || got != 41 {
+		t.Fatalf("LatestArticleID = %d, %v; want 41", got, err)
+	}
+}
+
+func TestArticleStreamRepo_PendingAndSettledArticles(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+	repo := sqlite.NewArticleStreamRepo(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE id > ? AND summary_status = 'pending' AND deleted_at IS NULL`)).
+		WithArgs(int64(120)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(122)).AddRow(int64(125)))
+	pending, err := repo.ListPendingArticleIDs(context.Background(), 120)
+	if err != nil || len(pending) != 2 || pending[0] != 122 {
+		t.Fatalf("ListPendingArticleIDs = %v, %v; want [122 125]", pending, err)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE a.source_id = ? AND a.deleted_at IS NULL AND a.summary_status <> 'pending' AND a.id IN (?, ?)
+ORDER BY a.id ASC`)).
+		WithArgs(int64(3), int64(122), int64(125)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	sourceID := int64(3)
+	got, err := repo.ListSettledArticles(context.Background(), nil, repository.ArticleSearchFilters{SourceID: &sourceID}, pending)
+	if err != nil || len(got) != 0 {
+		t.Fatalf("ListSettledArticles = %+v, %v; want none", got, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
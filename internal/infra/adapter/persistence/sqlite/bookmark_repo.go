@@ -0,0 +1,338 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+// articleWithSourceColumns lists the article columns in articleRow.dest order,
+// followed by the source name.
+const articleWithSourceColumns = `a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name`
+
+type BookmarkRepo struct {
+	db *sql.DB
+}
+
+func NewBookmarkRepo(db *sql.DB) repository.BookmarkRepository {
+	return &BookmarkRepo{db: db}
+}
+
+func (repo *BookmarkRepo) AddBookmark(ctx context.Context, userID string, articleID int64) (bool, error) {
+	// 記事が存在しない場合は SELECT が0行になる。既存のブックマークは日時を変えない
+	const query = `
+INSERT INTO bookmarks (user_id, article_id, created_at)
+SELECT ?, id, ? FROM articles WHERE id = ? AND deleted_at IS NULL
+ON CONFLICT (user_id, article_id) DO NOTHING`
+	res, err := repo.db.ExecContext(ctx, query, userID, time.Now(), articleID)
+	if err != nil {
+		return false, fmt.Errorf("AddBookmark: ExecContext: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("AddBookmark: RowsAffected: %w", err)
+	}
+	if n > 0 {
+		return true, nil
+	}
+
+	// 0行は記事がないか、既にブックマーク済み
+	var exists bool
+	if err := repo.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM articles WHERE id = ? AND deleted_at IS NULL)`, articleID).
+		Scan(&exists); err != nil {
+		return false, fmt.Errorf("AddBookmark: QueryRowContext: %w", err)
+	}
+	return exists, nil
+}
+
+func (repo *BookmarkRepo) RemoveBookmark(ctx context.Context, userID string, articleID int64) (bool, error) {
+	const query = `DELETE FROM bookmarks WHERE user_id = ? AND article_id = ?`
+	res, err := repo.db.ExecContext(ctx, query, userID, articleID)
+	if err != nil {
+		return false, fmt.Errorf("RemoveBookmark: ExecContext: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("RemoveBookmark: RowsAffected: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (repo *BookmarkRepo) ListBookmarks(ctx context.Context, userID string, offset, limit int) ([]repository.BookmarkedArticle, error) {
+	const query = `
+SELECT ` + articleWithSourceColumns + `, b.created_at
+FROM bookmarks b
+INNER JOIN articles a ON a.id = b.article_id
+INNER JOIN sources s ON a.source_id = s.id
+WHERE b.user_id = ? AND a.deleted_at IS NULL
+ORDER BY b.created_at DESC, a.id DESC
+LIMIT ? OFFSET ?`
+	rows, err := repo.db.QueryContext(ctx, query, userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("ListBookmarks: QueryContext: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	result := make([]repository.BookmarkedArticle, 0, limit)
+	for rows.Next() {
+		var row articleRow
+		var b repository.BookmarkedArticle
+		if err := rows.Scan(row.dest(&b.SourceName, &b.BookmarkedAt)...); err != nil {
+			return nil, fmt.Errorf("ListBookmarks: Scan: %w", err)
+		}
+		b.Article = row.toEntity()
+		result = append(result, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ListBookmarks: rows.Err: %w", err)
+	}
+	return result, nil
+}
+
+func (repo *BookmarkRepo) CountBookmarks(ctx context.Context, userID string) (int64, error) {
+	const query = `SELECT COUNT(*) FROM bookmarks WHERE user_id = ?`
+	var count int64
+	if err := repo.db.QueryRowContext(ctx, query, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("CountBookmarks: QueryRowContext: %w", err)
+	}
+	return count, nil
+}
+
+type ReadingListRepo struct {
+	db *sql.DB
+}
+
+func NewReadingListRepo(db *sql.DB) repository.ReadingListRepository {
+	return &ReadingListRepo{db: db}
+}
+
+const readingListColumns = `l.id, l.user_id, l.name, l.description, COALESCE(l.share_token, ''), l.created_at, l.updated_at`
+
+// readingListDest returns the Scan destinations in readingListColumns order.
+func readingListDest(l *entity.ReadingList) []any {
+	return []any{&l.ID, &l.UserID, &l.Name, &l.Description, &l.ShareToken, &l.CreatedAt, &l.UpdatedAt}
+}
+
+func (repo *ReadingListRepo) ListLists(ctx context.Context, userID string) ([]*entity.ReadingList, error) {
+	const query = `
+SELECT ` + readingListColumns + `, (SELECT COUNT(*) FROM reading_list_items i WHERE i.list_id = l.id)
+FROM reading_lists l
+WHERE l.user_id = ?
+ORDER BY l.id`
+	rows, err := repo.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("ListLists: QueryContext: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var lists []*entity.ReadingList
+	for rows.Next() {
+		var l entity.ReadingList
+		if err := rows.Scan(append(readingListDest(&l), &l.ItemCount)...); err != nil {
+			return nil, fmt.Errorf("ListLists: Scan: %w", err)
+		}
+		lists = append(lists, &l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ListLists: rows.Err: %w", err)
+	}
+	return lists, nil
+}
+
+func (repo *ReadingListRepo) GetList(ctx context.Context, userID string, id int64) (*entity.ReadingList, error) {
+	const query = `SELECT ` + readingListColumns + ` FROM reading_lists l WHERE l.id = ? AND l.user_id = ?`
+	return repo.getList(ctx, "GetList", query, id, userID)
+}
+
+func (repo *ReadingListRepo) GetListByShareToken(ctx context.Context, token string) (*entity.ReadingList, error) {
+	const query = `SELECT ` + readingListColumns + ` FROM reading_lists l WHERE l.share_token = ?`
+	return repo.getList(ctx, "GetListByShareToken", query, token)
+}
+
+// getList returns the single list selected by query, or nil if there is none.
+func (repo *ReadingListRepo) getList(ctx context.Context, op, query string, args ...any) (*entity.ReadingList, error) {
+	var l entity.ReadingList
+	err := repo.db.QueryRowContext(ctx, query, args...).Scan(readingListDest(&l)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: QueryRowContext: %w", op, err)
+	}
+	return &l, nil
+}
+
+func (repo *ReadingListRepo) CreateList(ctx context.Context, list *entity.ReadingList) error {
+	const query = `
+INSERT INTO reading_lists (user_id, name, description, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?)`
+	now := time.Now()
+	res, err := repo.db.ExecContext(ctx, query, list.UserID, list.Name, list.Description, now, now)
+	if err != nil {
+		return fmt.Errorf("CreateList: ExecContext: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("CreateList: LastInsertId: %w", err)
+	}
+	list.ID = id
+	list.CreatedAt = now
+	list.UpdatedAt = now
+	return nil
+}
+
+func (repo *ReadingListRepo) UpdateList(ctx context.Context, list *entity.ReadingList) (bool, error) {
+	const query = `UPDATE reading_lists SET name = ?, description = ?, updated_at = ? WHERE id = ? AND user_id = ?`
+	now := time.Now()
+	res, err := repo.db.ExecContext(ctx, query, list.Name, list.Description, now, list.ID, list.UserID)
+	if err != nil {
+		return false, fmt.Errorf("UpdateList: ExecContext: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("UpdateList: RowsAffected: %w", err)
+	}
+	if n == 0 {
+		return false, nil
+	}
+	list.UpdatedAt = now
+	return true, nil
+}
+
+func (repo *ReadingListRepo) DeleteList(ctx context.Context, userID string, id int64) (bool, error) {
+	const query = `DELETE FROM reading_lists WHERE id = ? AND user_id = ?`
+	res, err := repo.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return false, fmt.Errorf("DeleteList: ExecContext: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("DeleteList: RowsAffected: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (repo *ReadingListRepo) SetShareToken(ctx context.Context, userID string, id int64, token string) (bool, error) {
+	// 共有しない場合は NULL にする（UNIQUE 制約は NULL 同士を区別しない）
+	const query = `UPDATE reading_lists SET share_token = NULLIF(?, '') WHERE id = ? AND user_id = ?`
+	res, err := repo.db.ExecContext(ctx, query, token, id, userID)
+	if err != nil {
+		return false, fmt.Errorf("SetShareToken: ExecContext: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("SetShareToken: RowsAffected: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (repo *ReadingListRepo) ListItems(ctx context.Context, listID int64) ([]repository.ReadingListEntry, error) {
+	const query = `
+SELECT ` + articleWithSourceColumns + `, i.list_id, i.position, i.note, i.added_at
+FROM reading_list_items i
+INNER JOIN articles a ON a.id = i.article_id
+INNER JOIN sources s ON a.source_id = s.id
+WHERE i.list_id = ? AND a.deleted_at IS NULL
+ORDER BY i.position, i.added_at`
+	rows, err := repo.db.QueryContext(ctx, query, listID)
+	if err != nil {
+		return nil, fmt.Errorf("ListItems: QueryContext: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var entries []repository.ReadingListEntry
+	for rows.Next() {
+		var row articleRow
+		var e repository.ReadingListEntry
+		if err := rows.Scan(row.dest(&e.SourceName, &e.Item.ListID, &e.Item.Position, &e.Item.Note, &e.Item.AddedAt)...); err != nil {
+			return nil, fmt.Errorf("ListItems: Scan: %w", err)
+		}
+		e.Article = row.toEntity()
+		e.Item.ArticleID = e.Article.ID
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ListItems: rows.Err: %w", err)
+	}
+	return entries, nil
+}
+
+func (repo *ReadingListRepo) AddItem(ctx context.Context, item *entity.ReadingListItem) (bool, error) {
+	// 記事が存在しない場合は SELECT が0行になり、何も挿入されない
+	const insert = `
+INSERT INTO reading_list_items (list_id, article_id, position, note, added_at)
+SELECT ?, a.id, COALESCE((SELECT MAX(position) FROM reading_list_items WHERE list_id = ?), 0) + 1, ?, ?
+FROM articles a WHERE a.id = ? AND a.deleted_at IS NULL
+ON CONFLICT (list_id, article_id) DO UPDATE SET note = excluded.note`
+	const selectItem = `SELECT position, added_at FROM reading_list_items WHERE list_id = ? AND article_id = ?`
+
+	res, err := repo.db.ExecContext(ctx, insert, item.ListID, item.ListID, item.Note, time.Now(), item.ArticleID)
+	if err != nil {
+		return false, fmt.Errorf("AddItem: ExecContext: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("AddItem: RowsAffected: %w", err)
+	}
+	if n == 0 {
+		return false, nil
+	}
+
+	if err := repo.db.QueryRowContext(ctx, selectItem, item.ListID, item.ArticleID).
+		Scan(&item.Position, &item.AddedAt); err != nil {
+		return false, fmt.Errorf("AddItem: QueryRowContext: %w", err)
+	}
+	return true, nil
+}
+
+func (repo *ReadingListRepo) UpdateItemNote(ctx context.Context, listID, articleID int64, note string) (bool, error) {
+	const query = `UPDATE reading_list_items SET note = ? WHERE list_id = ? AND article_id = ?`
+	res, err := repo.db.ExecContext(ctx, query, note, listID, articleID)
+	if err != nil {
+		return false, fmt.Errorf("UpdateItemNote: ExecContext: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("UpdateItemNote: RowsAffected: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (repo *ReadingListRepo) RemoveItem(ctx context.Context, listID, articleID int64) (bool, error) {
+	const query = `DELETE FROM reading_list_items WHERE list_id = ? AND article_id = ?`
+	res, err := repo.db.ExecContext(ctx, query, listID, articleID)
+	if err != nil {
+		return false, fmt.Errorf("RemoveItem: ExecContext: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("RemoveItem: RowsAffected: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (repo *ReadingListRepo) ReorderItems(ctx context.Context, listID int64, articleIDs []int64) error {
+	const query = `UPDATE reading_list_items SET position = ? WHERE list_id = ? AND article_id = ?`
+
+	// 並び順が途中の状態で見えないよう、1トランザクションで更新する
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("ReorderItems: BeginTx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for i, articleID := range articleIDs {
+		if _, err := tx.ExecContext(ctx, query, i+1, listID, articleID); err != nil {
+			return fmt.Errorf("ReorderItems: ExecContext: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("ReorderItems: Commit: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/infra/adapter/persistence/sqlite"
+)
+
+var readingListColumnNames = []string{"id", "user_id", "name", "description", "share_token", "created_at", "updated_at"}
+
+func TestBookmarkRepo_AddBookmark(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		exists   bool
+		want     bool
+	}{
+		{name: "bookmarked", affected: 1, want: true},
+		{name: "already bookmarked", affected: 0, exists: true, want: true},
+		{name: "article not found", affected: 0, exists: false, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, _ := sqlmock.New()
+			defer func() { _ = db.Close() }()
+
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO bookmarks (user_id, article_id, created_at)
+SELECT ?, id, ? FROM articles WHERE id = ? AND deleted_at IS NULL
+ON CONFLICT (user_id, article_id) DO NOTHING`)).
+				WithArgs("alice", sqlmock.AnyArg(), int64(7)).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+			if tt.affected == 0 {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS (SELECT 1 FROM articles WHERE id = ? AND deleted_at IS NULL)`)).
+					WithArgs(int64(7)).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(tt.exists))
+			}
+
+			got, err := sqlite.NewBookmarkRepo(db).AddBookmark(context.Background(), "alice", 7)
+			if err != nil {
+				t.Fatalf("AddBookmark err=%v", err)
+			}
+			if got != tt.want {
+				t.Errorf("AddBookmark = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestBookmarkRepo_ListBookmarks(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`(?s)FROM bookmarks b.*WHERE b.user_id = \? AND a.deleted_at IS NULL\s+ORDER BY b.created_at DESC, a.id DESC\s+LIMIT \? OFFSET \?`).
+		WithArgs("alice", 20, 0).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "source_id", "title", "url",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name", "created_at",
+		}).AddRow(2, 10, "Go 1.25", "https://example.com/2", "Summary", now, now, nil, "", "", "", "", "", "Go Blog", now))
+
+	got, err := sqlite.NewBookmarkRepo(db).ListBookmarks(context.Background(), "alice", 0, 20)
+	if err != nil {
+		t.Fatalf("ListBookmarks err=%v", err)
+	}
+	if len(got) != 1 || got[0].Article.ID != 2 || got[0].SourceName != "Go Blog" || !got[0].BookmarkedAt.Equal(now) {
+		t.Errorf("ListBookmarks = %+v", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadingListRepo_CreateList(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO reading_lists (user_id, name, description, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?)`)).
+		WithArgs("alice", "weekly", "", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+
+	list := &entity.ReadingList{UserID: "alice", Name: "weekly"}
+	if err := sqlite.NewReadingListRepo(db).CreateList(context.Background(), list); err != nil {
+		t.Fatalf("CreateList err=%v", err)
+	}
+	if list.ID != 5 || list.CreatedAt.IsZero() || !list.UpdatedAt.Equal(list.CreatedAt) {
+		t.Errorf("list = %+v", list)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadingListRepo_GetListByShareToken_NotFound(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM reading_lists l WHERE l.share_token = ?`)).
+		WithArgs("unknown").
+		WillReturnRows(sqlmock.NewRows(readingListColumnNames))
+
+	got, err := sqlite.NewReadingListRepo(db).GetListByShareToken(context.Background(), "unknown")
+	if err != nil || got != nil {
+		t.Fatalf("GetListByShareToken = %+v, %v", got, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadingListRepo_UpdateList(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE reading_lists SET name = ?, description = ?, updated_at = ? WHERE id = ? AND user_id = ?`)).
+		WithArgs("weekly", "週次で読む", sqlmock.AnyArg(), int64(5), "alice").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	list := &entity.ReadingList{ID: 5, UserID: "alice", Name: "weekly", Description: "週次で読む"}
+	found, err := sqlite.NewReadingListRepo(db).UpdateList(context.Background(), list)
+	if err != nil || !found || list.UpdatedAt.IsZero() {
+		t.Fatalf("UpdateList = %v, %v (list %+v)", found, err, list)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadingListRepo_AddItem(t *testing.T) {
+	const insert = `INSERT INTO reading_list_items (list_id, article_id, position, note, added_at)
+SELECT ?, a.id, COALESCE((SELECT MAX(position) FROM reading_list_items WHERE list_id = ?), 0) + 1, ?, ?
+FROM articles a WHERE a.id = ? AND a.deleted_at IS NULL
+ON CONFLICT (list_id, article_id) DO UPDATE SET note = excluded.note`
+
+	t.Run("appended", func(t *testing.T) {
+		db, mock, _ := sqlmock.New()
+		defer func() { _ = db.Close() }()
+
+		now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		mock.ExpectExec(regexp.QuoteMeta(insert)).
+			WithArgs(int64(5), int64(5), "あとで", sqlmock.AnyArg(), int64(7)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT position, added_at FROM reading_list_items WHERE list_id = ? AND article_id = ?`)).
+			WithArgs(int64(5), int64(7)).
+			WillReturnRows(sqlmock.NewRows([]string{"position", "added_at"}).AddRow(3, now))
+
+		item := &entity.ReadingListItem{ListID: 5, ArticleID: 7, Note: "あとで"}
+		found, err := sqlite.NewReadingListRepo(db).AddItem(context.Background(), item)
+		if err != nil || !found || item.Position != 3 || !item.AddedAt.Equal(now) {
+			t.Fatalf("AddItem = %v, %v (item %+v)", found, err, item)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("article not found", func(t *testing.T) {
+		db, mock, _ := sqlmock.New()
+		defer func() { _ = db.Close() }()
+
+		mock.ExpectExec(regexp.QuoteMeta(insert)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		found, err := sqlite.NewReadingListRepo(db).AddItem(context.Background(), &entity.ReadingListItem{ListID: 5, ArticleID: 7})
+		if err != nil || found {
+			t.Fatalf("AddItem = %v, %v", found, err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestReadingListRepo_ReorderItems(t *testing.T) {
+	const update = `UPDATE reading_list_items SET position = ? WHERE list_id = ? AND article_id = ?`
+
+	t.Run("committed", func(t *testing.T) {
+		db, mock, _ := sqlmock.New()
+		defer func() { _ = db.Close() }()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(update)).WithArgs(1, int64(5), int64(9)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(update)).WithArgs(2, int64(5), int64(7)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		if err := sqlite.NewReadingListRepo(db).ReorderItems(context.Background(), 5, []int64{9, 7}); err != nil {
+			t.Fatalf("ReorderItems err=%v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("rolled back on error", func(t *testing.T) {
+		db, mock, _ := sqlmock.New()
+		defer func() { _ = db.Close() }()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(update)).WillReturnError(errors.New("db down"))
+		mock.ExpectRollback()
+
+		err := sqlite.NewReadingListRepo(db).ReorderItems(context.Background(), 5, []int64{9, 7})
+		if err == nil || err.Error() != "ReorderItems: ExecContext: db down" {
+			t.Fatalf("ReorderItems err=%v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,207 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"catchup-feed/internal/repository"
+)
+
+type BulkRepo struct {
+	db           *sql.DB
+	queryBuilder *ArticleQueryBuilder
+}
+
+func NewBulkRepo(db *sql.DB) repository.BulkRepository {
+	return &BulkRepo{
+		db:           db,
+		queryBuilder: NewArticleQueryBuilder(),
+	}
+}
+
+func (repo *BulkRepo) FindArticleIDs(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters, limit int) ([]int64, error) {
+	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters, "a")
+	args = append(args, limit)
+
+	// #nosec G202 -- whereClause is generated by QueryBuilder using parameterized placeholders (?)
+	query := `
+SELECT a.id
+FROM articles a
+` + andCondition(whereClause, "a.deleted_at IS NULL") + `
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT ?`
+
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("FindArticleIDs: QueryContext: %w", err)
+	}
+	return scanIDs(rows, "FindArticleIDs")
+}
+
+// SQLite には UPDATE ... RETURNING がない版もあるため、各メソッドはトランザクション内で
+// 対象の ID を SELECT してから、その ID だけをまとめて更新する
+
+func (repo *BulkRepo) DeleteArticles(ctx context.Context, ids []int64) ([]int64, error) {
+	return repo.apply(ctx, "DeleteArticles", `SELECT id FROM articles WHERE id IN (%s) AND deleted_at IS NULL ORDER BY id`, ids, nil,
+		func(tx *sql.Tx, in string, args []interface{}) error {
+			// #nosec G202 -- in only contains placeholders (?)
+			query := `UPDATE articles SET deleted_at = ? WHERE id IN (` + in + `)`
+			_, err := tx.ExecContext(ctx, query, append([]interface{}{time.Now().UTC()}, args...)...)
+			return err
+		})
+}
+
+func (repo *BulkRepo) MoveArticles(ctx context.Context, ids []int64, sourceID int64) ([]int64, error) {
+	return repo.apply(ctx, "MoveArticles", `
+SELECT id FROM articles
+WHERE id IN (%s) AND deleted_at IS NULL
+  AND EXISTS (SELECT 1 FROM sources WHERE id = ? AND deleted_at IS NULL)
+ORDER BY id`, ids, []interface{}{sourceID},
+		func(tx *sql.Tx, in string, args []interface{}) error {
+			// #nosec G202 -- in only contains placeholders (?)
+			query := `UPDATE articles SET source_id = ? WHERE id IN (` + in + `)`
+			_, err := tx.ExecContext(ctx, query, append([]interface{}{sourceID}, args...)...)
+			return err
+		})
+}
+
+func (repo *BulkRepo) RetagArticles(ctx context.Context, ids []int64, add, remove []string, origin string) ([]int64, error) {
+	return repo.apply(ctx, "RetagArticles", `SELECT id FROM articles WHERE id IN (%s) AND deleted_at IS NULL ORDER BY id`, ids, nil,
+		func(tx *sql.Tx, in string, args []interface{}) error {
+			if len(add) > 0 {
+				names, nameArgs := nameList(add)
+				// #nosec G202 -- only placeholders (?) are concatenated
+				insertTags := `INSERT INTO tags (name) VALUES (` + strings.Repeat("?), (", len(add)-1) + `?) ON CONFLICT (name) DO NOTHING`
+				if _, err := tx.ExecContext(ctx, insertTags, nameArgs...); err != nil {
+					return fmt.Errorf("insert tags: %w", err)
+				}
+				// #nosec G202 -- in and names only contain placeholders (?)
+				insertArticleTags := `
+INSERT INTO article_tags (article_id, tag_id, origin)
+SELECT a.id, t.id, ?
+FROM articles a
+CROSS JOIN tags t
+WHERE a.id IN (` + in + `) AND t.name IN (` + names + `)
+ON CONFLICT (article_id, tag_id) DO NOTHING`
+				insertArgs := append(append([]interface{}{origin}, args...), nameArgs...)
+				if _, err := tx.ExecContext(ctx, insertArticleTags, insertArgs...); err != nil {
+					return fmt.Errorf("insert article tags: %w", err)
+				}
+			}
+			if len(remove) > 0 {
+				names, nameArgs := nameList(remove)
+				// #nosec G202 -- in and names only contain placeholders (?)
+				deleteArticleTags := `
+DELETE FROM article_tags
+WHERE article_id IN (` + in + `)
+  AND tag_id IN (SELECT id FROM tags WHERE name IN (` + names + `))`
+				if _, err := tx.ExecContext(ctx, deleteArticleTags, append(args, nameArgs...)...); err != nil {
+					return fmt.Errorf("delete article tags: %w", err)
+				}
+			}
+			return nil
+		})
+}
+
+func (repo *BulkRepo) SetSourcesActive(ctx context.Context, ids []int64, active bool) ([]int64, error) {
+	return repo.apply(ctx, "SetSourcesActive", `SELECT id FROM sources WHERE id IN (%s) AND deleted_at IS NULL ORDER BY id`, ids, nil,
+		func(tx *sql.Tx, in string, args []interface{}) error {
+			// #nosec G202 -- in only contains placeholders (?)
+			query := `UPDATE sources SET active = ? WHERE id IN (` + in + `)`
+			_, err := tx.ExecContext(ctx, query, append([]interface{}{active}, args...)...)
+			return err
+		})
+}
+
+func (repo *BulkRepo) DeleteSources(ctx context.Context, ids []int64) ([]int64, error) {
+	return repo.apply(ctx, "DeleteSources", `SELECT id FROM sources WHERE id IN (%s) AND deleted_at IS NULL ORDER BY id`, ids, nil,
+		func(tx *sql.Tx, in string, args []interface{}) error {
+			// SourceRepo.Delete と同じく、記事にはソースと同じ deleted_at を付ける
+			deleteArgs := append([]interface{}{time.Now().UTC()}, args...)
+			// #nosec G202 -- in only contains placeholders (?)
+			trashSources := `UPDATE sources SET deleted_at = ? WHERE id IN (` + in + `)`
+			if _, err := tx.ExecContext(ctx, trashSources, deleteArgs...); err != nil {
+				return fmt.Errorf("sources: %w", err)
+			}
+			// #nosec G202 -- in only contains placeholders (?)
+			trashArticles := `UPDATE articles SET deleted_at = ? WHERE source_id IN (` + in + `) AND deleted_at IS NULL`
+			if _, err := tx.ExecContext(ctx, trashArticles, deleteArgs...); err != nil {
+				return fmt.Errorf("articles: %w", err)
+			}
+			return nil
+		})
+}
+
+// apply selects the target IDs among ids with selectQuery (whose %s is replaced
+// by the IN list of ids, followed by the extra arguments) and calls update with
+// the IN list of the found IDs, all in one transaction. It returns the found IDs.
+func (repo *BulkRepo) apply(ctx context.Context, op, selectQuery string, ids []int64, extra []interface{},
+	update func(tx *sql.Tx, in string, args []interface{}) error) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: BeginTx: %w", op, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	in, args := idList(ids)
+	// #nosec G201 -- in only contains placeholders (?)
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(selectQuery, in), append(args, extra...)...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: QueryContext: %w", op, err)
+	}
+	found, err := scanIDs(rows, op)
+	if err != nil || len(found) == 0 {
+		return found, err
+	}
+
+	in, args = idList(found)
+	if err := update(tx, in, args); err != nil {
+		return nil, fmt.Errorf("%s: ExecContext: %w", op, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: Commit: %w", op, err)
+	}
+	return found, nil
+}
+
+// idList returns the placeholders ("?, ?, ...") and the arguments of an IN list of ids.
+func idList(ids []int64) (string, []interface{}) {
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	return strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", "), args
+}
+
+// nameList is idList for tag names.
+func nameList(names []string) (string, []interface{}) {
+	args := make([]interface{}, len(names))
+	for i, name := range names {
+		args[i] = name
+	}
+	return strings.TrimSuffix(strings.Repeat("?, ", len(names)), ", "), args
+}
+
+// scanIDs reads and closes rows of a single ID column.
+func scanIDs(rows *sql.Rows, op string) ([]int64, error) {
+	defer func() { _ = rows.Close() }()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("%s: Scan: %w", op, err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows.Err: %w", op, err)
+	}
+	return ids, nil
+}
@@ -0,0 +1,193 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"catchup-feed/internal/infra/adapter/persistence/sqlite"
+	"catchup-feed/internal/repository"
+)
+
+func TestBulkRepo_FindArticleIDs(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	sourceID := int64(3)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT a.id
+FROM articles a
+WHERE (a.title LIKE ? OR a.summary LIKE ?) AND a.source_id = ? AND a.deleted_at IS NULL
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT ?`)).
+		WithArgs("%spam%", "%spam%", sourceID, 501).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(9)).AddRow(int64(7)))
+
+	got, err := sqlite.NewBulkRepo(db).FindArticleIDs(context.Background(), []string{"spam"},
+		repository.ArticleSearchFilters{SourceID: &sourceID}, 501)
+	if err != nil {
+		t.Fatalf("FindArticleIDs err=%v", err)
+	}
+	if !reflect.DeepEqual(got, []int64{9, 7}) {
+		t.Errorf("FindArticleIDs = %v, want [9 7]", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBulkRepo_DeleteArticles(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	// 存在する記事だけをまとめて更新する
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM articles WHERE id IN (?, ?, ?) AND deleted_at IS NULL ORDER BY id`)).
+		WithArgs(int64(1), int64(2), int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)).AddRow(int64(3)))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE articles SET deleted_at = ? WHERE id IN (?, ?)`)).
+		WithArgs(sqlmock.AnyArg(), int64(1), int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	got, err := sqlite.NewBulkRepo(db).DeleteArticles(context.Background(), []int64{1, 2, 3})
+	if err != nil {
+		t.Fatalf("DeleteArticles err=%v", err)
+	}
+	if !reflect.DeepEqual(got, []int64{1, 3}) {
+		t.Errorf("DeleteArticles = %v, want [1 3]", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBulkRepo_MoveArticles_SourceMissing(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`AND EXISTS (SELECT 1 FROM sources WHERE id = ? AND deleted_at IS NULL)`)).
+		WithArgs(int64(1), int64(2), int64(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	got, err := sqlite.NewBulkRepo(db).MoveArticles(context.Background(), []int64{1, 2}, 5)
+	if err != nil || len(got) != 0 {
+		t.Fatalf("MoveArticles = %v, %v; want nothing moved", got, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBulkRepo_RetagArticles(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM articles WHERE id IN (?, ?) AND deleted_at IS NULL ORDER BY id`)).
+		WithArgs(int64(1), int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)).AddRow(int64(2)))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO tags (name) VALUES (?), (?) ON CONFLICT (name) DO NOTHING`)).
+		WithArgs("go", "release").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`WHERE a.id IN (?, ?) AND t.name IN (?, ?)`)).
+		WithArgs("manual", int64(1), int64(2), "go", "release").
+		WillReturnResult(sqlmock.NewResult(0, 4))
+	mock.ExpectExec(regexp.QuoteMeta(`AND tag_id IN (SELECT id FROM tags WHERE name IN (?))`)).
+		WithArgs(int64(1), int64(2), "spam").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	got, err := sqlite.NewBulkRepo(db).RetagArticles(context.Background(), []int64{1, 2}, []string{"go", "release"}, []string{"spam"}, "manual")
+	if err != nil {
+		t.Fatalf("RetagArticles err=%v", err)
+	}
+	if !reflect.DeepEqual(got, []int64{1, 2}) {
+		t.Errorf("RetagArticles = %v, want [1 2]", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBulkRepo_SetSourcesActive(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM sources WHERE id IN (?, ?) AND deleted_at IS NULL ORDER BY id`)).
+		WithArgs(int64(4), int64(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(4)))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE sources SET active = ? WHERE id IN (?)`)).
+		WithArgs(true, int64(4)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	got, err := sqlite.NewBulkRepo(db).SetSourcesActive(context.Background(), []int64{4, 5}, true)
+	if err != nil {
+		t.Fatalf("SetSourcesActive err=%v", err)
+	}
+	if !reflect.DeepEqual(got, []int64{4}) {
+		t.Errorf("SetSourcesActive = %v, want [4]", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBulkRepo_DeleteSources(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM sources WHERE id IN (?, ?) AND deleted_at IS NULL ORDER BY id`)).
+		WithArgs(int64(4), int64(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(4)).AddRow(int64(5)))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE sources SET deleted_at = ? WHERE id IN (?, ?)`)).
+		WithArgs(sqlmock.AnyArg(), int64(4), int64(5)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE articles SET deleted_at = ? WHERE source_id IN (?, ?) AND deleted_at IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), int64(4), int64(5)).
+		WillReturnResult(sqlmock.NewResult(0, 30))
+	mock.ExpectCommit()
+
+	got, err := sqlite.NewBulkRepo(db).DeleteSources(context.Background(), []int64{4, 5})
+	if err != nil {
+		t.Fatalf("DeleteSources err=%v", err)
+	}
+	if !reflect.DeepEqual(got, []int64{4, 5}) {
+		t.Errorf("DeleteSources = %v, want [4 5]", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBulkRepo_DeleteSources_RollsBackOnError(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM sources`).
+		WithArgs(int64(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(4)))
+	mock.ExpectExec(`UPDATE sources SET deleted_at`).
+		WithArgs(sqlmock.AnyArg(), int64(4)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE articles SET deleted_at`).
+		WithArgs(sqlmock.AnyArg(), int64(4)).
+		WillReturnError(errors.New("disk full"))
+	mock.ExpectRollback()
+
+	if _, err := sqlite.NewBulkRepo(db).DeleteSources(context.Background(), []int64{4}); err == nil {
+		t.Fatal("DeleteSources should return error")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,94 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/infra/adapter/persistence/sqlite"
+	"catchup-feed/internal/repository"
+)
+
+func TestCategoryRepo_ListCategories(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("FROM source_categories c").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "created_at", "count"}).
+			AddRow(int64(2), "Go", now, int64(12)))
+
+	got, err := sqlite.NewCategoryRepo(db).ListCategories(context.Background())
+	if err != nil {
+		t.Fatalf("ListCategories err=%v", err)
+	}
+	want := []repository.CategoryCount{{Category: &entity.Category{ID: 2, Name: "Go", CreatedAt: now}, SourceCount: 12}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListCategories mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestCategoryRepo_CreateCategory(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec("INSERT INTO source_categories").
+		WithArgs("Go", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(3, 1))
+
+	c := &entity.Category{Name: "Go"}
+	if err := sqlite.NewCategoryRepo(db).CreateCategory(context.Background(), c); err != nil {
+		t.Fatalf("CreateCategory err=%v", err)
+	}
+	if c.ID != 3 || c.CreatedAt.IsZero() {
+		t.Errorf("category = %+v, want ID and CreatedAt set", c)
+	}
+}
+
+func TestCategoryRepo_DeleteCategory(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	// 割り当ても同じトランザクションで削除する
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM source_category_members WHERE category_id").
+		WithArgs(int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec("DELETE FROM source_categories WHERE id").
+		WithArgs(int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	ok, err := sqlite.NewCategoryRepo(db).DeleteCategory(context.Background(), 2)
+	if err != nil || !ok {
+		t.Fatalf("DeleteCategory = %v, %v; want true, nil", ok, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestCategoryRepo_SetSourceCategories(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM source_category_members WHERE source_id").
+		WithArgs(int64(5)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO source_category_members").
+		WithArgs(int64(5), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if err := sqlite.NewCategoryRepo(db).SetSourceCategories(context.Background(), 5, []int64{2}); err != nil {
+		t.Fatalf("SetSourceCategories err=%v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
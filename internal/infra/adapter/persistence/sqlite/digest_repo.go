@@ -0,0 +1,244 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+type DigestRepo struct {
+	db *sql.DB
+}
+
+func NewDigestRepo(db *sql.DB) repository.DigestRepository {
+	return &DigestRepo{db: db}
+}
+
+const digestColumns = `id, period, group_by, title, overview, period_start, period_end, article_count, groups, pending_article_ids, created_at`
+
+// digestRow holds the scan destinations for a digests row.
+type digestRow struct {
+	digest  entity.Digest
+	groups  []byte
+	pending []byte
+}
+
+// dest returns the Scan destinations in digestColumns order.
+func (r *digestRow) dest() []any {
+	d := &r.digest
+	return []any{
+		&d.ID, &d.Period, &d.GroupBy, &d.Title, &d.Overview,
+		&d.PeriodStart, &d.PeriodEnd, &d.ArticleCount, &r.groups, &r.pending, &d.CreatedAt,
+	}
+}
+
+// toEntity converts the scanned row into a digest entity.
+func (r *digestRow) toEntity() (*entity.Digest, error) {
+	d := r.digest
+	if err := json.Unmarshal(r.groups, &d.Groups); err != nil {
+		return nil, fmt.Errorf("decode groups of digest %d: %w", d.ID, err)
+	}
+	if err := json.Unmarshal(r.pending, &d.PendingArticleIDs); err != nil {
+		return nil, fmt.Errorf("decode pending articles of digest %d: %w", d.ID, err)
+	}
+	return &d, nil
+}
+
+func (repo *DigestRepo) CreateDigest(ctx context.Context, digest *entity.Digest) error {
+	const query = `
+INSERT INTO digests (period, group_by, title, overview, period_start, period_end, article_count, groups, pending_article_ids, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	groups, err := json.Marshal(digest.Groups)
+	if err != nil {
+		return fmt.Errorf("CreateDigest: marshal groups: %w", err)
+	}
+	pending, err := marshalPendingIDs(digest.PendingArticleIDs)
+	if err != nil {
+		return fmt.Errorf("CreateDigest: %w", err)
+	}
+	createdAt := time.Now()
+	res, err := repo.db.ExecContext(ctx, query,
+		digest.Period, digest.GroupBy, digest.Title, digest.Overview,
+		digest.PeriodStart, digest.PeriodEnd, digest.ArticleCount, string(groups), pending, createdAt,
+	)
+	if err != nil {
+		return fmt.Errorf("CreateDigest: ExecContext: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("CreateDigest: LastInsertId: %w", err)
+	}
+	digest.ID = id
+	digest.CreatedAt = createdAt
+	return nil
+}
+
+func (repo *DigestRepo) GetDigest(ctx context.Context, id int64) (*entity.Digest, error) {
+	query := `SELECT ` + digestColumns + ` FROM digests WHERE id = ?`
+	var row digestRow
+	err := repo.db.QueryRowContext(ctx, query, id).Scan(row.dest()...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("GetDigest: QueryRowContext: %w", err)
+	}
+	d, err := row.toEntity()
+	if err != nil {
+		return nil, fmt.Errorf("GetDigest: %w", err)
+	}
+	return d, nil
+}
+
+func (repo *DigestRepo) LatestDigest(ctx context.Context, period string) (*entity.Digest, error) {
+	query := `SELECT ` + digestColumns + `
+FROM digests
+WHERE period = ?
+ORDER BY period_end DESC, id DESC
+LIMIT 1`
+	var row digestRow
+	err := repo.db.QueryRowContext(ctx, query, period).Scan(row.dest()...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("LatestDigest: QueryRowContext: %w", err)
+	}
+	d, err := row.toEntity()
+	if err != nil {
+		return nil, fmt.Errorf("LatestDigest: %w", err)
+	}
+	return d, nil
+}
+
+func (repo *DigestRepo) ListDigests(ctx context.Context, offset, limit int) ([]*entity.Digest, error) {
+	query := `SELECT ` + digestColumns + `
+FROM digests
+ORDER BY id DESC
+LIMIT ? OFFSET ?`
+	rows, err := repo.db.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("ListDigests: QueryContext: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var digests []*entity.Digest
+	for rows.Next() {
+		var row digestRow
+		if err := rows.Scan(row.dest()...); err != nil {
+			return nil, fmt.Errorf("ListDigests: Scan: %w", err)
+		}
+		d, err := row.toEntity()
+		if err != nil {
+			return nil, fmt.Errorf("ListDigests: %w", err)
+		}
+		digests = append(digests, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ListDigests: rows.Err: %w", err)
+	}
+	return digests, nil
+}
+
+func (repo *DigestRepo) CountDigests(ctx context.Context) (int64, error) {
+	var count int64
+	if err := repo.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM digests`).Scan(&count); err != nil {
+		return 0, fmt.Errorf("CountDigests: QueryRowContext: %w", err)
+	}
+	return count, nil
+}
+
+func (repo *DigestRepo) ListDigestCandidates(ctx context.Context, from, to time.Time, carried []int64, limit int) ([]repository.DigestCandidate, error) {
+	window, args := digestWindow(from, to, carried)
+	args = append(args, limit)
+	// タグ名は空白が正規化されているため、改行区切りで連結しても曖昧にならない
+	query := `
+SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name,
+       COALESCE((SELECT group_concat(t.name, char(10))
+                 FROM article_tags atg
+                 INNER JOIN tags t ON t.id = atg.tag_id
+                 WHERE atg.article_id = a.id), '') AS tag_names
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+WHERE ` + window + ` AND a.deleted_at IS NULL AND a.summary_status <> 'pending'
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT ?`
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ListDigestCandidates: QueryContext: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var candidates []repository.DigestCandidate
+	for rows.Next() {
+		var row articleRow
+		var sourceName, tagNames string
+		if err := rows.Scan(row.dest(&sourceName, &tagNames)...); err != nil {
+			return nil, fmt.Errorf("ListDigestCandidates: Scan: %w", err)
+		}
+		candidates = append(candidates, repository.DigestCandidate{
+			Article:    row.toEntity(),
+			SourceName: sourceName,
+			Tags:       splitTagNames(tagNames),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ListDigestCandidates: rows.Err: %w", err)
+	}
+	return candidates, nil
+}
+
+func (repo *DigestRepo) ListPendingDigestArticleIDs(ctx context.Context, from, to time.Time, carried []int64) ([]int64, error) {
+	window, args := digestWindow(from, to, carried)
+	query := `
+SELECT a.id FROM articles a
+WHERE ` + window + ` AND a.deleted_at IS NULL AND a.summary_status = 'pending'
+ORDER BY a.id`
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ListPendingDigestArticleIDs: QueryContext: %w", err)
+	}
+	return scanIDs(rows, "ListPendingDigestArticleIDs")
+}
+
+// digestWindow returns the condition and arguments selecting the articles
+// fetched in (from, to] or listed in carried.
+func digestWindow(from, to time.Time, carried []int64) (string, []any) {
+	args := []any{from, to}
+	if len(carried) == 0 {
+		return `(a.created_at > ? AND a.created_at <= ?)`, args
+	}
+	list, ids := idList(carried)
+	return `(a.created_at > ? AND a.created_at <= ? OR a.id IN (` + list + `))`, append(args, ids...)
+}
+
+// marshalPendingIDs encodes the pending article IDs of a digest as a JSON array.
+func marshalPendingIDs(ids []int64) (string, error) {
+	if ids == nil {
+		ids = []int64{}
+	}
+	b, err := json.Marshal(ids)
+	if err != nil {
+		return "", fmt.Errorf("marshal pending article IDs: %w", err)
+	}
+	return string(b), nil
+}
+
+// splitTagNames splits newline-joined tag names into a sorted slice (nil when empty).
+// group_concat does not guarantee an order, so the names are sorted here.
+func splitTagNames(s string) []string {
+	if s == "" {
+		return nil
+	}
+	names := strings.Split(s, "\n")
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,245 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/infra/adapter/persistence/sqlite"
+	"catchup-feed/internal/repository"
+)
+
+var digestColumns = []string{
+	"id", "period", "group_by", "title", "overview", "period_start", "period_end", "article_count", "groups", "pending_article_ids", "created_at",
+}
+
+var digestCandidateColumns = []string{
+	"id", "source_id", "title", "url",
+	"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags",
+	"source_name", "tag_names",
+}
+
+const digestGroupsJSON = `[{"name":"Go Blog","articles":[{"id":7,"title":"Go 1.25","url":"https://go.dev/blog/go1.25","source_name":"Go Blog","summary":"要約","published_at":"2026-01-02T00:00:00Z"}]}]`
+
+func sampleDigest(now time.Time) *entity.Digest {
+	return &entity.Digest{
+		ID: 3, Period: entity.DigestDaily, GroupBy: entity.DigestGroupBySource,
+		Title: "デイリーダイジェスト 2026-01-02", Overview: "概要",
+		PeriodStart: now.Add(-24 * time.Hour), PeriodEnd: now, ArticleCount: 1,
+		Groups: []entity.DigestGroup{{Name: "Go Blog", Articles: []entity.DigestArticle{{
+			ID: 7, Title: "Go 1.25", URL: "https://go.dev/blog/go1.25", SourceName: "Go Blog", Summary: "要約",
+			PublishedAt: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+		}}}},
+		CreatedAt: now,
+	}
+}
+
+func TestDigestRepo_CreateDigest(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC)
+	d := sampleDigest(now)
+	d.ID, d.CreatedAt = 0, time.Time{}
+
+	mock.ExpectExec("INSERT INTO digests").
+		WithArgs(entity.DigestDaily, entity.DigestGroupBySource, d.Title, "概要", d.PeriodStart, now, 1, digestGroupsJSON, "[]", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(3, 1))
+
+	repo := sqlite.NewDigestRepo(db)
+	if err := repo.CreateDigest(context.Background(), d); err != nil {
+		t.Fatalf("CreateDigest err=%v", err)
+	}
+	if d.ID != 3 || d.CreatedAt.IsZero() {
+		t.Fatalf("ID/CreatedAt not set: %+v", d)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestDigestRepo_GetDigest(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC)
+	want := sampleDigest(now)
+	want.PendingArticleIDs = []int64{5}
+	mock.ExpectQuery("FROM digests WHERE id").
+		WithArgs(int64(3)).
+		WillReturnRows(sqlmock.NewRows(digestColumns).AddRow(
+			int64(3), entity.DigestDaily, entity.DigestGroupBySource, want.Title, "概要",
+			want.PeriodStart, now, 1, []byte(digestGroupsJSON), []byte("[5]"), now,
+		))
+
+	repo := sqlite.NewDigestRepo(db)
+	got, err := repo.GetDigest(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("GetDigest err=%v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDigestRepo_GetDigest_NotFoundAndErrors(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+	repo := sqlite.NewDigestRepo(db)
+	now := time.Now()
+
+	mock.ExpectQuery("FROM digests WHERE id").WillReturnRows(sqlmock.NewRows(digestColumns))
+	if got, err := repo.GetDigest(context.Background(), 1); got != nil || err != nil {
+		t.Fatalf("GetDigest(missing) = %v, %v; want nil, nil", got, err)
+	}
+
+	mock.ExpectQuery("FROM digests WHERE id").WillReturnError(errors.New("db down"))
+	if _, err := repo.GetDigest(context.Background(), 1); err == nil {
+		t.Fatal("GetDigest() error = nil, want error")
+	}
+
+	mock.ExpectQuery("FROM digests WHERE id").
+		WillReturnRows(sqlmock.NewRows(digestColumns).AddRow(
+			int64(1), entity.DigestDaily, entity.DigestGroupBySource, "t", "", now, now, 0, []byte("{broken"), []byte("[]"), now,
+		))
+	if _, err := repo.GetDigest(context.Background(), 1); err == nil {
+		t.Fatal("GetDigest(malformed groups) error = nil, want error")
+	}
+}
+
+func TestDigestRepo_LatestDigest(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("WHERE period = \\?").
+		WithArgs(entity.DigestWeekly).
+		WillReturnRows(sqlmock.NewRows(digestColumns))
+	mock.ExpectQuery("WHERE period = \\?").
+		WithArgs(entity.DigestDaily).
+		WillReturnRows(sqlmock.NewRows(digestColumns).AddRow(
+			int64(3), entity.DigestDaily, entity.DigestGroupBySource, "t", "", now.Add(-24*time.Hour), now, 0, []byte("[]"), []byte("[]"), now,
+		))
+
+	repo := sqlite.NewDigestRepo(db)
+	if got, err := repo.LatestDigest(context.Background(), entity.DigestWeekly); got != nil || err != nil {
+		t.Fatalf("LatestDigest(none) = %v, %v; want nil, nil", got, err)
+	}
+	got, err := repo.LatestDigest(context.Background(), entity.DigestDaily)
+	if err != nil {
+		t.Fatalf("LatestDigest err=%v", err)
+	}
+	if got.ID != 3 || !got.PeriodEnd.Equal(now) {
+		t.Errorf("LatestDigest = %+v", got)
+	}
+}
+
+func TestDigestRepo_ListAndCount(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("FROM digests\\s+ORDER BY id DESC").
+		WithArgs(20, 40).
+		WillReturnRows(sqlmock.NewRows(digestColumns).
+			AddRow(int64(5), entity.DigestDaily, entity.DigestGroupByTag, "t5", "", now, now, 0, []byte("[]"), []byte("[]"), now).
+			AddRow(int64(4), entity.DigestDaily, entity.DigestGroupByTag, "t4", "", now, now, 0, []byte("[]"), []byte("[]"), now))
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM digests").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(42)))
+
+	repo := sqlite.NewDigestRepo(db)
+	got, err := repo.ListDigests(context.Background(), 40, 20)
+	if err != nil {
+		t.Fatalf("ListDigests err=%v", err)
+	}
+	if len(got) != 2 || got[0].ID != 5 || got[1].Title != "t4" {
+		t.Errorf("ListDigests = %+v", got)
+	}
+
+	count, err := repo.CountDigests(context.Background())
+	if err != nil || count != 42 {
+		t.Errorf("CountDigests = %d, %v; want 42, nil", count, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestDigestRepo_ListDigestCandidates(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	to := time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC)
+	from := to.Add(-24 * time.Hour)
+	pub := to.Add(-time.Hour)
+	mock.ExpectQuery("WHERE \\(a.created_at > \\? AND a.created_at <= \\?\\) AND a.deleted_at IS NULL AND a.summary_status <> 'pending'").
+		WithArgs(from, to, 100).
+		WillReturnRows(sqlmock.NewRows(digestCandidateColumns).
+			AddRow(int64(7), int64(1), "Go 1.25", "https://go.dev/blog/go1.25", "要約", pub, to, nil, "", "", "", "", "", "Go Blog", "release\ngo").
+			AddRow(int64(6), int64(2), "未分類", "https://example.com/6", "", pub, to, nil, "", "pending", "msgbatch_1", "", "", "Example", ""))
+
+	repo := sqlite.NewDigestRepo(db)
+	got, err := repo.ListDigestCandidates(context.Background(), from, to, nil, 100)
+	if err != nil {
+		t.Fatalf("ListDigestCandidates err=%v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("len = %d, want 2", len(got))
+	}
+	if diff := cmp.Diff([]string{"go", "release"}, got[0].Tags); diff != "" {
+		t.Errorf("tags mismatch (-want +got):\n%s", diff)
+	}
+	if got[0].SourceName != "Go Blog" || got[0].Article.ID != 7 {
+		t.Errorf("candidate = %+v", got[0])
+	}
+	if got[1].Tags != nil || got[1].Article.SummaryStatus != "pending" {
+		t.Errorf("candidate without tags = %+v", got[1])
+	}
+
+	mock.ExpectQuery("FROM articles a").WillReturnError(errors.New("db down"))
+	var none []repository.DigestCandidate
+	if none, err = repo.ListDigestCandidates(context.Background(), from, to, nil, 100); err == nil || none != nil {
+		t.Fatalf("ListDigestCandidates() = %v, %v; want error", none, err)
+	}
+}
+
+func TestDigestRepo_CarriedAndPendingArticles(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	to := time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC)
+	from := to.Add(-24 * time.Hour)
+	carried := []int64{5, 6}
+	// 前回のダイジェストで要約待ちだった記事は、取得時刻が期間外でも対象にする
+	mock.ExpectQuery("WHERE \\(a.created_at > \\? AND a.created_at <= \\? OR a.id IN \\(\\?, \\?\\)\\) AND a.deleted_at IS NULL AND a.summary_status <> 'pending'").
+		WithArgs(from, to, int64(5), int64(6), 100).
+		WillReturnRows(sqlmock.NewRows(digestCandidateColumns).
+			AddRow(int64(5), int64(1), "Go 1.25", "https://go.dev/blog/go1.25", "要約", to, from, nil, "", "done", "msgbatch_1", "", "", "Go Blog", ""))
+	mock.ExpectQuery("SELECT a.id FROM articles a\\s+WHERE \\(a.created_at > \\? AND a.created_at <= \\? OR a.id IN \\(\\?, \\?\\)\\) AND a.deleted_at IS NULL AND a.summary_status = 'pending'").
+		WithArgs(from, to, int64(5), int64(6)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(6)).AddRow(int64(9)))
+
+	repo := sqlite.NewDigestRepo(db)
+	got, err := repo.ListDigestCandidates(context.Background(), from, to, carried, 100)
+	if err != nil {
+		t.Fatalf("ListDigestCandidates err=%v", err)
+	}
+	if len(got) != 1 || got[0].Article.ID != 5 {
+		t.Fatalf("candidates = %+v, want the carried article 5", got)
+	}
+	pending, err := repo.ListPendingDigestArticleIDs(context.Background(), from, to, carried)
+	if err != nil {
+		t.Fatalf("ListPendingDigestArticleIDs err=%v", err)
+	}
+	if diff := cmp.Diff([]int64{6, 9}, pending); diff != "" {
+		t.Errorf("pending mismatch (-want +got):\n%s", diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
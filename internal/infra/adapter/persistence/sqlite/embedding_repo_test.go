@@ -0,0 +1,137 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/infra/adapter/persistence/sqlite"
+	"catchup-feed/internal/pkg/vector"
+	"catchup-feed/internal/repository"
+)
+
+func TestEmbeddingRepo_SaveEmbedding(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	vec := []float32{0.5, -1}
+	mock.ExpectExec("INSERT INTO article_embeddings").
+		WithArgs(int64(7), "text-embedding-3-small", vector.Encode(vec)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := sqlite.NewEmbeddingRepo(db)
+	err := repo.SaveEmbedding(context.Background(), repository.ArticleEmbedding{
+		ArticleID: 7, Model: "text-embedding-3-small", Vector: vec,
+	})
+	if err != nil {
+		t.Fatalf("SaveEmbedding err=%v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestEmbeddingRepo_SaveEmbedding_Error(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec("INSERT INTO article_embeddings").WillReturnError(errors.New("db down"))
+
+	repo := sqlite.NewEmbeddingRepo(db)
+	if err := repo.SaveEmbedding(context.Background(), repository.ArticleEmbedding{ArticleID: 7}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestEmbeddingRepo_ListEmbeddings(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery("FROM article_embeddings WHERE model").
+		WithArgs("stub").
+		WillReturnRows(sqlmock.NewRows([]string{"article_id", "vector"}).
+			AddRow(int64(1), vector.Encode([]float32{1, 0})).
+			AddRow(int64(2), vector.Encode([]float32{0, 1})))
+
+	repo := sqlite.NewEmbeddingRepo(db)
+	got, err := repo.ListEmbeddings(context.Background(), "stub")
+	if err != nil {
+		t.Fatalf("ListEmbeddings err=%v", err)
+	}
+	want := []repository.ArticleEmbedding{
+		{ArticleID: 1, Model: "stub", Vector: []float32{1, 0}},
+		{ArticleID: 2, Model: "stub", Vector: []float32{0, 1}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestEmbeddingRepo_ListEmbeddings_CorruptVector(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery("FROM article_embeddings WHERE model").
+		WillReturnRows(sqlmock.NewRows([]string{"article_id", "vector"}).AddRow(int64(1), []byte{1, 2, 3}))
+
+	repo := sqlite.NewEmbeddingRepo(db)
+	if _, err := repo.ListEmbeddings(context.Background(), "stub"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestEmbeddingRepo_ListArticlesWithoutEmbedding(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	want := &entity.Article{ID: 3, SourceID: 1, Title: "t", URL: "https://example.com/3", Summary: "s", PublishedAt: now, CreatedAt: now}
+	mock.ExpectQuery("LEFT JOIN article_embeddings e").
+		WithArgs("stub", 50).
+		WillReturnRows(artRow(want))
+
+	repo := sqlite.NewEmbeddingRepo(db)
+	got, err := repo.ListArticlesWithoutEmbedding(context.Background(), "stub", 50)
+	if err != nil {
+		t.Fatalf("ListArticlesWithoutEmbedding err=%v", err)
+	}
+	if diff := cmp.Diff([]*entity.Article{want}, got); diff != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestEmbeddingRepo_ListWithSourceByIDs(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta("WHERE a.id IN (?,?)")).
+		WithArgs(int64(4), int64(9)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "source_id", "title", "url",
+			"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
+		}).AddRow(int64(9), int64(1), "t", "https://example.com/9", "s", now, now, nil, "", "", "", "", "", "Tech News"))
+
+	repo := sqlite.NewEmbeddingRepo(db)
+	got, err := repo.ListWithSourceByIDs(context.Background(), []int64{4, 9})
+	if err != nil {
+		t.Fatalf("ListWithSourceByIDs err=%v", err)
+	}
+	if len(got) != 1 || got[0].Article.ID != 9 || got[0].SourceName != "Tech News" {
+		t.Errorf("got %+v", got)
+	}
+
+	// IDが空の場合はクエリを発行しない
+	if got, err := repo.ListWithSourceByIDs(context.Background(), nil); err != nil || got != nil {
+		t.Errorf("ListWithSourceByIDs(nil) = %v, %v", got, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+type FeedTokenRepo struct {
+	db *sql.DB
+}
+
+func NewFeedTokenRepo(db *sql.DB) repository.FeedTokenRepository {
+	return &FeedTokenRepo{db: db}
+}
+
+func (repo *FeedTokenRepo) GetFeedToken(ctx context.Context, userID string) (*entity.FeedToken, error) {
+	const query = `SELECT user_id, token, created_at FROM feed_tokens WHERE user_id = ?`
+	return repo.getFeedToken(ctx, "GetFeedToken", query, userID)
+}
+
+func (repo *FeedTokenRepo) GetFeedTokenByToken(ctx context.Context, token string) (*entity.FeedToken, error) {
+	const query = `SELECT user_id, token, created_at FROM feed_tokens WHERE token = ?`
+	return repo.getFeedToken(ctx, "GetFeedTokenByToken", query, token)
+}
+
+// getFeedToken returns the single token selected by query, or nil if there is none.
+func (repo *FeedTokenRepo) getFeedToken(ctx context.Context, op, query string, arg string) (*entity.FeedToken, error) {
+	var t entity.FeedToken
+	err := repo.db.QueryRowContext(ctx, query, arg).Scan(&t.UserID, &t.Token, &t.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: QueryRowContext: %w", op, err)
+	}
+	return &t, nil
+}
+
+func (repo *FeedTokenRepo) SetFeedToken(ctx context.Context, token *entity.FeedToken) error {
+	const query = `
+INSERT INTO feed_tokens (user_id, token, created_at)
+VALUES (?, ?, ?)
+ON CONFLICT (user_id) DO UPDATE SET token = excluded.token, created_at = excluded.created_at`
+	now := time.Now()
+	if _, err := repo.db.ExecContext(ctx, query, token.UserID, token.Token, now); err != nil {
+		return fmt.Errorf("SetFeedToken: ExecContext: %w", err)
+	}
+	token.CreatedAt = now
+	return nil
+}
+
+func (repo *FeedTokenRepo) DeleteFeedToken(ctx context.Context, userID string) (bool, error) {
+	const query = `DELETE FROM feed_tokens WHERE user_id = ?`
+	res, err := repo.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return false, fmt.Errorf("DeleteFeedToken: ExecContext: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("DeleteFeedToken: RowsAffected: %w", err)
+	}
+	return n > 0, nil
+}
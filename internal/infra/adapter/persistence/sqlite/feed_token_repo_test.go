@@ -0,0 +1,71 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/infra/adapter/persistence/sqlite"
+)
+
+func TestFeedTokenRepo_GetFeedToken(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT user_id, token, created_at FROM feed_tokens WHERE user_id = ?`)).
+		WithArgs("alice").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "token", "created_at"}).AddRow("alice", "tok", now))
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM feed_tokens WHERE user_id = ?`)).
+		WithArgs("bob").
+		WillReturnError(sql.ErrNoRows)
+
+	repo := sqlite.NewFeedTokenRepo(db)
+	got, err := repo.GetFeedToken(context.Background(), "alice")
+	if err != nil || got == nil || got.Token != "tok" {
+		t.Fatalf("GetFeedToken = %+v, %v", got, err)
+	}
+	got, err = repo.GetFeedToken(context.Background(), "bob")
+	if err != nil || got != nil {
+		t.Fatalf("GetFeedToken = %+v, %v; want nil, nil", got, err)
+	}
+}
+
+func TestFeedTokenRepo_SetFeedToken(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec(regexp.QuoteMeta(`ON CONFLICT (user_id) DO UPDATE SET token = excluded.token`)).
+		WithArgs("alice", "tok", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	token := &entity.FeedToken{UserID: "alice", Token: "tok"}
+	if err := sqlite.NewFeedTokenRepo(db).SetFeedToken(context.Background(), token); err != nil {
+		t.Fatalf("SetFeedToken err=%v", err)
+	}
+	if token.CreatedAt.IsZero() {
+		t.Error("CreatedAt is not set")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFeedTokenRepo_DeleteFeedToken(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM feed_tokens WHERE user_id = ?`)).
+		WithArgs("alice").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	deleted, err := sqlite.NewFeedTokenRepo(db).DeleteFeedToken(context.Background(), "alice")
+	if err != nil || !deleted {
+		t.Fatalf("DeleteFeedToken = %v, %v; want true, nil", deleted, err)
+	}
+}
@@ -0,0 +1,159 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"catchup-feed/internal/repository"
+)
+
+type ReadStateRepo struct {
+	db           *sql.DB
+	queryBuilder *ArticleQueryBuilder
+}
+
+func NewReadStateRepo(db *sql.DB) repository.ReadStateRepository {
+	return &ReadStateRepo{
+		db:           db,
+		queryBuilder: NewArticleQueryBuilder(),
+	}
+}
+
+func (repo *ReadStateRepo) MarkRead(ctx context.Context, userID string, articleID int64) (bool, error) {
+	// 記事が存在しない場合は SELECT が0行になり、何も挿入されない
+	const query = `
+INSERT INTO article_reads (user_id, article_id)
+SELECT ?, id FROM articles WHERE id = ? AND deleted_at IS NULL
+ON CONFLICT (user_id, article_id) DO UPDATE SET read_at = excluded.read_at`
+	res, err := repo.db.ExecContext(ctx, query, userID, articleID)
+	if err != nil {
+		return false, fmt.Errorf("MarkRead: ExecContext: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("MarkRead: RowsAffected: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (repo *ReadStateRepo) MarkUnread(ctx context.Context, userID string, articleID int64) error {
+	const query = `DELETE FROM article_reads WHERE user_id = ? AND article_id = ?`
+	if _, err := repo.db.ExecContext(ctx, query, userID, articleID); err != nil {
+		return fmt.Errorf("MarkUnread: ExecContext: %w", err)
+	}
+	return nil
+}
+
+func (repo *ReadStateRepo) MarkAllRead(ctx context.Context, userID string, filter repository.MarkReadFilter) (int64, error) {
+	args := []interface{}{userID}
+	// SQLite の UPSERT は INSERT ... SELECT に WHERE 句が必要なため、常に条件を置く
+	conditions := []string{"a.deleted_at IS NULL"}
+	if filter.Before != nil {
+		conditions = append(conditions, "a.published_at <= ?")
+		args = append(args, *filter.Before)
+	}
+	if filter.SourceID != nil {
+		conditions = append(conditions, "a.source_id = ?")
+		args = append(args, *filter.SourceID)
+	}
+
+	// #nosec G202 -- conditions only contain parameterized placeholders (?)
+	query := `
+INSERT INTO article_reads (user_id, article_id)
+SELECT ?, a.id FROM articles a
+WHERE ` + strings.Join(conditions, " AND ") + `
+ON CONFLICT (user_id, article_id) DO NOTHING`
+	res, err := repo.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("MarkAllRead: ExecContext: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("MarkAllRead: RowsAffected: %w", err)
+	}
+	return n, nil
+}
+
+func (repo *ReadStateRepo) ListUnread(ctx context.Context, userID string, filters repository.ArticleSearchFilters, offset, limit int) ([]repository.ArticleWithSource, error) {
+	whereClause, args := repo.unreadWhereClause(userID, filters)
+	args = append(args, limit, offset)
+
+	// #nosec G202 -- whereClause is generated by QueryBuilder using parameterized placeholders (?)
+	query := `
+SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+` + whereClause + `
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT ? OFFSET ?`
+
+	return queryWithSource(ctx, repo.db, "ListUnread", query, args, limit)
+}
+
+func (repo *ReadStateRepo) CountUnread(ctx context.Context, userID string, filters repository.ArticleSearchFilters) (int64, error) {
+	whereClause, args := repo.unreadWhereClause(userID, filters)
+	query := "SELECT COUNT(*) FROM articles a " + whereClause
+
+	var count int64
+	if err := repo.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("CountUnread: %w", err)
+	}
+	return count, nil
+}
+
+func (repo *ReadStateRepo) UnreadCountsBySource(ctx context.Context, userID string) ([]repository.SourceUnreadCount, error) {
+	const query = `
+SELECT s.id, s.name, COUNT(*) AS unread_count
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+WHERE a.deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM article_reads r WHERE r.user_id = ? AND r.article_id = a.id)
+GROUP BY s.id, s.name
+ORDER BY unread_count DESC, s.name`
+	rows, err := repo.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("UnreadCountsBySource: QueryContext: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var counts []repository.SourceUnreadCount
+	for rows.Next() {
+		var c repository.SourceUnreadCount
+		if err := rows.Scan(&c.SourceID, &c.SourceName, &c.Count); err != nil {
+			return nil, fmt.Errorf("UnreadCountsBySource: Scan: %w", err)
+		}
+		counts = append(counts, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("UnreadCountsBySource: rows.Err: %w", err)
+	}
+	return counts, nil
+}
+
+func (repo *ReadStateRepo) ListUnreadPerSource(ctx context.Context, userID string, perSource int) ([]repository.ArticleWithSource, error) {
+	// ソースごとに新しい順の連番を振り、先頭 perSource 件だけを返す（ウィンドウ関数は SQLite 3.25 以降）
+	const query = `
+SELECT id, source_id, title, url, summary, published_at, created_at, summary_structured, prompt_version, summary_status, summary_batch_id, summary_model, injection_flags, source_name
+FROM (
+    SELECT a.id, a.source_id, a.title, a.url, a.summary, a.published_at, a.created_at, a.summary_structured, a.prompt_version, a.summary_status, a.summary_batch_id, a.summary_model, a.injection_flags, s.name AS source_name,
+           ROW_NUMBER() OVER (PARTITION BY a.source_id ORDER BY a.published_at DESC, a.id DESC) AS source_rank
+    FROM articles a
+    INNER JOIN sources s ON a.source_id = s.id
+    WHERE a.deleted_at IS NULL
+      AND NOT EXISTS (SELECT 1 FROM article_reads r WHERE r.user_id = ? AND r.article_id = a.id)
+) unread
+WHERE source_rank <= ?
+ORDER BY source_id, published_at DESC, id DESC`
+	return queryWithSource(ctx, repo.db, "ListUnreadPerSource", query, []interface{}{userID, perSource}, 0)
+}
+
+// unreadWhereClause builds the WHERE clause selecting the articles matching filters
+// that userID has not read.
+func (repo *ReadStateRepo) unreadWhereClause(userID string, filters repository.ArticleSearchFilters) (string, []interface{}) {
+	whereClause, args := repo.queryBuilder.BuildWhereClause(nil, filters, "a")
+	args = append(args, userID)
+	return andCondition(whereClause,
+		"a.deleted_at IS NULL AND NOT EXISTS (SELECT 1 FROM article_reads r WHERE r.user_id = ? AND r.article_id = a.id)"), args
+}
@@ -0,0 +1,236 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+
+	"catchup-feed/internal/infra/adapter/persistence/sqlite"
+	"catchup-feed/internal/repository"
+)
+
+func readStateRows(now time.Time) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "source_id", "title", "url",
+		"summary", "published_at", "created_at", "summary_structured", "prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
+	}).
+		AddRow(2, 10, "Go 1.25", "https://example.com/2", "Summary", now, now, nil, "", "", "", "", "", "Go Blog").
+		AddRow(1, 11, "Zenn", "https://example.com/1", "Summary", now, now, nil, "", "", "", "", "", "Zenn")
+}
+
+func TestReadStateRepo_MarkRead(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		want     bool
+	}{
+		{name: "marked", affected: 1, want: true},
+		{name: "article not found", affected: 0, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, _ := sqlmock.New()
+			defer func() { _ = db.Close() }()
+
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO article_reads (user_id, article_id)
+SELECT ?, id FROM articles WHERE id = ? AND deleted_at IS NULL
+ON CONFLICT (user_id, article_id) DO UPDATE SET read_at = excluded.read_at`)).
+				WithArgs("alice@example.com", int64(7)).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			got, err := sqlite.NewReadStateRepo(db).MarkRead(context.Background(), "alice@example.com", 7)
+			if err != nil {
+				t.Fatalf("MarkRead err=%v", err)
+			}
+			if got != tt.want {
+				t.Errorf("MarkRead = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestReadStateRepo_MarkRead_Error(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec("INSERT INTO article_reads").WillReturnError(errors.New("db down"))
+
+	_, err := sqlite.NewReadStateRepo(db).MarkRead(context.Background(), "alice@example.com", 7)
+	if err == nil || err.Error() != "MarkRead: ExecContext: db down" {
+		t.Fatalf("MarkRead err=%v", err)
+	}
+}
+
+func TestReadStateRepo_MarkUnread(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM article_reads WHERE user_id = ? AND article_id = ?`)).
+		WithArgs("alice@example.com", int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := sqlite.NewReadStateRepo(db).MarkUnread(context.Background(), "alice@example.com", 7); err != nil {
+		t.Fatalf("MarkUnread err=%v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadStateRepo_MarkAllRead(t *testing.T) {
+	before := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	sourceID := int64(10)
+
+	tests := []struct {
+		name   string
+		filter repository.MarkReadFilter
+		where  string
+		args   []driver.Value
+	}{
+		{
+			name:   "all",
+			filter: repository.MarkReadFilter{},
+			where:  "SELECT ?, a.id FROM articles a\nWHERE a.deleted_at IS NULL\nON CONFLICT",
+			args:   []driver.Value{"alice@example.com"},
+		},
+		{
+			name:   "before",
+			filter: repository.MarkReadFilter{Before: &before},
+			where:  "WHERE a.deleted_at IS NULL AND a.published_at <= ?\nON CONFLICT",
+			args:   []driver.Value{"alice@example.com", before},
+		},
+		{
+			name:   "source before",
+			filter: repository.MarkReadFilter{Before: &before, SourceID: &sourceID},
+			where:  "WHERE a.deleted_at IS NULL AND a.published_at <= ? AND a.source_id = ?\nON CONFLICT",
+			args:   []driver.Value{"alice@example.com", before, sourceID},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, _ := sqlmock.New()
+			defer func() { _ = db.Close() }()
+
+			mock.ExpectExec(regexp.QuoteMeta(tt.where)).
+				WithArgs(tt.args...).
+				WillReturnResult(sqlmock.NewResult(0, 5))
+
+			n, err := sqlite.NewReadStateRepo(db).MarkAllRead(context.Background(), "alice@example.com", tt.filter)
+			if err != nil {
+				t.Fatalf("MarkAllRead err=%v", err)
+			}
+			if n != 5 {
+				t.Errorf("MarkAllRead = %d, want 5", n)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestReadStateRepo_ListUnread(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+WHERE a.id IN (SELECT atg.article_id FROM article_tags atg INNER JOIN tags tg ON tg.id = atg.tag_id WHERE tg.name = ?) AND a.deleted_at IS NULL AND NOT EXISTS (SELECT 1 FROM article_reads r WHERE r.user_id = ? AND r.article_id = a.id)
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT ? OFFSET ?`)).
+		WithArgs("go", "alice@example.com", 20, 40).
+		WillReturnRows(readStateRows(now))
+
+	got, err := sqlite.NewReadStateRepo(db).ListUnread(context.Background(), "alice@example.com",
+		repository.ArticleSearchFilters{Tags: []string{"go"}}, 40, 20)
+	if err != nil {
+		t.Fatalf("ListUnread err=%v", err)
+	}
+	if len(got) != 2 || got[0].Article.ID != 2 || got[0].SourceName != "Go Blog" {
+		t.Fatalf("ListUnread = %+v", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadStateRepo_CountUnread(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM articles a WHERE a.deleted_at IS NULL AND NOT EXISTS (SELECT 1 FROM article_reads r WHERE r.user_id = ? AND r.article_id = a.id)`)).
+		WithArgs("alice@example.com").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(16)))
+
+	got, err := sqlite.NewReadStateRepo(db).CountUnread(context.Background(), "alice@example.com", repository.ArticleSearchFilters{})
+	if err != nil {
+		t.Fatalf("CountUnread err=%v", err)
+	}
+	if got != 16 {
+		t.Errorf("CountUnread = %d, want 16", got)
+	}
+}
+
+func TestReadStateRepo_UnreadCountsBySource(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`GROUP BY s.id, s.name
+ORDER BY unread_count DESC, s.name`)).
+		WithArgs("alice@example.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "unread_count"}).
+			AddRow(int64(10), "Go Blog", int64(12)).
+			AddRow(int64(11), "Zenn", int64(4)))
+
+	got, err := sqlite.NewReadStateRepo(db).UnreadCountsBySource(context.Background(), "alice@example.com")
+	if err != nil {
+		t.Fatalf("UnreadCountsBySource err=%v", err)
+	}
+	want := []repository.SourceUnreadCount{
+		{SourceID: 10, SourceName: "Go Blog", Count: 12},
+		{SourceID: 11, SourceName: "Zenn", Count: 4},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("UnreadCountsBySource mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestReadStateRepo_ListUnreadPerSource(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`ROW_NUMBER() OVER (PARTITION BY a.source_id ORDER BY a.published_at DESC, a.id DESC) AS source_rank`)).
+		WithArgs("alice@example.com", 5).
+		WillReturnRows(readStateRows(now))
+
+	got, err := sqlite.NewReadStateRepo(db).ListUnreadPerSource(context.Background(), "alice@example.com", 5)
+	if err != nil {
+		t.Fatalf("ListUnreadPerSource err=%v", err)
+	}
+	if len(got) != 2 || got[1].Article.SourceID != 11 || got[1].SourceName != "Zenn" {
+		t.Fatalf("ListUnreadPerSource = %+v", got)
+	}
+}
+
+func TestReadStateRepo_ListUnreadPerSource_Error(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery("SELECT").WillReturnError(errors.New("db down"))
+
+	_, err := sqlite.NewReadStateRepo(db).ListUnreadPerSource(context.Background(), "alice@example.com", 5)
+	if err == nil || err.Error() != "ListUnreadPerSource: QueryContext: db down" {
+		t.Fatalf("ListUnreadPerSource err=%v", err)
+	}
+}
@@ -0,0 +1,225 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+type ResummarizeRepo struct {
+	db *sql.DB
+}
+
+func NewResummarizeRepo(db *sql.DB) repository.ResummarizeRepository {
+	return &ResummarizeRepo{db: db}
+}
+
+const resummarizeJobColumns = `id, status, article_id, source_id, published_from, published_to, summary_model,
+       total, processed, succeeded, failed, last_article_id, error, requested_by,
+       created_at, started_at, finished_at`
+
+// resummarizeJobRow holds the scan destinations for a resummarize_jobs row.
+type resummarizeJobRow struct {
+	job        entity.ResummarizeJob
+	articleID  sql.NullInt64
+	sourceID   sql.NullInt64
+	from       sql.NullTime
+	to         sql.NullTime
+	startedAt  sql.NullTime
+	finishedAt sql.NullTime
+}
+
+// dest returns the Scan destinations in resummarizeJobColumns order.
+func (r *resummarizeJobRow) dest() []any {
+	return []any{
+		&r.job.ID, &r.job.Status, &r.articleID, &r.sourceID, &r.from, &r.to, &r.job.Filter.SummaryModel,
+		&r.job.Total, &r.job.Processed, &r.job.Succeeded, &r.job.Failed, &r.job.LastArticleID,
+		&r.job.Error, &r.job.RequestedBy,
+		&r.job.CreatedAt, &r.startedAt, &r.finishedAt,
+	}
+}
+
+// toEntity converts the scanned row into a job entity.
+func (r *resummarizeJobRow) toEntity() *entity.ResummarizeJob {
+	j := r.job
+	if r.articleID.Valid {
+		j.Filter.ArticleID = &r.articleID.Int64
+	}
+	if r.sourceID.Valid {
+		j.Filter.SourceID = &r.sourceID.Int64
+	}
+	if r.from.Valid {
+		j.Filter.From = &r.from.Time
+	}
+	if r.to.Valid {
+		j.Filter.To = &r.to.Time
+	}
+	if r.startedAt.Valid {
+		j.StartedAt = &r.startedAt.Time
+	}
+	if r.finishedAt.Valid {
+		j.FinishedAt = &r.finishedAt.Time
+	}
+	return &j
+}
+
+func (repo *ResummarizeRepo) CreateJob(ctx context.Context, job *entity.ResummarizeJob) error {
+	const query = `
+INSERT INTO resummarize_jobs
+(status, article_id, source_id, published_from, published_to, summary_model, requested_by, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	f := job.Filter
+	createdAt := time.Now()
+	res, err := repo.db.ExecContext(ctx, query,
+		job.Status, f.ArticleID, f.SourceID, f.From, f.To, f.SummaryModel, job.RequestedBy, createdAt,
+	)
+	if err != nil {
+		return fmt.Errorf("CreateJob: ExecContext: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("CreateJob: LastInsertId: %w", err)
+	}
+	job.ID = id
+	job.CreatedAt = createdAt
+	return nil
+}
+
+func (repo *ResummarizeRepo) GetJob(ctx context.Context, id int64) (*entity.ResummarizeJob, error) {
+	query := `SELECT ` + resummarizeJobColumns + `
+FROM resummarize_jobs
+WHERE id = ?`
+	var row resummarizeJobRow
+	err := repo.db.QueryRowContext(ctx, query, id).Scan(row.dest()...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("GetJob: QueryRowContext: %w", err)
+	}
+	return row.toEntity(), nil
+}
+
+func (repo *ResummarizeRepo) NextJob(ctx context.Context) (*entity.ResummarizeJob, error) {
+	query := `SELECT ` + resummarizeJobColumns + `
+FROM resummarize_jobs
+WHERE status IN (?, ?)
+ORDER BY CASE WHEN status = ? THEN 0 ELSE 1 END, id
+LIMIT 1`
+	var row resummarizeJobRow
+	err := repo.db.QueryRowContext(ctx, query,
+		entity.ResummarizeRunning, entity.ResummarizeQueued, entity.ResummarizeRunning,
+	).Scan(row.dest()...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("NextJob: QueryRowContext: %w", err)
+	}
+	return row.toEntity(), nil
+}
+
+func (repo *ResummarizeRepo) UpdateJob(ctx context.Context, job *entity.ResummarizeJob) error {
+	const query = `
+UPDATE resummarize_jobs SET
+       status           = ?,
+       total            = ?,
+       processed        = ?,
+       succeeded        = ?,
+       failed           = ?,
+       last_article_id  = ?,
+       error            = ?,
+       started_at       = ?,
+       finished_at      = ?
+WHERE id  = ?`
+	res, err := repo.db.ExecContext(ctx, query,
+		job.Status, job.Total, job.Processed, job.Succeeded, job.Failed, job.LastArticleID,
+		job.Error, job.StartedAt, job.FinishedAt, job.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("UpdateJob: ExecContext: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("UpdateJob: no rows affected")
+	}
+	return nil
+}
+
+// resummarizeTargetWhere builds the WHERE conditions selecting the articles of a filter.
+func resummarizeTargetWhere(f entity.ResummarizeFilter) ([]string, []any) {
+	var conditions []string
+	var args []any
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, cond)
+	}
+
+	// 要約待ちの記事はバッチ要約の結果を待つため対象外。ゴミ箱の記事も対象外
+	add("summary_status = ?", "")
+	conditions = append(conditions, "deleted_at IS NULL")
+	if f.ArticleID != nil {
+		add("id = ?", *f.ArticleID)
+	}
+	if f.SourceID != nil {
+		add("source_id = ?", *f.SourceID)
+	}
+	if f.From != nil {
+		add("published_at >= ?", *f.From)
+	}
+	if f.To != nil {
+		add("published_at <= ?", *f.To)
+	}
+	if f.SummaryModel != "" {
+		add("summary_model = ?", f.SummaryModel)
+	}
+	return conditions, args
+}
+
+func (repo *ResummarizeRepo) CountTargets(ctx context.Context, filter entity.ResummarizeFilter) (int, error) {
+	conditions, args := resummarizeTargetWhere(filter)
+	query := `SELECT COUNT(*) FROM articles WHERE ` + strings.Join(conditions, " AND ")
+
+	var count int
+	if err := repo.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("CountTargets: QueryRowContext: %w", err)
+	}
+	return count, nil
+}
+
+func (repo *ResummarizeRepo) ListTargets(ctx context.Context, filter entity.ResummarizeFilter, afterID int64, limit int) ([]*entity.Article, error) {
+	conditions, args := resummarizeTargetWhere(filter)
+	conditions = append(conditions, "id > ?")
+	args = append(args, afterID, limit)
+
+	query := `
+SELECT id, source_id, title, url, summary, published_at, created_at, summary_structured, prompt_version, summary_status, summary_batch_id, summary_model, injection_flags
+FROM articles
+WHERE ` + strings.Join(conditions, " AND ") + `
+ORDER BY id
+LIMIT ?`
+
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ListTargets: QueryContext: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var articles []*entity.Article
+	for rows.Next() {
+		var row articleRow
+		if err := rows.Scan(row.dest()...); err != nil {
+			return nil, fmt.Errorf("ListTargets: Scan: %w", err)
+		}
+		articles = append(articles, row.toEntity())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ListTargets: rows.Err: %w", err)
+	}
+	return articles, nil
+}
@@ -0,0 +1,267 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+type SavedSearchRepo struct {
+	db           *sql.DB
+	queryBuilder *ArticleQueryBuilder
+}
+
+func NewSavedSearchRepo(db *sql.DB) repository.SavedSearchRepository {
+	return &SavedSearchRepo{
+		db:           db,
+		queryBuilder: NewArticleQueryBuilder(),
+	}
+}
+
+// Pending articles (batch summarization) keep the ID assigned at insert but are
+// summarized later, so saved searches, which remember the last article ID they
+// evaluated, would skip them. settledArticleCondition limits a query on articles
+// "a" to the articles before the first pending one, and latestSettledArticleIDQuery
+// returns the last such ID.
+const (
+	settledArticleCondition = `NOT EXISTS (
+	SELECT 1 FROM articles p
+	WHERE p.summary_status = 'pending' AND p.deleted_at IS NULL AND p.id <= a.id)`
+
+	latestSettledArticleIDQuery = `
+SELECT COALESCE(
+	(SELECT MIN(id) - 1 FROM articles WHERE summary_status = 'pending' AND deleted_at IS NULL),
+	(SELECT MAX(id) FROM articles),
+	0)`
+)
+
+const savedSearchColumns = `id, user_id, name, keyword, source_id, published_from, published_to, tags, subscribed, channel, last_article_id, last_alerted_at, created_at, updated_at`
+
+// savedSearchRow holds the scan destinations for a saved_searches row.
+type savedSearchRow struct {
+	search        entity.SavedSearch
+	sourceID      sql.NullInt64
+	from          sql.NullTime
+	to            sql.NullTime
+	tags          []byte
+	lastAlertedAt sql.NullTime
+}
+
+// dest returns the Scan destinations in savedSearchColumns order.
+func (r *savedSearchRow) dest() []any {
+	s := &r.search
+	return []any{
+		&s.ID, &s.UserID, &s.Name, &s.Keyword, &r.sourceID, &r.from, &r.to, &r.tags,
+		&s.Subscribed, &s.Channel, &s.LastArticleID, &r.lastAlertedAt, &s.CreatedAt, &s.UpdatedAt,
+	}
+}
+
+// toEntity converts the scanned row into a saved search entity.
+func (r *savedSearchRow) toEntity() (*entity.SavedSearch, error) {
+	s := r.search
+	if r.sourceID.Valid {
+		s.SourceID = &r.sourceID.Int64
+	}
+	if r.from.Valid {
+		s.From = &r.from.Time
+	}
+	if r.to.Valid {
+		s.To = &r.to.Time
+	}
+	if r.lastAlertedAt.Valid {
+		s.LastAlertedAt = &r.lastAlertedAt.Time
+	}
+	if err := json.Unmarshal(r.tags, &s.Tags); err != nil {
+		return nil, fmt.Errorf("decode tags of saved search %d: %w", s.ID, err)
+	}
+	return &s, nil
+}
+
+// savedSearchCriteria returns the nullable criteria columns of search
+// (source_id, published_from, published_to, tags) as query arguments.
+func savedSearchCriteria(search *entity.SavedSearch) ([]any, error) {
+	tags := search.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	b, err := json.Marshal(tags)
+	if err != nil {
+		return nil, fmt.Errorf("marshal tags: %w", err)
+	}
+	var sourceID sql.NullInt64
+	if search.SourceID != nil {
+		sourceID = sql.NullInt64{Int64: *search.SourceID, Valid: true}
+	}
+	var from, to sql.NullTime
+	if search.From != nil {
+		from = sql.NullTime{Time: *search.From, Valid: true}
+	}
+	if search.To != nil {
+		to = sql.NullTime{Time: *search.To, Valid: true}
+	}
+	return []any{sourceID, from, to, string(b)}, nil
+}
+
+func (repo *SavedSearchRepo) ListSearches(ctx context.Context, userID string) ([]*entity.SavedSearch, error) {
+	const query = `SELECT ` + savedSearchColumns + ` FROM saved_searches WHERE user_id = ? ORDER BY id`
+	return repo.listSearches(ctx, "ListSearches", query, userID)
+}
+
+func (repo *SavedSearchRepo) GetSearch(ctx context.Context, userID string, id int64) (*entity.SavedSearch, error) {
+	const query = `SELECT ` + savedSearchColumns + ` FROM saved_searches WHERE id = ? AND user_id = ?`
+	var row savedSearchRow
+	err := repo.db.QueryRowContext(ctx, query, id, userID).Scan(row.dest()...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("GetSearch: QueryRowContext: %w", err)
+	}
+	return row.toEntity()
+}
+
+func (repo *SavedSearchRepo) CreateSearch(ctx context.Context, search *entity.SavedSearch) error {
+	const query = `
+INSERT INTO saved_searches (user_id, name, keyword, source_id, published_from, published_to, tags, subscribed, channel, last_article_id, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	criteria, err := savedSearchCriteria(search)
+	if err != nil {
+		return fmt.Errorf("CreateSearch: %w", err)
+	}
+	now := time.Now()
+	args := append([]any{search.UserID, search.Name, search.Keyword}, criteria...)
+	args = append(args, search.Subscribed, search.Channel, search.LastArticleID, now, now)
+	res, err := repo.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("CreateSearch: ExecContext: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("CreateSearch: LastInsertId: %w", err)
+	}
+	search.ID = id
+	search.CreatedAt = now
+	search.UpdatedAt = now
+	return nil
+}
+
+func (repo *SavedSearchRepo) UpdateSearch(ctx context.Context, search *entity.SavedSearch) (bool, error) {
+	const query = `
+UPDATE saved_searches
+SET name = ?, keyword = ?, source_id = ?, published_from = ?, published_to = ?, tags = ?,
+    subscribed = ?, channel = ?, last_article_id = ?, updated_at = ?
+WHERE id = ? AND user_id = ?`
+	criteria, err := savedSearchCriteria(search)
+	if err != nil {
+		return false, fmt.Errorf("UpdateSearch: %w", err)
+	}
+	now := time.Now()
+	args := append([]any{search.Name, search.Keyword}, criteria...)
+	args = append(args, search.Subscribed, search.Channel, search.LastArticleID, now, search.ID, search.UserID)
+	res, err := repo.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("UpdateSearch: ExecContext: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("UpdateSearch: RowsAffected: %w", err)
+	}
+	if n == 0 {
+		return false, nil
+	}
+	search.UpdatedAt = now
+	return true, nil
+}
+
+func (repo *SavedSearchRepo) DeleteSearch(ctx context.Context, userID string, id int64) (bool, error) {
+	const query = `DELETE FROM saved_searches WHERE id = ? AND user_id = ?`
+	res, err := repo.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return false, fmt.Errorf("DeleteSearch: ExecContext: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("DeleteSearch: RowsAffected: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (repo *SavedSearchRepo) ListSubscribed(ctx context.Context) ([]*entity.SavedSearch, error) {
+	const query = `SELECT ` + savedSearchColumns + ` FROM saved_searches WHERE subscribed ORDER BY id`
+	return repo.listSearches(ctx, "ListSubscribed", query)
+}
+
+// listSearches returns the saved searches selected by query.
+func (repo *SavedSearchRepo) listSearches(ctx context.Context, op, query string, args ...any) ([]*entity.SavedSearch, error) {
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: QueryContext: %w", op, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var searches []*entity.SavedSearch
+	for rows.Next() {
+		var row savedSearchRow
+		if err := rows.Scan(row.dest()...); err != nil {
+			return nil, fmt.Errorf("%s: Scan: %w", op, err)
+		}
+		s, err := row.toEntity()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		searches = append(searches, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows.Err: %w", op, err)
+	}
+	return searches, nil
+}
+
+func (repo *SavedSearchRepo) LatestArticleID(ctx context.Context) (int64, error) {
+	var id int64
+	if err := repo.db.QueryRowContext(ctx, latestSettledArticleIDQuery).Scan(&id); err != nil {
+		return 0, fmt.Errorf("LatestArticleID: QueryRowContext: %w", err)
+	}
+	return id, nil
+}
+
+func (repo *SavedSearchRepo) ListNewMatches(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters, afterID, uptoID int64, limit int) ([]repository.ArticleWithSource, error) {
+	whereClause, args := repo.queryBuilder.BuildWhereClause(keywords, filters, "a")
+	whereClause = andCondition(whereClause, "a.deleted_at IS NULL AND a.id > ? AND a.id <= ?")
+	whereClause = andCondition(whereClause, settledArticleCondition)
+	args = append(args, afterID, uptoID, limit)
+
+	// #nosec G202 -- whereClause is generated by QueryBuilder using parameterized placeholders (?)
+	query := `
+SELECT ` + articleWithSourceColumns + `
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+` + whereClause + `
+ORDER BY a.published_at DESC, a.id DESC
+LIMIT ?`
+
+	return queryWithSource(ctx, repo.db, "ListNewMatches", query, args, limit)
+}
+
+func (repo *SavedSearchRepo) MarkEvaluated(ctx context.Context, id, lastArticleID int64, alertedAt *time.Time) error {
+	// 通知しなかった場合は last_alerted_at を変えない。
+	// 要約待ちの記事を要約後に評価できるよう、既読位置はその手前までに抑える
+	const query = `
+UPDATE saved_searches
+SET last_article_id = MIN(?, (` + latestSettledArticleIDQuery + `)), last_alerted_at = COALESCE(?, last_alerted_at)
+WHERE id = ?`
+	var alerted sql.NullTime
+	if alertedAt != nil {
+		alerted = sql.NullTime{Time: *alertedAt, Valid: true}
+	}
+	if _, err := repo.db.ExecContext(ctx, query, lastArticleID, alerted, id); err != nil {
+		return fmt.Errorf("MarkEvaluated: ExecContext: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/infra/adapter/persistence/sqlite"
+	"catchup-feed/internal/repository"
+)
+
+var savedSearchColumnNames = []string{
+	"id", "user_id", "name", "keyword", "source_id", "published_from", "published_to", "tags",
+	"subscribed", "channel", "last_article_id", "last_alerted_at", "created_at", "updated_at",
+}
+
+func TestSavedSearchRepo_ListSearches(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM saved_searches WHERE user_id = ? ORDER BY id`)).
+		WithArgs("alice").
+		WillReturnRows(sqlmock.NewRows(savedSearchColumnNames).
+			AddRow(int64(1), "alice", "Go", "go", int64(3), nil, nil, []byte(`["go"]`), true, "slack", int64(10), now, now, now))
+
+	got, err := sqlite.NewSavedSearchRepo(db).ListSearches(context.Background(), "alice")
+	if err != nil {
+		t.Fatalf("ListSearches err=%v", err)
+	}
+	if len(got) != 1 || *got[0].SourceID != 3 || len(got[0].Tags) != 1 || got[0].LastAlertedAt == nil || got[0].Channel != "slack" {
+		t.Errorf("ListSearches = %+v", got)
+	}
+}
+
+func TestSavedSearchRepo_CreateSearch(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	search := &entity.SavedSearch{UserID: "alice", Name: "Go", Keyword: "go", LastArticleID: 120}
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO saved_searches`)).
+		WithArgs("alice", "Go", "go", sql.NullInt64{}, sql.NullTime{}, sql.NullTime{}, `[]`,
+			false, "", int64(120), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+
+	if err := sqlite.NewSavedSearchRepo(db).CreateSearch(context.Background(), search); err != nil {
+		t.Fatalf("CreateSearch err=%v", err)
+	}
+	if search.ID != 5 || search.CreatedAt.IsZero() {
+		t.Errorf("search = %+v", search)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSavedSearchRepo_UpdateSearch(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		want     bool
+	}{
+		{name: "updated", affected: 1, want: true},
+		{name: "not found", affected: 0, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, _ := sqlmock.New()
+			defer func() { _ = db.Close() }()
+
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE saved_searches`)).
+				WithArgs("Go", "go", sql.NullInt64{}, sql.NullTime{}, sql.NullTime{}, `["go"]`,
+					true, "discord", int64(7), sqlmock.AnyArg(), int64(9), "alice").
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			search := &entity.SavedSearch{ID: 9, UserID: "alice", Name: "Go", Keyword: "go", Tags: []string{"go"},
+				Subscribed: true, Channel: "discord", LastArticleID: 7}
+			got, err := sqlite.NewSavedSearchRepo(db).UpdateSearch(context.Background(), search)
+			if err != nil || got != tt.want {
+				t.Fatalf("UpdateSearch = %v, %v; want %v", got, err, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestSavedSearchRepo_ListNewMatches(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	// 要約待ちの記事とそれ以降の記事は、要約されるまで評価しない
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE (a.title LIKE ? OR a.summary LIKE ?) AND a.id IN (SELECT`)+
+		`(.|\n)*`+regexp.QuoteMeta(`WHERE p.summary_status = 'pending' AND p.deleted_at IS NULL AND p.id <= a.id)`)).
+		WithArgs("%go%", "%go%", "release", int64(120), int64(130), 20).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	got, err := sqlite.NewSavedSearchRepo(db).ListNewMatches(context.Background(),
+		[]string{"go"}, repository.ArticleSearchFilters{Tags: []string{"release"}}, 120, 130, 20)
+	if err != nil {
+		t.Fatalf("ListNewMatches err=%v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("ListNewMatches = %+v, want empty", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSavedSearchRepo_MarkEvaluated(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec(regexp.QuoteMeta(`SET last_article_id = MIN(?, (
+SELECT COALESCE(
+	(SELECT MIN(id) - 1 FROM articles WHERE summary_status = 'pending' AND deleted_at IS NULL),`)).
+		WithArgs(int64(130), sql.NullTime{}, int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := sqlite.NewSavedSearchRepo(db).MarkEvaluated(context.Background(), 1, 130, nil); err != nil {
+		t.Fatalf("MarkEvaluated err=%v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,68 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+type SourceArticleRepo struct {
+	db *sql.DB
+}
+
+func NewSourceArticleRepo(db *sql.DB) repository.SourceArticleRepository {
+	return &SourceArticleRepo{db: db}
+}
+
+func (repo *SourceArticleRepo) ListBySource(ctx context.Context, sourceID int64, offset, limit int) ([]*entity.Article, error) {
+	const query = `
+SELECT id, source_id, title, url, summary, published_at, created_at, summary_structured, prompt_version, summary_status, summary_batch_id, summary_model, injection_flags
+FROM articles
+WHERE source_id = ? AND deleted_at IS NULL
+ORDER BY published_at DESC, id DESC
+LIMIT ? OFFSET ?`
+	rows, err := repo.db.QueryContext(ctx, query, sourceID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("ListBySource: QueryContext: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	articles := make([]*entity.Article, 0, limit)
+	for rows.Next() {
+		var row articleRow
+		if err := rows.Scan(row.dest()...); err != nil {
+			return nil, fmt.Errorf("ListBySource: Scan: %w", err)
+		}
+		articles = append(articles, row.toEntity())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ListBySource: rows.Err: %w", err)
+	}
+	return articles, nil
+}
+
+func (repo *SourceArticleRepo) CountBySource(ctx context.Context, sourceID int64, dayStart, weekStart time.Time) (repository.SourceArticleCounts, error) {
+	const query = `
+SELECT COUNT(*),
+       COALESCE(SUM(CASE WHEN created_at >= ? THEN 1 ELSE 0 END), 0),
+       COALESCE(SUM(CASE WHEN created_at >= ? THEN 1 ELSE 0 END), 0),
+       MAX(created_at),
+       (SELECT COUNT(*) FROM summarize_failures WHERE source_id = ? AND failed_at >= ?)
+FROM articles
+WHERE source_id = ? AND deleted_at IS NULL`
+	var c repository.SourceArticleCounts
+	var lastArticle sql.NullTime
+	err := repo.db.QueryRowContext(ctx, query, dayStart, weekStart, sourceID, weekStart, sourceID).
+		Scan(&c.Total, &c.LastDay, &c.LastWeek, &lastArticle, &c.SummarizeFailuresLastWeek)
+	if err != nil {
+		return c, fmt.Errorf("CountBySource: QueryRowContext: %w", err)
+	}
+	if lastArticle.Valid {
+		c.LastArticleAt = &lastArticle.Time
+	}
+	return c, nil
+}
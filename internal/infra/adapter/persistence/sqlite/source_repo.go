@@ -20,13 +20,14 @@ func NewSourceRepo(db *sql.DB) repository.SourceRepository {
 
 func (repo *SourceRepo) Get(ctx context.Context, id int64) (*entity.Source, error) {
 	const query = `
-SELECT id, name, feed_url, last_crawled_at, active
+SELECT id, name, feed_url, last_crawled_at, active, COALESCE(prompt_template, '')
 FROM sources
-WHERE id = ?
+WHERE id = ? AND deleted_at IS NULL
 LIMIT 1`
 	var source entity.Source
 	err := repo.db.QueryRowContext(ctx, query, id).Scan(
 		&source.ID, &source.Name, &source.FeedURL, &source.LastCrawledAt, &source.Active,
+		&source.PromptTemplate,
 	)
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -44,8 +45,10 @@ SELECT
     name,
     feed_url,
     last_crawled_at,
-    active
+    active,
+    COALESCE(prompt_template, '')
 FROM sources
+WHERE deleted_at IS NULL
 ORDER BY id ASC
 `
 	rows, err := repo.db.QueryContext(ctx, query)
@@ -61,7 +64,7 @@ ORDER BY id ASC
 		err := rows.Scan(&source.ID,
 			&source.Name, &source.FeedURL,
 			&source.LastCrawledAt,
-			&source.Active)
+			&source.Active, &source.PromptTemplate)
 		if err != nil {
 			return nil, fmt.Errorf("List: Scan: %w", err)
 		}
@@ -77,9 +80,9 @@ ORDER BY id ASC
 
 func (repo *SourceRepo) ListActive(ctx context.Context) ([]*entity.Source, error) {
 	const query = `
-SELECT id, name, feed_url, last_crawled_at, active
+SELECT id, name, feed_url, last_crawled_at, active, COALESCE(prompt_template, '')
 FROM sources
-WHERE active = TRUE
+WHERE active = TRUE AND deleted_at IS NULL
 ORDER BY id ASC`
 	rows, err := repo.db.QueryContext(ctx, query)
 	if err != nil {
@@ -92,7 +95,7 @@ ORDER BY id ASC`
 	for rows.Next() {
 		var source entity.Source
 		if err := rows.Scan(&source.ID, &source.Name, &source.FeedURL,
-			&source.LastCrawledAt, &source.Active); err != nil {
+			&source.LastCrawledAt, &source.Active, &source.PromptTemplate); err != nil {
 			return nil, fmt.Errorf("ListActive: Scan: %w", err)
 		}
 		activeSource = append(activeSource, &source)
@@ -107,10 +110,12 @@ SELECT
     name,
     feed_url,
     last_crawled_at,
-    active
+    active,
+    COALESCE(prompt_template, '')
 FROM sources
-WHERE name  LIKE ?
-OR feed_url LIKE ?
+WHERE (name  LIKE ?
+OR feed_url LIKE ?)
+AND deleted_at IS NULL
 ORDER BY id ASC
 `
 	param := "%" + keyword + "%"
@@ -127,7 +132,7 @@ ORDER BY id ASC
 		err := rows.Scan(&source.ID,
 			&source.Name, &source.FeedURL,
 			&source.LastCrawledAt,
-			&source.Active)
+			&source.Active, &source.PromptTemplate)
 		if err != nil {
 			return nil, fmt.Errorf("Search: Scan: %w", err)
 		}
@@ -147,8 +152,8 @@ func (repo *SourceRepo) SearchWithFilters(ctx context.Context, keywords []string
 	ctx, cancel := context.WithTimeout(ctx, search.DefaultSearchTimeout)
 	defer cancel()
 
-	// Build WHERE clause conditions
-	var conditions []string
+	// Build WHERE clause conditions (sources in the trash are never returned)
+	conditions := []string{"deleted_at IS NULL"}
 	var args []interface{}
 
 	// Add keyword conditions (AND logic between keywords, OR logic within each keyword)
@@ -171,22 +176,19 @@ func (repo *SourceRepo) SearchWithFilters(ctx context.Context, keywords []string
 		args = append(args, *filters.Active)
 	}
 
+	// Add category filter if provided
+	if filters.CategoryID != nil {
+		conditions = append(conditions, "id IN (SELECT source_id FROM source_category_members WHERE category_id = ?)")
+		args = append(args, *filters.CategoryID)
+	}
+
 	// Build final query with dynamic WHERE clause
-	var query string
-	if len(conditions) > 0 {
-		// With filters or keywords
-		query = `
-SELECT id, name, feed_url, source_type, last_crawled_at, active
+	// (no keywords and no filters returns all sources: browse mode)
+	query := `
+SELECT id, name, feed_url, source_type, last_crawled_at, active, COALESCE(prompt_template, '')
 FROM sources
 WHERE ` + strings.Join(conditions, " AND ") + `
 ORDER BY id ASC`
-	} else {
-		// No keywords, no filters - return all sources (browse mode)
-		query = `
-SELECT id, name, feed_url, source_type, last_crawled_at, active
-FROM sources
-ORDER BY id ASC`
-	}
 
 	// Execute query
 	rows, err := repo.db.QueryContext(ctx, query, args...)
@@ -200,7 +202,7 @@ ORDER BY id ASC`
 	for rows.Next() {
 		var source entity.Source
 		if err := rows.Scan(&source.ID, &source.Name, &source.FeedURL,
-			&source.SourceType, &source.LastCrawledAt, &source.Active); err != nil {
+			&source.SourceType, &source.LastCrawledAt, &source.Active, &source.PromptTemplate); err != nil {
 			return nil, fmt.Errorf("SearchWithFilters: Scan: %w", err)
 		}
 		sources = append(sources, &source)
@@ -211,13 +213,13 @@ ORDER BY id ASC`
 func (repo *SourceRepo) Create(ctx context.Context, source *entity.Source) error {
 	const query = `
 INSERT INTO sources
-(name, feed_url, last_crawled_at, active)
-VALUES (?, ?, ?, ?)
+(name, feed_url, last_crawled_at, active, prompt_template)
+VALUES (?, ?, ?, ?, ?)
 `
 
 	_, err := repo.db.ExecContext(ctx, query,
 		source.Name, source.FeedURL,
-		source.LastCrawledAt, source.Active,
+		source.LastCrawledAt, source.Active, source.PromptTemplate,
 	)
 	if err != nil {
 		return fmt.Errorf("Create: ExecContext: %w", err)
@@ -231,12 +233,13 @@ UPDATE sources SET
     name            = ?,
     feed_url        = ?,
     last_crawled_at = ?,
-    active          = ?
-WHERE id = ?
+    active          = ?,
+    prompt_template = ?
+WHERE id = ? AND deleted_at IS NULL
 `
 	res, err := repo.db.ExecContext(ctx, query,
 		source.Name, source.FeedURL,
-		source.LastCrawledAt, source.Active, source.ID,
+		source.LastCrawledAt, source.Active, source.PromptTemplate, source.ID,
 	)
 
 	if err != nil {
@@ -252,11 +255,21 @@ WHERE id = ?
 	return nil
 }
 
+// Delete moves the source and its articles to the trash. The articles get the
+// same deleted_at as the source, so that restoring the source restores exactly
+// the articles deleted with it (see TrashRepo.RestoreSource).
 func (repo *SourceRepo) Delete(ctx context.Context, id int64) error {
-	const query = `DELETE FROM sources WHERE id = ?`
+	const trashSource = `UPDATE sources SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL`
+	const trashArticles = `UPDATE articles SET deleted_at = ? WHERE source_id = ? AND deleted_at IS NULL`
 
-	res, err := repo.db.ExecContext(ctx, query, id)
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Delete: BeginTx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
 
+	now := time.Now().UTC()
+	res, err := tx.ExecContext(ctx, trashSource, now, id)
 	if err != nil {
 		return fmt.Errorf("Delete: ExecContext: %w", err)
 	}
@@ -267,6 +280,12 @@ func (repo *SourceRepo) Delete(ctx context.Context, id int64) error {
 	if n == 0 {
 		return fmt.Errorf("Delete: no rows affected")
 	}
+	if _, err := tx.ExecContext(ctx, trashArticles, now, id); err != nil {
+		return fmt.Errorf("Delete: ExecContext: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("Delete: Commit: %w", err)
+	}
 	return nil
 }
 
@@ -20,10 +20,10 @@ import (
 func row(src *entity.Source) *sqlmock.Rows {
 	return sqlmock.NewRows([]string{
 		"id", "name", "feed_url",
-		"last_crawled_at", "active",
+		"last_crawled_at", "active", "prompt_template",
 	}).AddRow(
 		src.ID, src.Name, src.FeedURL,
-		src.LastCrawledAt, src.Active,
+		src.LastCrawledAt, src.Active, src.PromptTemplate,
 	)
 }
 
@@ -86,7 +86,7 @@ func TestSourceRepo_Search(t *testing.T) {
 	mock.ExpectQuery("FROM sources").
 		WithArgs("%go%", "%go%").
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "feed_url",
-			"last_crawled_at", "active"})) // 空結果で十分
+			"last_crawled_at", "active", "prompt_template"})) // 空結果で十分
 
 	repo := sqlite.NewSourceRepo(db)
 	_, err := repo.Search(context.Background(), "go")
@@ -106,7 +106,7 @@ func TestSourceRepo_Create(t *testing.T) {
 	defer func() { _ = db.Close() }()
 
 	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO sources")).
-		WithArgs("Qiita", "https://qiita.com/feed", sqlmock.AnyArg(), true).
+		WithArgs("Qiita", "https://qiita.com/feed", sqlmock.AnyArg(), true, "").
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	repo := sqlite.NewSourceRepo(db)
@@ -131,7 +131,7 @@ func TestSourceRepo_Update(t *testing.T) {
 
 	mock.ExpectExec("UPDATE sources").
 		WithArgs("Qiita", "https://qiita.com/feed",
-								sqlmock.AnyArg(), true, 1).
+								sqlmock.AnyArg(), true, "", 1).
 		WillReturnResult(sqlmock.NewResult(0, 1)) // 1行更新
 
 	repo := sqlite.NewSourceRepo(db)
@@ -154,9 +154,15 @@ func TestSourceRepo_Delete(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer func() { _ = db.Close() }()
 
-	mock.ExpectExec("DELETE FROM sources").
-		WithArgs(int64(1)).
-		WillReturnResult(sqlmock.NewResult(0, 1)) // 1行削除
+	// ソースと記事を同じ日時でゴミ箱へ移す
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE sources SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL")).
+		WithArgs(sqlmock.AnyArg(), int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE articles SET deleted_at = ? WHERE source_id = ? AND deleted_at IS NULL")).
+		WithArgs(sqlmock.AnyArg(), int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 12))
+	mock.ExpectCommit()
 
 	repo := sqlite.NewSourceRepo(db)
 	err := repo.Delete(context.Background(), 1)
@@ -177,10 +183,10 @@ func TestSourceRepo_ListActive(t *testing.T) {
 
 	now := time.Now()
 	rows := sqlmock.NewRows([]string{
-		"id", "name", "feed_url", "last_crawled_at", "active",
+		"id", "name", "feed_url", "last_crawled_at", "active", "prompt_template",
 	}).
-		AddRow(1, "Qiita", "https://qiita.com/feed", now, true).
-		AddRow(2, "Zenn", "https://zenn.dev/feed", now, true)
+		AddRow(1, "Qiita", "https://qiita.com/feed", now, true, "").
+		AddRow(2, "Zenn", "https://zenn.dev/feed", now, true, "")
 
 	mock.ExpectQuery("FROM sources").
 		WillReturnRows(rows)
@@ -206,7 +212,7 @@ func TestSourceRepo_ListActive_Empty(t *testing.T) {
 	defer func() { _ = db.Close() }()
 
 	rows := sqlmock.NewRows([]string{
-		"id", "name", "feed_url", "last_crawled_at", "active",
+		"id", "name", "feed_url", "last_crawled_at", "active", "prompt_template",
 	})
 
 	mock.ExpectQuery("FROM sources").
@@ -277,7 +283,7 @@ func TestSourceRepo_Update_NoRowsAffected(t *testing.T) {
 	now := time.Now()
 	mock.ExpectExec("UPDATE sources").
 		WithArgs("Qiita", "https://qiita.com/feed",
-			now, true, int64(999)).
+			now, true, "", int64(999)).
 		WillReturnResult(sqlmock.NewResult(0, 0))
 
 	repo := sqlite.NewSourceRepo(db)
@@ -297,9 +303,11 @@ func TestSourceRepo_Delete_NoRowsAffected(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer func() { _ = db.Close() }()
 
-	mock.ExpectExec("DELETE FROM sources").
-		WithArgs(int64(999)).
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE sources SET deleted_at").
+		WithArgs(sqlmock.AnyArg(), int64(999)).
 		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
 
 	repo := sqlite.NewSourceRepo(db)
 	err := repo.Delete(context.Background(), 999)
@@ -322,10 +330,10 @@ func TestSourceRepo_SearchWithFilters_EmptyKeywords_NoFilters(t *testing.T) {
 
 	now := time.Now()
 	rows := sqlmock.NewRows([]string{
-		"id", "name", "feed_url", "source_type", "last_crawled_at", "active",
+		"id", "name", "feed_url", "source_type", "last_crawled_at", "active", "prompt_template",
 	}).
-		AddRow(1, "Tech Blog", "https://example.com/feed", "RSS", now, true).
-		AddRow(2, "News Site", "https://news.example.com/feed", "Webflow", now, false)
+		AddRow(1, "Tech Blog", "https://example.com/feed", "RSS", now, true, "").
+		AddRow(2, "News Site", "https://news.example.com/feed", "Webflow", now, false, "")
 
 	// No WHERE clause - returns all sources
 	mock.ExpectQuery("FROM sources").
@@ -351,8 +359,8 @@ func TestSourceRepo_SearchWithFilters_EmptyKeywords_SourceTypeFilter(t *testing.
 
 	now := time.Now()
 	rows := sqlmock.NewRows([]string{
-		"id", "name", "feed_url", "source_type", "last_crawled_at", "active",
-	}).AddRow(1, "RSS Blog", "https://example.com/feed", "RSS", now, true)
+		"id", "name", "feed_url", "source_type", "last_crawled_at", "active", "prompt_template",
+	}).AddRow(1, "RSS Blog", "https://example.com/feed", "RSS", now, true, "")
 
 	sourceType := "RSS"
 	filters := repository.SourceSearchFilters{
@@ -387,10 +395,10 @@ func TestSourceRepo_SearchWithFilters_EmptyKeywords_ActiveFilter(t *testing.T) {
 
 	now := time.Now()
 	rows := sqlmock.NewRows([]string{
-		"id", "name", "feed_url", "source_type", "last_crawled_at", "active",
+		"id", "name", "feed_url", "source_type", "last_crawled_at", "active", "prompt_template",
 	}).
-		AddRow(1, "Active Blog", "https://example.com/feed", "RSS", now, true).
-		AddRow(2, "Another Active", "https://example2.com/feed", "Webflow", now, true)
+		AddRow(1, "Active Blog", "https://example.com/feed", "RSS", now, true, "").
+		AddRow(2, "Another Active", "https://example2.com/feed", "Webflow", now, true, "")
 
 	active := true
 	filters := repository.SourceSearchFilters{
@@ -427,8 +435,8 @@ func TestSourceRepo_SearchWithFilters_EmptyKeywords_MultipleFilters(t *testing.T
 
 	now := time.Now()
 	rows := sqlmock.NewRows([]string{
-		"id", "name", "feed_url", "source_type", "last_crawled_at", "active",
-	}).AddRow(1, "Active RSS", "https://example.com/feed", "RSS", now, true)
+		"id", "name", "feed_url", "source_type", "last_crawled_at", "active", "prompt_template",
+	}).AddRow(1, "Active RSS", "https://example.com/feed", "RSS", now, true, "")
 
 	sourceType := "RSS"
 	active := true
@@ -467,7 +475,7 @@ func TestSourceRepo_SearchWithFilters_EmptyKeywords_EmptyResult(t *testing.T) {
 	defer func() { _ = db.Close() }()
 
 	rows := sqlmock.NewRows([]string{
-		"id", "name", "feed_url", "source_type", "last_crawled_at", "active",
+		"id", "name", "feed_url", "source_type", "last_crawled_at", "active", "prompt_template",
 	}) // No rows
 
 	sourceType := "NonExistent"
@@ -494,3 +502,30 @@ func TestSourceRepo_SearchWithFilters_EmptyKeywords_EmptyResult(t *testing.T) {
 		t.Fatalf("ExpectationsWereMet: %v", err)
 	}
 }
+
+// TestSourceRepo_SearchWithFilters_CategoryFilter verifies the category filter subquery
+func TestSourceRepo_SearchWithFilters_CategoryFilter(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	rows := sqlmock.NewRows([]string{
+		"id", "name", "feed_url", "source_type", "last_crawled_at", "active", "prompt_template",
+	}).AddRow(1, "Go Blog", "https://go.dev/blog/feed.atom", "RSS", time.Now(), true, "")
+
+	categoryID := int64(4)
+	mock.ExpectQuery(regexp.QuoteMeta("id IN (SELECT source_id FROM source_category_members WHERE category_id = ?)")).
+		WithArgs(int64(4)).
+		WillReturnRows(rows)
+
+	repo := sqlite.NewSourceRepo(db)
+	sources, err := repo.SearchWithFilters(context.Background(), nil, repository.SourceSearchFilters{CategoryID: &categoryID})
+	if err != nil {
+		t.Fatalf("SearchWithFilters err=%v", err)
+	}
+	if len(sources) != 1 {
+		t.Fatalf("expected 1 source, got %d", len(sources))
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("ExpectationsWereMet: %v", err)
+	}
+}
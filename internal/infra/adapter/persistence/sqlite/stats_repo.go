@@ -0,0 +1,137 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"catchup-feed/internal/repository"
+)
+
+type StatsRepo struct {
+	db *sql.DB
+}
+
+func NewStatsRepo(db *sql.DB) repository.StatsRepository {
+	return &StatsRepo{db: db}
+}
+
+// NewSummarizeFailureRepo returns the repository the fetch service records failed
+// summarizations to. They are stored in the table StatsRepo aggregates.
+func NewSummarizeFailureRepo(db *sql.DB) repository.SummarizeFailureRepository {
+	return &StatsRepo{db: db}
+}
+
+func (repo *StatsRepo) SourceStats(ctx context.Context, dayStart, weekStart time.Time) ([]repository.SourceStats, error) {
+	const query = `
+SELECT s.id, s.name, s.active, s.last_crawled_at,
+       COUNT(a.id),
+       COALESCE(SUM(CASE WHEN a.created_at >= ? THEN 1 ELSE 0 END), 0),
+       COALESCE(SUM(CASE WHEN a.created_at >= ? THEN 1 ELSE 0 END), 0),
+       COALESCE(AVG(CASE WHEN a.summary <> '' THEN length(a.summary) END), 0),
+       MAX(a.created_at),
+       (SELECT COUNT(*) FROM summarize_failures f WHERE f.source_id = s.id AND f.failed_at >= ?)
+FROM sources s
+LEFT JOIN articles a ON a.source_id = s.id AND a.deleted_at IS NULL
+WHERE s.deleted_at IS NULL
+GROUP BY s.id
+ORDER BY s.id`
+	rows, err := repo.db.QueryContext(ctx, query, dayStart, weekStart, weekStart)
+	if err != nil {
+		return nil, fmt.Errorf("SourceStats: QueryContext: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var stats []repository.SourceStats
+	for rows.Next() {
+		var s repository.SourceStats
+		var lastCrawled, lastArticle sql.NullTime
+		if err := rows.Scan(&s.SourceID, &s.SourceName, &s.Active, &lastCrawled,
+			&s.ArticlesTotal, &s.ArticlesLastDay, &s.ArticlesLastWeek, &s.AvgSummaryLength,
+			&lastArticle, &s.SummarizeFailuresLastWeek); err != nil {
+			return nil, fmt.Errorf("SourceStats: Scan: %w", err)
+		}
+		if lastCrawled.Valid {
+			s.LastCrawledAt = &lastCrawled.Time
+		}
+		if lastArticle.Valid {
+			s.LastArticleAt = &lastArticle.Time
+		}
+		stats = append(stats, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("SourceStats: rows.Err: %w", err)
+	}
+	return stats, nil
+}
+
+// bucketFormats are the strftime formats truncating created_at to the start of each interval.
+var bucketFormats = map[string]string{
+	repository.StatsIntervalHour: `strftime('%Y-%m-%dT%H:00:00Z', created_at)`,
+	repository.StatsIntervalDay:  `strftime('%Y-%m-%dT00:00:00Z', created_at)`,
+	// 'weekday 0' で次の日曜日に進め、6日戻して月曜日にする
+	repository.StatsIntervalWeek: `strftime('%Y-%m-%dT00:00:00Z', created_at, 'weekday 0', '-6 days')`,
+}
+
+func (repo *StatsRepo) IngestionSeries(ctx context.Context, interval string, from, to time.Time, sourceID *int64) ([]repository.IngestionBucket, error) {
+	bucket, ok := bucketFormats[interval]
+	if !ok {
+		return nil, fmt.Errorf("IngestionSeries: unknown interval %q", interval)
+	}
+	query := `
+SELECT ` + bucket + ` AS bucket, COUNT(*)
+FROM articles
+WHERE deleted_at IS NULL AND created_at >= ? AND created_at < ?`
+	args := []interface{}{from, to}
+	if sourceID != nil {
+		query += " AND source_id = ?"
+		args = append(args, *sourceID)
+	}
+	query += `
+GROUP BY bucket
+ORDER BY bucket`
+
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("IngestionSeries: QueryContext: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var buckets []repository.IngestionBucket
+	for rows.Next() {
+		var start string
+		var b repository.IngestionBucket
+		if err := rows.Scan(&start, &b.Count); err != nil {
+			return nil, fmt.Errorf("IngestionSeries: Scan: %w", err)
+		}
+		if b.Start, err = time.Parse(time.RFC3339, start); err != nil {
+			return nil, fmt.Errorf("IngestionSeries: parse bucket: %w", err)
+		}
+		buckets = append(buckets, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("IngestionSeries: rows.Err: %w", err)
+	}
+	return buckets, nil
+}
+
+func (repo *StatsRepo) RecordSummarizeFailure(ctx context.Context, sourceID int64, url string) error {
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("RecordSummarizeFailure: BeginTx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	now := time.Now()
+	if _, err := tx.ExecContext(ctx, `DELETE FROM summarize_failures WHERE failed_at < ?`, now.AddDate(0, 0, -30)); err != nil {
+		return fmt.Errorf("RecordSummarizeFailure: ExecContext: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO summarize_failures (source_id, url, failed_at) VALUES (?, ?, ?)`, sourceID, url, now); err != nil {
+		return fmt.Errorf("RecordSummarizeFailure: ExecContext: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("RecordSummarizeFailure: Commit: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package sqlite_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"catchup-feed/internal/infra/adapter/persistence/sqlite"
+	"catchup-feed/internal/repository"
+)
+
+func TestStatsRepo_SourceStats(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	dayStart, weekStart := now.Add(-24*time.Hour), now.Add(-7*24*time.Hour)
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE s.deleted_at IS NULL
+GROUP BY s.id`)).
+		WithArgs(dayStart, weekStart, weekStart).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "active", "last_crawled_at", "total", "day", "week", "avg", "max", "failures"}).
+			AddRow(int64(1), "Go Blog", true, nil, int64(40), int64(2), int64(9), 312.5, now, int64(1)))
+
+	got, err := sqlite.NewStatsRepo(db).SourceStats(context.Background(), dayStart, weekStart)
+	if err != nil {
+		t.Fatalf("SourceStats err=%v", err)
+	}
+	if len(got) != 1 || got[0].ArticlesLastWeek != 9 || got[0].LastCrawledAt != nil || got[0].LastArticleAt == nil {
+		t.Errorf("SourceStats = %+v", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStatsRepo_IngestionSeries(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	from := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 14)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT strftime('%Y-%m-%dT00:00:00Z', created_at, 'weekday 0', '-6 days') AS bucket, COUNT(*)`)).
+		WithArgs(from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket", "count"}).
+			AddRow("2025-06-02T00:00:00Z", int64(4)).
+			AddRow("2025-06-09T00:00:00Z", int64(6)))
+
+	got, err := sqlite.NewStatsRepo(db).IngestionSeries(context.Background(), repository.StatsIntervalWeek, from, to, nil)
+	if err != nil {
+		t.Fatalf("IngestionSeries err=%v", err)
+	}
+	if len(got) != 2 || !got[0].Start.Equal(from) || got[1].Count != 6 {
+		t.Errorf("IngestionSeries = %+v", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := sqlite.NewStatsRepo(db).IngestionSeries(context.Background(), "month", from, to, nil); err == nil {
+		t.Error("unknown interval should fail")
+	}
+}
+
+func TestStatsRepo_RecordSummarizeFailure(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM summarize_failures WHERE failed_at < ?`)).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO summarize_failures (source_id, url, failed_at) VALUES (?, ?, ?)`)).
+		WithArgs(int64(3), "https://example.com/a", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	if err := sqlite.NewSummarizeFailureRepo(db).RecordSummarizeFailure(context.Background(), 3, "https://example.com/a"); err != nil {
+		t.Fatalf("RecordSummarizeFailure err=%v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
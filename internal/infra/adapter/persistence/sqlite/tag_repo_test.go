@@ -0,0 +1,136 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/infra/adapter/persistence/sqlite"
+	"catchup-feed/internal/repository"
+)
+
+func TestTagRepo_AddArticleTags(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	for _, name := range []string{"go", "release"} {
+		mock.ExpectExec("INSERT INTO tags").
+			WithArgs(name).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO article_tags").
+			WithArgs(int64(7), entity.TagOriginFeed, name).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	repo := sqlite.NewTagRepo(db)
+	if err := repo.AddArticleTags(context.Background(), 7, []string{"go", "release"}, entity.TagOriginFeed); err != nil {
+		t.Fatalf("AddArticleTags err=%v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestTagRepo_AddArticleTags_Error(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec("INSERT INTO tags").
+		WithArgs("go").
+		WillReturnError(errors.New("db down"))
+
+	repo := sqlite.NewTagRepo(db)
+	if err := repo.AddArticleTags(context.Background(), 7, []string{"go", "release"}, entity.TagOriginRule); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestTagRepo_ListTagCounts(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	// ゴミ箱の記事は数えない
+	mock.ExpectQuery("FROM tags t\\s+INNER JOIN article_tags atg ON atg.tag_id = t.id\\s+INNER JOIN articles a ON a.id = atg.article_id AND a.deleted_at IS NULL\\s+GROUP BY t.name").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "article_count"}).
+			AddRow("go", int64(12)).
+			AddRow("rust", int64(3)))
+
+	repo := sqlite.NewTagRepo(db)
+	got, err := repo.ListTagCounts(context.Background())
+	if err != nil {
+		t.Fatalf("ListTagCounts err=%v", err)
+	}
+	want := []repository.TagCount{{Name: "go", Count: 12}, {Name: "rust", Count: 3}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestTagRuleRepo_ListRules(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("FROM tag_rules").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tag", "pattern", "match_type", "created_at"}).
+			AddRow(int64(1), "go", "golang", entity.TagMatchKeyword, now).
+			AddRow(int64(2), "ai", `\bLLM\b`, entity.TagMatchRegex, now))
+
+	repo := sqlite.NewTagRuleRepo(db)
+	got, err := repo.ListRules(context.Background())
+	if err != nil {
+		t.Fatalf("ListRules err=%v", err)
+	}
+	want := []*entity.TagRule{
+		{ID: 1, Tag: "go", Pattern: "golang", MatchType: entity.TagMatchKeyword, CreatedAt: now},
+		{ID: 2, Tag: "ai", Pattern: `\bLLM\b`, MatchType: entity.TagMatchRegex, CreatedAt: now},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestTagRuleRepo_CreateRule(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec("INSERT INTO tag_rules").
+		WithArgs("go", "golang", entity.TagMatchKeyword, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+
+	rule := &entity.TagRule{Tag: "go", Pattern: "golang", MatchType: entity.TagMatchKeyword}
+	repo := sqlite.NewTagRuleRepo(db)
+	if err := repo.CreateRule(context.Background(), rule); err != nil {
+		t.Fatalf("CreateRule err=%v", err)
+	}
+	if rule.ID != 5 || rule.CreatedAt.IsZero() {
+		t.Fatalf("ID/CreatedAt not set: %+v", rule)
+	}
+}
+
+func TestTagRuleRepo_DeleteRule(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec("DELETE FROM tag_rules").
+		WithArgs(int64(5)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM tag_rules").
+		WithArgs(int64(6)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := sqlite.NewTagRuleRepo(db)
+	deleted, err := repo.DeleteRule(context.Background(), 5)
+	if err != nil || !deleted {
+		t.Fatalf("DeleteRule(5) = %v, %v; want true, nil", deleted, err)
+	}
+	deleted, err = repo.DeleteRule(context.Background(), 6)
+	if err != nil || deleted {
+		t.Fatalf("DeleteRule(6) = %v, %v; want false, nil", deleted, err)
+	}
+}
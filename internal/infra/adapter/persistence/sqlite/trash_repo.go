@@ -0,0 +1,200 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+type TrashRepo struct {
+	db *sql.DB
+}
+
+func NewTrashRepo(db *sql.DB) repository.TrashRepository {
+	return &TrashRepo{db: db}
+}
+
+func (repo *TrashRepo) ListTrashedArticles(ctx context.Context, offset, limit int) ([]repository.TrashedArticle, error) {
+	const query = `
+SELECT ` + articleWithSourceColumns + `, a.deleted_at, s.deleted_at IS NOT NULL
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+WHERE a.deleted_at IS NOT NULL
+ORDER BY a.deleted_at DESC, a.id DESC
+LIMIT ? OFFSET ?`
+	rows, err := repo.db.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("ListTrashedArticles: QueryContext: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	result := make([]repository.TrashedArticle, 0, limit)
+	for rows.Next() {
+		var row articleRow
+		var t repository.TrashedArticle
+		if err := rows.Scan(row.dest(&t.SourceName, &t.DeletedAt, &t.SourceDeleted)...); err != nil {
+			return nil, fmt.Errorf("ListTrashedArticles: Scan: %w", err)
+		}
+		t.Article = row.toEntity()
+		result = append(result, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ListTrashedArticles: rows.Err: %w", err)
+	}
+	return result, nil
+}
+
+func (repo *TrashRepo) CountTrashedArticles(ctx context.Context) (int64, error) {
+	var count int64
+	if err := repo.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM articles WHERE deleted_at IS NOT NULL`).Scan(&count); err != nil {
+		return 0, fmt.Errorf("CountTrashedArticles: QueryRowContext: %w", err)
+	}
+	return count, nil
+}
+
+func (repo *TrashRepo) ListTrashedSources(ctx context.Context) ([]repository.TrashedSource, error) {
+	const query = `
+SELECT s.id, s.name, s.feed_url, s.source_type, s.last_crawled_at, s.active, COALESCE(s.prompt_template, ''), s.deleted_at,
+       (SELECT COUNT(*) FROM articles a WHERE a.source_id = s.id AND a.deleted_at = s.deleted_at)
+FROM sources s
+WHERE s.deleted_at IS NOT NULL
+ORDER BY s.deleted_at DESC, s.id DESC`
+	rows, err := repo.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("ListTrashedSources: QueryContext: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var result []repository.TrashedSource
+	for rows.Next() {
+		var t repository.TrashedSource
+		var source entity.Source
+		if err := rows.Scan(&source.ID, &source.Name, &source.FeedURL, &source.SourceType,
+			&source.LastCrawledAt, &source.Active, &source.PromptTemplate, &t.DeletedAt, &t.ArticleCount); err != nil {
+			return nil, fmt.Errorf("ListTrashedSources: Scan: %w", err)
+		}
+		t.Source = &source
+		result = append(result, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ListTrashedSources: rows.Err: %w", err)
+	}
+	return result, nil
+}
+
+func (repo *TrashRepo) RestoreArticle(ctx context.Context, id int64) (repository.RestoreResult, error) {
+	const selectTarget = `
+SELECT s.deleted_at IS NOT NULL
+FROM articles a
+INNER JOIN sources s ON a.source_id = s.id
+WHERE a.id = ? AND a.deleted_at IS NOT NULL`
+	const restore = `UPDATE articles SET deleted_at = NULL WHERE id = ?`
+
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return repository.RestoreNotFound, fmt.Errorf("RestoreArticle: BeginTx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var sourceDeleted bool
+	err = tx.QueryRowContext(ctx, selectTarget, id).Scan(&sourceDeleted)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.RestoreNotFound, nil
+	}
+	if err != nil {
+		return repository.RestoreNotFound, fmt.Errorf("RestoreArticle: QueryRowContext: %w", err)
+	}
+	// ソースがゴミ箱にある記事は戻さない（ソースの復元で戻す）
+	if sourceDeleted {
+		return repository.RestoreSourceDeleted, nil
+	}
+	if _, err := tx.ExecContext(ctx, restore, id); err != nil {
+		return repository.RestoreNotFound, fmt.Errorf("RestoreArticle: ExecContext: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return repository.RestoreNotFound, fmt.Errorf("RestoreArticle: Commit: %w", err)
+	}
+	return repository.Restored, nil
+}
+
+func (repo *TrashRepo) RestoreSource(ctx context.Context, id int64) (repository.RestoreResult, error) {
+	// ソースと同時に削除された記事（deleted_at が同じ記事）だけを戻す。
+	// ソースの deleted_at を参照するため、記事を先に戻す
+	const restoreArticles = `
+UPDATE articles SET deleted_at = NULL
+WHERE source_id = ?1
+  AND deleted_at = (SELECT deleted_at FROM sources WHERE id = ?1 AND deleted_at IS NOT NULL)`
+	const restoreSource = `UPDATE sources SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL`
+
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return repository.RestoreNotFound, fmt.Errorf("RestoreSource: BeginTx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, restoreArticles, id); err != nil {
+		return repository.RestoreNotFound, fmt.Errorf("RestoreSource: ExecContext: %w", err)
+	}
+	res, err := tx.ExecContext(ctx, restoreSource, id)
+	if err != nil {
+		return repository.RestoreNotFound, fmt.Errorf("RestoreSource: ExecContext: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return repository.RestoreNotFound, fmt.Errorf("RestoreSource: RowsAffected: %w", err)
+	}
+	if n == 0 {
+		return repository.RestoreNotFound, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return repository.RestoreNotFound, fmt.Errorf("RestoreSource: Commit: %w", err)
+	}
+	return repository.Restored, nil
+}
+
+func (repo *TrashRepo) Purge(ctx context.Context, before time.Time) (repository.PurgeResult, error) {
+	// 完全削除した記事の URL は article_tombstones に残し、クロールで再取得しない
+	const purgedArticles = `
+WHERE deleted_at < ?1
+   OR source_id IN (SELECT id FROM sources WHERE deleted_at < ?1)`
+	const insertTombstones = `
+INSERT INTO article_tombstones (url, purged_at)
+SELECT url, ?2 FROM articles` + purgedArticles + `
+ON CONFLICT (url) DO NOTHING`
+	const purgeArticles = `DELETE FROM articles` + purgedArticles
+	const purgeSources = `DELETE FROM sources WHERE deleted_at < ?`
+
+	var result repository.PurgeResult
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return result, fmt.Errorf("Purge: BeginTx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, insertTombstones, before, time.Now().UTC()); err != nil {
+		return repository.PurgeResult{}, fmt.Errorf("Purge: tombstones: %w", err)
+	}
+	res, err := tx.ExecContext(ctx, purgeArticles, before)
+	if err != nil {
+		return repository.PurgeResult{}, fmt.Errorf("Purge: articles: %w", err)
+	}
+	if result.Articles, err = res.RowsAffected(); err != nil {
+		return repository.PurgeResult{}, fmt.Errorf("Purge: RowsAffected: %w", err)
+	}
+	res, err = tx.ExecContext(ctx, purgeSources, before)
+	if err != nil {
+		return repository.PurgeResult{}, fmt.Errorf("Purge: sources: %w", err)
+	}
+	if result.Sources, err = res.RowsAffected(); err != nil {
+		return repository.PurgeResult{}, fmt.Errorf("Purge: RowsAffected: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return repository.PurgeResult{}, fmt.Errorf("Purge: Commit: %w", err)
+	}
+	return result, nil
+}
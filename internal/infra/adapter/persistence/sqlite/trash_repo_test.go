@@ -0,0 +1,178 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"catchup-feed/internal/infra/adapter/persistence/sqlite"
+	"catchup-feed/internal/repository"
+)
+
+func TestTrashRepo_ListTrashedArticles(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE a.deleted_at IS NOT NULL
+ORDER BY a.deleted_at DESC, a.id DESC
+LIMIT ? OFFSET ?`)).
+		WithArgs(20, 40).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "source_id", "title", "url", "summary", "published_at", "created_at", "summary_structured",
+			"prompt_version", "summary_status", "summary_batch_id", "summary_model", "injection_flags", "source_name",
+			"deleted_at", "source_deleted",
+		}).AddRow(int64(7), int64(3), "Go 1.25", "https://go.dev/blog/go1.25", "summary", now, now, nil, "", "", "", "", "", "Go Blog", now, false))
+
+	got, err := sqlite.NewTrashRepo(db).ListTrashedArticles(context.Background(), 40, 20)
+	if err != nil {
+		t.Fatalf("ListTrashedArticles err=%v", err)
+	}
+	if len(got) != 1 || got[0].Article.ID != 7 || got[0].SourceName != "Go Blog" || !got[0].DeletedAt.Equal(now) || got[0].SourceDeleted {
+		t.Errorf("ListTrashedArticles = %+v", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTrashRepo_RestoreArticle(t *testing.T) {
+	const selectTarget = `WHERE a.id = ? AND a.deleted_at IS NOT NULL`
+
+	t.Run("restored", func(t *testing.T) {
+		db, mock, _ := sqlmock.New()
+		defer func() { _ = db.Close() }()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(selectTarget)).
+			WithArgs(int64(7)).
+			WillReturnRows(sqlmock.NewRows([]string{"source_deleted"}).AddRow(false))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE articles SET deleted_at = NULL WHERE id = ?`)).
+			WithArgs(int64(7)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		got, err := sqlite.NewTrashRepo(db).RestoreArticle(context.Background(), 7)
+		if err != nil || got != repository.Restored {
+			t.Fatalf("RestoreArticle = %v, %v; want Restored", got, err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("source in trash", func(t *testing.T) {
+		db, mock, _ := sqlmock.New()
+		defer func() { _ = db.Close() }()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(selectTarget)).
+			WithArgs(int64(7)).
+			WillReturnRows(sqlmock.NewRows([]string{"source_deleted"}).AddRow(true))
+		mock.ExpectRollback()
+
+		got, err := sqlite.NewTrashRepo(db).RestoreArticle(context.Background(), 7)
+		if err != nil || got != repository.RestoreSourceDeleted {
+			t.Fatalf("RestoreArticle = %v, %v; want RestoreSourceDeleted", got, err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("not in trash", func(t *testing.T) {
+		db, mock, _ := sqlmock.New()
+		defer func() { _ = db.Close() }()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(selectTarget)).
+			WithArgs(int64(7)).
+			WillReturnRows(sqlmock.NewRows([]string{"source_deleted"}))
+		mock.ExpectRollback()
+
+		got, err := sqlite.NewTrashRepo(db).RestoreArticle(context.Background(), 7)
+		if err != nil || got != repository.RestoreNotFound {
+			t.Fatalf("RestoreArticle = %v, %v; want RestoreNotFound", got, err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestTrashRepo_RestoreSource(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	// ソースと同時に削除された記事を先に戻す
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`AND deleted_at = (SELECT deleted_at FROM sources WHERE id = ?1 AND deleted_at IS NOT NULL)`)).
+		WithArgs(int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 12))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE sources SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL`)).
+		WithArgs(int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	got, err := sqlite.NewTrashRepo(db).RestoreSource(context.Background(), 3)
+	if err != nil || got != repository.Restored {
+		t.Fatalf("RestoreSource = %v, %v; want Restored", got, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTrashRepo_Purge(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	before := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO article_tombstones (url, purged_at)`)).
+		WithArgs(before, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 5))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM articles
+WHERE deleted_at < ?1
+   OR source_id IN (SELECT id FROM sources WHERE deleted_at < ?1)`)).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 5))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM sources WHERE deleted_at < ?`)).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	got, err := sqlite.NewTrashRepo(db).Purge(context.Background(), before)
+	if err != nil {
+		t.Fatalf("Purge err=%v", err)
+	}
+	if got != (repository.PurgeResult{Articles: 5, Sources: 1}) {
+		t.Errorf("Purge = %+v, want 5 articles and 1 source", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTrashRepo_Purge_RollsBackOnError(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer func() { _ = db.Close() }()
+
+	before := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO article_tombstones`).
+		WithArgs(before, sqlmock.AnyArg()).
+		WillReturnError(errors.New("disk full"))
+	mock.ExpectRollback()
+
+	if _, err := sqlite.NewTrashRepo(db).Purge(context.Background(), before); err == nil {
+		t.Fatal("Purge should return error")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -8,6 +8,187 @@ import (
 //go:embed seeds/sources.sql
 var seedSourcesSQL string
 
+// schemaUpgrades は初期スキーマ作成後に適用するスキーマ変更（列追加・テーブル追加など）
+// 記述順に実行される。再実行されても安全なよう、すべて冪等（IF NOT EXISTS）であること
+var schemaUpgrades = []string{
+	// 構造化要約（TL;DR・キーポイント・タグ・読了時間）
+	`ALTER TABLE articles ADD COLUMN IF NOT EXISTS summary_structured JSONB`,
+	// 要約プロンプトテンプレート（ソースごとの選択と、要約を生成したテンプレートのバージョン）
+	`ALTER TABLE sources ADD COLUMN IF NOT EXISTS prompt_template TEXT NOT NULL DEFAULT ''`,
+	`ALTER TABLE articles ADD COLUMN IF NOT EXISTS prompt_version TEXT NOT NULL DEFAULT ''`,
+	// バッチ要約（要約待ちの記事と、その要約を生成するバッチID）
+	`ALTER TABLE articles ADD COLUMN IF NOT EXISTS summary_status TEXT NOT NULL DEFAULT ''`,
+	`ALTER TABLE articles ADD COLUMN IF NOT EXISTS summary_batch_id TEXT NOT NULL DEFAULT ''`,
+	`CREATE INDEX IF NOT EXISTS idx_articles_summary_pending ON articles (summary_batch_id) WHERE summary_status <> ''`,
+	// 再要約（要約を生成したモデル、再要約に使う本文、再要約ジョブ）
+	`ALTER TABLE articles ADD COLUMN IF NOT EXISTS summary_model TEXT NOT NULL DEFAULT ''`,
+	`CREATE TABLE IF NOT EXISTS article_contents (
+    article_id INTEGER PRIMARY KEY REFERENCES articles(id) ON DELETE CASCADE,
+    content    TEXT NOT NULL,
+    fetched_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`,
+	`CREATE TABLE IF NOT EXISTS resummarize_jobs (
+    id              SERIAL PRIMARY KEY,
+    status          TEXT NOT NULL,
+    article_id      INTEGER,
+    source_id       INTEGER,
+    published_from  TIMESTAMPTZ,
+    published_to    TIMESTAMPTZ,
+    summary_model   TEXT NOT NULL DEFAULT '',
+    total           INTEGER NOT NULL DEFAULT 0,
+    processed       INTEGER NOT NULL DEFAULT 0,
+    succeeded       INTEGER NOT NULL DEFAULT 0,
+    failed          INTEGER NOT NULL DEFAULT 0,
+    last_article_id INTEGER NOT NULL DEFAULT 0,
+    error           TEXT NOT NULL DEFAULT '',
+    requested_by    TEXT NOT NULL DEFAULT '',
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    started_at      TIMESTAMPTZ,
+    finished_at     TIMESTAMPTZ
+)`,
+	`CREATE INDEX IF NOT EXISTS idx_resummarize_jobs_active ON resummarize_jobs (id) WHERE status IN ('queued', 'running')`,
+	// トピックタグ（タグ、記事とタグの対応、自動タグ付けルール）
+	`CREATE TABLE IF NOT EXISTS tags (
+    id         SERIAL PRIMARY KEY,
+    name       TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`,
+	`CREATE TABLE IF NOT EXISTS article_tags (
+    article_id INTEGER NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    tag_id     INTEGER NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    origin     TEXT NOT NULL,
+    PRIMARY KEY (article_id, tag_id)
+)`,
+	`CREATE INDEX IF NOT EXISTS idx_article_tags_tag_id ON article_tags (tag_id)`,
+	`CREATE TABLE IF NOT EXISTS tag_rules (
+    id         SERIAL PRIMARY KEY,
+    tag        TEXT NOT NULL,
+    pattern    TEXT NOT NULL,
+    match_type TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`,
+	// 意味検索・関連記事（記事の埋め込みベクトル、float32リトルエンディアン）
+	`CREATE TABLE IF NOT EXISTS article_embeddings (
+    article_id INTEGER PRIMARY KEY REFERENCES articles(id) ON DELETE CASCADE,
+    model      TEXT NOT NULL,
+    vector     BYTEA NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`,
+	`CREATE INDEX IF NOT EXISTS idx_article_embeddings_model ON article_embeddings (model)`,
+	// ダイジェスト（期間内の新着記事をグループ化したスナップショット）
+	`CREATE TABLE IF NOT EXISTS digests (
+    id            SERIAL PRIMARY KEY,
+    period        TEXT NOT NULL,
+    group_by      TEXT NOT NULL,
+    title         TEXT NOT NULL,
+    overview      TEXT NOT NULL DEFAULT '',
+    period_start  TIMESTAMPTZ NOT NULL,
+    period_end    TIMESTAMPTZ NOT NULL,
+    article_count INTEGER NOT NULL,
+    groups        JSONB NOT NULL,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT now()
+)`,
+	`CREATE INDEX IF NOT EXISTS idx_digests_period_end ON digests (period, period_end DESC)`,
+	`CREATE INDEX IF NOT EXISTS idx_articles_created_at ON articles (created_at)`,
+	// プロンプトインジェクション検知（要約時に検知したパターンのカンマ区切りリスト）
+	`ALTER TABLE articles ADD COLUMN IF NOT EXISTS injection_flags TEXT NOT NULL DEFAULT ''`,
+	// キーセットページネーション（ORDER BY published_at DESC, id DESC と行値比較で使用）
+	`CREATE INDEX IF NOT EXISTS idx_articles_published_at_id ON articles (published_at DESC, id DESC)`,
+	// 既読管理（ユーザー = JWT の sub ごとの既読記事）
+	`CREATE TABLE IF NOT EXISTS article_reads (
+    user_id    TEXT NOT NULL,
+    article_id INTEGER NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    read_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, article_id)
+)`,
+	`CREATE INDEX IF NOT EXISTS idx_article_reads_article_id ON article_reads (article_id)`,
+	// ブックマークとリーディングリスト（ユーザー = JWT の sub ごと）
+	`CREATE TABLE IF NOT EXISTS bookmarks (
+    user_id    TEXT NOT NULL,
+    article_id INTEGER NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, article_id)
+)`,
+	`CREATE INDEX IF NOT EXISTS idx_bookmarks_article_id ON bookmarks (article_id)`,
+	`CREATE TABLE IF NOT EXISTS reading_lists (
+    id          SERIAL PRIMARY KEY,
+    user_id     TEXT NOT NULL,
+    name        TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    share_token TEXT UNIQUE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+)`,
+	`CREATE INDEX IF NOT EXISTS idx_reading_lists_user_id ON reading_lists (user_id)`,
+	`CREATE TABLE IF NOT EXISTS reading_list_items (
+    list_id    INTEGER NOT NULL REFERENCES reading_lists(id) ON DELETE CASCADE,
+    article_id INTEGER NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    position   INTEGER NOT NULL,
+    note       TEXT NOT NULL DEFAULT '',
+    added_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (list_id, article_id)
+)`,
+	`CREATE INDEX IF NOT EXISTS idx_reading_list_items_article_id ON reading_list_items (article_id)`,
+	// 保存した検索（ユーザー = JWT の sub ごと）。last_article_id までの記事は通知の判定済み
+	`CREATE TABLE IF NOT EXISTS saved_searches (
+    id              SERIAL PRIMARY KEY,
+    user_id         TEXT NOT NULL,
+    name            TEXT NOT NULL,
+    keyword         TEXT NOT NULL DEFAULT '',
+    source_id       INTEGER REFERENCES sources(id) ON DELETE CASCADE,
+    published_from  TIMESTAMPTZ,
+    published_to    TIMESTAMPTZ,
+    tags            JSONB NOT NULL DEFAULT '[]',
+    subscribed      BOOLEAN NOT NULL DEFAULT FALSE,
+    channel         TEXT NOT NULL DEFAULT '',
+    last_article_id INTEGER NOT NULL DEFAULT 0,
+    last_alerted_at TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT now()
+)`,
+	`CREATE INDEX IF NOT EXISTS idx_saved_searches_user_id ON saved_searches (user_id)`,
+	`CREATE INDEX IF NOT EXISTS idx_saved_searches_subscribed ON saved_searches (id) WHERE subscribed`,
+	// 配信フィードのトークン（ユーザー = JWT の sub ごとに1つ）
+	`CREATE TABLE IF NOT EXISTS feed_tokens (
+    user_id    TEXT PRIMARY KEY,
+    token      TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`,
+	// ゴミ箱（論理削除）。deleted_at が NULL でない記事・ソースは一覧・検索に出さない
+	`ALTER TABLE articles ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ`,
+	`ALTER TABLE sources ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ`,
+	`CREATE INDEX IF NOT EXISTS idx_articles_deleted_at ON articles (deleted_at) WHERE deleted_at IS NOT NULL`,
+	`CREATE INDEX IF NOT EXISTS idx_sources_deleted_at ON sources (deleted_at) WHERE deleted_at IS NOT NULL`,
+	// 完全削除した記事の URL（クロールで再取得しないため）
+	`CREATE TABLE IF NOT EXISTS article_tombstones (
+    url       TEXT PRIMARY KEY,
+    purged_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`,
+	// 要約に失敗した記事（ソースごとの要約エラー率の集計用。30日で削除）
+	`CREATE TABLE IF NOT EXISTS summarize_failures (
+    id        SERIAL PRIMARY KEY,
+    source_id INTEGER NOT NULL REFERENCES sources(id) ON DELETE CASCADE,
+    url       TEXT NOT NULL,
+    failed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`,
+	`CREATE INDEX IF NOT EXISTS idx_summarize_failures_source_failed_at ON summarize_failures (source_id, failed_at)`,
+	// ソースのカテゴリ（"Go", "Security" など）と、ソースへの割り当て（多対多）
+	`CREATE TABLE IF NOT EXISTS source_categories (
+    id         SERIAL PRIMARY KEY,
+    name       TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`,
+	`CREATE TABLE IF NOT EXISTS source_category_members (
+    source_id   INTEGER NOT NULL REFERENCES sources(id) ON DELETE CASCADE,
+    category_id INTEGER NOT NULL REFERENCES source_categories(id) ON DELETE CASCADE,
+    PRIMARY KEY (source_id, category_id)
+)`,
+	// カテゴリでの絞り込み用（主キーは source_id 先頭のため別途作成）
+	`CREATE INDEX IF NOT EXISTS idx_source_category_members_category_id ON source_category_members (category_id)`,
+	// ダイジェスト生成時に要約待ちだった記事（次のダイジェストに含める）
+	`ALTER TABLE digests ADD COLUMN IF NOT EXISTS pending_article_ids JSONB NOT NULL DEFAULT '[]'`,
+}
+
 func MigrateUp(db *sql.DB) error {
 	if _, err := db.Exec(`
 CREATE TABLE IF NOT EXISTS sources (
@@ -71,6 +252,12 @@ CREATE TABLE IF NOT EXISTS articles (
 		}
 	}
 
+	for _, stmt := range schemaUpgrades {
+		if _, err := db.Exec(stmt); err != nil {
+			return err
+		}
+	}
+
 	// Web Scraper対応: source_type制約追加
 	// PostgreSQL特有の制約構文のため、エラーを無視（既に存在する場合）
 	_, _ = db.Exec(`
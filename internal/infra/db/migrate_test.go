@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"regexp"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -9,6 +10,14 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// expectSchemaUpgrades registers expectations for every statement in schemaUpgrades.
+func expectSchemaUpgrades(mock sqlmock.Sqlmock) {
+	for _, stmt := range schemaUpgrades {
+		mock.ExpectExec(regexp.QuoteMeta(stmt)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+}
+
 func TestMigrateUp_Success(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -31,6 +40,7 @@ func TestMigrateUp_Success(t *testing.T) {
 		WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectExec("CREATE INDEX IF NOT EXISTS idx_sources_source_type").
 		WillReturnResult(sqlmock.NewResult(0, 0))
+	expectSchemaUpgrades(mock)
 
 	// Expect seed data insertion
 	mock.ExpectExec("INSERT INTO sources").
@@ -132,6 +142,7 @@ func TestMigrateUp_SeedDataError(t *testing.T) {
 		WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectExec("CREATE INDEX IF NOT EXISTS idx_sources_source_type").
 		WillReturnResult(sqlmock.NewResult(0, 0))
+	expectSchemaUpgrades(mock)
 
 	// Expect seed data insertion to fail
 	mock.ExpectExec("INSERT INTO sources").
@@ -165,6 +176,7 @@ func TestMigrateUp_Idempotent(t *testing.T) {
 		WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectExec("CREATE INDEX IF NOT EXISTS idx_sources_source_type").
 		WillReturnResult(sqlmock.NewResult(0, 0))
+	expectSchemaUpgrades(mock)
 	mock.ExpectExec("INSERT INTO sources").
 		WillReturnResult(sqlmock.NewResult(0, 5))
 
@@ -176,6 +188,41 @@ func TestMigrateUp_Idempotent(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestMigrateUp_SchemaUpgradeError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS sources").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS articles").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE INDEX IF NOT EXISTS idx_articles_published_at").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE INDEX IF NOT EXISTS idx_articles_source_id").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE INDEX IF NOT EXISTS idx_sources_active").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE INDEX IF NOT EXISTS idx_sources_source_type").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Expect first schema upgrade to fail
+	mock.ExpectExec(regexp.QuoteMeta(schemaUpgrades[0])).
+		WillReturnError(sql.ErrConnDone)
+
+	err = MigrateUp(db)
+	assert.Error(t, err)
+	assert.Equal(t, sql.ErrConnDone, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSchemaUpgrades_Idempotent(t *testing.T) {
+	// All upgrade statements must be safe to re-run on every startup
+	for _, stmt := range schemaUpgrades {
+		assert.Contains(t, stmt, "IF NOT EXISTS", "schema upgrade must be idempotent: %s", stmt)
+	}
+}
+
 func TestSeedSourcesSQL_Embedded(t *testing.T) {
 	// Verify that seedSourcesSQL is embedded and not empty
 	assert.NotEmpty(t, seedSourcesSQL)
@@ -0,0 +1,124 @@
+// Package embedder provides implementations of embedding.Embedder: a client for
+// OpenAI-compatible embedding endpoints and a local hashing embedder that needs
+// no external service.
+package embedder
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"catchup-feed/internal/usecase/embedding"
+)
+
+// Embedding providers selectable with EMBEDDING_PROVIDER.
+const (
+	// ProviderOpenAI uses an OpenAI-compatible /embeddings endpoint.
+	ProviderOpenAI = "openai"
+	// ProviderLocal uses the in-process hashing embedder (no external service).
+	ProviderLocal = "local"
+)
+
+const (
+	// DefaultOpenAIModel is the embedding model used when EMBEDDING_MODEL is not set.
+	DefaultOpenAIModel = "text-embedding-3-small"
+	// DefaultLocalDimensions is the vector size of the local embedder.
+	DefaultLocalDimensions = 256
+	// DefaultTimeout is the timeout of one embedding request.
+	DefaultTimeout = 30 * time.Second
+)
+
+// Config holds the embedding configuration loaded from environment variables.
+type Config struct {
+	// Provider is ProviderOpenAI, ProviderLocal, or "" to disable embeddings.
+	Provider string
+	// APIKey is the API key of the OpenAI-compatible endpoint.
+	APIKey string
+	// BaseURL overrides the OpenAI API URL (e.g. a self-hosted compatible server).
+	BaseURL string
+	// Model is the embedding model name sent to the endpoint.
+	Model string
+	// Dimensions is the vector size: required for the local embedder, optional
+	// (0 = model default) for OpenAI-compatible endpoints.
+	Dimensions int
+	// Timeout is the timeout of one embedding request.
+	Timeout time.Duration
+}
+
+// LoadConfigFromEnv loads the embedding configuration from environment variables:
+//   - EMBEDDING_PROVIDER: "openai", "local" or empty (disabled)
+//   - EMBEDDING_API_KEY: API key (falls back to OPENAI_API_KEY)
+//   - EMBEDDING_BASE_URL: OpenAI-compatible API base URL (optional)
+//   - EMBEDDING_MODEL: model name (default: text-embedding-3-small)
+//   - EMBEDDING_DIMENSIONS: vector size (default: 256 for local, model default for openai)
+//
+// Invalid numeric values are reported by Validate.
+func LoadConfigFromEnv() (Config, error) {
+	cfg := Config{
+		Provider: strings.ToLower(strings.TrimSpace(os.Getenv("EMBEDDING_PROVIDER"))),
+		APIKey:   os.Getenv("EMBEDDING_API_KEY"),
+		BaseURL:  os.Getenv("EMBEDDING_BASE_URL"),
+		Model:    os.Getenv("EMBEDDING_MODEL"),
+		Timeout:  DefaultTimeout,
+	}
+	if cfg.APIKey == "" {
+		cfg.APIKey = os.Getenv("OPENAI_API_KEY")
+	}
+	if cfg.Model == "" && cfg.Provider == ProviderOpenAI {
+		cfg.Model = DefaultOpenAIModel
+	}
+	if v := os.Getenv("EMBEDDING_DIMENSIONS"); v != "" {
+		dims, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("EMBEDDING_DIMENSIONS must be an integer, got %q", v)
+		}
+		cfg.Dimensions = dims
+	} else if cfg.Provider == ProviderLocal {
+		cfg.Dimensions = DefaultLocalDimensions
+	}
+	return cfg, cfg.Validate()
+}
+
+// Enabled reports whether an embedding provider is configured.
+func (c Config) Enabled() bool {
+	return c.Provider != ""
+}
+
+// Validate checks that the provider is known and its settings are usable.
+func (c Config) Validate() error {
+	switch c.Provider {
+	case "":
+		return nil
+	case ProviderOpenAI:
+		if c.APIKey == "" {
+			return fmt.Errorf("EMBEDDING_API_KEY or OPENAI_API_KEY is required when EMBEDDING_PROVIDER=openai")
+		}
+		if c.Dimensions < 0 || c.Dimensions > 4096 {
+			return fmt.Errorf("embedding dimensions must be between 0 and 4096, got %d", c.Dimensions)
+		}
+	case ProviderLocal:
+		if c.Dimensions < 16 || c.Dimensions > 4096 {
+			return fmt.Errorf("embedding dimensions must be between 16 and 4096, got %d", c.Dimensions)
+		}
+	default:
+		return fmt.Errorf("unknown EMBEDDING_PROVIDER %q (expected openai or local)", c.Provider)
+	}
+	return nil
+}
+
+// New creates the embedder selected by cfg. It returns nil when embeddings are disabled.
+func New(cfg Config) (embedding.Embedder, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	switch cfg.Provider {
+	case ProviderOpenAI:
+		return NewOpenAI(cfg), nil
+	case ProviderLocal:
+		return NewLocal(cfg.Dimensions), nil
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,194 @@
+package embedder_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"catchup-feed/internal/infra/embedder"
+	"catchup-feed/internal/pkg/vector"
+)
+
+func TestLoadConfigFromEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		want     embedder.Config
+		wantErr  bool
+		disabled bool
+	}{
+		{name: "disabled by default", env: map[string]string{}, disabled: true},
+		{
+			name: "openai with fallback key",
+			env:  map[string]string{"EMBEDDING_PROVIDER": "openai", "OPENAI_API_KEY": "sk-test"},
+			want: embedder.Config{Provider: "openai", APIKey: "sk-test", Model: embedder.DefaultOpenAIModel, Timeout: embedder.DefaultTimeout},
+		},
+		{
+			name: "openai compatible endpoint",
+			env: map[string]string{
+				"EMBEDDING_PROVIDER": "OpenAI", "EMBEDDING_API_KEY": "key", "EMBEDDING_BASE_URL": "http://localhost:11434/v1",
+				"EMBEDDING_MODEL": "nomic-embed-text", "EMBEDDING_DIMENSIONS": "768",
+			},
+			want: embedder.Config{
+				Provider: "openai", APIKey: "key", BaseURL: "http://localhost:11434/v1",
+				Model: "nomic-embed-text", Dimensions: 768, Timeout: embedder.DefaultTimeout,
+			},
+		},
+		{
+			name: "local with default dimensions",
+			env:  map[string]string{"EMBEDDING_PROVIDER": "local"},
+			want: embedder.Config{Provider: "local", Dimensions: embedder.DefaultLocalDimensions, Timeout: embedder.DefaultTimeout},
+		},
+		{name: "openai without key", env: map[string]string{"EMBEDDING_PROVIDER": "openai"}, wantErr: true},
+		{name: "unknown provider", env: map[string]string{"EMBEDDING_PROVIDER": "bert"}, wantErr: true},
+		{name: "invalid dimensions", env: map[string]string{"EMBEDDING_PROVIDER": "local", "EMBEDDING_DIMENSIONS": "abc"}, wantErr: true},
+		{name: "local dimensions too small", env: map[string]string{"EMBEDDING_PROVIDER": "local", "EMBEDDING_DIMENSIONS": "4"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, k := range []string{"EMBEDDING_PROVIDER", "EMBEDDING_API_KEY", "OPENAI_API_KEY", "EMBEDDING_BASE_URL", "EMBEDDING_MODEL", "EMBEDDING_DIMENSIONS"} {
+				t.Setenv(k, tt.env[k])
+			}
+
+			got, err := embedder.LoadConfigFromEnv()
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("LoadConfigFromEnv() error = nil, want error (config %+v)", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadConfigFromEnv() error = %v", err)
+			}
+			if tt.disabled {
+				if got.Enabled() {
+					t.Errorf("config %+v must be disabled", got)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("config = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	emb, err := embedder.New(embedder.Config{})
+	if err != nil || emb != nil {
+		t.Errorf("New(disabled) = %v, %v; want nil, nil", emb, err)
+	}
+
+	emb, err = embedder.New(embedder.Config{Provider: embedder.ProviderLocal, Dimensions: 64})
+	if err != nil || emb == nil || emb.Model() != "local-hash-64" {
+		t.Errorf("New(local) = %v, %v", emb, err)
+	}
+
+	emb, err = embedder.New(embedder.Config{Provider: embedder.ProviderOpenAI, APIKey: "k", Model: "m", Dimensions: 512})
+	if err != nil || emb == nil || emb.Model() != "m@512" {
+		t.Errorf("New(openai) = %v, %v", emb, err)
+	}
+
+	if _, err := embedder.New(embedder.Config{Provider: "bert"}); err == nil {
+		t.Error("New(unknown provider) error = nil, want error")
+	}
+}
+
+func TestLocal_Embed(t *testing.T) {
+	l := embedder.NewLocal(256)
+
+	vecs, err := l.Embed(context.Background(), []string{
+		"Go 1.25 リリース: ジェネリクスの改善",
+		"go 1.25 released with generics improvements",
+		"ジェネリクスの改善がリリースされました",
+		"料理のレシピ",
+	})
+	if err != nil {
+		t.Fatalf("Embed() error = %v", err)
+	}
+	if len(vecs) != 4 || len(vecs[0]) != 256 {
+		t.Fatalf("got %d vectors of size %d", len(vecs), len(vecs[0]))
+	}
+
+	// 共通の語・文字bigramを持つ文は、持たない文より類似度が高い
+	related := vector.Cosine(vecs[0], vecs[2])
+	unrelated := vector.Cosine(vecs[0], vecs[3])
+	if related <= unrelated {
+		t.Errorf("cosine(related) = %v, cosine(unrelated) = %v", related, unrelated)
+	}
+	if vector.Cosine(vecs[0], vecs[1]) <= unrelated {
+		t.Errorf("shared latin words must increase similarity")
+	}
+
+	// 同じ入力には同じベクトルを返す
+	again, _ := l.Embed(context.Background(), []string{"Go 1.25 リリース: ジェネリクスの改善"})
+	if vector.Cosine(vecs[0], again[0]) < 0.9999 {
+		t.Error("Embed() must be deterministic")
+	}
+}
+
+func TestOpenAI_Embed(t *testing.T) {
+	var gotReq struct {
+		Input      []string `json:"input"`
+		Model      string   `json:"model"`
+		Dimensions int      `json:"dimensions"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/embeddings") || r.Header.Get("Authorization") != "Bearer key" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&gotReq)
+		w.Header().Set("Content-Type", "application/json")
+		// 入力と逆順で返し、indexで並べ直されることを確認する
+		_, _ = fmt.Fprint(w, `{"object":"list","model":"m","data":[
+			{"object":"embedding","index":1,"embedding":[0,1]},
+			{"object":"embedding","index":0,"embedding":[1,0]}]}`)
+	}))
+	defer server.Close()
+
+	o := embedder.NewOpenAI(embedder.Config{APIKey: "key", BaseURL: server.URL + "/v1", Model: "m", Dimensions: 2})
+	vecs, err := o.Embed(context.Background(), []string{"first", "second"})
+	if err != nil {
+		t.Fatalf("Embed() error = %v", err)
+	}
+	if len(vecs) != 2 || vecs[0][0] != 1 || vecs[1][1] != 1 {
+		t.Errorf("vectors = %v", vecs)
+	}
+	if gotReq.Model != "m" || gotReq.Dimensions != 2 || len(gotReq.Input) != 2 {
+		t.Errorf("request = %+v", gotReq)
+	}
+}
+
+func TestOpenAI_Embed_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{name: "api error", status: http.StatusUnauthorized, body: `{"error":{"message":"bad key","type":"invalid_request_error"}}`},
+		{name: "missing vectors", status: http.StatusOK, body: `{"data":[{"index":0,"embedding":[1]}]}`},
+		{name: "duplicate index", status: http.StatusOK, body: `{"data":[{"index":0,"embedding":[1]},{"index":0,"embedding":[2]}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = fmt.Fprint(w, tt.body)
+			}))
+			defer server.Close()
+
+			o := embedder.NewOpenAI(embedder.Config{APIKey: "key", BaseURL: server.URL, Model: "m"})
+			if _, err := o.Embed(context.Background(), []string{"a", "b"}); err == nil {
+				t.Error("Embed() error = nil, want error")
+			}
+		})
+	}
+}
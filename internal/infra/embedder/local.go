@@ -0,0 +1,99 @@
+package embedder
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"unicode"
+)
+
+// Local is a dependency-free embedder based on feature hashing. Each token is
+// hashed into one of the vector dimensions, so texts sharing words get similar
+// vectors. It does not capture meaning like a trained model and is intended for
+// development, tests and deployments without access to an embedding API.
+//
+// Latin text is split into words; Japanese and other scripts written without
+// spaces are split into overlapping character bigrams.
+type Local struct {
+	dimensions int
+}
+
+// NewLocal creates a local embedder producing vectors of the given size.
+func NewLocal(dimensions int) *Local {
+	return &Local{dimensions: dimensions}
+}
+
+// Model identifies the hashing scheme and vector size.
+func (l *Local) Model() string {
+	return fmt.Sprintf("local-hash-%d", l.dimensions)
+}
+
+// Embed returns the hashed token vector of each text.
+func (l *Local) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	vecs := make([][]float32, len(texts))
+	for i, text := range texts {
+		vec := make([]float32, l.dimensions)
+		for _, tok := range tokenize(text) {
+			h := fnv.New32a()
+			_, _ = h.Write([]byte(tok))
+			sum := h.Sum32()
+			// 上位ビットで符号を決め、ハッシュ衝突による偏りを打ち消す
+			sign := float32(1)
+			if sum&(1<<31) != 0 {
+				sign = -1
+			}
+			vec[int(sum%uint32(l.dimensions))] += sign
+		}
+		vecs[i] = vec
+	}
+	return vecs, nil
+}
+
+// tokenize splits text into lower-cased words, and runs of characters from
+// scripts without word separators (CJK) into character bigrams.
+func tokenize(text string) []string {
+	var tokens []string
+	var word []rune
+	var cjk []rune
+
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, string(word))
+			word = word[:0]
+		}
+	}
+	flushCJK := func() {
+		switch {
+		case len(cjk) == 1:
+			tokens = append(tokens, string(cjk))
+		case len(cjk) > 1:
+			for i := 0; i+1 < len(cjk); i++ {
+				tokens = append(tokens, string(cjk[i:i+2]))
+			}
+		}
+		cjk = cjk[:0]
+	}
+
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+	return tokens
+}
+
+// isCJK reports whether r belongs to a script written without spaces between words.
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) || r == 'ー'
+}
@@ -0,0 +1,75 @@
+package embedder
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// OpenAI computes embeddings with an OpenAI-compatible /embeddings endpoint.
+type OpenAI struct {
+	client     *openai.Client
+	model      string
+	dimensions int
+	timeout    time.Duration
+}
+
+// NewOpenAI creates an embedder for the endpoint, model and dimensions of cfg.
+func NewOpenAI(cfg Config) *OpenAI {
+	clientCfg := openai.DefaultConfig(cfg.APIKey)
+	if cfg.BaseURL != "" {
+		clientCfg.BaseURL = cfg.BaseURL
+	}
+	return &OpenAI{
+		client:     openai.NewClientWithConfig(clientCfg),
+		model:      cfg.Model,
+		dimensions: cfg.Dimensions,
+		timeout:    cfg.Timeout,
+	}
+}
+
+// Model returns the embedding model name, suffixed with the dimensions when they
+// are set, since vectors of different sizes are not comparable.
+func (o *OpenAI) Model() string {
+	if o.dimensions > 0 {
+		return fmt.Sprintf("%s@%d", o.model, o.dimensions)
+	}
+	return o.model
+}
+
+// Embed returns the embeddings of texts in one request.
+func (o *OpenAI) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+
+	if o.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.timeout)
+		defer cancel()
+	}
+
+	resp, err := o.client.CreateEmbeddings(ctx, openai.EmbeddingRequest{
+		Input:      texts,
+		Model:      openai.EmbeddingModel(o.model),
+		Dimensions: o.dimensions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("embeddings api error: %w", err)
+	}
+	if len(resp.Data) != len(texts) {
+		return nil, fmt.Errorf("embeddings api returned %d vectors for %d inputs", len(resp.Data), len(texts))
+	}
+
+	// レスポンスの順序は保証されないためindexで並べる
+	vecs := make([][]float32, len(texts))
+	for _, d := range resp.Data {
+		if d.Index < 0 || d.Index >= len(texts) || vecs[d.Index] != nil {
+			return nil, fmt.Errorf("embeddings api returned invalid index %d", d.Index)
+		}
+		vecs[d.Index] = d.Embedding
+	}
+	return vecs, nil
+}
@@ -1,8 +1,11 @@
 package notifier
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"strings"
 	"time"
 )
 
@@ -95,3 +98,82 @@ func truncateSummary(text string, maxLength int, suffix string) string {
 
 	return text[:truncateAt] + suffix
 }
+
+// sendWithRetry calls send with the retry strategy shared by the webhook notifiers.
+//
+// Retry strategy:
+//   - Max attempts: 2
+//   - Base delay: 5 seconds
+//   - 429 errors: Sleep for the retry_after duration of the response
+//   - Server errors (5xx) and network errors: Linear backoff (5s, 10s)
+//   - Client errors (4xx): No retry, fail immediately
+//
+// service names the webhook service in log messages ("Discord", "Slack");
+// attrs identify the notification and are added to every log entry
+// together with the request_id from ctx.
+func sendWithRetry(ctx context.Context, service string, send func() error, attrs ...any) error {
+	const (
+		maxAttempts = 2
+		baseDelay   = 5 * time.Second
+	)
+
+	requestID, _ := ctx.Value(requestIDKey).(string)
+	logArgs := func(extra ...any) []any {
+		args := append([]any{slog.String("request_id", requestID)}, attrs...)
+		return append(args, extra...)
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		err := send()
+
+		// Success
+		if err == nil {
+			slog.Info(service+" notification successful", logArgs(slog.Int("attempt", attempt))...)
+			return nil
+		}
+
+		lastErr = err
+
+		// Handle rate limit error (429)
+		if rateLimitErr, ok := is429Error(err); ok {
+			slog.Warn(service+" rate limit hit, backing off",
+				logArgs(slog.Duration("retry_after", rateLimitErr.RetryAfter), slog.Int("attempt", attempt))...)
+
+			// Sleep for retry_after duration
+			select {
+			case <-time.After(rateLimitErr.RetryAfter):
+				continue
+			case <-ctx.Done():
+				return fmt.Errorf("context canceled during rate limit backoff: %w", ctx.Err())
+			}
+		}
+
+		// Handle non-retryable errors (4xx client errors)
+		if !isRetryableError(err) {
+			slog.Error(service+" notification failed with non-retryable error",
+				logArgs(slog.Any("error", err), slog.Int("attempt", attempt))...)
+			return err
+		}
+
+		// Retry on retryable errors (5xx server errors, network errors)
+		if attempt < maxAttempts {
+			delay := baseDelay * time.Duration(attempt)
+			slog.Warn(service+" API request failed, retrying",
+				logArgs(slog.Any("error", err), slog.Int("attempt", attempt), slog.Duration("delay", delay))...)
+
+			select {
+			case <-time.After(delay):
+				continue
+			case <-ctx.Done():
+				return fmt.Errorf("context canceled during retry backoff: %w", ctx.Err())
+			}
+		}
+	}
+
+	// All retries exhausted
+	slog.Error(service+" notification failed after all retries",
+		logArgs(slog.Any("error", lastErr), slog.Int("max_attempts", maxAttempts))...)
+
+	return fmt.Errorf("%s notification failed after %d attempts: %w", strings.ToLower(service), maxAttempts, lastErr)
+}
@@ -0,0 +1,253 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"catchup-feed/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// DigestNotifier is implemented by notifiers that can deliver digests.
+// A digest is sent as one compact message listing the titles of its articles
+// grouped by source or tag, with the editor's overview when present.
+type DigestNotifier interface {
+	// NotifyDigest sends a digest (must not be nil) with the same rate limiting
+	// and retry behavior as NotifyArticle.
+	NotifyDigest(ctx context.Context, digest *entity.Digest) error
+}
+
+const (
+	// Slack digest limits (a message may have at most 50 blocks)
+	maxSlackDigestGroups = 20
+
+	// Discord digest limits (a message may have at most 10 embeds, 6000 embed characters in total
+	// and 2000 characters of content)
+	maxDiscordDigestGroups  = 10
+	maxDiscordEmbedTotal    = 6000
+	maxDiscordContentLength = 2000
+)
+
+// digestHeading returns the first line of a digest message: its title and article count.
+func digestHeading(digest *entity.Digest) string {
+	return fmt.Sprintf("%s（%d件）", digest.Title, digest.ArticleCount)
+}
+
+// hiddenGroupsNote describes the groups left out of a digest message, or returns ""
+// when all groups are included.
+func hiddenGroupsNote(groups []entity.DigestGroup, shown int) string {
+	if len(groups) <= shown {
+		return ""
+	}
+	articles := 0
+	for _, g := range groups[shown:] {
+		articles += len(g.Articles)
+	}
+	return fmt.Sprintf("…ほか%dグループ（%d件）", len(groups)-shown, articles)
+}
+
+// fitLines joins lines with newlines, keeping as many lines as fit in limit runes.
+// Omitted lines are summarized by a trailing "…ほかN件" line.
+func fitLines(lines []string, limit int) string {
+	var b strings.Builder
+	used := 0
+	for i, line := range lines {
+		n := utf8.RuneCountInString(line) + 1 // 改行
+		more := fmt.Sprintf("…ほか%d件", len(lines)-i)
+		// 残りの行を省略する場合の注記分を確保する
+		reserve := 0
+		if i < len(lines)-1 {
+			reserve = utf8.RuneCountInString(more) + 1
+		}
+		if used+n+reserve > limit {
+			b.WriteString(more)
+			return b.String()
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+		used += n
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// truncateRunes shortens text to at most limit runes, appending suffix when truncated.
+// Unlike truncateSummary it never splits a multi-byte character.
+func truncateRunes(text string, limit int, suffix string) string {
+	if utf8.RuneCountInString(text) <= limit {
+		return text
+	}
+	r := []rune(text)
+	return string(r[:max(limit-utf8.RuneCountInString(suffix), 0)]) + suffix
+}
+
+// slackEscaper escapes the control characters of Slack mrkdwn.
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// discordEscaper escapes the characters that would break a Discord markdown link label.
+var discordEscaper = strings.NewReplacer("[", "\\[", "]", "\\]")
+
+// buildDigestBlockKitPayload creates a Slack webhook payload from a digest.
+//
+// The payload includes:
+//   - Section Block: Digest title and article count
+//   - Section Block: Editor's overview (if any)
+//   - One Section Block per group: group name followed by linked article titles
+//     (with the source name when grouped by tag), up to 20 groups
+//   - Context Block: Number of omitted groups (if any)
+func (s *SlackNotifier) buildDigestBlockKitPayload(digest *entity.Digest) SlackWebhookPayload {
+	heading := digestHeading(digest)
+	blocks := []SlackBlock{{
+		Type: "section",
+		Text: &SlackTextObject{Type: "mrkdwn", Text: "*" + slackEscaper.Replace(heading) + "*"},
+	}}
+	if digest.Overview != "" {
+		blocks = append(blocks, SlackBlock{
+			Type: "section",
+			Text: &SlackTextObject{
+				Type: "mrkdwn",
+				Text: truncateRunes(slackEscaper.Replace(digest.Overview), maxSectionTextLength, slackTruncationSuffix),
+			},
+		})
+	}
+	blocks = append(blocks, SlackBlock{Type: "divider"})
+
+	groups := digest.Groups[:min(len(digest.Groups), maxSlackDigestGroups)]
+	for _, g := range groups {
+		header := fmt.Sprintf("*%s*（%d件）", slackEscaper.Replace(g.Name), len(g.Articles))
+		lines := make([]string, 0, len(g.Articles))
+		for _, a := range g.Articles {
+			line := fmt.Sprintf("• <%s|%s>", a.URL, slackEscaper.Replace(a.Title))
+			if digest.GroupBy == entity.DigestGroupByTag {
+				line += " _" + slackEscaper.Replace(a.SourceName) + "_"
+			}
+			lines = append(lines, line)
+		}
+		body := fitLines(lines, maxSectionTextLength-utf8.RuneCountInString(header)-1)
+		blocks = append(blocks, SlackBlock{
+			Type: "section",
+			Text: &SlackTextObject{Type: "mrkdwn", Text: header + "\n" + body},
+		})
+	}
+	if note := hiddenGroupsNote(digest.Groups, len(groups)); note != "" {
+		blocks = append(blocks, SlackBlock{
+			Type:     "context",
+			Elements: []SlackTextObject{{Type: "mrkdwn", Text: note}},
+		})
+	}
+
+	return SlackWebhookPayload{
+		Text:   truncateRunes(heading, maxFallbackLength, slackTruncationSuffix),
+		Blocks: blocks,
+	}
+}
+
+// NotifyDigest sends a digest to Slack as a single Block Kit message.
+// This method implements the DigestNotifier interface.
+func (s *SlackNotifier) NotifyDigest(ctx context.Context, digest *entity.Digest) error {
+	requestID := uuid.New().String()
+	ctx = context.WithValue(ctx, requestIDKey, requestID)
+
+	slog.Info("Starting Slack digest notification",
+		slog.String("request_id", requestID),
+		slog.Int64("digest_id", digest.ID),
+		slog.Int("articles", digest.ArticleCount))
+
+	if err := s.rateLimiter.Allow(ctx); err != nil {
+		return fmt.Errorf("rate limiter error: %w", err)
+	}
+
+	payload := s.buildDigestBlockKitPayload(digest)
+	return sendWithRetry(ctx, "Slack", func() error {
+		return s.postWebhook(ctx, payload)
+	}, slog.Int64("digest_id", digest.ID))
+}
+
+// buildDigestEmbedPayload creates a Discord webhook payload from a digest.
+//
+// The payload includes:
+//   - Content: Digest title, article count, the editor's overview (if any)
+//     and the number of omitted groups (if any), within 2000 characters
+//   - One embed per group (up to 10): group name as title and linked article
+//     titles (with the source name when grouped by tag) as description
+//
+// Group descriptions share the 6000-character limit for all embeds of a message.
+func (d *DiscordNotifier) buildDigestEmbedPayload(digest *entity.Digest) DiscordWebhookPayload {
+	groups := digest.Groups[:min(len(digest.Groups), maxDiscordDigestGroups)]
+
+	content := "**" + digestHeading(digest) + "**"
+	note := hiddenGroupsNote(digest.Groups, len(groups))
+	if digest.Overview != "" {
+		// 注記の分を残して概要を切り詰める
+		limit := maxDiscordContentLength - utf8.RuneCountInString(content) - 2
+		if note != "" {
+			limit -= utf8.RuneCountInString(note) + 2
+		}
+		content += "\n\n" + truncateRunes(digest.Overview, limit, truncationSuffix)
+	}
+	if note != "" {
+		content += "\n\n" + note
+	}
+
+	embeds := make([]DiscordEmbed, 0, len(groups))
+	remaining := maxDiscordEmbedTotal
+	for i, g := range groups {
+		title := truncateRunes(g.Name, maxTitleLength, truncationSuffix)
+		footer := fmt.Sprintf("%d件", len(g.Articles))
+		remaining -= utf8.RuneCountInString(title) + utf8.RuneCountInString(footer)
+
+		lines := make([]string, 0, len(g.Articles))
+		for _, a := range g.Articles {
+			line := fmt.Sprintf("• [%s](%s)", discordEscaper.Replace(a.Title), a.URL)
+			if digest.GroupBy == entity.DigestGroupByTag {
+				line += " — " + a.SourceName
+			}
+			lines = append(lines, line)
+		}
+		// 残りの文字数を、まだ出力していないグループで均等に分け合う
+		budget := min(maxDescriptionLength, remaining/(len(groups)-i))
+		description := fitLines(lines, budget)
+		remaining -= utf8.RuneCountInString(description)
+
+		embeds = append(embeds, DiscordEmbed{
+			Title:       title,
+			Description: description,
+			Color:       discordBlueColor,
+			Footer:      DiscordEmbedFooter{Text: footer},
+			Timestamp:   digest.PeriodEnd.Format(time.RFC3339),
+		})
+	}
+
+	return DiscordWebhookPayload{Content: content, Embeds: embeds}
+}
+
+// NotifyDigest sends a digest to Discord as a single message with one embed per group.
+// This method implements the DigestNotifier interface.
+func (d *DiscordNotifier) NotifyDigest(ctx context.Context, digest *entity.Digest) error {
+	requestID := uuid.New().String()
+	ctx = context.WithValue(ctx, requestIDKey, requestID)
+
+	slog.Info("Starting Discord digest notification",
+		slog.String("request_id", requestID),
+		slog.Int64("digest_id", digest.ID),
+		slog.Int("articles", digest.ArticleCount))
+
+	if err := d.rateLimiter.Allow(ctx); err != nil {
+		return fmt.Errorf("rate limiter error: %w", err)
+	}
+
+	payload := d.buildDigestEmbedPayload(digest)
+	return sendWithRetry(ctx, "Discord", func() error {
+		return d.postWebhook(ctx, payload)
+	}, slog.Int64("digest_id", digest.ID))
+}
+
+// NotifyDigest does nothing and returns nil immediately.
+func (n *NoOpNotifier) NotifyDigest(ctx context.Context, digest *entity.Digest) error {
+	// No-op: intentionally does nothing
+	return nil
+}
@@ -0,0 +1,323 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+func testDigest(groupBy string, groups ...entity.DigestGroup) *entity.Digest {
+	count := 0
+	for _, g := range groups {
+		count += len(g.Articles)
+	}
+	return &entity.Digest{
+		ID:           7,
+		Period:       entity.DigestDaily,
+		GroupBy:      groupBy,
+		Title:        "デイリーダイジェスト 2025-11-15",
+		Overview:     "今日は Go の話題が中心でした。",
+		PeriodStart:  time.Date(2025, 11, 14, 8, 0, 0, 0, time.UTC),
+		PeriodEnd:    time.Date(2025, 11, 15, 8, 0, 0, 0, time.UTC),
+		ArticleCount: count,
+		Groups:       groups,
+	}
+}
+
+func testDigestGroup(name string, n int) entity.DigestGroup {
+	articles := make([]entity.DigestArticle, n)
+	for i := range articles {
+		articles[i] = entity.DigestArticle{
+			ID:         int64(i + 1),
+			Title:      fmt.Sprintf("%s article %d", name, i+1),
+			URL:        fmt.Sprintf("https://example.com/%s/%d", name, i+1),
+			SourceName: "Example Blog",
+		}
+	}
+	return entity.DigestGroup{Name: name, Articles: articles}
+}
+
+func TestFitLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		limit int
+		want  string
+	}{
+		{name: "all lines fit", lines: []string{"aa", "bb"}, limit: 10, want: "aa\nbb"},
+		{name: "empty", lines: nil, limit: 10, want: ""},
+		{name: "omits lines over the limit", lines: []string{"aaaa", "bbbb", "cccc"}, limit: 12, want: "aaaa\n…ほか2件"},
+		{name: "nothing fits", lines: []string{"aaaaaaaa", "b"}, limit: 5, want: "…ほか2件"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fitLines(tt.lines, tt.limit)
+			if got != tt.want {
+				t.Errorf("fitLines() = %q, want %q", got, tt.want)
+			}
+			if len(tt.lines) > 0 && utf8.RuneCountInString(got) > tt.limit {
+				t.Errorf("fitLines() length %d exceeds limit %d", utf8.RuneCountInString(got), tt.limit)
+			}
+		})
+	}
+}
+
+func TestTruncateRunes(t *testing.T) {
+	if got := truncateRunes("あいうえお", 5, "…"); got != "あいうえお" {
+		t.Errorf("expected text within limit to be unchanged, got %q", got)
+	}
+	if got := truncateRunes("あいうえおか", 5, "…"); got != "あいうえ…" {
+		t.Errorf("expected %q, got %q", "あいうえ…", got)
+	}
+}
+
+func TestSlackNotifier_buildDigestBlockKitPayload(t *testing.T) {
+	notifier := NewSlackNotifier(SlackConfig{
+		Enabled:    true,
+		WebhookURL: "https://hooks.slack.com/services/test",
+		Timeout:    10 * time.Second,
+	})
+
+	t.Run("groups by source", func(t *testing.T) {
+		digest := testDigest(entity.DigestGroupBySource, testDigestGroup("Go Blog", 2), testDigestGroup("Rust Blog", 1))
+
+		payload := notifier.buildDigestBlockKitPayload(digest)
+
+		// 見出し, 概要, 区切り線, グループ×2
+		if len(payload.Blocks) != 5 {
+			t.Fatalf("expected 5 blocks, got %d", len(payload.Blocks))
+		}
+		if payload.Text != "デイリーダイジェスト 2025-11-15（3件）" {
+			t.Errorf("unexpected fallback text %q", payload.Text)
+		}
+		if got := payload.Blocks[0].Text.Text; got != "*デイリーダイジェスト 2025-11-15（3件）*" {
+			t.Errorf("unexpected heading %q", got)
+		}
+		if got := payload.Blocks[1].Text.Text; got != digest.Overview {
+			t.Errorf("unexpected overview %q", got)
+		}
+		if payload.Blocks[2].Type != "divider" {
+			t.Errorf("expected divider, got %q", payload.Blocks[2].Type)
+		}
+		want := "*Go Blog*（2件）\n• <https://example.com/Go Blog/1|Go Blog article 1>\n• <https://example.com/Go Blog/2|Go Blog article 2>"
+		if got := payload.Blocks[3].Text.Text; got != want {
+			t.Errorf("unexpected group section:\n got %q\nwant %q", got, want)
+		}
+	})
+
+	t.Run("lists source names when grouped by tag", func(t *testing.T) {
+		digest := testDigest(entity.DigestGroupByTag, testDigestGroup("go", 1))
+		digest.Overview = ""
+
+		payload := notifier.buildDigestBlockKitPayload(digest)
+
+		if len(payload.Blocks) != 3 {
+			t.Fatalf("expected 3 blocks without overview, got %d", len(payload.Blocks))
+		}
+		if !strings.Contains(payload.Blocks[2].Text.Text, "_Example Blog_") {
+			t.Errorf("expected source name in %q", payload.Blocks[2].Text.Text)
+		}
+	})
+
+	t.Run("escapes mrkdwn control characters", func(t *testing.T) {
+		group := testDigestGroup("C&C", 1)
+		group.Articles[0].Title = "<b>bold</b>"
+		digest := testDigest(entity.DigestGroupBySource, group)
+
+		payload := notifier.buildDigestBlockKitPayload(digest)
+
+		text := payload.Blocks[3].Text.Text
+		if !strings.Contains(text, "*C&amp;C*") || !strings.Contains(text, "&lt;b&gt;bold&lt;/b&gt;") {
+			t.Errorf("expected escaped text, got %q", text)
+		}
+	})
+
+	t.Run("caps groups and section length", func(t *testing.T) {
+		groups := []entity.DigestGroup{testDigestGroup("big", 200)}
+		for i := 0; i < maxSlackDigestGroups+4; i++ {
+			groups = append(groups, testDigestGroup(fmt.Sprintf("g%02d", i), 1))
+		}
+		digest := testDigest(entity.DigestGroupBySource, groups...)
+
+		payload := notifier.buildDigestBlockKitPayload(digest)
+
+		// 見出し, 概要, 区切り線, グループ×20, 注記
+		if len(payload.Blocks) != 3+maxSlackDigestGroups+1 {
+			t.Fatalf("expected %d blocks, got %d", 3+maxSlackDigestGroups+1, len(payload.Blocks))
+		}
+		big := payload.Blocks[3].Text.Text
+		if utf8.RuneCountInString(big) > maxSectionTextLength {
+			t.Errorf("section length %d exceeds %d", utf8.RuneCountInString(big), maxSectionTextLength)
+		}
+		if !strings.Contains(big, "…ほか") {
+			t.Errorf("expected omitted articles note in large group")
+		}
+		note := payload.Blocks[len(payload.Blocks)-1]
+		if note.Type != "context" || note.Elements[0].Text != "…ほか5グループ（5件）" {
+			t.Errorf("unexpected omitted groups note %+v", note)
+		}
+	})
+}
+
+func TestDiscordNotifier_buildDigestEmbedPayload(t *testing.T) {
+	notifier := NewDiscordNotifier(DiscordConfig{
+		Enabled:    true,
+		WebhookURL: "https://discord.com/api/webhooks/test",
+		Timeout:    10 * time.Second,
+	})
+
+	t.Run("one embed per group", func(t *testing.T) {
+		digest := testDigest(entity.DigestGroupBySource, testDigestGroup("Go Blog", 2), testDigestGroup("Rust Blog", 1))
+
+		payload := notifier.buildDigestEmbedPayload(digest)
+
+		wantContent := "**デイリーダイジェスト 2025-11-15（3件）**\n\n今日は Go の話題が中心でした。"
+		if payload.Content != wantContent {
+			t.Errorf("unexpected content:\n got %q\nwant %q", payload.Content, wantContent)
+		}
+		if len(payload.Embeds) != 2 {
+			t.Fatalf("expected 2 embeds, got %d", len(payload.Embeds))
+		}
+		embed := payload.Embeds[0]
+		if embed.Title != "Go Blog" || embed.Footer.Text != "2件" || embed.Color != discordBlueColor {
+			t.Errorf("unexpected embed %+v", embed)
+		}
+		wantDescription := "• [Go Blog article 1](https://example.com/Go Blog/1)\n• [Go Blog article 2](https://example.com/Go Blog/2)"
+		if embed.Description != wantDescription {
+			t.Errorf("unexpected description:\n got %q\nwant %q", embed.Description, wantDescription)
+		}
+		if embed.Timestamp != "2025-11-15T08:00:00Z" {
+			t.Errorf("unexpected timestamp %q", embed.Timestamp)
+		}
+		if embed.URL != "" {
+			t.Errorf("expected no embed URL, got %q", embed.URL)
+		}
+	})
+
+	t.Run("escapes link labels and lists source names when grouped by tag", func(t *testing.T) {
+		group := testDigestGroup("go", 1)
+		group.Articles[0].Title = "[RFC] generics"
+		digest := testDigest(entity.DigestGroupByTag, group)
+
+		payload := notifier.buildDigestEmbedPayload(digest)
+
+		want := "• [\\[RFC\\] generics](https://example.com/go/1) — Example Blog"
+		if payload.Embeds[0].Description != want {
+			t.Errorf("unexpected description:\n got %q\nwant %q", payload.Embeds[0].Description, want)
+		}
+	})
+
+	t.Run("respects Discord limits", func(t *testing.T) {
+		var groups []entity.DigestGroup
+		for i := 0; i < maxDiscordDigestGroups+2; i++ {
+			groups = append(groups, testDigestGroup(fmt.Sprintf("g%02d", i), 100))
+		}
+		digest := testDigest(entity.DigestGroupBySource, groups...)
+		digest.Overview = strings.Repeat("あ", 3000)
+
+		payload := notifier.buildDigestEmbedPayload(digest)
+
+		if got := utf8.RuneCountInString(payload.Content); got > maxDiscordContentLength {
+			t.Errorf("content length %d exceeds %d", got, maxDiscordContentLength)
+		}
+		if !strings.HasSuffix(payload.Content, "…ほか2グループ（200件）") {
+			t.Errorf("expected omitted groups note at the end of content")
+		}
+		if len(payload.Embeds) != maxDiscordDigestGroups {
+			t.Fatalf("expected %d embeds, got %d", maxDiscordDigestGroups, len(payload.Embeds))
+		}
+		total := 0
+		for _, e := range payload.Embeds {
+			n := utf8.RuneCountInString(e.Description)
+			if n > maxDescriptionLength {
+				t.Errorf("description length %d exceeds %d", n, maxDescriptionLength)
+			}
+			total += n + utf8.RuneCountInString(e.Title) + utf8.RuneCountInString(e.Footer.Text)
+		}
+		if total > maxDiscordEmbedTotal {
+			t.Errorf("total embed length %d exceeds %d", total, maxDiscordEmbedTotal)
+		}
+	})
+}
+
+func TestSlackNotifier_NotifyDigest(t *testing.T) {
+	t.Run("posts the digest payload", func(t *testing.T) {
+		var received SlackWebhookPayload
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+				t.Errorf("failed to decode payload: %v", err)
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		notifier := NewSlackNotifier(SlackConfig{Enabled: true, WebhookURL: server.URL, Timeout: 5 * time.Second})
+		digest := testDigest(entity.DigestGroupBySource, testDigestGroup("Go Blog", 1))
+
+		if err := notifier.NotifyDigest(context.Background(), digest); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(received.Blocks) != 4 {
+			t.Errorf("expected 4 blocks, got %d", len(received.Blocks))
+		}
+	})
+
+	t.Run("fails after retries", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		notifier := NewSlackNotifier(SlackConfig{Enabled: true, WebhookURL: server.URL, Timeout: 5 * time.Second})
+		digest := testDigest(entity.DigestGroupBySource, testDigestGroup("Go Blog", 1))
+
+		err := notifier.NotifyDigest(context.Background(), digest)
+		if err == nil || !strings.Contains(err.Error(), "slack notification failed") {
+			t.Fatalf("expected retry failure, got %v", err)
+		}
+		if calls.Load() != 2 {
+			t.Errorf("expected 2 attempts, got %d", calls.Load())
+		}
+	})
+}
+
+func TestDiscordNotifier_NotifyDigest(t *testing.T) {
+	var received DiscordWebhookPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("failed to decode payload: %v", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	notifier := NewDiscordNotifier(DiscordConfig{Enabled: true, WebhookURL: server.URL, Timeout: 5 * time.Second})
+	digest := testDigest(entity.DigestGroupBySource, testDigestGroup("Go Blog", 1), testDigestGroup("Rust Blog", 1))
+
+	if err := notifier.NotifyDigest(context.Background(), digest); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.HasPrefix(received.Content, "**デイリーダイジェスト") {
+		t.Errorf("unexpected content %q", received.Content)
+	}
+	if len(received.Embeds) != 2 {
+		t.Errorf("expected 2 embeds, got %d", len(received.Embeds))
+	}
+}
+
+func TestNoOpNotifier_NotifyDigest(t *testing.T) {
+	if err := NewNoOpNotifier().NotifyDigest(context.Background(), &entity.Digest{}); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+}
@@ -59,17 +59,18 @@ func NewDiscordNotifier(config DiscordConfig) *DiscordNotifier {
 
 // DiscordWebhookPayload represents the JSON payload sent to Discord webhook.
 type DiscordWebhookPayload struct {
-	Embeds []DiscordEmbed `json:"embeds"`
+	Content string         `json:"content,omitempty"` // Message text above the embeds (digests)
+	Embeds  []DiscordEmbed `json:"embeds"`
 }
 
 // DiscordEmbed represents a Discord embed message.
 type DiscordEmbed struct {
 	Title       string             `json:"title"`
 	Description string             `json:"description"`
-	URL         string             `json:"url"`
+	URL         string             `json:"url,omitempty"`
 	Color       int                `json:"color"`
 	Footer      DiscordEmbedFooter `json:"footer"`
-	Timestamp   string             `json:"timestamp"`
+	Timestamp   string             `json:"timestamp,omitempty"`
 }
 
 // DiscordEmbedFooter represents the footer of a Discord embed.
@@ -139,8 +140,12 @@ func (d *DiscordNotifier) buildEmbedPayload(article *entity.Article, source *ent
 //   - 5xx: Server error (retryable)
 //   - Network error: Connection/timeout error (retryable)
 func (d *DiscordNotifier) sendWebhookRequest(ctx context.Context, article *entity.Article, source *entity.Source) error {
-	payload := d.buildEmbedPayload(article, source)
+	return d.postWebhook(ctx, d.buildEmbedPayload(article, source))
+}
 
+// postWebhook posts a payload to the Discord webhook.
+// It returns the same error types as sendWebhookRequest.
+func (d *DiscordNotifier) postWebhook(ctx context.Context, payload DiscordWebhookPayload) error {
 	jsonData, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("marshal webhook payload: %w", err)
@@ -229,86 +234,9 @@ func extractRetryAfter(resp *http.Response, body []byte) time.Duration {
 //
 // All attempts are logged with request_id for tracing.
 func (d *DiscordNotifier) sendWebhookRequestWithRetry(ctx context.Context, article *entity.Article, source *entity.Source) error {
-	const (
-		maxAttempts = 2
-		baseDelay   = 5 * time.Second
-	)
-
-	requestID, _ := ctx.Value(requestIDKey).(string)
-
-	var lastErr error
-	for attempt := 1; attempt <= maxAttempts; attempt++ {
-		err := d.sendWebhookRequest(ctx, article, source)
-
-		// Success
-		if err == nil {
-			slog.Info("Discord notification successful",
-				slog.String("request_id", requestID),
-				slog.Int64("article_id", article.ID),
-				slog.String("url", article.URL),
-				slog.Int("attempt", attempt))
-			return nil
-		}
-
-		lastErr = err
-
-		// Handle rate limit error (429)
-		if rateLimitErr, ok := is429Error(err); ok {
-			slog.Warn("Discord rate limit hit, backing off",
-				slog.String("request_id", requestID),
-				slog.Int64("article_id", article.ID),
-				slog.Duration("retry_after", rateLimitErr.RetryAfter),
-				slog.Int("attempt", attempt))
-
-			// Sleep for retry_after duration
-			select {
-			case <-time.After(rateLimitErr.RetryAfter):
-				continue
-			case <-ctx.Done():
-				return fmt.Errorf("context canceled during rate limit backoff: %w", ctx.Err())
-			}
-		}
-
-		// Handle non-retryable errors (4xx client errors)
-		if !isRetryableError(err) {
-			slog.Error("Discord notification failed with non-retryable error",
-				slog.String("request_id", requestID),
-				slog.Int64("article_id", article.ID),
-				slog.String("url", article.URL),
-				slog.Any("error", err),
-				slog.Int("attempt", attempt))
-			return err
-		}
-
-		// Retry on retryable errors (5xx server errors, network errors)
-		if attempt < maxAttempts {
-			delay := baseDelay * time.Duration(attempt)
-			slog.Warn("Discord API request failed, retrying",
-				slog.String("request_id", requestID),
-				slog.Int64("article_id", article.ID),
-				slog.String("url", article.URL),
-				slog.Any("error", err),
-				slog.Int("attempt", attempt),
-				slog.Duration("delay", delay))
-
-			select {
-			case <-time.After(delay):
-				continue
-			case <-ctx.Done():
-				return fmt.Errorf("context canceled during retry backoff: %w", ctx.Err())
-			}
-		}
-	}
-
-	// All retries exhausted
-	slog.Error("Discord notification failed after all retries",
-		slog.String("request_id", requestID),
-		slog.Int64("article_id", article.ID),
-		slog.String("url", article.URL),
-		slog.Any("error", lastErr),
-		slog.Int("max_attempts", maxAttempts))
-
-	return fmt.Errorf("discord notification failed after %d attempts: %w", maxAttempts, lastErr)
+	return sendWithRetry(ctx, "Discord", func() error {
+		return d.sendWebhookRequest(ctx, article, source)
+	}, slog.Int64("article_id", article.ID), slog.String("url", article.URL))
 }
 
 // NotifyArticle sends a Discord notification for a newly fetched article.
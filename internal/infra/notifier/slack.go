@@ -155,8 +155,12 @@ func (s *SlackNotifier) buildBlockKitPayload(article *entity.Article, source *en
 //   - 5xx: Server error (retryable)
 //   - Network error: Connection/timeout error (retryable)
 func (s *SlackNotifier) sendWebhookRequest(ctx context.Context, article *entity.Article, source *entity.Source) error {
-	payload := s.buildBlockKitPayload(article, source)
+	return s.postWebhook(ctx, s.buildBlockKitPayload(article, source))
+}
 
+// postWebhook posts a payload to the Slack webhook.
+// It returns the same error types as sendWebhookRequest.
+func (s *SlackNotifier) postWebhook(ctx context.Context, payload SlackWebhookPayload) error {
 	jsonData, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("marshal webhook payload: %w", err)
@@ -222,86 +226,9 @@ func (s *SlackNotifier) sendWebhookRequest(ctx context.Context, article *entity.
 //
 // All attempts are logged with request_id for tracing.
 func (s *SlackNotifier) sendWebhookRequestWithRetry(ctx context.Context, article *entity.Article, source *entity.Source) error {
-	const (
-		maxAttempts = 2
-		baseDelay   = 5 * time.Second
-	)
-
-	requestID, _ := ctx.Value(requestIDKey).(string)
-
-	var lastErr error
-	for attempt := 1; attempt <= maxAttempts; attempt++ {
-		err := s.sendWebhookRequest(ctx, article, source)
-
-		// Success
-		if err == nil {
-			slog.Info("Slack notification successful",
-				slog.String("request_id", requestID),
-				slog.Int64("article_id", article.ID),
-				slog.String("url", article.URL),
-				slog.Int("attempt", attempt))
-			return nil
-		}
-
-		lastErr = err
-
-		// Handle rate limit error (429)
-		if rateLimitErr, ok := is429Error(err); ok {
-			slog.Warn("Slack rate limit hit, backing off",
-				slog.String("request_id", requestID),
-				slog.Int64("article_id", article.ID),
-				slog.Duration("retry_after", rateLimitErr.RetryAfter),
-				slog.Int("attempt", attempt))
-
-			// Sleep for retry_after duration
-			select {
-			case <-time.After(rateLimitErr.RetryAfter):
-				continue
-			case <-ctx.Done():
-				return fmt.Errorf("context canceled during rate limit backoff: %w", ctx.Err())
-			}
-		}
-
-		// Handle non-retryable errors (4xx client errors)
-		if !isRetryableError(err) {
-			slog.Error("Slack notification failed with non-retryable error",
-				slog.String("request_id", requestID),
-				slog.Int64("article_id", article.ID),
-				slog.String("url", article.URL),
-				slog.Any("error", err),
-				slog.Int("attempt", attempt))
-			return err
-		}
-
-		// Retry on retryable errors (5xx server errors, network errors)
-		if attempt < maxAttempts {
-			delay := baseDelay * time.Duration(attempt)
-			slog.Warn("Slack API request failed, retrying",
-				slog.String("request_id", requestID),
-				slog.Int64("article_id", article.ID),
-				slog.String("url", article.URL),
-				slog.Any("error", err),
-				slog.Int("attempt", attempt),
-				slog.Duration("delay", delay))
-
-			select {
-			case <-time.After(delay):
-				continue
-			case <-ctx.Done():
-				return fmt.Errorf("context canceled during retry backoff: %w", ctx.Err())
-			}
-		}
-	}
-
-	// All retries exhausted
-	slog.Error("Slack notification failed after all retries",
-		slog.String("request_id", requestID),
-		slog.Int64("article_id", article.ID),
-		slog.String("url", article.URL),
-		slog.Any("error", lastErr),
-		slog.Int("max_attempts", maxAttempts))
-
-	return fmt.Errorf("slack notification failed after %d attempts: %w", maxAttempts, lastErr)
+	return sendWithRetry(ctx, "Slack", func() error {
+		return s.sendWebhookRequest(ctx, article, source)
+	}, slog.Int64("article_id", article.ID), slog.String("url", article.URL))
 }
 
 // NotifyArticle sends a Slack notification for a newly fetched article.
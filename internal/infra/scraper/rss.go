@@ -100,6 +100,7 @@ func (f *RSSFetcher) doFetch(ctx context.Context, feedURL string) ([]fetch.FeedI
 			URL:         it.Link,
 			Content:     content,
 			PublishedAt: pubAt,
+			Categories:  it.Categories,
 		})
 	}
 
@@ -228,3 +228,39 @@ func TestRSSFetcher_Fetch_WithContent(t *testing.T) {
 		t.Errorf("items[0].Content = %q, want %q", items[0].Content, "Full content here")
 	}
 }
+
+func TestRSSFetcher_Fetch_Categories(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rss := `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0">
+  <channel>
+    <title>Test Feed</title>
+    <link>https://example.com</link>
+    <item>
+      <title>Article 1</title>
+      <link>https://example.com/article1</link>
+      <category>Go</category>
+      <category>Release</category>
+    </item>
+  </channel>
+</rss>`
+		w.Header().Set("Content-Type", "application/rss+xml")
+		if _, err := w.Write([]byte(rss)); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	fetcher := scraper.NewRSSFetcher(&http.Client{Timeout: 10 * time.Second})
+
+	items, err := fetcher.Fetch(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("items length = %d, want 1", len(items))
+	}
+	if got := items[0].Categories; len(got) != 2 || got[0] != "Go" || got[1] != "Release" {
+		t.Errorf("items[0].Categories = %v, want [Go Release]", got)
+	}
+}
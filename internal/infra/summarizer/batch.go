@@ -0,0 +1,166 @@
+package summarizer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/google/uuid"
+
+	"catchup-feed/internal/usecase/fetch"
+)
+
+// LoadBatchModeEnabled reports whether SUMMARIZER_BATCH_MODE enables batch mode.
+// In batch mode the worker summarizes new articles through the Message Batches API
+// instead of synchronous calls. Default: false.
+func LoadBatchModeEnabled() bool {
+	enabled, err := strconv.ParseBool(os.Getenv("SUMMARIZER_BATCH_MODE"))
+	return err == nil && enabled
+}
+
+// SubmitSummaryBatch implements fetch.BatchSummarizer using the Anthropic Message Batches API.
+// Each request uses the same prompt as SummarizeArticle, including structured mode.
+// Long articles are condensed chunk by chunk with synchronous calls first, so that
+// only the final pass of each article is sent in the batch.
+// Injection patterns are stripped from each request as in SummarizeArticle; the
+// detections are returned per request so that they can be stored on the articles.
+func (c *Claude) SubmitSummaryBatch(ctx context.Context, reqs []fetch.BatchSummaryRequest) (*fetch.BatchSubmission, error) {
+	params := make([]anthropic.MessageBatchNewParamsRequest, 0, len(reqs))
+	versions := make(map[string]string, len(reqs))
+	injections := make(map[string][]string)
+
+	for _, req := range reqs {
+		sreq, flags := guardRequest(ctx, req.SummaryRequest, c.metricsRecorder)
+		if len(flags) > 0 {
+			injections[req.CustomID] = flags
+		}
+		prompt, version, err := c.batchPrompt(ctx, sreq)
+		if err != nil {
+			return nil, fmt.Errorf("prepare batch request %s: %w", req.CustomID, err)
+		}
+		params = append(params, anthropic.MessageBatchNewParamsRequest{
+			CustomID: req.CustomID,
+			Params: anthropic.MessageBatchNewParamsRequestParams{
+				Model:     anthropic.Model(c.config.Model),
+				MaxTokens: int64(c.config.MaxTokens),
+				System:    []anthropic.TextBlockParam{{Text: injectionGuardSystemPrompt}},
+				Messages: []anthropic.MessageParam{
+					anthropic.NewUserMessage(anthropic.NewTextBlock(prompt)),
+				},
+			},
+		})
+		versions[req.CustomID] = version
+	}
+
+	batch, err := c.client.Messages.Batches.New(ctx, anthropic.MessageBatchNewParams{Requests: params})
+	if err != nil {
+		return nil, fmt.Errorf("claude batch api error: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Submitted summary batch",
+		slog.String("batch_id", batch.ID),
+		slog.Int("requests", len(params)))
+
+	return &fetch.BatchSubmission{ID: batch.ID, PromptVersions: versions, InjectionFlags: injections}, nil
+}
+
+// batchPrompt builds the final prompt for one batch request.
+func (c *Claude) batchPrompt(ctx context.Context, req fetch.SummaryRequest) (string, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.LongDocument.timeout(60*time.Second, req.Content))
+	defer cancel()
+
+	content, err := c.prepareContent(ctx, uuid.New().String(), req)
+	if err != nil {
+		return "", "", err
+	}
+	prompt, version, err := c.buildPrompt(req, content)
+	if err != nil {
+		return "", "", err
+	}
+	if c.config.Structured {
+		prompt = buildStructuredPrompt(prompt, c.config.CharacterLimit)
+	}
+	return prompt, version, nil
+}
+
+// SummaryBatchResults implements fetch.BatchSummarizer.
+// It returns done=false while the batch is in progress or canceling.
+func (c *Claude) SummaryBatchResults(ctx context.Context, batchID string) (map[string]fetch.BatchSummaryResult, bool, error) {
+	batch, err := c.client.Messages.Batches.Get(ctx, batchID)
+	if err != nil {
+		return nil, false, fmt.Errorf("claude batch api error: %w", err)
+	}
+	if batch.ProcessingStatus != anthropic.MessageBatchProcessingStatusEnded {
+		return nil, false, nil
+	}
+
+	stream := c.client.Messages.Batches.ResultsStreaming(ctx, batchID)
+	defer func() { _ = stream.Close() }()
+
+	results := make(map[string]fetch.BatchSummaryResult)
+	for stream.Next() {
+		item := stream.Current()
+		result, err := c.batchResult(ctx, item.Result)
+		results[item.CustomID] = fetch.BatchSummaryResult{Result: result, Err: err}
+	}
+	if err := stream.Err(); err != nil {
+		return nil, false, fmt.Errorf("read claude batch results: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Fetched summary batch results",
+		slog.String("batch_id", batchID),
+		slog.Int("results", len(results)))
+
+	return results, true, nil
+}
+
+// batchResult converts one batch result into a summary.
+// Malformed structured output is reported as an error because the article content
+// needed for a plain fallback call is not kept while the batch is processed.
+// Over-limit summaries are shortened with synchronous calls, as in SummarizeArticle.
+// Echoed instructions are removed from the output; URLs cannot be checked for the
+// same reason and are kept.
+func (c *Claude) batchResult(ctx context.Context, r anthropic.MessageBatchResultUnion) (*fetch.SummaryResult, error) {
+	switch r.Type {
+	case "succeeded":
+	case "errored":
+		return nil, fmt.Errorf("batch request errored: %s: %s", r.Error.Error.Type, r.Error.Error.Message)
+	default:
+		return nil, fmt.Errorf("batch request %s", r.Type)
+	}
+
+	if len(r.Message.Content) == 0 {
+		return nil, errors.New("claude api returned empty response")
+	}
+	textBlock, ok := r.Message.Content[0].AsAny().(anthropic.TextBlock)
+	if !ok {
+		return nil, errors.New("claude api returned unexpected response type")
+	}
+
+	requestID := r.Message.ID
+	model := string(r.Message.Model)
+	if !c.config.Structured {
+		result := &fetch.SummaryResult{Summary: c.finalizeSummary(ctx, requestID, textBlock.Text), Model: model}
+		guardResult(ctx, "", result, "", nil, c.metricsRecorder)
+		return result, nil
+	}
+
+	summary, structured, err := ParseStructuredSummary(textBlock.Text, "")
+	if err != nil {
+		c.metricsRecorder.RecordStructuredResult(StructuredResultMalformed)
+		return nil, err
+	}
+	if structured == nil {
+		c.metricsRecorder.RecordStructuredResult(StructuredResultInvalid)
+	} else {
+		c.metricsRecorder.RecordStructuredResult(StructuredResultValid)
+	}
+	result := &fetch.SummaryResult{Summary: c.finalizeSummary(ctx, requestID, summary), Structured: structured, Model: model}
+	guardResult(ctx, "", result, "", nil, c.metricsRecorder)
+	return result, nil
+}
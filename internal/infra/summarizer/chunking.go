@@ -0,0 +1,352 @@
+package summarizer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/sync/errgroup"
+
+	"catchup-feed/internal/usecase/fetch"
+)
+
+// Default bounds for long-document (map-reduce) summarization.
+const (
+	defaultChunkSize        = 6000
+	defaultMaxChunks        = 8
+	defaultTokenBudget      = 60000
+	defaultChunkParallelism = 3
+)
+
+// LongDocumentConfig bounds map-reduce summarization of long articles.
+// Articles longer than ChunkSize are split on paragraph/sentence boundaries,
+// each chunk is summarized separately, and the partial summaries are combined
+// by a final pass that uses the regular prompt template.
+type LongDocumentConfig struct {
+	// ChunkSize is the maximum chunk length in characters (Unicode runes).
+	// Loaded from SUMMARIZER_CHUNK_SIZE. Default: 6000.
+	ChunkSize int
+
+	// MaxChunks caps the number of per-chunk summaries for one article.
+	// Loaded from SUMMARIZER_MAX_CHUNKS. Default: 8.
+	MaxChunks int
+
+	// TokenBudget caps the estimated input and output tokens of all API calls
+	// made for one article. Loaded from SUMMARIZER_TOKEN_BUDGET. Default: 60000.
+	TokenBudget int
+
+	// Parallelism is the number of chunks summarized concurrently. Default: 3.
+	Parallelism int
+}
+
+// loadLongDocumentConfig reads the long-document settings from the environment.
+// Invalid values fall back to the defaults with a warning log.
+func loadLongDocumentConfig() LongDocumentConfig {
+	return LongDocumentConfig{
+		ChunkSize:   envPositiveInt("SUMMARIZER_CHUNK_SIZE", defaultChunkSize),
+		MaxChunks:   envPositiveInt("SUMMARIZER_MAX_CHUNKS", defaultMaxChunks),
+		TokenBudget: envPositiveInt("SUMMARIZER_TOKEN_BUDGET", defaultTokenBudget),
+		Parallelism: defaultChunkParallelism,
+	}
+}
+
+// envPositiveInt parses a positive integer environment variable, returning def when
+// the variable is unset or invalid.
+func envPositiveInt(name string, def int) int {
+	v := os.Getenv(name)
+	if v == "" {
+		return def
+	}
+	parsed, err := strconv.Atoi(v)
+	if err != nil || parsed <= 0 {
+		slog.Warn("Invalid "+name+", using default",
+			slog.String("value", v),
+			slog.Int("default", def))
+		return def
+	}
+	return parsed
+}
+
+// withDefaults fills unset fields so that a zero LongDocumentConfig is usable.
+func (c LongDocumentConfig) withDefaults() LongDocumentConfig {
+	if c.ChunkSize <= 0 {
+		c.ChunkSize = defaultChunkSize
+	}
+	if c.MaxChunks <= 0 {
+		c.MaxChunks = defaultMaxChunks
+	}
+	if c.TokenBudget <= 0 {
+		c.TokenBudget = defaultTokenBudget
+	}
+	if c.Parallelism <= 0 {
+		c.Parallelism = defaultChunkParallelism
+	}
+	return c
+}
+
+// isLong reports whether content needs map-reduce summarization.
+func (c LongDocumentConfig) isLong(content string) bool {
+	return utf8.RuneCountInString(content) > c.withDefaults().ChunkSize
+}
+
+// timeout extends the per-article timeout for long documents so that every
+// round of parallel chunk calls and the final pass get the base timeout.
+func (c LongDocumentConfig) timeout(base time.Duration, content string) time.Duration {
+	if !c.isLong(content) {
+		return base
+	}
+	c = c.withDefaults()
+	chunks := (utf8.RuneCountInString(content) + c.ChunkSize - 1) / c.ChunkSize
+	if chunks > c.MaxChunks {
+		chunks = c.MaxChunks
+	}
+	rounds := (chunks + c.Parallelism - 1) / c.Parallelism
+	return base * time.Duration(rounds+1)
+}
+
+// chunkPlan is the set of chunks selected for summarization.
+type chunkPlan struct {
+	chunks []string
+	// total is the number of chunks the article was split into.
+	total int
+}
+
+// bounded reports whether chunks were dropped to stay within MaxChunks or TokenBudget.
+func (p chunkPlan) bounded() bool {
+	return len(p.chunks) < p.total
+}
+
+// planChunks splits content and drops chunks until the plan fits MaxChunks and
+// the token budget. charLimit is the output size of each chunk summary and of
+// the final summary. At least two chunks (the beginning and the end) are kept.
+func (c LongDocumentConfig) planChunks(content string, charLimit int) chunkPlan {
+	c = c.withDefaults()
+	all := splitIntoChunks(content, c.ChunkSize)
+	plan := chunkPlan{chunks: selectChunks(all, c.MaxChunks), total: len(all)}
+
+	for len(plan.chunks) > 2 && estimatePlanTokens(plan.chunks, charLimit) > c.TokenBudget {
+		plan.chunks = selectChunks(all, len(plan.chunks)-1)
+	}
+	return plan
+}
+
+// estimatePlanTokens estimates the tokens of all chunk calls plus the final pass.
+func estimatePlanTokens(chunks []string, charLimit int) int {
+	total := 0
+	for _, ch := range chunks {
+		total += estimateTokens(ch) + charLimit
+	}
+	// 最終パス：各チャンク要約を入力とし、最終要約を出力する
+	return total + len(chunks)*charLimit + charLimit
+}
+
+// estimateTokens roughly estimates the token count of s:
+// about one token per non-ASCII character and per four ASCII characters.
+func estimateTokens(s string) int {
+	ascii, other := 0, 0
+	for _, r := range s {
+		if r < utf8.RuneSelf {
+			ascii++
+		} else {
+			other++
+		}
+	}
+	return other + (ascii+3)/4
+}
+
+// selectChunks returns at most max chunks, evenly spaced and always including
+// the first and last chunk so that the introduction and the conclusion are kept.
+func selectChunks(chunks []string, max int) []string {
+	n := len(chunks)
+	if n <= max {
+		return chunks
+	}
+	if max <= 1 {
+		return chunks[:1]
+	}
+	out := make([]string, 0, max)
+	for i := 0; i < max; i++ {
+		out = append(out, chunks[i*(n-1)/(max-1)])
+	}
+	return out
+}
+
+// splitIntoChunks splits text into chunks of at most size runes, preferring
+// paragraph boundaries, then sentence boundaries, then a hard split.
+func splitIntoChunks(text string, size int) []string {
+	var chunks []string
+	var cur strings.Builder
+	curLen := 0
+
+	flush := func() {
+		if s := strings.TrimSpace(cur.String()); s != "" {
+			chunks = append(chunks, s)
+		}
+		cur.Reset()
+		curLen = 0
+	}
+	add := func(piece, sep string) {
+		n := utf8.RuneCountInString(piece)
+		if curLen > 0 && curLen+utf8.RuneCountInString(sep)+n > size {
+			flush()
+		}
+		if curLen > 0 {
+			cur.WriteString(sep)
+			curLen += utf8.RuneCountInString(sep)
+		}
+		cur.WriteString(piece)
+		curLen += n
+	}
+
+	for _, para := range strings.Split(text, "\n\n") {
+		para = strings.TrimSpace(para)
+		if para == "" {
+			continue
+		}
+		if utf8.RuneCountInString(para) <= size {
+			add(para, "\n\n")
+			continue
+		}
+		// 段落が長すぎる場合は文単位で分割する
+		for _, sentence := range splitSentences(para) {
+			if utf8.RuneCountInString(sentence) <= size {
+				add(sentence, "")
+				continue
+			}
+			// 文が長すぎる場合は文字数で強制分割する
+			runes := []rune(sentence)
+			for len(runes) > 0 {
+				n := size
+				if n > len(runes) {
+					n = len(runes)
+				}
+				add(string(runes[:n]), "")
+				runes = runes[n:]
+			}
+		}
+	}
+	flush()
+	return chunks
+}
+
+// splitSentences splits text after sentence-ending punctuation, keeping the
+// punctuation and any following whitespace with the preceding sentence.
+func splitSentences(text string) []string {
+	var out []string
+	runes := []rune(text)
+	start := 0
+	for i := 0; i < len(runes); i++ {
+		if !isSentenceEnd(runes[i]) {
+			continue
+		}
+		// ASCIIの終止符は直後が空白の場合のみ文末とみなす（小数点・URL対策）
+		if (runes[i] == '.' || runes[i] == '!' || runes[i] == '?') && i+1 < len(runes) && !unicode.IsSpace(runes[i+1]) {
+			continue
+		}
+		end := i + 1
+		for end < len(runes) && unicode.IsSpace(runes[end]) {
+			end++
+		}
+		out = append(out, string(runes[start:end]))
+		start = end
+		i = end - 1
+	}
+	if start < len(runes) {
+		out = append(out, string(runes[start:]))
+	}
+	return out
+}
+
+func isSentenceEnd(r rune) bool {
+	switch r {
+	case '。', '．', '！', '？', '.', '!', '?', '\n':
+		return true
+	}
+	return false
+}
+
+// buildChunkPrompt constructs the map-phase prompt for one chunk.
+func buildChunkPrompt(req fetch.SummaryRequest, language string, charLimit, index, total int, chunk string) string {
+	title := req.Title
+	if title == "" {
+		title = "（タイトルなし）"
+	}
+	return fmt.Sprintf("以下は長い記事「%s」の一部（%d/%d）です。後で全体の要約にまとめるため、結論・数値・固有名詞などの重要な情報を漏らさず%sで%d文字以内で要約してください：\n%s",
+		title, index, total, language, charLimit, delimitUntrusted(chunk))
+}
+
+// combineChunkSummaries joins the partial summaries into the content of the final pass.
+func combineChunkSummaries(parts []string, bounded bool) string {
+	var b strings.Builder
+	for i, p := range parts {
+		if i > 0 {
+			b.WriteString("\n\n")
+		}
+		fmt.Fprintf(&b, "【パート%d】\n%s", i+1, strings.TrimSpace(p))
+	}
+	if bounded {
+		b.WriteString("\n\n(記事が長いため一部のパートは省略されました)")
+	}
+	return b.String()
+}
+
+// mapReduceContent returns the content for the final summarization prompt.
+// Short content is returned unchanged. Long content is split into chunks
+// (bounded by cfg), each chunk is summarized with call, and the partial
+// summaries are combined so that the final pass sees the whole article,
+// including its conclusion, instead of a truncated prefix.
+func mapReduceContent(
+	ctx context.Context,
+	cfg LongDocumentConfig,
+	req fetch.SummaryRequest,
+	language string,
+	charLimit int,
+	metrics SummaryMetricsRecorder,
+	call func(ctx context.Context, prompt string) (string, error),
+) (string, error) {
+	if !cfg.isLong(req.Content) {
+		return req.Content, nil
+	}
+	cfg = cfg.withDefaults()
+	plan := cfg.planChunks(req.Content, charLimit)
+
+	slog.InfoContext(ctx, "Summarizing long document in chunks",
+		slog.String("url", req.URL),
+		slog.Int("input_length", utf8.RuneCountInString(req.Content)),
+		slog.Int("chunks", len(plan.chunks)),
+		slog.Int("total_chunks", plan.total))
+	if plan.bounded() {
+		slog.WarnContext(ctx, "Long document exceeds chunk bounds, some chunks skipped",
+			slog.String("url", req.URL),
+			slog.Int("max_chunks", cfg.MaxChunks),
+			slog.Int("token_budget", cfg.TokenBudget),
+			slog.Int("skipped", plan.total-len(plan.chunks)))
+	}
+	metrics.RecordLongDocument(len(plan.chunks), plan.bounded())
+
+	parts := make([]string, len(plan.chunks))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(cfg.Parallelism)
+	for i, chunk := range plan.chunks {
+		g.Go(func() error {
+			prompt := buildChunkPrompt(req, language, charLimit, i+1, len(plan.chunks), chunk)
+			part, err := call(gctx, prompt)
+			if err != nil {
+				return fmt.Errorf("summarize chunk %d/%d: %w", i+1, len(plan.chunks), err)
+			}
+			parts[i] = part
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return "", err
+	}
+
+	return combineChunkSummaries(parts, plan.bounded()), nil
+}
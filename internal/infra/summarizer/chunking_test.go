@@ -0,0 +1,183 @@
+package summarizer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"catchup-feed/internal/usecase/fetch"
+)
+
+func TestSplitIntoChunks_ParagraphBoundaries(t *testing.T) {
+	paras := []string{
+		strings.Repeat("あ", 40),
+		strings.Repeat("い", 40),
+		strings.Repeat("う", 40),
+	}
+	chunks := splitIntoChunks(strings.Join(paras, "\n\n"), 90)
+
+	require.Len(t, chunks, 2)
+	assert.Equal(t, paras[0]+"\n\n"+paras[1], chunks[0])
+	assert.Equal(t, paras[2], chunks[1])
+}
+
+func TestSplitIntoChunks_SentenceBoundaries(t *testing.T) {
+	sentence := strings.Repeat("字", 29) + "。"
+	text := strings.Repeat(sentence, 5) // 150 runes, one paragraph
+
+	chunks := splitIntoChunks(text, 70)
+
+	require.Len(t, chunks, 3)
+	for _, c := range chunks {
+		assert.LessOrEqual(t, utf8.RuneCountInString(c), 70)
+		assert.True(t, strings.HasSuffix(c, "。"), "chunk must end at a sentence boundary: %q", c)
+	}
+	assert.Equal(t, text, strings.Join(chunks, ""))
+}
+
+func TestSplitIntoChunks_HardSplit(t *testing.T) {
+	text := strings.Repeat("x", 250)
+	chunks := splitIntoChunks(text, 100)
+
+	require.Len(t, chunks, 3)
+	assert.Equal(t, 100, utf8.RuneCountInString(chunks[0]))
+	assert.Equal(t, 50, utf8.RuneCountInString(chunks[2]))
+}
+
+func TestSplitSentences_IgnoresDecimalPoints(t *testing.T) {
+	got := splitSentences("Go 1.24 is out. See go.dev for details! Done")
+	assert.Equal(t, []string{"Go 1.24 is out. ", "See go.dev for details! ", "Done"}, got)
+}
+
+func TestSelectChunks_KeepsFirstAndLast(t *testing.T) {
+	chunks := []string{"c0", "c1", "c2", "c3", "c4", "c5", "c6", "c7", "c8", "c9"}
+
+	assert.Equal(t, chunks, selectChunks(chunks, 10))
+	assert.Equal(t, []string{"c0", "c4", "c9"}, selectChunks(chunks, 3))
+	assert.Equal(t, []string{"c0", "c9"}, selectChunks(chunks, 2))
+	assert.Equal(t, []string{"c0"}, selectChunks(chunks, 1))
+}
+
+func TestEstimateTokens(t *testing.T) {
+	assert.Equal(t, 0, estimateTokens(""))
+	assert.Equal(t, 3, estimateTokens("日本語"))
+	assert.Equal(t, 2, estimateTokens("abcdefgh"))
+	assert.Equal(t, 3, estimateTokens("abcde日"))
+}
+
+func TestLongDocumentConfig_PlanChunks(t *testing.T) {
+	text := strings.TrimSpace(strings.Repeat(strings.Repeat("あ", 100)+"\n\n", 10))
+
+	t.Run("within bounds", func(t *testing.T) {
+		plan := LongDocumentConfig{ChunkSize: 100, MaxChunks: 20, TokenBudget: 1_000_000}.planChunks(text, 50)
+		assert.Len(t, plan.chunks, 10)
+		assert.False(t, plan.bounded())
+	})
+
+	t.Run("max chunks", func(t *testing.T) {
+		plan := LongDocumentConfig{ChunkSize: 100, MaxChunks: 4, TokenBudget: 1_000_000}.planChunks(text, 50)
+		assert.Len(t, plan.chunks, 4)
+		assert.Equal(t, 10, plan.total)
+		assert.True(t, plan.bounded())
+	})
+
+	t.Run("token budget", func(t *testing.T) {
+		// 1チャンクあたり 100 + 50 + 50 トークン、最終出力 50 トークン
+		plan := LongDocumentConfig{ChunkSize: 100, MaxChunks: 10, TokenBudget: 1000}.planChunks(text, 50)
+		assert.Len(t, plan.chunks, 4)
+		assert.LessOrEqual(t, estimatePlanTokens(plan.chunks, 50), 1000)
+		assert.True(t, plan.bounded())
+	})
+
+	t.Run("budget keeps beginning and end", func(t *testing.T) {
+		plan := LongDocumentConfig{ChunkSize: 100, MaxChunks: 10, TokenBudget: 1}.planChunks(text, 50)
+		assert.Len(t, plan.chunks, 2)
+	})
+}
+
+func TestLongDocumentConfig_Timeout(t *testing.T) {
+	cfg := LongDocumentConfig{ChunkSize: 100, MaxChunks: 5, Parallelism: 2}
+
+	assert.Equal(t, time.Minute, cfg.timeout(time.Minute, strings.Repeat("a", 100)))
+	// 3チャンク → 2ラウンド + 最終パス
+	assert.Equal(t, 3*time.Minute, cfg.timeout(time.Minute, strings.Repeat("a", 250)))
+	// MaxChunksで頭打ち: 5チャンク → 3ラウンド + 最終パス
+	assert.Equal(t, 4*time.Minute, cfg.timeout(time.Minute, strings.Repeat("a", 5000)))
+}
+
+func TestLoadLongDocumentConfig(t *testing.T) {
+	t.Setenv("SUMMARIZER_CHUNK_SIZE", "3000")
+	t.Setenv("SUMMARIZER_MAX_CHUNKS", "abc")
+	t.Setenv("SUMMARIZER_TOKEN_BUDGET", "-1")
+
+	cfg := loadLongDocumentConfig()
+	assert.Equal(t, 3000, cfg.ChunkSize)
+	assert.Equal(t, defaultMaxChunks, cfg.MaxChunks)
+	assert.Equal(t, defaultTokenBudget, cfg.TokenBudget)
+}
+
+func TestMapReduceContent_ShortContentUnchanged(t *testing.T) {
+	rec := &MockMetricsRecorder{}
+	called := false
+	got, err := mapReduceContent(context.Background(), LongDocumentConfig{}, fetch.SummaryRequest{Content: "short"},
+		"日本語", 900, rec, func(context.Context, string) (string, error) {
+			called = true
+			return "", nil
+		})
+
+	require.NoError(t, err)
+	assert.Equal(t, "short", got)
+	assert.False(t, called)
+	assert.Empty(t, rec.RecordedLongDocs)
+}
+
+func TestMapReduceContent_ChunkError(t *testing.T) {
+	rec := &MockMetricsRecorder{}
+	_, err := mapReduceContent(context.Background(), LongDocumentConfig{ChunkSize: 10, Parallelism: 1},
+		fetch.SummaryRequest{Content: strings.Repeat("a", 30)}, "日本語", 100, rec,
+		func(context.Context, string) (string, error) {
+			return "", errors.New("api down")
+		})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "summarize chunk 1/3")
+}
+
+func TestClaude_SummarizeArticle_LongDocument(t *testing.T) {
+	stub := &claudeStub{replies: []string{"前半の要約", "中盤の要約", "結論の要約", "最終要約"}}
+	srv := httptest.NewServer(http.HandlerFunc(stub.handler))
+	defer srv.Close()
+
+	cfg := testClaudeConfig(false)
+	cfg.LongDocument = LongDocumentConfig{ChunkSize: 100, MaxChunks: 3, Parallelism: 1}
+	c, rec := newTestClaude(t, srv.URL, cfg)
+
+	content := strings.Join([]string{
+		strings.Repeat("序", 90),
+		strings.Repeat("本", 90),
+		strings.Repeat("飛", 90),
+		strings.Repeat("結", 90),
+	}, "\n\n")
+	res, err := c.SummarizeArticle(context.Background(), fetch.SummaryRequest{Title: "長い記事", Content: content})
+	require.NoError(t, err)
+
+	assert.Equal(t, "最終要約", res.Summary)
+	require.Len(t, stub.prompts, 4)
+	assert.Contains(t, stub.prompts[0], "「長い記事」の一部（1/3）")
+	assert.Contains(t, stub.prompts[2], strings.Repeat("結", 90), "the conclusion must be summarized")
+	assert.NotContains(t, stub.prompts[3], "切り詰めました")
+	assert.Contains(t, stub.prompts[3], "【パート3】\n結論の要約")
+	assert.Contains(t, stub.prompts[3], "一部のパートは省略されました")
+
+	assert.Equal(t, []int{3}, rec.RecordedLongDocs)
+	assert.Equal(t, []bool{true}, rec.RecordedBounded)
+	assert.Equal(t, []int{4}, rec.RecordedLengths, "only the final summary is length-checked")
+}
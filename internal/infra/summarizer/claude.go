@@ -20,6 +20,7 @@ import (
 
 	"catchup-feed/internal/resilience/circuitbreaker"
 	"catchup-feed/internal/resilience/retry"
+	"catchup-feed/internal/usecase/fetch"
 	"catchup-feed/internal/utils/text"
 )
 
@@ -43,6 +44,22 @@ type ClaudeConfig struct {
 
 	// Timeout is the maximum duration for a single summarization API call.
 	Timeout time.Duration
+
+	// Structured enables structured output (TL;DR, key points, tags, reading time).
+	// Loaded from SUMMARIZER_STRUCTURED environment variable. Default: false.
+	Structured bool
+
+	// LongDocument bounds map-reduce summarization of articles longer than one chunk.
+	LongDocument LongDocumentConfig
+
+	// LimitRetries is the number of times a summary over CharacterLimit is regenerated
+	// with a shortening prompt before it is trimmed at a sentence boundary.
+	// Loaded from SUMMARIZER_LIMIT_RETRIES environment variable. Valid range: 0-5. Default: 2.
+	LimitRetries int
+
+	// BaseURL overrides the Anthropic API endpoint (e.g. a local stub server in tests).
+	// Loaded from ANTHROPIC_BASE_URL environment variable. Default: the public API.
+	BaseURL string
 }
 
 // LoadClaudeConfig loads configuration from environment variables.
@@ -51,6 +68,10 @@ type ClaudeConfig struct {
 //
 // Environment variables:
 //   - SUMMARIZER_CHAR_LIMIT: Character limit (default: 900, range: 100-5000)
+//   - SUMMARIZER_STRUCTURED: Enable structured summaries (default: false)
+//   - SUMMARIZER_LIMIT_RETRIES: Shortening attempts for over-limit summaries (default: 2, range: 0-5)
+//   - SUMMARIZER_CHUNK_SIZE, SUMMARIZER_MAX_CHUNKS, SUMMARIZER_TOKEN_BUDGET: long-document bounds
+//   - ANTHROPIC_BASE_URL: API endpoint override (default: the public API)
 //
 // Returns ClaudeConfig with validated settings.
 func LoadClaudeConfig() ClaudeConfig {
@@ -86,6 +107,10 @@ func LoadClaudeConfig() ClaudeConfig {
 		Model:          string(anthropic.ModelClaudeSonnet4_5_20250929),
 		MaxTokens:      1024,
 		Timeout:        60 * time.Second,
+		Structured:     loadStructuredEnabled(),
+		LongDocument:   loadLongDocumentConfig(),
+		LimitRetries:   loadLimitRetries(),
+		BaseURL:        os.Getenv("ANTHROPIC_BASE_URL"),
 	}
 }
 
@@ -98,6 +123,7 @@ type Claude struct {
 	retryConfig     retry.Config
 	config          ClaudeConfig
 	metricsRecorder SummaryMetricsRecorder
+	templates       *PromptTemplates
 }
 
 // NewClaude creates a new Claude summarizer with the given API key.
@@ -109,10 +135,17 @@ func NewClaude(apiKey string) *Claude {
 	slog.Info("Initialized Claude summarizer with configuration",
 		slog.Int("character_limit", config.CharacterLimit),
 		slog.String("language", config.Language),
-		slog.String("model", config.Model))
+		slog.String("model", config.Model),
+		slog.Bool("structured", config.Structured),
+		slog.String("base_url", config.BaseURL))
+
+	opts := []option.RequestOption{option.WithAPIKey(apiKey)}
+	if config.BaseURL != "" {
+		opts = append(opts, option.WithBaseURL(config.BaseURL))
+	}
 
 	return &Claude{
-		client:          anthropic.NewClient(option.WithAPIKey(apiKey)),
+		client:          anthropic.NewClient(opts...),
 		circuitBreaker:  circuitbreaker.New(circuitbreaker.ClaudeAPIConfig()),
 		retryConfig:     retry.AIAPIConfig(),
 		config:          config,
@@ -123,18 +156,137 @@ func NewClaude(apiKey string) *Claude {
 // Summarize generates a summary of the given text using Claude AI.
 // It uses circuit breaker and retry logic for improved reliability.
 // Returns the summarized text in Japanese.
+// The text is handled as untrusted content, as in SummarizeArticle.
 func (c *Claude) Summarize(ctx context.Context, text string) (string, error) {
-	// Set individual timeout (60 seconds)
-	ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+	req, inputFlags := guardRequest(ctx, fetch.SummaryRequest{Content: text}, c.metricsRecorder)
+
+	// Set individual timeout (60 seconds, extended per chunk round for long documents)
+	ctx, cancel := context.WithTimeout(ctx, c.config.LongDocument.timeout(60*time.Second, req.Content))
+	defer cancel()
+
+	requestID := uuid.New().String()
+	content, err := c.prepareContent(ctx, requestID, req)
+	if err != nil {
+		return "", err
+	}
+	result, err := c.summarizePlain(ctx, requestID, req, content)
+	if err != nil {
+		return "", err
+	}
+	guardResult(ctx, req.URL, result, req.Content, inputFlags, c.metricsRecorder)
+	return result.Summary, nil
+}
+
+// SetPromptTemplates sets the prompt templates used to build summarization prompts.
+// Without templates, the built-in default prompt is used.
+func (c *Claude) SetPromptTemplates(templates *PromptTemplates) {
+	c.templates = templates
+}
+
+// SummarizeArticle implements fetch.ArticleSummarizer.
+// In structured mode (SUMMARIZER_STRUCTURED=true) the model is asked for a JSON object
+// with TL;DR, key points, tags and reading time in addition to the prose summary.
+// Malformed JSON falls back to a plain Summarize call so an article is never lost
+// because of an output-format problem.
+// Long articles are condensed chunk by chunk before the final pass (see LongDocumentConfig).
+// The title and content are treated as untrusted: injection patterns are stripped before
+// the call and the output is checked for anomalies (see injection.go).
+func (c *Claude) SummarizeArticle(ctx context.Context, req fetch.SummaryRequest) (*fetch.SummaryResult, error) {
+	req, inputFlags := guardRequest(ctx, req, c.metricsRecorder)
+	result, err := c.summarizeArticle(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	guardResult(ctx, req.URL, result, req.Content, inputFlags, c.metricsRecorder)
+	return result, nil
+}
+
+// summarizeArticle summarizes a sanitized request in plain or structured mode.
+func (c *Claude) summarizeArticle(ctx context.Context, req fetch.SummaryRequest) (*fetch.SummaryResult, error) {
+	// Set individual timeout (60 seconds, extended per chunk round for long documents)
+	ctx, cancel := context.WithTimeout(ctx, c.config.LongDocument.timeout(60*time.Second, req.Content))
 	defer cancel()
 
+	requestID := uuid.New().String()
+	content, err := c.prepareContent(ctx, requestID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.config.Structured {
+		return c.summarizePlain(ctx, requestID, req, content)
+	}
+
+	base, version, err := c.buildPrompt(req, content)
+	if err != nil {
+		return nil, err
+	}
+	prompt := buildStructuredPrompt(base, c.config.CharacterLimit)
+
+	raw, err := c.execute(ctx, func() (string, error) {
+		return c.complete(ctx, requestID, prompt, text.CountRunes(content))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	summary, structured, err := ParseStructuredSummary(raw, req.Content)
+	if err != nil {
+		slog.WarnContext(ctx, "Structured summary malformed, falling back to plain summary",
+			slog.String("request_id", requestID),
+			slog.String("url", req.URL),
+			slog.String("error", err.Error()))
+		c.metricsRecorder.RecordStructuredResult(StructuredResultMalformed)
+		return c.summarizePlain(ctx, requestID, req, content)
+	}
+
+	if structured == nil {
+		c.metricsRecorder.RecordStructuredResult(StructuredResultInvalid)
+	} else {
+		c.metricsRecorder.RecordStructuredResult(StructuredResultValid)
+	}
+	summary = c.finalizeSummary(ctx, requestID, summary)
+
+	return &fetch.SummaryResult{Summary: summary, Structured: structured, PromptVersion: version, Model: c.config.Model}, nil
+}
+
+// prepareContent returns the content for the final summarization prompt.
+// Articles longer than one chunk are condensed by summarizing each chunk first.
+func (c *Claude) prepareContent(ctx context.Context, requestID string, req fetch.SummaryRequest) (string, error) {
+	return mapReduceContent(ctx, c.config.LongDocument, req, c.config.Language, c.config.CharacterLimit, c.metricsRecorder,
+		func(ctx context.Context, prompt string) (string, error) {
+			return c.execute(ctx, func() (string, error) {
+				return c.complete(ctx, requestID, prompt, text.CountRunes(prompt))
+			})
+		})
+}
+
+// summarizePlain renders the prompt for req with content and requests a prose
+// summary with retry and circuit breaker.
+func (c *Claude) summarizePlain(ctx context.Context, requestID string, req fetch.SummaryRequest, content string) (*fetch.SummaryResult, error) {
+	prompt, version, err := c.buildPrompt(req, content)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := c.execute(ctx, func() (string, error) {
+		return c.complete(ctx, requestID, prompt, text.CountRunes(content))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &fetch.SummaryResult{Summary: c.finalizeSummary(ctx, requestID, summary), PromptVersion: version, Model: c.config.Model}, nil
+}
+
+// execute runs fn with retry logic through the circuit breaker.
+func (c *Claude) execute(ctx context.Context, fn func() (string, error)) (string, error) {
 	var result string
 
 	// Wrap with retry logic
 	retryErr := retry.WithBackoff(ctx, c.retryConfig, func() error {
 		// Execute through circuit breaker
 		cbResult, err := c.circuitBreaker.Execute(func() (interface{}, error) {
-			return c.doSummarize(ctx, text)
+			return fn()
 		})
 
 		// Handle circuit breaker open state
@@ -159,39 +311,46 @@ func (c *Claude) Summarize(ctx context.Context, text string) (string, error) {
 	return result, nil
 }
 
-// buildPrompt constructs the summarization prompt using configured parameters.
-// It instructs the AI to generate a summary in the target language within the character limit.
+// buildPrompt renders the prompt template selected by req.Template with the
+// article metadata, the configured language and character limit, and text as content.
+// The content is wrapped in the untrusted-content block (see delimitUntrusted).
+// It returns the prompt together with the template version.
 //
-// Example output:
+// Example output of the default template:
 //
-//	"以下のテキストを日本語で900文字以内で要約してください：\n{text}"
-func (c *Claude) buildPrompt(text string) string {
-	return fmt.Sprintf("以下のテキストを%sで%d文字以内で要約してください：\n%s",
-		c.config.Language, c.config.CharacterLimit, text)
-}
-
-// doSummarize performs the actual API call without retry or circuit breaker.
-// It includes comprehensive structured logging and metrics recording for observability.
-func (c *Claude) doSummarize(ctx context.Context, inputText string) (string, error) {
-	// Generate unique request ID for tracing
-	requestID := uuid.New().String()
-
-	// Truncate text to avoid token limit (safety measure, even though Claude supports 200k tokens)
-	// Safe limit: ~10,000 chars to maintain consistency with OpenAI implementation
-	const maxChars = 10000
-	truncatedText := inputText
-	if len(inputText) > maxChars {
-		truncatedText = inputText[:maxChars] + "...\n(内容が長いため切り詰めました)"
-		slog.Warn("text truncated for claude api",
-			slog.String("request_id", requestID),
-			slog.Int("original_length", len(inputText)),
-			slog.Int("truncated_length", len(truncatedText)))
+//	"以下のテキストを日本語で900文字以内で要約してください：\n<article_content>\n{text}\n</article_content>"
+func (c *Claude) buildPrompt(req fetch.SummaryRequest, text string) (string, string, error) {
+	templates := c.templates
+	if templates == nil {
+		templates = DefaultPromptTemplates()
 	}
+	return templates.Render(req.Template, PromptData{
+		Title:      req.Title,
+		SourceName: req.SourceName,
+		Language:   c.config.Language,
+		CharLimit:  c.config.CharacterLimit,
+		Content:    delimitUntrusted(text),
+	})
+}
 
-	// Build prompt with configured character limit
-	prompt := c.buildPrompt(truncatedText)
-	inputLength := text.CountRunes(truncatedText)
+// finalizeSummary enforces the character limit on a generated summary and records
+// the length and compliance metrics. Over-limit summaries are regenerated with a
+// shortening prompt up to LimitRetries times and then trimmed at a sentence boundary.
+func (c *Claude) finalizeSummary(ctx context.Context, requestID, summary string) string {
+	res := enforceCharLimit(ctx, summary, c.config.CharacterLimit, c.config.LimitRetries, c.config.Language,
+		func(ctx context.Context, prompt string) (string, error) {
+			return c.execute(ctx, func() (string, error) {
+				return c.complete(ctx, requestID, prompt, text.CountRunes(prompt))
+			})
+		})
+	c.recordSummaryLength(ctx, requestID, res)
+	return res.summary
+}
 
+// complete sends a single-turn prompt to the Claude API, with the injection guard as
+// system prompt, and returns the text of the reply.
+// It logs the call and records the duration metric.
+func (c *Claude) complete(ctx context.Context, requestID, prompt string, inputLength int) (string, error) {
 	// Log summarization start
 	slog.InfoContext(ctx, "Starting summarization",
 		slog.String("request_id", requestID),
@@ -205,6 +364,7 @@ func (c *Claude) doSummarize(ctx context.Context, inputText string) (string, err
 	message, err := c.client.Messages.New(ctx, anthropic.MessageNewParams{
 		Model:     anthropic.Model(c.config.Model),
 		MaxTokens: int64(c.config.MaxTokens),
+		System:    []anthropic.TextBlockParam{{Text: injectionGuardSystemPrompt}},
 		Messages: []anthropic.MessageParam{
 			anthropic.NewUserMessage(
 				anthropic.NewTextBlock(prompt),
@@ -239,35 +399,40 @@ func (c *Claude) doSummarize(ctx context.Context, inputText string) (string, err
 		return "", fmt.Errorf("claude api returned unexpected response type")
 	}
 
-	summary := textBlock.Text
-	summaryLength := text.CountRunes(summary)
-	withinLimit := summaryLength <= c.config.CharacterLimit
+	c.metricsRecorder.RecordDuration(duration)
+	slog.InfoContext(ctx, "Summarization completed",
+		slog.String("request_id", requestID),
+		slog.Duration("duration", duration))
+
+	return textBlock.Text, nil
+}
+
+// recordSummaryLength logs the outcome of limit enforcement and records the metrics:
+// the stored length, the limit-exceeded counter when the first summary was too long,
+// and compliance, which is false only when the summary had to be trimmed.
+func (c *Claude) recordSummaryLength(ctx context.Context, requestID string, res limitResult) {
+	summaryLength := text.CountRunes(res.summary)
+	withinLimit := !res.trimmed
 
 	// Log summary result
-	slog.InfoContext(ctx, "Summarization completed",
+	slog.InfoContext(ctx, "Summary length checked",
 		slog.String("request_id", requestID),
 		slog.Int("summary_length", summaryLength),
 		slog.Int("character_limit", c.config.CharacterLimit),
-		slog.Bool("within_limit", withinLimit),
-		slog.Duration("duration", duration))
+		slog.Bool("within_limit", withinLimit))
 
-	// Log warning if limit exceeded (should be rare)
-	if !withinLimit {
-		excess := summaryLength - c.config.CharacterLimit
-		slog.WarnContext(ctx, "Summary exceeds character limit",
+	// Log warning if the first summary exceeded the limit
+	if res.exceeded(c.config.CharacterLimit) {
+		slog.WarnContext(ctx, "Summary exceeded character limit",
 			slog.String("request_id", requestID),
-			slog.Int("summary_length", summaryLength),
+			slog.Int("original_length", res.originalLength),
 			slog.Int("limit", c.config.CharacterLimit),
-			slog.Int("excess", excess))
+			slog.Int("regenerations", res.regenerations),
+			slog.Bool("trimmed", res.trimmed))
+		c.metricsRecorder.RecordLimitExceeded()
 	}
 
 	// Record metrics
 	c.metricsRecorder.RecordLength(summaryLength)
-	c.metricsRecorder.RecordDuration(duration)
 	c.metricsRecorder.RecordCompliance(withinLimit)
-	if !withinLimit {
-		c.metricsRecorder.RecordLimitExceeded()
-	}
-
-	return summary, nil
 }
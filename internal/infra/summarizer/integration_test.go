@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"catchup-feed/internal/usecase/fetch"
 )
 
 /* ───────── Integration Tests with Mock Metrics ───────── */
@@ -64,7 +67,8 @@ func TestOpenAI_BuildPromptIntegration(t *testing.T) {
 	}
 
 	text := "テスト用のテキストです。"
-	prompt := openai.buildPrompt(text)
+	prompt, _, err := openai.buildPrompt(fetch.SummaryRequest{}, text)
+	require.NoError(t, err)
 
 	// Verify prompt format
 	assert.Contains(t, prompt, "日本語")
@@ -88,7 +92,8 @@ func TestClaude_BuildPromptIntegration(t *testing.T) {
 	}
 
 	text := "テスト用のテキストです。"
-	prompt := claude.buildPrompt(text)
+	prompt, _, err := claude.buildPrompt(fetch.SummaryRequest{}, text)
+	require.NoError(t, err)
 
 	// Verify prompt format
 	assert.Contains(t, prompt, "japanese")
@@ -112,7 +117,8 @@ func TestOpenAI_CharacterLimitInPrompt(t *testing.T) {
 			}
 
 			openai := &OpenAI{config: config}
-			prompt := openai.buildPrompt("テスト")
+			prompt, _, err := openai.buildPrompt(fetch.SummaryRequest{}, "テスト")
+			require.NoError(t, err)
 
 			// Verify character limit is in prompt
 			assert.Contains(t, prompt, "文字以内")
@@ -135,7 +141,8 @@ func TestClaude_CharacterLimitInPrompt(t *testing.T) {
 			}
 
 			claude := &Claude{config: config}
-			prompt := claude.buildPrompt("テスト")
+			prompt, _, err := claude.buildPrompt(fetch.SummaryRequest{}, "テスト")
+			require.NoError(t, err)
 
 			// Verify character limit is in prompt
 			assert.Contains(t, prompt, "文字以内")
@@ -0,0 +1,126 @@
+package summarizer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Bounds for SUMMARIZER_LIMIT_RETRIES.
+const (
+	defaultLimitRetries = 2
+	maxLimitRetries     = 5
+)
+
+// loadLimitRetries reads SUMMARIZER_LIMIT_RETRIES, the number of times an
+// over-limit summary is regenerated with a shortening prompt before it is trimmed.
+// Valid range: 0-5 (0 trims immediately). Invalid values fall back to the default (2).
+func loadLimitRetries() int {
+	v := os.Getenv("SUMMARIZER_LIMIT_RETRIES")
+	if v == "" {
+		return defaultLimitRetries
+	}
+	parsed, err := strconv.Atoi(v)
+	if err != nil || parsed < 0 || parsed > maxLimitRetries {
+		slog.Warn("Invalid SUMMARIZER_LIMIT_RETRIES, using default",
+			slog.String("value", v),
+			slog.Int("max", maxLimitRetries),
+			slog.Int("default", defaultLimitRetries))
+		return defaultLimitRetries
+	}
+	return parsed
+}
+
+// limitResult is the outcome of enforcing the character limit on a summary.
+type limitResult struct {
+	// summary is the final summary, always within the limit.
+	summary string
+	// originalLength is the length of the first generated summary in runes.
+	originalLength int
+	// regenerations is the number of shortening calls that were made.
+	regenerations int
+	// trimmed is true when the summary was cut at a sentence boundary because
+	// regeneration did not bring it within the limit.
+	trimmed bool
+}
+
+// exceeded reports whether the first generated summary was over the limit.
+func (r limitResult) exceeded(charLimit int) bool {
+	return r.originalLength > charLimit
+}
+
+// enforceCharLimit makes sure summary fits within charLimit runes.
+// An over-limit summary is rewritten with buildShortenPrompt up to retries times
+// using shorten; if it is still too long, or a shortening call fails, it is
+// trimmed at a sentence boundary so that an over-limit summary is never stored.
+func enforceCharLimit(
+	ctx context.Context,
+	summary string,
+	charLimit, retries int,
+	language string,
+	shorten func(ctx context.Context, prompt string) (string, error),
+) limitResult {
+	res := limitResult{summary: summary, originalLength: utf8.RuneCountInString(summary)}
+
+	for res.regenerations < retries && utf8.RuneCountInString(res.summary) > charLimit {
+		res.regenerations++
+		prompt := buildShortenPrompt(res.summary, language, charLimit, res.regenerations)
+		shorter, err := shorten(ctx, prompt)
+		if err != nil {
+			slog.WarnContext(ctx, "Summary shortening failed, trimming instead",
+				slog.Int("attempt", res.regenerations),
+				slog.String("error", err.Error()))
+			break
+		}
+		if shorter = strings.TrimSpace(shorter); shorter != "" {
+			res.summary = shorter
+		}
+	}
+
+	if utf8.RuneCountInString(res.summary) > charLimit {
+		res.summary = trimAtSentence(res.summary, charLimit)
+		res.trimmed = true
+	}
+	return res
+}
+
+// buildShortenPrompt constructs the prompt that asks the model to rewrite an
+// over-limit summary. Each attempt targets a shorter length to leave more headroom.
+func buildShortenPrompt(summary, language string, charLimit, attempt int) string {
+	target := charLimit * (10 - attempt) / 10
+	if target < charLimit/2 {
+		target = charLimit / 2
+	}
+	return fmt.Sprintf("次の要約は%d文字あり、上限の%d文字を超えています。重要な情報を残したまま、%sで%d文字以内に短く書き直してください。書き直した要約のみを出力してください：\n%s",
+		utf8.RuneCountInString(summary), charLimit, language, target, summary)
+}
+
+// trimAtSentence cuts s to at most limit runes, keeping whole sentences.
+// If even the first sentence is too long, it is cut and an ellipsis is appended.
+func trimAtSentence(s string, limit int) string {
+	if utf8.RuneCountInString(s) <= limit {
+		return s
+	}
+
+	var b strings.Builder
+	n := 0
+	for _, sentence := range splitSentences(s) {
+		l := utf8.RuneCountInString(sentence)
+		if n+l > limit {
+			break
+		}
+		b.WriteString(sentence)
+		n += l
+	}
+	if trimmed := strings.TrimSpace(b.String()); trimmed != "" {
+		return trimmed
+	}
+
+	// 先頭の文だけで上限を超える場合は文字数で切り詰める
+	runes := []rune(s)
+	return strings.TrimSpace(string(runes[:limit-1])) + "…"
+}
@@ -0,0 +1,184 @@
+package summarizer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"catchup-feed/internal/usecase/fetch"
+)
+
+func TestTrimAtSentence(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		limit int
+		want  string
+	}{
+		{"within limit", "短い要約。", 10, "短い要約。"},
+		{"keeps whole sentences", "一文目です。二文目です。三文目です。", 13, "一文目です。二文目です。"},
+		{"ascii sentences", "Go is fast. It is simple. It scales.", 26, "Go is fast. It is simple."},
+		{"first sentence too long", strings.Repeat("あ", 20) + "。", 10, strings.Repeat("あ", 9) + "…"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := trimAtSentence(tt.input, tt.limit)
+			assert.Equal(t, tt.want, got)
+			assert.LessOrEqual(t, utf8.RuneCountInString(got), tt.limit)
+		})
+	}
+}
+
+func TestBuildShortenPrompt(t *testing.T) {
+	summary := strings.Repeat("字", 120)
+
+	first := buildShortenPrompt(summary, "日本語", 100, 1)
+	assert.Contains(t, first, "120文字あり、上限の100文字を超えています")
+	assert.Contains(t, first, "日本語で90文字以内")
+	assert.True(t, strings.HasSuffix(first, "\n"+summary))
+
+	// 試行ごとに目標を厳しくするが、上限の半分より短くはしない
+	assert.Contains(t, buildShortenPrompt(summary, "日本語", 100, 2), "80文字以内")
+	assert.Contains(t, buildShortenPrompt(summary, "日本語", 100, 9), "50文字以内")
+}
+
+func TestEnforceCharLimit(t *testing.T) {
+	long := strings.Repeat("長い文です。", 30) // 180 runes
+
+	t.Run("within limit is unchanged", func(t *testing.T) {
+		res := enforceCharLimit(context.Background(), "短い要約", 100, 2, "日本語",
+			func(context.Context, string) (string, error) {
+				t.Fatal("shorten must not be called")
+				return "", nil
+			})
+		assert.Equal(t, "短い要約", res.summary)
+		assert.False(t, res.exceeded(100))
+		assert.Zero(t, res.regenerations)
+		assert.False(t, res.trimmed)
+	})
+
+	t.Run("regeneration succeeds", func(t *testing.T) {
+		var prompts []string
+		replies := []string{strings.Repeat("字", 110), "  短くした要約  "}
+		res := enforceCharLimit(context.Background(), long, 100, 3, "日本語",
+			func(_ context.Context, prompt string) (string, error) {
+				prompts = append(prompts, prompt)
+				reply := replies[0]
+				replies = replies[1:]
+				return reply, nil
+			})
+
+		assert.Equal(t, "短くした要約", res.summary)
+		assert.True(t, res.exceeded(100))
+		assert.Equal(t, 180, res.originalLength)
+		assert.Equal(t, 2, res.regenerations)
+		assert.False(t, res.trimmed)
+		require.Len(t, prompts, 2)
+		assert.Contains(t, prompts[1], strings.Repeat("字", 110), "each attempt shortens the previous output")
+	})
+
+	t.Run("retries exhausted trims at sentence boundary", func(t *testing.T) {
+		calls := 0
+		res := enforceCharLimit(context.Background(), long, 100, 2, "日本語",
+			func(context.Context, string) (string, error) {
+				calls++
+				return long, nil
+			})
+
+		assert.Equal(t, 2, calls)
+		assert.True(t, res.trimmed)
+		assert.Equal(t, strings.Repeat("長い文です。", 16), res.summary)
+	})
+
+	t.Run("shorten error trims", func(t *testing.T) {
+		calls := 0
+		res := enforceCharLimit(context.Background(), long, 100, 2, "日本語",
+			func(context.Context, string) (string, error) {
+				calls++
+				return "", errors.New("api down")
+			})
+
+		assert.Equal(t, 1, calls)
+		assert.True(t, res.trimmed)
+		assert.LessOrEqual(t, utf8.RuneCountInString(res.summary), 100)
+	})
+
+	t.Run("zero retries trims immediately", func(t *testing.T) {
+		res := enforceCharLimit(context.Background(), long, 100, 0, "日本語",
+			func(context.Context, string) (string, error) {
+				t.Fatal("shorten must not be called")
+				return "", nil
+			})
+		assert.True(t, res.trimmed)
+		assert.Zero(t, res.regenerations)
+	})
+}
+
+func TestLoadLimitRetries(t *testing.T) {
+	t.Setenv("SUMMARIZER_LIMIT_RETRIES", "")
+	assert.Equal(t, defaultLimitRetries, loadLimitRetries())
+
+	t.Setenv("SUMMARIZER_LIMIT_RETRIES", "0")
+	assert.Equal(t, 0, loadLimitRetries())
+
+	t.Setenv("SUMMARIZER_LIMIT_RETRIES", "4")
+	assert.Equal(t, 4, loadLimitRetries())
+
+	t.Setenv("SUMMARIZER_LIMIT_RETRIES", "6")
+	assert.Equal(t, defaultLimitRetries, loadLimitRetries())
+
+	t.Setenv("SUMMARIZER_LIMIT_RETRIES", "abc")
+	assert.Equal(t, defaultLimitRetries, loadLimitRetries())
+}
+
+func TestClaude_SummarizeArticle_RegeneratesOverLimit(t *testing.T) {
+	stub := &claudeStub{replies: []string{strings.Repeat("長", 150), "短い要約"}}
+	srv := httptest.NewServer(http.HandlerFunc(stub.handler))
+	defer srv.Close()
+
+	cfg := testClaudeConfig(false)
+	cfg.CharacterLimit = 100
+	cfg.LimitRetries = 2
+	c, rec := newTestClaude(t, srv.URL, cfg)
+
+	res, err := c.SummarizeArticle(context.Background(), fetch.SummaryRequest{Content: "本文"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "短い要約", res.Summary)
+	require.Len(t, stub.prompts, 2)
+	assert.Contains(t, stub.prompts[1], "上限の100文字を超えています")
+	assert.Equal(t, []int{4}, rec.RecordedLengths)
+	assert.Equal(t, 1, rec.RecordedExceeded)
+	assert.Equal(t, []bool{true}, rec.RecordedCompliance)
+}
+
+func TestClaude_SummarizeArticle_TrimsWhenRegenerationFails(t *testing.T) {
+	long := strings.Repeat("長い文です。", 30)
+	stub := &claudeStub{replies: []string{long, long}}
+	srv := httptest.NewServer(http.HandlerFunc(stub.handler))
+	defer srv.Close()
+
+	cfg := testClaudeConfig(true)
+	cfg.CharacterLimit = 100
+	cfg.LimitRetries = 1
+	c, rec := newTestClaude(t, srv.URL, cfg)
+	stub.replies[0] = `{"summary":"` + long + `","tldr":"一行","key_points":["a","b","c"],"tags":["go"],"reading_time_minutes":2}`
+
+	res, err := c.SummarizeArticle(context.Background(), fetch.SummaryRequest{Content: "本文"})
+	require.NoError(t, err)
+
+	assert.Equal(t, strings.Repeat("長い文です。", 16), res.Summary)
+	require.NotNil(t, res.Structured, "structured fields are kept when only the prose is shortened")
+	assert.Len(t, stub.prompts, 2)
+	assert.Equal(t, 1, rec.RecordedExceeded)
+	assert.Equal(t, []bool{false}, rec.RecordedCompliance)
+	assert.Equal(t, []int{96}, rec.RecordedLengths)
+}
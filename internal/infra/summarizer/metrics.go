@@ -1,6 +1,7 @@
 package summarizer
 
 import (
+	"strconv"
 	"sync"
 	"time"
 
@@ -40,17 +41,41 @@ type SummaryMetricsRecorder interface {
 	// RecordLength records the length of a generated summary in characters.
 	RecordLength(length int)
 
-	// RecordLimitExceeded increments the counter when a summary exceeds the configured character limit.
+	// RecordLimitExceeded increments the counter when a generated summary exceeds the
+	// configured character limit, before it is regenerated or trimmed.
 	RecordLimitExceeded()
 
-	// RecordCompliance records whether a summary is within the configured character limit.
+	// RecordCompliance records whether a summary was brought within the configured
+	// character limit by the model (first try or regeneration) rather than by trimming.
 	// This is used to calculate the compliance ratio gauge.
 	RecordCompliance(withinLimit bool)
 
 	// RecordDuration records the time taken to generate a summary.
 	RecordDuration(duration time.Duration)
+
+	// RecordStructuredResult records the outcome of a structured-mode summarization:
+	// "valid" (structured summary stored), "invalid" (prose kept, structured fields rejected)
+	// or "malformed" (response was not usable JSON, fell back to plain summary).
+	RecordStructuredResult(result string)
+
+	// RecordLongDocument records that an article was summarized in long-document
+	// (map-reduce) mode with the given number of chunk summaries. bounded is true
+	// when chunks were skipped to stay within the chunk count or token budget.
+	RecordLongDocument(chunks int, bounded bool)
+
+	// RecordInjectionDetection records a prompt-injection detection. stage is "input"
+	// (pattern stripped from the feed content) or "output" (anomaly removed from the
+	// summary) and flag is one of the entity.InjectionFlag* constants.
+	RecordInjectionDetection(stage, flag string)
 }
 
+// Structured summary outcomes for RecordStructuredResult.
+const (
+	StructuredResultValid     = "valid"
+	StructuredResultInvalid   = "invalid"
+	StructuredResultMalformed = "malformed"
+)
+
 // PrometheusSummaryMetrics implements SummaryMetricsRecorder using Prometheus metrics.
 // This is the production implementation that records metrics to Prometheus.
 type PrometheusSummaryMetrics struct {
@@ -58,6 +83,10 @@ type PrometheusSummaryMetrics struct {
 	exceededCounter   prometheus.Counter
 	complianceGauge   prometheus.Gauge
 	durationHistogram prometheus.Histogram
+	structuredCounter *prometheus.CounterVec
+	longDocCounter    *prometheus.CounterVec
+	chunksHistogram   prometheus.Histogram
+	injectionCounter  *prometheus.CounterVec
 }
 
 var (
@@ -102,6 +131,18 @@ func getOrCreateGauge(opts prometheus.GaugeOpts) prometheus.Gauge {
 	return g
 }
 
+// getOrCreateCounterVec gets an existing counter vector or creates a new one if it doesn't exist
+func getOrCreateCounterVec(opts prometheus.CounterOpts, labels []string) *prometheus.CounterVec {
+	c := prometheus.NewCounterVec(opts, labels)
+	if err := prometheus.Register(c); err != nil {
+		if are, ok := err.(prometheus.AlreadyRegisteredError); ok {
+			return are.ExistingCollector.(*prometheus.CounterVec)
+		}
+		return promauto.NewCounterVec(opts, labels)
+	}
+	return c
+}
+
 // NewPrometheusSummaryMetrics creates a new Prometheus-based metrics recorder.
 // It initializes and registers all required Prometheus metrics.
 // Uses singleton pattern to avoid duplicate metric registration in tests.
@@ -126,6 +167,23 @@ func NewPrometheusSummaryMetrics() *PrometheusSummaryMetrics {
 				Help:    "Time taken to generate a summary via AI API",
 				Buckets: prometheus.ExponentialBuckets(0.5, 2, 10),
 			}),
+			structuredCounter: getOrCreateCounterVec(prometheus.CounterOpts{
+				Name: "article_summary_structured_total",
+				Help: "Total number of structured-mode summaries by result (valid, invalid, malformed)",
+			}, []string{"result"}),
+			longDocCounter: getOrCreateCounterVec(prometheus.CounterOpts{
+				Name: "article_summary_long_document_total",
+				Help: "Total number of articles summarized in long-document (map-reduce) mode, by whether chunks were skipped",
+			}, []string{"bounded"}),
+			chunksHistogram: getOrCreateHistogram(prometheus.HistogramOpts{
+				Name:    "article_summary_chunks",
+				Help:    "Number of chunk summaries per long-document summarization",
+				Buckets: []float64{2, 3, 4, 6, 8, 12, 16},
+			}),
+			injectionCounter: getOrCreateCounterVec(prometheus.CounterOpts{
+				Name: "article_summary_injection_detections_total",
+				Help: "Total number of prompt-injection detections by stage (input, output) and flag",
+			}, []string{"stage", "flag"}),
 		}
 	})
 	return prometheusMetricsInstance
@@ -154,3 +212,19 @@ func (p *PrometheusSummaryMetrics) RecordCompliance(withinLimit bool) {
 func (p *PrometheusSummaryMetrics) RecordDuration(duration time.Duration) {
 	p.durationHistogram.Observe(duration.Seconds())
 }
+
+// RecordStructuredResult implements SummaryMetricsRecorder.RecordStructuredResult
+func (p *PrometheusSummaryMetrics) RecordStructuredResult(result string) {
+	p.structuredCounter.WithLabelValues(result).Inc()
+}
+
+// RecordLongDocument implements SummaryMetricsRecorder.RecordLongDocument
+func (p *PrometheusSummaryMetrics) RecordLongDocument(chunks int, bounded bool) {
+	p.longDocCounter.WithLabelValues(strconv.FormatBool(bounded)).Inc()
+	p.chunksHistogram.Observe(float64(chunks))
+}
+
+// RecordInjectionDetection implements SummaryMetricsRecorder.RecordInjectionDetection
+func (p *PrometheusSummaryMetrics) RecordInjectionDetection(stage, flag string) {
+	p.injectionCounter.WithLabelValues(stage, flag).Inc()
+}
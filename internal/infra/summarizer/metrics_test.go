@@ -180,6 +180,15 @@ func TestPrometheusSummaryMetrics_AllMethods(t *testing.T) {
 		metrics.RecordLimitExceeded()
 		metrics.RecordCompliance(false)
 		metrics.RecordDuration(2 * time.Second)
+
+		metrics.RecordStructuredResult(StructuredResultValid)
+		metrics.RecordStructuredResult(StructuredResultMalformed)
+
+		metrics.RecordLongDocument(3, false)
+		metrics.RecordLongDocument(8, true)
+
+		metrics.RecordInjectionDetection(InjectionStageInput, "instruction_override")
+		metrics.RecordInjectionDetection(InjectionStageOutput, "unknown_url")
 	})
 }
 
@@ -189,6 +198,10 @@ type MockMetricsRecorder struct {
 	RecordedExceeded   int
 	RecordedCompliance []bool
 	RecordedDurations  []time.Duration
+	RecordedStructured []string
+	RecordedLongDocs   []int
+	RecordedBounded    []bool
+	RecordedInjections []string
 }
 
 func (m *MockMetricsRecorder) RecordLength(length int) {
@@ -207,6 +220,19 @@ func (m *MockMetricsRecorder) RecordDuration(duration time.Duration) {
 	m.RecordedDurations = append(m.RecordedDurations, duration)
 }
 
+func (m *MockMetricsRecorder) RecordStructuredResult(result string) {
+	m.RecordedStructured = append(m.RecordedStructured, result)
+}
+
+func (m *MockMetricsRecorder) RecordLongDocument(chunks int, bounded bool) {
+	m.RecordedLongDocs = append(m.RecordedLongDocs, chunks)
+	m.RecordedBounded = append(m.RecordedBounded, bounded)
+}
+
+func (m *MockMetricsRecorder) RecordInjectionDetection(stage, flag string) {
+	m.RecordedInjections = append(m.RecordedInjections, stage+":"+flag)
+}
+
 func TestMockMetricsRecorder_ImplementsInterface(t *testing.T) {
 	mock := &MockMetricsRecorder{}
 
@@ -14,9 +14,14 @@ import (
 
 	"catchup-feed/internal/resilience/circuitbreaker"
 	"catchup-feed/internal/resilience/retry"
+	"catchup-feed/internal/usecase/fetch"
 	"catchup-feed/internal/utils/text"
 )
 
+// openAIChatModel is the chat model used for every OpenAI request.
+// It is also reported as SummaryResult.Model.
+const openAIChatModel = "gpt-3.5-turbo"
+
 // OpenAIConfig holds configuration parameters for the OpenAI summarizer.
 // Configuration is loaded from environment variables with fallback to defaults.
 type OpenAIConfig struct {
@@ -37,6 +42,18 @@ type OpenAIConfig struct {
 
 	// Timeout is the maximum duration for a single summarization API call.
 	Timeout time.Duration
+
+	// Structured enables structured output (TL;DR, key points, tags, reading time).
+	// Loaded from SUMMARIZER_STRUCTURED environment variable. Default: false.
+	Structured bool
+
+	// LongDocument bounds map-reduce summarization of articles longer than one chunk.
+	LongDocument LongDocumentConfig
+
+	// LimitRetries is the number of times a summary over CharacterLimit is regenerated
+	// with a shortening prompt before it is trimmed at a sentence boundary.
+	// Loaded from SUMMARIZER_LIMIT_RETRIES environment variable. Valid range: 0-5. Default: 2.
+	LimitRetries int
 }
 
 // GetCharacterLimit implements SummarizerConfig interface.
@@ -79,6 +96,8 @@ func (c *OpenAIConfig) Validate() error {
 //
 // Environment variables:
 //   - SUMMARIZER_CHAR_LIMIT: Character limit (default: 900, range: 100-5000)
+//   - SUMMARIZER_STRUCTURED: Enable structured summaries (default: false)
+//   - SUMMARIZER_CHUNK_SIZE, SUMMARIZER_MAX_CHUNKS, SUMMARIZER_TOKEN_BUDGET: long-document bounds
 //
 // Returns:
 //   - OpenAIConfig with validated settings
@@ -107,9 +126,12 @@ func LoadOpenAIConfig() (*OpenAIConfig, error) {
 	config := &OpenAIConfig{
 		CharacterLimit: charLimit,
 		Language:       "japanese",
-		Model:          "gpt-3.5-turbo",
+		Model:          openAIChatModel,
 		MaxTokens:      1024,
 		Timeout:        60 * time.Second,
+		Structured:     loadStructuredEnabled(),
+		LongDocument:   loadLongDocumentConfig(),
+		LimitRetries:   loadLimitRetries(),
 	}
 
 	// Validate the entire configuration
@@ -129,14 +151,29 @@ type OpenAI struct {
 	retryConfig     retry.Config
 	config          SummarizerConfig
 	metricsRecorder SummaryMetricsRecorder
+	structured      bool
+	longDoc         LongDocumentConfig
+	limitRetries    int
+	templates       *PromptTemplates
 }
 
 // NewOpenAI creates a new OpenAI summarizer with the given API key.
 // It automatically configures circuit breaker, retry logic, character limit configuration,
 // and metrics recording.
 func NewOpenAI(apiKey string, config SummarizerConfig) *OpenAI {
+	// 構造化出力・長文設定・再生成回数はOpenAIConfigでのみ設定可能
+	structured := false
+	var longDoc LongDocumentConfig
+	limitRetries := 0
+	if cfg, ok := config.(*OpenAIConfig); ok {
+		structured = cfg.Structured
+		longDoc = cfg.LongDocument
+		limitRetries = cfg.LimitRetries
+	}
+
 	slog.Info("Initialized OpenAI summarizer with configuration",
-		slog.Int("character_limit", config.GetCharacterLimit()))
+		slog.Int("character_limit", config.GetCharacterLimit()),
+		slog.Bool("structured", structured))
 
 	return &OpenAI{
 		client:          openai.NewClient(apiKey),
@@ -144,24 +181,139 @@ func NewOpenAI(apiKey string, config SummarizerConfig) *OpenAI {
 		retryConfig:     retry.AIAPIConfig(),
 		config:          config,
 		metricsRecorder: NewPrometheusSummaryMetrics(),
+		structured:      structured,
+		longDoc:         longDoc,
+		limitRetries:    limitRetries,
 	}
 }
 
 // Summarize generates a summary of the given text using OpenAI's GPT API.
 // It uses circuit breaker and retry logic for improved reliability.
 // Returns the summarized text in Japanese.
+// The text is handled as untrusted content, as in SummarizeArticle.
 func (o *OpenAI) Summarize(ctx context.Context, text string) (string, error) {
-	// Set individual timeout (60 seconds)
-	ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+	req, inputFlags := guardRequest(ctx, fetch.SummaryRequest{Content: text}, o.metricsRecorder)
+
+	// Set individual timeout (60 seconds, extended per chunk round for long documents)
+	ctx, cancel := context.WithTimeout(ctx, o.longDoc.timeout(60*time.Second, req.Content))
+	defer cancel()
+
+	content, err := o.prepareContent(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	result, err := o.summarizePlain(ctx, req, content)
+	if err != nil {
+		return "", err
+	}
+	guardResult(ctx, req.URL, result, req.Content, inputFlags, o.metricsRecorder)
+	return result.Summary, nil
+}
+
+// SetPromptTemplates sets the prompt templates used to build summarization prompts.
+// Without templates, the built-in default prompt is used.
+func (o *OpenAI) SetPromptTemplates(templates *PromptTemplates) {
+	o.templates = templates
+}
+
+// SummarizeArticle implements fetch.ArticleSummarizer.
+// Behaves like Claude.SummarizeArticle: structured JSON output when enabled,
+// falling back to a plain Summarize call on malformed JSON, and map-reduce
+// summarization for long articles, with the same prompt-injection hardening.
+func (o *OpenAI) SummarizeArticle(ctx context.Context, req fetch.SummaryRequest) (*fetch.SummaryResult, error) {
+	req, inputFlags := guardRequest(ctx, req, o.metricsRecorder)
+	result, err := o.summarizeArticle(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	guardResult(ctx, req.URL, result, req.Content, inputFlags, o.metricsRecorder)
+	return result, nil
+}
+
+// summarizeArticle summarizes a sanitized request in plain or structured mode.
+func (o *OpenAI) summarizeArticle(ctx context.Context, req fetch.SummaryRequest) (*fetch.SummaryResult, error) {
+	// Set individual timeout (60 seconds, extended per chunk round for long documents)
+	ctx, cancel := context.WithTimeout(ctx, o.longDoc.timeout(60*time.Second, req.Content))
 	defer cancel()
 
+	content, err := o.prepareContent(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !o.structured {
+		return o.summarizePlain(ctx, req, content)
+	}
+
+	base, version, err := o.buildPrompt(req, content)
+	if err != nil {
+		return nil, err
+	}
+	prompt := buildStructuredPrompt(base, o.config.GetCharacterLimit())
+
+	raw, err := o.execute(ctx, func() (string, error) {
+		return o.complete(ctx, prompt, text.CountRunes(content))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	summary, structured, err := ParseStructuredSummary(raw, req.Content)
+	if err != nil {
+		slog.WarnContext(ctx, "Structured summary malformed, falling back to plain summary",
+			slog.String("url", req.URL),
+			slog.String("error", err.Error()))
+		o.metricsRecorder.RecordStructuredResult(StructuredResultMalformed)
+		return o.summarizePlain(ctx, req, content)
+	}
+
+	if structured == nil {
+		o.metricsRecorder.RecordStructuredResult(StructuredResultInvalid)
+	} else {
+		o.metricsRecorder.RecordStructuredResult(StructuredResultValid)
+	}
+	summary = o.finalizeSummary(ctx, summary)
+
+	return &fetch.SummaryResult{Summary: summary, Structured: structured, PromptVersion: version, Model: openAIChatModel}, nil
+}
+
+// prepareContent returns the content for the final summarization prompt.
+// Articles longer than one chunk are condensed by summarizing each chunk first.
+func (o *OpenAI) prepareContent(ctx context.Context, req fetch.SummaryRequest) (string, error) {
+	return mapReduceContent(ctx, o.longDoc, req, "日本語", o.config.GetCharacterLimit(), o.metricsRecorder,
+		func(ctx context.Context, prompt string) (string, error) {
+			return o.execute(ctx, func() (string, error) {
+				return o.complete(ctx, prompt, text.CountRunes(prompt))
+			})
+		})
+}
+
+// summarizePlain renders the prompt for req with content and requests a prose
+// summary with retry and circuit breaker.
+func (o *OpenAI) summarizePlain(ctx context.Context, req fetch.SummaryRequest, content string) (*fetch.SummaryResult, error) {
+	prompt, version, err := o.buildPrompt(req, content)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := o.execute(ctx, func() (string, error) {
+		return o.complete(ctx, prompt, text.CountRunes(content))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &fetch.SummaryResult{Summary: o.finalizeSummary(ctx, summary), PromptVersion: version, Model: openAIChatModel}, nil
+}
+
+// execute runs fn with retry logic through the circuit breaker.
+func (o *OpenAI) execute(ctx context.Context, fn func() (string, error)) (string, error) {
 	var result string
 
 	// Wrap with retry logic
 	retryErr := retry.WithBackoff(ctx, o.retryConfig, func() error {
 		// Execute through circuit breaker
 		cbResult, err := o.circuitBreaker.Execute(func() (interface{}, error) {
-			return o.doSummarize(ctx, text)
+			return fn()
 		})
 
 		// Handle circuit breaker open state
@@ -186,35 +338,44 @@ func (o *OpenAI) Summarize(ctx context.Context, text string) (string, error) {
 	return result, nil
 }
 
-// buildPrompt constructs the summarization prompt using configured parameters.
-// It instructs the AI to generate a summary in Japanese within the character limit.
+// buildPrompt renders the prompt template selected by req.Template with the
+// article metadata, Japanese as the language, the configured character limit,
+// and text as content. It returns the prompt together with the template version.
 //
-// Example output:
+// Example output of the default template:
 //
-//	"以下のテキストを日本語で900文字以内で要約してください：\n{text}"
-func (o *OpenAI) buildPrompt(text string) string {
-	return fmt.Sprintf("以下のテキストを日本語で%d文字以内で要約してください：\n%s",
-		o.config.GetCharacterLimit(), text)
-}
-
-// doSummarize performs the actual API call without retry or circuit breaker.
-// It includes comprehensive structured logging and metrics recording for observability.
-func (o *OpenAI) doSummarize(ctx context.Context, inputText string) (string, error) {
-	// Truncate text to avoid token limit (gpt-3.5-turbo max: 16,385 tokens)
-	// Safe limit: ~10,000 chars (~2,500 tokens) to account for system prompt and response
-	const maxChars = 10000
-	truncatedText := inputText
-	if len(inputText) > maxChars {
-		truncatedText = inputText[:maxChars] + "...\n(内容が長いため切り詰めました)"
-		slog.Warn("text truncated for openai api",
-			slog.Int("original_length", len(inputText)),
-			slog.Int("truncated_length", len(truncatedText)))
+//	"以下のテキストを日本語で900文字以内で要約してください：\n<article_content>\n{text}\n</article_content>"
+func (o *OpenAI) buildPrompt(req fetch.SummaryRequest, text string) (string, string, error) {
+	templates := o.templates
+	if templates == nil {
+		templates = DefaultPromptTemplates()
 	}
+	return templates.Render(req.Template, PromptData{
+		Title:      req.Title,
+		SourceName: req.SourceName,
+		Language:   "日本語",
+		CharLimit:  o.config.GetCharacterLimit(),
+		Content:    delimitUntrusted(text),
+	})
+}
 
-	// Build prompt with configured character limit
-	prompt := o.buildPrompt(truncatedText)
-	inputLength := text.CountRunes(truncatedText)
+// finalizeSummary enforces the character limit on a generated summary and records
+// the length and compliance metrics (see Claude.finalizeSummary).
+func (o *OpenAI) finalizeSummary(ctx context.Context, summary string) string {
+	res := enforceCharLimit(ctx, summary, o.config.GetCharacterLimit(), o.limitRetries, "日本語",
+		func(ctx context.Context, prompt string) (string, error) {
+			return o.execute(ctx, func() (string, error) {
+				return o.complete(ctx, prompt, text.CountRunes(prompt))
+			})
+		})
+	o.recordSummaryLength(ctx, res)
+	return res.summary
+}
 
+// complete sends the prompt to the chat completion API as a user message, with the
+// injection guard as system message, and returns the reply text.
+// It logs the call and records the duration metric.
+func (o *OpenAI) complete(ctx context.Context, prompt string, inputLength int) (string, error) {
 	// Log summarization start
 	slog.InfoContext(ctx, "Starting summarization",
 		slog.Int("input_length", inputLength),
@@ -225,11 +386,11 @@ func (o *OpenAI) doSummarize(ctx context.Context, inputText string) (string, err
 
 	// Call OpenAI API
 	resp, err := o.client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
-		Model: "gpt-3.5-turbo",
-		Messages: []openai.ChatCompletionMessage{{
-			Role:    "system",
-			Content: prompt,
-		}},
+		Model: openAIChatModel,
+		Messages: []openai.ChatCompletionMessage{
+			{Role: "system", Content: injectionGuardSystemPrompt},
+			{Role: "user", Content: prompt},
+		},
 	})
 
 	duration := time.Since(start)
@@ -248,34 +409,36 @@ func (o *OpenAI) doSummarize(ctx context.Context, inputText string) (string, err
 		return "", fmt.Errorf("openai api returned empty response")
 	}
 
-	// Extract summary from response
-	summary := resp.Choices[0].Message.Content
-	summaryLength := text.CountRunes(summary)
-	withinLimit := summaryLength <= o.config.GetCharacterLimit()
+	o.metricsRecorder.RecordDuration(duration)
+	slog.InfoContext(ctx, "Summarization completed",
+		slog.Duration("duration", duration))
+
+	return resp.Choices[0].Message.Content, nil
+}
+
+// recordSummaryLength logs the outcome of limit enforcement and records the metrics.
+// Compliance is false only when the summary had to be trimmed.
+func (o *OpenAI) recordSummaryLength(ctx context.Context, res limitResult) {
+	summaryLength := text.CountRunes(res.summary)
+	withinLimit := !res.trimmed
 
 	// Log summary result
-	slog.InfoContext(ctx, "Summarization completed",
+	slog.InfoContext(ctx, "Summary length checked",
 		slog.Int("summary_length", summaryLength),
 		slog.Int("character_limit", o.config.GetCharacterLimit()),
-		slog.Bool("within_limit", withinLimit),
-		slog.Duration("duration", duration))
+		slog.Bool("within_limit", withinLimit))
 
-	// Log warning if limit exceeded (soft limit, not hard rejection)
-	if !withinLimit {
-		excess := summaryLength - o.config.GetCharacterLimit()
-		slog.WarnContext(ctx, "Summary exceeds character limit",
-			slog.Int("summary_length", summaryLength),
+	// Log warning if the first summary exceeded the limit
+	if res.exceeded(o.config.GetCharacterLimit()) {
+		slog.WarnContext(ctx, "Summary exceeded character limit",
+			slog.Int("original_length", res.originalLength),
 			slog.Int("limit", o.config.GetCharacterLimit()),
-			slog.Int("excess", excess))
+			slog.Int("regenerations", res.regenerations),
+			slog.Bool("trimmed", res.trimmed))
+		o.metricsRecorder.RecordLimitExceeded()
 	}
 
 	// Record metrics
 	o.metricsRecorder.RecordLength(summaryLength)
-	o.metricsRecorder.RecordDuration(duration)
 	o.metricsRecorder.RecordCompliance(withinLimit)
-	if !withinLimit {
-		o.metricsRecorder.RecordLimitExceeded()
-	}
-
-	return summary, nil
 }
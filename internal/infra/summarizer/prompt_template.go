@@ -0,0 +1,187 @@
+package summarizer
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// DefaultPromptTemplateName is the template used when a source does not select one
+// or selects a template that is not loaded.
+const DefaultPromptTemplateName = "default"
+
+// promptTemplateExt is the file extension of prompt template files in the config directory.
+const promptTemplateExt = ".tmpl"
+
+// defaultPromptTemplate reproduces the original hard-coded summarization prompt.
+const defaultPromptTemplate = "以下のテキストを{{.Language}}で{{.CharLimit}}文字以内で要約してください：\n{{.Content}}"
+
+// PromptData holds the variables available to prompt templates.
+//
+// Example template:
+//
+//	{{.SourceName}} のリリースノート「{{.Title}}」を{{.Language}}で{{.CharLimit}}文字以内で要約してください。
+//	{{.Content}}
+type PromptData struct {
+	Title      string
+	SourceName string
+	Language   string
+	CharLimit  int
+	Content    string
+}
+
+// PromptTemplate is a parsed prompt template with its content-derived version.
+type PromptTemplate struct {
+	// Name is the template name (file name without the .tmpl extension).
+	Name string
+
+	// Version identifies the template content as "<name>@<first 8 hex chars of sha256>".
+	// It is stored with each summary so the prompt that produced it can be identified.
+	Version string
+
+	tmpl *template.Template
+}
+
+// PromptTemplates is a set of named prompt templates.
+// It always contains a default template; a default.tmpl file in the config
+// directory overrides the built-in one.
+type PromptTemplates struct {
+	templates map[string]*PromptTemplate
+}
+
+// builtinPromptTemplates holds only the built-in default template.
+// It is used by summarizers that were not given a template set.
+var builtinPromptTemplates = mustBuiltinPromptTemplates()
+
+func mustBuiltinPromptTemplates() *PromptTemplates {
+	def, err := parsePromptTemplate(DefaultPromptTemplateName, defaultPromptTemplate)
+	if err != nil {
+		panic(fmt.Sprintf("parse built-in prompt template: %v", err))
+	}
+	return &PromptTemplates{templates: map[string]*PromptTemplate{DefaultPromptTemplateName: def}}
+}
+
+// DefaultPromptTemplates returns a template set containing only the built-in default template.
+func DefaultPromptTemplates() *PromptTemplates {
+	return builtinPromptTemplates
+}
+
+// LoadPromptTemplatesFromEnv loads prompt templates from the directory named by
+// SUMMARIZER_PROMPT_DIR. When the variable is unset, only the built-in default
+// template is available.
+func LoadPromptTemplatesFromEnv() (*PromptTemplates, error) {
+	dir := os.Getenv("SUMMARIZER_PROMPT_DIR")
+	if dir == "" {
+		return DefaultPromptTemplates(), nil
+	}
+	return LoadPromptTemplates(dir)
+}
+
+// LoadPromptTemplates loads every *.tmpl file in dir as a prompt template.
+// The template name is the file name without the extension and must satisfy
+// entity.ValidatePromptTemplateName. Each template is parsed and executed once
+// against sample data so that syntax errors and references to unknown fields
+// are reported at startup rather than during a crawl.
+func LoadPromptTemplates(dir string) (*PromptTemplates, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read prompt template dir: %w", err)
+	}
+
+	set := &PromptTemplates{templates: make(map[string]*PromptTemplate, len(entries)+1)}
+	set.templates[DefaultPromptTemplateName] = builtinPromptTemplates.templates[DefaultPromptTemplateName]
+
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != promptTemplateExt {
+			continue
+		}
+		name := strings.TrimSuffix(e.Name(), promptTemplateExt)
+		if err := entity.ValidatePromptTemplateName(name); err != nil {
+			return nil, fmt.Errorf("prompt template %q: %w", e.Name(), err)
+		}
+
+		content, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read prompt template %q: %w", e.Name(), err)
+		}
+		pt, err := parsePromptTemplate(name, string(content))
+		if err != nil {
+			return nil, err
+		}
+		set.templates[name] = pt
+	}
+
+	slog.Info("Loaded summarizer prompt templates",
+		slog.String("dir", dir),
+		slog.Any("templates", set.Names()))
+
+	return set, nil
+}
+
+// parsePromptTemplate parses and test-executes a single template.
+func parsePromptTemplate(name, content string) (*PromptTemplate, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("parse prompt template %q: %w", name, err)
+	}
+
+	sample := PromptData{Title: "title", SourceName: "source", Language: "日本語", CharLimit: 900, Content: "content"}
+	if err := tmpl.Execute(io.Discard, sample); err != nil {
+		return nil, fmt.Errorf("execute prompt template %q: %w", name, err)
+	}
+
+	sum := sha256.Sum256([]byte(content))
+	return &PromptTemplate{
+		Name:    name,
+		Version: name + "@" + hex.EncodeToString(sum[:])[:8],
+		tmpl:    tmpl,
+	}, nil
+}
+
+// Has reports whether a template with the given name is loaded.
+func (p *PromptTemplates) Has(name string) bool {
+	_, ok := p.templates[name]
+	return ok
+}
+
+// Names returns the loaded template names in sorted order.
+func (p *PromptTemplates) Names() []string {
+	names := make([]string, 0, len(p.templates))
+	for name := range p.templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Render executes the named template with data and returns the prompt and the
+// template version. An empty name selects the default template; an unknown name
+// falls back to the default template with a warning so that a misconfigured
+// source is still summarized.
+func (p *PromptTemplates) Render(name string, data PromptData) (prompt string, version string, err error) {
+	if name == "" {
+		name = DefaultPromptTemplateName
+	}
+	pt, ok := p.templates[name]
+	if !ok {
+		slog.Warn("Unknown prompt template, using default",
+			slog.String("template", name))
+		pt = p.templates[DefaultPromptTemplateName]
+	}
+
+	var buf bytes.Buffer
+	if err := pt.tmpl.Execute(&buf, data); err != nil {
+		return "", "", fmt.Errorf("render prompt template %q: %w", pt.Name, err)
+	}
+	return buf.String(), pt.Version, nil
+}
@@ -0,0 +1,163 @@
+package summarizer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"catchup-feed/internal/usecase/fetch"
+)
+
+func writeTemplate(t *testing.T, dir, name, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+}
+
+func TestDefaultPromptTemplates_MatchesLegacyPrompt(t *testing.T) {
+	prompt, version, err := DefaultPromptTemplates().Render("", PromptData{
+		Language: "日本語", CharLimit: 900, Content: "本文",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "以下のテキストを日本語で900文字以内で要約してください：\n本文", prompt)
+	assert.True(t, strings.HasPrefix(version, "default@"), version)
+	assert.Len(t, version, len("default@")+8)
+}
+
+func TestLoadPromptTemplates(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplate(t, dir, "release-notes.tmpl",
+		"{{.SourceName}}のリリースノート「{{.Title}}」を{{.Language}}で{{.CharLimit}}文字以内にまとめてください：\n{{.Content}}")
+	writeTemplate(t, dir, "README.md", "not a template")
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "sub.tmpl"), 0o700))
+
+	set, err := LoadPromptTemplates(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default", "release-notes"}, set.Names())
+
+	prompt, version, err := set.Render("release-notes", PromptData{
+		Title: "v1.2.0", SourceName: "Go", Language: "日本語", CharLimit: 300, Content: "changes",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Goのリリースノート「v1.2.0」を日本語で300文字以内にまとめてください：\nchanges", prompt)
+	assert.True(t, strings.HasPrefix(version, "release-notes@"), version)
+}
+
+func TestLoadPromptTemplates_OverrideDefault(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplate(t, dir, "default.tmpl", "Summarize in {{.Language}}:\n{{.Content}}")
+
+	set, err := LoadPromptTemplates(dir)
+	require.NoError(t, err)
+
+	prompt, version, err := set.Render("", PromptData{Language: "English", Content: "body"})
+	require.NoError(t, err)
+	assert.Equal(t, "Summarize in English:\nbody", prompt)
+
+	_, builtinVersion, err := DefaultPromptTemplates().Render("", PromptData{})
+	require.NoError(t, err)
+	assert.NotEqual(t, builtinVersion, version, "version must change with template content")
+}
+
+func TestLoadPromptTemplates_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		contains string
+	}{
+		{name: "syntax error", file: "broken.tmpl", content: "{{.Content", contains: "parse prompt template"},
+		{name: "unknown field", file: "typo.tmpl", content: "{{.Body}}", contains: "execute prompt template"},
+		{name: "invalid name", file: "Release Notes.tmpl", content: "{{.Content}}", contains: "prompt_template"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeTemplate(t, dir, tt.file, tt.content)
+
+			_, err := LoadPromptTemplates(dir)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.contains)
+		})
+	}
+}
+
+func TestLoadPromptTemplates_MissingDir(t *testing.T) {
+	_, err := LoadPromptTemplates(filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
+}
+
+func TestLoadPromptTemplatesFromEnv(t *testing.T) {
+	t.Run("unset uses built-in default", func(t *testing.T) {
+		t.Setenv("SUMMARIZER_PROMPT_DIR", "")
+		set, err := LoadPromptTemplatesFromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"default"}, set.Names())
+	})
+
+	t.Run("loads directory", func(t *testing.T) {
+		dir := t.TempDir()
+		writeTemplate(t, dir, "changelog.tmpl", "{{.Content}}")
+		t.Setenv("SUMMARIZER_PROMPT_DIR", dir)
+
+		set, err := LoadPromptTemplatesFromEnv()
+		require.NoError(t, err)
+		assert.True(t, set.Has("changelog"))
+	})
+}
+
+func TestPromptTemplates_UnknownFallsBackToDefault(t *testing.T) {
+	prompt, version, err := DefaultPromptTemplates().Render("missing", PromptData{
+		Language: "日本語", CharLimit: 100, Content: "本文",
+	})
+	require.NoError(t, err)
+	assert.Contains(t, prompt, "100文字以内")
+	assert.True(t, strings.HasPrefix(version, "default@"), version)
+}
+
+func TestClaude_SummarizeArticle_UsesSourceTemplate(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplate(t, dir, "release-notes.tmpl", "RELEASE {{.SourceName}} {{.Title}}\n{{.Content}}")
+	set, err := LoadPromptTemplates(dir)
+	require.NoError(t, err)
+
+	stub := &claudeStub{replies: []string{"summary"}}
+	srv := httptest.NewServer(http.HandlerFunc(stub.handler))
+	defer srv.Close()
+
+	c, _ := newTestClaude(t, srv.URL, testClaudeConfig(false))
+	c.SetPromptTemplates(set)
+
+	res, err := c.SummarizeArticle(context.Background(), fetch.SummaryRequest{
+		Title: "v2.0", SourceName: "Changelog", Content: "本文", Template: "release-notes",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, stub.prompts, 1)
+	assert.Equal(t, "RELEASE Changelog v2.0\n<article_content>\n本文\n</article_content>", stub.prompts[0])
+	assert.True(t, strings.HasPrefix(res.PromptVersion, "release-notes@"), res.PromptVersion)
+}
+
+func TestClaude_SummarizeArticle_StructuredRecordsVersion(t *testing.T) {
+	stub := &claudeStub{replies: []string{
+		`{"summary":"要約","tldr":"一行","key_points":["a","b","c"],"tags":[],"reading_time_minutes":1}`,
+	}}
+	srv := httptest.NewServer(http.HandlerFunc(stub.handler))
+	defer srv.Close()
+
+	c, _ := newTestClaude(t, srv.URL, testClaudeConfig(true))
+	res, err := c.SummarizeArticle(context.Background(), fetch.SummaryRequest{Content: "本文"})
+	require.NoError(t, err)
+
+	require.Len(t, stub.prompts, 1)
+	assert.True(t, strings.HasPrefix(stub.prompts[0], "以下のテキストを"), "structured prompt must start with the rendered template")
+	assert.True(t, strings.HasPrefix(res.PromptVersion, "default@"), res.PromptVersion)
+}
@@ -0,0 +1,128 @@
+package summarizer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/utils/text"
+)
+
+// ErrMalformedStructuredOutput is returned when the model response cannot be
+// decoded as the structured summary JSON object.
+var ErrMalformedStructuredOutput = errors.New("malformed structured summary output")
+
+// loadStructuredEnabled reads SUMMARIZER_STRUCTURED.
+// Structured output is disabled unless the variable parses as true.
+func loadStructuredEnabled() bool {
+	enabled, err := strconv.ParseBool(os.Getenv("SUMMARIZER_STRUCTURED"))
+	return err == nil && enabled
+}
+
+// structuredOutput is the JSON object the model is asked to return in structured mode.
+type structuredOutput struct {
+	Summary            string   `json:"summary"`
+	TLDR               string   `json:"tldr"`
+	KeyPoints          []string `json:"key_points"`
+	Tags               []string `json:"tags"`
+	ReadingTimeMinutes int      `json:"reading_time_minutes"`
+}
+
+// buildStructuredPrompt extends a rendered prompt template for structured mode.
+// The model is asked to follow the template's instructions but return a single
+// JSON object containing the prose summary (within the character limit) plus
+// TL;DR, key points, tags and reading time.
+func buildStructuredPrompt(prompt string, charLimit int) string {
+	return fmt.Sprintf(`%s
+
+上記の指示に従って要約し、次のキーを持つJSONオブジェクトのみを出力してください（コードブロックや説明文は不要です）：
+- "summary": %d文字以内の要約（文章）
+- "tldr": 1行の要約（%d文字以内）
+- "key_points": 重要なポイントを%d〜%d個の配列で
+- "tags": 内容を表す短いタグの配列（最大%d個）
+- "reading_time_minutes": 元記事の推定読了時間（分、整数）`,
+		prompt, charLimit, entity.MaxTLDRLength, entity.MinKeyPoints, entity.MaxKeyPoints, entity.MaxSummaryTags)
+}
+
+// ParseStructuredSummary decodes a structured-mode model response.
+// It tolerates Markdown code fences and surrounding prose, normalizes the fields
+// (trimming, de-duplicating tags, capping list sizes) and estimates the reading
+// time from source when the model omitted it.
+//
+// Returns:
+//   - summary: the prose summary to store in Article.Summary
+//   - structured: the validated structured summary, or nil if its fields are invalid
+//   - error: ErrMalformedStructuredOutput if the response is not usable JSON
+//     or has no prose summary
+func ParseStructuredSummary(raw string, source string) (string, *entity.StructuredSummary, error) {
+	body := extractJSONObject(raw)
+	if body == "" {
+		return "", nil, ErrMalformedStructuredOutput
+	}
+
+	var out structuredOutput
+	if err := json.Unmarshal([]byte(body), &out); err != nil {
+		return "", nil, fmt.Errorf("%w: %v", ErrMalformedStructuredOutput, err)
+	}
+
+	summary := strings.TrimSpace(out.Summary)
+	if summary == "" {
+		return "", nil, fmt.Errorf("%w: summary is empty", ErrMalformedStructuredOutput)
+	}
+
+	structured := &entity.StructuredSummary{
+		TLDR:               strings.Join(strings.Fields(out.TLDR), " "),
+		KeyPoints:          normalizeList(out.KeyPoints, entity.MaxKeyPoints, false),
+		Tags:               normalizeList(out.Tags, entity.MaxSummaryTags, true),
+		ReadingTimeMinutes: out.ReadingTimeMinutes,
+	}
+	if structured.ReadingTimeMinutes <= 0 {
+		structured.ReadingTimeMinutes = text.EstimateReadingMinutes(source)
+	}
+
+	if err := structured.Validate(); err != nil {
+		// 構造化部分が不正でも文章要約は利用できる
+		return summary, nil, nil
+	}
+	return summary, structured, nil
+}
+
+// extractJSONObject returns the outermost {...} block of s, or "" if none exists.
+// This strips code fences and any leading/trailing commentary from the model.
+func extractJSONObject(s string) string {
+	start := strings.Index(s, "{")
+	end := strings.LastIndex(s, "}")
+	if start < 0 || end <= start {
+		return ""
+	}
+	return s[start : end+1]
+}
+
+// normalizeList trims items, drops empty ones and caps the list at max entries.
+// When dedupe is true, case-insensitive duplicates are removed.
+func normalizeList(items []string, max int, dedupe bool) []string {
+	result := make([]string, 0, len(items))
+	seen := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if dedupe {
+			key := strings.ToLower(item)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+		}
+		result = append(result, item)
+		if len(result) == max {
+			break
+		}
+	}
+	return result
+}
@@ -0,0 +1,240 @@
+package summarizer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/anthropics/anthropic-sdk-go/option"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"catchup-feed/internal/resilience/circuitbreaker"
+	"catchup-feed/internal/resilience/retry"
+	"catchup-feed/internal/usecase/fetch"
+)
+
+/* ───────── ParseStructuredSummary ───────── */
+
+func TestParseStructuredSummary(t *testing.T) {
+	tests := []struct {
+		name           string
+		raw            string
+		wantSummary    string
+		wantStructured bool
+		wantErr        bool
+		check          func(t *testing.T, summary string)
+	}{
+		{
+			name:           "valid object",
+			raw:            `{"summary":"本文","tldr":"一行","key_points":["a","b","c"],"tags":["go"],"reading_time_minutes":3}`,
+			wantSummary:    "本文",
+			wantStructured: true,
+		},
+		{
+			name:           "code fence and commentary are stripped",
+			raw:            "以下がJSONです\n```json\n{\"summary\":\"s\",\"tldr\":\"t\",\"key_points\":[\"a\",\"b\",\"c\"],\"tags\":[],\"reading_time_minutes\":1}\n```",
+			wantSummary:    "s",
+			wantStructured: true,
+		},
+		{
+			name:           "too few key points keeps prose only",
+			raw:            `{"summary":"s","tldr":"t","key_points":["a"],"tags":["go"],"reading_time_minutes":1}`,
+			wantSummary:    "s",
+			wantStructured: false,
+		},
+		{
+			name:    "not json",
+			raw:     "これは普通の要約です",
+			wantErr: true,
+		},
+		{
+			name:    "broken json",
+			raw:     `{"summary": "s", "tldr": }`,
+			wantErr: true,
+		},
+		{
+			name:    "empty summary",
+			raw:     `{"summary":"","tldr":"t","key_points":["a","b","c"],"reading_time_minutes":1}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary, structured, err := ParseStructuredSummary(tt.raw, "source text")
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrMalformedStructuredOutput)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSummary, summary)
+			assert.Equal(t, tt.wantStructured, structured != nil)
+		})
+	}
+}
+
+func TestParseStructuredSummary_Normalization(t *testing.T) {
+	raw := `{"summary":"s","tldr":"multi\nline  tldr","key_points":[" a ","","b","c","d","e","f"],` +
+		`"tags":["Go","go"," Release ",""],"reading_time_minutes":0}`
+
+	_, structured, err := ParseStructuredSummary(raw, strings.Repeat("word ", 450))
+	require.NoError(t, err)
+	require.NotNil(t, structured)
+
+	assert.Equal(t, "multi line tldr", structured.TLDR)
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, structured.KeyPoints)
+	assert.Equal(t, []string{"Go", "Release"}, structured.Tags)
+	// reading time estimated from the source (450 words / 200 wpm → 3 min)
+	assert.Equal(t, 3, structured.ReadingTimeMinutes)
+}
+
+/* ───────── Claude.SummarizeArticle (stub server) ───────── */
+
+// claudeStub is a minimal Messages API stub that replies with queued texts.
+type claudeStub struct {
+	mu      sync.Mutex
+	replies []string
+	prompts []string
+	systems []string
+}
+
+func (s *claudeStub) handler(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		System []struct {
+			Text string `json:"text"`
+		} `json:"system"`
+		Messages []struct {
+			Content []struct {
+				Text string `json:"text"`
+			} `json:"content"`
+		} `json:"messages"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	s.mu.Lock()
+	if len(body.Messages) > 0 && len(body.Messages[0].Content) > 0 {
+		s.prompts = append(s.prompts, body.Messages[0].Content[0].Text)
+	}
+	if len(body.System) > 0 {
+		s.systems = append(s.systems, body.System[0].Text)
+	}
+	reply := "fallback"
+	if len(s.replies) > 0 {
+		reply, s.replies = s.replies[0], s.replies[1:]
+	}
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"id":            "msg_test",
+		"type":          "message",
+		"role":          "assistant",
+		"model":         "claude-test",
+		"stop_reason":   "end_turn",
+		"stop_sequence": nil,
+		"content":       []map[string]any{{"type": "text", "text": reply}},
+		"usage":         map[string]any{"input_tokens": 10, "output_tokens": 10},
+	})
+}
+
+// newTestClaude creates a Claude summarizer pointed at a stub server.
+func newTestClaude(t *testing.T, baseURL string, cfg ClaudeConfig) (*Claude, *MockMetricsRecorder) {
+	t.Helper()
+	rec := &MockMetricsRecorder{}
+	return &Claude{
+		client: anthropic.NewClient(
+			option.WithAPIKey("test-key"),
+			option.WithBaseURL(baseURL),
+			option.WithMaxRetries(0),
+		),
+		circuitBreaker:  circuitbreaker.New(circuitbreaker.ClaudeAPIConfig()),
+		retryConfig:     retry.Config{MaxAttempts: 1, InitialDelay: time.Millisecond, MaxDelay: time.Millisecond, Multiplier: 1},
+		config:          cfg,
+		metricsRecorder: rec,
+	}, rec
+}
+
+func testClaudeConfig(structured bool) ClaudeConfig {
+	return ClaudeConfig{
+		CharacterLimit: 900,
+		Language:       "japanese",
+		Model:          "claude-test",
+		MaxTokens:      1024,
+		Timeout:        time.Minute,
+		Structured:     structured,
+	}
+}
+
+func TestClaude_SummarizeArticle_Structured(t *testing.T) {
+	stub := &claudeStub{replies: []string{
+		`{"summary":"要約本文","tldr":"一行要約","key_points":["p1","p2","p3"],"tags":["go","release"],"reading_time_minutes":4}`,
+	}}
+	srv := httptest.NewServer(http.HandlerFunc(stub.handler))
+	defer srv.Close()
+
+	c, rec := newTestClaude(t, srv.URL, testClaudeConfig(true))
+	res, err := c.SummarizeArticle(context.Background(), fetch.SummaryRequest{Title: "t", Content: "記事本文"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "要約本文", res.Summary)
+	require.NotNil(t, res.Structured)
+	assert.Equal(t, "一行要約", res.Structured.TLDR)
+	assert.Equal(t, []string{"p1", "p2", "p3"}, res.Structured.KeyPoints)
+	assert.Equal(t, 4, res.Structured.ReadingTimeMinutes)
+	assert.Equal(t, []string{StructuredResultValid}, rec.RecordedStructured)
+	assert.Equal(t, []int{4}, rec.RecordedLengths)
+	require.Len(t, stub.prompts, 1)
+	assert.Contains(t, stub.prompts[0], `"key_points"`)
+}
+
+func TestClaude_SummarizeArticle_MalformedFallsBackToPlain(t *testing.T) {
+	stub := &claudeStub{replies: []string{"これはJSONではありません", "普通の要約"}}
+	srv := httptest.NewServer(http.HandlerFunc(stub.handler))
+	defer srv.Close()
+
+	c, rec := newTestClaude(t, srv.URL, testClaudeConfig(true))
+	res, err := c.SummarizeArticle(context.Background(), fetch.SummaryRequest{Content: "本文"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "普通の要約", res.Summary)
+	assert.Nil(t, res.Structured)
+	assert.Equal(t, []string{StructuredResultMalformed}, rec.RecordedStructured)
+	assert.Len(t, stub.prompts, 2)
+}
+
+func TestClaude_SummarizeArticle_PlainMode(t *testing.T) {
+	stub := &claudeStub{replies: []string{"plain summary"}}
+	srv := httptest.NewServer(http.HandlerFunc(stub.handler))
+	defer srv.Close()
+
+	c, rec := newTestClaude(t, srv.URL, testClaudeConfig(false))
+	res, err := c.SummarizeArticle(context.Background(), fetch.SummaryRequest{Content: "本文"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "plain summary", res.Summary)
+	assert.Nil(t, res.Structured)
+	assert.Empty(t, rec.RecordedStructured)
+	require.Len(t, stub.prompts, 1)
+	assert.NotContains(t, stub.prompts[0], "key_points")
+}
+
+func TestClaude_SummarizeArticle_APIError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"type":"error","error":{"type":"invalid_request_error","message":"bad"}}`))
+	}))
+	defer srv.Close()
+
+	c, _ := newTestClaude(t, srv.URL, testClaudeConfig(true))
+	_, err := c.SummarizeArticle(context.Background(), fetch.SummaryRequest{Content: "本文"})
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, ErrMalformedStructuredOutput))
+}
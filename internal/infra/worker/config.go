@@ -64,6 +64,24 @@ type WorkerConfig struct {
 	// Range: 1024-65535 (avoid privileged ports)
 	// Default: 9091
 	HealthPort int
+
+	// BatchPollInterval is how often pending summary batches are checked for results
+	// when batch summarization (SUMMARIZER_BATCH_MODE) is enabled.
+	// Range: 1 minute - 1 hour
+	// Default: 5 minutes
+	BatchPollInterval time.Duration
+
+	// ResummarizePerMinute is the maximum number of articles re-summarized per minute
+	// by resummarize jobs. Jobs are paused while a crawl is running.
+	// Range: 1-60
+	// Default: 10
+	ResummarizePerMinute int
+
+	// TrashRetentionDays is how long deleted articles and sources stay in the trash
+	// before the hourly purge job deletes them permanently.
+	// Range: 1-365
+	// Default: 30
+	TrashRetentionDays int
 }
 
 // DefaultConfig returns a WorkerConfig with sensible default values.
@@ -82,11 +100,14 @@ type WorkerConfig struct {
 //	config.CronSchedule = "0 */6 * * *"  // Customize to run every 6 hours
 func DefaultConfig() WorkerConfig {
 	return WorkerConfig{
-		CronSchedule:        "30 5 * * *",      // Every day at 5:30 AM
-		Timezone:            "Asia/Tokyo",      // JST
-		NotifyMaxConcurrent: 10,                // 10 concurrent notifications
-		CrawlTimeout:        30 * time.Minute,  // 30 minutes
-		HealthPort:          9091,              // Standard Prometheus exporter port
+		CronSchedule:         "30 5 * * *",     // Every day at 5:30 AM
+		Timezone:             "Asia/Tokyo",     // JST
+		NotifyMaxConcurrent:  10,               // 10 concurrent notifications
+		CrawlTimeout:         30 * time.Minute, // 30 minutes
+		HealthPort:           9091,             // Standard Prometheus exporter port
+		BatchPollInterval:    5 * time.Minute,  // 5 minutes
+		ResummarizePerMinute: 10,               // 10 articles per minute
+		TrashRetentionDays:   30,               // 30 days
 	}
 }
 
@@ -100,6 +121,9 @@ func DefaultConfig() WorkerConfig {
 //   - NotifyMaxConcurrent: Must be between 1 and 100 (inclusive)
 //   - CrawlTimeout: Must be positive (> 0)
 //   - HealthPort: Must be between 1024 and 65535 (avoid privileged ports)
+//   - BatchPollInterval: Must be between 1 minute and 1 hour
+//   - ResummarizePerMinute: Must be between 1 and 60
+//   - TrashRetentionDays: Must be between 1 and 365
 //
 // Returns:
 //   - error: nil if configuration is valid, aggregated error if any validation fails
@@ -144,6 +168,21 @@ func (c *WorkerConfig) Validate() error {
 		errors = append(errors, fmt.Errorf("health port: %w", err))
 	}
 
+	// Validate BatchPollInterval (range: 1m-1h)
+	if err := config.ValidateDuration(c.BatchPollInterval, 1*time.Minute, 1*time.Hour); err != nil {
+		errors = append(errors, fmt.Errorf("batch poll interval: %w", err))
+	}
+
+	// Validate ResummarizePerMinute (range: 1-60)
+	if err := config.ValidateIntRange(c.ResummarizePerMinute, 1, 60); err != nil {
+		errors = append(errors, fmt.Errorf("resummarize per minute: %w", err))
+	}
+
+	// Validate TrashRetentionDays (range: 1-365)
+	if err := config.ValidateIntRange(c.TrashRetentionDays, 1, 365); err != nil {
+		errors = append(errors, fmt.Errorf("trash retention days: %w", err))
+	}
+
 	// Return aggregated errors
 	if len(errors) > 0 {
 		return fmt.Errorf("validation failed: %v", errors)
@@ -168,6 +207,9 @@ func (c *WorkerConfig) Validate() error {
 //   - NOTIFY_MAX_CONCURRENT: Integer 1-100 (default: 10)
 //   - CRAWL_TIMEOUT: Duration string, e.g., "30m" (default: 30 minutes)
 //   - WORKER_HEALTH_PORT: Integer 1024-65535 (default: 9091)
+//   - SUMMARY_BATCH_POLL_INTERVAL: Duration string 1m-1h (default: 5 minutes)
+//   - RESUMMARIZE_RATE_PER_MINUTE: Integer 1-60 (default: 10)
+//   - TRASH_RETENTION_DAYS: Integer 1-365 (default: 30)
 //
 // Metrics updated:
 //   - ValidationErrorsTotal: Incremented for each validation failure
@@ -279,6 +321,54 @@ func LoadConfigFromEnv(logger *slog.Logger, metrics *WorkerMetrics) (*WorkerConf
 		}
 	}
 
+	// Load BatchPollInterval (with 1m-1h range limit)
+	result = config.LoadEnvDuration("SUMMARY_BATCH_POLL_INTERVAL", cfg.BatchPollInterval, func(d time.Duration) error {
+		return config.ValidateDuration(d, 1*time.Minute, 1*time.Hour)
+	})
+	cfg.BatchPollInterval = result.Value.(time.Duration)
+	if result.FallbackApplied {
+		fallbackApplied = true
+		metrics.RecordValidationError("batch_poll_interval")
+		metrics.RecordFallback("batch_poll_interval", "default")
+		for _, warning := range result.Warnings {
+			logger.Warn("Configuration fallback applied",
+				slog.String("field", "BatchPollInterval"),
+				slog.String("warning", warning))
+		}
+	}
+
+	// Load ResummarizePerMinute
+	result = config.LoadEnvInt("RESUMMARIZE_RATE_PER_MINUTE", cfg.ResummarizePerMinute, func(v int) error {
+		return config.ValidateIntRange(v, 1, 60)
+	})
+	cfg.ResummarizePerMinute = result.Value.(int)
+	if result.FallbackApplied {
+		fallbackApplied = true
+		metrics.RecordValidationError("resummarize_per_minute")
+		metrics.RecordFallback("resummarize_per_minute", "default")
+		for _, warning := range result.Warnings {
+			logger.Warn("Configuration fallback applied",
+				slog.String("field", "ResummarizePerMinute"),
+				slog.String("warning", warning))
+		}
+	}
+
+	// Load TrashRetentionDays
+	result = config.LoadEnvInt("TRASH_RETENTION_DAYS", cfg.TrashRetentionDays, func(v int) error {
+		return config.ValidateIntRange(v, 1, 365)
+	})
+	cfg.TrashRetentionDays = result.Value.(int)
+	if result.FallbackApplied {
+		fallbackApplied = true
+		metrics.RecordValidationError("trash_retention_days")
+		metrics.RecordFallback("trash_retention_days", "default")
+		for _, warning := range result.Warnings {
+			logger.Warn("Configuration fallback applied",
+				slog.String("field", "TrashRetentionDays"),
+				slog.String("warning", warning))
+		}
+	}
+
 	// Update metrics
 	metrics.SetFallbackActive("", fallbackApplied)
 	metrics.RecordLoadTimestamp()
@@ -32,6 +32,18 @@ func TestDefaultConfig(t *testing.T) {
 	if config.HealthPort != 9091 {
 		t.Errorf("Expected HealthPort 9091, got %d", config.HealthPort)
 	}
+
+	if config.BatchPollInterval != 5*time.Minute {
+		t.Errorf("Expected BatchPollInterval 5m, got %v", config.BatchPollInterval)
+	}
+
+	if config.ResummarizePerMinute != 10 {
+		t.Errorf("Expected ResummarizePerMinute 10, got %d", config.ResummarizePerMinute)
+	}
+
+	if config.TrashRetentionDays != 30 {
+		t.Errorf("Expected TrashRetentionDays 30, got %d", config.TrashRetentionDays)
+	}
 }
 
 func TestDefaultConfig_Immutability(t *testing.T) {
@@ -337,11 +349,14 @@ func TestWorkerConfig_Validate_MultipleErrors(t *testing.T) {
 
 func TestWorkerConfig_Validate_ValidCustomConfig(t *testing.T) {
 	config := WorkerConfig{
-		CronSchedule:        "0 */6 * * *",
-		Timezone:            "UTC",
-		NotifyMaxConcurrent: 20,
-		CrawlTimeout:        1 * time.Hour,
-		HealthPort:          8080,
+		CronSchedule:         "0 */6 * * *",
+		Timezone:             "UTC",
+		NotifyMaxConcurrent:  20,
+		CrawlTimeout:         1 * time.Hour,
+		HealthPort:           8080,
+		BatchPollInterval:    10 * time.Minute,
+		ResummarizePerMinute: 30,
+		TrashRetentionDays:   90,
 	}
 
 	err := config.Validate()
@@ -350,6 +365,92 @@ func TestWorkerConfig_Validate_ValidCustomConfig(t *testing.T) {
 	}
 }
 
+func TestWorkerConfig_Validate_BatchPollInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval time.Duration
+		valid    bool
+	}{
+		{"zero", 0, false},
+		{"below minimum", 30 * time.Second, false},
+		{"minimum", 1 * time.Minute, true},
+		{"maximum", 1 * time.Hour, true},
+		{"above maximum", 2 * time.Hour, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultConfig()
+			config.BatchPollInterval = tt.interval
+			err := config.Validate()
+			if tt.valid && err != nil {
+				t.Errorf("Expected valid interval %v, got error: %v", tt.interval, err)
+			}
+			if !tt.valid && err == nil {
+				t.Errorf("Expected validation error for interval %v", tt.interval)
+			}
+		})
+	}
+}
+
+func TestLoadConfigFromEnv_BatchPollInterval(t *testing.T) {
+	t.Setenv("SUMMARY_BATCH_POLL_INTERVAL", "15m")
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	config, _ := LoadConfigFromEnv(logger, globalTestMetrics)
+	if config.BatchPollInterval != 15*time.Minute {
+		t.Errorf("Expected BatchPollInterval 15m, got %v", config.BatchPollInterval)
+	}
+
+	t.Setenv("SUMMARY_BATCH_POLL_INTERVAL", "10s")
+	config, _ = LoadConfigFromEnv(logger, globalTestMetrics)
+	if config.BatchPollInterval != DefaultConfig().BatchPollInterval {
+		t.Errorf("Expected default BatchPollInterval for out-of-range value, got %v", config.BatchPollInterval)
+	}
+}
+
+func TestLoadConfigFromEnv_ResummarizePerMinute(t *testing.T) {
+	t.Setenv("RESUMMARIZE_RATE_PER_MINUTE", "30")
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	config, _ := LoadConfigFromEnv(logger, globalTestMetrics)
+	if config.ResummarizePerMinute != 30 {
+		t.Errorf("Expected ResummarizePerMinute 30, got %d", config.ResummarizePerMinute)
+	}
+
+	for _, v := range []string{"0", "61", "abc"} {
+		t.Setenv("RESUMMARIZE_RATE_PER_MINUTE", v)
+		config, _ = LoadConfigFromEnv(logger, globalTestMetrics)
+		if config.ResummarizePerMinute != DefaultConfig().ResummarizePerMinute {
+			t.Errorf("Expected default ResummarizePerMinute for %q, got %d", v, config.ResummarizePerMinute)
+		}
+	}
+}
+
+func TestLoadConfigFromEnv_TrashRetentionDays(t *testing.T) {
+	t.Setenv("TRASH_RETENTION_DAYS", "90")
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	config, _ := LoadConfigFromEnv(logger, globalTestMetrics)
+	if config.TrashRetentionDays != 90 {
+		t.Errorf("Expected TrashRetentionDays 90, got %d", config.TrashRetentionDays)
+	}
+
+	for _, v := range []string{"0", "366", "abc"} {
+		t.Setenv("TRASH_RETENTION_DAYS", v)
+		config, _ = LoadConfigFromEnv(logger, globalTestMetrics)
+		if config.TrashRetentionDays != DefaultConfig().TrashRetentionDays {
+			t.Errorf("Expected default TrashRetentionDays for %q, got %d", v, config.TrashRetentionDays)
+		}
+	}
+}
+
 // globalTestMetrics is a shared metrics instance for tests to avoid
 // duplicate Prometheus registration errors. In production, metrics are
 // created once at startup, so this simulates that behavior.
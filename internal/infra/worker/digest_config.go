@@ -0,0 +1,81 @@
+package worker
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/pkg/config"
+	digestUC "catchup-feed/internal/usecase/digest"
+)
+
+// Default digest schedules, in the worker timezone.
+const (
+	// DefaultDailyDigestSchedule sends daily digests every day at 8:00.
+	DefaultDailyDigestSchedule = "0 8 * * *"
+	// DefaultWeeklyDigestSchedule sends weekly digests every Monday at 8:00.
+	DefaultWeeklyDigestSchedule = "0 8 * * 1"
+)
+
+// DigestConfig holds the digest job configuration loaded from environment variables.
+type DigestConfig struct {
+	// Enabled turns on the scheduled digest job.
+	Enabled bool
+	// Schedule is the cron expression of the digest job.
+	Schedule string
+	// Digest is the period, grouping and size of generated digests.
+	Digest digestUC.Config
+	// Overview asks the summarizer for an editor's overview of each digest.
+	Overview bool
+}
+
+// LoadDigestConfigFromEnv loads the digest configuration from environment variables:
+//   - DIGEST_ENABLED: "true" to enable the digest job (default: false)
+//   - DIGEST_PERIOD: "daily" or "weekly" (default: daily)
+//   - DIGEST_SCHEDULE: cron expression (default: "0 8 * * *" daily, "0 8 * * 1" weekly)
+//   - DIGEST_GROUP_BY: "source" or "tag" (default: source)
+//   - DIGEST_MAX_ARTICLES: integer 1-500 (default: 100)
+//   - DIGEST_OVERVIEW_ENABLED: "true" to write an overview with the summarizer (default: false)
+//
+// Unlike LoadConfigFromEnv, invalid values are returned as an error instead of
+// falling back to defaults, because a digest sent at the wrong time or to the wrong
+// shape is worse than a worker that refuses to start.
+func LoadDigestConfigFromEnv() (DigestConfig, error) {
+	cfg := DigestConfig{
+		Enabled:  os.Getenv("DIGEST_ENABLED") == "true",
+		Overview: os.Getenv("DIGEST_OVERVIEW_ENABLED") == "true",
+		Digest: digestUC.Config{
+			Period:      strings.ToLower(config.LoadEnvString("DIGEST_PERIOD", entity.DigestDaily)),
+			GroupBy:     strings.ToLower(config.LoadEnvString("DIGEST_GROUP_BY", entity.DigestGroupBySource)),
+			MaxArticles: digestUC.DefaultMaxArticles,
+		},
+	}
+
+	cfg.Schedule = DefaultDailyDigestSchedule
+	if cfg.Digest.Period == entity.DigestWeekly {
+		cfg.Schedule = DefaultWeeklyDigestSchedule
+	}
+	cfg.Schedule = config.LoadEnvString("DIGEST_SCHEDULE", cfg.Schedule)
+
+	if v := os.Getenv("DIGEST_MAX_ARTICLES"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("DIGEST_MAX_ARTICLES must be an integer, got %q", v)
+		}
+		cfg.Digest.MaxArticles = n
+	}
+	return cfg, cfg.Validate()
+}
+
+// Validate checks the schedule and digest settings. A disabled configuration is always valid.
+func (c DigestConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if err := config.ValidateCronSchedule(c.Schedule); err != nil {
+		return fmt.Errorf("digest schedule: %w", err)
+	}
+	return c.Digest.Validate()
+}
@@ -0,0 +1,125 @@
+package worker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadDigestConfigFromEnv(t *testing.T) {
+	tests := []struct {
+		name         string
+		env          map[string]string
+		wantErr      string
+		wantEnabled  bool
+		wantSchedule string
+		wantPeriod   string
+		wantGroupBy  string
+		wantMax      int
+		wantOverview bool
+	}{
+		{
+			name:         "defaults",
+			env:          map[string]string{},
+			wantSchedule: DefaultDailyDigestSchedule,
+			wantPeriod:   "daily",
+			wantGroupBy:  "source",
+			wantMax:      100,
+		},
+		{
+			name:         "weekly digest uses the weekly default schedule",
+			env:          map[string]string{"DIGEST_ENABLED": "true", "DIGEST_PERIOD": "Weekly", "DIGEST_GROUP_BY": "tag"},
+			wantEnabled:  true,
+			wantSchedule: DefaultWeeklyDigestSchedule,
+			wantPeriod:   "weekly",
+			wantGroupBy:  "tag",
+			wantMax:      100,
+		},
+		{
+			name: "all settings",
+			env: map[string]string{
+				"DIGEST_ENABLED":          "true",
+				"DIGEST_SCHEDULE":         "30 7 * * 1-5",
+				"DIGEST_MAX_ARTICLES":     "50",
+				"DIGEST_OVERVIEW_ENABLED": "true",
+			},
+			wantEnabled:  true,
+			wantSchedule: "30 7 * * 1-5",
+			wantPeriod:   "daily",
+			wantGroupBy:  "source",
+			wantMax:      50,
+			wantOverview: true,
+		},
+		{
+			name:    "invalid schedule",
+			env:     map[string]string{"DIGEST_ENABLED": "true", "DIGEST_SCHEDULE": "every morning"},
+			wantErr: "digest schedule",
+		},
+		{
+			name:    "invalid period",
+			env:     map[string]string{"DIGEST_ENABLED": "true", "DIGEST_PERIOD": "monthly"},
+			wantErr: "invalid digest period",
+		},
+		{
+			name:    "invalid grouping",
+			env:     map[string]string{"DIGEST_ENABLED": "true", "DIGEST_GROUP_BY": "author"},
+			wantErr: "invalid digest grouping",
+		},
+		{
+			name:    "max articles not a number",
+			env:     map[string]string{"DIGEST_MAX_ARTICLES": "many"},
+			wantErr: "DIGEST_MAX_ARTICLES must be an integer",
+		},
+		{
+			name:    "max articles out of range",
+			env:     map[string]string{"DIGEST_ENABLED": "true", "DIGEST_MAX_ARTICLES": "501"},
+			wantErr: "invalid digest max articles",
+		},
+		{
+			name:         "invalid settings are ignored while disabled",
+			env:          map[string]string{"DIGEST_PERIOD": "monthly"},
+			wantSchedule: DefaultDailyDigestSchedule,
+			wantPeriod:   "monthly",
+			wantGroupBy:  "source",
+			wantMax:      100,
+		},
+	}
+
+	keys := []string{"DIGEST_ENABLED", "DIGEST_PERIOD", "DIGEST_SCHEDULE", "DIGEST_GROUP_BY", "DIGEST_MAX_ARTICLES", "DIGEST_OVERVIEW_ENABLED"}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, k := range keys {
+				t.Setenv(k, tt.env[k])
+			}
+
+			cfg, err := LoadDigestConfigFromEnv()
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if cfg.Enabled != tt.wantEnabled {
+				t.Errorf("Enabled = %v, want %v", cfg.Enabled, tt.wantEnabled)
+			}
+			if cfg.Schedule != tt.wantSchedule {
+				t.Errorf("Schedule = %q, want %q", cfg.Schedule, tt.wantSchedule)
+			}
+			if cfg.Digest.Period != tt.wantPeriod {
+				t.Errorf("Period = %q, want %q", cfg.Digest.Period, tt.wantPeriod)
+			}
+			if cfg.Digest.GroupBy != tt.wantGroupBy {
+				t.Errorf("GroupBy = %q, want %q", cfg.Digest.GroupBy, tt.wantGroupBy)
+			}
+			if cfg.Digest.MaxArticles != tt.wantMax {
+				t.Errorf("MaxArticles = %d, want %d", cfg.Digest.MaxArticles, tt.wantMax)
+			}
+			if cfg.Overview != tt.wantOverview {
+				t.Errorf("Overview = %v, want %v", cfg.Overview, tt.wantOverview)
+			}
+		})
+	}
+}
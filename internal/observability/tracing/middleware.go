@@ -29,6 +29,11 @@ func (rw *responseWriter) WriteHeader(code int) {
 	rw.ResponseWriter.WriteHeader(code)
 }
 
+// Unwrap returns the underlying http.ResponseWriter (for http.ResponseController support).
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 // Middleware creates OpenTelemetry tracing middleware for HTTP handlers.
 // It extracts trace context from incoming requests, creates a new span,
 // and propagates the trace ID in response headers.
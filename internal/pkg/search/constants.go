@@ -1,4 +1,5 @@
-// Package search provides utilities for parsing and escaping search keywords.
+// Package search provides utilities for parsing and escaping search keywords and
+// queries, and for highlighting matched terms in search results.
 package search
 
 import "time"
@@ -0,0 +1,101 @@
+package search
+
+import (
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Highlight markers wrapped around matched fragments by Highlight.
+const (
+	HighlightStart = "<mark>"
+	HighlightEnd   = "</mark>"
+)
+
+// snippetEllipsis marks text cut off by Highlight.
+const snippetEllipsis = "…"
+
+// Highlight returns text with every case-insensitive occurrence of terms wrapped in
+// HighlightStart and HighlightEnd. The rest of the text is HTML-escaped, so the result
+// can be inserted into HTML as is.
+//
+// When maxRunes is positive and text is longer, only a fragment of about maxRunes
+// runes around the first match is returned, with an ellipsis on the cut sides.
+// Returns "" if no term occurs in text.
+//
+// Example:
+//
+//	Highlight("Go 1.23 adds iterators", []string{"iterator"}, 0)
+//	// "Go 1.23 adds <mark>iterator</mark>s"
+func Highlight(text string, terms []string, maxRunes int) string {
+	re := highlightPattern(terms)
+	if re == nil {
+		return ""
+	}
+	matches := re.FindAllStringIndex(text, -1)
+	if len(matches) == 0 {
+		return ""
+	}
+
+	start, end := 0, len(text)
+	if maxRunes > 0 && utf8.RuneCountInString(text) > maxRunes {
+		start, end = snippetWindow(text, matches[0][0], maxRunes)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString(snippetEllipsis)
+	}
+	pos := start
+	for _, m := range matches {
+		ms, me := max(m[0], start), min(m[1], end)
+		if ms >= me {
+			continue
+		}
+		b.WriteString(html.EscapeString(text[pos:ms]))
+		b.WriteString(HighlightStart)
+		b.WriteString(html.EscapeString(text[ms:me]))
+		b.WriteString(HighlightEnd)
+		pos = me
+	}
+	if pos < end {
+		b.WriteString(html.EscapeString(text[pos:end]))
+	}
+	if end < len(text) {
+		b.WriteString(snippetEllipsis)
+	}
+	return b.String()
+}
+
+// highlightPattern returns a case-insensitive pattern matching any of terms, longest
+// first so that a phrase wins over the words it contains. Returns nil for no terms.
+func highlightPattern(terms []string) *regexp.Regexp {
+	quoted := make([]string, 0, len(terms))
+	for _, t := range terms {
+		if t = strings.TrimSpace(t); t != "" {
+			quoted = append(quoted, regexp.QuoteMeta(t))
+		}
+	}
+	if len(quoted) == 0 {
+		return nil
+	}
+	sort.SliceStable(quoted, func(i, j int) bool { return len(quoted[i]) > len(quoted[j]) })
+	return regexp.MustCompile("(?i)" + strings.Join(quoted, "|"))
+}
+
+// snippetWindow returns the byte range of a fragment of maxRunes runes of text that
+// contains the byte offset at, starting up to a quarter of the fragment before it.
+func snippetWindow(text string, at, maxRunes int) (start, end int) {
+	runes := []rune(text)
+	atRune := utf8.RuneCountInString(text[:at])
+
+	first := max(atRune-maxRunes/4, 0)
+	last := min(first+maxRunes, len(runes))
+	first = max(last-maxRunes, 0)
+
+	start = len(string(runes[:first]))
+	end = start + len(string(runes[first:last]))
+	return start, end
+}
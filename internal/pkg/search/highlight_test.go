@@ -0,0 +1,88 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		terms    []string
+		maxRunes int
+		want     string
+	}{
+		{
+			name:  "case-insensitive matches are marked",
+			text:  "Go adds iterators. GO is fast.",
+			terms: []string{"go"},
+			want:  "<mark>Go</mark> adds iterators. <mark>GO</mark> is fast.",
+		},
+		{
+			name:  "phrase wins over its words",
+			text:  "better error handling in Go",
+			terms: []string{"error", "error handling"},
+			want:  "better <mark>error handling</mark> in Go",
+		},
+		{
+			name:  "text is html-escaped",
+			text:  "<script>alert(1)</script> & Go",
+			terms: []string{"go"},
+			want:  "&lt;script&gt;alert(1)&lt;/script&gt; &amp; <mark>Go</mark>",
+		},
+		{
+			name:  "regexp metacharacters are literal",
+			text:  "C++ and C#",
+			terms: []string{"c++"},
+			want:  "<mark>C++</mark> and C#",
+		},
+		{
+			name:  "no match",
+			text:  "Rust release",
+			terms: []string{"go"},
+			want:  "",
+		},
+		{
+			name:  "no terms",
+			text:  "Rust release",
+			terms: []string{" "},
+			want:  "",
+		},
+		{
+			name:     "snippet around the first match",
+			text:     "0123456789 abcdefghij Go klmnopqrst 9876543210",
+			terms:    []string{"go"},
+			maxRunes: 16,
+			want:     "…hij <mark>Go</mark> klmnopqrs…",
+		},
+		{
+			name:     "snippet at the start has no leading ellipsis",
+			text:     "Go 0123456789 abcdefghij",
+			terms:    []string{"go"},
+			maxRunes: 8,
+			want:     "<mark>Go</mark> 01234…",
+		},
+		{
+			name:     "snippet of japanese text counts runes",
+			text:     "新しいバージョンでは型パラメータの推論が改善されました。",
+			terms:    []string{"型パラメータ"},
+			maxRunes: 12,
+			want:     "…ンでは<mark>型パラメータ</mark>の推論…",
+		},
+		{
+			name:     "match cut by the end of the snippet",
+			text:     "aaaa Go Go Go",
+			terms:    []string{"go"},
+			maxRunes: 9,
+			want:     "…a <mark>Go</mark> <mark>Go</mark> <mark>G</mark>…",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Highlight(tt.text, tt.terms, tt.maxRunes))
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Term is a single search term of a Query.
+type Term struct {
+	Text   string // Term text (the words of a phrase, without quotes)
+	Phrase bool   // Written as a quoted phrase; its words must appear adjacent and in order
+}
+
+// Query is a parsed search query with AND, OR, phrase and exclusion operators.
+//
+// An article matches when every clause matches and no excluded term matches.
+// A clause matches when any of its terms matches.
+type Query struct {
+	Clauses  [][]Term // Terms joined by AND; the terms of each clause are joined by OR
+	Excluded []Term   // Terms that must not match
+}
+
+// orOperator joins the terms on both sides of it into one clause.
+const orOperator = "OR"
+
+// ParseQuery parses a search query.
+//
+// Syntax:
+//   - Whitespace separated terms must all match (AND): `go generics`
+//   - "OR" (upper case) between two terms matches either of them: `go OR rust`
+//   - Double quotes search for a phrase: `"error handling"`
+//   - A leading minus excludes a term or phrase: `-beta`, `-"release candidate"`
+//
+// The limits of ParseKeywords apply to the number of terms (including excluded
+// terms) and to the length of each term.
+//
+// Returns an error if the input is empty, contains no term that is not excluded,
+// has an OR that is not between two terms, or exceeds the limits.
+//
+// Example:
+//
+//	q, _ := ParseQuery(`"error handling" go OR rust -beta`, 10, 100)
+//	// q.Clauses  = [["error handling"(phrase)], ["go", "rust"]]
+//	// q.Excluded = ["beta"]
+func ParseQuery(input string, maxCount int, maxLength int) (Query, error) {
+	tokens := tokenizeQuery(input)
+	if len(tokens) == 0 {
+		return Query{}, fmt.Errorf("keywords cannot be empty")
+	}
+
+	count := 0
+	for _, tok := range tokens {
+		if !tok.operator {
+			count++
+		}
+	}
+	if count > maxCount {
+		return Query{}, fmt.Errorf("too many keywords: got %d, maximum %d allowed", count, maxCount)
+	}
+
+	var q Query
+	joinNext := false
+	for i, tok := range tokens {
+		if tok.operator {
+			if len(q.Clauses) == 0 || joinNext || i == len(tokens)-1 || tokens[i+1].excluded || tokens[i+1].operator {
+				return Query{}, fmt.Errorf("invalid query: %s must be between two terms", orOperator)
+			}
+			joinNext = true
+			continue
+		}
+
+		if len([]rune(tok.term.Text)) > maxLength {
+			return Query{}, fmt.Errorf("keyword '%s' exceeds maximum length of %d characters", tok.term.Text, maxLength)
+		}
+
+		switch {
+		case tok.excluded:
+			q.Excluded = append(q.Excluded, tok.term)
+		case joinNext:
+			last := len(q.Clauses) - 1
+			q.Clauses[last] = append(q.Clauses[last], tok.term)
+			joinNext = false
+		default:
+			q.Clauses = append(q.Clauses, []Term{tok.term})
+		}
+	}
+
+	if len(q.Clauses) == 0 {
+		return Query{}, fmt.Errorf("invalid query: at least one term must not be excluded")
+	}
+	return q, nil
+}
+
+// Terms returns the terms of all clauses (not the excluded terms) in query order.
+func (q Query) Terms() []Term {
+	var terms []Term
+	for _, clause := range q.Clauses {
+		terms = append(terms, clause...)
+	}
+	return terms
+}
+
+// TermTexts returns the texts of Terms.
+func (q Query) TermTexts() []string {
+	terms := q.Terms()
+	texts := make([]string, len(terms))
+	for i, t := range terms {
+		texts[i] = t.Text
+	}
+	return texts
+}
+
+// String returns the query in the syntax accepted by ParseQuery.
+func (q Query) String() string {
+	var parts []string
+	for _, clause := range q.Clauses {
+		terms := make([]string, len(clause))
+		for i, t := range clause {
+			terms[i] = t.String()
+		}
+		parts = append(parts, strings.Join(terms, " "+orOperator+" "))
+	}
+	for _, t := range q.Excluded {
+		parts = append(parts, "-"+t.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+// String returns the term in the syntax accepted by ParseQuery.
+func (t Term) String() string {
+	if t.Phrase {
+		return `"` + t.Text + `"`
+	}
+	return t.Text
+}
+
+// queryToken is a term or operator of a query.
+type queryToken struct {
+	term     Term
+	excluded bool
+	operator bool
+}
+
+// tokenizeQuery splits input into terms and OR operators.
+// An unterminated quote extends the phrase to the end of input; empty terms are dropped.
+func tokenizeQuery(input string) []queryToken {
+	var tokens []queryToken
+	runes := []rune(input)
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		var tok queryToken
+		if runes[i] == '-' {
+			tok.excluded = true
+			i++
+		}
+
+		start := i
+		if i < len(runes) && runes[i] == '"' {
+			start++
+			end := start
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			tok.term = Term{Text: strings.Join(strings.Fields(string(runes[start:end])), " "), Phrase: true}
+			i = end + 1
+		} else {
+			for i < len(runes) && !unicode.IsSpace(runes[i]) {
+				i++
+			}
+			tok.term = Term{Text: string(runes[start:i])}
+			tok.operator = !tok.excluded && tok.term.Text == orOperator
+		}
+
+		if tok.term.Text != "" {
+			tokens = append(tokens, tok)
+		}
+	}
+	return tokens
+}
+
+// ContainsCJK reports whether s contains Chinese, Japanese or Korean characters,
+// which are not separated by spaces and therefore not split into words by
+// whitespace-based tokenizers.
+func ContainsCJK(s string) bool {
+	for _, r := range s {
+		if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) {
+			return true
+		}
+	}
+	return false
+}
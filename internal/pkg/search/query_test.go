@@ -0,0 +1,119 @@
+package search
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		wantClauses  [][]Term
+		wantExcluded []Term
+	}{
+		{
+			name:        "terms are joined by AND",
+			input:       "go generics",
+			wantClauses: [][]Term{{{Text: "go"}}, {{Text: "generics"}}},
+		},
+		{
+			name:        "OR joins adjacent terms into one clause",
+			input:       "go OR rust OR zig release",
+			wantClauses: [][]Term{{{Text: "go"}, {Text: "rust"}, {Text: "zig"}}, {{Text: "release"}}},
+		},
+		{
+			name:        "lower case or is a term",
+			input:       "go or rust",
+			wantClauses: [][]Term{{{Text: "go"}}, {{Text: "or"}}, {{Text: "rust"}}},
+		},
+		{
+			name:        "phrases keep their words together",
+			input:       `"error   handling" go`,
+			wantClauses: [][]Term{{{Text: "error handling", Phrase: true}}, {{Text: "go"}}},
+		},
+		{
+			name:         "exclusions of terms and phrases",
+			input:        `go -beta -"release candidate"`,
+			wantClauses:  [][]Term{{{Text: "go"}}},
+			wantExcluded: []Term{{Text: "beta"}, {Text: "release candidate", Phrase: true}},
+		},
+		{
+			name:        "phrase OR term",
+			input:       `"type parameters" OR generics`,
+			wantClauses: [][]Term{{{Text: "type parameters", Phrase: true}, {Text: "generics"}}},
+		},
+		{
+			name:        "unterminated phrase extends to the end",
+			input:       `go "error handling`,
+			wantClauses: [][]Term{{{Text: "go"}}, {{Text: "error handling", Phrase: true}}},
+		},
+		{
+			name:        "empty phrase and lone minus are dropped",
+			input:       `go "" -`,
+			wantClauses: [][]Term{{{Text: "go"}}},
+		},
+		{
+			name:        "japanese terms",
+			input:       "型パラメータ OR ジェネリクス",
+			wantClauses: [][]Term{{{Text: "型パラメータ"}, {Text: "ジェネリクス"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := ParseQuery(tt.input, 10, 100)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantClauses, q.Clauses)
+			assert.Equal(t, tt.wantExcluded, q.Excluded)
+		})
+	}
+}
+
+func TestParseQuery_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{name: "empty", input: "   ", wantErr: "keywords cannot be empty"},
+		{name: "only exclusions", input: "-beta -rc", wantErr: "at least one term must not be excluded"},
+		{name: "leading OR", input: "OR go", wantErr: "OR must be between two terms"},
+		{name: "trailing OR", input: "go OR", wantErr: "OR must be between two terms"},
+		{name: "double OR", input: "go OR OR rust", wantErr: "OR must be between two terms"},
+		{name: "OR before exclusion", input: "go OR -rust", wantErr: "OR must be between two terms"},
+		{name: "too many terms", input: "a b c -d", wantErr: "too many keywords: got 4, maximum 3 allowed"},
+		{name: "term too long", input: "go " + strings.Repeat("x", 11), wantErr: "exceeds maximum length of 10 characters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			max := 10
+			if tt.name == "too many terms" {
+				max = 3
+			}
+			_, err := ParseQuery(tt.input, max, 10)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestQuery_String(t *testing.T) {
+	q, err := ParseQuery(`go OR rust  "error handling" -beta`, 10, 100)
+	require.NoError(t, err)
+
+	assert.Equal(t, `go OR rust "error handling" -beta`, q.String())
+	assert.Equal(t, []string{"go", "rust", "error handling"}, q.TermTexts())
+}
+
+func TestContainsCJK(t *testing.T) {
+	assert.True(t, ContainsCJK("Go の型パラメータ"))
+	assert.True(t, ContainsCJK("カタカナ"))
+	assert.True(t, ContainsCJK("한국어"))
+	assert.False(t, ContainsCJK("generics"))
+	assert.False(t, ContainsCJK("café ✓"))
+}
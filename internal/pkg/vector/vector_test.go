@@ -0,0 +1,68 @@
+package vector
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	got := Normalize([]float32{3, 4})
+	if math.Abs(float64(got[0])-0.6) > 1e-6 || math.Abs(float64(got[1])-0.8) > 1e-6 {
+		t.Errorf("Normalize([3 4]) = %v, want [0.6 0.8]", got)
+	}
+
+	zero := []float32{0, 0}
+	if got := Normalize(zero); !reflect.DeepEqual(got, zero) {
+		t.Errorf("Normalize(zero) = %v, want %v", got, zero)
+	}
+}
+
+func TestCosine(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []float32
+		want float64
+	}{
+		{name: "same direction", a: []float32{1, 2}, b: []float32{2, 4}, want: 1},
+		{name: "orthogonal", a: []float32{1, 0}, b: []float32{0, 1}, want: 0},
+		{name: "opposite", a: []float32{1, 0}, b: []float32{-1, 0}, want: -1},
+		{name: "dimension mismatch", a: []float32{1, 0}, b: []float32{1}, want: 0},
+		{name: "zero vector", a: []float32{0, 0}, b: []float32{1, 0}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Cosine(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Cosine() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDot_NormalizedEqualsCosine(t *testing.T) {
+	a := []float32{1, 2, 3}
+	b := []float32{-2, 0.5, 4}
+	if got, want := Dot(Normalize(a), Normalize(b)), Cosine(a, b); math.Abs(got-want) > 1e-6 {
+		t.Errorf("Dot(normalized) = %v, want %v", got, want)
+	}
+	if got := Dot([]float32{1}, []float32{1, 2}); got != 0 {
+		t.Errorf("Dot(dimension mismatch) = %v, want 0", got)
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	v := []float32{0, 1.5, -2.25, float32(math.Pi)}
+
+	got, err := Decode(Encode(v))
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, v) {
+		t.Errorf("round trip = %v, want %v", got, v)
+	}
+
+	if _, err := Decode([]byte{1, 2, 3}); err == nil {
+		t.Error("Decode() of truncated input must fail")
+	}
+}
@@ -0,0 +1,12 @@
+package repository
+
+import "context"
+
+// ArticleContentRepository stores the full text an article was summarized from,
+// so that its summary can be regenerated without fetching the page again.
+type ArticleContentRepository interface {
+	// SaveContent stores (or replaces) the content of an article.
+	SaveContent(ctx context.Context, articleID int64, content string) error
+	// GetContent returns the stored content of an article, or "" if none is stored.
+	GetContent(ctx context.Context, articleID int64) (string, error)
+}
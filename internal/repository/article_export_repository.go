@@ -0,0 +1,13 @@
+package repository
+
+import "context"
+
+// ArticleExportRepository reads articles for bulk export one row at a time, so
+// that exports do not load every article into memory (unlike ListWithSource).
+type ArticleExportRepository interface {
+	// EachArticle calls fn for up to limit articles matching keywords and filters
+	// (as SearchWithFiltersPaginated, but every article when both are empty), with
+	// their source names, newest first. It stops at the first error of fn and
+	// returns it.
+	EachArticle(ctx context.Context, keywords []string, filters ArticleSearchFilters, limit int, fn func(ArticleWithSource) error) error
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/pkg/search"
 )
 
 // ArticleWithSource represents an article along with its source name.
@@ -15,9 +16,16 @@ type ArticleWithSource struct {
 
 // ArticleSearchFilters contains optional filters for article search
 type ArticleSearchFilters struct {
-	SourceID *int64     // Optional: Filter by source ID
-	From     *time.Time // Optional: Filter articles published >= this date
-	To       *time.Time // Optional: Filter articles published <= this date
+	SourceID   *int64     // Optional: Filter by source ID
+	From       *time.Time // Optional: Filter articles published >= this date
+	To         *time.Time // Optional: Filter articles published <= this date
+	Tags       []string   // Optional: Filter articles having all of these (normalized) tag names
+	CategoryID *int64     // Optional: Filter articles of the sources in this category
+}
+
+// Empty reports whether no filter is set.
+func (f ArticleSearchFilters) Empty() bool {
+	return f.SourceID == nil && f.From == nil && f.To == nil && len(f.Tags) == 0 && f.CategoryID == nil
 }
 
 type ArticleRepository interface {
@@ -57,3 +65,64 @@ type ArticleRepository interface {
 	// ExistsByURLBatch はバッチでURL存在チェックを行い、N+1問題を解消する
 	ExistsByURLBatch(ctx context.Context, urls []string) (map[string]bool, error)
 }
+
+// ArticleKeyset is a position in the (published_at DESC, id DESC) article order.
+type ArticleKeyset struct {
+	PublishedAt time.Time
+	ID          int64
+}
+
+// ArticleKeysetRepository is an optional extension of ArticleRepository for keyset
+// (cursor) pagination. Unlike the OFFSET based methods, the cost of a page does not
+// grow with its depth.
+type ArticleKeysetRepository interface {
+	// ListWithSourceAfter returns up to limit articles with their source names that
+	// come after the given position in (published_at DESC, id DESC) order.
+	// A nil after returns the first page.
+	ListWithSourceAfter(ctx context.Context, after *ArticleKeyset, limit int) ([]ArticleWithSource, error)
+	// SearchWithFiltersAfter is the keyset counterpart of SearchWithFiltersPaginated.
+	// Returns an empty result when there are no keywords and no filters.
+	SearchWithFiltersAfter(ctx context.Context, keywords []string, filters ArticleSearchFilters, after *ArticleKeyset, limit int) ([]ArticleWithSource, error)
+}
+
+// RankedArticle is an article found by a ranked search with its relevance score.
+type RankedArticle struct {
+	ArticleWithSource
+	// Rank is the relevance of the article to the query (higher is more relevant).
+	// Scores are only comparable within the results of one query.
+	Rank float64
+}
+
+// ArticleRankedSearchRepository is an optional extension of ArticleRepository for
+// relevance-ranked full-text search.
+type ArticleRankedSearchRepository interface {
+	// SearchRanked returns up to limit articles matching q and filters, skipping
+	// offset, ordered by relevance (then by published_at DESC, id DESC).
+	SearchRanked(ctx context.Context, q search.Query, filters ArticleSearchFilters, offset, limit int) ([]RankedArticle, error)
+	// CountRanked returns the number of articles matching q and filters.
+	CountRanked(ctx context.Context, q search.Query, filters ArticleSearchFilters) (int64, error)
+}
+
+// Facets that ArticleFacetRepository can count search results by.
+const (
+	FacetSource = "source" // Bucket value is the source ID, label the source name
+	FacetMonth  = "month"  // Bucket value is the publication month ("2006-01", UTC)
+	FacetTag    = "tag"    // Bucket value is the tag name
+)
+
+// FacetBucket is the number of articles that have one value of a facet.
+type FacetBucket struct {
+	Value string
+	Label string // Display name, if different from Value
+	Count int64
+}
+
+// ArticleFacetRepository is an optional extension of ArticleRepository for counting
+// search results per facet value.
+type ArticleFacetRepository interface {
+	// CountFacet counts the articles matching keywords and filters (the conditions
+	// of SearchWithFiltersPaginated) per value of facet and returns at most limit
+	// buckets: the largest ones for FacetSource and FacetTag, the latest months for
+	// FacetMonth. Returns an empty result when there are no keywords and no filters.
+	CountFacet(ctx context.Context, keywords []string, filters ArticleSearchFilters, facet string, limit int) ([]FacetBucket, error)
+}
@@ -0,0 +1,25 @@
+package repository
+
+import "context"
+
+// ArticleStreamRepository finds the articles inserted after a known article, for
+// the real-time new-article stream. Article IDs increase with insertion order, so
+// the last streamed ID is the resume position of a client.
+//
+// Articles pending batch summarization are not streamed until summarized. Since
+// they keep the ID assigned at insert, a stream moving past them remembers their
+// IDs (ListPendingArticleIDs) and reads them once summarized (ListSettledArticles).
+type ArticleStreamRepository interface {
+	// LatestArticleID returns the largest article ID, or 0 when there are no articles.
+	LatestArticleID(ctx context.Context) (int64, error)
+	// ListArticlesAfter returns up to limit articles with id > afterID that match
+	// keywords and filters (as SearchWithFiltersPaginated), with their source
+	// names, in ascending ID order. Pending articles are skipped.
+	ListArticlesAfter(ctx context.Context, keywords []string, filters ArticleSearchFilters, afterID int64, limit int) ([]ArticleWithSource, error)
+	// ListPendingArticleIDs returns the IDs of the articles with id > afterID that
+	// are pending batch summarization, in ascending order.
+	ListPendingArticleIDs(ctx context.Context, afterID int64) ([]int64, error)
+	// ListSettledArticles returns the articles among ids that are no longer pending
+	// and match keywords and filters, with their source names, in ascending ID order.
+	ListSettledArticles(ctx context.Context, keywords []string, filters ArticleSearchFilters, ids []int64) ([]ArticleWithSource, error)
+}
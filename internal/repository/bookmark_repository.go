@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// BookmarkedArticle is an article bookmarked by a user.
+type BookmarkedArticle struct {
+	ArticleWithSource
+	BookmarkedAt time.Time
+}
+
+// ReadingListEntry is a reading list item with its article.
+type ReadingListEntry struct {
+	Item entity.ReadingListItem
+	ArticleWithSource
+}
+
+// BookmarkRepository stores the articles each user has bookmarked (starred).
+// Users are identified by the subject of their JWT.
+type BookmarkRepository interface {
+	// AddBookmark bookmarks an article and reports whether the article exists.
+	// Bookmarking an article again keeps the original bookmark time.
+	AddBookmark(ctx context.Context, userID string, articleID int64) (bool, error)
+	// RemoveBookmark removes a bookmark and reports whether it existed.
+	RemoveBookmark(ctx context.Context, userID string, articleID int64) (bool, error)
+	// ListBookmarks returns the bookmarked articles with their source names,
+	// most recently bookmarked first, skipping offset.
+	ListBookmarks(ctx context.Context, userID string, offset, limit int) ([]BookmarkedArticle, error)
+	// CountBookmarks returns the number of articles bookmarked by userID.
+	CountBookmarks(ctx context.Context, userID string) (int64, error)
+}
+
+// ReadingListRepository stores the reading lists of each user and their items.
+// Methods taking a userID only act on lists owned by that user.
+type ReadingListRepository interface {
+	// ListLists returns the lists of userID with their ItemCount, in ascending ID order.
+	ListLists(ctx context.Context, userID string) ([]*entity.ReadingList, error)
+	// GetList returns the list with the given ID owned by userID, or nil if there is none.
+	GetList(ctx context.Context, userID string, id int64) (*entity.ReadingList, error)
+	// GetListByShareToken returns the list shared with token, or nil if there is none.
+	GetListByShareToken(ctx context.Context, token string) (*entity.ReadingList, error)
+	// CreateList stores a new list and sets its ID, CreatedAt and UpdatedAt.
+	CreateList(ctx context.Context, list *entity.ReadingList) error
+	// UpdateList updates the name and description of the list, sets its UpdatedAt
+	// and reports whether the list exists.
+	UpdateList(ctx context.Context, list *entity.ReadingList) (bool, error)
+	// DeleteList removes a list with its items and reports whether it existed.
+	DeleteList(ctx context.Context, userID string, id int64) (bool, error)
+	// SetShareToken sets the share token of a list ("" stops sharing) and reports
+	// whether the list exists.
+	SetShareToken(ctx context.Context, userID string, id int64, token string) (bool, error)
+
+	// ListItems returns the items of a list with their articles, ordered by position.
+	ListItems(ctx context.Context, listID int64) ([]ReadingListEntry, error)
+	// AddItem appends an article to a list and reports whether the article exists.
+	// Adding an article that is already in the list only updates its note.
+	// It sets the Position and AddedAt of item.
+	AddItem(ctx context.Context, item *entity.ReadingListItem) (bool, error)
+	// UpdateItemNote updates the note of an item and reports whether the item exists.
+	UpdateItemNote(ctx context.Context, listID, articleID int64, note string) (bool, error)
+	// RemoveItem removes an article from a list and reports whether it was in the list.
+	RemoveItem(ctx context.Context, listID, articleID int64) (bool, error)
+	// ReorderItems sets the order of the items of a list to that of articleIDs,
+	// which must contain every article of the list exactly once.
+	ReorderItems(ctx context.Context, listID int64, articleIDs []int64) error
+}
@@ -0,0 +1,30 @@
+package repository
+
+import "context"
+
+// BulkRepository applies one operation to many articles or sources at once.
+//
+// Each method runs in a single transaction with batched statements (not one
+// statement per item) and returns the IDs the operation was applied to. Items
+// that do not exist or are in the trash are skipped and their IDs are not
+// returned. IDs must be unique.
+type BulkRepository interface {
+	// FindArticleIDs returns the IDs of up to limit articles matching keywords
+	// and filters as in SearchWithFilters, newest first.
+	FindArticleIDs(ctx context.Context, keywords []string, filters ArticleSearchFilters, limit int) ([]int64, error)
+	// DeleteArticles moves the articles to the trash.
+	DeleteArticles(ctx context.Context, ids []int64) ([]int64, error)
+	// MoveArticles changes the source of the articles to sourceID. No article is
+	// changed when the source does not exist or is in the trash.
+	MoveArticles(ctx context.Context, ids []int64, sourceID int64) ([]int64, error)
+	// RetagArticles attaches the add tags to the articles with the given origin,
+	// creating tags that do not exist yet, and detaches the remove tags. Names must
+	// already be normalized (see entity.NormalizeTagNames). Tags already attached
+	// are kept with their original origin.
+	RetagArticles(ctx context.Context, ids []int64, add, remove []string, origin string) ([]int64, error)
+	// SetSourcesActive activates or deactivates the sources.
+	SetSourcesActive(ctx context.Context, ids []int64, active bool) ([]int64, error)
+	// DeleteSources moves the sources and their articles to the trash, as
+	// SourceRepository.Delete does for one source.
+	DeleteSources(ctx context.Context, ids []int64) ([]int64, error)
+}
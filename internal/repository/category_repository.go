@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// CategoryCount is a source category with the number of sources assigned to it.
+// Sources in the trash are not counted.
+type CategoryCount struct {
+	Category    *entity.Category
+	SourceCount int64
+}
+
+// CategoryRepository stores source categories and their assignment to sources.
+type CategoryRepository interface {
+	// ListCategories returns all categories with their source counts, ordered by name.
+	ListCategories(ctx context.Context) ([]CategoryCount, error)
+	// GetCategory returns the category with the given ID, or nil if none.
+	GetCategory(ctx context.Context, id int64) (*entity.Category, error)
+	// GetCategoryByName returns the category with the given name, or nil if none.
+	GetCategoryByName(ctx context.Context, name string) (*entity.Category, error)
+	// CreateCategory stores a new category and sets its ID and CreatedAt.
+	CreateCategory(ctx context.Context, category *entity.Category) error
+	// RenameCategory changes the name of a category and reports whether it exists.
+	RenameCategory(ctx context.Context, id int64, name string) (bool, error)
+	// DeleteCategory removes a category, and with it its assignments, and reports
+	// whether it existed. The sources themselves are kept.
+	DeleteCategory(ctx context.Context, id int64) (bool, error)
+
+	// ListSourceCategories returns the categories of a source, ordered by name.
+	ListSourceCategories(ctx context.Context, sourceID int64) ([]*entity.Category, error)
+	// SetSourceCategories replaces the categories of a source with categoryIDs.
+	SetSourceCategories(ctx context.Context, sourceID int64, categoryIDs []int64) error
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// DigestCandidate is an article that may be included in a digest, with its
+// source name and the names of its topic tags (sorted by name).
+type DigestCandidate struct {
+	Article    *entity.Article
+	SourceName string
+	Tags       []string
+}
+
+// DigestRepository stores generated digests and selects the articles of new digests.
+type DigestRepository interface {
+	// CreateDigest stores a new digest and sets its ID and CreatedAt.
+	CreateDigest(ctx context.Context, digest *entity.Digest) error
+	// GetDigest returns the digest with the given ID, or (nil, nil) if it does not exist.
+	GetDigest(ctx context.Context, id int64) (*entity.Digest, error)
+	// LatestDigest returns the most recent digest of the given period, or (nil, nil) if there is none.
+	LatestDigest(ctx context.Context, period string) (*entity.Digest, error)
+	// ListDigests returns digests newest first.
+	ListDigests(ctx context.Context, offset, limit int) ([]*entity.Digest, error)
+	// CountDigests returns the total number of digests.
+	CountDigests(ctx context.Context) (int64, error)
+
+	// ListDigestCandidates returns up to limit summarized articles fetched in
+	// (from, to] or listed in carried (the PendingArticleIDs of the previous digest),
+	// newest published first. Articles pending batch summarization are excluded.
+	ListDigestCandidates(ctx context.Context, from, to time.Time, carried []int64, limit int) ([]DigestCandidate, error)
+	// ListPendingDigestArticleIDs returns the IDs of the articles fetched in (from, to]
+	// or listed in carried that are still pending batch summarization, in ascending order.
+	ListPendingDigestArticleIDs(ctx context.Context, from, to time.Time, carried []int64) ([]int64, error)
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// ArticleEmbedding is the embedding vector of an article computed with Model.
+type ArticleEmbedding struct {
+	ArticleID int64
+	Model     string
+	Vector    []float32
+}
+
+// EmbeddingRepository stores article embeddings for semantic search and related articles.
+type EmbeddingRepository interface {
+	// SaveEmbedding stores (or replaces) the embedding of an article.
+	SaveEmbedding(ctx context.Context, emb ArticleEmbedding) error
+	// ListEmbeddings returns all embeddings computed with model.
+	ListEmbeddings(ctx context.Context, model string) ([]ArticleEmbedding, error)
+	// ListArticlesWithoutEmbedding returns up to limit summarized articles that have
+	// no embedding computed with model, newest first.
+	ListArticlesWithoutEmbedding(ctx context.Context, model string, limit int) ([]*entity.Article, error)
+	// ListWithSourceByIDs returns the articles with the given IDs and their source
+	// names. IDs that do not exist are skipped; the order is unspecified.
+	ListWithSourceByIDs(ctx context.Context, ids []int64) ([]ArticleWithSource, error)
+}
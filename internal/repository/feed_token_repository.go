@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// FeedTokenRepository stores the feed token of each user.
+type FeedTokenRepository interface {
+	// GetFeedToken returns the feed token of userID, or nil if none.
+	GetFeedToken(ctx context.Context, userID string) (*entity.FeedToken, error)
+	// GetFeedTokenByToken returns the feed token with the given value, or nil if none.
+	GetFeedTokenByToken(ctx context.Context, token string) (*entity.FeedToken, error)
+	// SetFeedToken stores the token of token.UserID, replacing the previous one,
+	// and sets its CreatedAt.
+	SetFeedToken(ctx context.Context, token *entity.FeedToken) error
+	// DeleteFeedToken removes the feed token of userID and reports whether it existed.
+	DeleteFeedToken(ctx context.Context, userID string) (bool, error)
+}
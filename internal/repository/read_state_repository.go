@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// MarkReadFilter selects the articles marked read by MarkAllRead.
+// Nil fields do not restrict the selection.
+type MarkReadFilter struct {
+	Before   *time.Time // Articles published at or before this time
+	SourceID *int64     // Articles of this source
+}
+
+// SourceUnreadCount is the number of unread articles of a source.
+type SourceUnreadCount struct {
+	SourceID   int64
+	SourceName string
+	Count      int64
+}
+
+// ReadStateRepository stores which articles each user has read. Users are
+// identified by the subject of their JWT.
+type ReadStateRepository interface {
+	// MarkRead marks an article read and reports whether the article exists.
+	// Marking an article that is already read updates its read time.
+	MarkRead(ctx context.Context, userID string, articleID int64) (bool, error)
+	// MarkUnread removes the read marker of an article. Unread articles are left as is.
+	MarkUnread(ctx context.Context, userID string, articleID int64) error
+	// MarkAllRead marks all articles selected by filter read and returns the number
+	// of articles that were unread.
+	MarkAllRead(ctx context.Context, userID string, filter MarkReadFilter) (int64, error)
+
+	// ListUnread returns unread articles matching filters with their source names,
+	// newest published first, skipping offset.
+	ListUnread(ctx context.Context, userID string, filters ArticleSearchFilters, offset, limit int) ([]ArticleWithSource, error)
+	// CountUnread returns the number of unread articles matching filters.
+	CountUnread(ctx context.Context, userID string, filters ArticleSearchFilters) (int64, error)
+	// UnreadCountsBySource returns the number of unread articles of every source that
+	// has any, ordered by count (descending) and then by source name.
+	UnreadCountsBySource(ctx context.Context, userID string) ([]SourceUnreadCount, error)
+	// ListUnreadPerSource returns up to perSource newest unread articles of every
+	// source, ordered by source ID and then newest published first.
+	ListUnreadPerSource(ctx context.Context, userID string, perSource int) ([]ArticleWithSource, error)
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// ResummarizeRepository persists resummarize jobs and selects their target articles.
+type ResummarizeRepository interface {
+	// CreateJob stores a new job and sets its ID and CreatedAt.
+	CreateJob(ctx context.Context, job *entity.ResummarizeJob) error
+	// GetJob returns the job with the given ID, or (nil, nil) if it does not exist.
+	GetJob(ctx context.Context, id int64) (*entity.ResummarizeJob, error)
+	// NextJob returns the oldest queued or running job, or (nil, nil) if there is none.
+	// Running jobs come first so that an interrupted job is resumed before new ones start.
+	NextJob(ctx context.Context) (*entity.ResummarizeJob, error)
+	// UpdateJob stores the status, progress and timestamps of a job.
+	UpdateJob(ctx context.Context, job *entity.ResummarizeJob) error
+
+	// CountTargets returns the number of articles matched by filter.
+	CountTargets(ctx context.Context, filter entity.ResummarizeFilter) (int, error)
+	// ListTargets returns up to limit articles matched by filter with an ID greater
+	// than afterID, in ascending ID order.
+	ListTargets(ctx context.Context, filter entity.ResummarizeFilter, afterID int64, limit int) ([]*entity.Article, error)
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// SavedSearchRepository stores the saved searches of each user and finds the new
+// articles matching them. Methods taking a userID only act on searches owned by
+// that user.
+type SavedSearchRepository interface {
+	// ListSearches returns the saved searches of userID in ascending ID order.
+	ListSearches(ctx context.Context, userID string) ([]*entity.SavedSearch, error)
+	// GetSearch returns the saved search with the given ID owned by userID, or nil if none.
+	GetSearch(ctx context.Context, userID string, id int64) (*entity.SavedSearch, error)
+	// CreateSearch stores a new saved search and sets its ID, CreatedAt and UpdatedAt.
+	CreateSearch(ctx context.Context, search *entity.SavedSearch) error
+	// UpdateSearch replaces the name, criteria, subscription, channel and LastArticleID
+	// of a search of search.UserID, sets UpdatedAt and reports whether the search exists.
+	UpdateSearch(ctx context.Context, search *entity.SavedSearch) (bool, error)
+	// DeleteSearch removes a saved search and reports whether it existed.
+	DeleteSearch(ctx context.Context, userID string, id int64) (bool, error)
+
+	// ListSubscribed returns the subscribed searches of all users in ascending ID order.
+	ListSubscribed(ctx context.Context) ([]*entity.SavedSearch, error)
+	// LatestArticleID returns the largest article ID, or 0 when there are no articles.
+	// While articles are pending batch summarization, it returns the ID before the
+	// first pending article so that they are evaluated once summarized.
+	LatestArticleID(ctx context.Context) (int64, error)
+	// ListNewMatches returns up to limit articles with afterID < id <= uptoID that
+	// match keywords and filters (as SearchWithFiltersPaginated), with their source
+	// names, newest first. Articles from the first pending article on are excluded.
+	ListNewMatches(ctx context.Context, keywords []string, filters ArticleSearchFilters, afterID, uptoID int64, limit int) ([]ArticleWithSource, error)
+	// MarkEvaluated sets the LastArticleID of a saved search, capped below the first
+	// pending article, and its LastAlertedAt when alertedAt is not nil.
+	MarkEvaluated(ctx context.Context, id, lastArticleID int64, alertedAt *time.Time) error
+}
@@ -11,6 +11,7 @@ import (
 type SourceSearchFilters struct {
 	SourceType *string // Optional: Filter by source type (RSS, Webflow, NextJS, Remix)
 	Active     *bool   // Optional: Filter by active status
+	CategoryID *int64  // Optional: Filter by source category
 }
 
 type SourceRepository interface {
@@ -24,3 +25,27 @@ type SourceRepository interface {
 	Delete(ctx context.Context, id int64) error
 	TouchCrawledAt(ctx context.Context, id int64, t time.Time) error
 }
+
+// SourceArticleCounts are the article counts and crawl health figures of one source.
+// Articles in the trash are not counted.
+type SourceArticleCounts struct {
+	Total int64
+	// LastDay and LastWeek are the numbers of articles created since the dayStart
+	// and weekStart given to CountBySource.
+	LastDay  int64
+	LastWeek int64
+	// LastArticleAt is when the newest article was stored. Nil without articles.
+	LastArticleAt *time.Time
+	// SummarizeFailuresLastWeek is the number of failed summarizations since weekStart.
+	SummarizeFailuresLastWeek int64
+}
+
+// SourceArticleRepository reads the articles of one source. Articles in the trash
+// are excluded.
+type SourceArticleRepository interface {
+	// ListBySource returns up to limit articles of the source, skipping offset,
+	// ordered by published_at DESC, id DESC.
+	ListBySource(ctx context.Context, sourceID int64, offset, limit int) ([]*entity.Article, error)
+	// CountBySource returns the article counts of the source.
+	CountBySource(ctx context.Context, sourceID int64, dayStart, weekStart time.Time) (SourceArticleCounts, error)
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// Intervals of an ingestion time series. Weeks start on Monday; all buckets are in UTC.
+const (
+	StatsIntervalHour = "hour"
+	StatsIntervalDay  = "day"
+	StatsIntervalWeek = "week"
+)
+
+// SourceStats are the activity figures of one source.
+type SourceStats struct {
+	SourceID   int64
+	SourceName string
+	Active     bool
+	// ArticlesTotal is the number of articles of the source (not counting the trash).
+	ArticlesTotal int64
+	// ArticlesLastDay and ArticlesLastWeek are the numbers of articles created
+	// since the dayStart and weekStart given to SourceStats.
+	ArticlesLastDay  int64
+	ArticlesLastWeek int64
+	// AvgSummaryLength is the average summary length in characters (0 without summaries).
+	AvgSummaryLength float64
+	// SummarizeFailuresLastWeek is the number of failed summarizations since weekStart.
+	SummarizeFailuresLastWeek int64
+	// LastArticleAt is when the newest article was stored, i.e. the last
+	// successful ingestion. Nil when the source has no article.
+	LastArticleAt *time.Time
+	LastCrawledAt *time.Time
+}
+
+// IngestionBucket is the number of articles stored in one interval.
+type IngestionBucket struct {
+	Start time.Time
+	Count int64
+}
+
+// StatsRepository computes source and article statistics with SQL aggregates.
+// Sources and articles in the trash are not counted.
+type StatsRepository interface {
+	// SourceStats returns the statistics of every source, in ascending ID order.
+	SourceStats(ctx context.Context, dayStart, weekStart time.Time) ([]SourceStats, error)
+	// IngestionSeries returns the number of articles created in [from, to) per
+	// interval (one of the StatsInterval* constants), optionally of one source.
+	// Intervals without articles are omitted.
+	IngestionSeries(ctx context.Context, interval string, from, to time.Time, sourceID *int64) ([]IngestionBucket, error)
+}
+
+// SummarizeFailureRepository records the failed summarizations counted by
+// StatsRepository.SourceStats. Failures older than 30 days are discarded.
+type SummarizeFailureRepository interface {
+	// RecordSummarizeFailure records that summarizing the article at url of the
+	// source failed, so the article was not stored.
+	RecordSummarizeFailure(ctx context.Context, sourceID int64, url string) error
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// SummaryBatchRepository looks up articles whose summaries are awaited from
+// batch summarization requests (entity.SummaryStatusPending).
+type SummaryBatchRepository interface {
+	// ListPendingBatchIDs returns the distinct IDs of submitted batches that still
+	// have pending articles.
+	ListPendingBatchIDs(ctx context.Context) ([]string, error)
+	// ListPendingByBatch returns the pending articles submitted in the given batch.
+	// An empty batchID returns pending articles that have not been submitted.
+	ListPendingByBatch(ctx context.Context, batchID string) ([]*entity.Article, error)
+	// DiscardPending permanently deletes a pending article whose summary will not
+	// arrive. Unlike ArticleRepository.Delete it does not move the article to the
+	// trash, so the next crawl fetches its URL again as a new article.
+	DiscardPending(ctx context.Context, id int64) error
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// TagCount is a tag name with the number of articles it is attached to.
+type TagCount struct {
+	Name  string
+	Count int64
+}
+
+// TagRepository stores topic tags and their assignment to articles.
+type TagRepository interface {
+	// AddArticleTags attaches the named tags to an article, creating tags that do not
+	// exist yet. Names must already be normalized (see entity.NormalizeTagNames).
+	// Tags already attached to the article are kept with their original origin.
+	AddArticleTags(ctx context.Context, articleID int64, names []string, origin string) error
+	// ListTagCounts returns all tags attached to at least one article outside the
+	// trash, ordered by article count (descending) and then by name.
+	ListTagCounts(ctx context.Context) ([]TagCount, error)
+}
+
+// TagRuleRepository stores the keyword and regex rules used for automatic tagging.
+type TagRuleRepository interface {
+	// ListRules returns all tag rules in ascending ID order.
+	ListRules(ctx context.Context) ([]*entity.TagRule, error)
+	// CreateRule stores a new rule and sets its ID and CreatedAt.
+	CreateRule(ctx context.Context, rule *entity.TagRule) error
+	// DeleteRule removes the rule with the given ID and reports whether it existed.
+	DeleteRule(ctx context.Context, id int64) (bool, error)
+}
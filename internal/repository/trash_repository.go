@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// TrashedArticle is an article in the trash.
+type TrashedArticle struct {
+	ArticleWithSource
+	DeletedAt time.Time
+	// SourceDeleted reports whether the source of the article is in the trash too.
+	// Such an article is restored by restoring its source.
+	SourceDeleted bool
+}
+
+// TrashedSource is a source in the trash.
+type TrashedSource struct {
+	Source    *entity.Source
+	DeletedAt time.Time
+	// ArticleCount is the number of articles deleted together with the source,
+	// which are restored with it.
+	ArticleCount int64
+}
+
+// RestoreResult is the outcome of restoring an article or source from the trash.
+type RestoreResult int
+
+const (
+	// RestoreNotFound means that the item does not exist or is not in the trash.
+	RestoreNotFound RestoreResult = iota
+	// Restored means that the item was restored.
+	Restored
+	// RestoreSourceDeleted means that the article was not restored because its
+	// source is in the trash.
+	RestoreSourceDeleted
+)
+
+// PurgeResult is the number of items permanently deleted by TrashRepository.Purge.
+type PurgeResult struct {
+	Articles int64
+	Sources  int64
+}
+
+// TrashRepository manages soft-deleted articles and sources.
+//
+// ArticleRepository.Delete and SourceRepository.Delete move rows to the trash
+// (deleted_at is set) instead of deleting them; every other query ignores rows in
+// the trash. The URLs of articles in the trash and of purged articles still count
+// as fetched (ArticleRepository.ExistsByURL), so the crawler does not insert them
+// again.
+type TrashRepository interface {
+	// ListTrashedArticles returns the articles in the trash with their source
+	// names, most recently deleted first, skipping offset.
+	ListTrashedArticles(ctx context.Context, offset, limit int) ([]TrashedArticle, error)
+	// CountTrashedArticles returns the number of articles in the trash.
+	CountTrashedArticles(ctx context.Context) (int64, error)
+	// ListTrashedSources returns the sources in the trash, most recently deleted first.
+	ListTrashedSources(ctx context.Context) ([]TrashedSource, error)
+	// RestoreArticle takes an article out of the trash.
+	RestoreArticle(ctx context.Context, id int64) (RestoreResult, error)
+	// RestoreSource takes a source out of the trash together with the articles
+	// deleted with it. Articles deleted individually before stay in the trash.
+	RestoreSource(ctx context.Context, id int64) (RestoreResult, error)
+	// Purge permanently deletes the articles and sources put in the trash before
+	// the given time, and the articles of the purged sources. The URLs of the
+	// purged articles are kept so that they are not fetched again.
+	Purge(ctx context.Context, before time.Time) (PurgeResult, error)
+}
@@ -19,4 +19,36 @@ var (
 	// ErrDuplicateArticle indicates that an article with the same URL already exists.
 	// This prevents duplicate articles from being created in the system.
 	ErrDuplicateArticle = errors.New("article with this URL already exists")
+
+	// ErrInvalidResummarizeJobID indicates that the provided resummarize job ID is invalid.
+	ErrInvalidResummarizeJobID = errors.New("invalid resummarize job ID")
+
+	// ErrResummarizeJobNotFound indicates that the requested resummarize job was not found.
+	ErrResummarizeJobNotFound = errors.New("resummarize job not found")
+
+	// ErrSemanticSearchDisabled indicates that semantic search or related articles
+	// were requested but no embedding provider is configured.
+	ErrSemanticSearchDisabled = errors.New("semantic search is not enabled: an embedding provider must be configured")
+
+	// ErrKeysetPaginationUnsupported indicates that cursor pagination was requested
+	// but the article repository does not implement repository.ArticleKeysetRepository.
+	ErrKeysetPaginationUnsupported = errors.New("cursor pagination is not supported by the article repository")
+
+	// ErrRankedSearchUnsupported indicates that ranked search was requested but the
+	// article repository does not implement repository.ArticleRankedSearchRepository.
+	ErrRankedSearchUnsupported = errors.New("ranked search is not supported by the article repository")
+
+	// ErrFacetsUnsupported indicates that facet counts were requested but the
+	// article repository does not implement repository.ArticleFacetRepository.
+	ErrFacetsUnsupported = errors.New("facets are not supported by the article repository")
+
+	// ErrInvalidFacet indicates that an unknown facet was requested.
+	ErrInvalidFacet = errors.New("invalid facet")
+
+	// ErrReadStateUnsupported indicates that unread articles were requested but the
+	// service has no ReadStateRepo.
+	ErrReadStateUnsupported = errors.New("read state is not enabled")
+
+	// ErrUserRequired indicates that unread articles were requested without a user.
+	ErrUserRequired = errors.New("unread articles require an authenticated user")
 )
@@ -0,0 +1,49 @@
+package article
+
+import (
+	"context"
+	"fmt"
+
+	"catchup-feed/internal/repository"
+)
+
+// facetBucketLimits bounds the number of buckets returned per facet: the largest
+// sources and tags, and the latest two years of months.
+var facetBucketLimits = map[string]int{
+	repository.FacetSource: 20,
+	repository.FacetTag:    20,
+	repository.FacetMonth:  24,
+}
+
+// ValidFacet reports whether name is a facet SearchFacets can count.
+func ValidFacet(name string) bool {
+	_, ok := facetBucketLimits[name]
+	return ok
+}
+
+// SearchFacets counts the articles matching keywords and filters (the conditions of
+// SearchWithFiltersPaginated) per value of each of facets.
+// Returns ErrFacetsUnsupported if the repository does not support it.
+func (s *Service) SearchFacets(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters, facets []string) (map[string][]repository.FacetBucket, error) {
+	repo, ok := s.Repo.(repository.ArticleFacetRepository)
+	if !ok {
+		return nil, ErrFacetsUnsupported
+	}
+
+	result := make(map[string][]repository.FacetBucket, len(facets))
+	for _, facet := range facets {
+		limit, ok := facetBucketLimits[facet]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidFacet, facet)
+		}
+		if _, done := result[facet]; done {
+			continue
+		}
+		buckets, err := repo.CountFacet(ctx, keywords, filters, facet, limit)
+		if err != nil {
+			return nil, fmt.Errorf("count %s facet: %w", facet, err)
+		}
+		result[facet] = buckets
+	}
+	return result, nil
+}
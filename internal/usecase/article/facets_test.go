@@ -0,0 +1,120 @@
+package article_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"catchup-feed/internal/repository"
+	"catchup-feed/internal/usecase/article"
+)
+
+// facetArticleRepo adds facet counts to mockArticleRepo.
+type facetArticleRepo struct {
+	mockArticleRepo
+	buckets    map[string][]repository.FacetBucket
+	facetErr   error
+	gotFacets  []string
+	gotLimits  map[string]int
+	gotFilters repository.ArticleSearchFilters
+}
+
+func (m *facetArticleRepo) CountFacet(_ context.Context, _ []string, filters repository.ArticleSearchFilters, facet string, limit int) ([]repository.FacetBucket, error) {
+	if m.facetErr != nil {
+		return nil, m.facetErr
+	}
+	if m.gotLimits == nil {
+		m.gotLimits = map[string]int{}
+	}
+	m.gotFacets = append(m.gotFacets, facet)
+	m.gotLimits[facet] = limit
+	m.gotFilters = filters
+	return m.buckets[facet], nil
+}
+
+func TestService_SearchFacets(t *testing.T) {
+	sourceID := int64(3)
+	filters := repository.ArticleSearchFilters{SourceID: &sourceID}
+	repo := &facetArticleRepo{buckets: map[string][]repository.FacetBucket{
+		repository.FacetSource: {{Value: "3", Label: "Go Blog", Count: 12}},
+		repository.FacetMonth:  {{Value: "2025-06", Count: 7}},
+	}}
+	svc := article.Service{Repo: repo}
+
+	got, err := svc.SearchFacets(context.Background(), []string{"go"}, filters,
+		[]string{repository.FacetSource, repository.FacetMonth, repository.FacetSource})
+	if err != nil {
+		t.Fatalf("SearchFacets err=%v", err)
+	}
+
+	want := map[string][]repository.FacetBucket{
+		repository.FacetSource: {{Value: "3", Label: "Go Blog", Count: 12}},
+		repository.FacetMonth:  {{Value: "2025-06", Count: 7}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("SearchFacets mismatch (-want +got):\n%s", diff)
+	}
+	// 重複した facet は一度だけ集計する
+	if diff := cmp.Diff([]string{repository.FacetSource, repository.FacetMonth}, repo.gotFacets); diff != "" {
+		t.Errorf("counted facets mismatch (-want +got):\n%s", diff)
+	}
+	if repo.gotLimits[repository.FacetSource] != 20 || repo.gotLimits[repository.FacetMonth] != 24 {
+		t.Errorf("bucket limits = %v", repo.gotLimits)
+	}
+	if repo.gotFilters.SourceID == nil || *repo.gotFilters.SourceID != sourceID {
+		t.Errorf("filters = %+v, want source_id %d", repo.gotFilters, sourceID)
+	}
+}
+
+func TestService_SearchFacets_Errors(t *testing.T) {
+	dbErr := errors.New("db down")
+
+	tests := []struct {
+		name    string
+		repo    repository.ArticleRepository
+		facets  []string
+		wantErr error
+	}{
+		{
+			name:    "unsupported repository",
+			repo:    &mockArticleRepo{},
+			facets:  []string{repository.FacetTag},
+			wantErr: article.ErrFacetsUnsupported,
+		},
+		{
+			name:    "unknown facet",
+			repo:    &facetArticleRepo{},
+			facets:  []string{"author"},
+			wantErr: article.ErrInvalidFacet,
+		},
+		{
+			name:    "repository error",
+			repo:    &facetArticleRepo{facetErr: dbErr},
+			facets:  []string{repository.FacetTag},
+			wantErr: dbErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := article.Service{Repo: tt.repo}
+			_, err := svc.SearchFacets(context.Background(), []string{"go"}, repository.ArticleSearchFilters{}, tt.facets)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SearchFacets err=%v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidFacet(t *testing.T) {
+	for _, name := range []string{repository.FacetSource, repository.FacetMonth, repository.FacetTag} {
+		if !article.ValidFacet(name) {
+			t.Errorf("ValidFacet(%q) = false", name)
+		}
+	}
+	if article.ValidFacet("author") {
+		t.Error(`ValidFacet("author") = true`)
+	}
+}
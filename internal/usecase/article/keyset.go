@@ -0,0 +1,73 @@
+package article
+
+import (
+	"context"
+	"fmt"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/repository"
+)
+
+// ListWithSourceKeyset retrieves a page of articles with source names using keyset
+// pagination. The page starts after params.Cursor (or at the newest article when it
+// is nil) and no total count is computed, so deep pages cost the same as the first.
+// Returns ErrKeysetPaginationUnsupported if the repository does not support it.
+func (s *Service) ListWithSourceKeyset(ctx context.Context, params pagination.Params) (*PaginatedResult, error) {
+	repo, ok := s.Repo.(repository.ArticleKeysetRepository)
+	if !ok {
+		return nil, ErrKeysetPaginationUnsupported
+	}
+
+	strategy := pagination.KeysetStrategy{}
+	query := strategy.CalculateQuery(params)
+	articles, err := repo.ListWithSourceAfter(ctx, toArticleKeyset(params.Cursor), query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("list articles with source keyset: %w", err)
+	}
+	return keysetResult(strategy, params, articles), nil
+}
+
+// SearchWithFiltersKeyset is the keyset pagination counterpart of
+// SearchWithFiltersPaginated (see ListWithSourceKeyset).
+// Returns ErrKeysetPaginationUnsupported if the repository does not support it.
+func (s *Service) SearchWithFiltersKeyset(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters, params pagination.Params) (*PaginatedResult, error) {
+	repo, ok := s.Repo.(repository.ArticleKeysetRepository)
+	if !ok {
+		return nil, ErrKeysetPaginationUnsupported
+	}
+
+	strategy := pagination.KeysetStrategy{}
+	query := strategy.CalculateQuery(params)
+	articles, err := repo.SearchWithFiltersAfter(ctx, keywords, filters, toArticleKeyset(params.Cursor), query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("search articles with filters keyset: %w", err)
+	}
+	return keysetResult(strategy, params, articles), nil
+}
+
+// toArticleKeyset converts a decoded cursor to a repository keyset position.
+func toArticleKeyset(c *pagination.Cursor) *repository.ArticleKeyset {
+	if c == nil {
+		return nil
+	}
+	return &repository.ArticleKeyset{PublishedAt: c.PublishedAt, ID: c.ID}
+}
+
+// keysetResult trims the extra row requested by KeysetStrategy and sets Next to the
+// position of the last returned article when more articles follow.
+func keysetResult(strategy pagination.KeysetStrategy, params pagination.Params, articles []repository.ArticleWithSource) *PaginatedResult {
+	hasMore := len(articles) > params.Limit
+	if hasMore {
+		articles = articles[:params.Limit]
+	}
+
+	result := &PaginatedResult{
+		Data:       articles,
+		Pagination: strategy.BuildMetadata(params, -1, hasMore),
+	}
+	if hasMore {
+		last := articles[len(articles)-1].Article
+		result.Next = &pagination.Cursor{PublishedAt: last.PublishedAt, ID: last.ID}
+	}
+	return result
+}
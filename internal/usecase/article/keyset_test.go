@@ -0,0 +1,167 @@
+package article_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	"catchup-feed/internal/usecase/article"
+)
+
+// keysetArticleRepo adds keyset pagination to mockArticleRepo.
+// articlesWithSrc must be in (published_at DESC, id DESC) order.
+type keysetArticleRepo struct {
+	mockArticleRepo
+	gotAfter    *repository.ArticleKeyset
+	gotLimit    int
+	gotKeywords []string
+}
+
+func (m *keysetArticleRepo) ListWithSourceAfter(_ context.Context, after *repository.ArticleKeyset, limit int) ([]repository.ArticleWithSource, error) {
+	m.gotAfter, m.gotLimit = after, limit
+	if m.listErr != nil {
+		return nil, m.listErr
+	}
+	var out []repository.ArticleWithSource
+	for _, a := range m.articlesWithSrc {
+		if after != nil && !a.Article.PublishedAt.Before(after.PublishedAt) &&
+			!(a.Article.PublishedAt.Equal(after.PublishedAt) && a.Article.ID < after.ID) {
+			continue
+		}
+		if len(out) == limit {
+			break
+		}
+		out = append(out, a)
+	}
+	return out, nil
+}
+
+func (m *keysetArticleRepo) SearchWithFiltersAfter(ctx context.Context, keywords []string, _ repository.ArticleSearchFilters, after *repository.ArticleKeyset, limit int) ([]repository.ArticleWithSource, error) {
+	m.gotKeywords = keywords
+	return m.ListWithSourceAfter(ctx, after, limit)
+}
+
+func keysetArticles() []repository.ArticleWithSource {
+	base := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	// 5 と 4 は同じ公開日時（id で順序が決まる）
+	return []repository.ArticleWithSource{
+		{Article: &entity.Article{ID: 5, PublishedAt: base}, SourceName: "s"},
+		{Article: &entity.Article{ID: 4, PublishedAt: base}, SourceName: "s"},
+		{Article: &entity.Article{ID: 3, PublishedAt: base.Add(-time.Hour)}, SourceName: "s"},
+		{Article: &entity.Article{ID: 2, PublishedAt: base.Add(-2 * time.Hour)}, SourceName: "s"},
+		{Article: &entity.Article{ID: 1, PublishedAt: base.Add(-3 * time.Hour)}, SourceName: "s"},
+	}
+}
+
+func TestService_ListWithSourceKeyset_WalksAllPages(t *testing.T) {
+	repo := &keysetArticleRepo{mockArticleRepo: mockArticleRepo{articlesWithSrc: keysetArticles()}}
+	svc := article.Service{Repo: repo}
+
+	params := pagination.Params{Page: 1, Limit: 2, Keyset: true}
+	var ids []int64
+	for pages := 0; ; pages++ {
+		if pages > 5 {
+			t.Fatal("pagination did not terminate")
+		}
+		result, err := svc.ListWithSourceKeyset(context.Background(), params)
+		if err != nil {
+			t.Fatalf("ListWithSourceKeyset() error = %v", err)
+		}
+		if repo.gotLimit != 3 {
+			t.Errorf("repository limit = %d, want 3 (limit + 1)", repo.gotLimit)
+		}
+		if result.Pagination.Total != -1 || result.Pagination.Limit != 2 {
+			t.Errorf("Pagination = %+v, want Total=-1 Limit=2", result.Pagination)
+		}
+		for _, a := range result.Data {
+			ids = append(ids, a.Article.ID)
+		}
+		if result.Next == nil {
+			break
+		}
+		params.Cursor = result.Next
+	}
+
+	want := []int64{5, 4, 3, 2, 1}
+	if len(ids) != len(want) {
+		t.Fatalf("ids = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("ids = %v, want %v", ids, want)
+		}
+	}
+}
+
+func TestService_ListWithSourceKeyset_ExactLastPage(t *testing.T) {
+	repo := &keysetArticleRepo{mockArticleRepo: mockArticleRepo{articlesWithSrc: keysetArticles()[:2]}}
+	svc := article.Service{Repo: repo}
+
+	result, err := svc.ListWithSourceKeyset(context.Background(), pagination.Params{Limit: 2, Keyset: true})
+	if err != nil {
+		t.Fatalf("ListWithSourceKeyset() error = %v", err)
+	}
+	if len(result.Data) != 2 || result.Next != nil {
+		t.Errorf("got %d items, Next = %v; want 2 items and no next page", len(result.Data), result.Next)
+	}
+}
+
+func TestService_ListWithSourceKeyset_PassesCursor(t *testing.T) {
+	repo := &keysetArticleRepo{}
+	svc := article.Service{Repo: repo}
+	cur := &pagination.Cursor{PublishedAt: time.Unix(1700000000, 0).UTC(), ID: 9}
+
+	if _, err := svc.ListWithSourceKeyset(context.Background(), pagination.Params{Limit: 10, Keyset: true, Cursor: cur}); err != nil {
+		t.Fatalf("ListWithSourceKeyset() error = %v", err)
+	}
+	if repo.gotAfter == nil || repo.gotAfter.ID != 9 || !repo.gotAfter.PublishedAt.Equal(cur.PublishedAt) {
+		t.Errorf("repository after = %+v, want %+v", repo.gotAfter, cur)
+	}
+}
+
+func TestService_ListWithSourceKeyset_Errors(t *testing.T) {
+	t.Run("repository without keyset support", func(t *testing.T) {
+		svc := article.Service{Repo: &mockArticleRepo{}}
+		_, err := svc.ListWithSourceKeyset(context.Background(), pagination.Params{Limit: 10, Keyset: true})
+		if !errors.Is(err, article.ErrKeysetPaginationUnsupported) {
+			t.Errorf("error = %v, want ErrKeysetPaginationUnsupported", err)
+		}
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		dbErr := errors.New("db down")
+		svc := article.Service{Repo: &keysetArticleRepo{mockArticleRepo: mockArticleRepo{listErr: dbErr}}}
+		_, err := svc.ListWithSourceKeyset(context.Background(), pagination.Params{Limit: 10, Keyset: true})
+		if !errors.Is(err, dbErr) {
+			t.Errorf("error = %v, want wrapped %v", err, dbErr)
+		}
+	})
+}
+
+func TestService_SearchWithFiltersKeyset(t *testing.T) {
+	repo := &keysetArticleRepo{mockArticleRepo: mockArticleRepo{articlesWithSrc: keysetArticles()}}
+	svc := article.Service{Repo: repo}
+
+	result, err := svc.SearchWithFiltersKeyset(context.Background(), []string{"go"}, repository.ArticleSearchFilters{},
+		pagination.Params{Limit: 3, Keyset: true})
+	if err != nil {
+		t.Fatalf("SearchWithFiltersKeyset() error = %v", err)
+	}
+	if len(repo.gotKeywords) != 1 || repo.gotKeywords[0] != "go" {
+		t.Errorf("keywords = %v, want [go]", repo.gotKeywords)
+	}
+	if len(result.Data) != 3 || result.Next == nil || result.Next.ID != 3 {
+		t.Errorf("got %d items, Next = %+v; want 3 items and next after id 3", len(result.Data), result.Next)
+	}
+
+	plain := article.Service{Repo: &mockArticleRepo{}}
+	_, err = plain.SearchWithFiltersKeyset(context.Background(), nil,
+		repository.ArticleSearchFilters{}, pagination.Params{Limit: 3, Keyset: true})
+	if !errors.Is(err, article.ErrKeysetPaginationUnsupported) {
+		t.Errorf("error = %v, want ErrKeysetPaginationUnsupported", err)
+	}
+}
@@ -0,0 +1,59 @@
+package article
+
+import (
+	"context"
+	"fmt"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/pkg/search"
+	"catchup-feed/internal/repository"
+)
+
+// RankedResult is a page of a ranked search.
+type RankedResult struct {
+	Data       []repository.RankedArticle
+	Pagination pagination.Metadata
+}
+
+// SearchRanked searches articles matching q and filters, most relevant first.
+// Like SearchWithFiltersPaginated, a failed count is reported as total=-1 instead
+// of failing the search.
+// Returns ErrRankedSearchUnsupported if the repository does not support it.
+func (s *Service) SearchRanked(ctx context.Context, q search.Query, filters repository.ArticleSearchFilters, params pagination.Params) (*RankedResult, error) {
+	repo, ok := s.Repo.(repository.ArticleRankedSearchRepository)
+	if !ok {
+		return nil, ErrRankedSearchUnsupported
+	}
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.Limit <= 0 {
+		params.Limit = 10
+	}
+
+	total, err := repo.CountRanked(ctx, q, filters)
+	if err != nil {
+		// Graceful degradation: 件数が取れなくても検索結果は返す
+		total = -1
+	}
+
+	query := pagination.OffsetStrategy{}.CalculateQuery(params)
+	articles, err := repo.SearchRanked(ctx, q, filters, query.Offset, query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("search articles ranked: %w", err)
+	}
+
+	totalPages := 0
+	if total >= 0 {
+		totalPages = pagination.CalculateTotalPages(total, params.Limit)
+	}
+	return &RankedResult{
+		Data: articles,
+		Pagination: pagination.Metadata{
+			Total:      total,
+			Page:       params.Page,
+			Limit:      params.Limit,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
@@ -0,0 +1,126 @@
+package article_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/pkg/search"
+	"catchup-feed/internal/repository"
+	"catchup-feed/internal/usecase/article"
+)
+
+// rankedArticleRepo adds ranked search to mockArticleRepo.
+type rankedArticleRepo struct {
+	mockArticleRepo
+	ranked     []repository.RankedArticle
+	searchErr  error
+	gotQuery   search.Query
+	gotOffset  int
+	gotLimit   int
+	gotFilters repository.ArticleSearchFilters
+}
+
+func (m *rankedArticleRepo) SearchRanked(_ context.Context, q search.Query, filters repository.ArticleSearchFilters, offset, limit int) ([]repository.RankedArticle, error) {
+	m.gotQuery, m.gotFilters, m.gotOffset, m.gotLimit = q, filters, offset, limit
+	return m.ranked, m.searchErr
+}
+
+func (m *rankedArticleRepo) CountRanked(_ context.Context, _ search.Query, _ repository.ArticleSearchFilters) (int64, error) {
+	return m.totalCount, m.countErr
+}
+
+func TestService_SearchRanked(t *testing.T) {
+	q, err := search.ParseQuery("go OR rust -beta", 10, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sourceID := int64(3)
+
+	tests := []struct {
+		name          string
+		repo          *rankedArticleRepo
+		params        pagination.Params
+		wantErr       error
+		wantOffset    int
+		wantLimit     int
+		wantTotal     int64
+		wantTotalPage int
+	}{
+		{
+			name: "second page",
+			repo: &rankedArticleRepo{
+				mockArticleRepo: mockArticleRepo{totalCount: 45},
+				ranked: []repository.RankedArticle{
+					{ArticleWithSource: repository.ArticleWithSource{Article: &entity.Article{ID: 1}}, Rank: 0.9},
+				},
+			},
+			params:        pagination.Params{Page: 2, Limit: 20},
+			wantOffset:    20,
+			wantLimit:     20,
+			wantTotal:     45,
+			wantTotalPage: 3,
+		},
+		{
+			name:          "defaults",
+			repo:          &rankedArticleRepo{},
+			params:        pagination.Params{},
+			wantOffset:    0,
+			wantLimit:     10,
+			wantTotalPage: 1,
+		},
+		{
+			name:       "count error degrades to unknown total",
+			repo:       &rankedArticleRepo{mockArticleRepo: mockArticleRepo{countErr: errors.New("timeout")}},
+			params:     pagination.Params{Page: 1, Limit: 10},
+			wantLimit:  10,
+			wantTotal:  -1,
+			wantOffset: 0,
+		},
+		{
+			name:    "search error",
+			repo:    &rankedArticleRepo{searchErr: errors.New("db down")},
+			params:  pagination.Params{Page: 1, Limit: 10},
+			wantErr: errors.New("search articles ranked: db down"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := article.Service{Repo: tt.repo}
+			result, err := svc.SearchRanked(context.Background(), q, repository.ArticleSearchFilters{SourceID: &sourceID}, tt.params)
+
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.repo.gotOffset != tt.wantOffset || tt.repo.gotLimit != tt.wantLimit {
+				t.Errorf("offset, limit = %d, %d, want %d, %d", tt.repo.gotOffset, tt.repo.gotLimit, tt.wantOffset, tt.wantLimit)
+			}
+			if tt.repo.gotQuery.String() != q.String() || tt.repo.gotFilters.SourceID != &sourceID {
+				t.Errorf("query, filters = %q, %+v", tt.repo.gotQuery.String(), tt.repo.gotFilters)
+			}
+			if result.Pagination.Total != tt.wantTotal || result.Pagination.TotalPages != tt.wantTotalPage {
+				t.Errorf("Pagination = %+v, want total %d, total pages %d", result.Pagination, tt.wantTotal, tt.wantTotalPage)
+			}
+			if len(result.Data) != len(tt.repo.ranked) {
+				t.Errorf("len(Data) = %d, want %d", len(result.Data), len(tt.repo.ranked))
+			}
+		})
+	}
+}
+
+func TestService_SearchRanked_Unsupported(t *testing.T) {
+	svc := article.Service{Repo: &mockArticleRepo{}}
+	_, err := svc.SearchRanked(context.Background(), search.Query{}, repository.ArticleSearchFilters{}, pagination.Params{})
+	if !errors.Is(err, article.ErrRankedSearchUnsupported) {
+		t.Errorf("error = %v, want ErrRankedSearchUnsupported", err)
+	}
+}
@@ -0,0 +1,57 @@
+package article
+
+import (
+	"context"
+	"fmt"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// RequestResummarize queues a job that regenerates the summaries of the articles
+// matched by filter with the currently configured summarizer. The job is
+// processed by the worker; its progress is available through GetResummarizeJob.
+// Returns a ValidationError if the filter is invalid.
+// Returns ErrArticleNotFound if the filter names a single article that does not exist.
+func (s *Service) RequestResummarize(ctx context.Context, filter entity.ResummarizeFilter, requestedBy string) (*entity.ResummarizeJob, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	if filter.ArticleID != nil {
+		art, err := s.Repo.Get(ctx, *filter.ArticleID)
+		if err != nil {
+			return nil, fmt.Errorf("get article: %w", err)
+		}
+		if art == nil {
+			return nil, ErrArticleNotFound
+		}
+	}
+
+	job := &entity.ResummarizeJob{
+		Status:      entity.ResummarizeQueued,
+		Filter:      filter,
+		RequestedBy: requestedBy,
+	}
+	if err := s.ResummarizeRepo.CreateJob(ctx, job); err != nil {
+		return nil, fmt.Errorf("create resummarize job: %w", err)
+	}
+	return job, nil
+}
+
+// GetResummarizeJob retrieves a resummarize job by its ID.
+// Returns ErrInvalidResummarizeJobID if the ID is not positive.
+// Returns ErrResummarizeJobNotFound if the job does not exist.
+func (s *Service) GetResummarizeJob(ctx context.Context, id int64) (*entity.ResummarizeJob, error) {
+	if id <= 0 {
+		return nil, ErrInvalidResummarizeJobID
+	}
+
+	job, err := s.ResummarizeRepo.GetJob(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get resummarize job: %w", err)
+	}
+	if job == nil {
+		return nil, ErrResummarizeJobNotFound
+	}
+	return job, nil
+}
@@ -0,0 +1,126 @@
+package article_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	artUC "catchup-feed/internal/usecase/article"
+)
+
+// 最小限のインメモリ ResummarizeRepository
+type stubJobRepo struct {
+	jobs map[int64]*entity.ResummarizeJob
+	err  error
+}
+
+func newStubJobRepo() *stubJobRepo {
+	return &stubJobRepo{jobs: map[int64]*entity.ResummarizeJob{}}
+}
+
+func (s *stubJobRepo) CreateJob(_ context.Context, job *entity.ResummarizeJob) error {
+	if s.err != nil {
+		return s.err
+	}
+	job.ID = int64(len(s.jobs) + 1)
+	job.CreatedAt = time.Now()
+	s.jobs[job.ID] = job
+	return nil
+}
+func (s *stubJobRepo) GetJob(_ context.Context, id int64) (*entity.ResummarizeJob, error) {
+	return s.jobs[id], s.err
+}
+func (s *stubJobRepo) NextJob(_ context.Context) (*entity.ResummarizeJob, error) {
+	return nil, nil // テストでは未使用
+}
+func (s *stubJobRepo) UpdateJob(_ context.Context, _ *entity.ResummarizeJob) error {
+	return nil // テストでは未使用
+}
+func (s *stubJobRepo) CountTargets(_ context.Context, _ entity.ResummarizeFilter) (int, error) {
+	return 0, nil // テストでは未使用
+}
+func (s *stubJobRepo) ListTargets(_ context.Context, _ entity.ResummarizeFilter, _ int64, _ int) ([]*entity.Article, error) {
+	return nil, nil // テストでは未使用
+}
+
+func TestService_RequestResummarize(t *testing.T) {
+	artRepo := newStub()
+	artRepo.data[1] = &entity.Article{ID: 1, Title: "Go"}
+	id := func(v int64) *int64 { return &v }
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		filter  entity.ResummarizeFilter
+		wantErr error
+		wantVal bool
+	}{
+		{name: "single article", filter: entity.ResummarizeFilter{ArticleID: id(1)}},
+		{name: "bulk by source and model", filter: entity.ResummarizeFilter{SourceID: id(3), SummaryModel: "old"}},
+		{name: "article not found", filter: entity.ResummarizeFilter{ArticleID: id(99)}, wantErr: artUC.ErrArticleNotFound},
+		{name: "invalid source id", filter: entity.ResummarizeFilter{SourceID: id(0)}, wantVal: true},
+		{name: "from after to", filter: entity.ResummarizeFilter{From: &from, To: &to}, wantVal: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobRepo := newStubJobRepo()
+			svc := artUC.Service{Repo: artRepo, ResummarizeRepo: jobRepo}
+
+			job, err := svc.RequestResummarize(context.Background(), tt.filter, "admin@example.com")
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if tt.wantVal {
+				var ve *entity.ValidationError
+				if !errors.As(err, &ve) {
+					t.Fatalf("err = %v, want ValidationError", err)
+				}
+				if len(jobRepo.jobs) != 0 {
+					t.Error("job created for invalid filter")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if job.ID == 0 || job.Status != entity.ResummarizeQueued || job.RequestedBy != "admin@example.com" {
+				t.Errorf("unexpected job: %+v", job)
+			}
+		})
+	}
+}
+
+func TestService_RequestResummarize_RepoError(t *testing.T) {
+	jobRepo := newStubJobRepo()
+	jobRepo.err = errors.New("db down")
+	svc := artUC.Service{Repo: newStub(), ResummarizeRepo: jobRepo}
+
+	if _, err := svc.RequestResummarize(context.Background(), entity.ResummarizeFilter{}, "admin"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestService_GetResummarizeJob(t *testing.T) {
+	jobRepo := newStubJobRepo()
+	jobRepo.jobs[1] = &entity.ResummarizeJob{ID: 1, Status: entity.ResummarizeRunning}
+	svc := artUC.Service{Repo: newStub(), ResummarizeRepo: jobRepo}
+
+	job, err := svc.GetResummarizeJob(context.Background(), 1)
+	if err != nil || job.ID != 1 {
+		t.Fatalf("GetResummarizeJob(1) = (%+v, %v)", job, err)
+	}
+	if _, err := svc.GetResummarizeJob(context.Background(), 2); !errors.Is(err, artUC.ErrResummarizeJobNotFound) {
+		t.Errorf("err = %v, want ErrResummarizeJobNotFound", err)
+	}
+	if _, err := svc.GetResummarizeJob(context.Background(), 0); !errors.Is(err, artUC.ErrInvalidResummarizeJobID) {
+		t.Errorf("err = %v, want ErrInvalidResummarizeJobID", err)
+	}
+}
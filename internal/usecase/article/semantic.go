@@ -0,0 +1,84 @@
+package article
+
+import (
+	"context"
+	"fmt"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/repository"
+	"catchup-feed/internal/usecase/embedding"
+)
+
+// SemanticSearcher ranks articles by the similarity of their embeddings.
+// It is implemented by embedding.Service.
+type SemanticSearcher interface {
+	// Search returns the articles in [offset, offset+limit) of the ranking of
+	// articles similar to query, and the number of ranked articles.
+	Search(ctx context.Context, query string, offset, limit int) ([]embedding.ScoredArticle, int, error)
+	// Related returns up to limit articles most similar to the given article.
+	Related(ctx context.Context, articleID int64, limit int) ([]embedding.ScoredArticle, error)
+}
+
+// SemanticSearchPaginated searches articles by meaning rather than by keyword:
+// articles are ranked by the cosine similarity between the embedding of query and
+// the embeddings of their title and summary, most similar first.
+// Returns ErrSemanticSearchDisabled if no SemanticSearcher is configured.
+func (s *Service) SemanticSearchPaginated(ctx context.Context, query string, page, limit int) (*PaginatedResult, error) {
+	if s.Semantic == nil {
+		return nil, ErrSemanticSearchDisabled
+	}
+	if page < 1 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+
+	scored, total, err := s.Semantic.Search(ctx, query, pagination.CalculateOffset(page, limit), limit)
+	if err != nil {
+		return nil, fmt.Errorf("semantic search: %w", err)
+	}
+
+	data := make([]repository.ArticleWithSource, len(scored))
+	for i, a := range scored {
+		data[i] = a.ArticleWithSource
+	}
+	return &PaginatedResult{
+		Data: data,
+		Pagination: pagination.Metadata{
+			Total:      int64(total),
+			Page:       page,
+			Limit:      limit,
+			TotalPages: pagination.CalculateTotalPages(int64(total), limit),
+		},
+	}, nil
+}
+
+// Related returns up to limit articles most similar to the article with the given
+// ID, with their similarity scores. The result is empty when the article has not
+// been embedded yet.
+// Returns ErrInvalidArticleID if the ID is not positive.
+// Returns ErrSemanticSearchDisabled if no SemanticSearcher is configured.
+// Returns ErrArticleNotFound if the article does not exist.
+func (s *Service) Related(ctx context.Context, id int64, limit int) ([]embedding.ScoredArticle, error) {
+	if id <= 0 {
+		return nil, ErrInvalidArticleID
+	}
+	if s.Semantic == nil {
+		return nil, ErrSemanticSearchDisabled
+	}
+
+	art, err := s.Repo.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get article: %w", err)
+	}
+	if art == nil {
+		return nil, ErrArticleNotFound
+	}
+
+	related, err := s.Semantic.Related(ctx, id, limit)
+	if err != nil {
+		return nil, fmt.Errorf("related articles: %w", err)
+	}
+	return related, nil
+}
@@ -0,0 +1,119 @@
+package article_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	artUC "catchup-feed/internal/usecase/article"
+	"catchup-feed/internal/usecase/embedding"
+)
+
+// stubSemantic はSemanticSearcherのモック実装
+type stubSemantic struct {
+	ranked     []embedding.ScoredArticle
+	lastOffset int
+	lastLimit  int
+	err        error
+}
+
+func (s *stubSemantic) Search(_ context.Context, _ string, offset, limit int) ([]embedding.ScoredArticle, int, error) {
+	s.lastOffset, s.lastLimit = offset, limit
+	if s.err != nil {
+		return nil, 0, s.err
+	}
+	if offset >= len(s.ranked) {
+		return nil, len(s.ranked), nil
+	}
+	return s.ranked[offset:min(offset+limit, len(s.ranked))], len(s.ranked), nil
+}
+
+func (s *stubSemantic) Related(_ context.Context, _ int64, limit int) ([]embedding.ScoredArticle, error) {
+	s.lastLimit = limit
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.ranked[:min(limit, len(s.ranked))], nil
+}
+
+func scored(ids ...int64) []embedding.ScoredArticle {
+	out := make([]embedding.ScoredArticle, len(ids))
+	for i, id := range ids {
+		out[i] = embedding.ScoredArticle{
+			ArticleWithSource: repository.ArticleWithSource{Article: &entity.Article{ID: id}, SourceName: "src"},
+			Score:             1 - float64(i)/10,
+		}
+	}
+	return out
+}
+
+func TestService_SemanticSearchPaginated(t *testing.T) {
+	sem := &stubSemantic{ranked: scored(5, 3, 9)}
+	svc := artUC.Service{Repo: newStub(), Semantic: sem}
+
+	got, err := svc.SemanticSearchPaginated(context.Background(), "go generics", 2, 2)
+	if err != nil {
+		t.Fatalf("SemanticSearchPaginated() error = %v", err)
+	}
+	if sem.lastOffset != 2 || sem.lastLimit != 2 {
+		t.Errorf("offset, limit = %d, %d; want 2, 2", sem.lastOffset, sem.lastLimit)
+	}
+	if len(got.Data) != 1 || got.Data[0].Article.ID != 9 {
+		t.Errorf("data = %+v, want article 9", got.Data)
+	}
+	if got.Pagination.Total != 3 || got.Pagination.TotalPages != 2 || got.Pagination.Page != 2 {
+		t.Errorf("pagination = %+v", got.Pagination)
+	}
+
+	sem.err = errors.New("api down")
+	if _, err := svc.SemanticSearchPaginated(context.Background(), "go", 1, 10); err == nil {
+		t.Error("SemanticSearchPaginated() error = nil, want error")
+	}
+}
+
+func TestService_SemanticSearchPaginated_Disabled(t *testing.T) {
+	svc := artUC.Service{Repo: newStub()}
+
+	if _, err := svc.SemanticSearchPaginated(context.Background(), "go", 1, 10); !errors.Is(err, artUC.ErrSemanticSearchDisabled) {
+		t.Errorf("err = %v, want ErrSemanticSearchDisabled", err)
+	}
+}
+
+func TestService_Related(t *testing.T) {
+	repo := newStub()
+	repo.data[1] = &entity.Article{ID: 1, Title: "Go"}
+	sem := &stubSemantic{ranked: scored(4, 2)}
+
+	tests := []struct {
+		name    string
+		svc     artUC.Service
+		id      int64
+		wantErr error
+		wantLen int
+	}{
+		{name: "related articles", svc: artUC.Service{Repo: repo, Semantic: sem}, id: 1, wantLen: 2},
+		{name: "invalid id", svc: artUC.Service{Repo: repo, Semantic: sem}, id: 0, wantErr: artUC.ErrInvalidArticleID},
+		{name: "article not found", svc: artUC.Service{Repo: repo, Semantic: sem}, id: 2, wantErr: artUC.ErrArticleNotFound},
+		{name: "disabled", svc: artUC.Service{Repo: repo}, id: 1, wantErr: artUC.ErrSemanticSearchDisabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.svc.Related(context.Background(), tt.id, 5)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Related() error = %v", err)
+			}
+			if len(got) != tt.wantLen {
+				t.Errorf("len = %d, want %d", len(got), tt.wantLen)
+			}
+		})
+	}
+}
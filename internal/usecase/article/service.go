@@ -34,6 +34,14 @@ type UpdateInput struct {
 // It handles business logic for article operations and delegates persistence to the repository.
 type Service struct {
 	Repo repository.ArticleRepository
+	// ResummarizeRepo stores the jobs created by RequestResummarize.
+	ResummarizeRepo repository.ResummarizeRepository
+	// Semantic answers semantic searches and related-article queries (optional).
+	// Without it those operations return ErrSemanticSearchDisabled.
+	Semantic SemanticSearcher
+	// ReadStateRepo answers unread-only listings (optional).
+	// Without it ListUnreadPaginated returns ErrReadStateUnsupported.
+	ReadStateRepo repository.ReadStateRepository
 }
 
 // PaginatedResult represents the result of a paginated query.
@@ -41,6 +49,9 @@ type Service struct {
 type PaginatedResult struct {
 	Data       []repository.ArticleWithSource
 	Pagination pagination.Metadata
+	// Next is the position of the last item when more items follow (keyset
+	// pagination only). Handlers encode it into Pagination.NextCursor.
+	Next *pagination.Cursor
 }
 
 // List retrieves all articles from the repository.
@@ -295,7 +306,8 @@ func (s *Service) Update(ctx context.Context, in UpdateInput) error {
 	return nil
 }
 
-// Delete removes an article by its ID.
+// Delete moves an article to the trash by its ID.
+// It can be restored until the trash is purged (see the trash use case).
 // Returns ErrInvalidArticleID if the ID is not positive.
 // Returns an error if the repository operation fails.
 func (s *Service) Delete(ctx context.Context, id int64) error {
@@ -0,0 +1,42 @@
+package article
+
+import (
+	"context"
+	"fmt"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/repository"
+)
+
+// ListUnreadPaginated retrieves a page of the articles matching filters that userID
+// has not read, newest first, with source names.
+// Returns ErrReadStateUnsupported if the service has no ReadStateRepo.
+func (s *Service) ListUnreadPaginated(ctx context.Context, userID string, filters repository.ArticleSearchFilters, params pagination.Params) (*PaginatedResult, error) {
+	if s.ReadStateRepo == nil {
+		return nil, ErrReadStateUnsupported
+	}
+	if userID == "" {
+		return nil, ErrUserRequired
+	}
+
+	total, err := s.ReadStateRepo.CountUnread(ctx, userID, filters)
+	if err != nil {
+		return nil, fmt.Errorf("count unread articles: %w", err)
+	}
+
+	offset := pagination.CalculateOffset(params.Page, params.Limit)
+	articles, err := s.ReadStateRepo.ListUnread(ctx, userID, filters, offset, params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("list unread articles: %w", err)
+	}
+
+	return &PaginatedResult{
+		Data: articles,
+		Pagination: pagination.Metadata{
+			Total:      total,
+			Page:       params.Page,
+			Limit:      params.Limit,
+			TotalPages: pagination.CalculateTotalPages(total, params.Limit),
+		},
+	}, nil
+}
@@ -0,0 +1,88 @@
+package article_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	"catchup-feed/internal/usecase/article"
+)
+
+// stubReadStateRepo implements the unread queries of repository.ReadStateRepository.
+type stubReadStateRepo struct {
+	repository.ReadStateRepository
+	total      int64
+	articles   []repository.ArticleWithSource
+	err        error
+	gotUser    string
+	gotFilters repository.ArticleSearchFilters
+	gotOffset  int
+	gotLimit   int
+}
+
+func (s *stubReadStateRepo) CountUnread(_ context.Context, userID string, filters repository.ArticleSearchFilters) (int64, error) {
+	s.gotUser, s.gotFilters = userID, filters
+	return s.total, s.err
+}
+
+func (s *stubReadStateRepo) ListUnread(_ context.Context, _ string, _ repository.ArticleSearchFilters, offset, limit int) ([]repository.ArticleWithSource, error) {
+	s.gotOffset, s.gotLimit = offset, limit
+	return s.articles, s.err
+}
+
+func TestService_ListUnreadPaginated(t *testing.T) {
+	readRepo := &stubReadStateRepo{
+		total: 45,
+		articles: []repository.ArticleWithSource{
+			{Article: &entity.Article{ID: 21, Title: "unread"}, SourceName: "Go Blog"},
+		},
+	}
+	svc := article.Service{Repo: &stubRepo{}, ReadStateRepo: readRepo}
+	filters := repository.ArticleSearchFilters{Tags: []string{"go"}}
+
+	got, err := svc.ListUnreadPaginated(context.Background(), "alice", filters, pagination.Params{Page: 3, Limit: 20})
+	if err != nil {
+		t.Fatalf("ListUnreadPaginated err=%v", err)
+	}
+	if len(got.Data) != 1 || got.Data[0].Article.ID != 21 {
+		t.Errorf("Data = %+v", got.Data)
+	}
+	wantMeta := pagination.Metadata{Total: 45, Page: 3, Limit: 20, TotalPages: 3}
+	if got.Pagination != wantMeta {
+		t.Errorf("Pagination = %+v, want %+v", got.Pagination, wantMeta)
+	}
+	if readRepo.gotUser != "alice" || len(readRepo.gotFilters.Tags) != 1 {
+		t.Errorf("CountUnread called with (%q, %+v)", readRepo.gotUser, readRepo.gotFilters)
+	}
+	if readRepo.gotOffset != 40 || readRepo.gotLimit != 20 {
+		t.Errorf("ListUnread offset/limit = %d/%d, want 40/20", readRepo.gotOffset, readRepo.gotLimit)
+	}
+}
+
+func TestService_ListUnreadPaginated_Errors(t *testing.T) {
+	dbErr := errors.New("db down")
+
+	tests := []struct {
+		name     string
+		readRepo repository.ReadStateRepository
+		userID   string
+		wantErr  error
+	}{
+		{name: "read state unsupported", userID: "alice", wantErr: article.ErrReadStateUnsupported},
+		{name: "no user", readRepo: &stubReadStateRepo{}, wantErr: article.ErrUserRequired},
+		{name: "repository error", readRepo: &stubReadStateRepo{err: dbErr}, userID: "alice", wantErr: dbErr},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := article.Service{Repo: &stubRepo{}, ReadStateRepo: tt.readRepo}
+			_, err := svc.ListUnreadPaginated(context.Background(), tt.userID,
+				repository.ArticleSearchFilters{}, pagination.Params{Page: 1, Limit: 20})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// Package bookmark provides use cases for the per-user bookmarks (starred articles)
+// and reading lists: named, ordered collections of articles with notes that can be
+// shared read-only through a share token.
+package bookmark
+
+import "errors"
+
+// Sentinel errors for bookmark and reading list use case operations.
+var (
+	// ErrUserRequired indicates that the request is not associated with a user.
+	// Bookmarks and reading lists are stored per JWT subject.
+	ErrUserRequired = errors.New("authenticated user required for bookmarks")
+
+	// ErrInvalidArticleID indicates that the provided article ID is invalid.
+	// Article IDs must be positive integers.
+	ErrInvalidArticleID = errors.New("invalid article ID")
+
+	// ErrArticleNotFound indicates that the article to bookmark or add to a list does not exist.
+	ErrArticleNotFound = errors.New("article not found")
+
+	// ErrBookmarkNotFound indicates that the article is not bookmarked.
+	ErrBookmarkNotFound = errors.New("bookmark not found")
+
+	// ErrInvalidListID indicates that the provided reading list ID is invalid.
+	// Reading list IDs must be positive integers.
+	ErrInvalidListID = errors.New("invalid reading list ID")
+
+	// ErrListNotFound indicates that the reading list does not exist, is owned by
+	// another user, or (for share tokens) is not shared.
+	ErrListNotFound = errors.New("reading list not found")
+
+	// ErrItemNotFound indicates that the article is not in the reading list.
+	ErrItemNotFound = errors.New("reading list item not found")
+
+	// ErrInvalidOrder indicates that a new item order does not list every article
+	// of the reading list exactly once.
+	ErrInvalidOrder = errors.New("invalid order: must list every article of the reading list exactly once")
+)
@@ -0,0 +1,312 @@
+package bookmark
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+// shareTokenBytes is the number of random bytes in a share token (256 bits).
+const shareTokenBytes = 32
+
+// maxShareTokenLength bounds the tokens looked up by GetSharedList; longer
+// tokens cannot have been issued and are rejected without a query.
+const maxShareTokenLength = 64
+
+// ListInput represents the editable fields of a reading list.
+type ListInput struct {
+	Name        string
+	Description string
+}
+
+// ListWithItems is a reading list with its articles, in list order.
+type ListWithItems struct {
+	List  *entity.ReadingList
+	Items []repository.ReadingListEntry
+}
+
+// ListLists returns the reading lists of userID with their item counts.
+func (s *Service) ListLists(ctx context.Context, userID string) ([]*entity.ReadingList, error) {
+	if userID == "" {
+		return nil, ErrUserRequired
+	}
+	lists, err := s.ListRepo.ListLists(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list reading lists: %w", err)
+	}
+	return lists, nil
+}
+
+// CreateList validates and stores a new reading list of userID.
+// Returns a ValidationError if the input is invalid.
+func (s *Service) CreateList(ctx context.Context, userID string, in ListInput) (*entity.ReadingList, error) {
+	if userID == "" {
+		return nil, ErrUserRequired
+	}
+	list := &entity.ReadingList{
+		UserID:      userID,
+		Name:        strings.TrimSpace(in.Name),
+		Description: strings.TrimSpace(in.Description),
+	}
+	if err := list.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.ListRepo.CreateList(ctx, list); err != nil {
+		return nil, fmt.Errorf("create reading list: %w", err)
+	}
+	return list, nil
+}
+
+// GetList returns a reading list of userID with its items.
+// Returns ErrListNotFound if the list does not exist or is owned by another user.
+func (s *Service) GetList(ctx context.Context, userID string, id int64) (*ListWithItems, error) {
+	list, err := s.ownedList(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.withItems(ctx, list)
+}
+
+// UpdateList replaces the name and description of a reading list of userID.
+// Returns a ValidationError if the input is invalid and ErrListNotFound if the
+// list does not exist or is owned by another user.
+func (s *Service) UpdateList(ctx context.Context, userID string, id int64, in ListInput) (*entity.ReadingList, error) {
+	list, err := s.ownedList(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	list.Name = strings.TrimSpace(in.Name)
+	list.Description = strings.TrimSpace(in.Description)
+	if err := list.Validate(); err != nil {
+		return nil, err
+	}
+
+	found, err := s.ListRepo.UpdateList(ctx, list)
+	if err != nil {
+		return nil, fmt.Errorf("update reading list: %w", err)
+	}
+	if !found {
+		return nil, ErrListNotFound
+	}
+	return list, nil
+}
+
+// DeleteList removes a reading list of userID with its items. The articles are kept.
+// Returns ErrListNotFound if the list does not exist or is owned by another user.
+func (s *Service) DeleteList(ctx context.Context, userID string, id int64) error {
+	if err := validateUserList(userID, id); err != nil {
+		return err
+	}
+	deleted, err := s.ListRepo.DeleteList(ctx, userID, id)
+	if err != nil {
+		return fmt.Errorf("delete reading list: %w", err)
+	}
+	if !deleted {
+		return ErrListNotFound
+	}
+	return nil
+}
+
+// ShareList issues a new share token for a reading list of userID and returns it.
+// Anyone with the token can read the list without logging in. Sharing an already
+// shared list replaces its token, so the previous link stops working.
+func (s *Service) ShareList(ctx context.Context, userID string, id int64) (string, error) {
+	if err := validateUserList(userID, id); err != nil {
+		return "", err
+	}
+	token, err := newShareToken()
+	if err != nil {
+		return "", fmt.Errorf("share reading list: %w", err)
+	}
+	found, err := s.ListRepo.SetShareToken(ctx, userID, id, token)
+	if err != nil {
+		return "", fmt.Errorf("share reading list: %w", err)
+	}
+	if !found {
+		return "", ErrListNotFound
+	}
+	return token, nil
+}
+
+// UnshareList revokes the share token of a reading list of userID.
+// Unsharing a list that is not shared is not an error.
+func (s *Service) UnshareList(ctx context.Context, userID string, id int64) error {
+	if err := validateUserList(userID, id); err != nil {
+		return err
+	}
+	found, err := s.ListRepo.SetShareToken(ctx, userID, id, "")
+	if err != nil {
+		return fmt.Errorf("unshare reading list: %w", err)
+	}
+	if !found {
+		return ErrListNotFound
+	}
+	return nil
+}
+
+// GetSharedList returns the reading list shared with token, with its items.
+// Returns ErrListNotFound if no list is shared with token.
+func (s *Service) GetSharedList(ctx context.Context, token string) (*ListWithItems, error) {
+	if token == "" || len(token) > maxShareTokenLength {
+		return nil, ErrListNotFound
+	}
+	list, err := s.ListRepo.GetListByShareToken(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("get shared reading list: %w", err)
+	}
+	if list == nil {
+		return nil, ErrListNotFound
+	}
+	return s.withItems(ctx, list)
+}
+
+// AddItem appends an article to a reading list of userID with an optional note.
+// Adding an article that is already in the list keeps its position and replaces its note.
+// Returns ErrListNotFound or ErrArticleNotFound if the list or article does not exist.
+func (s *Service) AddItem(ctx context.Context, userID string, listID, articleID int64, note string) (*entity.ReadingListItem, error) {
+	if articleID <= 0 {
+		return nil, ErrInvalidArticleID
+	}
+	if err := entity.ValidateReadingListNote(note); err != nil {
+		return nil, err
+	}
+	if _, err := s.ownedList(ctx, userID, listID); err != nil {
+		return nil, err
+	}
+
+	item := &entity.ReadingListItem{ListID: listID, ArticleID: articleID, Note: strings.TrimSpace(note)}
+	found, err := s.ListRepo.AddItem(ctx, item)
+	if err != nil {
+		return nil, fmt.Errorf("add reading list item: %w", err)
+	}
+	if !found {
+		return nil, ErrArticleNotFound
+	}
+	return item, nil
+}
+
+// UpdateItemNote replaces the note of an article in a reading list of userID.
+// Returns ErrItemNotFound if the article is not in the list.
+func (s *Service) UpdateItemNote(ctx context.Context, userID string, listID, articleID int64, note string) error {
+	if articleID <= 0 {
+		return ErrInvalidArticleID
+	}
+	if err := entity.ValidateReadingListNote(note); err != nil {
+		return err
+	}
+	if _, err := s.ownedList(ctx, userID, listID); err != nil {
+		return err
+	}
+
+	found, err := s.ListRepo.UpdateItemNote(ctx, listID, articleID, strings.TrimSpace(note))
+	if err != nil {
+		return fmt.Errorf("update reading list item: %w", err)
+	}
+	if !found {
+		return ErrItemNotFound
+	}
+	return nil
+}
+
+// RemoveItem removes an article from a reading list of userID.
+// Returns ErrItemNotFound if the article is not in the list.
+func (s *Service) RemoveItem(ctx context.Context, userID string, listID, articleID int64) error {
+	if articleID <= 0 {
+		return ErrInvalidArticleID
+	}
+	if _, err := s.ownedList(ctx, userID, listID); err != nil {
+		return err
+	}
+
+	removed, err := s.ListRepo.RemoveItem(ctx, listID, articleID)
+	if err != nil {
+		return fmt.Errorf("remove reading list item: %w", err)
+	}
+	if !removed {
+		return ErrItemNotFound
+	}
+	return nil
+}
+
+// ReorderItems reorders a reading list of userID. articleIDs must list every
+// article of the list exactly once, in the new order.
+// Returns ErrInvalidOrder otherwise.
+func (s *Service) ReorderItems(ctx context.Context, userID string, listID int64, articleIDs []int64) error {
+	if _, err := s.ownedList(ctx, userID, listID); err != nil {
+		return err
+	}
+	items, err := s.ListRepo.ListItems(ctx, listID)
+	if err != nil {
+		return fmt.Errorf("list reading list items: %w", err)
+	}
+
+	// 並べ替え後の順序は、現在の記事の集合と完全に一致する必要がある
+	if len(articleIDs) != len(items) {
+		return ErrInvalidOrder
+	}
+	current := make(map[int64]bool, len(items))
+	for _, e := range items {
+		current[e.Item.ArticleID] = true
+	}
+	for _, id := range articleIDs {
+		if !current[id] {
+			return ErrInvalidOrder
+		}
+		delete(current, id)
+	}
+
+	if err := s.ListRepo.ReorderItems(ctx, listID, articleIDs); err != nil {
+		return fmt.Errorf("reorder reading list items: %w", err)
+	}
+	return nil
+}
+
+// ownedList returns the reading list with the given ID owned by userID.
+func (s *Service) ownedList(ctx context.Context, userID string, id int64) (*entity.ReadingList, error) {
+	if err := validateUserList(userID, id); err != nil {
+		return nil, err
+	}
+	list, err := s.ListRepo.GetList(ctx, userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("get reading list: %w", err)
+	}
+	if list == nil {
+		return nil, ErrListNotFound
+	}
+	return list, nil
+}
+
+// withItems loads the items of list.
+func (s *Service) withItems(ctx context.Context, list *entity.ReadingList) (*ListWithItems, error) {
+	items, err := s.ListRepo.ListItems(ctx, list.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list reading list items: %w", err)
+	}
+	list.ItemCount = len(items)
+	return &ListWithItems{List: list, Items: items}, nil
+}
+
+// validateUserList checks the user and list of a reading list operation.
+func validateUserList(userID string, listID int64) error {
+	if userID == "" {
+		return ErrUserRequired
+	}
+	if listID <= 0 {
+		return ErrInvalidListID
+	}
+	return nil
+}
+
+// newShareToken returns a random URL-safe share token.
+func newShareToken() (string, error) {
+	b := make([]byte, shareTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate share token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,361 @@
+package bookmark_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	"catchup-feed/internal/usecase/bookmark"
+)
+
+// memListRepo is an in-memory repository.ReadingListRepository.
+type memListRepo struct {
+	lists    map[int64]*entity.ReadingList
+	items    map[int64][]entity.ReadingListItem
+	articles map[int64]bool
+	nextID   int64
+	err      error
+}
+
+func newMemListRepo(articleIDs ...int64) *memListRepo {
+	r := &memListRepo{
+		lists:    map[int64]*entity.ReadingList{},
+		items:    map[int64][]entity.ReadingListItem{},
+		articles: map[int64]bool{},
+	}
+	for _, id := range articleIDs {
+		r.articles[id] = true
+	}
+	return r
+}
+
+func (r *memListRepo) ListLists(_ context.Context, userID string) ([]*entity.ReadingList, error) {
+	var out []*entity.ReadingList
+	for _, l := range r.lists {
+		if l.UserID == userID {
+			c := *l
+			c.ItemCount = len(r.items[l.ID])
+			out = append(out, &c)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out, r.err
+}
+
+func (r *memListRepo) GetList(_ context.Context, userID string, id int64) (*entity.ReadingList, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if l, ok := r.lists[id]; ok && l.UserID == userID {
+		c := *l
+		return &c, nil
+	}
+	return nil, nil
+}
+
+func (r *memListRepo) GetListByShareToken(_ context.Context, token string) (*entity.ReadingList, error) {
+	for _, l := range r.lists {
+		if l.ShareToken != "" && l.ShareToken == token {
+			c := *l
+			return &c, nil
+		}
+	}
+	return nil, r.err
+}
+
+func (r *memListRepo) CreateList(_ context.Context, list *entity.ReadingList) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.nextID++
+	list.ID = r.nextID
+	c := *list
+	r.lists[list.ID] = &c
+	return nil
+}
+
+func (r *memListRepo) UpdateList(_ context.Context, list *entity.ReadingList) (bool, error) {
+	l, ok := r.lists[list.ID]
+	if !ok || l.UserID != list.UserID {
+		return false, r.err
+	}
+	l.Name, l.Description = list.Name, list.Description
+	return true, r.err
+}
+
+func (r *memListRepo) DeleteList(_ context.Context, userID string, id int64) (bool, error) {
+	l, ok := r.lists[id]
+	if !ok || l.UserID != userID {
+		return false, r.err
+	}
+	delete(r.lists, id)
+	delete(r.items, id)
+	return true, r.err
+}
+
+func (r *memListRepo) SetShareToken(_ context.Context, userID string, id int64, token string) (bool, error) {
+	l, ok := r.lists[id]
+	if !ok || l.UserID != userID {
+		return false, r.err
+	}
+	l.ShareToken = token
+	return true, r.err
+}
+
+func (r *memListRepo) ListItems(_ context.Context, listID int64) ([]repository.ReadingListEntry, error) {
+	items := append([]entity.ReadingListItem(nil), r.items[listID]...)
+	sort.Slice(items, func(i, j int) bool { return items[i].Position < items[j].Position })
+	var out []repository.ReadingListEntry
+	for _, it := range items {
+		out = append(out, repository.ReadingListEntry{
+			Item:              it,
+			ArticleWithSource: repository.ArticleWithSource{Article: &entity.Article{ID: it.ArticleID}},
+		})
+	}
+	return out, r.err
+}
+
+func (r *memListRepo) AddItem(_ context.Context, item *entity.ReadingListItem) (bool, error) {
+	if !r.articles[item.ArticleID] {
+		return false, r.err
+	}
+	items := r.items[item.ListID]
+	maxPos := 0
+	for i := range items {
+		if items[i].ArticleID == item.ArticleID {
+			items[i].Note = item.Note
+			item.Position = items[i].Position
+			return true, r.err
+		}
+		maxPos = max(maxPos, items[i].Position)
+	}
+	item.Position = maxPos + 1
+	r.items[item.ListID] = append(items, *item)
+	return true, r.err
+}
+
+func (r *memListRepo) UpdateItemNote(_ context.Context, listID, articleID int64, note string) (bool, error) {
+	for i := range r.items[listID] {
+		if r.items[listID][i].ArticleID == articleID {
+			r.items[listID][i].Note = note
+			return true, r.err
+		}
+	}
+	return false, r.err
+}
+
+func (r *memListRepo) RemoveItem(_ context.Context, listID, articleID int64) (bool, error) {
+	items := r.items[listID]
+	for i := range items {
+		if items[i].ArticleID == articleID {
+			r.items[listID] = append(items[:i], items[i+1:]...)
+			return true, r.err
+		}
+	}
+	return false, r.err
+}
+
+func (r *memListRepo) ReorderItems(_ context.Context, listID int64, articleIDs []int64) error {
+	pos := make(map[int64]int, len(articleIDs))
+	for i, id := range articleIDs {
+		pos[id] = i + 1
+	}
+	for i := range r.items[listID] {
+		r.items[listID][i].Position = pos[r.items[listID][i].ArticleID]
+	}
+	return r.err
+}
+
+// itemIDs returns the article IDs of a list in list order.
+func itemIDs(l *bookmark.ListWithItems) []int64 {
+	var ids []int64
+	for _, e := range l.Items {
+		ids = append(ids, e.Item.ArticleID)
+	}
+	return ids
+}
+
+func TestService_ReadingListLifecycle(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemListRepo(7, 8, 9)
+	svc := bookmark.Service{ListRepo: repo}
+
+	list, err := svc.CreateList(ctx, "alice", bookmark.ListInput{Name: "  週次ミーティング  ", Description: "金曜に読む"})
+	if err != nil {
+		t.Fatalf("CreateList err=%v", err)
+	}
+	if list.Name != "週次ミーティング" || list.UserID != "alice" {
+		t.Errorf("list = %+v", list)
+	}
+
+	for _, id := range []int64{7, 8, 9} {
+		if _, err := svc.AddItem(ctx, "alice", list.ID, id, ""); err != nil {
+			t.Fatalf("AddItem(%d) err=%v", id, err)
+		}
+	}
+	// 追加済みの記事はメモだけ更新し、位置は変えない
+	item, err := svc.AddItem(ctx, "alice", list.ID, 7, "最初に読む")
+	if err != nil || item.Position != 1 {
+		t.Fatalf("AddItem (again) = %+v, %v", item, err)
+	}
+
+	if err := svc.ReorderItems(ctx, "alice", list.ID, []int64{9, 7, 8}); err != nil {
+		t.Fatalf("ReorderItems err=%v", err)
+	}
+	if err := svc.RemoveItem(ctx, "alice", list.ID, 8); err != nil {
+		t.Fatalf("RemoveItem err=%v", err)
+	}
+
+	got, err := svc.GetList(ctx, "alice", list.ID)
+	if err != nil {
+		t.Fatalf("GetList err=%v", err)
+	}
+	if diff := cmp.Diff([]int64{9, 7}, itemIDs(got)); diff != "" {
+		t.Errorf("items mismatch (-want +got):\n%s", diff)
+	}
+	if got.List.ItemCount != 2 || got.Items[1].Item.Note != "最初に読む" {
+		t.Errorf("list = %+v, items = %+v", got.List, got.Items)
+	}
+
+	updated, err := svc.UpdateList(ctx, "alice", list.ID, bookmark.ListInput{Name: "weekly"})
+	if err != nil || updated.Name != "weekly" || updated.Description != "" {
+		t.Fatalf("UpdateList = %+v, %v", updated, err)
+	}
+
+	if err := svc.DeleteList(ctx, "alice", list.ID); err != nil {
+		t.Fatalf("DeleteList err=%v", err)
+	}
+	if _, err := svc.GetList(ctx, "alice", list.ID); !errors.Is(err, bookmark.ErrListNotFound) {
+		t.Errorf("GetList after delete err = %v, want %v", err, bookmark.ErrListNotFound)
+	}
+}
+
+func TestService_ReadingListsAreScopedToUser(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemListRepo(7)
+	svc := bookmark.Service{ListRepo: repo}
+
+	list, err := svc.CreateList(ctx, "alice", bookmark.ListInput{Name: "weekly"})
+	if err != nil {
+		t.Fatalf("CreateList err=%v", err)
+	}
+
+	if _, err := svc.GetList(ctx, "bob", list.ID); !errors.Is(err, bookmark.ErrListNotFound) {
+		t.Errorf("GetList err = %v, want %v", err, bookmark.ErrListNotFound)
+	}
+	if _, err := svc.AddItem(ctx, "bob", list.ID, 7, ""); !errors.Is(err, bookmark.ErrListNotFound) {
+		t.Errorf("AddItem err = %v, want %v", err, bookmark.ErrListNotFound)
+	}
+	if _, err := svc.ShareList(ctx, "bob", list.ID); !errors.Is(err, bookmark.ErrListNotFound) {
+		t.Errorf("ShareList err = %v, want %v", err, bookmark.ErrListNotFound)
+	}
+	if err := svc.DeleteList(ctx, "bob", list.ID); !errors.Is(err, bookmark.ErrListNotFound) {
+		t.Errorf("DeleteList err = %v, want %v", err, bookmark.ErrListNotFound)
+	}
+	lists, err := svc.ListLists(ctx, "bob")
+	if err != nil || len(lists) != 0 {
+		t.Errorf("ListLists(bob) = %+v, %v", lists, err)
+	}
+}
+
+func TestService_ShareList(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemListRepo(7)
+	svc := bookmark.Service{ListRepo: repo}
+
+	list, _ := svc.CreateList(ctx, "alice", bookmark.ListInput{Name: "weekly"})
+	if _, err := svc.AddItem(ctx, "alice", list.ID, 7, "必読"); err != nil {
+		t.Fatalf("AddItem err=%v", err)
+	}
+
+	token, err := svc.ShareList(ctx, "alice", list.ID)
+	if err != nil {
+		t.Fatalf("ShareList err=%v", err)
+	}
+	if len(token) != 43 || strings.ContainsAny(token, "+/=") {
+		t.Errorf("token = %q, want 43 URL-safe characters", token)
+	}
+
+	shared, err := svc.GetSharedList(ctx, token)
+	if err != nil {
+		t.Fatalf("GetSharedList err=%v", err)
+	}
+	if shared.List.ID != list.ID || len(shared.Items) != 1 || shared.Items[0].Item.Note != "必読" {
+		t.Errorf("shared = %+v", shared)
+	}
+
+	// 再共有でトークンが変わり、以前のリンクは無効になる
+	rotated, err := svc.ShareList(ctx, "alice", list.ID)
+	if err != nil || rotated == token {
+		t.Fatalf("ShareList (again) = %q, %v", rotated, err)
+	}
+	if _, err := svc.GetSharedList(ctx, token); !errors.Is(err, bookmark.ErrListNotFound) {
+		t.Errorf("GetSharedList (old token) err = %v, want %v", err, bookmark.ErrListNotFound)
+	}
+
+	if err := svc.UnshareList(ctx, "alice", list.ID); err != nil {
+		t.Fatalf("UnshareList err=%v", err)
+	}
+	if _, err := svc.GetSharedList(ctx, rotated); !errors.Is(err, bookmark.ErrListNotFound) {
+		t.Errorf("GetSharedList (revoked) err = %v, want %v", err, bookmark.ErrListNotFound)
+	}
+	for _, bad := range []string{"", strings.Repeat("a", 65)} {
+		if _, err := svc.GetSharedList(ctx, bad); !errors.Is(err, bookmark.ErrListNotFound) {
+			t.Errorf("GetSharedList(%q) err = %v, want %v", bad, err, bookmark.ErrListNotFound)
+		}
+	}
+}
+
+func TestService_ReadingList_Errors(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemListRepo(7, 8)
+	svc := bookmark.Service{ListRepo: repo}
+	list, _ := svc.CreateList(ctx, "alice", bookmark.ListInput{Name: "weekly"})
+	_, _ = svc.AddItem(ctx, "alice", list.ID, 7, "")
+	_, _ = svc.AddItem(ctx, "alice", list.ID, 8, "")
+
+	var vErr *entity.ValidationError
+	if _, err := svc.CreateList(ctx, "alice", bookmark.ListInput{Name: " "}); !errors.As(err, &vErr) {
+		t.Errorf("CreateList (empty name) err = %v, want ValidationError", err)
+	}
+	if _, err := svc.CreateList(ctx, "", bookmark.ListInput{Name: "x"}); !errors.Is(err, bookmark.ErrUserRequired) {
+		t.Errorf("CreateList (no user) err = %v, want %v", err, bookmark.ErrUserRequired)
+	}
+	if _, err := svc.GetList(ctx, "alice", 0); !errors.Is(err, bookmark.ErrInvalidListID) {
+		t.Errorf("GetList (id 0) err = %v, want %v", err, bookmark.ErrInvalidListID)
+	}
+	if _, err := svc.AddItem(ctx, "alice", list.ID, 99, ""); !errors.Is(err, bookmark.ErrArticleNotFound) {
+		t.Errorf("AddItem (missing article) err = %v, want %v", err, bookmark.ErrArticleNotFound)
+	}
+	if _, err := svc.AddItem(ctx, "alice", list.ID, 7, strings.Repeat("a", entity.MaxReadingListNoteLength+1)); !errors.As(err, &vErr) {
+		t.Errorf("AddItem (long note) err = %v, want ValidationError", err)
+	}
+	if err := svc.UpdateItemNote(ctx, "alice", list.ID, 99, "x"); !errors.Is(err, bookmark.ErrItemNotFound) {
+		t.Errorf("UpdateItemNote (missing item) err = %v, want %v", err, bookmark.ErrItemNotFound)
+	}
+	if err := svc.RemoveItem(ctx, "alice", list.ID, 99); !errors.Is(err, bookmark.ErrItemNotFound) {
+		t.Errorf("RemoveItem (missing item) err = %v, want %v", err, bookmark.ErrItemNotFound)
+	}
+
+	for name, order := range map[string][]int64{
+		"missing article":   {7},
+		"duplicate article": {7, 7},
+		"unknown article":   {7, 99},
+	} {
+		if err := svc.ReorderItems(ctx, "alice", list.ID, order); !errors.Is(err, bookmark.ErrInvalidOrder) {
+			t.Errorf("ReorderItems (%s) err = %v, want %v", name, err, bookmark.ErrInvalidOrder)
+		}
+	}
+
+	dbErr := errors.New("db down")
+	repo.err = dbErr
+	if _, err := svc.ListLists(ctx, "alice"); !errors.Is(err, dbErr) {
+		t.Errorf("ListLists err = %v, want %v", err, dbErr)
+	}
+}
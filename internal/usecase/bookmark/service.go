@@ -0,0 +1,90 @@
+package bookmark
+
+import (
+	"context"
+	"fmt"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/repository"
+)
+
+// Service provides the bookmark and reading list use cases. Users are identified
+// by the JWT subject and only see their own bookmarks and lists.
+type Service struct {
+	Repo     repository.BookmarkRepository
+	ListRepo repository.ReadingListRepository
+}
+
+// BookmarkListResult is a page of bookmarked articles with pagination metadata.
+type BookmarkListResult struct {
+	Data       []repository.BookmarkedArticle
+	Pagination pagination.Metadata
+}
+
+// AddBookmark bookmarks an article for userID. Bookmarking an article twice is not an error.
+// Returns ErrArticleNotFound if the article does not exist.
+func (s *Service) AddBookmark(ctx context.Context, userID string, articleID int64) error {
+	if err := validateUserArticle(userID, articleID); err != nil {
+		return err
+	}
+	found, err := s.Repo.AddBookmark(ctx, userID, articleID)
+	if err != nil {
+		return fmt.Errorf("add bookmark: %w", err)
+	}
+	if !found {
+		return ErrArticleNotFound
+	}
+	return nil
+}
+
+// RemoveBookmark removes the bookmark of an article for userID.
+// Returns ErrBookmarkNotFound if the article is not bookmarked.
+func (s *Service) RemoveBookmark(ctx context.Context, userID string, articleID int64) error {
+	if err := validateUserArticle(userID, articleID); err != nil {
+		return err
+	}
+	removed, err := s.Repo.RemoveBookmark(ctx, userID, articleID)
+	if err != nil {
+		return fmt.Errorf("remove bookmark: %w", err)
+	}
+	if !removed {
+		return ErrBookmarkNotFound
+	}
+	return nil
+}
+
+// ListBookmarks returns a page of the articles bookmarked by userID, most recently
+// bookmarked first.
+func (s *Service) ListBookmarks(ctx context.Context, userID string, params pagination.Params) (*BookmarkListResult, error) {
+	if userID == "" {
+		return nil, ErrUserRequired
+	}
+	total, err := s.Repo.CountBookmarks(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("count bookmarks: %w", err)
+	}
+	bookmarks, err := s.Repo.ListBookmarks(ctx, userID, pagination.CalculateOffset(params.Page, params.Limit), params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("list bookmarks: %w", err)
+	}
+	return &BookmarkListResult{
+		Data: bookmarks,
+		Pagination: pagination.Metadata{
+			Total:      total,
+			Page:       params.Page,
+			Limit:      params.Limit,
+			TotalPages: pagination.CalculateTotalPages(total, params.Limit),
+		},
+	}, nil
+}
+
+// validateUserArticle checks the user and article of a per-user article operation.
+func validateUserArticle(userID string, articleID int64) error {
+	if userID == "" {
+		return ErrUserRequired
+	}
+	if articleID <= 0 {
+		return ErrInvalidArticleID
+	}
+	return nil
+}
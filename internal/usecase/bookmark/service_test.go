@@ -0,0 +1,117 @@
+package bookmark_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	"catchup-feed/internal/usecase/bookmark"
+)
+
+/* ───────── モック ───────── */
+
+type stubBookmarkRepo struct {
+	found     bool
+	total     int64
+	bookmarks []repository.BookmarkedArticle
+	err       error
+	gotUser   string
+	gotID     int64
+	gotOffset int
+	gotLimit  int
+}
+
+func (s *stubBookmarkRepo) AddBookmark(_ context.Context, userID string, articleID int64) (bool, error) {
+	s.gotUser, s.gotID = userID, articleID
+	return s.found, s.err
+}
+
+func (s *stubBookmarkRepo) RemoveBookmark(_ context.Context, userID string, articleID int64) (bool, error) {
+	s.gotUser, s.gotID = userID, articleID
+	return s.found, s.err
+}
+
+func (s *stubBookmarkRepo) ListBookmarks(_ context.Context, userID string, offset, limit int) ([]repository.BookmarkedArticle, error) {
+	s.gotUser, s.gotOffset, s.gotLimit = userID, offset, limit
+	return s.bookmarks, s.err
+}
+
+func (s *stubBookmarkRepo) CountBookmarks(_ context.Context, _ string) (int64, error) {
+	return s.total, s.err
+}
+
+/* ───────── テスト ───────── */
+
+func TestService_AddBookmark(t *testing.T) {
+	dbErr := errors.New("db down")
+
+	tests := []struct {
+		name      string
+		repo      *stubBookmarkRepo
+		userID    string
+		articleID int64
+		wantErr   error
+	}{
+		{name: "bookmarked", repo: &stubBookmarkRepo{found: true}, userID: "alice", articleID: 7},
+		{name: "article not found", repo: &stubBookmarkRepo{}, userID: "alice", articleID: 7, wantErr: bookmark.ErrArticleNotFound},
+		{name: "no user", repo: &stubBookmarkRepo{found: true}, articleID: 7, wantErr: bookmark.ErrUserRequired},
+		{name: "invalid article", repo: &stubBookmarkRepo{found: true}, userID: "alice", articleID: 0, wantErr: bookmark.ErrInvalidArticleID},
+		{name: "repository error", repo: &stubBookmarkRepo{err: dbErr}, userID: "alice", articleID: 7, wantErr: dbErr},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := bookmark.Service{Repo: tt.repo}
+			err := svc.AddBookmark(context.Background(), tt.userID, tt.articleID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (tt.repo.gotUser != "alice" || tt.repo.gotID != 7) {
+				t.Errorf("repo called with (%q, %d)", tt.repo.gotUser, tt.repo.gotID)
+			}
+		})
+	}
+}
+
+func TestService_RemoveBookmark(t *testing.T) {
+	svc := bookmark.Service{Repo: &stubBookmarkRepo{found: true}}
+	if err := svc.RemoveBookmark(context.Background(), "alice", 7); err != nil {
+		t.Fatalf("RemoveBookmark err=%v", err)
+	}
+
+	svc = bookmark.Service{Repo: &stubBookmarkRepo{}}
+	if err := svc.RemoveBookmark(context.Background(), "alice", 7); !errors.Is(err, bookmark.ErrBookmarkNotFound) {
+		t.Fatalf("err = %v, want %v", err, bookmark.ErrBookmarkNotFound)
+	}
+}
+
+func TestService_ListBookmarks(t *testing.T) {
+	repo := &stubBookmarkRepo{
+		total: 21,
+		bookmarks: []repository.BookmarkedArticle{
+			{ArticleWithSource: repository.ArticleWithSource{Article: &entity.Article{ID: 3}, SourceName: "Go Blog"}},
+		},
+	}
+	svc := bookmark.Service{Repo: repo}
+
+	got, err := svc.ListBookmarks(context.Background(), "alice", pagination.Params{Page: 2, Limit: 20})
+	if err != nil {
+		t.Fatalf("ListBookmarks err=%v", err)
+	}
+	if len(got.Data) != 1 || got.Data[0].Article.ID != 3 {
+		t.Errorf("Data = %+v", got.Data)
+	}
+	wantMeta := pagination.Metadata{Total: 21, Page: 2, Limit: 20, TotalPages: 2}
+	if got.Pagination != wantMeta {
+		t.Errorf("Pagination = %+v, want %+v", got.Pagination, wantMeta)
+	}
+	if repo.gotUser != "alice" || repo.gotOffset != 20 || repo.gotLimit != 20 {
+		t.Errorf("repo called with (%q, %d, %d)", repo.gotUser, repo.gotOffset, repo.gotLimit)
+	}
+
+	if _, err := svc.ListBookmarks(context.Background(), "", pagination.Params{Page: 1, Limit: 20}); !errors.Is(err, bookmark.ErrUserRequired) {
+		t.Errorf("err = %v, want %v", err, bookmark.ErrUserRequired)
+	}
+}
@@ -0,0 +1,17 @@
+// Package bulk provides the bulk operation use cases: one action (delete, move to
+// another source, re-tag, activate, ...) applied to a batch of articles or
+// sources in a single transaction, with a result for each item.
+package bulk
+
+import "errors"
+
+// Sentinel errors for bulk use case operations.
+var (
+	// ErrBatchTooLarge indicates that a request selects more than MaxBatchSize items,
+	// either by listing their IDs or with a filter matching too many articles.
+	ErrBatchTooLarge = errors.New("too many items in bulk request")
+
+	// ErrSourceNotFound indicates that the source articles should be moved to
+	// does not exist or is in the trash.
+	ErrSourceNotFound = errors.New("target source not found")
+)
@@ -0,0 +1,259 @@
+package bulk
+
+import (
+	"context"
+	"fmt"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/pkg/search"
+	"catchup-feed/internal/repository"
+)
+
+// MaxBatchSize is the maximum number of items of one bulk request.
+const MaxBatchSize = 500
+
+// Article actions.
+const (
+	// ArticleActionDelete moves the articles to the trash.
+	ArticleActionDelete = "delete"
+	// ArticleActionUpdateSource moves the articles to another source.
+	ArticleActionUpdateSource = "update_source"
+	// ArticleActionRetag attaches and detaches tags.
+	ArticleActionRetag = "retag"
+)
+
+// Source actions.
+const (
+	// SourceActionActivate activates the sources, so that they are crawled.
+	SourceActionActivate = "activate"
+	// SourceActionDeactivate deactivates the sources.
+	SourceActionDeactivate = "deactivate"
+	// SourceActionDelete moves the sources and their articles to the trash.
+	SourceActionDelete = "delete"
+)
+
+// Item statuses.
+const (
+	// StatusOK means that the action was applied to the item.
+	StatusOK = "ok"
+	// StatusNotFound means that the item does not exist or is in the trash.
+	StatusNotFound = "not_found"
+	// StatusInvalidID means that the ID is not a positive integer.
+	StatusInvalidID = "invalid_id"
+)
+
+// Service provides the bulk use cases.
+type Service struct {
+	Repo repository.BulkRepository
+	// SourceRepo is used to check the target source of ArticleActionUpdateSource.
+	SourceRepo repository.SourceRepository
+}
+
+// ArticleFilter selects articles as /articles/search does.
+type ArticleFilter struct {
+	Keyword string
+	Filters repository.ArticleSearchFilters
+}
+
+// ArticleRequest is a bulk action on articles. The articles are selected either
+// by IDs or by Filter, not both.
+type ArticleRequest struct {
+	Action string
+	IDs    []int64
+	Filter *ArticleFilter
+	// SourceID is the target source of ArticleActionUpdateSource.
+	SourceID int64
+	// AddTags and RemoveTags are the tags of ArticleActionRetag.
+	AddTags    []string
+	RemoveTags []string
+}
+
+// SourceRequest is a bulk action on the sources with the given IDs.
+type SourceRequest struct {
+	Action string
+	IDs    []int64
+}
+
+// ItemResult is the outcome of a bulk action for one item.
+type ItemResult struct {
+	ID     int64
+	Status string
+}
+
+// Result is the outcome of a bulk action, with one item per requested ID in
+// request order (duplicate IDs are reported once).
+type Result struct {
+	Action    string
+	Items     []ItemResult
+	Succeeded int
+	Failed    int
+}
+
+// Articles applies req to the selected articles in one transaction.
+// Returns a *entity.ValidationError for an invalid request, ErrBatchTooLarge if
+// more than MaxBatchSize articles are selected, and ErrSourceNotFound if the
+// target source of ArticleActionUpdateSource does not exist.
+func (s *Service) Articles(ctx context.Context, req ArticleRequest) (*Result, error) {
+	if err := validateTarget(req.IDs, req.Filter != nil); err != nil {
+		return nil, err
+	}
+	var add, remove []string
+	switch req.Action {
+	case ArticleActionDelete:
+	case ArticleActionUpdateSource:
+		if req.SourceID <= 0 {
+			return nil, &entity.ValidationError{Field: "source_id", Message: "is required for update_source"}
+		}
+	case ArticleActionRetag:
+		add, remove = entity.NormalizeTagNames(req.AddTags), entity.NormalizeTagNames(req.RemoveTags)
+		if len(add) == 0 && len(remove) == 0 {
+			return nil, &entity.ValidationError{Field: "add_tags", Message: "add_tags or remove_tags is required for retag"}
+		}
+	default:
+		return nil, &entity.ValidationError{Field: "action",
+			Message: fmt.Sprintf("must be %q, %q or %q", ArticleActionDelete, ArticleActionUpdateSource, ArticleActionRetag)}
+	}
+
+	ids := req.IDs
+	if req.Filter != nil {
+		var err error
+		if ids, err = s.findArticleIDs(ctx, *req.Filter); err != nil {
+			return nil, err
+		}
+	}
+	if req.Action == ArticleActionUpdateSource {
+		source, err := s.SourceRepo.Get(ctx, req.SourceID)
+		if err != nil {
+			return nil, fmt.Errorf("get source: %w", err)
+		}
+		if source == nil {
+			return nil, ErrSourceNotFound
+		}
+	}
+
+	return apply(req.Action, ids, func(valid []int64) ([]int64, error) {
+		switch req.Action {
+		case ArticleActionDelete:
+			return s.Repo.DeleteArticles(ctx, valid)
+		case ArticleActionUpdateSource:
+			return s.Repo.MoveArticles(ctx, valid, req.SourceID)
+		default:
+			return s.Repo.RetagArticles(ctx, valid, add, remove, entity.TagOriginManual)
+		}
+	})
+}
+
+// Sources applies req to the sources with the given IDs in one transaction.
+// Returns a *entity.ValidationError for an invalid request and ErrBatchTooLarge
+// if more than MaxBatchSize IDs are given.
+func (s *Service) Sources(ctx context.Context, req SourceRequest) (*Result, error) {
+	if len(req.IDs) == 0 {
+		return nil, &entity.ValidationError{Field: "ids", Message: "is required"}
+	}
+	if err := validateTarget(req.IDs, false); err != nil {
+		return nil, err
+	}
+	switch req.Action {
+	case SourceActionActivate, SourceActionDeactivate, SourceActionDelete:
+	default:
+		return nil, &entity.ValidationError{Field: "action",
+			Message: fmt.Sprintf("must be %q, %q or %q", SourceActionActivate, SourceActionDeactivate, SourceActionDelete)}
+	}
+
+	return apply(req.Action, req.IDs, func(valid []int64) ([]int64, error) {
+		switch req.Action {
+		case SourceActionDelete:
+			return s.Repo.DeleteSources(ctx, valid)
+		default:
+			return s.Repo.SetSourcesActive(ctx, valid, req.Action == SourceActionActivate)
+		}
+	})
+}
+
+// findArticleIDs returns the IDs of the articles matching filter. A filter must
+// narrow the selection and must not match more than MaxBatchSize articles.
+func (s *Service) findArticleIDs(ctx context.Context, filter ArticleFilter) ([]int64, error) {
+	var keywords []string
+	if filter.Keyword != "" {
+		var err error
+		keywords, err = search.ParseKeywords(filter.Keyword, search.DefaultMaxKeywordCount, search.DefaultMaxKeywordLength)
+		if err != nil {
+			return nil, &entity.ValidationError{Field: "filter.keyword", Message: "is invalid: " + err.Error()}
+		}
+	}
+	filter.Filters.Tags = entity.NormalizeTagNames(filter.Filters.Tags)
+	if len(keywords) == 0 && filter.Filters.Empty() {
+		return nil, &entity.ValidationError{Field: "filter", Message: "must have a keyword or at least one filter"}
+	}
+
+	// 上限を1件超えて取得し、超えた場合は一部だけ処理せずにエラーにする
+	ids, err := s.Repo.FindArticleIDs(ctx, keywords, filter.Filters, MaxBatchSize+1)
+	if err != nil {
+		return nil, fmt.Errorf("find articles: %w", err)
+	}
+	if len(ids) > MaxBatchSize {
+		return nil, fmt.Errorf("%w: filter matches more than %d articles", ErrBatchTooLarge, MaxBatchSize)
+	}
+	return ids, nil
+}
+
+// validateTarget checks that a request has either IDs or a filter, and at most
+// MaxBatchSize IDs.
+func validateTarget(ids []int64, hasFilter bool) error {
+	switch {
+	case len(ids) > 0 && hasFilter:
+		return &entity.ValidationError{Field: "ids", Message: "must not be combined with filter"}
+	case len(ids) == 0 && !hasFilter:
+		return &entity.ValidationError{Field: "ids", Message: "ids or filter is required"}
+	case len(ids) > MaxBatchSize:
+		return fmt.Errorf("%w: %d IDs (max %d)", ErrBatchTooLarge, len(ids), MaxBatchSize)
+	}
+	return nil
+}
+
+// apply runs fn with the unique positive IDs among ids and builds the result of
+// action from the IDs fn applied it to.
+func apply(action string, ids []int64, fn func(valid []int64) ([]int64, error)) (*Result, error) {
+	var valid []int64
+	unique := make([]int64, 0, len(ids))
+	seen := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+		if id > 0 {
+			valid = append(valid, id)
+		}
+	}
+
+	applied := map[int64]bool{}
+	if len(valid) > 0 {
+		done, err := fn(valid)
+		if err != nil {
+			return nil, fmt.Errorf("bulk %s: %w", action, err)
+		}
+		for _, id := range done {
+			applied[id] = true
+		}
+	}
+
+	result := &Result{Action: action, Items: make([]ItemResult, 0, len(unique))}
+	for _, id := range unique {
+		item := ItemResult{ID: id, Status: StatusOK}
+		switch {
+		case id <= 0:
+			item.Status = StatusInvalidID
+		case !applied[id]:
+			item.Status = StatusNotFound
+		}
+		if item.Status == StatusOK {
+			result.Succeeded++
+		} else {
+			result.Failed++
+		}
+		result.Items = append(result.Items, item)
+	}
+	return result, nil
+}
@@ -0,0 +1,257 @@
+package bulk_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	"catchup-feed/internal/usecase/bulk"
+)
+
+/* ───────── モック ───────── */
+
+// stubBulkRepo applies every operation to the IDs in existing.
+type stubBulkRepo struct {
+	existing  map[int64]bool
+	found     []int64
+	err       error
+	gotOp     string
+	gotIDs    []int64
+	gotAdd    []string
+	gotRemove []string
+	gotOrigin string
+	gotActive bool
+	gotLimit  int
+}
+
+func (s *stubBulkRepo) applied(op string, ids []int64) ([]int64, error) {
+	s.gotOp, s.gotIDs = op, ids
+	if s.err != nil {
+		return nil, s.err
+	}
+	var done []int64
+	for _, id := range ids {
+		if s.existing[id] {
+			done = append(done, id)
+		}
+	}
+	return done, nil
+}
+
+func (s *stubBulkRepo) FindArticleIDs(_ context.Context, _ []string, _ repository.ArticleSearchFilters, limit int) ([]int64, error) {
+	s.gotLimit = limit
+	return s.found, s.err
+}
+
+func (s *stubBulkRepo) DeleteArticles(_ context.Context, ids []int64) ([]int64, error) {
+	return s.applied("DeleteArticles", ids)
+}
+
+func (s *stubBulkRepo) MoveArticles(_ context.Context, ids []int64, _ int64) ([]int64, error) {
+	return s.applied("MoveArticles", ids)
+}
+
+func (s *stubBulkRepo) RetagArticles(_ context.Context, ids []int64, add, remove []string, origin string) ([]int64, error) {
+	s.gotAdd, s.gotRemove, s.gotOrigin = add, remove, origin
+	return s.applied("RetagArticles", ids)
+}
+
+func (s *stubBulkRepo) SetSourcesActive(_ context.Context, ids []int64, active bool) ([]int64, error) {
+	s.gotActive = active
+	return s.applied("SetSourcesActive", ids)
+}
+
+func (s *stubBulkRepo) DeleteSources(_ context.Context, ids []int64) ([]int64, error) {
+	return s.applied("DeleteSources", ids)
+}
+
+type stubSourceRepo struct {
+	repository.SourceRepository
+	source *entity.Source
+}
+
+func (s *stubSourceRepo) Get(context.Context, int64) (*entity.Source, error) {
+	return s.source, nil
+}
+
+/* ───────── テスト ───────── */
+
+func TestService_Articles_Delete(t *testing.T) {
+	repo := &stubBulkRepo{existing: map[int64]bool{1: true, 3: true}}
+	svc := bulk.Service{Repo: repo}
+
+	got, err := svc.Articles(context.Background(), bulk.ArticleRequest{
+		Action: bulk.ArticleActionDelete,
+		IDs:    []int64{3, 2, 3, 0, 1},
+	})
+	if err != nil {
+		t.Fatalf("Articles err=%v", err)
+	}
+	// 重複は1件にまとめ、不正な ID はリポジトリに渡さない
+	if repo.gotOp != "DeleteArticles" || !reflect.DeepEqual(repo.gotIDs, []int64{3, 2, 1}) {
+		t.Errorf("repo got %s %v", repo.gotOp, repo.gotIDs)
+	}
+	want := []bulk.ItemResult{
+		{ID: 3, Status: bulk.StatusOK},
+		{ID: 2, Status: bulk.StatusNotFound},
+		{ID: 0, Status: bulk.StatusInvalidID},
+		{ID: 1, Status: bulk.StatusOK},
+	}
+	if !reflect.DeepEqual(got.Items, want) || got.Succeeded != 2 || got.Failed != 2 {
+		t.Errorf("Articles = %+v", got)
+	}
+}
+
+func TestService_Articles_Retag(t *testing.T) {
+	repo := &stubBulkRepo{existing: map[int64]bool{1: true}}
+	svc := bulk.Service{Repo: repo}
+
+	_, err := svc.Articles(context.Background(), bulk.ArticleRequest{
+		Action:     bulk.ArticleActionRetag,
+		IDs:        []int64{1},
+		AddTags:    []string{" Go ", "go", "Release"},
+		RemoveTags: []string{"SPAM"},
+	})
+	if err != nil {
+		t.Fatalf("Articles err=%v", err)
+	}
+	if !reflect.DeepEqual(repo.gotAdd, []string{"go", "release"}) || !reflect.DeepEqual(repo.gotRemove, []string{"spam"}) ||
+		repo.gotOrigin != entity.TagOriginManual {
+		t.Errorf("repo got add=%v remove=%v origin=%q", repo.gotAdd, repo.gotRemove, repo.gotOrigin)
+	}
+}
+
+func TestService_Articles_UpdateSource(t *testing.T) {
+	t.Run("moved", func(t *testing.T) {
+		repo := &stubBulkRepo{existing: map[int64]bool{1: true}}
+		svc := bulk.Service{Repo: repo, SourceRepo: &stubSourceRepo{source: &entity.Source{ID: 5}}}
+
+		got, err := svc.Articles(context.Background(), bulk.ArticleRequest{
+			Action: bulk.ArticleActionUpdateSource, IDs: []int64{1}, SourceID: 5,
+		})
+		if err != nil || repo.gotOp != "MoveArticles" || got.Succeeded != 1 {
+			t.Fatalf("Articles = %+v, %v (op %s)", got, err, repo.gotOp)
+		}
+	})
+
+	t.Run("source not found", func(t *testing.T) {
+		repo := &stubBulkRepo{}
+		svc := bulk.Service{Repo: repo, SourceRepo: &stubSourceRepo{}}
+
+		_, err := svc.Articles(context.Background(), bulk.ArticleRequest{
+			Action: bulk.ArticleActionUpdateSource, IDs: []int64{1}, SourceID: 5,
+		})
+		if !errors.Is(err, bulk.ErrSourceNotFound) || repo.gotOp != "" {
+			t.Errorf("err = %v, op = %q; want ErrSourceNotFound and no update", err, repo.gotOp)
+		}
+	})
+}
+
+func TestService_Articles_Filter(t *testing.T) {
+	sourceID := int64(3)
+	filter := &bulk.ArticleFilter{Keyword: "spam", Filters: repository.ArticleSearchFilters{SourceID: &sourceID}}
+
+	t.Run("matching articles", func(t *testing.T) {
+		repo := &stubBulkRepo{found: []int64{9, 7}, existing: map[int64]bool{9: true, 7: true}}
+		svc := bulk.Service{Repo: repo}
+
+		got, err := svc.Articles(context.Background(), bulk.ArticleRequest{Action: bulk.ArticleActionDelete, Filter: filter})
+		if err != nil {
+			t.Fatalf("Articles err=%v", err)
+		}
+		if repo.gotLimit != bulk.MaxBatchSize+1 || !reflect.DeepEqual(repo.gotIDs, []int64{9, 7}) || got.Succeeded != 2 {
+			t.Errorf("limit=%d ids=%v result=%+v", repo.gotLimit, repo.gotIDs, got)
+		}
+	})
+
+	t.Run("too many matches", func(t *testing.T) {
+		repo := &stubBulkRepo{found: make([]int64, bulk.MaxBatchSize+1)}
+		svc := bulk.Service{Repo: repo}
+
+		_, err := svc.Articles(context.Background(), bulk.ArticleRequest{Action: bulk.ArticleActionDelete, Filter: filter})
+		if !errors.Is(err, bulk.ErrBatchTooLarge) || repo.gotOp != "" {
+			t.Errorf("err = %v, op = %q; want ErrBatchTooLarge and no update", err, repo.gotOp)
+		}
+	})
+}
+
+func TestService_Articles_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		req  bulk.ArticleRequest
+	}{
+		{"unknown action", bulk.ArticleRequest{Action: "archive", IDs: []int64{1}}},
+		{"no target", bulk.ArticleRequest{Action: bulk.ArticleActionDelete}},
+		{"ids and filter", bulk.ArticleRequest{Action: bulk.ArticleActionDelete, IDs: []int64{1}, Filter: &bulk.ArticleFilter{Keyword: "go"}}},
+		{"empty filter", bulk.ArticleRequest{Action: bulk.ArticleActionDelete, Filter: &bulk.ArticleFilter{}}},
+		{"update_source without source", bulk.ArticleRequest{Action: bulk.ArticleActionUpdateSource, IDs: []int64{1}}},
+		{"retag without tags", bulk.ArticleRequest{Action: bulk.ArticleActionRetag, IDs: []int64{1}, AddTags: []string{" "}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &stubBulkRepo{}
+			_, err := (&bulk.Service{Repo: repo}).Articles(context.Background(), tt.req)
+			var ve *entity.ValidationError
+			if !errors.As(err, &ve) {
+				t.Errorf("err = %v, want ValidationError", err)
+			}
+			if repo.gotOp != "" {
+				t.Errorf("repo called: %s", repo.gotOp)
+			}
+		})
+	}
+
+	t.Run("too many ids", func(t *testing.T) {
+		_, err := (&bulk.Service{Repo: &stubBulkRepo{}}).Articles(context.Background(), bulk.ArticleRequest{
+			Action: bulk.ArticleActionDelete, IDs: make([]int64, bulk.MaxBatchSize+1),
+		})
+		if !errors.Is(err, bulk.ErrBatchTooLarge) {
+			t.Errorf("err = %v, want ErrBatchTooLarge", err)
+		}
+	})
+}
+
+func TestService_Sources(t *testing.T) {
+	tests := []struct {
+		action     string
+		wantOp     string
+		wantActive bool
+	}{
+		{bulk.SourceActionActivate, "SetSourcesActive", true},
+		{bulk.SourceActionDeactivate, "SetSourcesActive", false},
+		{bulk.SourceActionDelete, "DeleteSources", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.action, func(t *testing.T) {
+			repo := &stubBulkRepo{existing: map[int64]bool{4: true}}
+			got, err := (&bulk.Service{Repo: repo}).Sources(context.Background(), bulk.SourceRequest{Action: tt.action, IDs: []int64{4, 5}})
+			if err != nil {
+				t.Fatalf("Sources err=%v", err)
+			}
+			if repo.gotOp != tt.wantOp || repo.gotActive != tt.wantActive {
+				t.Errorf("repo got %s active=%v", repo.gotOp, repo.gotActive)
+			}
+			if got.Action != tt.action || got.Succeeded != 1 || got.Failed != 1 || got.Items[1].Status != bulk.StatusNotFound {
+				t.Errorf("Sources = %+v", got)
+			}
+		})
+	}
+}
+
+func TestService_Sources_Errors(t *testing.T) {
+	var ve *entity.ValidationError
+	if _, err := (&bulk.Service{Repo: &stubBulkRepo{}}).Sources(context.Background(), bulk.SourceRequest{Action: "pause", IDs: []int64{1}}); !errors.As(err, &ve) {
+		t.Errorf("unknown action err = %v, want ValidationError", err)
+	}
+	if _, err := (&bulk.Service{Repo: &stubBulkRepo{}}).Sources(context.Background(), bulk.SourceRequest{Action: bulk.SourceActionDelete}); !errors.As(err, &ve) {
+		t.Errorf("no ids err = %v, want ValidationError", err)
+	}
+
+	repo := &stubBulkRepo{err: errors.New("db down")}
+	if _, err := (&bulk.Service{Repo: repo}).Sources(context.Background(), bulk.SourceRequest{Action: bulk.SourceActionDelete, IDs: []int64{1}}); err == nil {
+		t.Error("repository error should be returned")
+	}
+}
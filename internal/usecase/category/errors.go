@@ -0,0 +1,17 @@
+// Package category provides use cases for source categories: managing the
+// categories and assigning sources to them.
+package category
+
+import "errors"
+
+// Sentinel errors for category use case operations.
+var (
+	// ErrCategoryNotFound indicates that the requested category was not found.
+	ErrCategoryNotFound = errors.New("category not found")
+
+	// ErrDuplicateCategory indicates that a category with the same name already exists.
+	ErrDuplicateCategory = errors.New("category with this name already exists")
+
+	// ErrSourceNotFound indicates that the source to assign categories to was not found.
+	ErrSourceNotFound = errors.New("source not found")
+)
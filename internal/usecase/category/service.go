@@ -0,0 +1,190 @@
+package category
+
+import (
+	"context"
+	"fmt"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+// MaxCategoriesPerSource is the maximum number of categories of one source.
+const MaxCategoriesPerSource = 20
+
+// Service provides source category management use cases.
+type Service struct {
+	Repo       repository.CategoryRepository
+	SourceRepo repository.SourceRepository
+}
+
+// List returns all categories with the number of sources in each, ordered by name.
+func (s *Service) List(ctx context.Context) ([]repository.CategoryCount, error) {
+	categories, err := s.Repo.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list categories: %w", err)
+	}
+	return categories, nil
+}
+
+// Create validates and stores a new category. The name is normalized
+// (see entity.NormalizeCategoryName).
+// Returns a ValidationError if the name is invalid.
+// Returns ErrDuplicateCategory if a category with the name already exists.
+func (s *Service) Create(ctx context.Context, name string) (*entity.Category, error) {
+	c := &entity.Category{Name: name}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.checkNameFree(ctx, c.Name, 0); err != nil {
+		return nil, err
+	}
+
+	if err := s.Repo.CreateCategory(ctx, c); err != nil {
+		return nil, fmt.Errorf("create category: %w", err)
+	}
+	return c, nil
+}
+
+// Rename changes the name of a category. The name is normalized as in Create.
+// Returns a ValidationError if the ID or name is invalid.
+// Returns ErrCategoryNotFound if the category does not exist.
+// Returns ErrDuplicateCategory if another category already has the name.
+func (s *Service) Rename(ctx context.Context, id int64, name string) (*entity.Category, error) {
+	current, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	c := &entity.Category{ID: current.ID, Name: name, CreatedAt: current.CreatedAt}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.checkNameFree(ctx, c.Name, id); err != nil {
+		return nil, err
+	}
+
+	renamed, err := s.Repo.RenameCategory(ctx, id, c.Name)
+	if err != nil {
+		return nil, fmt.Errorf("rename category: %w", err)
+	}
+	if !renamed {
+		return nil, ErrCategoryNotFound
+	}
+	return c, nil
+}
+
+// Delete removes a category and its assignments. The sources are kept.
+// Returns a ValidationError if the ID is not positive.
+// Returns ErrCategoryNotFound if the category does not exist.
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return &entity.ValidationError{Field: "id", Message: "must be positive"}
+	}
+
+	deleted, err := s.Repo.DeleteCategory(ctx, id)
+	if err != nil {
+		return fmt.Errorf("delete category: %w", err)
+	}
+	if !deleted {
+		return ErrCategoryNotFound
+	}
+	return nil
+}
+
+// SourceCategories returns the categories of a source, ordered by name.
+// Returns ErrSourceNotFound if the source does not exist or is in the trash.
+func (s *Service) SourceCategories(ctx context.Context, sourceID int64) ([]*entity.Category, error) {
+	if err := s.checkSource(ctx, sourceID); err != nil {
+		return nil, err
+	}
+
+	categories, err := s.Repo.ListSourceCategories(ctx, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("list source categories: %w", err)
+	}
+	return categories, nil
+}
+
+// SetSourceCategories replaces the categories of a source and returns them.
+// Duplicate IDs are ignored and an empty list removes the source from every category.
+// Returns a ValidationError if an ID is not positive or there are more than
+// MaxCategoriesPerSource categories.
+// Returns ErrSourceNotFound if the source does not exist or is in the trash.
+// Returns ErrCategoryNotFound if one of the categories does not exist.
+func (s *Service) SetSourceCategories(ctx context.Context, sourceID int64, categoryIDs []int64) ([]*entity.Category, error) {
+	ids := make([]int64, 0, len(categoryIDs))
+	seen := make(map[int64]bool, len(categoryIDs))
+	for _, id := range categoryIDs {
+		if id <= 0 {
+			return nil, &entity.ValidationError{Field: "category_ids", Message: "must be positive"}
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) > MaxCategoriesPerSource {
+		return nil, &entity.ValidationError{
+			Field:   "category_ids",
+			Message: fmt.Sprintf("must not contain more than %d categories", MaxCategoriesPerSource),
+		}
+	}
+	if err := s.checkSource(ctx, sourceID); err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		if _, err := s.get(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.Repo.SetSourceCategories(ctx, sourceID, ids); err != nil {
+		return nil, fmt.Errorf("set source categories: %w", err)
+	}
+	categories, err := s.Repo.ListSourceCategories(ctx, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("list source categories: %w", err)
+	}
+	return categories, nil
+}
+
+// get returns the category with the given ID.
+func (s *Service) get(ctx context.Context, id int64) (*entity.Category, error) {
+	if id <= 0 {
+		return nil, &entity.ValidationError{Field: "id", Message: "must be positive"}
+	}
+	c, err := s.Repo.GetCategory(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get category: %w", err)
+	}
+	if c == nil {
+		return nil, ErrCategoryNotFound
+	}
+	return c, nil
+}
+
+// checkNameFree returns ErrDuplicateCategory if a category other than exceptID has the name.
+func (s *Service) checkNameFree(ctx context.Context, name string, exceptID int64) error {
+	existing, err := s.Repo.GetCategoryByName(ctx, name)
+	if err != nil {
+		return fmt.Errorf("get category by name: %w", err)
+	}
+	if existing != nil && existing.ID != exceptID {
+		return ErrDuplicateCategory
+	}
+	return nil
+}
+
+// checkSource returns ErrSourceNotFound if the source does not exist or is in the trash.
+func (s *Service) checkSource(ctx context.Context, sourceID int64) error {
+	if sourceID <= 0 {
+		return &entity.ValidationError{Field: "source_id", Message: "must be positive"}
+	}
+	src, err := s.SourceRepo.Get(ctx, sourceID)
+	if err != nil {
+		return fmt.Errorf("get source: %w", err)
+	}
+	if src == nil {
+		return ErrSourceNotFound
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package category_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	"catchup-feed/internal/usecase/category"
+)
+
+/* ───────── モック ───────── */
+
+type stubCategoryRepo struct {
+	categories map[int64]*entity.Category
+	members    map[int64][]int64
+	err        error
+	setCalls   int
+}
+
+func newStubCategoryRepo(names ...string) *stubCategoryRepo {
+	s := &stubCategoryRepo{categories: map[int64]*entity.Category{}, members: map[int64][]int64{}}
+	for i, n := range names {
+		s.categories[int64(i+1)] = &entity.Category{ID: int64(i + 1), Name: n}
+	}
+	return s
+}
+
+func (s *stubCategoryRepo) ListCategories(context.Context) ([]repository.CategoryCount, error) {
+	var out []repository.CategoryCount
+	for _, c := range s.categories {
+		out = append(out, repository.CategoryCount{Category: c})
+	}
+	return out, s.err
+}
+
+func (s *stubCategoryRepo) GetCategory(_ context.Context, id int64) (*entity.Category, error) {
+	if c, ok := s.categories[id]; ok {
+		cp := *c
+		return &cp, s.err
+	}
+	return nil, s.err
+}
+
+func (s *stubCategoryRepo) GetCategoryByName(_ context.Context, name string) (*entity.Category, error) {
+	for _, c := range s.categories {
+		if c.Name == name {
+			return c, s.err
+		}
+	}
+	return nil, s.err
+}
+
+func (s *stubCategoryRepo) CreateCategory(_ context.Context, c *entity.Category) error {
+	if s.err != nil {
+		return s.err
+	}
+	c.ID = int64(len(s.categories) + 1)
+	s.categories[c.ID] = c
+	return nil
+}
+
+func (s *stubCategoryRepo) RenameCategory(_ context.Context, id int64, name string) (bool, error) {
+	c, ok := s.categories[id]
+	if ok {
+		c.Name = name
+	}
+	return ok, s.err
+}
+
+func (s *stubCategoryRepo) DeleteCategory(_ context.Context, id int64) (bool, error) {
+	_, ok := s.categories[id]
+	delete(s.categories, id)
+	return ok, s.err
+}
+
+func (s *stubCategoryRepo) ListSourceCategories(_ context.Context, sourceID int64) ([]*entity.Category, error) {
+	var out []*entity.Category
+	for _, id := range s.members[sourceID] {
+		out = append(out, s.categories[id])
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, s.err
+}
+
+func (s *stubCategoryRepo) SetSourceCategories(_ context.Context, sourceID int64, ids []int64) error {
+	s.setCalls++
+	s.members[sourceID] = ids
+	return s.err
+}
+
+type stubSourceRepo struct {
+	repository.SourceRepository
+	source *entity.Source
+}
+
+func (s *stubSourceRepo) Get(context.Context, int64) (*entity.Source, error) {
+	return s.source, nil
+}
+
+/* ───────── テスト ───────── */
+
+func TestService_Create(t *testing.T) {
+	repo := newStubCategoryRepo("Go")
+	svc := category.Service{Repo: repo}
+
+	got, err := svc.Create(context.Background(), "  Company   blogs ")
+	if err != nil {
+		t.Fatalf("Create err=%v", err)
+	}
+	if got.ID == 0 || got.Name != "Company blogs" {
+		t.Errorf("Create = %+v, want stored category with normalized name", got)
+	}
+
+	if _, err := svc.Create(context.Background(), "Go"); !errors.Is(err, category.ErrDuplicateCategory) {
+		t.Errorf("duplicate err = %v, want ErrDuplicateCategory", err)
+	}
+
+	var ve *entity.ValidationError
+	for _, name := range []string{" ", strings.Repeat("a", entity.MaxCategoryNameLength+1)} {
+		if _, err := svc.Create(context.Background(), name); !errors.As(err, &ve) {
+			t.Errorf("Create(%q) err = %v, want ValidationError", name, err)
+		}
+	}
+}
+
+func TestService_Rename(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      int64
+		newName string
+		wantErr error
+	}{
+		{name: "renamed", id: 1, newName: "Golang"},
+		{name: "same name", id: 1, newName: "Go"},
+		{name: "taken by other", id: 1, newName: "Security", wantErr: category.ErrDuplicateCategory},
+		{name: "not found", id: 9, newName: "Rust", wantErr: category.ErrCategoryNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := category.Service{Repo: newStubCategoryRepo("Go", "Security")}
+			got, err := svc.Rename(context.Background(), tt.id, tt.newName)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Rename err = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.Name != tt.newName {
+				t.Errorf("Rename = %+v", got)
+			}
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	svc := category.Service{Repo: newStubCategoryRepo("Go")}
+
+	if err := svc.Delete(context.Background(), 1); err != nil {
+		t.Fatalf("Delete err=%v", err)
+	}
+	if err := svc.Delete(context.Background(), 1); !errors.Is(err, category.ErrCategoryNotFound) {
+		t.Errorf("second Delete err = %v, want ErrCategoryNotFound", err)
+	}
+	var ve *entity.ValidationError
+	if err := svc.Delete(context.Background(), 0); !errors.As(err, &ve) {
+		t.Errorf("Delete(0) err = %v, want ValidationError", err)
+	}
+}
+
+func TestService_SetSourceCategories(t *testing.T) {
+	repo := newStubCategoryRepo("Security", "Go")
+	svc := category.Service{Repo: repo, SourceRepo: &stubSourceRepo{source: &entity.Source{ID: 5}}}
+
+	got, err := svc.SetSourceCategories(context.Background(), 5, []int64{1, 2, 1})
+	if err != nil {
+		t.Fatalf("SetSourceCategories err=%v", err)
+	}
+	if len(repo.members[5]) != 2 || len(got) != 2 || got[0].Name != "Go" {
+		t.Errorf("SetSourceCategories = %+v, stored %v", got, repo.members[5])
+	}
+
+	// 空のリストはすべてのカテゴリから外す
+	if got, err := svc.SetSourceCategories(context.Background(), 5, nil); err != nil || len(got) != 0 {
+		t.Errorf("clear = %+v, %v", got, err)
+	}
+}
+
+func TestService_SetSourceCategories_Invalid(t *testing.T) {
+	tooMany := make([]int64, category.MaxCategoriesPerSource+1)
+	for i := range tooMany {
+		tooMany[i] = int64(i + 1)
+	}
+	var ve *entity.ValidationError
+
+	tests := []struct {
+		name    string
+		source  *entity.Source
+		ids     []int64
+		wantErr func(error) bool
+	}{
+		{name: "non-positive id", source: &entity.Source{ID: 5}, ids: []int64{1, 0},
+			wantErr: func(err error) bool { return errors.As(err, &ve) }},
+		{name: "too many", source: &entity.Source{ID: 5}, ids: tooMany,
+			wantErr: func(err error) bool { return errors.As(err, &ve) }},
+		{name: "unknown category", source: &entity.Source{ID: 5}, ids: []int64{1, 7},
+			wantErr: func(err error) bool { return errors.Is(err, category.ErrCategoryNotFound) }},
+		{name: "unknown source", ids: []int64{1},
+			wantErr: func(err error) bool { return errors.Is(err, category.ErrSourceNotFound) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubCategoryRepo("Go")
+			svc := category.Service{Repo: repo, SourceRepo: &stubSourceRepo{source: tt.source}}
+			_, err := svc.SetSourceCategories(context.Background(), 5, tt.ids)
+			if !tt.wantErr(err) || repo.setCalls != 0 {
+				t.Errorf("err = %v, repository calls = %d", err, repo.setCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+// Package digest provides use cases for daily and weekly digests: collecting the
+// articles fetched since the previous digest, grouping them by source or tag,
+// writing an optional editor's overview, storing the digest and delivering it
+// to the notification channels.
+package digest
+
+import "errors"
+
+// Sentinel errors for digest use case operations.
+var (
+	// ErrInvalidDigestID indicates that the provided digest ID is invalid.
+	// Digest IDs must be positive integers.
+	ErrInvalidDigestID = errors.New("invalid digest ID")
+
+	// ErrDigestNotFound indicates that the requested digest was not found.
+	ErrDigestNotFound = errors.New("digest not found")
+)
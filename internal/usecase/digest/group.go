@@ -0,0 +1,128 @@
+package digest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+// UntaggedGroupName is the name of the group collecting articles without tags
+// in digests grouped by tag. It is always the last group.
+const UntaggedGroupName = "その他"
+
+// maxOverviewSummaryRunes limits the summary of each article in the overview input.
+const maxOverviewSummaryRunes = 200
+
+// groupCandidates groups digest articles by source or tag. Groups are ordered by
+// size (largest first) and then by name; articles keep the candidate order.
+//
+// When grouping by tag, an article with several tags is listed once, under the tag
+// shared by the most articles of the digest, so that the digest stays compact.
+func groupCandidates(candidates []repository.DigestCandidate, groupBy string) []entity.DigestGroup {
+	tagCounts := map[string]int{}
+	if groupBy == entity.DigestGroupByTag {
+		for _, c := range candidates {
+			for _, t := range c.Tags {
+				tagCounts[t]++
+			}
+		}
+	}
+
+	var names []string
+	byName := map[string][]entity.DigestArticle{}
+	for _, c := range candidates {
+		name := c.SourceName
+		if groupBy == entity.DigestGroupByTag {
+			name = primaryTag(c.Tags, tagCounts)
+		}
+		if _, ok := byName[name]; !ok {
+			names = append(names, name)
+		}
+		byName[name] = append(byName[name], toDigestArticle(c))
+	}
+
+	sort.SliceStable(names, func(i, j int) bool {
+		a, b := names[i], names[j]
+		if groupBy == entity.DigestGroupByTag && (a == UntaggedGroupName || b == UntaggedGroupName) {
+			return b == UntaggedGroupName && a != UntaggedGroupName
+		}
+		if len(byName[a]) != len(byName[b]) {
+			return len(byName[a]) > len(byName[b])
+		}
+		return a < b
+	})
+
+	groups := make([]entity.DigestGroup, 0, len(names))
+	for _, name := range names {
+		groups = append(groups, entity.DigestGroup{Name: name, Articles: byName[name]})
+	}
+	return groups
+}
+
+// primaryTag returns the tag of tags with the highest count (ties broken by name),
+// or UntaggedGroupName when there is none.
+func primaryTag(tags []string, counts map[string]int) string {
+	best := ""
+	for _, t := range tags {
+		if best == "" || counts[t] > counts[best] || (counts[t] == counts[best] && t < best) {
+			best = t
+		}
+	}
+	if best == "" {
+		return UntaggedGroupName
+	}
+	return best
+}
+
+// toDigestArticle snapshots a candidate article. The TL;DR of a structured summary
+// is preferred over the prose summary because digests list many articles.
+func toDigestArticle(c repository.DigestCandidate) entity.DigestArticle {
+	a := c.Article
+	summary := a.Summary
+	if a.Structured != nil && a.Structured.TLDR != "" {
+		summary = a.Structured.TLDR
+	}
+	return entity.DigestArticle{
+		ID:          a.ID,
+		Title:       a.Title,
+		URL:         a.URL,
+		SourceName:  c.SourceName,
+		Summary:     summary,
+		PublishedAt: a.PublishedAt,
+	}
+}
+
+// overviewInput renders the digest as the text given to the summarizer for the
+// editor's overview: an instruction followed by the grouped article list.
+func overviewInput(d *entity.Digest) string {
+	label := "本日"
+	if d.Period == entity.DigestWeekly {
+		label = "今週"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "以下は%sの新着技術記事%d件の一覧です。編集者として、全体の傾向と特に注目すべき話題を読者に紹介する概要を書いてください。\n", label, d.ArticleCount)
+	for _, g := range d.Groups {
+		fmt.Fprintf(&b, "\n## %s\n", g.Name)
+		for _, a := range g.Articles {
+			fmt.Fprintf(&b, "- %s（%s）", a.Title, a.SourceName)
+			if a.Summary != "" {
+				fmt.Fprintf(&b, ": %s", truncateRunes(strings.Join(strings.Fields(a.Summary), " "), maxOverviewSummaryRunes))
+			}
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// truncateRunes shortens s to at most n runes, marking the cut with "…".
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
@@ -0,0 +1,215 @@
+package digest
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+// Article count limits of a digest.
+const (
+	// DefaultMaxArticles is the default maximum number of articles in one digest.
+	DefaultMaxArticles = 100
+	// MaxArticlesLimit is the upper bound of Config.MaxArticles.
+	MaxArticlesLimit = 500
+)
+
+// Summarizer writes the editor's overview of a digest from a plain-text listing
+// of its articles. The summarizers of the fetch use case satisfy it.
+type Summarizer interface {
+	Summarize(ctx context.Context, text string) (string, error)
+}
+
+// Notifier delivers a stored digest to the notification channels.
+type Notifier interface {
+	NotifyDigest(ctx context.Context, digest *entity.Digest) error
+}
+
+// Config controls how digests are generated.
+type Config struct {
+	// Period is entity.DigestDaily or entity.DigestWeekly.
+	Period string
+	// GroupBy is entity.DigestGroupBySource or entity.DigestGroupByTag.
+	GroupBy string
+	// MaxArticles caps the number of articles in one digest; the newest are kept.
+	MaxArticles int
+}
+
+// Validate checks that the configuration describes a supported digest.
+func (c Config) Validate() error {
+	if !entity.ValidDigestPeriod(c.Period) {
+		return fmt.Errorf("invalid digest period %q: must be %s or %s", c.Period, entity.DigestDaily, entity.DigestWeekly)
+	}
+	if !entity.ValidDigestGroupBy(c.GroupBy) {
+		return fmt.Errorf("invalid digest grouping %q: must be %s or %s", c.GroupBy, entity.DigestGroupBySource, entity.DigestGroupByTag)
+	}
+	if c.MaxArticles < 1 || c.MaxArticles > MaxArticlesLimit {
+		return fmt.Errorf("invalid digest max articles %d: must be between 1 and %d", c.MaxArticles, MaxArticlesLimit)
+	}
+	return nil
+}
+
+// ListResult is a page of digests with pagination metadata.
+type ListResult struct {
+	Data       []*entity.Digest
+	Pagination pagination.Metadata
+}
+
+// Service provides digest generation and retrieval use cases.
+// Summarizer and Notifier are optional: without a Summarizer digests have no
+// overview, and without a Notifier they are only stored.
+type Service struct {
+	Repo       repository.DigestRepository
+	Summarizer Summarizer
+	Notifier   Notifier
+	Config     Config
+}
+
+// Generate creates the digest of the articles fetched since the previous digest
+// of the configured period (or during the last period when there is none) up to now,
+// stores it and delivers it through the Notifier.
+//
+// Articles still pending batch summarization are left out and recorded in
+// PendingArticleIDs; the next digest of the period includes them once they are
+// summarized, even though they were fetched before its window.
+//
+// It returns (nil, nil) when no article was fetched in that window; the next digest
+// then covers the articles since the previous one. Overview and delivery failures are
+// logged and do not fail the digest, which has already been stored.
+func (s *Service) Generate(ctx context.Context, now time.Time) (*entity.Digest, error) {
+	if err := s.Config.Validate(); err != nil {
+		return nil, err
+	}
+
+	from := now.Add(-entity.DigestPeriodLength(s.Config.Period))
+	latest, err := s.Repo.LatestDigest(ctx, s.Config.Period)
+	if err != nil {
+		return nil, fmt.Errorf("get latest digest: %w", err)
+	}
+	var carried []int64
+	if latest != nil {
+		from = latest.PeriodEnd
+		carried = latest.PendingArticleIDs
+	}
+
+	// 候補より先に取得する: 間に要約が終わった記事は候補に入り、下で除外される
+	pending, err := s.Repo.ListPendingDigestArticleIDs(ctx, from, now, carried)
+	if err != nil {
+		return nil, fmt.Errorf("list pending digest articles: %w", err)
+	}
+	candidates, err := s.Repo.ListDigestCandidates(ctx, from, now, carried, s.Config.MaxArticles)
+	if err != nil {
+		return nil, fmt.Errorf("list digest articles: %w", err)
+	}
+	if len(candidates) == 0 {
+		slog.InfoContext(ctx, "No new articles for digest",
+			slog.String("period", s.Config.Period),
+			slog.Time("since", from))
+		return nil, nil
+	}
+
+	digest := &entity.Digest{
+		Period:       s.Config.Period,
+		GroupBy:      s.Config.GroupBy,
+		Title:        digestTitle(s.Config.Period, now),
+		PeriodStart:  from,
+		PeriodEnd:    now,
+		ArticleCount: len(candidates),
+		Groups:       groupCandidates(candidates, s.Config.GroupBy),
+	}
+	digest.PendingArticleIDs = stillPending(pending, candidates)
+
+	if s.Summarizer != nil {
+		overview, err := s.Summarizer.Summarize(ctx, overviewInput(digest))
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to write digest overview, continuing without it",
+				slog.String("period", digest.Period),
+				slog.Any("error", err))
+		} else {
+			digest.Overview = overview
+		}
+	}
+
+	if err := s.Repo.CreateDigest(ctx, digest); err != nil {
+		return nil, fmt.Errorf("create digest: %w", err)
+	}
+	slog.InfoContext(ctx, "Digest created",
+		slog.Int64("digest_id", digest.ID),
+		slog.String("period", digest.Period),
+		slog.Int("articles", digest.ArticleCount),
+		slog.Int("groups", len(digest.Groups)))
+
+	if s.Notifier != nil {
+		if err := s.Notifier.NotifyDigest(ctx, digest); err != nil {
+			slog.WarnContext(ctx, "Failed to deliver digest",
+				slog.Int64("digest_id", digest.ID),
+				slog.Any("error", err))
+		}
+	}
+	return digest, nil
+}
+
+// List returns a page of digests, newest first.
+func (s *Service) List(ctx context.Context, params pagination.Params) (*ListResult, error) {
+	total, err := s.Repo.CountDigests(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count digests: %w", err)
+	}
+	digests, err := s.Repo.ListDigests(ctx, pagination.CalculateOffset(params.Page, params.Limit), params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("list digests: %w", err)
+	}
+	return &ListResult{
+		Data: digests,
+		Pagination: pagination.Metadata{
+			Total:      total,
+			Page:       params.Page,
+			Limit:      params.Limit,
+			TotalPages: pagination.CalculateTotalPages(total, params.Limit),
+		},
+	}, nil
+}
+
+// Get returns the digest with the given ID.
+func (s *Service) Get(ctx context.Context, id int64) (*entity.Digest, error) {
+	if id <= 0 {
+		return nil, ErrInvalidDigestID
+	}
+	digest, err := s.Repo.GetDigest(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get digest: %w", err)
+	}
+	if digest == nil {
+		return nil, ErrDigestNotFound
+	}
+	return digest, nil
+}
+
+// stillPending returns the pending IDs that are not among the candidates.
+func stillPending(pending []int64, candidates []repository.DigestCandidate) []int64 {
+	included := make(map[int64]bool, len(candidates))
+	for _, c := range candidates {
+		included[c.Article.ID] = true
+	}
+	var out []int64
+	for _, id := range pending {
+		if !included[id] {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// digestTitle returns the title of a digest generated at now.
+func digestTitle(period string, now time.Time) string {
+	if period == entity.DigestWeekly {
+		return fmt.Sprintf("ウィークリーダイジェスト %s〜%s",
+			now.AddDate(0, 0, -6).Format("2006-01-02"), now.Format("01-02"))
+	}
+	return "デイリーダイジェスト " + now.Format("2006-01-02")
+}
@@ -0,0 +1,454 @@
+package digest_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	digestUC "catchup-feed/internal/usecase/digest"
+)
+
+/* ───────── モック ───────── */
+
+// stubDigestRepo はDigestRepositoryのモック実装
+type stubDigestRepo struct {
+	digests    []*entity.Digest
+	candidates []repository.DigestCandidate
+	articles   []*stubArticle // 設定するとcandidatesの代わりに取得時刻と要約状態で絞り込む
+	from, to   time.Time      // ListDigestCandidatesの呼び出し内容
+	carried    []int64
+	limit      int
+	err        error
+}
+
+// stubArticle は取得時刻と要約待ちかどうかを持つ記事
+type stubArticle struct {
+	repository.DigestCandidate
+	createdAt time.Time
+	pending   bool
+}
+
+// inWindow はダイジェストの対象期間 (from, to] に取得されたか、carriedに含まれるかを返す
+func (a *stubArticle) inWindow(from, to time.Time, carried []int64) bool {
+	return (a.createdAt.After(from) && !a.createdAt.After(to)) || slices.Contains(carried, a.Article.ID)
+}
+
+func (s *stubDigestRepo) CreateDigest(_ context.Context, d *entity.Digest) error {
+	if s.err != nil {
+		return s.err
+	}
+	d.ID = int64(len(s.digests) + 1)
+	d.CreatedAt = time.Now()
+	s.digests = append(s.digests, d)
+	return nil
+}
+
+func (s *stubDigestRepo) GetDigest(_ context.Context, id int64) (*entity.Digest, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	for _, d := range s.digests {
+		if d.ID == id {
+			return d, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *stubDigestRepo) LatestDigest(_ context.Context, period string) (*entity.Digest, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	var latest *entity.Digest
+	for _, d := range s.digests {
+		if d.Period == period && (latest == nil || d.PeriodEnd.After(latest.PeriodEnd)) {
+			latest = d
+		}
+	}
+	return latest, nil
+}
+
+func (s *stubDigestRepo) ListDigests(_ context.Context, offset, limit int) ([]*entity.Digest, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if offset >= len(s.digests) {
+		return nil, nil
+	}
+	return s.digests[offset:min(offset+limit, len(s.digests))], nil
+}
+
+func (s *stubDigestRepo) CountDigests(_ context.Context) (int64, error) {
+	return int64(len(s.digests)), s.err
+}
+
+func (s *stubDigestRepo) ListDigestCandidates(_ context.Context, from, to time.Time, carried []int64, limit int) ([]repository.DigestCandidate, error) {
+	s.from, s.to, s.carried, s.limit = from, to, carried, limit
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.articles == nil {
+		return s.candidates, nil
+	}
+	var out []repository.DigestCandidate
+	for _, a := range s.articles {
+		if !a.pending && a.inWindow(from, to, carried) {
+			out = append(out, a.DigestCandidate)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubDigestRepo) ListPendingDigestArticleIDs(_ context.Context, from, to time.Time, carried []int64) ([]int64, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	var ids []int64
+	for _, a := range s.articles {
+		if a.pending && a.inWindow(from, to, carried) {
+			ids = append(ids, a.Article.ID)
+		}
+	}
+	return ids, nil
+}
+
+// stubSummarizer は概要を生成するSummarizerのモック実装
+type stubSummarizer struct {
+	input string
+	err   error
+}
+
+func (s *stubSummarizer) Summarize(_ context.Context, text string) (string, error) {
+	s.input = text
+	if s.err != nil {
+		return "", s.err
+	}
+	return "今日はGoの話題が中心です。", nil
+}
+
+// stubNotifier はNotifierのモック実装
+type stubNotifier struct {
+	delivered []*entity.Digest
+	err       error
+}
+
+func (s *stubNotifier) NotifyDigest(_ context.Context, d *entity.Digest) error {
+	s.delivered = append(s.delivered, d)
+	return s.err
+}
+
+func candidate(id int64, source string, tags ...string) repository.DigestCandidate {
+	return repository.DigestCandidate{
+		Article: &entity.Article{
+			ID: id, Title: "記事" + string(rune('A'+id-1)), URL: "https://example.com/" + string(rune('a'+id-1)),
+			Summary: "要約", PublishedAt: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		SourceName: source,
+		Tags:       tags,
+	}
+}
+
+func groupNames(d *entity.Digest) map[string][]int64 {
+	out := map[string][]int64{}
+	for _, g := range d.Groups {
+		for _, a := range g.Articles {
+			out[g.Name] = append(out[g.Name], a.ID)
+		}
+	}
+	return out
+}
+
+func dailyConfig(groupBy string) digestUC.Config {
+	return digestUC.Config{Period: entity.DigestDaily, GroupBy: groupBy, MaxArticles: digestUC.DefaultMaxArticles}
+}
+
+/* ───────── テストケース ───────── */
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     digestUC.Config
+		wantErr bool
+	}{
+		{name: "daily by source", cfg: dailyConfig(entity.DigestGroupBySource)},
+		{name: "weekly by tag", cfg: digestUC.Config{Period: entity.DigestWeekly, GroupBy: entity.DigestGroupByTag, MaxArticles: 1}},
+		{name: "unknown period", cfg: digestUC.Config{Period: "monthly", GroupBy: entity.DigestGroupBySource, MaxArticles: 10}, wantErr: true},
+		{name: "unknown grouping", cfg: digestUC.Config{Period: entity.DigestDaily, GroupBy: "category", MaxArticles: 10}, wantErr: true},
+		{name: "zero max articles", cfg: digestUC.Config{Period: entity.DigestDaily, GroupBy: entity.DigestGroupBySource}, wantErr: true},
+		{name: "too many articles", cfg: digestUC.Config{Period: entity.DigestDaily, GroupBy: entity.DigestGroupBySource, MaxArticles: digestUC.MaxArticlesLimit + 1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cfg.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_Generate_GroupBySource(t *testing.T) {
+	now := time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC)
+	repo := &stubDigestRepo{candidates: []repository.DigestCandidate{
+		candidate(1, "Tech Blog"), candidate(2, "Go Blog"), candidate(3, "Go Blog"),
+	}}
+	notifier := &stubNotifier{}
+	svc := digestUC.Service{Repo: repo, Notifier: notifier, Config: dailyConfig(entity.DigestGroupBySource)}
+
+	d, err := svc.Generate(context.Background(), now)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if d.ID != 1 || d.Title != "デイリーダイジェスト 2026-01-02" || d.ArticleCount != 3 {
+		t.Errorf("digest = %+v", d)
+	}
+	// 前回のダイジェストがなければ、直近の1期間が対象になる
+	if !repo.from.Equal(now.Add(-24*time.Hour)) || !repo.to.Equal(now) || repo.limit != digestUC.DefaultMaxArticles {
+		t.Errorf("window = (%v, %v] limit %d", repo.from, repo.to, repo.limit)
+	}
+	if !d.PeriodStart.Equal(repo.from) || !d.PeriodEnd.Equal(now) {
+		t.Errorf("period = %v - %v", d.PeriodStart, d.PeriodEnd)
+	}
+	// 記事数の多いグループが先、記事は候補の順序のまま
+	if d.Groups[0].Name != "Go Blog" || d.Groups[1].Name != "Tech Blog" {
+		t.Errorf("group order = %v", groupNames(d))
+	}
+	if diff := cmp.Diff(map[string][]int64{"Go Blog": {2, 3}, "Tech Blog": {1}}, groupNames(d)); diff != "" {
+		t.Errorf("groups mismatch (-want +got):\n%s", diff)
+	}
+	if d.Overview != "" {
+		t.Errorf("Overview = %q, want empty without a summarizer", d.Overview)
+	}
+	if len(notifier.delivered) != 1 || notifier.delivered[0] != d {
+		t.Errorf("digest must be delivered once: %v", notifier.delivered)
+	}
+}
+
+func TestService_Generate_GroupByTag(t *testing.T) {
+	repo := &stubDigestRepo{candidates: []repository.DigestCandidate{
+		candidate(1, "Go Blog", "go", "release"),
+		candidate(2, "Tech Blog", "go"),
+		candidate(3, "Tech Blog"),
+		candidate(4, "Rust Blog", "release", "rust"),
+		candidate(5, "AI Blog", "ai"),
+	}}
+	svc := digestUC.Service{Repo: repo, Config: dailyConfig(entity.DigestGroupByTag)}
+
+	d, err := svc.Generate(context.Background(), time.Now())
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	// 複数タグの記事は、ダイジェスト内で最も多いタグ（同数なら名前順）に1回だけ載る
+	want := map[string][]int64{"go": {1, 2}, "release": {4}, "ai": {5}, digestUC.UntaggedGroupName: {3}}
+	if diff := cmp.Diff(want, groupNames(d)); diff != "" {
+		t.Errorf("groups mismatch (-want +got):\n%s", diff)
+	}
+	var order []string
+	for _, g := range d.Groups {
+		order = append(order, g.Name)
+	}
+	if diff := cmp.Diff([]string{"go", "ai", "release", digestUC.UntaggedGroupName}, order); diff != "" {
+		t.Errorf("group order mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestService_Generate_SinceLastDigest(t *testing.T) {
+	lastEnd := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+	repo := &stubDigestRepo{
+		digests: []*entity.Digest{
+			{ID: 1, Period: entity.DigestWeekly, PeriodEnd: lastEnd.Add(time.Hour)},
+			{ID: 2, Period: entity.DigestDaily, PeriodEnd: lastEnd},
+		},
+		candidates: []repository.DigestCandidate{candidate(1, "Go Blog")},
+	}
+	svc := digestUC.Service{Repo: repo, Config: dailyConfig(entity.DigestGroupBySource)}
+
+	// 前回から2日空いても、前回のダイジェスト以降の記事がすべて対象になる
+	now := lastEnd.Add(48 * time.Hour)
+	d, err := svc.Generate(context.Background(), now)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if !repo.from.Equal(lastEnd) || !d.PeriodStart.Equal(lastEnd) {
+		t.Errorf("window starts at %v, want the end of the previous daily digest %v", repo.from, lastEnd)
+	}
+}
+
+func TestService_Generate_PendingUntilSummarized(t *testing.T) {
+	first := time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC)
+	settled := &stubArticle{DigestCandidate: candidate(1, "Go Blog"), createdAt: first.Add(-time.Hour)}
+	pending := &stubArticle{DigestCandidate: candidate(2, "Go Blog"), createdAt: first.Add(-time.Hour), pending: true}
+	repo := &stubDigestRepo{articles: []*stubArticle{settled, pending}}
+	svc := digestUC.Service{Repo: repo, Config: dailyConfig(entity.DigestGroupBySource)}
+
+	d, err := svc.Generate(context.Background(), first)
+	if err != nil {
+		t.Fatalf("first Generate() error = %v", err)
+	}
+	if d.ArticleCount != 1 || !cmp.Equal(d.PendingArticleIDs, []int64{2}) {
+		t.Fatalf("first digest: %d articles, pending %v; want 1 article and 2 pending", d.ArticleCount, d.PendingArticleIDs)
+	}
+
+	// 次のダイジェストまでに要約が終わる。取得時刻は前回の期間内のまま
+	pending.pending = false
+	d, err = svc.Generate(context.Background(), first.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("second Generate() error = %v", err)
+	}
+	if d == nil {
+		t.Fatal("second Generate() = nil; want the digest of the summarized article")
+	}
+	if got := groupNames(d); !cmp.Equal(got, map[string][]int64{"Go Blog": {2}}) {
+		t.Errorf("second digest groups = %v, want only article 2", got)
+	}
+	if !cmp.Equal(repo.carried, []int64{2}) || d.PendingArticleIDs != nil {
+		t.Errorf("carried = %v, pending = %v; want article 2 carried over and nothing left pending", repo.carried, d.PendingArticleIDs)
+	}
+}
+
+func TestService_Generate_NoArticles(t *testing.T) {
+	repo := &stubDigestRepo{}
+	notifier := &stubNotifier{}
+	svc := digestUC.Service{Repo: repo, Notifier: notifier, Config: dailyConfig(entity.DigestGroupBySource)}
+
+	d, err := svc.Generate(context.Background(), time.Now())
+	if d != nil || err != nil {
+		t.Fatalf("Generate() = %v, %v; want nil, nil", d, err)
+	}
+	if len(repo.digests) != 0 || len(notifier.delivered) != 0 {
+		t.Error("an empty digest must be neither stored nor delivered")
+	}
+}
+
+func TestService_Generate_Overview(t *testing.T) {
+	structured := candidate(2, "Go Blog")
+	structured.Article.Summary = "長い要約"
+	structured.Article.Structured = &entity.StructuredSummary{TLDR: "Go 1.25 が出た"}
+
+	sum := &stubSummarizer{}
+	repo := &stubDigestRepo{candidates: []repository.DigestCandidate{candidate(1, "Tech Blog"), structured}}
+	svc := digestUC.Service{Repo: repo, Summarizer: sum, Config: digestUC.Config{
+		Period: entity.DigestWeekly, GroupBy: entity.DigestGroupBySource, MaxArticles: 10,
+	}}
+
+	d, err := svc.Generate(context.Background(), time.Date(2026, 1, 7, 8, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if d.Overview != "今日はGoの話題が中心です。" {
+		t.Errorf("Overview = %q", d.Overview)
+	}
+	if d.Title != "ウィークリーダイジェスト 2026-01-01〜01-07" {
+		t.Errorf("Title = %q", d.Title)
+	}
+	for _, want := range []string{"今週の新着技術記事2件", "## Go Blog", "- 記事B（Go Blog）: Go 1.25 が出た", "- 記事A（Tech Blog）: 要約"} {
+		if !strings.Contains(sum.input, want) {
+			t.Errorf("overview input does not contain %q:\n%s", want, sum.input)
+		}
+	}
+	// 構造化要約のTL;DRがダイジェストの要約として使われる
+	if got := d.Groups[0].Articles[0].Summary; got != "Go 1.25 が出た" {
+		t.Errorf("structured article summary = %q, want the TL;DR", got)
+	}
+}
+
+func TestService_Generate_FailuresAfterSelection(t *testing.T) {
+	repo := &stubDigestRepo{candidates: []repository.DigestCandidate{candidate(1, "Go Blog")}}
+	notifier := &stubNotifier{err: errors.New("slack down")}
+	svc := digestUC.Service{
+		Repo: repo, Summarizer: &stubSummarizer{err: errors.New("api down")}, Notifier: notifier,
+		Config: dailyConfig(entity.DigestGroupBySource),
+	}
+
+	// 概要の生成・配信の失敗はダイジェストの保存を妨げない
+	d, err := svc.Generate(context.Background(), time.Now())
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if d.Overview != "" || len(repo.digests) != 1 || len(notifier.delivered) != 1 {
+		t.Errorf("digest = %+v, stored %d, delivered %d", d, len(repo.digests), len(notifier.delivered))
+	}
+}
+
+func TestService_Generate_Errors(t *testing.T) {
+	if _, err := (&digestUC.Service{Repo: &stubDigestRepo{}}).Generate(context.Background(), time.Now()); err == nil {
+		t.Error("Generate() with invalid config error = nil, want error")
+	}
+
+	svc := digestUC.Service{Repo: &stubDigestRepo{err: errors.New("db down")}, Config: dailyConfig(entity.DigestGroupBySource)}
+	if _, err := svc.Generate(context.Background(), time.Now()); err == nil {
+		t.Error("Generate() with repository error = nil, want error")
+	}
+}
+
+func TestService_List(t *testing.T) {
+	repo := &stubDigestRepo{}
+	for i := 0; i < 3; i++ {
+		_ = repo.CreateDigest(context.Background(), &entity.Digest{Period: entity.DigestDaily})
+	}
+	svc := digestUC.Service{Repo: repo}
+
+	got, err := svc.List(context.Background(), pagination.Params{Page: 2, Limit: 2})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(got.Data) != 1 || got.Data[0].ID != 3 {
+		t.Errorf("data = %+v", got.Data)
+	}
+	want := pagination.Metadata{Total: 3, Page: 2, Limit: 2, TotalPages: 2}
+	if got.Pagination != want {
+		t.Errorf("pagination = %+v, want %+v", got.Pagination, want)
+	}
+
+	repo.err = errors.New("db down")
+	if _, err := svc.List(context.Background(), pagination.Params{Page: 1, Limit: 10}); err == nil {
+		t.Error("List() error = nil, want error")
+	}
+}
+
+func TestService_Get(t *testing.T) {
+	repo := &stubDigestRepo{}
+	_ = repo.CreateDigest(context.Background(), &entity.Digest{Period: entity.DigestDaily, Title: "t"})
+	svc := digestUC.Service{Repo: repo}
+
+	tests := []struct {
+		name    string
+		id      int64
+		repoErr error
+		wantErr error
+	}{
+		{name: "found", id: 1},
+		{name: "invalid id", id: 0, wantErr: digestUC.ErrInvalidDigestID},
+		{name: "not found", id: 2, wantErr: digestUC.ErrDigestNotFound},
+		{name: "repository error", id: 1, repoErr: errors.New("db down")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo.err = tt.repoErr
+			got, err := svc.Get(context.Background(), tt.id)
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+			case tt.repoErr != nil:
+				if err == nil {
+					t.Fatal("err = nil, want repository error")
+				}
+			default:
+				if err != nil || got.Title != "t" {
+					t.Fatalf("Get() = %+v, %v", got, err)
+				}
+			}
+		})
+	}
+}
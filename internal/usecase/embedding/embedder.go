@@ -0,0 +1,27 @@
+// Package embedding provides semantic search and related-article lookup based on
+// article embeddings. Embeddings are computed by a pluggable Embedder, stored
+// through repository.EmbeddingRepository and searched with an in-process cosine
+// similarity index, so no vector extension is required in the database.
+package embedding
+
+import (
+	"context"
+	"strings"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// Embedder converts texts into embedding vectors.
+type Embedder interface {
+	// Embed returns one vector per text, in the same order as texts.
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+	// Model identifies the embedding model. Vectors are only comparable with
+	// vectors of the same model, so it is stored with each embedding.
+	Model() string
+}
+
+// ArticleText returns the text an article is embedded from: its title and summary.
+// The full content is not used so that the vector reflects what readers see.
+func ArticleText(art *entity.Article) string {
+	return strings.TrimSpace(art.Title + "\n" + art.Summary)
+}
@@ -0,0 +1,266 @@
+package embedding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/pkg/vector"
+	"catchup-feed/internal/repository"
+)
+
+const (
+	// DefaultIndexTTL is how long the in-process index is used before it is reloaded,
+	// so that embeddings stored by another process (the worker) become searchable.
+	DefaultIndexTTL = 5 * time.Minute
+
+	// MaxSearchResults is the maximum number of articles a semantic search ranks.
+	MaxSearchResults = 100
+
+	// embedBatchSize is the number of texts sent to the Embedder in one call by Backfill.
+	embedBatchSize = 32
+)
+
+// ErrEmptyQuery indicates that a semantic search was requested without a query.
+var ErrEmptyQuery = errors.New("query is required")
+
+// ScoredArticle is an article with its cosine similarity to the query or source article.
+type ScoredArticle struct {
+	repository.ArticleWithSource
+	Score float64
+}
+
+// match is an indexed article and its similarity score.
+type match struct {
+	articleID int64
+	score     float64
+}
+
+// Service computes and stores article embeddings and answers similarity queries.
+// It is safe for concurrent use.
+type Service struct {
+	Embedder Embedder
+	Repo     repository.EmbeddingRepository
+	// IndexTTL overrides DefaultIndexTTL when positive.
+	IndexTTL time.Duration
+
+	mu       sync.RWMutex
+	vectors  map[int64][]float32 // 正規化済みベクトル（内積 = コサイン類似度）
+	loadedAt time.Time
+}
+
+// NewService creates a Service that embeds with embedder and stores vectors in repo.
+func NewService(embedder Embedder, repo repository.EmbeddingRepository) *Service {
+	return &Service{Embedder: embedder, Repo: repo}
+}
+
+// IndexArticle computes and stores the embedding of art. It is called whenever
+// the title or summary of an article is created or regenerated.
+func (s *Service) IndexArticle(ctx context.Context, art *entity.Article) error {
+	vecs, err := s.embed(ctx, []string{ArticleText(art)})
+	if err != nil {
+		return err
+	}
+	return s.save(ctx, art.ID, vecs[0])
+}
+
+// Backfill embeds up to limit summarized articles that have no embedding for the
+// current model yet, newest first, and returns the number of articles embedded.
+// It lets existing articles become searchable after embeddings are enabled or the
+// model is changed.
+func (s *Service) Backfill(ctx context.Context, limit int) (int, error) {
+	articles, err := s.Repo.ListArticlesWithoutEmbedding(ctx, s.Embedder.Model(), limit)
+	if err != nil {
+		return 0, fmt.Errorf("list articles without embedding: %w", err)
+	}
+
+	done := 0
+	for start := 0; start < len(articles); start += embedBatchSize {
+		batch := articles[start:min(start+embedBatchSize, len(articles))]
+		texts := make([]string, len(batch))
+		for i, art := range batch {
+			texts[i] = ArticleText(art)
+		}
+		vecs, err := s.embed(ctx, texts)
+		if err != nil {
+			return done, err
+		}
+		for i, art := range batch {
+			if err := s.save(ctx, art.ID, vecs[i]); err != nil {
+				return done, err
+			}
+			done++
+		}
+	}
+	return done, nil
+}
+
+// Search ranks the indexed articles by similarity to query and returns the
+// articles in [offset, offset+limit) of the ranking together with the number of
+// ranked articles (at most MaxSearchResults). Only positively similar articles are ranked.
+// Returns ErrEmptyQuery if query is blank.
+func (s *Service) Search(ctx context.Context, query string, offset, limit int) ([]ScoredArticle, int, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, 0, ErrEmptyQuery
+	}
+
+	vecs, err := s.embed(ctx, []string{query})
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := s.ensureLoaded(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	ranked := s.rank(vector.Normalize(vecs[0]), 0, MaxSearchResults)
+	if offset >= len(ranked) {
+		return []ScoredArticle{}, len(ranked), nil
+	}
+	window := ranked[offset:min(offset+limit, len(ranked))]
+
+	articles, err := s.articles(ctx, window)
+	if err != nil {
+		return nil, 0, err
+	}
+	return articles, len(ranked), nil
+}
+
+// Related returns up to limit articles most similar to the article with the given
+// ID, most similar first. It returns an empty slice when the article has not been
+// embedded yet.
+func (s *Service) Related(ctx context.Context, articleID int64, limit int) ([]ScoredArticle, error) {
+	if err := s.ensureLoaded(ctx); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	vec, ok := s.vectors[articleID]
+	s.mu.RUnlock()
+	if !ok {
+		return []ScoredArticle{}, nil
+	}
+
+	return s.articles(ctx, s.rank(vec, articleID, limit))
+}
+
+// embed calls the Embedder and checks that it returned one vector per text.
+func (s *Service) embed(ctx context.Context, texts []string) ([][]float32, error) {
+	vecs, err := s.Embedder.Embed(ctx, texts)
+	if err != nil {
+		return nil, fmt.Errorf("embed: %w", err)
+	}
+	if len(vecs) != len(texts) {
+		return nil, fmt.Errorf("embed: got %d vectors for %d texts", len(vecs), len(texts))
+	}
+	return vecs, nil
+}
+
+// save stores the embedding of an article and updates the in-process index if loaded.
+func (s *Service) save(ctx context.Context, articleID int64, vec []float32) error {
+	emb := repository.ArticleEmbedding{ArticleID: articleID, Model: s.Embedder.Model(), Vector: vec}
+	if err := s.Repo.SaveEmbedding(ctx, emb); err != nil {
+		return fmt.Errorf("save embedding: %w", err)
+	}
+
+	s.mu.Lock()
+	if s.vectors != nil {
+		s.vectors[articleID] = vector.Normalize(vec)
+	}
+	s.mu.Unlock()
+	return nil
+}
+
+// ensureLoaded (re)loads the in-process index when it is missing or expired.
+func (s *Service) ensureLoaded(ctx context.Context) error {
+	ttl := s.IndexTTL
+	if ttl <= 0 {
+		ttl = DefaultIndexTTL
+	}
+
+	s.mu.RLock()
+	fresh := s.vectors != nil && time.Since(s.loadedAt) < ttl
+	s.mu.RUnlock()
+	if fresh {
+		return nil
+	}
+
+	embs, err := s.Repo.ListEmbeddings(ctx, s.Embedder.Model())
+	if err != nil {
+		return fmt.Errorf("load embeddings: %w", err)
+	}
+	vectors := make(map[int64][]float32, len(embs))
+	for _, emb := range embs {
+		vectors[emb.ArticleID] = vector.Normalize(emb.Vector)
+	}
+
+	s.mu.Lock()
+	s.vectors = vectors
+	s.loadedAt = time.Now()
+	s.mu.Unlock()
+	return nil
+}
+
+// rank returns up to limit indexed articles with a positive similarity to the
+// normalized vector q, most similar first (ties broken by newer ID). The article
+// excludeID is skipped.
+func (s *Service) rank(q []float32, excludeID int64, limit int) []match {
+	s.mu.RLock()
+	matches := make([]match, 0, len(s.vectors))
+	for id, v := range s.vectors {
+		if id == excludeID {
+			continue
+		}
+		if score := vector.Dot(q, v); score > 0 {
+			matches = append(matches, match{articleID: id, score: score})
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return matches[i].articleID > matches[j].articleID
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// articles loads the articles of matches, keeping the ranking order.
+// Articles deleted since they were indexed are skipped.
+func (s *Service) articles(ctx context.Context, matches []match) ([]ScoredArticle, error) {
+	if len(matches) == 0 {
+		return []ScoredArticle{}, nil
+	}
+
+	ids := make([]int64, len(matches))
+	for i, m := range matches {
+		ids[i] = m.articleID
+	}
+	rows, err := s.Repo.ListWithSourceByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("list articles by IDs: %w", err)
+	}
+	byID := make(map[int64]repository.ArticleWithSource, len(rows))
+	for _, row := range rows {
+		byID[row.Article.ID] = row
+	}
+
+	out := make([]ScoredArticle, 0, len(matches))
+	for _, m := range matches {
+		row, ok := byID[m.articleID]
+		if !ok {
+			continue
+		}
+		out = append(out, ScoredArticle{ArticleWithSource: row, Score: m.score})
+	}
+	return out, nil
+}
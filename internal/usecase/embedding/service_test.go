@@ -0,0 +1,278 @@
+package embedding_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	"catchup-feed/internal/usecase/embedding"
+)
+
+/* ───────── モック ───────── */
+
+// wordEmbedder は語ごとに1次元を割り当てるEmbedderのモック実装
+type wordEmbedder struct {
+	err error
+}
+
+var embedWords = []string{"go", "rust", "ai"}
+
+func (e *wordEmbedder) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	vecs := make([][]float32, len(texts))
+	for i, text := range texts {
+		vec := make([]float32, len(embedWords))
+		for _, w := range strings.Fields(strings.ToLower(text)) {
+			for d, word := range embedWords {
+				if w == word {
+					vec[d]++
+				}
+			}
+		}
+		vecs[i] = vec
+	}
+	return vecs, nil
+}
+
+func (e *wordEmbedder) Model() string { return "words" }
+
+// stubEmbeddingRepo はEmbeddingRepositoryのモック実装
+type stubEmbeddingRepo struct {
+	embeddings map[int64]repository.ArticleEmbedding
+	articles   map[int64]*entity.Article
+	listCalls  int
+	err        error
+}
+
+func newStubEmbeddingRepo(articles ...*entity.Article) *stubEmbeddingRepo {
+	r := &stubEmbeddingRepo{
+		embeddings: map[int64]repository.ArticleEmbedding{},
+		articles:   map[int64]*entity.Article{},
+	}
+	for _, a := range articles {
+		r.articles[a.ID] = a
+	}
+	return r
+}
+
+func (r *stubEmbeddingRepo) SaveEmbedding(_ context.Context, emb repository.ArticleEmbedding) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.embeddings[emb.ArticleID] = emb
+	return nil
+}
+
+func (r *stubEmbeddingRepo) ListEmbeddings(_ context.Context, model string) ([]repository.ArticleEmbedding, error) {
+	r.listCalls++
+	if r.err != nil {
+		return nil, r.err
+	}
+	var out []repository.ArticleEmbedding
+	for _, emb := range r.embeddings {
+		if emb.Model == model {
+			out = append(out, emb)
+		}
+	}
+	return out, nil
+}
+
+func (r *stubEmbeddingRepo) ListArticlesWithoutEmbedding(_ context.Context, model string, limit int) ([]*entity.Article, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	var out []*entity.Article
+	for id := int64(1); id <= int64(len(r.articles)) && len(out) < limit; id++ {
+		if emb, ok := r.embeddings[id]; ok && emb.Model == model {
+			continue
+		}
+		if a, ok := r.articles[id]; ok {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func (r *stubEmbeddingRepo) ListWithSourceByIDs(_ context.Context, ids []int64) ([]repository.ArticleWithSource, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	var out []repository.ArticleWithSource
+	for _, id := range ids {
+		if a, ok := r.articles[id]; ok {
+			out = append(out, repository.ArticleWithSource{Article: a, SourceName: "src"})
+		}
+	}
+	return out, nil
+}
+
+func testArticles() []*entity.Article {
+	return []*entity.Article{
+		{ID: 1, Title: "Go release", Summary: "go go"},
+		{ID: 2, Title: "Rust release", Summary: "rust"},
+		{ID: 3, Title: "Go and AI", Summary: "ai"},
+		{ID: 4, Title: "Cooking", Summary: "pasta"},
+	}
+}
+
+// indexedService returns a Service with all testArticles embedded.
+func indexedService(t *testing.T) (*embedding.Service, *stubEmbeddingRepo) {
+	t.Helper()
+	repo := newStubEmbeddingRepo(testArticles()...)
+	svc := embedding.NewService(&wordEmbedder{}, repo)
+	n, err := svc.Backfill(context.Background(), 10)
+	if err != nil || n != 4 {
+		t.Fatalf("Backfill() = %d, %v; want 4, nil", n, err)
+	}
+	return svc, repo
+}
+
+func ids(articles []embedding.ScoredArticle) []int64 {
+	out := make([]int64, len(articles))
+	for i, a := range articles {
+		out[i] = a.Article.ID
+	}
+	return out
+}
+
+/* ───────── テストケース ───────── */
+
+func TestService_IndexArticle(t *testing.T) {
+	repo := newStubEmbeddingRepo()
+	svc := embedding.NewService(&wordEmbedder{}, repo)
+
+	if err := svc.IndexArticle(context.Background(), &entity.Article{ID: 5, Title: "Rust", Summary: "rust and go"}); err != nil {
+		t.Fatalf("IndexArticle() error = %v", err)
+	}
+	emb, ok := repo.embeddings[5]
+	if !ok || emb.Model != "words" || emb.Vector[0] != 1 || emb.Vector[1] != 2 {
+		t.Errorf("stored embedding = %+v", emb)
+	}
+
+	failing := embedding.NewService(&wordEmbedder{err: errors.New("api down")}, repo)
+	if err := failing.IndexArticle(context.Background(), &entity.Article{ID: 6}); err == nil {
+		t.Error("IndexArticle() error = nil, want embedder error")
+	}
+}
+
+func TestService_Backfill_SkipsEmbedded(t *testing.T) {
+	svc, _ := indexedService(t)
+
+	n, err := svc.Backfill(context.Background(), 10)
+	if err != nil || n != 0 {
+		t.Errorf("second Backfill() = %d, %v; want 0, nil", n, err)
+	}
+}
+
+func TestService_Search(t *testing.T) {
+	svc, _ := indexedService(t)
+
+	got, total, err := svc.Search(context.Background(), "go", 0, 10)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if total != 2 {
+		t.Errorf("total = %d, want 2", total)
+	}
+	// 記事1は"go"のみ、記事3は"go"と"ai"を含むため記事1が上位
+	if want := []int64{1, 3}; !equalIDs(ids(got), want) {
+		t.Errorf("ranking = %v, want %v", ids(got), want)
+	}
+	if got[0].Score <= got[1].Score || got[0].SourceName != "src" {
+		t.Errorf("results = %+v", got)
+	}
+
+	page, total, err := svc.Search(context.Background(), "go", 1, 10)
+	if err != nil || total != 2 || !equalIDs(ids(page), []int64{3}) {
+		t.Errorf("Search(offset=1) = %v, %d, %v", ids(page), total, err)
+	}
+
+	page, _, err = svc.Search(context.Background(), "go", 5, 10)
+	if err != nil || len(page) != 0 {
+		t.Errorf("Search(offset past end) = %v, %v", ids(page), err)
+	}
+}
+
+func TestService_Search_EmptyQuery(t *testing.T) {
+	svc, _ := indexedService(t)
+
+	if _, _, err := svc.Search(context.Background(), "  ", 0, 10); !errors.Is(err, embedding.ErrEmptyQuery) {
+		t.Errorf("Search(blank) error = %v, want ErrEmptyQuery", err)
+	}
+}
+
+func TestService_Related(t *testing.T) {
+	svc, _ := indexedService(t)
+
+	got, err := svc.Related(context.Background(), 1, 5)
+	if err != nil {
+		t.Fatalf("Related() error = %v", err)
+	}
+	// 記事自身と類似度0の記事は含まない
+	if want := []int64{3}; !equalIDs(ids(got), want) {
+		t.Errorf("related = %v, want %v", ids(got), want)
+	}
+
+	got, err = svc.Related(context.Background(), 99, 5)
+	if err != nil || len(got) != 0 {
+		t.Errorf("Related(not indexed) = %v, %v; want empty", ids(got), err)
+	}
+}
+
+func TestService_IndexCache(t *testing.T) {
+	svc, repo := indexedService(t)
+
+	for i := 0; i < 3; i++ {
+		if _, err := svc.Related(context.Background(), 1, 5); err != nil {
+			t.Fatalf("Related() error = %v", err)
+		}
+	}
+	if repo.listCalls != 1 {
+		t.Errorf("ListEmbeddings calls = %d, want 1", repo.listCalls)
+	}
+
+	// 読み込み済みのインデックスには新しい埋め込みが即時反映される
+	repo.articles[5] = &entity.Article{ID: 5, Title: "Go tips"}
+	if err := svc.IndexArticle(context.Background(), repo.articles[5]); err != nil {
+		t.Fatalf("IndexArticle() error = %v", err)
+	}
+	got, err := svc.Related(context.Background(), 1, 5)
+	if err != nil || !equalIDs(ids(got), []int64{5, 3}) {
+		t.Errorf("Related() after IndexArticle = %v, %v", ids(got), err)
+	}
+}
+
+func TestService_Errors(t *testing.T) {
+	svc, repo := indexedService(t)
+	repo.err = errors.New("db down")
+
+	if _, err := svc.Backfill(context.Background(), 10); err == nil {
+		t.Error("Backfill() error = nil, want repository error")
+	}
+	if _, err := svc.Related(context.Background(), 1, 5); err == nil {
+		t.Error("Related() error = nil, want repository error")
+	}
+
+	// 未読み込みのインデックスの読み込みに失敗した場合
+	fresh := embedding.NewService(&wordEmbedder{}, repo)
+	if _, _, err := fresh.Search(context.Background(), "go", 0, 10); err == nil {
+		t.Error("Search() error = nil, want repository error")
+	}
+}
+
+func equalIDs(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,44 @@
+// Package export provides the bulk article export use case: articles matching
+// the /articles/search filters are passed one at a time to an encoder, so that
+// large exports are not held in memory.
+package export
+
+import (
+	"context"
+
+	"catchup-feed/internal/repository"
+)
+
+// Export size limits.
+const (
+	// DefaultLimit is the default maximum number of exported articles.
+	DefaultLimit = 10000
+	// MaxLimit is the maximum number of articles per export.
+	MaxLimit = 100000
+)
+
+// Service provides the article export use case.
+type Service struct {
+	Repo repository.ArticleExportRepository
+}
+
+// Query selects the exported articles. Keywords and Filters work as in
+// /articles/search; without them every article is exported.
+type Query struct {
+	Keywords []string
+	Filters  repository.ArticleSearchFilters
+	// Limit is the maximum number of articles (DefaultLimit when not positive,
+	// capped at MaxLimit).
+	Limit int
+}
+
+// Export calls fn for each article selected by q, newest first. It stops at the
+// first error of fn and returns it.
+func (s *Service) Export(ctx context.Context, q Query, fn func(repository.ArticleWithSource) error) error {
+	limit := q.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	limit = min(limit, MaxLimit)
+	return s.Repo.EachArticle(ctx, q.Keywords, q.Filters, limit, fn)
+}
@@ -0,0 +1,57 @@
+package export_test
+
+import (
+	"context"
+	"testing"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	"catchup-feed/internal/usecase/export"
+)
+
+type stubExportRepo struct {
+	gotLimit int
+}
+
+func (s *stubExportRepo) EachArticle(_ context.Context, _ []string, _ repository.ArticleSearchFilters, limit int, fn func(repository.ArticleWithSource) error) error {
+	s.gotLimit = limit
+	for id := int64(2); id >= 1; id-- {
+		if err := fn(repository.ArticleWithSource{Article: &entity.Article{ID: id}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestService_Export(t *testing.T) {
+	tests := []struct {
+		name      string
+		limit     int
+		wantLimit int
+	}{
+		{name: "default limit", limit: 0, wantLimit: export.DefaultLimit},
+		{name: "custom limit", limit: 500, wantLimit: 500},
+		{name: "limit capped", limit: export.MaxLimit + 1, wantLimit: export.MaxLimit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &stubExportRepo{}
+			svc := export.Service{Repo: repo}
+
+			var ids []int64
+			err := svc.Export(context.Background(), export.Query{Limit: tt.limit}, func(a repository.ArticleWithSource) error {
+				ids = append(ids, a.Article.ID)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("Export err=%v", err)
+			}
+			if len(ids) != 2 || ids[0] != 2 {
+				t.Errorf("ids = %v, want [2 1]", ids)
+			}
+			if repo.gotLimit != tt.wantLimit {
+				t.Errorf("limit = %d, want %d", repo.gotLimit, tt.wantLimit)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Package feed provides use cases for the outbound article feeds (Atom, RSS and
+// JSON Feed) that feed readers poll with a per-user feed token.
+package feed
+
+import "errors"
+
+// Sentinel errors for feed use case operations.
+var (
+	// ErrUserRequired indicates that the request is not associated with a user.
+	// Feed tokens are issued per JWT subject.
+	ErrUserRequired = errors.New("authenticated user required for feed tokens")
+
+	// ErrTokenNotFound indicates that the user has no feed token.
+	ErrTokenNotFound = errors.New("feed token not found")
+
+	// ErrInvalidToken indicates that a feed was requested without a token or with
+	// a token that has been rotated or revoked.
+	ErrInvalidToken = errors.New("invalid feed token")
+)
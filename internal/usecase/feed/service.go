@@ -0,0 +1,132 @@
+package feed
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+// Feed size limits.
+const (
+	// DefaultLimit is the default number of articles in a feed.
+	DefaultLimit = 50
+	// MaxLimit is the maximum number of articles in a feed.
+	MaxLimit = 100
+)
+
+// tokenBytes is the number of random bytes in a feed token (256 bits).
+const tokenBytes = 32
+
+// ArticleLister lists the newest articles with their source names.
+// repository.ArticleRepository satisfies it.
+type ArticleLister interface {
+	ListWithSourcePaginated(ctx context.Context, offset, limit int) ([]repository.ArticleWithSource, error)
+	SearchWithFiltersPaginated(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters, offset, limit int) ([]repository.ArticleWithSource, error)
+}
+
+// Service provides the feed token and feed content use cases.
+type Service struct {
+	TokenRepo   repository.FeedTokenRepository
+	ArticleRepo ArticleLister
+}
+
+// Query selects the articles of a feed. Keywords and Filters work as in
+// /articles/search; without them the feed has the newest articles.
+type Query struct {
+	Keywords []string
+	Filters  repository.ArticleSearchFilters
+	// Limit is the maximum number of articles (DefaultLimit when not positive).
+	Limit int
+}
+
+// GetToken returns the feed token of userID.
+func (s *Service) GetToken(ctx context.Context, userID string) (*entity.FeedToken, error) {
+	if userID == "" {
+		return nil, ErrUserRequired
+	}
+	token, err := s.TokenRepo.GetFeedToken(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get feed token: %w", err)
+	}
+	if token == nil {
+		return nil, ErrTokenNotFound
+	}
+	return token, nil
+}
+
+// RotateToken issues a new feed token for userID. The previous token, if any,
+// stops working, so feed URLs must be updated in the readers.
+func (s *Service) RotateToken(ctx context.Context, userID string) (*entity.FeedToken, error) {
+	if userID == "" {
+		return nil, ErrUserRequired
+	}
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("generate feed token: %w", err)
+	}
+	token := &entity.FeedToken{UserID: userID, Token: base64.RawURLEncoding.EncodeToString(b)}
+	if err := s.TokenRepo.SetFeedToken(ctx, token); err != nil {
+		return nil, fmt.Errorf("set feed token: %w", err)
+	}
+	return token, nil
+}
+
+// RevokeToken removes the feed token of userID, disabling its feeds.
+func (s *Service) RevokeToken(ctx context.Context, userID string) error {
+	if userID == "" {
+		return ErrUserRequired
+	}
+	deleted, err := s.TokenRepo.DeleteFeedToken(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("delete feed token: %w", err)
+	}
+	if !deleted {
+		return ErrTokenNotFound
+	}
+	return nil
+}
+
+// Articles returns the articles of the feed selected by q, newest first, after
+// checking the feed token. Articles whose summary is still being generated are
+// left out so that readers do not keep an entry without its summary.
+func (s *Service) Articles(ctx context.Context, token string, q Query) ([]repository.ArticleWithSource, error) {
+	if token == "" {
+		return nil, ErrInvalidToken
+	}
+	owner, err := s.TokenRepo.GetFeedTokenByToken(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("get feed token: %w", err)
+	}
+	if owner == nil {
+		return nil, ErrInvalidToken
+	}
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	limit = min(limit, MaxLimit)
+
+	var articles []repository.ArticleWithSource
+	if len(q.Keywords) == 0 && q.Filters.Empty() {
+		articles, err = s.ArticleRepo.ListWithSourcePaginated(ctx, 0, limit)
+	} else {
+		articles, err = s.ArticleRepo.SearchWithFiltersPaginated(ctx, q.Keywords, q.Filters, 0, limit)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("list feed articles: %w", err)
+	}
+
+	out := make([]repository.ArticleWithSource, 0, len(articles))
+	for _, a := range articles {
+		if a.Article.SummaryStatus == entity.SummaryStatusPending {
+			continue
+		}
+		out = append(out, a)
+	}
+	return out, nil
+}
@@ -0,0 +1,145 @@
+package feed_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	"catchup-feed/internal/usecase/feed"
+)
+
+/* ───────── モック ───────── */
+
+type stubTokenRepo struct {
+	tokens map[string]*entity.FeedToken // user_id -> token
+	err    error
+}
+
+func newStubTokenRepo() *stubTokenRepo {
+	return &stubTokenRepo{tokens: map[string]*entity.FeedToken{"alice": {UserID: "alice", Token: "alice-token"}}}
+}
+
+func (s *stubTokenRepo) GetFeedToken(_ context.Context, userID string) (*entity.FeedToken, error) {
+	return s.tokens[userID], s.err
+}
+func (s *stubTokenRepo) GetFeedTokenByToken(_ context.Context, token string) (*entity.FeedToken, error) {
+	for _, t := range s.tokens {
+		if t.Token == token {
+			return t, s.err
+		}
+	}
+	return nil, s.err
+}
+func (s *stubTokenRepo) SetFeedToken(_ context.Context, token *entity.FeedToken) error {
+	s.tokens[token.UserID] = token
+	return s.err
+}
+func (s *stubTokenRepo) DeleteFeedToken(_ context.Context, userID string) (bool, error) {
+	_, ok := s.tokens[userID]
+	delete(s.tokens, userID)
+	return ok, s.err
+}
+
+type stubArticles struct {
+	articles    []repository.ArticleWithSource
+	searched    bool
+	gotKeywords []string
+	gotLimit    int
+}
+
+func (s *stubArticles) ListWithSourcePaginated(_ context.Context, _, limit int) ([]repository.ArticleWithSource, error) {
+	s.gotLimit = limit
+	return s.articles, nil
+}
+func (s *stubArticles) SearchWithFiltersPaginated(_ context.Context, keywords []string, _ repository.ArticleSearchFilters, _, limit int) ([]repository.ArticleWithSource, error) {
+	s.searched, s.gotKeywords, s.gotLimit = true, keywords, limit
+	return s.articles, nil
+}
+
+/* ───────── テスト ───────── */
+
+func TestService_RotateToken(t *testing.T) {
+	repo := newStubTokenRepo()
+	svc := feed.Service{TokenRepo: repo}
+
+	got, err := svc.RotateToken(context.Background(), "alice")
+	if err != nil {
+		t.Fatalf("RotateToken err=%v", err)
+	}
+	if got.Token == "alice-token" || len(got.Token) != 43 {
+		t.Errorf("Token = %q, want a new 43-character token", got.Token)
+	}
+	if repo.tokens["alice"].Token != got.Token {
+		t.Error("new token is not stored")
+	}
+
+	if _, err := svc.RotateToken(context.Background(), ""); !errors.Is(err, feed.ErrUserRequired) {
+		t.Errorf("err = %v, want %v", err, feed.ErrUserRequired)
+	}
+}
+
+func TestService_GetAndRevokeToken(t *testing.T) {
+	svc := feed.Service{TokenRepo: newStubTokenRepo()}
+
+	got, err := svc.GetToken(context.Background(), "alice")
+	if err != nil || got.Token != "alice-token" {
+		t.Fatalf("GetToken = %+v, %v", got, err)
+	}
+	if _, err := svc.GetToken(context.Background(), "bob"); !errors.Is(err, feed.ErrTokenNotFound) {
+		t.Errorf("err = %v, want %v", err, feed.ErrTokenNotFound)
+	}
+
+	if err := svc.RevokeToken(context.Background(), "alice"); err != nil {
+		t.Fatalf("RevokeToken err=%v", err)
+	}
+	if err := svc.RevokeToken(context.Background(), "alice"); !errors.Is(err, feed.ErrTokenNotFound) {
+		t.Errorf("err = %v, want %v", err, feed.ErrTokenNotFound)
+	}
+}
+
+func TestService_Articles(t *testing.T) {
+	sourceID := int64(2)
+	articles := []repository.ArticleWithSource{
+		{Article: &entity.Article{ID: 3, Summary: "要約"}, SourceName: "Go Blog"},
+		{Article: &entity.Article{ID: 2, SummaryStatus: entity.SummaryStatusPending}, SourceName: "Go Blog"},
+		{Article: &entity.Article{ID: 1, Summary: "要約"}, SourceName: "Go Blog"},
+	}
+
+	tests := []struct {
+		name         string
+		token        string
+		query        feed.Query
+		wantErr      error
+		wantSearched bool
+		wantLimit    int
+	}{
+		{name: "newest articles", token: "alice-token", wantLimit: feed.DefaultLimit},
+		{name: "keyword", token: "alice-token", query: feed.Query{Keywords: []string{"go"}, Limit: 10}, wantSearched: true, wantLimit: 10},
+		{name: "filters only", token: "alice-token", query: feed.Query{Filters: repository.ArticleSearchFilters{SourceID: &sourceID}}, wantSearched: true, wantLimit: feed.DefaultLimit},
+		{name: "limit capped", token: "alice-token", query: feed.Query{Limit: 1000}, wantLimit: feed.MaxLimit},
+		{name: "unknown token", token: "stolen", wantErr: feed.ErrInvalidToken},
+		{name: "no token", wantErr: feed.ErrInvalidToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lister := &stubArticles{articles: articles}
+			svc := feed.Service{TokenRepo: newStubTokenRepo(), ArticleRepo: lister}
+
+			got, err := svc.Articles(context.Background(), tt.token, tt.query)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if len(got) != 2 || got[0].Article.ID != 3 || got[1].Article.ID != 1 {
+				t.Errorf("articles = %+v, want 3 and 1 (pending summary left out)", got)
+			}
+			if lister.searched != tt.wantSearched || lister.gotLimit != tt.wantLimit {
+				t.Errorf("searched = %v, limit = %d; want %v, %d", lister.searched, lister.gotLimit, tt.wantSearched, tt.wantLimit)
+			}
+		})
+	}
+}
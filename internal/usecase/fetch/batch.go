@@ -0,0 +1,354 @@
+package fetch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/observability/metrics"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// BatchSummaryRequest is one article of a batch summarization request.
+type BatchSummaryRequest struct {
+	// CustomID identifies the article in the batch results.
+	CustomID string
+	SummaryRequest
+}
+
+// BatchSubmission describes a submitted batch.
+type BatchSubmission struct {
+	ID string
+	// PromptVersions maps each CustomID to the version of the prompt template used for it.
+	PromptVersions map[string]string
+	// InjectionFlags maps each CustomID to the prompt-injection patterns stripped from
+	// its content before submission. The output checks are added when results arrive.
+	InjectionFlags map[string][]string
+}
+
+// BatchSummaryResult is the outcome of one request of a finished batch.
+// Err is set when the request errored, expired or was canceled.
+type BatchSummaryResult struct {
+	Result *SummaryResult
+	Err    error
+}
+
+// BatchSummarizer is an optional extension of Summarizer that summarizes many
+// articles with a single asynchronous batch request at a lower cost.
+type BatchSummarizer interface {
+	// SubmitSummaryBatch submits reqs as one batch and returns its ID.
+	SubmitSummaryBatch(ctx context.Context, reqs []BatchSummaryRequest) (*BatchSubmission, error)
+	// SummaryBatchResults returns the results keyed by CustomID once the batch has
+	// finished processing. done is false while the batch is still in progress.
+	SummaryBatchResults(ctx context.Context, batchID string) (results map[string]BatchSummaryResult, done bool, err error)
+}
+
+// SummaryBatchStats contains statistics about a CollectSummaryBatches run.
+type SummaryBatchStats struct {
+	Batches    int // submitted batches with pending articles
+	Finished   int // batches whose results were applied
+	Summarized int64
+	Failed     int64
+	Duration   time.Duration
+}
+
+// pendingSummary is a pending article waiting to be submitted in a batch.
+type pendingSummary struct {
+	article *entity.Article
+	source  *entity.Source
+	item    FeedItem
+	content string
+}
+
+// pendingSummaries collects the pending articles of one crawl.
+type pendingSummaries struct {
+	mu    sync.Mutex
+	items []pendingSummary
+}
+
+func (p *pendingSummaries) add(item pendingSummary) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.items = append(p.items, item)
+}
+
+// summaryCustomID returns the batch request ID of an article.
+func summaryCustomID(articleID int64) string {
+	return fmt.Sprintf("article-%d", articleID)
+}
+
+// savePending stores a new article without summary and adds it to pending.
+// The notification is deferred until the summary arrives.
+func (s *Service) savePending(
+	ctx context.Context,
+	src *entity.Source,
+	item FeedItem,
+	content string,
+	pending *pendingSummaries,
+	stats *CrawlStats,
+) error {
+	art := &entity.Article{
+		SourceID:      src.ID,
+		Title:         item.Title,
+		URL:           item.URL,
+		PublishedAt:   item.PublishedAt,
+		CreatedAt:     time.Now(),
+		SummaryStatus: entity.SummaryStatusPending,
+	}
+	if err := s.ArticleRepo.Create(ctx, art); err != nil {
+		return fmt.Errorf("create article in repository: %w", err)
+	}
+	atomic.AddInt64(&stats.Inserted, 1)
+	s.saveContent(ctx, art, content)
+	// 本文とフィードのカテゴリによるタグはここで付け、要約由来のタグは要約完了時に付ける
+	s.tagArticle(ctx, art, content, item.Categories)
+
+	pending.add(pendingSummary{article: art, source: src, item: item, content: content})
+	return nil
+}
+
+// discardUnsubmitted deletes pending articles that were saved but never submitted,
+// e.g. because an earlier crawl was interrupted. Deleting them lets this crawl
+// pick them up again as new articles.
+func (s *Service) discardUnsubmitted(ctx context.Context) error {
+	arts, err := s.SummaryBatchRepo.ListPendingByBatch(ctx, "")
+	if err != nil {
+		return fmt.Errorf("list unsubmitted pending articles: %w", err)
+	}
+	for _, art := range arts {
+		if err := s.SummaryBatchRepo.DiscardPending(ctx, art.ID); err != nil {
+			return fmt.Errorf("discard unsubmitted pending article: %w", err)
+		}
+	}
+	if len(arts) > 0 {
+		slog.Warn("discarded unsubmitted pending articles",
+			slog.Int("count", len(arts)))
+	}
+	return nil
+}
+
+// submitSummaryBatch submits the pending articles of a crawl as one batch and
+// records the batch ID and prompt version on each article.
+// If the submission fails, the articles are summarized synchronously instead so
+// that a batch API outage does not leave them without summaries.
+func (s *Service) submitSummaryBatch(ctx context.Context, pending *pendingSummaries, stats *CrawlStats) error {
+	if len(pending.items) == 0 {
+		return nil
+	}
+
+	reqs := make([]BatchSummaryRequest, 0, len(pending.items))
+	for _, p := range pending.items {
+		reqs = append(reqs, BatchSummaryRequest{
+			CustomID:       summaryCustomID(p.article.ID),
+			SummaryRequest: newSummaryRequest(p.source, p.item, p.content),
+		})
+	}
+
+	sub, err := s.BatchSummarizer.SubmitSummaryBatch(ctx, reqs)
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return err
+		}
+		slog.Warn("summary batch submission failed, summarizing synchronously",
+			slog.Int("articles", len(pending.items)),
+			slog.Any("error", err))
+		return s.summarizePending(ctx, pending.items, stats)
+	}
+
+	safeCtx := context.WithoutCancel(ctx)
+	for _, p := range pending.items {
+		p.article.SummaryBatchID = sub.ID
+		p.article.PromptVersion = sub.PromptVersions[summaryCustomID(p.article.ID)]
+		p.article.InjectionFlags = sub.InjectionFlags[summaryCustomID(p.article.ID)]
+		if err := s.ArticleRepo.Update(safeCtx, p.article); err != nil {
+			return fmt.Errorf("record summary batch: %w", err)
+		}
+	}
+	atomic.AddInt64(&stats.PendingSummaries, int64(len(pending.items)))
+
+	slog.Info("summary batch submitted",
+		slog.String("batch_id", sub.ID),
+		slog.Int("articles", len(pending.items)))
+	return nil
+}
+
+// summarizePending summarizes pending articles synchronously with the regular
+// summarizer parallelism. Articles that cannot be summarized are deleted so that
+// the next crawl retries them, as in synchronous mode.
+func (s *Service) summarizePending(ctx context.Context, items []pendingSummary, stats *CrawlStats) error {
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(summarizerParallelism)
+
+	for _, p := range items {
+		eg.Go(func() error {
+			summaryStart := time.Now()
+			result, err := s.summarize(egCtx, p.source, p.item, p.content)
+			metrics.RecordSummarizationDuration(time.Since(summaryStart))
+			if err != nil {
+				if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+					return err
+				}
+				atomic.AddInt64(&stats.SummarizeError, 1)
+				metrics.RecordArticleSummarized(false)
+				s.recordSummarizeFailure(egCtx, p.article.SourceID, p.article.URL)
+				slog.Warn("summarization failed, discarding pending article",
+					slog.Int64("article_id", p.article.ID),
+					slog.String("url", p.article.URL),
+					slog.Any("error", err))
+				return s.discardPending(egCtx, p.article)
+			}
+
+			metrics.RecordArticleSummarized(true)
+			return s.completeSummary(egCtx, p.article, p.source, result)
+		})
+	}
+
+	return eg.Wait()
+}
+
+// completeSummary stores the summary of a pending article and sends the
+// notifications that were deferred when the article was saved.
+func (s *Service) completeSummary(ctx context.Context, art *entity.Article, src *entity.Source, result *SummaryResult) error {
+	art.Summary = result.Summary
+	art.Structured = result.Structured
+	if result.PromptVersion != "" {
+		art.PromptVersion = result.PromptVersion
+	}
+	art.SummaryModel = result.Model
+	// バッチ投入時に記録した入力側の検知結果に、出力側の検知結果を加える
+	art.InjectionFlags = entity.MergeInjectionFlags(art.InjectionFlags, result.InjectionFlags)
+	art.SummaryStatus = ""
+	art.SummaryBatchID = ""
+	if err := s.ArticleRepo.Update(context.WithoutCancel(ctx), art); err != nil {
+		return fmt.Errorf("update summarized article: %w", err)
+	}
+	s.tagArticle(ctx, art, "", nil)
+	s.indexArticle(ctx, art)
+	s.notifyStream(ctx, art)
+
+	if src != nil {
+		s.notifyNewArticle(art, src)
+	}
+	return nil
+}
+
+// discardPending deletes a pending article whose summary could not be generated.
+// The article is deleted permanently rather than trashed so that the next crawl
+// picks it up again.
+func (s *Service) discardPending(ctx context.Context, art *entity.Article) error {
+	if err := s.SummaryBatchRepo.DiscardPending(context.WithoutCancel(ctx), art.ID); err != nil {
+		return fmt.Errorf("discard pending article: %w", err)
+	}
+	return nil
+}
+
+// CollectSummaryBatches checks every submitted batch that still has pending
+// articles and, for finished batches, fills in the summaries and sends the
+// deferred notifications. Articles whose batch request failed are deleted so
+// that the next crawl picks them up again.
+// Batches that are still in progress, or whose status cannot be fetched, are
+// left for the next call.
+func (s *Service) CollectSummaryBatches(ctx context.Context) (*SummaryBatchStats, error) {
+	start := time.Now()
+	stats := &SummaryBatchStats{}
+
+	batchIDs, err := s.SummaryBatchRepo.ListPendingBatchIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list pending summary batches: %w", err)
+	}
+	stats.Batches = len(batchIDs)
+
+	sources := make(map[int64]*entity.Source)
+	for _, batchID := range batchIDs {
+		results, done, err := s.BatchSummarizer.SummaryBatchResults(ctx, batchID)
+		if err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				return stats, err
+			}
+			slog.Warn("failed to fetch summary batch results",
+				slog.String("batch_id", batchID),
+				slog.Any("error", err))
+			continue
+		}
+		if !done {
+			continue
+		}
+
+		if err := s.applyBatchResults(ctx, batchID, results, sources, stats); err != nil {
+			return stats, err
+		}
+		stats.Finished++
+	}
+
+	stats.Duration = time.Since(start)
+	slog.Info("summary batches collected",
+		slog.Int("batches", stats.Batches),
+		slog.Int("finished", stats.Finished),
+		slog.Int64("summarized", stats.Summarized),
+		slog.Int64("failed", stats.Failed),
+		slog.Duration("duration", stats.Duration))
+
+	return stats, nil
+}
+
+// applyBatchResults stores the results of a finished batch on its pending articles.
+// sources caches the sources looked up for notifications across batches.
+func (s *Service) applyBatchResults(
+	ctx context.Context,
+	batchID string,
+	results map[string]BatchSummaryResult,
+	sources map[int64]*entity.Source,
+	stats *SummaryBatchStats,
+) error {
+	arts, err := s.SummaryBatchRepo.ListPendingByBatch(ctx, batchID)
+	if err != nil {
+		return fmt.Errorf("list pending articles of batch: %w", err)
+	}
+
+	for _, art := range arts {
+		res, ok := results[summaryCustomID(art.ID)]
+		if !ok {
+			res.Err = errors.New("no result in batch")
+		}
+		if res.Err == nil && res.Result == nil {
+			res.Err = errors.New("empty result")
+		}
+		if res.Err != nil {
+			stats.Failed++
+			metrics.RecordArticleSummarized(false)
+			s.recordSummarizeFailure(ctx, art.SourceID, art.URL)
+			slog.Warn("batch summarization failed, discarding pending article",
+				slog.String("batch_id", batchID),
+				slog.Int64("article_id", art.ID),
+				slog.String("url", art.URL),
+				slog.Any("error", res.Err))
+			if err := s.discardPending(ctx, art); err != nil {
+				return err
+			}
+			continue
+		}
+
+		src, ok := sources[art.SourceID]
+		if !ok {
+			src, err = s.SourceRepo.Get(ctx, art.SourceID)
+			if err != nil {
+				return fmt.Errorf("get source of pending article: %w", err)
+			}
+			sources[art.SourceID] = src
+		}
+
+		if err := s.completeSummary(ctx, art, src, res.Result); err != nil {
+			return err
+		}
+		stats.Summarized++
+		metrics.RecordArticleSummarized(true)
+	}
+
+	return nil
+}
@@ -0,0 +1,361 @@
+package fetch_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	fetchUC "catchup-feed/internal/usecase/fetch"
+)
+
+/* ───────── バッチ要約のモック ───────── */
+
+// stubBatchSummarizer はBatchSummarizerのモック実装
+type stubBatchSummarizer struct {
+	submitErr error
+	submitted []fetchUC.BatchSummaryRequest
+	results   map[string]fetchUC.BatchSummaryResult
+	done      bool
+	resultErr error
+	// injectionFlags は投入した全リクエストで検知されたことにするインジェクションフラグ
+	injectionFlags []string
+}
+
+func (s *stubBatchSummarizer) SubmitSummaryBatch(_ context.Context, reqs []fetchUC.BatchSummaryRequest) (*fetchUC.BatchSubmission, error) {
+	if s.submitErr != nil {
+		return nil, s.submitErr
+	}
+	s.submitted = reqs
+	versions := make(map[string]string, len(reqs))
+	flags := make(map[string][]string)
+	for _, r := range reqs {
+		versions[r.CustomID] = "default@0123abcd"
+		if s.injectionFlags != nil {
+			flags[r.CustomID] = s.injectionFlags
+		}
+	}
+	return &fetchUC.BatchSubmission{ID: "msgbatch_1", PromptVersions: versions, InjectionFlags: flags}, nil
+}
+
+func (s *stubBatchSummarizer) SummaryBatchResults(_ context.Context, _ string) (map[string]fetchUC.BatchSummaryResult, bool, error) {
+	return s.results, s.done, s.resultErr
+}
+
+// stubSummaryBatchRepo はSummaryBatchRepositoryのモック実装
+type stubSummaryBatchRepo struct {
+	mu        sync.Mutex
+	pending   []*entity.Article
+	discarded []int64
+	// articles が設定されていれば、破棄した記事の URL を既存 URL から外す（物理削除の再現）
+	articles *stubArticleRepo
+}
+
+func (s *stubSummaryBatchRepo) ListPendingBatchIDs(_ context.Context) ([]string, error) {
+	seen := make(map[string]bool)
+	var ids []string
+	for _, a := range s.pending {
+		if a.SummaryBatchID != "" && !seen[a.SummaryBatchID] {
+			seen[a.SummaryBatchID] = true
+			ids = append(ids, a.SummaryBatchID)
+		}
+	}
+	return ids, nil
+}
+
+func (s *stubSummaryBatchRepo) ListPendingByBatch(_ context.Context, batchID string) ([]*entity.Article, error) {
+	var out []*entity.Article
+	for _, a := range s.pending {
+		if a.SummaryBatchID == batchID {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubSummaryBatchRepo) DiscardPending(_ context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.discarded = append(s.discarded, id)
+	if s.articles == nil {
+		return nil
+	}
+	for _, a := range s.pending {
+		if a.ID == id {
+			s.articles.mu.Lock()
+			delete(s.articles.existsMap, a.URL)
+			s.articles.mu.Unlock()
+		}
+	}
+	return nil
+}
+
+// stubStreamNotifier はStreamNotifierのモック実装
+type stubStreamNotifier struct {
+	mu       sync.Mutex
+	notified []int64
+}
+
+func (s *stubStreamNotifier) NotifyArticleSummarized(_ context.Context, articleID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.notified = append(s.notified, articleID)
+	return nil
+}
+
+// countingSummarizer は呼び出し回数を数えるSummarizerモック
+type countingSummarizer struct {
+	calls int32
+}
+
+func (s *countingSummarizer) Summarize(_ context.Context, text string) (string, error) {
+	atomic.AddInt32(&s.calls, 1)
+	return "Summary: " + text, nil
+}
+
+func newBatchTestService(artRepo *stubArticleRepo, sum fetchUC.Summarizer, notifier *mockNotifyService,
+	bs *stubBatchSummarizer, batchRepo *stubSummaryBatchRepo) fetchUC.Service {
+	now := time.Now()
+	svc := fetchUC.NewService(
+		&stubSourceRepo{sources: []*entity.Source{
+			{ID: 1, Name: "Go Blog", FeedURL: "https://example.com/feed", Active: true},
+		}},
+		artRepo,
+		sum,
+		&stubFeedFetcher{items: []fetchUC.FeedItem{
+			{Title: "Article 1", URL: "https://example.com/article1", Content: "Content 1", PublishedAt: now},
+			{Title: "Article 2", URL: "https://example.com/article2", Content: "Content 2", PublishedAt: now},
+		}},
+		nil,
+		nil,
+		notifier,
+		fetchUC.ContentFetchConfig{Parallelism: 10, Threshold: 1500},
+	)
+	svc.BatchSummarizer = bs
+	svc.SummaryBatchRepo = batchRepo
+	return svc
+}
+
+/* ───────── テストケース ───────── */
+
+func TestService_CrawlAllSources_BatchMode(t *testing.T) {
+	artRepo := &stubArticleRepo{existsMap: map[string]bool{}}
+	sum := &countingSummarizer{}
+	notifier := &mockNotifyService{}
+	bs := &stubBatchSummarizer{injectionFlags: []string{entity.InjectionFlagChatMarkup}}
+	svc := newBatchTestService(artRepo, sum, notifier, bs, &stubSummaryBatchRepo{})
+
+	stats, err := svc.CrawlAllSources(context.Background())
+	if err != nil {
+		t.Fatalf("CrawlAllSources() error = %v", err)
+	}
+
+	if stats.Inserted != 2 || stats.PendingSummaries != 2 {
+		t.Errorf("Inserted = %d, PendingSummaries = %d, want 2 and 2", stats.Inserted, stats.PendingSummaries)
+	}
+	if sum.calls != 0 {
+		t.Errorf("synchronous summarizer called %d times, want 0", sum.calls)
+	}
+	if notifier.notifyCalled != 0 {
+		t.Errorf("notifications = %d, want 0 until summaries arrive", notifier.notifyCalled)
+	}
+
+	if len(bs.submitted) != 2 {
+		t.Fatalf("submitted requests = %d, want 2", len(bs.submitted))
+	}
+	for _, req := range bs.submitted {
+		if req.Content == "" || req.SourceName != "Go Blog" {
+			t.Errorf("batch request missing article context: %+v", req)
+		}
+	}
+
+	for _, a := range artRepo.articles {
+		if a.SummaryStatus != entity.SummaryStatusPending {
+			t.Errorf("article %d status = %q, want pending", a.ID, a.SummaryStatus)
+		}
+		if a.SummaryBatchID != "msgbatch_1" {
+			t.Errorf("article %d batch = %q, want msgbatch_1", a.ID, a.SummaryBatchID)
+		}
+		if a.PromptVersion != "default@0123abcd" {
+			t.Errorf("article %d prompt version = %q", a.ID, a.PromptVersion)
+		}
+		if len(a.InjectionFlags) != 1 || a.InjectionFlags[0] != entity.InjectionFlagChatMarkup {
+			t.Errorf("article %d injection flags = %v, want [chat_markup]", a.ID, a.InjectionFlags)
+		}
+		if a.Summary != "" {
+			t.Errorf("article %d summary = %q, want empty", a.ID, a.Summary)
+		}
+	}
+}
+
+func TestService_CrawlAllSources_BatchSubmitFailureFallsBack(t *testing.T) {
+	artRepo := &stubArticleRepo{existsMap: map[string]bool{}}
+	sum := &countingSummarizer{}
+	notifier := &mockNotifyService{}
+	bs := &stubBatchSummarizer{submitErr: errors.New("batch api unavailable")}
+	svc := newBatchTestService(artRepo, sum, notifier, bs, &stubSummaryBatchRepo{})
+
+	stats, err := svc.CrawlAllSources(context.Background())
+	if err != nil {
+		t.Fatalf("CrawlAllSources() error = %v", err)
+	}
+
+	if stats.PendingSummaries != 0 {
+		t.Errorf("PendingSummaries = %d, want 0", stats.PendingSummaries)
+	}
+	if sum.calls != 2 {
+		t.Errorf("synchronous summarizer called %d times, want 2", sum.calls)
+	}
+	if notifier.notifyCalled != 2 {
+		t.Errorf("notifications = %d, want 2", notifier.notifyCalled)
+	}
+	for _, a := range artRepo.articles {
+		if a.SummaryStatus != "" || a.Summary == "" {
+			t.Errorf("article %d not summarized: status=%q summary=%q", a.ID, a.SummaryStatus, a.Summary)
+		}
+	}
+}
+
+func TestService_CrawlAllSources_BatchModeDiscardsUnsubmitted(t *testing.T) {
+	artRepo := &stubArticleRepo{existsMap: map[string]bool{}, nextID: 10}
+	batchRepo := &stubSummaryBatchRepo{pending: []*entity.Article{
+		{ID: 3, SummaryStatus: entity.SummaryStatusPending},
+		{ID: 4, SummaryStatus: entity.SummaryStatusPending, SummaryBatchID: "msgbatch_0"},
+	}}
+	svc := newBatchTestService(artRepo, &countingSummarizer{}, &mockNotifyService{}, &stubBatchSummarizer{}, batchRepo)
+
+	if _, err := svc.CrawlAllSources(context.Background()); err != nil {
+		t.Fatalf("CrawlAllSources() error = %v", err)
+	}
+
+	if len(batchRepo.discarded) != 1 || batchRepo.discarded[0] != 3 {
+		t.Errorf("discarded = %v, want [3] (only the unsubmitted article)", batchRepo.discarded)
+	}
+	if len(artRepo.deleted) != 0 {
+		t.Errorf("deleted = %v, want none: discarded articles must not go to the trash", artRepo.deleted)
+	}
+}
+
+func TestService_CrawlAllSources_BatchModeRecrawlsDiscarded(t *testing.T) {
+	// 前回のクロールで保存したが投入できなかった記事。URL は既存扱いになっている
+	artRepo := &stubArticleRepo{existsMap: map[string]bool{"https://example.com/article1": true}, nextID: 10}
+	batchRepo := &stubSummaryBatchRepo{
+		pending: []*entity.Article{
+			{ID: 3, URL: "https://example.com/article1", SummaryStatus: entity.SummaryStatusPending},
+		},
+		articles: artRepo,
+	}
+	bs := &stubBatchSummarizer{}
+	svc := newBatchTestService(artRepo, &countingSummarizer{}, &mockNotifyService{}, bs, batchRepo)
+
+	stats, err := svc.CrawlAllSources(context.Background())
+	if err != nil {
+		t.Fatalf("CrawlAllSources() error = %v", err)
+	}
+
+	// 破棄した記事は同じクロールで新しい記事として再取得される
+	if stats.Inserted != 2 || stats.Duplicated != 0 {
+		t.Errorf("Inserted = %d, Duplicated = %d, want 2 and 0", stats.Inserted, stats.Duplicated)
+	}
+	if len(bs.submitted) != 2 {
+		t.Errorf("submitted requests = %d, want 2", len(bs.submitted))
+	}
+}
+
+func TestService_CollectSummaryBatches(t *testing.T) {
+	pending := []*entity.Article{
+		{ID: 1, SourceID: 1, URL: "https://example.com/1", PromptVersion: "default@0123abcd",
+			SummaryStatus: entity.SummaryStatusPending, SummaryBatchID: "msgbatch_1",
+			InjectionFlags: []string{entity.InjectionFlagInstructionOverride}},
+		{ID: 2, SourceID: 1, URL: "https://example.com/2",
+			SummaryStatus: entity.SummaryStatusPending, SummaryBatchID: "msgbatch_1"},
+		{ID: 3, SourceID: 1, URL: "https://example.com/3",
+			SummaryStatus: entity.SummaryStatusPending, SummaryBatchID: "msgbatch_1"},
+	}
+
+	t.Run("in progress", func(t *testing.T) {
+		artRepo := &stubArticleRepo{}
+		notifier := &mockNotifyService{}
+		svc := newBatchTestService(artRepo, &countingSummarizer{}, notifier,
+			&stubBatchSummarizer{done: false}, &stubSummaryBatchRepo{pending: pending})
+
+		stats, err := svc.CollectSummaryBatches(context.Background())
+		if err != nil {
+			t.Fatalf("CollectSummaryBatches() error = %v", err)
+		}
+		if stats.Batches != 1 || stats.Finished != 0 {
+			t.Errorf("Batches = %d, Finished = %d, want 1 and 0", stats.Batches, stats.Finished)
+		}
+		if len(artRepo.updated) != 0 || notifier.notifyCalled != 0 {
+			t.Errorf("in-progress batch must not touch articles")
+		}
+	})
+
+	t.Run("status error is retried later", func(t *testing.T) {
+		svc := newBatchTestService(&stubArticleRepo{}, &countingSummarizer{}, &mockNotifyService{},
+			&stubBatchSummarizer{resultErr: errors.New("503")}, &stubSummaryBatchRepo{pending: pending})
+
+		stats, err := svc.CollectSummaryBatches(context.Background())
+		if err != nil {
+			t.Fatalf("CollectSummaryBatches() error = %v", err)
+		}
+		if stats.Finished != 0 {
+			t.Errorf("Finished = %d, want 0", stats.Finished)
+		}
+	})
+
+	t.Run("finished", func(t *testing.T) {
+		artRepo := &stubArticleRepo{}
+		notifier := &mockNotifyService{}
+		batchRepo := &stubSummaryBatchRepo{pending: pending}
+		bs := &stubBatchSummarizer{done: true, results: map[string]fetchUC.BatchSummaryResult{
+			"article-1": {Result: &fetchUC.SummaryResult{Summary: "要約1", InjectionFlags: []string{entity.InjectionFlagEchoedInstruction}}},
+			"article-2": {Err: errors.New("batch request expired")},
+		}}
+		svc := newBatchTestService(artRepo, &countingSummarizer{}, notifier, bs, batchRepo)
+		streamNotifier := &stubStreamNotifier{}
+		svc.StreamNotifier = streamNotifier
+
+		stats, err := svc.CollectSummaryBatches(context.Background())
+		if err != nil {
+			t.Fatalf("CollectSummaryBatches() error = %v", err)
+		}
+
+		if stats.Finished != 1 || stats.Summarized != 1 || stats.Failed != 2 {
+			t.Errorf("stats = %+v, want 1 finished, 1 summarized, 2 failed", stats)
+		}
+
+		a := pending[0]
+		if a.Summary != "要約1" || a.SummaryStatus != "" || a.SummaryBatchID != "" {
+			t.Errorf("article 1 not completed: %+v", a)
+		}
+		if a.PromptVersion != "default@0123abcd" {
+			t.Errorf("prompt version recorded at submission must be kept, got %q", a.PromptVersion)
+		}
+		// 投入時の入力側フラグに出力側フラグが加わる
+		if len(a.InjectionFlags) != 2 || a.InjectionFlags[0] != entity.InjectionFlagEchoedInstruction ||
+			a.InjectionFlags[1] != entity.InjectionFlagInstructionOverride {
+			t.Errorf("injection flags = %v, want [echoed_instruction instruction_override]", a.InjectionFlags)
+		}
+		if len(artRepo.updated) != 1 || artRepo.updated[0] != 1 {
+			t.Errorf("updated = %v, want [1]", artRepo.updated)
+		}
+		if notifier.notifyCalled != 1 {
+			t.Errorf("notifications = %d, want 1", notifier.notifyCalled)
+		}
+		// 新着記事ストリームへは要約を保存した記事だけを通知する
+		if len(streamNotifier.notified) != 1 || streamNotifier.notified[0] != 1 {
+			t.Errorf("stream notifications = %v, want [1]", streamNotifier.notified)
+		}
+		// 失敗した記事・結果のない記事は物理削除され、次回クロールで再取得される
+		if len(batchRepo.discarded) != 2 || batchRepo.discarded[0] != 2 || batchRepo.discarded[1] != 3 {
+			t.Errorf("discarded = %v, want [2 3]", batchRepo.discarded)
+		}
+		if len(artRepo.deleted) != 0 {
+			t.Errorf("deleted = %v, want none", artRepo.deleted)
+		}
+	})
+}
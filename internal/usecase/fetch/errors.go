@@ -18,4 +18,8 @@ var (
 	// ErrSummarizationFailed indicates that AI summarization of an article failed.
 	// This can occur due to API errors, rate limits, or invalid content.
 	ErrSummarizationFailed = errors.New("failed to summarize article content")
+
+	// ErrNoContent indicates that an article cannot be re-summarized because no
+	// stored content exists and it could not be re-fetched from its URL.
+	ErrNoContent = errors.New("no article content available")
 )
@@ -0,0 +1,133 @@
+package fetch_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	fetchUC "catchup-feed/internal/usecase/fetch"
+)
+
+/* ───────── 埋め込みのモック ───────── */
+
+// stubIndexer はIndexerのモック実装
+type stubIndexer struct {
+	mu        sync.Mutex
+	summaries map[int64]string // IndexArticle呼び出し時の記事IDと要約
+	backfill  int
+	err       error
+}
+
+func (s *stubIndexer) IndexArticle(_ context.Context, art *entity.Article) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.summaries == nil {
+		s.summaries = map[int64]string{}
+	}
+	s.summaries[art.ID] = art.Summary
+	return s.err
+}
+
+func (s *stubIndexer) Backfill(_ context.Context, limit int) (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+	return min(s.backfill, limit), nil
+}
+
+/* ───────── テストケース ───────── */
+
+func TestService_CrawlAllSources_IndexesNewArticles(t *testing.T) {
+	for _, indexErr := range []error{nil, errors.New("api down")} {
+		artRepo := &stubArticleRepo{existsMap: map[string]bool{}}
+		indexer := &stubIndexer{err: indexErr}
+		svc := fetchUC.NewService(
+			&stubSourceRepo{sources: []*entity.Source{{ID: 1, FeedURL: "https://example.com/feed", Active: true}}},
+			artRepo, &stubSummarizer{},
+			&stubFeedFetcher{items: []fetchUC.FeedItem{
+				{Title: "t", URL: "https://example.com/a", Content: "body", PublishedAt: time.Now()},
+			}},
+			nil, nil, &mockNotifyService{}, fetchUC.ContentFetchConfig{Parallelism: 1, Threshold: 1500},
+		)
+		svc.Indexer = indexer
+
+		stats, err := svc.CrawlAllSources(context.Background())
+		if err != nil {
+			t.Fatalf("CrawlAllSources() error = %v (indexer error must not fail the crawl)", err)
+		}
+		if stats.Inserted != 1 {
+			t.Fatalf("Inserted = %d, want 1", stats.Inserted)
+		}
+		if summary, ok := indexer.summaries[artRepo.articles[0].ID]; !ok || summary == "" {
+			t.Errorf("new article must be indexed with its summary: %v", indexer.summaries)
+		}
+	}
+}
+
+func TestService_BatchMode_IndexesOnCompletion(t *testing.T) {
+	artRepo := &stubArticleRepo{existsMap: map[string]bool{}}
+	indexer := &stubIndexer{}
+	svc := newBatchTestService(artRepo, &countingSummarizer{}, &mockNotifyService{},
+		&stubBatchSummarizer{}, &stubSummaryBatchRepo{})
+	svc.Indexer = indexer
+
+	if _, err := svc.CrawlAllSources(context.Background()); err != nil {
+		t.Fatalf("CrawlAllSources() error = %v", err)
+	}
+	if len(indexer.summaries) != 0 {
+		t.Errorf("pending articles must not be indexed before they are summarized: %v", indexer.summaries)
+	}
+
+	pending := []*entity.Article{{ID: 1, SourceID: 1, URL: "https://example.com/1",
+		SummaryStatus: entity.SummaryStatusPending, SummaryBatchID: "msgbatch_1"}}
+	svc = newBatchTestService(&stubArticleRepo{}, &countingSummarizer{}, &mockNotifyService{},
+		&stubBatchSummarizer{done: true, results: map[string]fetchUC.BatchSummaryResult{
+			"article-1": {Result: &fetchUC.SummaryResult{Summary: "要約1"}},
+		}}, &stubSummaryBatchRepo{pending: pending})
+	svc.Indexer = indexer
+
+	if _, err := svc.CollectSummaryBatches(context.Background()); err != nil {
+		t.Fatalf("CollectSummaryBatches() error = %v", err)
+	}
+	if indexer.summaries[1] != "要約1" {
+		t.Errorf("completed article must be indexed with its summary: %v", indexer.summaries)
+	}
+}
+
+func TestService_RunResummarizeJobs_ReindexesArticles(t *testing.T) {
+	indexer := &stubIndexer{}
+	jobRepo := &stubResummarizeRepo{
+		jobs:    []*entity.ResummarizeJob{{ID: 1, Status: entity.ResummarizeQueued}},
+		targets: resummarizeTargets(1),
+	}
+	svc := newResummarizeTestService(&stubArticleRepo{}, &structuredSummarizer{}, nil,
+		&stubContentRepo{contents: map[int64]string{10: "stored body"}}, jobRepo)
+	svc.Indexer = indexer
+
+	if _, err := svc.RunResummarizeJobs(context.Background(), 10); err != nil {
+		t.Fatalf("RunResummarizeJobs() error = %v", err)
+	}
+	if _, ok := indexer.summaries[10]; !ok {
+		t.Errorf("re-summarized article must be re-indexed: %v", indexer.summaries)
+	}
+}
+
+func TestService_BackfillEmbeddings(t *testing.T) {
+	var svc fetchUC.Service
+	if n, err := svc.BackfillEmbeddings(context.Background(), 10); n != 0 || err != nil {
+		t.Errorf("BackfillEmbeddings() without Indexer = %d, %v; want 0, nil", n, err)
+	}
+
+	svc.Indexer = &stubIndexer{backfill: 25}
+	if n, err := svc.BackfillEmbeddings(context.Background(), 10); n != 10 || err != nil {
+		t.Errorf("BackfillEmbeddings() = %d, %v; want 10, nil", n, err)
+	}
+
+	svc.Indexer = &stubIndexer{err: errors.New("api down")}
+	if _, err := svc.BackfillEmbeddings(context.Background(), 10); err == nil {
+		t.Error("BackfillEmbeddings() error = nil, want error")
+	}
+}
@@ -0,0 +1,209 @@
+package fetch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// ResummarizeStats contains statistics about a RunResummarizeJobs run.
+type ResummarizeStats struct {
+	Jobs      int // jobs that processed at least one step
+	Completed int // jobs that reached a final status
+	Succeeded int
+	Failed    int
+	Duration  time.Duration
+}
+
+// RunResummarizeJobs re-summarizes at most budget articles of the queued and
+// running resummarize jobs, oldest job first. Articles are processed one at a
+// time so that re-summarization never competes with the crawl for summarizer
+// capacity; the caller limits the rate by choosing budget and how often it runs.
+// Progress is stored after every step so an interrupted job resumes after the
+// last processed article.
+func (s *Service) RunResummarizeJobs(ctx context.Context, budget int) (*ResummarizeStats, error) {
+	start := time.Now()
+	stats := &ResummarizeStats{}
+	sources := make(map[int64]*entity.Source)
+
+	for processed := 0; processed < budget; {
+		job, err := s.ResummarizeRepo.NextJob(ctx)
+		if err != nil {
+			return stats, fmt.Errorf("get next resummarize job: %w", err)
+		}
+		if job == nil {
+			break
+		}
+		stats.Jobs++
+
+		n, err := s.runResummarizeStep(ctx, job, budget-processed, sources, stats)
+		if err != nil {
+			return stats, err
+		}
+		processed += n
+		if job.Done() {
+			stats.Completed++
+		}
+	}
+
+	stats.Duration = time.Since(start)
+	if stats.Jobs > 0 {
+		slog.Info("resummarize jobs processed",
+			slog.Int("jobs", stats.Jobs),
+			slog.Int("completed", stats.Completed),
+			slog.Int("succeeded", stats.Succeeded),
+			slog.Int("failed", stats.Failed),
+			slog.Duration("duration", stats.Duration))
+	}
+	return stats, nil
+}
+
+// runResummarizeStep processes up to limit target articles of job and returns
+// the number of articles processed. A queued job is started first; a job with no
+// targets left is completed.
+func (s *Service) runResummarizeStep(
+	ctx context.Context,
+	job *entity.ResummarizeJob,
+	limit int,
+	sources map[int64]*entity.Source,
+	stats *ResummarizeStats,
+) (int, error) {
+	safeCtx := context.WithoutCancel(ctx)
+
+	if job.Status == entity.ResummarizeQueued {
+		total, err := s.ResummarizeRepo.CountTargets(ctx, job.Filter)
+		if err != nil {
+			return 0, s.failResummarizeJob(safeCtx, job, err)
+		}
+		now := time.Now()
+		job.Status = entity.ResummarizeRunning
+		job.Total = total
+		job.StartedAt = &now
+		if err := s.ResummarizeRepo.UpdateJob(safeCtx, job); err != nil {
+			return 0, fmt.Errorf("start resummarize job: %w", err)
+		}
+	}
+
+	arts, err := s.ResummarizeRepo.ListTargets(ctx, job.Filter, job.LastArticleID, limit)
+	if err != nil {
+		return 0, s.failResummarizeJob(safeCtx, job, err)
+	}
+
+	for _, art := range arts {
+		if err := s.resummarizeArticle(ctx, art, sources); err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				if uerr := s.ResummarizeRepo.UpdateJob(safeCtx, job); uerr != nil {
+					return 0, fmt.Errorf("store resummarize progress: %w", uerr)
+				}
+				return 0, err
+			}
+			job.Failed++
+			stats.Failed++
+			slog.Warn("resummarization failed, keeping previous summary",
+				slog.Int64("job_id", job.ID),
+				slog.Int64("article_id", art.ID),
+				slog.String("url", art.URL),
+				slog.Any("error", err))
+		} else {
+			job.Succeeded++
+			stats.Succeeded++
+		}
+		job.Processed++
+		job.LastArticleID = art.ID
+	}
+
+	// 上限に満たない件数しか返らなければ対象はもう残っていない
+	if len(arts) < limit {
+		now := time.Now()
+		job.Status = entity.ResummarizeCompleted
+		job.FinishedAt = &now
+	}
+	if err := s.ResummarizeRepo.UpdateJob(safeCtx, job); err != nil {
+		return 0, fmt.Errorf("store resummarize progress: %w", err)
+	}
+	return len(arts), nil
+}
+
+// failResummarizeJob marks job as failed because its targets could not be selected.
+func (s *Service) failResummarizeJob(ctx context.Context, job *entity.ResummarizeJob, cause error) error {
+	now := time.Now()
+	job.Status = entity.ResummarizeFailed
+	job.Error = cause.Error()
+	job.FinishedAt = &now
+	if err := s.ResummarizeRepo.UpdateJob(ctx, job); err != nil {
+		return fmt.Errorf("mark resummarize job failed: %w", err)
+	}
+	return fmt.Errorf("select resummarize targets: %w", cause)
+}
+
+// resummarizeArticle regenerates the summary of art with the configured summarizer
+// and stores it together with the prompt version and model that produced it.
+// sources caches the sources looked up for prompt metadata.
+func (s *Service) resummarizeArticle(ctx context.Context, art *entity.Article, sources map[int64]*entity.Source) error {
+	src, ok := sources[art.SourceID]
+	if !ok {
+		var err error
+		src, err = s.SourceRepo.Get(ctx, art.SourceID)
+		if err != nil {
+			return fmt.Errorf("get source: %w", err)
+		}
+		if src == nil {
+			return fmt.Errorf("source %d not found", art.SourceID)
+		}
+		sources[art.SourceID] = src
+	}
+
+	content, err := s.articleContent(ctx, art)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.summarize(ctx, src, FeedItem{Title: art.Title, URL: art.URL}, content)
+	if err != nil {
+		return fmt.Errorf("summarize: %w", err)
+	}
+
+	art.Summary = result.Summary
+	art.Structured = result.Structured
+	art.PromptVersion = result.PromptVersion
+	art.SummaryModel = result.Model
+	art.InjectionFlags = result.InjectionFlags
+	if err := s.ArticleRepo.Update(context.WithoutCancel(ctx), art); err != nil {
+		return fmt.Errorf("update article: %w", err)
+	}
+	s.tagArticle(ctx, art, content, nil)
+	s.indexArticle(ctx, art)
+	return nil
+}
+
+// articleContent returns the full text to re-summarize art from: the content
+// stored when the article was crawled, or else the content re-fetched from its
+// URL through ContentFetcher, which is then stored for the next time.
+func (s *Service) articleContent(ctx context.Context, art *entity.Article) (string, error) {
+	if s.ContentRepo != nil {
+		content, err := s.ContentRepo.GetContent(ctx, art.ID)
+		if err != nil {
+			return "", fmt.Errorf("get stored content: %w", err)
+		}
+		if content != "" {
+			return content, nil
+		}
+	}
+
+	if s.ContentFetcher == nil {
+		return "", ErrNoContent
+	}
+	content, err := s.ContentFetcher.FetchContent(ctx, art.URL)
+	if err != nil {
+		return "", fmt.Errorf("fetch content: %w", err)
+	}
+	if content == "" {
+		return "", ErrNoContent
+	}
+	s.saveContent(ctx, art, content)
+	return content, nil
+}
@@ -0,0 +1,339 @@
+package fetch_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	fetchUC "catchup-feed/internal/usecase/fetch"
+)
+
+/* ───────── 再要約のモック ───────── */
+
+// stubResummarizeRepo はResummarizeRepositoryのモック実装
+// targets はID昇順で保持する
+type stubResummarizeRepo struct {
+	jobs     []*entity.ResummarizeJob
+	targets  []*entity.Article
+	listErr  error
+	updates  int
+	lastList int
+}
+
+func (s *stubResummarizeRepo) CreateJob(_ context.Context, job *entity.ResummarizeJob) error {
+	job.ID = int64(len(s.jobs) + 1)
+	s.jobs = append(s.jobs, job)
+	return nil
+}
+
+func (s *stubResummarizeRepo) GetJob(_ context.Context, id int64) (*entity.ResummarizeJob, error) {
+	for _, j := range s.jobs {
+		if j.ID == id {
+			return j, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *stubResummarizeRepo) NextJob(_ context.Context) (*entity.ResummarizeJob, error) {
+	for _, status := range []string{entity.ResummarizeRunning, entity.ResummarizeQueued} {
+		for _, j := range s.jobs {
+			if j.Status == status {
+				return j, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (s *stubResummarizeRepo) UpdateJob(_ context.Context, _ *entity.ResummarizeJob) error {
+	s.updates++
+	return nil
+}
+
+func (s *stubResummarizeRepo) CountTargets(_ context.Context, _ entity.ResummarizeFilter) (int, error) {
+	return len(s.targets), s.listErr
+}
+
+func (s *stubResummarizeRepo) ListTargets(_ context.Context, _ entity.ResummarizeFilter, afterID int64, limit int) ([]*entity.Article, error) {
+	if s.listErr != nil {
+		return nil, s.listErr
+	}
+	s.lastList = limit
+	var out []*entity.Article
+	for _, a := range s.targets {
+		if a.ID > afterID && len(out) < limit {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+// stubContentRepo はArticleContentRepositoryのモック実装
+type stubContentRepo struct {
+	mu       sync.Mutex
+	contents map[int64]string
+}
+
+func (s *stubContentRepo) SaveContent(_ context.Context, articleID int64, content string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.contents == nil {
+		s.contents = make(map[int64]string)
+	}
+	s.contents[articleID] = content
+	return nil
+}
+
+func (s *stubContentRepo) GetContent(_ context.Context, articleID int64) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.contents[articleID], nil
+}
+
+func newResummarizeTestService(artRepo *stubArticleRepo, sum fetchUC.Summarizer, cf fetchUC.ContentFetcher,
+	contentRepo *stubContentRepo, jobRepo *stubResummarizeRepo) fetchUC.Service {
+	svc := fetchUC.NewService(
+		&stubSourceRepo{sources: []*entity.Source{
+			{ID: 1, Name: "Go Blog", FeedURL: "https://example.com/feed", Active: true, PromptTemplate: "release-notes"},
+		}},
+		artRepo, sum, &stubFeedFetcher{}, nil, cf, &mockNotifyService{},
+		fetchUC.ContentFetchConfig{Parallelism: 1, Threshold: 1500},
+	)
+	svc.ContentRepo = contentRepo
+	svc.ResummarizeRepo = jobRepo
+	return svc
+}
+
+func resummarizeTargets(n int) []*entity.Article {
+	arts := make([]*entity.Article, 0, n)
+	for i := 1; i <= n; i++ {
+		arts = append(arts, &entity.Article{
+			ID: int64(i * 10), SourceID: 1, Title: "title", URL: "https://example.com/a",
+			Summary: "old", SummaryModel: "old-model",
+		})
+	}
+	return arts
+}
+
+/* ───────── テストケース ───────── */
+
+func TestService_RunResummarizeJobs_UsesStoredContent(t *testing.T) {
+	artRepo := &stubArticleRepo{}
+	sum := &structuredSummarizer{}
+	cf := &mockContentFetcher{content: "fetched"}
+	contentRepo := &stubContentRepo{contents: map[int64]string{10: "stored body"}}
+	jobRepo := &stubResummarizeRepo{
+		jobs:    []*entity.ResummarizeJob{{ID: 1, Status: entity.ResummarizeQueued}},
+		targets: resummarizeTargets(1),
+	}
+	svc := newResummarizeTestService(artRepo, sum, cf, contentRepo, jobRepo)
+
+	stats, err := svc.RunResummarizeJobs(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("RunResummarizeJobs() error = %v", err)
+	}
+
+	if cf.called {
+		t.Error("ContentFetcher called although content was stored")
+	}
+	if len(sum.requests) != 1 || sum.requests[0].Content != "stored body" || sum.requests[0].Template != "release-notes" {
+		t.Fatalf("unexpected summarize requests: %+v", sum.requests)
+	}
+	art := jobRepo.targets[0]
+	if art.Summary != "structured prose" || art.SummaryModel != "test-model" || art.PromptVersion != "release-notes@0123abcd" {
+		t.Errorf("article not updated: %+v", art)
+	}
+	if len(artRepo.updated) != 1 || artRepo.updated[0] != 10 {
+		t.Errorf("updated = %v, want [10]", artRepo.updated)
+	}
+
+	job := jobRepo.jobs[0]
+	if job.Status != entity.ResummarizeCompleted || job.StartedAt == nil || job.FinishedAt == nil {
+		t.Errorf("job not completed: %+v", job)
+	}
+	if job.Total != 1 || job.Processed != 1 || job.Succeeded != 1 || job.LastArticleID != 10 {
+		t.Errorf("unexpected progress: %+v", job)
+	}
+	if stats.Jobs != 1 || stats.Completed != 1 || stats.Succeeded != 1 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestService_RunResummarizeJobs_RefetchesMissingContent(t *testing.T) {
+	sum := &structuredSummarizer{}
+	cf := &mockContentFetcher{content: "fetched body"}
+	contentRepo := &stubContentRepo{}
+	jobRepo := &stubResummarizeRepo{
+		jobs:    []*entity.ResummarizeJob{{ID: 1, Status: entity.ResummarizeQueued}},
+		targets: resummarizeTargets(1),
+	}
+	svc := newResummarizeTestService(&stubArticleRepo{}, sum, cf, contentRepo, jobRepo)
+
+	if _, err := svc.RunResummarizeJobs(context.Background(), 10); err != nil {
+		t.Fatalf("RunResummarizeJobs() error = %v", err)
+	}
+
+	if !cf.called {
+		t.Fatal("ContentFetcher not called for missing content")
+	}
+	if len(sum.requests) != 1 || sum.requests[0].Content != "fetched body" {
+		t.Fatalf("unexpected summarize requests: %+v", sum.requests)
+	}
+	if contentRepo.contents[10] != "fetched body" {
+		t.Errorf("re-fetched content not stored: %v", contentRepo.contents)
+	}
+}
+
+func TestService_RunResummarizeJobs_BudgetAndResume(t *testing.T) {
+	artRepo := &stubArticleRepo{}
+	sum := &structuredSummarizer{}
+	contentRepo := &stubContentRepo{contents: map[int64]string{10: "a", 20: "b", 30: "c"}}
+	jobRepo := &stubResummarizeRepo{
+		jobs:    []*entity.ResummarizeJob{{ID: 1, Status: entity.ResummarizeQueued}},
+		targets: resummarizeTargets(3),
+	}
+	svc := newResummarizeTestService(artRepo, sum, nil, contentRepo, jobRepo)
+
+	if _, err := svc.RunResummarizeJobs(context.Background(), 2); err != nil {
+		t.Fatalf("first run error = %v", err)
+	}
+	job := jobRepo.jobs[0]
+	if job.Status != entity.ResummarizeRunning || job.Processed != 2 || job.LastArticleID != 20 || job.Total != 3 {
+		t.Fatalf("after first run: %+v", job)
+	}
+	if len(sum.requests) != 2 {
+		t.Fatalf("summarize calls = %d, want 2 (budget)", len(sum.requests))
+	}
+
+	if _, err := svc.RunResummarizeJobs(context.Background(), 2); err != nil {
+		t.Fatalf("second run error = %v", err)
+	}
+	if job.Status != entity.ResummarizeCompleted || job.Processed != 3 || job.LastArticleID != 30 {
+		t.Fatalf("after second run: %+v", job)
+	}
+	if len(sum.requests) != 3 {
+		t.Errorf("summarize calls = %d, want 3", len(sum.requests))
+	}
+}
+
+func TestService_RunResummarizeJobs_ContinuesWithNextJob(t *testing.T) {
+	sum := &structuredSummarizer{}
+	contentRepo := &stubContentRepo{contents: map[int64]string{10: "a"}}
+	jobRepo := &stubResummarizeRepo{
+		jobs: []*entity.ResummarizeJob{
+			{ID: 1, Status: entity.ResummarizeQueued},
+			{ID: 2, Status: entity.ResummarizeQueued},
+		},
+		targets: resummarizeTargets(1),
+	}
+	svc := newResummarizeTestService(&stubArticleRepo{}, sum, nil, contentRepo, jobRepo)
+
+	stats, err := svc.RunResummarizeJobs(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("RunResummarizeJobs() error = %v", err)
+	}
+	if stats.Jobs != 2 || stats.Completed != 2 {
+		t.Errorf("stats = %+v, want both jobs completed", stats)
+	}
+	if jobRepo.lastList != 4 {
+		t.Errorf("second job limit = %d, want remaining budget 4", jobRepo.lastList)
+	}
+}
+
+func TestService_RunResummarizeJobs_FailedArticleKeepsSummary(t *testing.T) {
+	artRepo := &stubArticleRepo{}
+	jobRepo := &stubResummarizeRepo{
+		jobs:    []*entity.ResummarizeJob{{ID: 1, Status: entity.ResummarizeQueued}},
+		targets: resummarizeTargets(2),
+	}
+	// 本文が保存されておらず、ContentFetcherも無いため再要約できない
+	svc := newResummarizeTestService(artRepo, &structuredSummarizer{}, nil, &stubContentRepo{}, jobRepo)
+
+	stats, err := svc.RunResummarizeJobs(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("RunResummarizeJobs() error = %v", err)
+	}
+	job := jobRepo.jobs[0]
+	if job.Status != entity.ResummarizeCompleted || job.Failed != 2 || job.Succeeded != 0 || job.Processed != 2 {
+		t.Errorf("unexpected job: %+v", job)
+	}
+	if stats.Failed != 2 {
+		t.Errorf("stats.Failed = %d, want 2", stats.Failed)
+	}
+	if len(artRepo.updated) != 0 || jobRepo.targets[0].Summary != "old" {
+		t.Error("article updated although resummarization failed")
+	}
+}
+
+func TestService_RunResummarizeJobs_SummarizerError(t *testing.T) {
+	jobRepo := &stubResummarizeRepo{
+		jobs:    []*entity.ResummarizeJob{{ID: 1, Status: entity.ResummarizeQueued}},
+		targets: resummarizeTargets(1),
+	}
+	contentRepo := &stubContentRepo{contents: map[int64]string{10: "a"}}
+	svc := newResummarizeTestService(&stubArticleRepo{}, &stubSummarizer{err: errors.New("api down")}, nil, contentRepo, jobRepo)
+
+	if _, err := svc.RunResummarizeJobs(context.Background(), 10); err != nil {
+		t.Fatalf("RunResummarizeJobs() error = %v", err)
+	}
+	if job := jobRepo.jobs[0]; job.Failed != 1 || job.Status != entity.ResummarizeCompleted {
+		t.Errorf("unexpected job: %+v", job)
+	}
+}
+
+func TestService_RunResummarizeJobs_ListErrorFailsJob(t *testing.T) {
+	jobRepo := &stubResummarizeRepo{
+		jobs:    []*entity.ResummarizeJob{{ID: 1, Status: entity.ResummarizeRunning}},
+		listErr: errors.New("db down"),
+	}
+	svc := newResummarizeTestService(&stubArticleRepo{}, &structuredSummarizer{}, nil, &stubContentRepo{}, jobRepo)
+
+	if _, err := svc.RunResummarizeJobs(context.Background(), 10); err == nil {
+		t.Fatal("expected error")
+	}
+	job := jobRepo.jobs[0]
+	if job.Status != entity.ResummarizeFailed || job.Error != "db down" || job.FinishedAt == nil {
+		t.Errorf("job not failed: %+v", job)
+	}
+}
+
+func TestService_RunResummarizeJobs_NoJobs(t *testing.T) {
+	jobRepo := &stubResummarizeRepo{}
+	svc := newResummarizeTestService(&stubArticleRepo{}, &structuredSummarizer{}, nil, &stubContentRepo{}, jobRepo)
+
+	stats, err := svc.RunResummarizeJobs(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("RunResummarizeJobs() error = %v", err)
+	}
+	if stats.Jobs != 0 || jobRepo.updates != 0 {
+		t.Errorf("stats = %+v, updates = %d, want no work", stats, jobRepo.updates)
+	}
+}
+
+func TestService_CrawlAllSources_StoresContent(t *testing.T) {
+	artRepo := &stubArticleRepo{existsMap: map[string]bool{}}
+	contentRepo := &stubContentRepo{}
+	svc := fetchUC.NewService(
+		&stubSourceRepo{sources: []*entity.Source{{ID: 1, FeedURL: "https://example.com/feed", Active: true}}},
+		artRepo, &stubSummarizer{},
+		&stubFeedFetcher{items: []fetchUC.FeedItem{
+			{Title: "t", URL: "https://example.com/a", Content: "body", PublishedAt: time.Now()},
+		}},
+		nil, nil, &mockNotifyService{}, fetchUC.ContentFetchConfig{Parallelism: 1, Threshold: 1500},
+	)
+	svc.ContentRepo = contentRepo
+
+	if _, err := svc.CrawlAllSources(context.Background()); err != nil {
+		t.Fatalf("CrawlAllSources() error = %v", err)
+	}
+	if len(artRepo.articles) != 1 {
+		t.Fatalf("created articles = %d, want 1", len(artRepo.articles))
+	}
+	if got := contentRepo.contents[artRepo.articles[0].ID]; got != "body" {
+		t.Errorf("stored content = %q, want %q", got, "body")
+	}
+}
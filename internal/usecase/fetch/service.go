@@ -41,6 +41,8 @@ type FeedItem struct {
 	URL         string
 	Content     string
 	PublishedAt time.Time
+	// Categories are the categories the feed assigns to the item (e.g. RSS <category>).
+	Categories []string
 }
 
 // Service provides feed crawling and article fetching use cases.
@@ -54,6 +56,52 @@ type Service struct {
 	ContentFetcher ContentFetcher         // NEW: Content enhancement for B-rated feeds
 	NotifyService  notify.Service
 	contentConfig  ContentFetchConfig // Configuration for content fetching behavior
+
+	// BatchSummarizer enables batch mode when set: new articles are saved as pending
+	// and summarized by one batch request per crawl. Summaries are filled in and
+	// notifications sent by CollectSummaryBatches. SummaryBatchRepo is required with it.
+	BatchSummarizer  BatchSummarizer
+	SummaryBatchRepo repository.SummaryBatchRepository
+
+	// ContentRepo stores the full text each new article was summarized from so
+	// that it can be re-summarized later without fetching it again (optional).
+	ContentRepo repository.ArticleContentRepository
+	// ResummarizeRepo holds the re-summarization jobs run by RunResummarizeJobs.
+	ResummarizeRepo repository.ResummarizeRepository
+	// FailureRepo records the articles discarded because summarization failed,
+	// for the summarize error rate of the source statistics (optional).
+	FailureRepo repository.SummarizeFailureRepository
+
+	// Tagger attaches topic tags to new and re-summarized articles (optional).
+	Tagger Tagger
+	// Indexer computes the embeddings of summarized articles for semantic search (optional).
+	Indexer Indexer
+	// StreamNotifier announces pending articles to the new-article stream once their
+	// summary is stored (optional). Articles summarized before insert are announced
+	// by the repository.
+	StreamNotifier StreamNotifier
+}
+
+// StreamNotifier announces articles to the new-article stream.
+type StreamNotifier interface {
+	// NotifyArticleSummarized announces that the summary of a pending article was stored.
+	NotifyArticleSummarized(ctx context.Context, articleID int64) error
+}
+
+// Tagger attaches topic tags to a saved article.
+type Tagger interface {
+	// TagArticle tags art from its title, summary and structured summary, the full
+	// content it was summarized from (may be empty) and the feed categories (may be nil).
+	TagArticle(ctx context.Context, art *entity.Article, content string, categories []string) error
+}
+
+// Indexer stores the embeddings used by semantic search and related articles.
+type Indexer interface {
+	// IndexArticle computes and stores the embedding of a summarized article.
+	IndexArticle(ctx context.Context, art *entity.Article) error
+	// Backfill embeds up to limit summarized articles that have no embedding yet
+	// and returns the number of articles embedded.
+	Backfill(ctx context.Context, limit int) (int, error)
 }
 
 // Summarizer is an interface for AI-powered text summarization.
@@ -111,7 +159,9 @@ type CrawlStats struct {
 	Inserted       int64
 	Duplicated     int64
 	SummarizeError int64
-	Duration       time.Duration
+	// PendingSummaries is the number of inserted articles submitted for batch summarization.
+	PendingSummaries int64
+	Duration         time.Duration
 }
 
 // CrawlAllSources fetches and processes articles from all active sources.
@@ -120,6 +170,8 @@ type CrawlStats struct {
 // 2. Filters out duplicate articles using batch URL checking
 // 3. Summarizes article content in parallel using AI
 // 4. Stores new articles in the repository
+// In batch mode (BatchSummarizer set), step 3 is replaced by saving the articles as
+// pending and submitting them as one batch after all sources have been crawled.
 // Returns crawl statistics including counts of processed, inserted, and duplicated articles.
 func (s *Service) CrawlAllSources(ctx context.Context) (*CrawlStats, error) {
 	logger := slog.Default()
@@ -132,8 +184,22 @@ func (s *Service) CrawlAllSources(ctx context.Context) (*CrawlStats, error) {
 	}
 	stats.Sources = len(srcs)
 
+	var pending *pendingSummaries
+	if s.BatchSummarizer != nil {
+		if err := s.discardUnsubmitted(ctx); err != nil {
+			return nil, err
+		}
+		pending = &pendingSummaries{}
+	}
+
 	for _, src := range srcs {
-		if err := s.processSingleSource(ctx, src, stats); err != nil {
+		if err := s.processSingleSource(ctx, src, stats, pending); err != nil {
+			return stats, err
+		}
+	}
+
+	if pending != nil {
+		if err := s.submitSummaryBatch(ctx, pending, stats); err != nil {
 			return stats, err
 		}
 	}
@@ -145,6 +211,7 @@ func (s *Service) CrawlAllSources(ctx context.Context) (*CrawlStats, error) {
 		slog.Int64("inserted", stats.Inserted),
 		slog.Int64("duplicated", stats.Duplicated),
 		slog.Int64("summarize_errors", stats.SummarizeError),
+		slog.Int64("pending_summaries", stats.PendingSummaries),
 		slog.Duration("duration", stats.Duration),
 	)
 
@@ -177,9 +244,10 @@ func (s *Service) selectFetcher(src *entity.Source) FeedFetcher {
 
 // processSingleSource processes a single feed source by fetching, deduplicating,
 // summarizing, and storing articles. It updates the provided stats atomically.
+// In batch mode (pending != nil) new articles are collected into pending instead of being summarized.
 // Returns error only for critical failures (summarizer errors, timestamp updates).
 // Logs and continues for recoverable failures (fetch errors, batch check errors).
-func (s *Service) processSingleSource(ctx context.Context, src *entity.Source, stats *CrawlStats) error {
+func (s *Service) processSingleSource(ctx context.Context, src *entity.Source, stats *CrawlStats, pending *pendingSummaries) error {
 	logger := slog.Default()
 	sourceStart := time.Now()
 
@@ -230,7 +298,7 @@ func (s *Service) processSingleSource(ctx context.Context, src *entity.Source, s
 	beforeInserted := atomic.LoadInt64(&stats.Inserted)
 	beforeDuplicated := atomic.LoadInt64(&stats.Duplicated)
 
-	if err := s.processFeedItems(ctx, src, feedItems, existsMap, stats); err != nil {
+	if err := s.processFeedItems(ctx, src, feedItems, existsMap, stats, pending); err != nil {
 		metrics.RecordFeedCrawlError(src.ID, "process_items_failed")
 		return fmt.Errorf("process feed items: %w", err)
 	}
@@ -267,12 +335,16 @@ func (s *Service) processSingleSource(ctx context.Context, src *entity.Source, s
 //   - Context cancellation (context.Canceled, context.DeadlineExceeded): Propagates immediately (aborts crawl)
 //   - Database errors: Propagates (aborts crawl for this source)
 //   - Summarization errors: Logged and counted in stats.SummarizeError, processing continues with other articles
+//
+// In batch mode (pending != nil) new articles are saved as pending after content
+// enhancement and added to pending; they are summarized and notified later.
 func (s *Service) processFeedItems(
 	ctx context.Context,
 	src *entity.Source,
 	feedItems []FeedItem,
 	existsMap map[string]bool,
 	stats *CrawlStats,
+	pending *pendingSummaries,
 ) error {
 	contentSem := make(chan struct{}, s.contentConfig.Parallelism)
 	summarySem := make(chan struct{}, summarizerParallelism)
@@ -295,13 +367,17 @@ func (s *Service) processFeedItems(
 			content := s.enhanceContent(egCtx, item)
 			<-contentSem
 
+			if pending != nil {
+				return s.savePending(egCtx, src, item, content, pending, stats)
+			}
+
 			// Step 2: AI summarization (lower parallelism, rate-limited)
 			summarySem <- struct{}{}
 			defer func() { <-summarySem }()
 
 			// Measure summarization duration
 			summaryStart := time.Now()
-			summary, err := s.Summarizer.Summarize(egCtx, content)
+			result, err := s.summarize(egCtx, src, item, content)
 			summaryDuration := time.Since(summaryStart)
 
 			if err != nil {
@@ -316,6 +392,7 @@ func (s *Service) processFeedItems(
 				atomic.AddInt64(&stats.SummarizeError, 1)
 				metrics.RecordArticleSummarized(false)
 				metrics.RecordSummarizationDuration(summaryDuration)
+				s.recordSummarizeFailure(egCtx, src.ID, item.URL)
 
 				// Log warning and skip this article instead of stopping entire crawl
 				logger := slog.Default()
@@ -332,28 +409,26 @@ func (s *Service) processFeedItems(
 			metrics.RecordSummarizationDuration(summaryDuration)
 
 			art := &entity.Article{
-				SourceID:    src.ID,
-				Title:       item.Title,
-				URL:         item.URL,
-				Summary:     summary,
-				PublishedAt: item.PublishedAt,
-				CreatedAt:   time.Now(),
+				SourceID:       src.ID,
+				Title:          item.Title,
+				URL:            item.URL,
+				Summary:        result.Summary,
+				Structured:     result.Structured,
+				PromptVersion:  result.PromptVersion,
+				SummaryModel:   result.Model,
+				PublishedAt:    item.PublishedAt,
+				CreatedAt:      time.Now(),
+				InjectionFlags: result.InjectionFlags,
 			}
 			if err := s.ArticleRepo.Create(egCtx, art); err != nil {
 				return fmt.Errorf("create article in repository: %w", err)
 			}
 			atomic.AddInt64(&stats.Inserted, 1)
+			s.saveContent(egCtx, art, content)
+			s.tagArticle(egCtx, art, content, item.Categories)
+			s.indexArticle(egCtx, art)
 
-			// Notify about new article (non-blocking)
-			// Note: NotifyService handles goroutines internally, no need for go func() here
-			if err := s.NotifyService.NotifyNewArticle(context.Background(), art, src); err != nil {
-				// NotifyNewArticle returns nil (fire-and-forget), but keeping error check for future
-				slog.Warn("Failed to dispatch notification",
-					slog.Int64("article_id", art.ID),
-					slog.String("url", art.URL),
-					slog.Any("error", err))
-			}
-
+			s.notifyNewArticle(art, src)
 			return nil
 		})
 	}
@@ -365,6 +440,104 @@ func (s *Service) processFeedItems(
 	return nil
 }
 
+// notifyNewArticle dispatches the new-article notification (non-blocking).
+// Note: NotifyService handles goroutines internally, no need for go func() here
+func (s *Service) notifyNewArticle(art *entity.Article, src *entity.Source) {
+	if err := s.NotifyService.NotifyNewArticle(context.Background(), art, src); err != nil {
+		// NotifyNewArticle returns nil (fire-and-forget), but keeping error check for future
+		slog.Warn("Failed to dispatch notification",
+			slog.Int64("article_id", art.ID),
+			slog.String("url", art.URL),
+			slog.Any("error", err))
+	}
+}
+
+// notifyStream announces a summarized pending article to the new-article stream
+// when StreamNotifier is set. Failures are only logged: the stream also polls.
+func (s *Service) notifyStream(ctx context.Context, art *entity.Article) {
+	if s.StreamNotifier == nil {
+		return
+	}
+	if err := s.StreamNotifier.NotifyArticleSummarized(context.WithoutCancel(ctx), art.ID); err != nil {
+		slog.Warn("failed to notify article stream",
+			slog.Int64("article_id", art.ID),
+			slog.Any("error", err))
+	}
+}
+
+// saveContent stores the content an article was summarized from when ContentRepo is set.
+// Failures are only logged: the article itself has already been saved.
+func (s *Service) saveContent(ctx context.Context, art *entity.Article, content string) {
+	if s.ContentRepo == nil || content == "" {
+		return
+	}
+	if err := s.ContentRepo.SaveContent(context.WithoutCancel(ctx), art.ID, content); err != nil {
+		slog.Warn("failed to save article content",
+			slog.Int64("article_id", art.ID),
+			slog.String("url", art.URL),
+			slog.Any("error", err))
+	}
+}
+
+// tagArticle attaches topic tags to art when Tagger is set.
+// Failures are only logged: the article itself has already been saved.
+func (s *Service) tagArticle(ctx context.Context, art *entity.Article, content string, categories []string) {
+	if s.Tagger == nil {
+		return
+	}
+	if err := s.Tagger.TagArticle(context.WithoutCancel(ctx), art, content, categories); err != nil {
+		slog.Warn("failed to tag article",
+			slog.Int64("article_id", art.ID),
+			slog.String("url", art.URL),
+			slog.Any("error", err))
+	}
+}
+
+// indexArticle stores the embedding of art when Indexer is set.
+// Failures are only logged: the article itself has already been saved.
+func (s *Service) indexArticle(ctx context.Context, art *entity.Article) {
+	if s.Indexer == nil {
+		return
+	}
+	if err := s.Indexer.IndexArticle(context.WithoutCancel(ctx), art); err != nil {
+		slog.Warn("failed to index article embedding",
+			slog.Int64("article_id", art.ID),
+			slog.String("url", art.URL),
+			slog.Any("error", err))
+	}
+}
+
+// recordSummarizeFailure records that the article at url was discarded because
+// summarization failed, when FailureRepo is set. Failures are only logged.
+func (s *Service) recordSummarizeFailure(ctx context.Context, sourceID int64, url string) {
+	if s.FailureRepo == nil {
+		return
+	}
+	if err := s.FailureRepo.RecordSummarizeFailure(context.WithoutCancel(ctx), sourceID, url); err != nil {
+		slog.Warn("failed to record summarization failure",
+			slog.Int64("source_id", sourceID),
+			slog.String("url", url),
+			slog.Any("error", err))
+	}
+}
+
+// BackfillEmbeddings embeds up to limit summarized articles that have no embedding
+// yet, so that articles saved before embeddings were enabled (or before the model
+// changed) become searchable. It does nothing when Indexer is not set.
+func (s *Service) BackfillEmbeddings(ctx context.Context, limit int) (int, error) {
+	if s.Indexer == nil {
+		return 0, nil
+	}
+	n, err := s.Indexer.Backfill(ctx, limit)
+	if err != nil {
+		return n, fmt.Errorf("backfill embeddings: %w", err)
+	}
+	if n > 0 {
+		slog.Info("article embeddings backfilled", slog.Int("articles", n))
+	}
+	return n, nil
+}
+
 // enhanceContent enhances RSS content by fetching full article content if needed.
 // This method implements the content enhancement logic:
 //  1. Check if ContentFetcher is enabled (nil check)
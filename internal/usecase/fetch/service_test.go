@@ -16,6 +16,19 @@ import (
 
 /* ───────── モック実装 ───────── */
 
+// stubFailureRepo はSummarizeFailureRepositoryのモック実装
+type stubFailureRepo struct {
+	mu   sync.Mutex
+	urls []string
+}
+
+func (s *stubFailureRepo) RecordSummarizeFailure(_ context.Context, _ int64, url string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.urls = append(s.urls, url)
+	return nil
+}
+
 // mockNotifyService はnotify.Serviceのモック実装
 type mockNotifyService struct {
 	notifyCalled int32
@@ -27,6 +40,14 @@ func (m *mockNotifyService) NotifyNewArticle(ctx context.Context, article *entit
 	return m.notifyError
 }
 
+func (m *mockNotifyService) NotifyDigest(ctx context.Context, digest *entity.Digest) error {
+	return nil
+}
+
+func (m *mockNotifyService) NotifySearchAlert(ctx context.Context, alert *entity.SearchAlert) error {
+	return nil
+}
+
 func (m *mockNotifyService) Shutdown(ctx context.Context) error {
 	return nil
 }
@@ -58,10 +79,16 @@ func (s *stubSourceRepo) TouchCrawledAt(_ context.Context, id int64, t time.Time
 	return nil
 }
 
-// 以下は未使用だが、インターフェース満たすために実装
-func (s *stubSourceRepo) Get(_ context.Context, _ int64) (*entity.Source, error) {
+func (s *stubSourceRepo) Get(_ context.Context, id int64) (*entity.Source, error) {
+	for _, src := range s.sources {
+		if src.ID == id {
+			return src, nil
+		}
+	}
 	return nil, nil
 }
+
+// 以下は未使用だが、インターフェース満たすために実装
 func (s *stubSourceRepo) List(_ context.Context) ([]*entity.Source, error) {
 	return nil, nil
 }
@@ -89,6 +116,8 @@ type stubArticleRepo struct {
 	existsErr error
 	createErr error
 	nextID    int64
+	updated   []int64
+	deleted   []int64
 }
 
 func (s *stubArticleRepo) ExistsByURLBatch(_ context.Context, urls []string) (map[string]bool, error) {
@@ -116,6 +145,20 @@ func (s *stubArticleRepo) Create(_ context.Context, a *entity.Article) error {
 	return nil
 }
 
+func (s *stubArticleRepo) Update(_ context.Context, a *entity.Article) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.updated = append(s.updated, a.ID)
+	return nil
+}
+
+func (s *stubArticleRepo) Delete(_ context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deleted = append(s.deleted, id)
+	return nil
+}
+
 // 以下は未使用だが、インターフェース満たすために実装
 func (s *stubArticleRepo) List(_ context.Context) ([]*entity.Article, error) {
 	return nil, nil
@@ -126,12 +169,6 @@ func (s *stubArticleRepo) Get(_ context.Context, _ int64) (*entity.Article, erro
 func (s *stubArticleRepo) Search(_ context.Context, _ string) ([]*entity.Article, error) {
 	return nil, nil
 }
-func (s *stubArticleRepo) Update(_ context.Context, _ *entity.Article) error {
-	return nil
-}
-func (s *stubArticleRepo) Delete(_ context.Context, _ int64) error {
-	return nil
-}
 func (s *stubArticleRepo) ExistsByURL(_ context.Context, _ string) (bool, error) {
 	return false, nil
 }
@@ -517,6 +554,8 @@ func TestService_CrawlAllSources_SummarizerError(t *testing.T) {
 			Threshold:   1500,
 		},
 	)
+	failureRepo := &stubFailureRepo{}
+	svc.FailureRepo = failureRepo
 
 	// Summarizerエラーでもクロール全体は継続する（エラーを返さない）
 	stats, err := svc.CrawlAllSources(context.Background())
@@ -538,6 +577,11 @@ func TestService_CrawlAllSources_SummarizerError(t *testing.T) {
 	if stats.FeedItems != 1 {
 		t.Errorf("stats.FeedItems = %d, want 1", stats.FeedItems)
 	}
+
+	// 要約エラー率の集計用に失敗が記録されている
+	if len(failureRepo.urls) != 1 || failureRepo.urls[0] != "https://example.com/article1" {
+		t.Errorf("recorded failures = %v, want [https://example.com/article1]", failureRepo.urls)
+	}
 }
 
 func TestService_CrawlAllSources_ExistsByURLBatchError(t *testing.T) {
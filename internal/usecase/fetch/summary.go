@@ -0,0 +1,67 @@
+package fetch
+
+import (
+	"context"
+
+	"catchup-feed/internal/domain/entity"
+)
+
+// SummaryRequest carries an article's content together with the metadata
+// a summarizer may use to build its prompt.
+type SummaryRequest struct {
+	Title      string
+	URL        string
+	SourceName string
+	Content    string
+
+	// Template is the prompt template name selected by the source (empty = default).
+	Template string
+}
+
+// SummaryResult is the output of an ArticleSummarizer.
+// Structured is nil when the summarizer runs in plain-prose mode or
+// the model's structured output could not be parsed.
+// PromptVersion identifies the prompt template that produced the summary;
+// it is empty for summarizers without template support.
+// Model identifies the model that generated the summary (empty if unknown).
+// InjectionFlags lists the prompt-injection detections (entity.InjectionFlag*)
+// of the summarization; it is empty for summarizers without injection checks.
+type SummaryResult struct {
+	Summary        string
+	Structured     *entity.StructuredSummary
+	PromptVersion  string
+	Model          string
+	InjectionFlags []string
+}
+
+// ArticleSummarizer is an optional extension of Summarizer that receives the
+// full article context and may return a structured summary.
+// Summarizers that do not implement it are called through Summarize.
+type ArticleSummarizer interface {
+	SummarizeArticle(ctx context.Context, req SummaryRequest) (*SummaryResult, error)
+}
+
+// summarize generates the summary for a feed item, preferring ArticleSummarizer
+// when the configured summarizer supports it.
+func (s *Service) summarize(ctx context.Context, src *entity.Source, item FeedItem, content string) (*SummaryResult, error) {
+	if as, ok := s.Summarizer.(ArticleSummarizer); ok {
+		return as.SummarizeArticle(ctx, newSummaryRequest(src, item, content))
+	}
+
+	summary, err := s.Summarizer.Summarize(ctx, content)
+	if err != nil {
+		return nil, err
+	}
+	return &SummaryResult{Summary: summary}, nil
+}
+
+// newSummaryRequest builds the summarization request for a feed item of src.
+func newSummaryRequest(src *entity.Source, item FeedItem, content string) SummaryRequest {
+	return SummaryRequest{
+		Title:      item.Title,
+		URL:        item.URL,
+		SourceName: src.Name,
+		Content:    content,
+		Template:   src.PromptTemplate,
+	}
+}
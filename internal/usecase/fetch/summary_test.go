@@ -0,0 +1,105 @@
+package fetch_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	fetchUC "catchup-feed/internal/usecase/fetch"
+)
+
+// structuredSummarizer はArticleSummarizerを実装するモック
+type structuredSummarizer struct {
+	mu       sync.Mutex
+	requests []fetchUC.SummaryRequest
+}
+
+func (s *structuredSummarizer) Summarize(_ context.Context, _ string) (string, error) {
+	return "plain", nil
+}
+
+func (s *structuredSummarizer) SummarizeArticle(_ context.Context, req fetchUC.SummaryRequest) (*fetchUC.SummaryResult, error) {
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	s.mu.Unlock()
+	return &fetchUC.SummaryResult{
+		Summary:       "structured prose",
+		PromptVersion: req.Template + "@0123abcd",
+		Model:         "test-model",
+		Structured: &entity.StructuredSummary{
+			TLDR:               "tldr",
+			KeyPoints:          []string{"a", "b", "c"},
+			Tags:               []string{"go"},
+			ReadingTimeMinutes: 2,
+		},
+	}, nil
+}
+
+func TestService_CrawlAllSources_ArticleSummarizer(t *testing.T) {
+	srcRepo := &stubSourceRepo{
+		sources: []*entity.Source{{ID: 1, Name: "Go Blog", FeedURL: "https://example.com/feed", Active: true,
+			PromptTemplate: "release-notes"}},
+	}
+	artRepo := &stubArticleRepo{existsMap: map[string]bool{}}
+	fetcher := &stubFeedFetcher{items: []fetchUC.FeedItem{
+		{Title: "Go 1.24", URL: "https://example.com/go124", Content: "body", PublishedAt: time.Now()},
+	}}
+	sum := &structuredSummarizer{}
+
+	svc := fetchUC.NewService(srcRepo, artRepo, sum, fetcher, nil, nil, &mockNotifyService{},
+		fetchUC.ContentFetchConfig{Parallelism: 1, Threshold: 1500})
+
+	if _, err := svc.CrawlAllSources(context.Background()); err != nil {
+		t.Fatalf("CrawlAllSources() error = %v", err)
+	}
+
+	if len(sum.requests) != 1 {
+		t.Fatalf("SummarizeArticle calls = %d, want 1", len(sum.requests))
+	}
+	req := sum.requests[0]
+	if req.Title != "Go 1.24" || req.SourceName != "Go Blog" || req.Content != "body" || req.Template != "release-notes" {
+		t.Errorf("unexpected request: %+v", req)
+	}
+
+	if len(artRepo.articles) != 1 {
+		t.Fatalf("created articles = %d, want 1", len(artRepo.articles))
+	}
+	art := artRepo.articles[0]
+	if art.Summary != "structured prose" {
+		t.Errorf("Summary = %q, want %q", art.Summary, "structured prose")
+	}
+	if art.Structured == nil || art.Structured.TLDR != "tldr" {
+		t.Errorf("Structured = %+v, want TLDR=tldr", art.Structured)
+	}
+	if art.PromptVersion != "release-notes@0123abcd" {
+		t.Errorf("PromptVersion = %q, want %q", art.PromptVersion, "release-notes@0123abcd")
+	}
+	if art.SummaryModel != "test-model" {
+		t.Errorf("SummaryModel = %q, want %q", art.SummaryModel, "test-model")
+	}
+}
+
+func TestService_CrawlAllSources_PlainSummarizerHasNoStructured(t *testing.T) {
+	srcRepo := &stubSourceRepo{
+		sources: []*entity.Source{{ID: 1, FeedURL: "https://example.com/feed", Active: true}},
+	}
+	artRepo := &stubArticleRepo{existsMap: map[string]bool{}}
+	fetcher := &stubFeedFetcher{items: []fetchUC.FeedItem{
+		{Title: "t", URL: "https://example.com/a", Content: "c", PublishedAt: time.Now()},
+	}}
+
+	svc := fetchUC.NewService(srcRepo, artRepo, &stubSummarizer{result: "plain"}, fetcher, nil, nil,
+		&mockNotifyService{}, fetchUC.ContentFetchConfig{Parallelism: 1, Threshold: 1500})
+
+	if _, err := svc.CrawlAllSources(context.Background()); err != nil {
+		t.Fatalf("CrawlAllSources() error = %v", err)
+	}
+	if len(artRepo.articles) != 1 {
+		t.Fatalf("created articles = %d, want 1", len(artRepo.articles))
+	}
+	if artRepo.articles[0].Structured != nil {
+		t.Errorf("Structured = %+v, want nil", artRepo.articles[0].Structured)
+	}
+}
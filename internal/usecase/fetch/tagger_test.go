@@ -0,0 +1,132 @@
+package fetch_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	fetchUC "catchup-feed/internal/usecase/fetch"
+)
+
+/* ───────── タグ付けのモック ───────── */
+
+// taggedCall はTagArticleの呼び出し内容
+type taggedCall struct {
+	articleID  int64
+	summary    string
+	content    string
+	categories []string
+}
+
+// stubTagger はTaggerのモック実装
+type stubTagger struct {
+	mu    sync.Mutex
+	calls []taggedCall
+	err   error
+}
+
+func (s *stubTagger) TagArticle(_ context.Context, art *entity.Article, content string, categories []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, taggedCall{
+		articleID: art.ID, summary: art.Summary, content: content, categories: categories,
+	})
+	return s.err
+}
+
+/* ───────── テストケース ───────── */
+
+func TestService_CrawlAllSources_TagsNewArticles(t *testing.T) {
+	for _, tagErr := range []error{nil, errors.New("db down")} {
+		artRepo := &stubArticleRepo{existsMap: map[string]bool{}}
+		tagger := &stubTagger{err: tagErr}
+		svc := fetchUC.NewService(
+			&stubSourceRepo{sources: []*entity.Source{{ID: 1, FeedURL: "https://example.com/feed", Active: true}}},
+			artRepo, &stubSummarizer{},
+			&stubFeedFetcher{items: []fetchUC.FeedItem{
+				{Title: "t", URL: "https://example.com/a", Content: "body", PublishedAt: time.Now(),
+					Categories: []string{"Go", "Release"}},
+			}},
+			nil, nil, &mockNotifyService{}, fetchUC.ContentFetchConfig{Parallelism: 1, Threshold: 1500},
+		)
+		svc.Tagger = tagger
+
+		stats, err := svc.CrawlAllSources(context.Background())
+		if err != nil {
+			t.Fatalf("CrawlAllSources() error = %v (tagger error must not fail the crawl)", err)
+		}
+		if stats.Inserted != 1 {
+			t.Fatalf("Inserted = %d, want 1", stats.Inserted)
+		}
+		if len(tagger.calls) != 1 {
+			t.Fatalf("TagArticle calls = %d, want 1", len(tagger.calls))
+		}
+		call := tagger.calls[0]
+		if call.articleID != artRepo.articles[0].ID || call.content != "body" || call.summary == "" {
+			t.Errorf("unexpected tag call: %+v", call)
+		}
+		if len(call.categories) != 2 || call.categories[0] != "Go" {
+			t.Errorf("categories = %v, want feed categories", call.categories)
+		}
+	}
+}
+
+func TestService_BatchMode_TagsAtSaveAndCompletion(t *testing.T) {
+	artRepo := &stubArticleRepo{existsMap: map[string]bool{}}
+	tagger := &stubTagger{}
+	svc := newBatchTestService(artRepo, &countingSummarizer{}, &mockNotifyService{},
+		&stubBatchSummarizer{}, &stubSummaryBatchRepo{})
+	svc.Tagger = tagger
+
+	if _, err := svc.CrawlAllSources(context.Background()); err != nil {
+		t.Fatalf("CrawlAllSources() error = %v", err)
+	}
+	if len(tagger.calls) != 2 {
+		t.Fatalf("TagArticle calls after crawl = %d, want 2", len(tagger.calls))
+	}
+	for _, c := range tagger.calls {
+		if c.content == "" || c.summary != "" {
+			t.Errorf("pending article must be tagged from its content only: %+v", c)
+		}
+	}
+
+	pending := []*entity.Article{{ID: 1, SourceID: 1, URL: "https://example.com/1",
+		SummaryStatus: entity.SummaryStatusPending, SummaryBatchID: "msgbatch_1"}}
+	tagger.calls = nil
+	svc = newBatchTestService(&stubArticleRepo{}, &countingSummarizer{}, &mockNotifyService{},
+		&stubBatchSummarizer{done: true, results: map[string]fetchUC.BatchSummaryResult{
+			"article-1": {Result: &fetchUC.SummaryResult{Summary: "要約1"}},
+		}}, &stubSummaryBatchRepo{pending: pending})
+	svc.Tagger = tagger
+
+	if _, err := svc.CollectSummaryBatches(context.Background()); err != nil {
+		t.Fatalf("CollectSummaryBatches() error = %v", err)
+	}
+	if len(tagger.calls) != 1 || tagger.calls[0].articleID != 1 || tagger.calls[0].summary != "要約1" {
+		t.Errorf("completed article must be tagged from its summary: %+v", tagger.calls)
+	}
+}
+
+func TestService_RunResummarizeJobs_RetagsArticles(t *testing.T) {
+	tagger := &stubTagger{}
+	jobRepo := &stubResummarizeRepo{
+		jobs:    []*entity.ResummarizeJob{{ID: 1, Status: entity.ResummarizeQueued}},
+		targets: resummarizeTargets(1),
+	}
+	svc := newResummarizeTestService(&stubArticleRepo{}, &structuredSummarizer{}, nil,
+		&stubContentRepo{contents: map[int64]string{10: "stored body"}}, jobRepo)
+	svc.Tagger = tagger
+
+	if _, err := svc.RunResummarizeJobs(context.Background(), 10); err != nil {
+		t.Fatalf("RunResummarizeJobs() error = %v", err)
+	}
+	if len(tagger.calls) != 1 {
+		t.Fatalf("TagArticle calls = %d, want 1", len(tagger.calls))
+	}
+	if c := tagger.calls[0]; c.articleID != 10 || c.content != "stored body" || c.summary != "structured prose" {
+		t.Errorf("unexpected tag call: %+v", c)
+	}
+}
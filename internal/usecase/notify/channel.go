@@ -63,3 +63,18 @@ type Channel interface {
 	//     - Network/API errors: Wrapped with context
 	Send(ctx context.Context, article *entity.Article, source *entity.Source) error
 }
+
+// DigestChannel is implemented by channels that can deliver digests in addition
+// to per-article notifications. Service.NotifyDigest skips channels that do not
+// implement it.
+type DigestChannel interface {
+	// SendDigest sends a digest of new articles to this channel, following the
+	// same rate limiting, retry and context contract as Send.
+	//
+	// Returns:
+	//   - error: Non-nil if notification failed after all retries
+	//     - ErrChannelDisabled: If SendDigest() called on disabled channel
+	//     - ErrInvalidDigest: If digest is nil
+	//     - Network/API errors: Wrapped with context
+	SendDigest(ctx context.Context, digest *entity.Digest) error
+}
@@ -0,0 +1,253 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/infra/notifier"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockDigestChannel is a mockChannel that can also deliver digests.
+type mockDigestChannel struct {
+	mockChannel
+	digestError  error
+	digestCalled int
+	capturedCtx  context.Context
+	digestMu     sync.Mutex
+}
+
+func (m *mockDigestChannel) SendDigest(ctx context.Context, digest *entity.Digest) error {
+	m.digestMu.Lock()
+	defer m.digestMu.Unlock()
+	m.digestCalled++
+	m.capturedCtx = ctx
+	return m.digestError
+}
+
+// mockDigestNotifier is a notifier.Notifier that also implements notifier.DigestNotifier.
+type mockDigestNotifier struct {
+	mockSlackNotifier
+	digestCalled    int
+	capturedDigest  *entity.Digest
+	digestReturnErr error
+}
+
+func (m *mockDigestNotifier) NotifyDigest(ctx context.Context, digest *entity.Digest) error {
+	m.digestCalled++
+	m.capturedDigest = digest
+	return m.digestReturnErr
+}
+
+func TestNotifyDigest_DeliversToDigestChannels(t *testing.T) {
+	discord := &mockDigestChannel{mockChannel: mockChannel{name: "discord", enabled: true}}
+	slack := &mockDigestChannel{mockChannel: mockChannel{name: "slack", enabled: false}}
+	plain := &mockChannel{name: "email", enabled: true}
+	svc := NewService([]Channel{discord, slack, plain}, 10)
+
+	err := svc.NotifyDigest(context.Background(), &entity.Digest{ID: 1})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, discord.digestCalled)
+	assert.Equal(t, 0, slack.digestCalled, "disabled channel must be skipped")
+	assert.Equal(t, 0, plain.getSendCalledCount(), "article notification must not be sent")
+
+	requestID, _ := discord.capturedCtx.Value(requestIDKey).(string)
+	assert.NotEmpty(t, requestID)
+	_, hasDeadline := discord.capturedCtx.Deadline()
+	assert.True(t, hasDeadline, "each channel must get a timeout")
+}
+
+func TestNotifyDigest_JoinsChannelErrors(t *testing.T) {
+	sendErr := errors.New("webhook failed")
+	discord := &mockDigestChannel{mockChannel: mockChannel{name: "discord", enabled: true}, digestError: sendErr}
+	slack := &mockDigestChannel{mockChannel: mockChannel{name: "slack", enabled: true}}
+	svc := NewService([]Channel{discord, slack}, 10)
+
+	err := svc.NotifyDigest(context.Background(), &entity.Digest{ID: 1})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, sendErr)
+	assert.Contains(t, err.Error(), "discord")
+	assert.Equal(t, 1, slack.digestCalled, "a failing channel must not stop the others")
+}
+
+func TestNotifyDigest_NilDigest(t *testing.T) {
+	discord := &mockDigestChannel{mockChannel: mockChannel{name: "discord", enabled: true}}
+	svc := NewService([]Channel{discord}, 10)
+
+	err := svc.NotifyDigest(context.Background(), nil)
+
+	assert.ErrorIs(t, err, ErrInvalidDigest)
+	assert.Equal(t, 0, discord.digestCalled)
+}
+
+func TestNotifyDigest_CircuitBreaker(t *testing.T) {
+	discord := &mockDigestChannel{
+		mockChannel: mockChannel{name: "discord", enabled: true},
+		digestError: errors.New("webhook failed"),
+	}
+	svc := NewService([]Channel{discord}, 10)
+
+	for i := 0; i < circuitBreakerThreshold; i++ {
+		_ = svc.NotifyDigest(context.Background(), &entity.Digest{ID: int64(i + 1)})
+	}
+	require.Equal(t, circuitBreakerThreshold, discord.digestCalled)
+
+	health := svc.GetChannelHealth()
+	require.Len(t, health, 1)
+	assert.True(t, health[0].CircuitBreakerOpen)
+
+	err := svc.NotifyDigest(context.Background(), &entity.Digest{ID: 99})
+
+	assert.ErrorIs(t, err, ErrCircuitBreakerOpen)
+	assert.Equal(t, circuitBreakerThreshold, discord.digestCalled, "open circuit must skip the channel")
+}
+
+func TestNotifyDigest_SuccessResetsFailures(t *testing.T) {
+	discord := &mockDigestChannel{
+		mockChannel: mockChannel{name: "discord", enabled: true},
+		digestError: errors.New("webhook failed"),
+	}
+	svc := NewService([]Channel{discord}, 10)
+
+	for i := 0; i < circuitBreakerThreshold-1; i++ {
+		_ = svc.NotifyDigest(context.Background(), &entity.Digest{ID: 1})
+	}
+	discord.digestError = nil
+	require.NoError(t, svc.NotifyDigest(context.Background(), &entity.Digest{ID: 1}))
+
+	discord.digestError = errors.New("webhook failed")
+	_ = svc.NotifyDigest(context.Background(), &entity.Digest{ID: 1})
+
+	assert.False(t, svc.GetChannelHealth()[0].CircuitBreakerOpen)
+}
+
+func TestSlackChannel_SendDigest(t *testing.T) {
+	digest := &entity.Digest{ID: 1, Title: "デイリーダイジェスト"}
+
+	t.Run("delegates to notifier", func(t *testing.T) {
+		n := &mockDigestNotifier{}
+		ch := &SlackChannel{notifier: n, enabled: true}
+
+		require.NoError(t, ch.SendDigest(context.Background(), digest))
+		assert.Equal(t, 1, n.digestCalled)
+		assert.Same(t, digest, n.capturedDigest)
+	})
+
+	t.Run("disabled channel", func(t *testing.T) {
+		ch := &SlackChannel{notifier: &mockDigestNotifier{}, enabled: false}
+		assert.ErrorIs(t, ch.SendDigest(context.Background(), digest), ErrChannelDisabled)
+	})
+
+	t.Run("nil digest", func(t *testing.T) {
+		ch := &SlackChannel{notifier: &mockDigestNotifier{}, enabled: true}
+		assert.ErrorIs(t, ch.SendDigest(context.Background(), nil), ErrInvalidDigest)
+	})
+
+	t.Run("notifier without digest support", func(t *testing.T) {
+		ch := newTestSlackChannel(true, &mockSlackNotifier{})
+		assert.Error(t, ch.SendDigest(context.Background(), digest))
+	})
+}
+
+func TestDiscordChannel_SendDigest(t *testing.T) {
+	digest := &entity.Digest{ID: 1, Title: "デイリーダイジェスト"}
+
+	t.Run("delegates to notifier", func(t *testing.T) {
+		n := &mockDigestNotifier{digestReturnErr: errors.New("boom")}
+		ch := &DiscordChannel{notifier: n, enabled: true}
+
+		assert.EqualError(t, ch.SendDigest(context.Background(), digest), "boom")
+		assert.Equal(t, 1, n.digestCalled)
+	})
+
+	t.Run("disabled channel", func(t *testing.T) {
+		ch := &DiscordChannel{notifier: &mockDigestNotifier{}, enabled: false}
+		assert.ErrorIs(t, ch.SendDigest(context.Background(), digest), ErrChannelDisabled)
+	})
+
+	t.Run("nil digest", func(t *testing.T) {
+		ch := &DiscordChannel{notifier: &mockDigestNotifier{}, enabled: true}
+		assert.ErrorIs(t, ch.SendDigest(context.Background(), nil), ErrInvalidDigest)
+	})
+}
+
+func TestNewChannels_SupportDigests(t *testing.T) {
+	var _ DigestChannel = NewSlackChannel(notifier.SlackConfig{})
+	var _ DigestChannel = NewDiscordChannel(notifier.DiscordConfig{})
+
+	// 無効なチャネルは NoOp 通知を使うが、SendDigest は送信せず無効エラーを返す
+	err := NewSlackChannel(notifier.SlackConfig{Enabled: false}).SendDigest(context.Background(), &entity.Digest{})
+	assert.ErrorIs(t, err, ErrChannelDisabled)
+}
+
+func TestNotifySearchAlert_ChannelSelection(t *testing.T) {
+	alert := &entity.SearchAlert{
+		SearchID:   1,
+		SearchName: "Go",
+		Articles:   []entity.DigestArticle{{ID: 3, Title: "Go 1.25", SourceName: "Go Blog"}},
+	}
+
+	tests := []struct {
+		name        string
+		channel     string
+		wantDiscord int
+		wantSlack   int
+		wantErr     error
+	}{
+		{name: "all channels", channel: entity.AlertChannelAll, wantDiscord: 1, wantSlack: 1},
+		{name: "slack only", channel: entity.AlertChannelSlack, wantSlack: 1},
+		{name: "disabled channel", channel: entity.AlertChannelDiscord, wantErr: ErrNoAlertChannel},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enabledDiscord := tt.channel != entity.AlertChannelDiscord
+			discord := &mockDigestChannel{mockChannel: mockChannel{name: "discord", enabled: enabledDiscord}}
+			slack := &mockDigestChannel{mockChannel: mockChannel{name: "slack", enabled: true}}
+			svc := NewService([]Channel{discord, slack}, 10)
+
+			a := *alert
+			a.Channel = tt.channel
+			err := svc.NotifySearchAlert(context.Background(), &a)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantDiscord, discord.digestCalled)
+			assert.Equal(t, tt.wantSlack, slack.digestCalled)
+		})
+	}
+}
+
+func TestNotifySearchAlert_SendsAlertAsDigest(t *testing.T) {
+	n := &mockDigestNotifier{}
+	svc := NewService([]Channel{&SlackChannel{notifier: n, enabled: true}}, 10)
+
+	err := svc.NotifySearchAlert(context.Background(), &entity.SearchAlert{
+		SearchID:   1,
+		SearchName: "Go",
+		Articles:   []entity.DigestArticle{{ID: 3, Title: "Go 1.25", SourceName: "Go Blog"}},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, n.capturedDigest)
+	assert.Equal(t, "保存した検索「Go」の新着記事", n.capturedDigest.Title)
+	assert.Equal(t, 1, n.capturedDigest.ArticleCount)
+}
+
+func TestNotifySearchAlert_Errors(t *testing.T) {
+	sendErr := errors.New("webhook failed")
+	slack := &mockDigestChannel{mockChannel: mockChannel{name: "slack", enabled: true}, digestError: sendErr}
+	svc := NewService([]Channel{slack}, 10)
+
+	assert.ErrorIs(t, svc.NotifySearchAlert(context.Background(), nil), ErrInvalidSearchAlert)
+	assert.ErrorIs(t, svc.NotifySearchAlert(context.Background(), &entity.SearchAlert{SearchID: 1}), sendErr)
+}
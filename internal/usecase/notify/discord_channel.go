@@ -2,6 +2,7 @@ package notify
 
 import (
 	"context"
+	"fmt"
 
 	"catchup-feed/internal/domain/entity"
 	"catchup-feed/internal/infra/notifier"
@@ -95,3 +96,26 @@ func (c *DiscordChannel) Send(ctx context.Context, article *entity.Article, sour
 	// Delegate to underlying notifier
 	return c.notifier.NotifyArticle(ctx, article, source)
 }
+
+// SendDigest sends a digest to Discord as a single message.
+// This method implements the DigestChannel interface.
+//
+// Returns:
+//   - nil: Digest sent successfully
+//   - ErrChannelDisabled: If called on disabled channel
+//   - ErrInvalidDigest: If digest is nil
+//   - Other errors: Network errors, rate limit errors, Discord API errors
+func (c *DiscordChannel) SendDigest(ctx context.Context, digest *entity.Digest) error {
+	if !c.enabled {
+		return ErrChannelDisabled
+	}
+	if digest == nil {
+		return ErrInvalidDigest
+	}
+
+	dn, ok := c.notifier.(notifier.DigestNotifier)
+	if !ok {
+		return fmt.Errorf("discord notifier does not support digests")
+	}
+	return dn.NotifyDigest(ctx, digest)
+}
@@ -22,6 +22,16 @@ var (
 	//   - source.Name is empty
 	ErrInvalidSource = errors.New("invalid source data")
 
+	// ErrInvalidDigest indicates that the digest passed to SendDigest() is nil.
+	ErrInvalidDigest = errors.New("invalid digest data")
+
+	// ErrInvalidSearchAlert indicates that the alert passed to NotifySearchAlert() is nil.
+	ErrInvalidSearchAlert = errors.New("invalid search alert data")
+
+	// ErrNoAlertChannel indicates that the channel requested by a search alert is
+	// not configured, is disabled or cannot deliver digests.
+	ErrNoAlertChannel = errors.New("alert channel is not available")
+
 	// ErrNotificationDropped indicates that a notification was dropped due to
 	// goroutine pool saturation or timeout waiting for a worker slot.
 	// This is a non-critical error used for observability.
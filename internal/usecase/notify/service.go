@@ -2,6 +2,8 @@ package notify
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"runtime/debug"
 	"sync"
@@ -45,6 +47,34 @@ type Service interface {
 	//   - nil (always succeeds, errors are handled internally)
 	NotifyNewArticle(ctx context.Context, article *entity.Article, source *entity.Source) error
 
+	// NotifyDigest delivers a digest to all enabled channels that implement DigestChannel.
+	//
+	// Unlike NotifyNewArticle, this method blocks until every channel has been tried,
+	// because digests are sent by scheduled jobs rather than the crawl loop. Channels
+	// with an open circuit breaker are skipped, and failures count towards it.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation; each channel gets its own 30s timeout
+	//   - digest: The digest to deliver (must not be nil)
+	//
+	// Returns:
+	//   - error: Joined errors of the channels that failed, or nil
+	NotifyDigest(ctx context.Context, digest *entity.Digest) error
+
+	// NotifySearchAlert delivers the new matches of a saved search in the digest
+	// message format, with the same blocking and circuit breaker behavior as
+	// NotifyDigest.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation; each channel gets its own 30s timeout
+	//   - alert: The alert to deliver (must not be nil). When alert.Channel is set,
+	//     only that channel is used.
+	//
+	// Returns:
+	//   - error: Joined errors of the channels that failed, ErrNoAlertChannel when
+	//     no channel can deliver the alert, or nil
+	NotifySearchAlert(ctx context.Context, alert *entity.SearchAlert) error
+
 	// GetChannelHealth returns the health status of all notification channels.
 	//
 	// This method provides visibility into circuit breaker states for monitoring
@@ -234,21 +264,7 @@ func (s *service) notifyChannel(requestID string, channel Channel, article *enti
 	duration := time.Since(startTime)
 
 	// Update circuit breaker state
-	health.mu.Lock()
-	if err != nil {
-		health.consecutiveFailures++
-		if health.consecutiveFailures >= circuitBreakerThreshold {
-			health.disabledUntil = time.Now().Add(circuitBreakerTimeout)
-			slog.Error("Circuit breaker opened for channel",
-				slog.String("request_id", requestID),
-				slog.String("channel", channel.Name()),
-				slog.Int("consecutive_failures", health.consecutiveFailures))
-			RecordCircuitBreakerOpen(channel.Name())
-		}
-	} else {
-		health.consecutiveFailures = 0 // Reset on success
-	}
-	health.mu.Unlock()
+	health.recordResult(requestID, channel.Name(), err)
 
 	// Record metrics and log result
 	if err != nil {
@@ -271,6 +287,112 @@ func (s *service) notifyChannel(requestID string, channel Channel, article *enti
 	}
 }
 
+// recordResult updates the circuit breaker state after a send attempt, opening it
+// after circuitBreakerThreshold consecutive failures.
+func (h *channelHealth) recordResult(requestID, channelName string, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if err != nil {
+		h.consecutiveFailures++
+		if h.consecutiveFailures >= circuitBreakerThreshold {
+			h.disabledUntil = time.Now().Add(circuitBreakerTimeout)
+			slog.Error("Circuit breaker opened for channel",
+				slog.String("request_id", requestID),
+				slog.String("channel", channelName),
+				slog.Int("consecutive_failures", h.consecutiveFailures))
+			RecordCircuitBreakerOpen(channelName)
+		}
+	} else {
+		h.consecutiveFailures = 0 // Reset on success
+	}
+}
+
+// NotifyDigest implements Service.NotifyDigest.
+func (s *service) NotifyDigest(ctx context.Context, digest *entity.Digest) error {
+	if digest == nil {
+		return ErrInvalidDigest
+	}
+	_, err := s.sendDigest(ctx, digest, "", slog.Int64("digest_id", digest.ID))
+	return err
+}
+
+// NotifySearchAlert implements Service.NotifySearchAlert.
+func (s *service) NotifySearchAlert(ctx context.Context, alert *entity.SearchAlert) error {
+	if alert == nil {
+		return ErrInvalidSearchAlert
+	}
+	tried, err := s.sendDigest(ctx, alert.Digest(time.Now()), alert.Channel, slog.Int64("search_id", alert.SearchID))
+	if tried == 0 {
+		return ErrNoAlertChannel
+	}
+	return err
+}
+
+// sendDigest delivers digest to the enabled DigestChannels, or only to the one
+// named only when it is not empty. It returns the number of channels tried
+// (including those skipped by an open circuit breaker) and their joined errors.
+// attr identifies the digest in the logs.
+func (s *service) sendDigest(ctx context.Context, digest *entity.Digest, only string, attr slog.Attr) (int, error) {
+	requestID, ok := ctx.Value("request_id").(string)
+	if !ok || requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	var tried int
+	var errs []error
+	for _, ch := range s.channels {
+		dc, ok := ch.(DigestChannel)
+		if !ok || !ch.IsEnabled() || (only != "" && ch.Name() != only) {
+			continue
+		}
+		tried++
+
+		health := s.getChannelHealth(ch.Name())
+		health.mu.Lock()
+		disabledUntil := health.disabledUntil
+		health.mu.Unlock()
+		if time.Now().Before(disabledUntil) {
+			slog.Warn("Digest skipped: channel temporarily disabled due to circuit breaker",
+				slog.String("request_id", requestID),
+				slog.String("channel", ch.Name()),
+				slog.Time("disabled_until", disabledUntil))
+			RecordDropped(ch.Name(), "circuit_open")
+			errs = append(errs, fmt.Errorf("%s: %w", ch.Name(), ErrCircuitBreakerOpen))
+			continue
+		}
+
+		sendCtx, cancel := context.WithTimeout(ctx, notificationTimeout)
+		sendCtx = context.WithValue(sendCtx, requestIDKey, requestID)
+
+		startTime := time.Now()
+		RecordDispatch(ch.Name())
+		err := dc.SendDigest(sendCtx, digest)
+		duration := time.Since(startTime)
+		cancel()
+
+		health.recordResult(requestID, ch.Name(), err)
+
+		if err != nil {
+			RecordFailure(ch.Name(), duration)
+			slog.Warn("Channel digest notification failed",
+				slog.String("request_id", requestID),
+				slog.String("channel", ch.Name()),
+				attr,
+				slog.Duration("send_duration", duration),
+				slog.Any("error", err))
+			errs = append(errs, fmt.Errorf("%s: %w", ch.Name(), err))
+			continue
+		}
+		RecordSuccess(ch.Name(), duration)
+		slog.Info("Channel digest notification sent successfully",
+			slog.String("request_id", requestID),
+			slog.String("channel", ch.Name()),
+			attr,
+			slog.Duration("send_duration", duration))
+	}
+	return tried, errors.Join(errs...)
+}
+
 // getChannelHealth returns circuit breaker state for a channel
 func (s *service) getChannelHealth(channelName string) *channelHealth {
 	s.healthMu.RLock()
@@ -0,0 +1,14 @@
+// Package opml provides the OPML import and export of sources. Source categories
+// map to the folders of the OPML document: outlines that group feed outlines.
+package opml
+
+import "errors"
+
+// Sentinel errors for OPML use case operations.
+var (
+	// ErrNoFeeds indicates that the imported document has no feed outlines.
+	ErrNoFeeds = errors.New("invalid OPML document: no feed outlines (xmlUrl)")
+
+	// ErrTooManyFeeds indicates that the imported document has more than MaxFeeds feeds.
+	ErrTooManyFeeds = errors.New("invalid OPML document: must not contain more than 1000 feeds")
+)
@@ -0,0 +1,298 @@
+package opml
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	catUC "catchup-feed/internal/usecase/category"
+)
+
+// MaxFeeds is the maximum number of feeds of one imported document.
+const MaxFeeds = 1000
+
+// Import item statuses.
+const (
+	// StatusCreated means that a source was created for the feed.
+	StatusCreated = "created"
+	// StatusExisting means that a source with the feed URL already exists.
+	// Its name and settings are kept; only the categories of the feed are added.
+	StatusExisting = "existing"
+	// StatusInvalid means that the feed URL is invalid.
+	StatusInvalid = "invalid"
+	// StatusFailed means that the source could not be created, e.g. because a
+	// source with the feed URL is in the trash.
+	StatusFailed = "failed"
+)
+
+// Service provides the OPML import and export use cases.
+type Service struct {
+	SourceRepo   repository.SourceRepository
+	CategoryRepo repository.CategoryRepository
+}
+
+// Feed is a feed outline of an OPML document.
+type Feed struct {
+	// Title is the name of the source to create. The feed URL is used if empty.
+	Title   string
+	FeedURL string
+	// Categories are the names of the folders the feed is listed in.
+	Categories []string
+}
+
+// Folder is a category with its sources.
+type Folder struct {
+	Category *entity.Category
+	Sources  []*entity.Source
+}
+
+// Subscriptions are the sources grouped by category for export.
+type Subscriptions struct {
+	// Folders are the categories with at least one source, ordered by name.
+	// A source in several categories is listed in each of them.
+	Folders []Folder
+	// Unfiled are the sources without a category.
+	Unfiled []*entity.Source
+}
+
+// ImportItem is the outcome of the import of one feed.
+type ImportItem struct {
+	FeedURL string
+	Status  string
+	// Categories are the categories the source was added to.
+	Categories []string
+	// Error explains StatusInvalid and StatusFailed, and categories that were skipped.
+	Error string
+}
+
+// ImportResult is the outcome of an import, with one item per feed URL in
+// document order (a feed listed in several folders is reported once).
+type ImportResult struct {
+	Items             []ImportItem
+	Created           int
+	Existing          int
+	Failed            int
+	CategoriesCreated int
+}
+
+// Export returns all sources grouped by category.
+func (s *Service) Export(ctx context.Context) (*Subscriptions, error) {
+	sources, err := s.SourceRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list sources: %w", err)
+	}
+	categories, err := s.CategoryRepo.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list categories: %w", err)
+	}
+
+	subs := &Subscriptions{}
+	filed := make(map[int64]bool)
+	for _, c := range categories {
+		if c.SourceCount == 0 {
+			continue
+		}
+		id := c.Category.ID
+		members, err := s.SourceRepo.SearchWithFilters(ctx, nil, repository.SourceSearchFilters{CategoryID: &id})
+		if err != nil {
+			return nil, fmt.Errorf("list sources of category: %w", err)
+		}
+		if len(members) == 0 {
+			continue
+		}
+		for _, src := range members {
+			filed[src.ID] = true
+		}
+		subs.Folders = append(subs.Folders, Folder{Category: c.Category, Sources: members})
+	}
+	for _, src := range sources {
+		if !filed[src.ID] {
+			subs.Unfiled = append(subs.Unfiled, src)
+		}
+	}
+	return subs, nil
+}
+
+// Import creates a source for each feed whose URL is not a source yet and adds
+// the sources to the categories of their folders, creating missing categories.
+// Existing sources keep their name, settings and categories.
+// Returns ErrNoFeeds or ErrTooManyFeeds if the document has no or more than
+// MaxFeeds feeds. Invalid feeds are reported in the result and skipped.
+func (s *Service) Import(ctx context.Context, feeds []Feed) (*ImportResult, error) {
+	feeds = mergeFeeds(feeds)
+	if len(feeds) == 0 {
+		return nil, ErrNoFeeds
+	}
+	if len(feeds) > MaxFeeds {
+		return nil, ErrTooManyFeeds
+	}
+
+	sourceIDs, err := s.sourceIDsByURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ImportResult{Items: make([]ImportItem, len(feeds))}
+	created := false
+	for i, f := range feeds {
+		item := &result.Items[i]
+		item.FeedURL = f.FeedURL
+		if _, ok := sourceIDs[f.FeedURL]; ok {
+			item.Status = StatusExisting
+			result.Existing++
+			continue
+		}
+		if err := entity.ValidateURL(f.FeedURL); err != nil {
+			item.Status, item.Error = StatusInvalid, err.Error()
+			result.Failed++
+			continue
+		}
+		name := f.Title
+		if name == "" {
+			name = f.FeedURL
+		}
+		src := &entity.Source{Name: name, FeedURL: f.FeedURL, Active: true}
+		if err := s.SourceRepo.Create(ctx, src); err != nil {
+			// ゴミ箱にある同じ URL のソースとの衝突など。他のフィードの取り込みは続ける
+			item.Status, item.Error = StatusFailed, "source could not be created (a source with this feed URL may be in the trash)"
+			result.Failed++
+			continue
+		}
+		item.Status = StatusCreated
+		result.Created++
+		created = true
+	}
+
+	// 作成したソースの ID を引き直してから、フォルダのカテゴリに追加する
+	if created {
+		if sourceIDs, err = s.sourceIDsByURL(ctx); err != nil {
+			return nil, err
+		}
+	}
+	categoryIDs := make(map[string]int64)
+	for i, f := range feeds {
+		item := &result.Items[i]
+		sourceID, ok := sourceIDs[f.FeedURL]
+		if !ok || len(f.Categories) == 0 {
+			continue
+		}
+		if err := s.fileSource(ctx, sourceID, f.Categories, categoryIDs, item, result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// fileSource adds a source to the named categories, creating missing ones.
+// categoryIDs caches the IDs of the categories resolved so far.
+func (s *Service) fileSource(ctx context.Context, sourceID int64, names []string,
+	categoryIDs map[string]int64, item *ImportItem, result *ImportResult) error {
+	current, err := s.CategoryRepo.ListSourceCategories(ctx, sourceID)
+	if err != nil {
+		return fmt.Errorf("list source categories: %w", err)
+	}
+	ids := make([]int64, 0, len(current)+len(names))
+	assigned := make(map[int64]bool, len(current))
+	for _, c := range current {
+		ids = append(ids, c.ID)
+		assigned[c.ID] = true
+	}
+
+	var skipped []string
+	for _, name := range names {
+		id, ok := categoryIDs[name]
+		if !ok {
+			c, err := s.CategoryRepo.GetCategoryByName(ctx, name)
+			if err != nil {
+				return fmt.Errorf("get category by name: %w", err)
+			}
+			if c == nil {
+				c = &entity.Category{Name: name}
+				if err := c.Validate(); err != nil {
+					skipped = append(skipped, fmt.Sprintf("category %q: %s", name, err.Error()))
+					continue
+				}
+				if err := s.CategoryRepo.CreateCategory(ctx, c); err != nil {
+					return fmt.Errorf("create category: %w", err)
+				}
+				result.CategoriesCreated++
+			}
+			id = c.ID
+			categoryIDs[name] = id
+		}
+		if !assigned[id] {
+			if len(ids) >= catUC.MaxCategoriesPerSource {
+				skipped = append(skipped, fmt.Sprintf("category %q: source has %d categories already", name, catUC.MaxCategoriesPerSource))
+				continue
+			}
+			ids = append(ids, id)
+			assigned[id] = true
+		}
+		item.Categories = append(item.Categories, name)
+	}
+	if len(skipped) > 0 {
+		item.Error = strings.Join(skipped, "; ")
+	}
+
+	if len(ids) == len(current) {
+		return nil
+	}
+	if err := s.CategoryRepo.SetSourceCategories(ctx, sourceID, ids); err != nil {
+		return fmt.Errorf("set source categories: %w", err)
+	}
+	return nil
+}
+
+// sourceIDsByURL returns the IDs of all sources by feed URL.
+func (s *Service) sourceIDsByURL(ctx context.Context) (map[string]int64, error) {
+	sources, err := s.SourceRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list sources: %w", err)
+	}
+	ids := make(map[string]int64, len(sources))
+	for _, src := range sources {
+		ids[src.FeedURL] = src.ID
+	}
+	return ids, nil
+}
+
+// mergeFeeds trims the feeds, drops the feeds without URL and merges the feeds with the same URL, in the
+// order of their first occurrence. The title of the first occurrence is kept and
+// the category names are normalized, deduplicated and sorted.
+func mergeFeeds(feeds []Feed) []Feed {
+	var out []Feed
+	index := make(map[string]int, len(feeds))
+	for _, f := range feeds {
+		u := strings.TrimSpace(f.FeedURL)
+		if u == "" {
+			continue
+		}
+		i, ok := index[u]
+		if !ok {
+			i = len(out)
+			index[u] = i
+			out = append(out, Feed{Title: strings.TrimSpace(f.Title), FeedURL: u})
+		}
+		for _, name := range f.Categories {
+			if name = entity.NormalizeCategoryName(name); name != "" && !contains(out[i].Categories, name) {
+				out[i].Categories = append(out[i].Categories, name)
+			}
+		}
+	}
+	for i := range out {
+		sort.Strings(out[i].Categories)
+	}
+	return out
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
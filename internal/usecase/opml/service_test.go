@@ -0,0 +1,283 @@
+package opml_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	catUC "catchup-feed/internal/usecase/category"
+	"catchup-feed/internal/usecase/opml"
+)
+
+/* ───────── モック ───────── */
+
+// stubStore holds the sources and categories shared by the stub repositories.
+type stubStore struct {
+	sources    []*entity.Source
+	categories []*entity.Category
+	members    map[int64][]int64 // source ID -> category IDs
+	createErr  map[string]error  // feed URL -> Create error
+	setCalls   int
+}
+
+func newStubStore() *stubStore {
+	return &stubStore{members: map[int64][]int64{}, createErr: map[string]error{}}
+}
+
+func (s *stubStore) addSource(name, feedURL string, categoryIDs ...int64) *entity.Source {
+	src := &entity.Source{ID: int64(len(s.sources) + 1), Name: name, FeedURL: feedURL, Active: true}
+	s.sources = append(s.sources, src)
+	if len(categoryIDs) > 0 {
+		s.members[src.ID] = categoryIDs
+	}
+	return src
+}
+
+func (s *stubStore) addCategory(name string) *entity.Category {
+	c := &entity.Category{ID: int64(len(s.categories) + 1), Name: name}
+	s.categories = append(s.categories, c)
+	return c
+}
+
+func (s *stubStore) category(name string) *entity.Category {
+	for _, c := range s.categories {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func (s *stubStore) source(feedURL string) *entity.Source {
+	for _, src := range s.sources {
+		if src.FeedURL == feedURL {
+			return src
+		}
+	}
+	return nil
+}
+
+type stubSourceRepo struct {
+	repository.SourceRepository
+	store *stubStore
+}
+
+func (r stubSourceRepo) List(context.Context) ([]*entity.Source, error) {
+	return r.store.sources, nil
+}
+
+func (r stubSourceRepo) SearchWithFilters(_ context.Context, _ []string, f repository.SourceSearchFilters) ([]*entity.Source, error) {
+	var out []*entity.Source
+	for _, src := range r.store.sources {
+		for _, id := range r.store.members[src.ID] {
+			if f.CategoryID != nil && id == *f.CategoryID {
+				out = append(out, src)
+			}
+		}
+	}
+	return out, nil
+}
+
+// Create は postgres と同じく ID を設定しない
+func (r stubSourceRepo) Create(_ context.Context, src *entity.Source) error {
+	if err := r.store.createErr[src.FeedURL]; err != nil {
+		return err
+	}
+	cp := *src
+	cp.ID = int64(len(r.store.sources) + 1)
+	r.store.sources = append(r.store.sources, &cp)
+	return nil
+}
+
+type stubCategoryRepo struct {
+	repository.CategoryRepository
+	store *stubStore
+}
+
+func (r stubCategoryRepo) ListCategories(context.Context) ([]repository.CategoryCount, error) {
+	var out []repository.CategoryCount
+	for _, c := range r.store.categories {
+		var n int64
+		for _, ids := range r.store.members {
+			for _, id := range ids {
+				if id == c.ID {
+					n++
+				}
+			}
+		}
+		out = append(out, repository.CategoryCount{Category: c, SourceCount: n})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Category.Name < out[j].Category.Name })
+	return out, nil
+}
+
+func (r stubCategoryRepo) GetCategoryByName(_ context.Context, name string) (*entity.Category, error) {
+	return r.store.category(name), nil
+}
+
+func (r stubCategoryRepo) CreateCategory(_ context.Context, c *entity.Category) error {
+	c.ID = int64(len(r.store.categories) + 1)
+	r.store.categories = append(r.store.categories, c)
+	return nil
+}
+
+func (r stubCategoryRepo) ListSourceCategories(_ context.Context, sourceID int64) ([]*entity.Category, error) {
+	var out []*entity.Category
+	for _, id := range r.store.members[sourceID] {
+		out = append(out, r.store.categories[id-1])
+	}
+	return out, nil
+}
+
+func (r stubCategoryRepo) SetSourceCategories(_ context.Context, sourceID int64, ids []int64) error {
+	r.store.setCalls++
+	r.store.members[sourceID] = ids
+	return nil
+}
+
+func newService(store *stubStore) *opml.Service {
+	return &opml.Service{SourceRepo: stubSourceRepo{store: store}, CategoryRepo: stubCategoryRepo{store: store}}
+}
+
+func categoryNames(store *stubStore, sourceID int64) []string {
+	var names []string
+	for _, id := range store.members[sourceID] {
+		names = append(names, store.categories[id-1].Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/* ───────── テスト ───────── */
+
+func TestService_Export(t *testing.T) {
+	store := newStubStore()
+	goCat := store.addCategory("Go")
+	secCat := store.addCategory("Security")
+	store.addCategory("Empty")
+	store.addSource("Go Blog", "https://go.dev/blog/feed.atom", goCat.ID, secCat.ID)
+	store.addSource("Krebs", "https://krebsonsecurity.com/feed/", secCat.ID)
+	store.addSource("HN", "https://news.ycombinator.com/rss")
+
+	subs, err := newService(store).Export(context.Background())
+	if err != nil {
+		t.Fatalf("Export err=%v", err)
+	}
+
+	if len(subs.Folders) != 2 {
+		t.Fatalf("folders = %d, want 2 (empty categories are left out)", len(subs.Folders))
+	}
+	if subs.Folders[0].Category.Name != "Go" || len(subs.Folders[0].Sources) != 1 {
+		t.Errorf("folder[0] = %s with %d sources, want Go with 1", subs.Folders[0].Category.Name, len(subs.Folders[0].Sources))
+	}
+	if subs.Folders[1].Category.Name != "Security" || len(subs.Folders[1].Sources) != 2 {
+		t.Errorf("folder[1] = %s with %d sources, want Security with 2", subs.Folders[1].Category.Name, len(subs.Folders[1].Sources))
+	}
+	if len(subs.Unfiled) != 1 || subs.Unfiled[0].Name != "HN" {
+		t.Errorf("unfiled = %+v, want only HN", subs.Unfiled)
+	}
+}
+
+func TestService_Import(t *testing.T) {
+	store := newStubStore()
+	goCat := store.addCategory("Go")
+	existing := store.addSource("Go Blog (custom)", "https://go.dev/blog/feed.atom", goCat.ID)
+	store.createErr["https://trashed.example.com/feed"] = errors.New("duplicate key")
+
+	feeds := []opml.Feed{
+		{Title: "The Go Blog", FeedURL: "https://go.dev/blog/feed.atom", Categories: []string{"Go", " Programming "}},
+		{Title: "Krebs", FeedURL: " https://krebsonsecurity.com/feed/ ", Categories: []string{"Security"}},
+		{Title: "", FeedURL: "https://news.ycombinator.com/rss"},
+		{Title: "Bad", FeedURL: "ftp://example.com/feed"},
+		{Title: "Trashed", FeedURL: "https://trashed.example.com/feed", Categories: []string{"Go"}},
+		// 別フォルダに同じフィード
+		{Title: "Krebs again", FeedURL: "https://krebsonsecurity.com/feed/", Categories: []string{"News"}},
+	}
+	result, err := newService(store).Import(context.Background(), feeds)
+	if err != nil {
+		t.Fatalf("Import err=%v", err)
+	}
+
+	if result.Created != 2 || result.Existing != 1 || result.Failed != 2 || result.CategoriesCreated != 3 {
+		t.Errorf("counts = created %d existing %d failed %d categories %d, want 2 1 2 3",
+			result.Created, result.Existing, result.Failed, result.CategoriesCreated)
+	}
+	if len(result.Items) != 5 {
+		t.Fatalf("items = %d, want 5 (one per feed URL)", len(result.Items))
+	}
+	wantStatus := []string{opml.StatusExisting, opml.StatusCreated, opml.StatusCreated, opml.StatusInvalid, opml.StatusFailed}
+	for i, want := range wantStatus {
+		if result.Items[i].Status != want {
+			t.Errorf("item[%d] (%s) status = %s, want %s", i, result.Items[i].FeedURL, result.Items[i].Status, want)
+		}
+	}
+	if got := result.Items[1].Categories; strings.Join(got, ",") != "News,Security" {
+		t.Errorf("merged categories = %v, want [News Security]", got)
+	}
+
+	// 既存ソースは名前を変えずにカテゴリだけ追加する
+	if existing.Name != "Go Blog (custom)" {
+		t.Errorf("existing source renamed to %q", existing.Name)
+	}
+	if got := categoryNames(store, existing.ID); strings.Join(got, ",") != "Go,Programming" {
+		t.Errorf("existing source categories = %v, want [Go Programming]", got)
+	}
+	krebs := store.source("https://krebsonsecurity.com/feed/")
+	if krebs == nil || krebs.Name != "Krebs" {
+		t.Fatalf("created source = %+v, want Krebs", krebs)
+	}
+	if got := categoryNames(store, krebs.ID); strings.Join(got, ",") != "News,Security" {
+		t.Errorf("created source categories = %v, want [News Security]", got)
+	}
+	if hn := store.source("https://news.ycombinator.com/rss"); hn == nil || hn.Name != "https://news.ycombinator.com/rss" {
+		t.Errorf("untitled source = %+v, want the feed URL as name", hn)
+	}
+	// カテゴリのないフィードでは SetSourceCategories を呼ばない
+	if store.setCalls != 2 {
+		t.Errorf("SetSourceCategories calls = %d, want 2", store.setCalls)
+	}
+}
+
+func TestService_Import_CategoryLimit(t *testing.T) {
+	store := newStubStore()
+	var ids []int64
+	for i := range catUC.MaxCategoriesPerSource {
+		ids = append(ids, store.addCategory(string(rune('A'+i))).ID)
+	}
+	src := store.addSource("Full", "https://full.example.com/feed", ids...)
+
+	result, err := newService(store).Import(context.Background(), []opml.Feed{
+		{FeedURL: src.FeedURL, Categories: []string{"A", "New"}},
+	})
+	if err != nil {
+		t.Fatalf("Import err=%v", err)
+	}
+	item := result.Items[0]
+	if strings.Join(item.Categories, ",") != "A" || !strings.Contains(item.Error, `"New"`) {
+		t.Errorf("item = %+v, want A kept and New reported as skipped", item)
+	}
+	if store.setCalls != 0 {
+		t.Errorf("SetSourceCategories calls = %d, want 0 (nothing added)", store.setCalls)
+	}
+}
+
+func TestService_Import_Errors(t *testing.T) {
+	svc := newService(newStubStore())
+
+	if _, err := svc.Import(context.Background(), []opml.Feed{{FeedURL: "  "}}); !errors.Is(err, opml.ErrNoFeeds) {
+		t.Errorf("empty err = %v, want ErrNoFeeds", err)
+	}
+
+	feeds := make([]opml.Feed, opml.MaxFeeds+1)
+	for i := range feeds {
+		feeds[i].FeedURL = "https://example.com/feed/" + strconv.Itoa(i)
+	}
+	if _, err := svc.Import(context.Background(), feeds); !errors.Is(err, opml.ErrTooManyFeeds) {
+		t.Errorf("too many err = %v, want ErrTooManyFeeds", err)
+	}
+}
@@ -0,0 +1,23 @@
+// Package readstate provides use cases for per-user read markers: marking articles
+// read or unread, marking many articles read at once, and the "catch up" view of
+// the unread articles grouped by source.
+package readstate
+
+import "errors"
+
+// Sentinel errors for read state use case operations.
+var (
+	// ErrUserRequired indicates that the request is not associated with a user.
+	// Read state is stored per JWT subject, so anonymous requests cannot have any.
+	ErrUserRequired = errors.New("read state requires an authenticated user")
+
+	// ErrInvalidArticleID indicates that the provided article ID is invalid.
+	// Article IDs must be positive integers.
+	ErrInvalidArticleID = errors.New("invalid article ID")
+
+	// ErrArticleNotFound indicates that the article to mark read does not exist.
+	ErrArticleNotFound = errors.New("article not found")
+
+	// ErrInvalidSourceID indicates that the source to mark read has an invalid ID.
+	ErrInvalidSourceID = errors.New("invalid source ID")
+)
@@ -0,0 +1,127 @@
+package readstate
+
+import (
+	"context"
+	"fmt"
+
+	"catchup-feed/internal/repository"
+)
+
+// Number of articles per source in the catch-up view.
+const (
+	// DefaultCatchupPerSource is the default number of unread articles shown per source.
+	DefaultCatchupPerSource = 5
+	// MaxCatchupPerSource is the upper bound of the number of articles per source.
+	MaxCatchupPerSource = 20
+)
+
+// Service provides the read state use cases. Users are identified by the JWT subject.
+type Service struct {
+	Repo repository.ReadStateRepository
+}
+
+// SourceCatchup is the unread state of one source.
+type SourceCatchup struct {
+	SourceID    int64
+	SourceName  string
+	UnreadCount int64
+	// Articles are the newest unread articles of the source, newest first.
+	Articles []repository.ArticleWithSource
+}
+
+// Catchup is the unread state of a user: the sources with unread articles, the
+// source with the most unread articles first.
+type Catchup struct {
+	TotalUnread int64
+	Sources     []SourceCatchup
+}
+
+// MarkRead marks an article read for userID.
+// Returns ErrArticleNotFound if the article does not exist.
+func (s *Service) MarkRead(ctx context.Context, userID string, articleID int64) error {
+	if userID == "" {
+		return ErrUserRequired
+	}
+	if articleID <= 0 {
+		return ErrInvalidArticleID
+	}
+	found, err := s.Repo.MarkRead(ctx, userID, articleID)
+	if err != nil {
+		return fmt.Errorf("mark article read: %w", err)
+	}
+	if !found {
+		return ErrArticleNotFound
+	}
+	return nil
+}
+
+// MarkUnread removes the read marker of an article for userID.
+// Marking an unread (or missing) article unread is not an error.
+func (s *Service) MarkUnread(ctx context.Context, userID string, articleID int64) error {
+	if userID == "" {
+		return ErrUserRequired
+	}
+	if articleID <= 0 {
+		return ErrInvalidArticleID
+	}
+	if err := s.Repo.MarkUnread(ctx, userID, articleID); err != nil {
+		return fmt.Errorf("mark article unread: %w", err)
+	}
+	return nil
+}
+
+// MarkAllRead marks all articles selected by filter read for userID: those published
+// up to filter.Before and/or those of filter.SourceID, or every article when the
+// filter is empty. Returns the number of articles that were unread.
+func (s *Service) MarkAllRead(ctx context.Context, userID string, filter repository.MarkReadFilter) (int64, error) {
+	if userID == "" {
+		return 0, ErrUserRequired
+	}
+	if filter.SourceID != nil && *filter.SourceID <= 0 {
+		return 0, ErrInvalidSourceID
+	}
+	n, err := s.Repo.MarkAllRead(ctx, userID, filter)
+	if err != nil {
+		return 0, fmt.Errorf("mark articles read: %w", err)
+	}
+	return n, nil
+}
+
+// Catchup returns the unread articles of userID grouped by source, with up to
+// perSource articles per source (DefaultCatchupPerSource when perSource <= 0,
+// at most MaxCatchupPerSource).
+func (s *Service) Catchup(ctx context.Context, userID string, perSource int) (*Catchup, error) {
+	if userID == "" {
+		return nil, ErrUserRequired
+	}
+	if perSource <= 0 {
+		perSource = DefaultCatchupPerSource
+	}
+	perSource = min(perSource, MaxCatchupPerSource)
+
+	counts, err := s.Repo.UnreadCountsBySource(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("count unread articles: %w", err)
+	}
+	articles, err := s.Repo.ListUnreadPerSource(ctx, userID, perSource)
+	if err != nil {
+		return nil, fmt.Errorf("list unread articles: %w", err)
+	}
+
+	bySource := make(map[int64][]repository.ArticleWithSource, len(counts))
+	for _, a := range articles {
+		bySource[a.Article.SourceID] = append(bySource[a.Article.SourceID], a)
+	}
+
+	result := &Catchup{Sources: make([]SourceCatchup, 0, len(counts))}
+	for _, c := range counts {
+		result.TotalUnread += c.Count
+		result.Sources = append(result.Sources, SourceCatchup{
+			SourceID:    c.SourceID,
+			SourceName:  c.SourceName,
+			UnreadCount: c.Count,
+			Articles:    bySource[c.SourceID],
+		})
+	}
+	return result, nil
+}
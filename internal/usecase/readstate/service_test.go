@@ -0,0 +1,201 @@
+package readstate_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	"catchup-feed/internal/usecase/readstate"
+)
+
+/* ───────── モック ───────── */
+
+type stubReadStateRepo struct {
+	found      bool
+	marked     int64
+	counts     []repository.SourceUnreadCount
+	articles   []repository.ArticleWithSource
+	err        error
+	gotUser    string
+	gotArticle int64
+	gotFilter  repository.MarkReadFilter
+	gotPerSrc  int
+}
+
+func (s *stubReadStateRepo) MarkRead(_ context.Context, userID string, articleID int64) (bool, error) {
+	s.gotUser, s.gotArticle = userID, articleID
+	return s.found, s.err
+}
+func (s *stubReadStateRepo) MarkUnread(_ context.Context, userID string, articleID int64) error {
+	s.gotUser, s.gotArticle = userID, articleID
+	return s.err
+}
+func (s *stubReadStateRepo) MarkAllRead(_ context.Context, userID string, filter repository.MarkReadFilter) (int64, error) {
+	s.gotUser, s.gotFilter = userID, filter
+	return s.marked, s.err
+}
+func (s *stubReadStateRepo) ListUnread(_ context.Context, _ string, _ repository.ArticleSearchFilters, _, _ int) ([]repository.ArticleWithSource, error) {
+	return nil, nil // テストでは未使用
+}
+func (s *stubReadStateRepo) CountUnread(_ context.Context, _ string, _ repository.ArticleSearchFilters) (int64, error) {
+	return 0, nil // テストでは未使用
+}
+func (s *stubReadStateRepo) UnreadCountsBySource(_ context.Context, userID string) ([]repository.SourceUnreadCount, error) {
+	s.gotUser = userID
+	return s.counts, s.err
+}
+func (s *stubReadStateRepo) ListUnreadPerSource(_ context.Context, _ string, perSource int) ([]repository.ArticleWithSource, error) {
+	s.gotPerSrc = perSource
+	return s.articles, s.err
+}
+
+func unreadArticle(id, sourceID int64, sourceName string) repository.ArticleWithSource {
+	return repository.ArticleWithSource{
+		Article:    &entity.Article{ID: id, SourceID: sourceID, Title: "Article", PublishedAt: time.Unix(id, 0)},
+		SourceName: sourceName,
+	}
+}
+
+/* ───────── テスト ───────── */
+
+func TestService_MarkRead(t *testing.T) {
+	dbErr := errors.New("db down")
+	tests := []struct {
+		name    string
+		user    string
+		id      int64
+		repo    *stubReadStateRepo
+		wantErr error
+	}{
+		{name: "marked", user: "alice", id: 7, repo: &stubReadStateRepo{found: true}},
+		{name: "article not found", user: "alice", id: 7, repo: &stubReadStateRepo{}, wantErr: readstate.ErrArticleNotFound},
+		{name: "no user", user: "", id: 7, repo: &stubReadStateRepo{found: true}, wantErr: readstate.ErrUserRequired},
+		{name: "invalid id", user: "alice", id: 0, repo: &stubReadStateRepo{found: true}, wantErr: readstate.ErrInvalidArticleID},
+		{name: "repository error", user: "alice", id: 7, repo: &stubReadStateRepo{err: dbErr}, wantErr: dbErr},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := readstate.Service{Repo: tt.repo}
+			err := svc.MarkRead(context.Background(), tt.user, tt.id)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MarkRead err=%v, want %v", err, tt.wantErr)
+			}
+			if err == nil && (tt.repo.gotUser != tt.user || tt.repo.gotArticle != tt.id) {
+				t.Errorf("repo called with (%q, %d)", tt.repo.gotUser, tt.repo.gotArticle)
+			}
+		})
+	}
+}
+
+func TestService_MarkUnread(t *testing.T) {
+	repo := &stubReadStateRepo{}
+	svc := readstate.Service{Repo: repo}
+
+	if err := svc.MarkUnread(context.Background(), "alice", 7); err != nil {
+		t.Fatalf("MarkUnread err=%v", err)
+	}
+	if repo.gotUser != "alice" || repo.gotArticle != 7 {
+		t.Errorf("repo called with (%q, %d)", repo.gotUser, repo.gotArticle)
+	}
+	if err := svc.MarkUnread(context.Background(), "", 7); !errors.Is(err, readstate.ErrUserRequired) {
+		t.Errorf("MarkUnread without user err=%v", err)
+	}
+	if err := svc.MarkUnread(context.Background(), "alice", -1); !errors.Is(err, readstate.ErrInvalidArticleID) {
+		t.Errorf("MarkUnread with invalid id err=%v", err)
+	}
+}
+
+func TestService_MarkAllRead(t *testing.T) {
+	before := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	sourceID := int64(3)
+	repo := &stubReadStateRepo{marked: 24}
+	svc := readstate.Service{Repo: repo}
+
+	n, err := svc.MarkAllRead(context.Background(), "alice", repository.MarkReadFilter{Before: &before, SourceID: &sourceID})
+	if err != nil {
+		t.Fatalf("MarkAllRead err=%v", err)
+	}
+	if n != 24 {
+		t.Errorf("MarkAllRead = %d, want 24", n)
+	}
+	if repo.gotFilter.Before == nil || !repo.gotFilter.Before.Equal(before) || *repo.gotFilter.SourceID != sourceID {
+		t.Errorf("filter = %+v", repo.gotFilter)
+	}
+
+	invalid := int64(0)
+	if _, err := svc.MarkAllRead(context.Background(), "alice", repository.MarkReadFilter{SourceID: &invalid}); !errors.Is(err, readstate.ErrInvalidSourceID) {
+		t.Errorf("MarkAllRead with invalid source err=%v", err)
+	}
+	if _, err := svc.MarkAllRead(context.Background(), "", repository.MarkReadFilter{}); !errors.Is(err, readstate.ErrUserRequired) {
+		t.Errorf("MarkAllRead without user err=%v", err)
+	}
+}
+
+func TestService_Catchup(t *testing.T) {
+	repo := &stubReadStateRepo{
+		counts: []repository.SourceUnreadCount{
+			{SourceID: 2, SourceName: "Go Blog", Count: 12},
+			{SourceID: 1, SourceName: "Zenn", Count: 4},
+		},
+		articles: []repository.ArticleWithSource{
+			unreadArticle(10, 1, "Zenn"),
+			unreadArticle(30, 2, "Go Blog"),
+			unreadArticle(20, 2, "Go Blog"),
+		},
+	}
+	svc := readstate.Service{Repo: repo}
+
+	got, err := svc.Catchup(context.Background(), "alice", 0)
+	if err != nil {
+		t.Fatalf("Catchup err=%v", err)
+	}
+	if repo.gotPerSrc != readstate.DefaultCatchupPerSource {
+		t.Errorf("perSource = %d, want default %d", repo.gotPerSrc, readstate.DefaultCatchupPerSource)
+	}
+
+	want := &readstate.Catchup{
+		TotalUnread: 16,
+		Sources: []readstate.SourceCatchup{
+			{SourceID: 2, SourceName: "Go Blog", UnreadCount: 12, Articles: []repository.ArticleWithSource{
+				unreadArticle(30, 2, "Go Blog"), unreadArticle(20, 2, "Go Blog"),
+			}},
+			{SourceID: 1, SourceName: "Zenn", UnreadCount: 4, Articles: []repository.ArticleWithSource{
+				unreadArticle(10, 1, "Zenn"),
+			}},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Catchup mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestService_Catchup_PerSourceLimit(t *testing.T) {
+	repo := &stubReadStateRepo{}
+	svc := readstate.Service{Repo: repo}
+
+	got, err := svc.Catchup(context.Background(), "alice", 1000)
+	if err != nil {
+		t.Fatalf("Catchup err=%v", err)
+	}
+	if repo.gotPerSrc != readstate.MaxCatchupPerSource {
+		t.Errorf("perSource = %d, want max %d", repo.gotPerSrc, readstate.MaxCatchupPerSource)
+	}
+	if got.TotalUnread != 0 || got.Sources == nil || len(got.Sources) != 0 {
+		t.Errorf("Catchup = %+v, want empty", got)
+	}
+}
+
+func TestService_Catchup_Errors(t *testing.T) {
+	svc := readstate.Service{Repo: &stubReadStateRepo{err: errors.New("db down")}}
+	if _, err := svc.Catchup(context.Background(), "alice", 5); err == nil {
+		t.Error("Catchup expected error")
+	}
+	if _, err := svc.Catchup(context.Background(), "", 5); !errors.Is(err, readstate.ErrUserRequired) {
+		t.Errorf("Catchup without user err=%v", err)
+	}
+}
@@ -0,0 +1,20 @@
+// Package savedsearch provides use cases for the saved article searches of each
+// user: running them on demand and alerting their owners about new matching
+// articles after each crawl.
+package savedsearch
+
+import "errors"
+
+// Sentinel errors for saved search use case operations.
+var (
+	// ErrUserRequired indicates that the request is not associated with a user.
+	// Saved searches are stored per JWT subject.
+	ErrUserRequired = errors.New("authenticated user required for saved searches")
+
+	// ErrInvalidSearchID indicates that the provided saved search ID is invalid.
+	// Saved search IDs must be positive integers.
+	ErrInvalidSearchID = errors.New("invalid saved search ID")
+
+	// ErrSearchNotFound indicates that the saved search does not exist or is owned by another user.
+	ErrSearchNotFound = errors.New("saved search not found")
+)
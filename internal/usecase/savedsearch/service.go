@@ -0,0 +1,349 @@
+package savedsearch
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/pkg/search"
+	"catchup-feed/internal/repository"
+)
+
+// ArticleSearcher runs article searches with pagination.
+// repository.ArticleRepository satisfies it.
+type ArticleSearcher interface {
+	CountArticlesWithFilters(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters) (int64, error)
+	SearchWithFiltersPaginated(ctx context.Context, keywords []string, filters repository.ArticleSearchFilters, offset, limit int) ([]repository.ArticleWithSource, error)
+}
+
+// Notifier delivers search alerts to the notification channels.
+type Notifier interface {
+	NotifySearchAlert(ctx context.Context, alert *entity.SearchAlert) error
+}
+
+// Service provides the saved search use cases. Users are identified by the JWT
+// subject and only see their own searches. Notifier is optional: without it new
+// matches are not delivered, but subscribed searches still advance past them.
+type Service struct {
+	Repo     repository.SavedSearchRepository
+	Articles ArticleSearcher
+	Notifier Notifier
+}
+
+// Input is the name, criteria and alert settings of a saved search.
+type Input struct {
+	Name    string
+	Keyword string
+	// SourceID, From, To and Tags filter the results like the /articles/search parameters.
+	SourceID   *int64
+	From       *time.Time
+	To         *time.Time
+	Tags       []string
+	Subscribed bool
+	Channel    string
+}
+
+// RunResult is a page of the articles matching a saved search.
+type RunResult struct {
+	Search     *entity.SavedSearch
+	Data       []repository.ArticleWithSource
+	Pagination pagination.Metadata
+}
+
+// AlertStats summarizes one evaluation of the subscribed searches.
+type AlertStats struct {
+	// Evaluated is the number of subscribed searches checked against new articles.
+	Evaluated int
+	// Alerted is the number of alerts delivered.
+	Alerted int
+	// Failed is the number of searches that could not be evaluated or delivered.
+	Failed int
+}
+
+// List returns the saved searches of userID in creation order.
+func (s *Service) List(ctx context.Context, userID string) ([]*entity.SavedSearch, error) {
+	if userID == "" {
+		return nil, ErrUserRequired
+	}
+	searches, err := s.Repo.ListSearches(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list saved searches: %w", err)
+	}
+	return searches, nil
+}
+
+// Get returns the saved search with the given ID owned by userID.
+func (s *Service) Get(ctx context.Context, userID string, id int64) (*entity.SavedSearch, error) {
+	if err := validateUserSearch(userID, id); err != nil {
+		return nil, err
+	}
+	return s.get(ctx, userID, id)
+}
+
+func (s *Service) get(ctx context.Context, userID string, id int64) (*entity.SavedSearch, error) {
+	saved, err := s.Repo.GetSearch(ctx, userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("get saved search: %w", err)
+	}
+	if saved == nil {
+		return nil, ErrSearchNotFound
+	}
+	return saved, nil
+}
+
+// Create saves a search for userID. Alerts of a subscribed search only cover the
+// articles fetched after it was saved.
+// Returns a ValidationError if the name or criteria are invalid.
+func (s *Service) Create(ctx context.Context, userID string, in Input) (*entity.SavedSearch, error) {
+	if userID == "" {
+		return nil, ErrUserRequired
+	}
+	saved := &entity.SavedSearch{UserID: userID}
+	if err := applyInput(saved, in); err != nil {
+		return nil, err
+	}
+	latest, err := s.Repo.LatestArticleID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get latest article: %w", err)
+	}
+	saved.LastArticleID = latest
+	if err := s.Repo.CreateSearch(ctx, saved); err != nil {
+		return nil, fmt.Errorf("create saved search: %w", err)
+	}
+	return saved, nil
+}
+
+// Update replaces the name, criteria and alert settings of a saved search of
+// userID. Subscribing again starts the alerts from the current articles instead
+// of the ones fetched while the search was unsubscribed.
+// Returns a ValidationError if the name or criteria are invalid.
+func (s *Service) Update(ctx context.Context, userID string, id int64, in Input) (*entity.SavedSearch, error) {
+	if err := validateUserSearch(userID, id); err != nil {
+		return nil, err
+	}
+	saved, err := s.get(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	wasSubscribed := saved.Subscribed
+	if err := applyInput(saved, in); err != nil {
+		return nil, err
+	}
+	if saved.Subscribed && !wasSubscribed {
+		latest, err := s.Repo.LatestArticleID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get latest article: %w", err)
+		}
+		saved.LastArticleID = latest
+	}
+	found, err := s.Repo.UpdateSearch(ctx, saved)
+	if err != nil {
+		return nil, fmt.Errorf("update saved search: %w", err)
+	}
+	if !found {
+		return nil, ErrSearchNotFound
+	}
+	return saved, nil
+}
+
+// Delete removes a saved search of userID.
+func (s *Service) Delete(ctx context.Context, userID string, id int64) error {
+	if err := validateUserSearch(userID, id); err != nil {
+		return err
+	}
+	deleted, err := s.Repo.DeleteSearch(ctx, userID, id)
+	if err != nil {
+		return fmt.Errorf("delete saved search: %w", err)
+	}
+	if !deleted {
+		return ErrSearchNotFound
+	}
+	return nil
+}
+
+// Run returns a page of the articles matching a saved search of userID, newest
+// first, as /articles/search would return them.
+func (s *Service) Run(ctx context.Context, userID string, id int64, params pagination.Params) (*RunResult, error) {
+	if err := validateUserSearch(userID, id); err != nil {
+		return nil, err
+	}
+	saved, err := s.get(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	keywords, filters, err := criteria(saved)
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.Articles.CountArticlesWithFilters(ctx, keywords, filters)
+	if err != nil {
+		return nil, fmt.Errorf("count saved search results: %w", err)
+	}
+	articles, err := s.Articles.SearchWithFiltersPaginated(ctx, keywords, filters,
+		pagination.CalculateOffset(params.Page, params.Limit), params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("run saved search: %w", err)
+	}
+	return &RunResult{
+		Search: saved,
+		Data:   articles,
+		Pagination: pagination.Metadata{
+			Total:      total,
+			Page:       params.Page,
+			Limit:      params.Limit,
+			TotalPages: pagination.CalculateTotalPages(total, params.Limit),
+		},
+	}, nil
+}
+
+// EvaluateAlerts checks every subscribed search against the articles fetched
+// since it was last evaluated and sends one alert per search with new matches
+// (at most entity.MaxSearchAlertArticles articles, newest first).
+//
+// A search advances past the new articles even when its alert cannot be
+// delivered, so that a failing channel does not repeat the same alert after every
+// crawl. Failures of individual searches are logged and counted in the stats.
+func (s *Service) EvaluateAlerts(ctx context.Context, now time.Time) (AlertStats, error) {
+	var stats AlertStats
+	searches, err := s.Repo.ListSubscribed(ctx)
+	if err != nil {
+		return stats, fmt.Errorf("list subscribed searches: %w", err)
+	}
+	if len(searches) == 0 {
+		return stats, nil
+	}
+	latest, err := s.Repo.LatestArticleID(ctx)
+	if err != nil {
+		return stats, fmt.Errorf("get latest article: %w", err)
+	}
+
+	for _, saved := range searches {
+		if saved.LastArticleID >= latest {
+			continue
+		}
+		stats.Evaluated++
+		alerted, err := s.evaluate(ctx, saved, latest, now)
+		if err != nil {
+			stats.Failed++
+			slog.WarnContext(ctx, "Failed to evaluate saved search",
+				slog.Int64("search_id", saved.ID),
+				slog.Any("error", err))
+		}
+		if alerted {
+			stats.Alerted++
+		}
+	}
+	return stats, nil
+}
+
+// evaluate sends the alert of one subscribed search for the articles with
+// saved.LastArticleID < id <= latest and reports whether an alert was delivered.
+func (s *Service) evaluate(ctx context.Context, saved *entity.SavedSearch, latest int64, now time.Time) (bool, error) {
+	keywords, filters, err := criteria(saved)
+	if err != nil {
+		return false, err
+	}
+	matches, err := s.Repo.ListNewMatches(ctx, keywords, filters, saved.LastArticleID, latest, entity.MaxSearchAlertArticles)
+	if err != nil {
+		return false, fmt.Errorf("list new matches: %w", err)
+	}
+
+	var alertedAt *time.Time
+	var notifyErr error
+	if len(matches) > 0 && s.Notifier != nil {
+		alert := &entity.SearchAlert{
+			SearchID:   saved.ID,
+			SearchName: saved.Name,
+			Channel:    saved.Channel,
+			Articles:   make([]entity.DigestArticle, 0, len(matches)),
+		}
+		for _, m := range matches {
+			alert.Articles = append(alert.Articles, toDigestArticle(m))
+		}
+		if notifyErr = s.Notifier.NotifySearchAlert(ctx, alert); notifyErr == nil {
+			alertedAt = &now
+		}
+	}
+
+	if err := s.Repo.MarkEvaluated(ctx, saved.ID, latest, alertedAt); err != nil {
+		return alertedAt != nil, fmt.Errorf("mark evaluated: %w", err)
+	}
+	if notifyErr != nil {
+		return false, fmt.Errorf("notify search alert: %w", notifyErr)
+	}
+	return alertedAt != nil, nil
+}
+
+// applyInput sets the name, criteria and alert settings of saved from in and
+// validates the result.
+func applyInput(saved *entity.SavedSearch, in Input) error {
+	saved.Name = strings.TrimSpace(in.Name)
+	saved.Keyword = strings.Join(strings.Fields(in.Keyword), " ")
+	saved.SourceID = in.SourceID
+	saved.From = in.From
+	saved.To = in.To
+	saved.Subscribed = in.Subscribed
+	saved.Channel = in.Channel
+	for _, tag := range in.Tags {
+		if entity.NormalizeTagName(tag) == "" {
+			return &entity.ValidationError{Field: "tags", Message: "must be non-empty tag names"}
+		}
+	}
+	saved.Tags = entity.NormalizeTagNames(in.Tags)
+	if err := saved.Validate(); err != nil {
+		return err
+	}
+	_, _, err := criteria(saved)
+	return err
+}
+
+// criteria returns the parsed keywords and the filters of a saved search.
+func criteria(saved *entity.SavedSearch) ([]string, repository.ArticleSearchFilters, error) {
+	filters := repository.ArticleSearchFilters{
+		SourceID: saved.SourceID,
+		From:     saved.From,
+		To:       saved.To,
+		Tags:     saved.Tags,
+	}
+	if saved.Keyword == "" {
+		return nil, filters, nil
+	}
+	keywords, err := search.ParseKeywords(saved.Keyword, search.DefaultMaxKeywordCount, search.DefaultMaxKeywordLength)
+	if err != nil {
+		return nil, filters, &entity.ValidationError{Field: "keyword", Message: "is invalid: " + err.Error()}
+	}
+	return keywords, filters, nil
+}
+
+// toDigestArticle snapshots a matching article for an alert. The TL;DR of a
+// structured summary is preferred because alerts list several articles.
+func toDigestArticle(m repository.ArticleWithSource) entity.DigestArticle {
+	a := m.Article
+	summary := a.Summary
+	if a.Structured != nil && a.Structured.TLDR != "" {
+		summary = a.Structured.TLDR
+	}
+	return entity.DigestArticle{
+		ID:          a.ID,
+		Title:       a.Title,
+		URL:         a.URL,
+		SourceName:  m.SourceName,
+		Summary:     summary,
+		PublishedAt: a.PublishedAt,
+	}
+}
+
+// validateUserSearch checks the user and ID of a per-user saved search operation.
+func validateUserSearch(userID string, id int64) error {
+	if userID == "" {
+		return ErrUserRequired
+	}
+	if id <= 0 {
+		return ErrInvalidSearchID
+	}
+	return nil
+}
@@ -0,0 +1,314 @@
+package savedsearch_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	"catchup-feed/internal/usecase/savedsearch"
+)
+
+/* ───────── モック ───────── */
+
+type stubSearchRepo struct {
+	searches []*entity.SavedSearch
+	latest   int64
+	matches  map[int64][]repository.ArticleWithSource
+	found    bool
+	err      error
+
+	created   *entity.SavedSearch
+	updated   *entity.SavedSearch
+	evaluated map[int64]evaluation
+	gotAfter  map[int64]int64
+}
+
+type evaluation struct {
+	lastArticleID int64
+	alerted       bool
+}
+
+func (s *stubSearchRepo) ListSearches(context.Context, string) ([]*entity.SavedSearch, error) {
+	return s.searches, s.err
+}
+
+func (s *stubSearchRepo) GetSearch(_ context.Context, userID string, id int64) (*entity.SavedSearch, error) {
+	for _, saved := range s.searches {
+		if saved.ID == id && saved.UserID == userID {
+			c := *saved
+			return &c, s.err
+		}
+	}
+	return nil, s.err
+}
+
+func (s *stubSearchRepo) CreateSearch(_ context.Context, search *entity.SavedSearch) error {
+	search.ID = 10
+	s.created = search
+	return s.err
+}
+
+func (s *stubSearchRepo) UpdateSearch(_ context.Context, search *entity.SavedSearch) (bool, error) {
+	s.updated = search
+	return s.found, s.err
+}
+
+func (s *stubSearchRepo) DeleteSearch(context.Context, string, int64) (bool, error) {
+	return s.found, s.err
+}
+
+func (s *stubSearchRepo) ListSubscribed(context.Context) ([]*entity.SavedSearch, error) {
+	return s.searches, s.err
+}
+
+func (s *stubSearchRepo) LatestArticleID(context.Context) (int64, error) {
+	return s.latest, s.err
+}
+
+func (s *stubSearchRepo) ListNewMatches(_ context.Context, _ []string, filters repository.ArticleSearchFilters, afterID, _ int64, _ int) ([]repository.ArticleWithSource, error) {
+	// テストでは SourceID で検索を区別する
+	id := *filters.SourceID
+	if s.gotAfter == nil {
+		s.gotAfter = map[int64]int64{}
+	}
+	s.gotAfter[id] = afterID
+	return s.matches[id], nil
+}
+
+func (s *stubSearchRepo) MarkEvaluated(_ context.Context, id, lastArticleID int64, alertedAt *time.Time) error {
+	if s.evaluated == nil {
+		s.evaluated = map[int64]evaluation{}
+	}
+	s.evaluated[id] = evaluation{lastArticleID: lastArticleID, alerted: alertedAt != nil}
+	return nil
+}
+
+type stubArticles struct {
+	total       int64
+	gotKeywords []string
+	gotFilters  repository.ArticleSearchFilters
+	gotOffset   int
+}
+
+func (s *stubArticles) CountArticlesWithFilters(context.Context, []string, repository.ArticleSearchFilters) (int64, error) {
+	return s.total, nil
+}
+
+func (s *stubArticles) SearchWithFiltersPaginated(_ context.Context, keywords []string, filters repository.ArticleSearchFilters, offset, _ int) ([]repository.ArticleWithSource, error) {
+	s.gotKeywords, s.gotFilters, s.gotOffset = keywords, filters, offset
+	return []repository.ArticleWithSource{{Article: &entity.Article{ID: 1}, SourceName: "Go Blog"}}, nil
+}
+
+type stubNotifier struct {
+	alerts []*entity.SearchAlert
+	err    error
+}
+
+func (s *stubNotifier) NotifySearchAlert(_ context.Context, alert *entity.SearchAlert) error {
+	s.alerts = append(s.alerts, alert)
+	return s.err
+}
+
+func int64Ptr(v int64) *int64 { return &v }
+
+/* ───────── テスト ───────── */
+
+func TestService_Create(t *testing.T) {
+	repo := &stubSearchRepo{latest: 130}
+	svc := savedsearch.Service{Repo: repo}
+
+	got, err := svc.Create(context.Background(), "alice", savedsearch.Input{
+		Name: " Go リリース ", Keyword: " go   release ", Tags: []string{"Go", "go"}, Subscribed: true,
+	})
+	if err != nil {
+		t.Fatalf("Create err=%v", err)
+	}
+	if got.ID != 10 || got.UserID != "alice" || got.Name != "Go リリース" || got.Keyword != "go release" {
+		t.Errorf("search = %+v", got)
+	}
+	if len(got.Tags) != 1 || got.Tags[0] != "go" {
+		t.Errorf("Tags = %v, want [go]", got.Tags)
+	}
+	if got.LastArticleID != 130 {
+		t.Errorf("LastArticleID = %d, want 130", got.LastArticleID)
+	}
+}
+
+func TestService_Create_Invalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        savedsearch.Input
+		wantField string
+	}{
+		{name: "no criteria", in: savedsearch.Input{Name: "Go"}, wantField: "keyword"},
+		{name: "too many keywords", in: savedsearch.Input{Name: "Go", Keyword: strings.Repeat("go ", 11)}, wantField: "keyword"},
+		{name: "empty tag", in: savedsearch.Input{Name: "Go", Tags: []string{" "}}, wantField: "tags"},
+		{name: "unknown channel", in: savedsearch.Input{Name: "Go", Keyword: "go", Channel: "email"}, wantField: "channel"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &stubSearchRepo{}
+			svc := savedsearch.Service{Repo: repo}
+			_, err := svc.Create(context.Background(), "alice", tt.in)
+			var vErr *entity.ValidationError
+			if !errors.As(err, &vErr) || vErr.Field != tt.wantField {
+				t.Fatalf("err = %v, want ValidationError on %s", err, tt.wantField)
+			}
+			if repo.created != nil {
+				t.Error("CreateSearch should not be called")
+			}
+		})
+	}
+
+	svc := savedsearch.Service{Repo: &stubSearchRepo{}}
+	if _, err := svc.Create(context.Background(), "", savedsearch.Input{Name: "Go", Keyword: "go"}); !errors.Is(err, savedsearch.ErrUserRequired) {
+		t.Errorf("err = %v, want %v", err, savedsearch.ErrUserRequired)
+	}
+}
+
+func TestService_Update(t *testing.T) {
+	tests := []struct {
+		name       string
+		existing   entity.SavedSearch
+		subscribed bool
+		wantLast   int64
+	}{
+		{name: "subscribe resets watermark", existing: entity.SavedSearch{LastArticleID: 20}, subscribed: true, wantLast: 130},
+		{name: "stay subscribed keeps watermark", existing: entity.SavedSearch{Subscribed: true, LastArticleID: 120}, subscribed: true, wantLast: 120},
+		{name: "unsubscribe keeps watermark", existing: entity.SavedSearch{Subscribed: true, LastArticleID: 120}, wantLast: 120},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			existing := tt.existing
+			existing.ID, existing.UserID, existing.Name, existing.Keyword = 1, "alice", "Go", "go"
+			repo := &stubSearchRepo{searches: []*entity.SavedSearch{&existing}, latest: 130, found: true}
+			svc := savedsearch.Service{Repo: repo}
+
+			got, err := svc.Update(context.Background(), "alice", 1, savedsearch.Input{Name: "Go 2", Keyword: "go", Subscribed: tt.subscribed})
+			if err != nil {
+				t.Fatalf("Update err=%v", err)
+			}
+			if got.Name != "Go 2" || got.Subscribed != tt.subscribed || repo.updated.LastArticleID != tt.wantLast {
+				t.Errorf("updated = %+v, want LastArticleID %d", repo.updated, tt.wantLast)
+			}
+		})
+	}
+}
+
+func TestService_Update_NotFound(t *testing.T) {
+	svc := savedsearch.Service{Repo: &stubSearchRepo{}}
+	_, err := svc.Update(context.Background(), "bob", 1, savedsearch.Input{Name: "Go", Keyword: "go"})
+	if !errors.Is(err, savedsearch.ErrSearchNotFound) {
+		t.Fatalf("err = %v, want %v", err, savedsearch.ErrSearchNotFound)
+	}
+	if _, err := svc.Update(context.Background(), "bob", 0, savedsearch.Input{}); !errors.Is(err, savedsearch.ErrInvalidSearchID) {
+		t.Fatalf("err = %v, want %v", err, savedsearch.ErrInvalidSearchID)
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	svc := savedsearch.Service{Repo: &stubSearchRepo{found: true}}
+	if err := svc.Delete(context.Background(), "alice", 1); err != nil {
+		t.Fatalf("Delete err=%v", err)
+	}
+	svc = savedsearch.Service{Repo: &stubSearchRepo{}}
+	if err := svc.Delete(context.Background(), "alice", 1); !errors.Is(err, savedsearch.ErrSearchNotFound) {
+		t.Fatalf("err = %v, want %v", err, savedsearch.ErrSearchNotFound)
+	}
+}
+
+func TestService_Run(t *testing.T) {
+	saved := &entity.SavedSearch{ID: 1, UserID: "alice", Name: "Go", Keyword: "go release", SourceID: int64Ptr(3), Tags: []string{"go"}}
+	articles := &stubArticles{total: 45}
+	svc := savedsearch.Service{Repo: &stubSearchRepo{searches: []*entity.SavedSearch{saved}}, Articles: articles}
+
+	got, err := svc.Run(context.Background(), "alice", 1, pagination.Params{Page: 3, Limit: 20})
+	if err != nil {
+		t.Fatalf("Run err=%v", err)
+	}
+	if got.Search.ID != 1 || len(got.Data) != 1 {
+		t.Errorf("result = %+v", got)
+	}
+	wantMeta := pagination.Metadata{Total: 45, Page: 3, Limit: 20, TotalPages: 3}
+	if got.Pagination != wantMeta {
+		t.Errorf("Pagination = %+v, want %+v", got.Pagination, wantMeta)
+	}
+	if len(articles.gotKeywords) != 2 || *articles.gotFilters.SourceID != 3 || articles.gotFilters.Tags[0] != "go" || articles.gotOffset != 40 {
+		t.Errorf("search called with (%v, %+v, %d)", articles.gotKeywords, articles.gotFilters, articles.gotOffset)
+	}
+
+	if _, err := svc.Run(context.Background(), "bob", 1, pagination.Params{Page: 1, Limit: 20}); !errors.Is(err, savedsearch.ErrSearchNotFound) {
+		t.Errorf("err = %v, want %v", err, savedsearch.ErrSearchNotFound)
+	}
+}
+
+func TestService_EvaluateAlerts(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &stubSearchRepo{
+		searches: []*entity.SavedSearch{
+			{ID: 1, UserID: "alice", Name: "Go", SourceID: int64Ptr(1), Subscribed: true, Channel: entity.AlertChannelSlack, LastArticleID: 100},
+			{ID: 2, UserID: "alice", Name: "Rust", SourceID: int64Ptr(2), Subscribed: true, LastArticleID: 110},
+			{ID: 3, UserID: "bob", Name: "Up to date", SourceID: int64Ptr(3), Subscribed: true, LastArticleID: 130},
+		},
+		latest: 130,
+		matches: map[int64][]repository.ArticleWithSource{
+			1: {
+				{Article: &entity.Article{ID: 125, Title: "Go 1.25", Summary: "要約",
+					Structured: &entity.StructuredSummary{TLDR: "Go 1.25 リリース"}}, SourceName: "Go Blog"},
+				{Article: &entity.Article{ID: 101, Title: "Go 1.25 RC"}, SourceName: "Go Blog"},
+			},
+		},
+	}
+	notifier := &stubNotifier{}
+	svc := savedsearch.Service{Repo: repo, Notifier: notifier}
+
+	stats, err := svc.EvaluateAlerts(context.Background(), now)
+	if err != nil {
+		t.Fatalf("EvaluateAlerts err=%v", err)
+	}
+	if stats != (savedsearch.AlertStats{Evaluated: 2, Alerted: 1}) {
+		t.Errorf("stats = %+v", stats)
+	}
+	if len(notifier.alerts) != 1 {
+		t.Fatalf("alerts = %d, want 1", len(notifier.alerts))
+	}
+	alert := notifier.alerts[0]
+	if alert.SearchID != 1 || alert.Channel != entity.AlertChannelSlack || len(alert.Articles) != 2 || alert.Articles[0].Summary != "Go 1.25 リリース" {
+		t.Errorf("alert = %+v", alert)
+	}
+	if repo.gotAfter[1] != 100 || repo.gotAfter[2] != 110 {
+		t.Errorf("afterIDs = %v", repo.gotAfter)
+	}
+	wantEval := map[int64]evaluation{1: {130, true}, 2: {130, false}}
+	if len(repo.evaluated) != 2 || repo.evaluated[1] != wantEval[1] || repo.evaluated[2] != wantEval[2] {
+		t.Errorf("evaluated = %v, want %v", repo.evaluated, wantEval)
+	}
+}
+
+func TestService_EvaluateAlerts_DeliveryFailure(t *testing.T) {
+	repo := &stubSearchRepo{
+		searches: []*entity.SavedSearch{{ID: 1, UserID: "alice", Name: "Go", SourceID: int64Ptr(1), Subscribed: true, LastArticleID: 100}},
+		latest:   130,
+		matches: map[int64][]repository.ArticleWithSource{
+			1: {{Article: &entity.Article{ID: 125}, SourceName: "Go Blog"}},
+		},
+	}
+	svc := savedsearch.Service{Repo: repo, Notifier: &stubNotifier{err: errors.New("slack down")}}
+
+	stats, err := svc.EvaluateAlerts(context.Background(), time.Now())
+	if err != nil {
+		t.Fatalf("EvaluateAlerts err=%v", err)
+	}
+	if stats != (savedsearch.AlertStats{Evaluated: 1, Failed: 1}) {
+		t.Errorf("stats = %+v", stats)
+	}
+	// 配信に失敗しても同じ通知を繰り返さないよう既読位置は進める
+	if repo.evaluated[1] != (evaluation{lastArticleID: 130}) {
+		t.Errorf("evaluated = %v", repo.evaluated)
+	}
+}
@@ -0,0 +1,100 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+// MaxRecentArticles is the maximum number of recent articles in a source detail.
+const MaxRecentArticles = 50
+
+// Detail is a source with its article counts, crawl health and newest articles.
+type Detail struct {
+	Source *entity.Source
+	Counts repository.SourceArticleCounts
+	Recent []*entity.Article
+}
+
+// ArticlePage is one page of the articles of a source.
+type ArticlePage struct {
+	Data       []*entity.Article
+	Pagination pagination.Metadata
+}
+
+// Get retrieves a single source by its ID.
+// Returns a ValidationError if the ID is not positive.
+// Returns ErrSourceNotFound if the source does not exist or is in the trash.
+func (s *Service) Get(ctx context.Context, id int64) (*entity.Source, error) {
+	if id <= 0 {
+		return nil, &entity.ValidationError{Field: "id", Message: "must be positive"}
+	}
+
+	src, err := s.Repo.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get source: %w", err)
+	}
+	if src == nil {
+		return nil, ErrSourceNotFound
+	}
+	return src, nil
+}
+
+// Detail retrieves a source with its article counts (total, last 24 hours, last
+// 7 days), crawl health and its recent newest articles.
+// Returns a ValidationError if recent is not between 0 and MaxRecentArticles.
+// Returns ErrSourceNotFound if the source does not exist or is in the trash.
+func (s *Service) Detail(ctx context.Context, id int64, recent int) (*Detail, error) {
+	if recent < 0 || recent > MaxRecentArticles {
+		return nil, &entity.ValidationError{Field: "recent", Message: fmt.Sprintf("must be between 0 and %d", MaxRecentArticles)}
+	}
+	src, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	counts, err := s.ArticleRepo.CountBySource(ctx, id, now.Add(-24*time.Hour), now.Add(-7*24*time.Hour))
+	if err != nil {
+		return nil, fmt.Errorf("count source articles: %w", err)
+	}
+
+	d := &Detail{Source: src, Counts: counts, Recent: []*entity.Article{}}
+	if recent > 0 && counts.Total > 0 {
+		if d.Recent, err = s.ArticleRepo.ListBySource(ctx, id, 0, recent); err != nil {
+			return nil, fmt.Errorf("list recent source articles: %w", err)
+		}
+	}
+	return d, nil
+}
+
+// ListArticlesPaginated retrieves one page of the articles of a source, newest first.
+// Returns ErrSourceNotFound if the source does not exist or is in the trash.
+func (s *Service) ListArticlesPaginated(ctx context.Context, id int64, params pagination.Params) (*ArticlePage, error) {
+	if _, err := s.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	counts, err := s.ArticleRepo.CountBySource(ctx, id, time.Time{}, time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("count source articles: %w", err)
+	}
+	articles, err := s.ArticleRepo.ListBySource(ctx, id, pagination.CalculateOffset(params.Page, params.Limit), params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("list source articles: %w", err)
+	}
+
+	return &ArticlePage{
+		Data: articles,
+		Pagination: pagination.Metadata{
+			Total:      counts.Total,
+			Page:       params.Page,
+			Limit:      params.Limit,
+			TotalPages: pagination.CalculateTotalPages(counts.Total, params.Limit),
+		},
+	}, nil
+}
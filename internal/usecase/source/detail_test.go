@@ -0,0 +1,101 @@
+package source_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	srcUC "catchup-feed/internal/usecase/source"
+)
+
+// stubSourceArticleRepo はSourceArticleRepositoryのモック実装
+type stubSourceArticleRepo struct {
+	articles    []*entity.Article
+	counts      repository.SourceArticleCounts
+	listCalls   int
+	gotOffset   int
+	gotLimit    int
+	gotSinceDay time.Time
+}
+
+func (s *stubSourceArticleRepo) ListBySource(_ context.Context, _ int64, offset, limit int) ([]*entity.Article, error) {
+	s.listCalls++
+	s.gotOffset, s.gotLimit = offset, limit
+	return s.articles, nil
+}
+
+func (s *stubSourceArticleRepo) CountBySource(_ context.Context, _ int64, dayStart, _ time.Time) (repository.SourceArticleCounts, error) {
+	s.gotSinceDay = dayStart
+	return s.counts, nil
+}
+
+func TestService_Detail(t *testing.T) {
+	repo := newStub()
+	repo.data[3] = &entity.Source{ID: 3, Name: "Go Blog"}
+	arts := &stubSourceArticleRepo{
+		articles: []*entity.Article{{ID: 9, SourceID: 3}},
+		counts:   repository.SourceArticleCounts{Total: 40, LastDay: 2},
+	}
+	svc := srcUC.Service{Repo: repo, ArticleRepo: arts}
+
+	got, err := svc.Detail(context.Background(), 3, 5)
+	if err != nil {
+		t.Fatalf("Detail err=%v", err)
+	}
+	if got.Source.Name != "Go Blog" || got.Counts.Total != 40 || len(got.Recent) != 1 || arts.gotLimit != 5 || arts.gotOffset != 0 {
+		t.Errorf("Detail = %+v (limit %d)", got, arts.gotLimit)
+	}
+	if since := time.Since(arts.gotSinceDay); since < 23*time.Hour || since > 25*time.Hour {
+		t.Errorf("dayStart = %v, want 24 hours ago", arts.gotSinceDay)
+	}
+
+	// recent=0 では記事を読まない
+	arts.listCalls = 0
+	if got, err := svc.Detail(context.Background(), 3, 0); err != nil || len(got.Recent) != 0 || arts.listCalls != 0 {
+		t.Errorf("Detail(recent=0) = %+v, %v (list calls %d)", got, err, arts.listCalls)
+	}
+}
+
+func TestService_Detail_Errors(t *testing.T) {
+	svc := srcUC.Service{Repo: newStub(), ArticleRepo: &stubSourceArticleRepo{}}
+
+	if _, err := svc.Detail(context.Background(), 3, 5); !errors.Is(err, srcUC.ErrSourceNotFound) {
+		t.Errorf("missing source err = %v, want ErrSourceNotFound", err)
+	}
+	var ve *entity.ValidationError
+	if _, err := svc.Detail(context.Background(), 3, srcUC.MaxRecentArticles+1); !errors.As(err, &ve) {
+		t.Errorf("too many recent err = %v, want ValidationError", err)
+	}
+	if _, err := svc.Detail(context.Background(), 0, 5); !errors.As(err, &ve) {
+		t.Errorf("invalid id err = %v, want ValidationError", err)
+	}
+}
+
+func TestService_ListArticlesPaginated(t *testing.T) {
+	repo := newStub()
+	repo.data[3] = &entity.Source{ID: 3}
+	arts := &stubSourceArticleRepo{
+		articles: []*entity.Article{{ID: 9}, {ID: 8}},
+		counts:   repository.SourceArticleCounts{Total: 42},
+	}
+	svc := srcUC.Service{Repo: repo, ArticleRepo: arts}
+
+	got, err := svc.ListArticlesPaginated(context.Background(), 3, pagination.Params{Page: 3, Limit: 20})
+	if err != nil {
+		t.Fatalf("ListArticlesPaginated err=%v", err)
+	}
+	if arts.gotOffset != 40 || arts.gotLimit != 20 {
+		t.Errorf("repo got offset=%d limit=%d, want 40, 20", arts.gotOffset, arts.gotLimit)
+	}
+	if len(got.Data) != 2 || got.Pagination.Total != 42 || got.Pagination.TotalPages != 3 || got.Pagination.Page != 3 {
+		t.Errorf("ListArticlesPaginated = %+v", got)
+	}
+
+	if _, err := svc.ListArticlesPaginated(context.Background(), 4, pagination.Params{Page: 1, Limit: 20}); !errors.Is(err, srcUC.ErrSourceNotFound) {
+		t.Errorf("missing source err = %v, want ErrSourceNotFound", err)
+	}
+}
@@ -12,21 +12,27 @@ import (
 type CreateInput struct {
 	Name    string
 	FeedURL string
+	// PromptTemplate selects the summary prompt template (empty = default).
+	PromptTemplate string
 }
 
 // UpdateInput represents the input parameters for updating an existing source.
-// Empty string fields and nil Active field will not be updated.
+// Empty string fields and nil Active/PromptTemplate fields will not be updated.
+// A non-nil empty PromptTemplate resets the source to the default template.
 type UpdateInput struct {
-	ID      int64
-	Name    string
-	FeedURL string
-	Active  *bool
+	ID             int64
+	Name           string
+	FeedURL        string
+	Active         *bool
+	PromptTemplate *string
 }
 
 // Service provides source management use cases.
 // It handles business logic for source operations and delegates persistence to the repository.
 type Service struct {
 	Repo repository.SourceRepository
+	// ArticleRepo reads the articles of a source for Detail and ListArticlesPaginated.
+	ArticleRepo repository.SourceArticleRepository
 }
 
 // List retrieves all sources from the repository.
@@ -77,12 +83,16 @@ func (s *Service) Create(ctx context.Context, in CreateInput) error {
 	if err := entity.ValidateURL(in.FeedURL); err != nil {
 		return fmt.Errorf("validate feed URL: %w", err)
 	}
+	if err := entity.ValidatePromptTemplateName(in.PromptTemplate); err != nil {
+		return err
+	}
 
 	src := &entity.Source{
-		Name:          in.Name,
-		FeedURL:       in.FeedURL,
-		LastCrawledAt: nil,
-		Active:        true,
+		Name:           in.Name,
+		FeedURL:        in.FeedURL,
+		LastCrawledAt:  nil,
+		Active:         true,
+		PromptTemplate: in.PromptTemplate,
 	}
 
 	if err := s.Repo.Create(ctx, src); err != nil {
@@ -121,6 +131,12 @@ func (s *Service) Update(ctx context.Context, in UpdateInput) error {
 	if in.Active != nil {
 		src.Active = *in.Active
 	}
+	if in.PromptTemplate != nil {
+		if err := entity.ValidatePromptTemplateName(*in.PromptTemplate); err != nil {
+			return err
+		}
+		src.PromptTemplate = *in.PromptTemplate
+	}
 
 	if err := s.Repo.Update(ctx, src); err != nil {
 		return fmt.Errorf("update source: %w", err)
@@ -128,7 +144,8 @@ func (s *Service) Update(ctx context.Context, in UpdateInput) error {
 	return nil
 }
 
-// Delete removes a source by its ID.
+// Delete moves a source and its articles to the trash by its ID.
+// They can be restored until the trash is purged (see the trash use case).
 // Returns a ValidationError if the ID is not positive.
 // Returns an error if the repository operation fails.
 func (s *Service) Delete(ctx context.Context, id int64) error {
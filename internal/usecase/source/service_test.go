@@ -648,3 +648,48 @@ func TestService_Delete_success(t *testing.T) {
 		})
 	}
 }
+
+/* Create/Update: プロンプトテンプレート名の保存とバリデーション */
+func TestService_PromptTemplate(t *testing.T) {
+	stub := newStub()
+	svc := srcUC.Service{Repo: stub}
+
+	err := svc.Create(context.Background(), srcUC.CreateInput{
+		Name: "Go Changelog", FeedURL: "https://go.dev/changelog.xml", PromptTemplate: "Release Notes",
+	})
+	var vErr *entity.ValidationError
+	if !errors.As(err, &vErr) || vErr.Field != "prompt_template" {
+		t.Fatalf("want prompt_template ValidationError, got %v", err)
+	}
+
+	if err := svc.Create(context.Background(), srcUC.CreateInput{
+		Name: "Go Changelog", FeedURL: "https://go.dev/changelog.xml", PromptTemplate: "release-notes",
+	}); err != nil {
+		t.Fatalf("Create err=%v", err)
+	}
+	if got := stub.data[1].PromptTemplate; got != "release-notes" {
+		t.Fatalf("PromptTemplate = %q, want release-notes", got)
+	}
+
+	// nil は変更なし
+	if err := svc.Update(context.Background(), srcUC.UpdateInput{ID: 1, Name: "Go"}); err != nil {
+		t.Fatalf("Update err=%v", err)
+	}
+	if got := stub.data[1].PromptTemplate; got != "release-notes" {
+		t.Fatalf("PromptTemplate = %q, want unchanged", got)
+	}
+
+	// 空文字はデフォルトに戻す
+	empty := ""
+	if err := svc.Update(context.Background(), srcUC.UpdateInput{ID: 1, PromptTemplate: &empty}); err != nil {
+		t.Fatalf("Update err=%v", err)
+	}
+	if got := stub.data[1].PromptTemplate; got != "" {
+		t.Fatalf("PromptTemplate = %q, want empty", got)
+	}
+
+	invalid := "../secret"
+	if err := svc.Update(context.Background(), srcUC.UpdateInput{ID: 1, PromptTemplate: &invalid}); !errors.As(err, &vErr) {
+		t.Fatalf("want ValidationError, got %v", err)
+	}
+}
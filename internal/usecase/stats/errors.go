@@ -0,0 +1,14 @@
+// Package stats provides the source and article statistics use cases: the
+// activity of each source and time series of ingested articles, computed with
+// SQL aggregates and cached briefly. The same figures feed the Prometheus
+// gauges of the article and source totals.
+package stats
+
+import "errors"
+
+// Sentinel errors for stats use case operations.
+var (
+	// ErrTooManyBuckets indicates that a time series would have more than
+	// MaxBuckets intervals.
+	ErrTooManyBuckets = errors.New("too many intervals in time series")
+)
@@ -0,0 +1,265 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+// cacheTTL is how long computed statistics are reused, so that dashboards
+// polling the endpoints do not run the aggregates on every request.
+const cacheTTL = time.Minute
+
+// MaxBuckets is the maximum number of intervals of a time series.
+const MaxBuckets = 1000
+
+// defaultBuckets is the number of intervals of a time series without a start.
+var defaultBuckets = map[string]int{
+	repository.StatsIntervalHour: 48,
+	repository.StatsIntervalDay:  30,
+	repository.StatsIntervalWeek: 12,
+}
+
+// SourceStats are the statistics of one source.
+type SourceStats struct {
+	repository.SourceStats
+	// SummarizeErrorRate is the share of the articles of the last week whose
+	// summarization failed (0 to 1), counting failed and stored articles.
+	SummarizeErrorRate float64
+}
+
+// SourceReport are the statistics of every source.
+type SourceReport struct {
+	Sources     []SourceStats
+	GeneratedAt time.Time
+}
+
+// SeriesQuery selects an ingestion time series. Zero values select the defaults:
+// daily intervals, up to the current interval, and a range of 48 hours, 30 days
+// or 12 weeks depending on the interval.
+type SeriesQuery struct {
+	Interval string
+	From     time.Time
+	To       time.Time
+	SourceID *int64
+}
+
+// Series is the number of articles ingested per interval. From and To are
+// aligned to interval boundaries and every interval of [From, To) has a bucket.
+type Series struct {
+	Interval    string
+	From        time.Time
+	To          time.Time
+	SourceID    *int64
+	Buckets     []repository.IngestionBucket
+	Total       int64
+	GeneratedAt time.Time
+}
+
+// seriesKey identifies a cached series; sourceID is 0 for all sources.
+type seriesKey struct {
+	interval string
+	from, to int64
+	sourceID int64
+}
+
+// Service computes the statistics and caches them for cacheTTL.
+type Service struct {
+	Repo repository.StatsRepository
+
+	// UpdateTotals, if set, receives the number of sources and articles each time
+	// the source statistics are computed (e.g. to update Prometheus gauges).
+	UpdateTotals func(sources, articles int)
+
+	mu      sync.Mutex
+	sources *SourceReport
+	series  map[seriesKey]*Series
+}
+
+// NewService creates a Service computing the statistics with repo.
+func NewService(repo repository.StatsRepository) *Service {
+	return &Service{Repo: repo, series: make(map[seriesKey]*Series)}
+}
+
+// Sources returns the statistics of every source. Computing them also reports
+// the source and article totals to UpdateTotals.
+func (s *Service) Sources(ctx context.Context) (*SourceReport, error) {
+	s.mu.Lock()
+	cached := s.sources
+	s.mu.Unlock()
+	if cached != nil && time.Since(cached.GeneratedAt) < cacheTTL {
+		return cached, nil
+	}
+	return s.refreshSources(ctx)
+}
+
+// refreshSources computes the source statistics, caches them and reports the totals.
+func (s *Service) refreshSources(ctx context.Context) (*SourceReport, error) {
+	now := time.Now().UTC()
+	rows, err := s.Repo.SourceStats(ctx, now.Add(-24*time.Hour), now.Add(-7*24*time.Hour))
+	if err != nil {
+		return nil, fmt.Errorf("compute source stats: %w", err)
+	}
+
+	report := &SourceReport{Sources: make([]SourceStats, 0, len(rows)), GeneratedAt: now}
+	var articles int64
+	for _, r := range rows {
+		st := SourceStats{SourceStats: r}
+		if attempts := r.SummarizeFailuresLastWeek + r.ArticlesLastWeek; attempts > 0 {
+			st.SummarizeErrorRate = float64(r.SummarizeFailuresLastWeek) / float64(attempts)
+		}
+		report.Sources = append(report.Sources, st)
+		articles += r.ArticlesTotal
+	}
+	if s.UpdateTotals != nil {
+		s.UpdateTotals(len(rows), int(articles))
+	}
+
+	s.mu.Lock()
+	s.sources = report
+	s.mu.Unlock()
+	return report, nil
+}
+
+// Articles returns the number of articles ingested per interval.
+func (s *Service) Articles(ctx context.Context, q SeriesQuery) (*Series, error) {
+	if q.Interval == "" {
+		q.Interval = repository.StatsIntervalDay
+	}
+	n, ok := defaultBuckets[q.Interval]
+	if !ok {
+		return nil, &entity.ValidationError{Field: "interval", Message: "must be one of hour, day, week"}
+	}
+	if q.SourceID != nil && *q.SourceID <= 0 {
+		return nil, &entity.ValidationError{Field: "source_id", Message: "must be positive"}
+	}
+
+	now := time.Now().UTC()
+	to := q.To
+	if to.IsZero() {
+		to = now
+	}
+	// 現在進行中の区間も含めるため、終端は次の区間の開始に切り上げる
+	if end := truncate(to, q.Interval); !end.Equal(to.UTC()) {
+		to = advance(end, q.Interval, 1)
+	} else {
+		to = end
+	}
+	from := q.From
+	if from.IsZero() {
+		from = advance(to, q.Interval, -n)
+	}
+	from = truncate(from, q.Interval)
+	if !from.Before(to) {
+		return nil, &entity.ValidationError{Field: "from", Message: "must be before to"}
+	}
+	if buckets := countBuckets(from, to, q.Interval); buckets > MaxBuckets {
+		return nil, fmt.Errorf("%w: %d intervals, at most %d", ErrTooManyBuckets, buckets, MaxBuckets)
+	}
+
+	key := seriesKey{interval: q.Interval, from: from.Unix(), to: to.Unix()}
+	if q.SourceID != nil {
+		key.sourceID = *q.SourceID
+	}
+	s.mu.Lock()
+	cached := s.series[key]
+	s.mu.Unlock()
+	if cached != nil && now.Sub(cached.GeneratedAt) < cacheTTL {
+		return cached, nil
+	}
+
+	rows, err := s.Repo.IngestionSeries(ctx, q.Interval, from, to, q.SourceID)
+	if err != nil {
+		return nil, fmt.Errorf("compute ingestion series: %w", err)
+	}
+	series := &Series{Interval: q.Interval, From: from, To: to, SourceID: q.SourceID, GeneratedAt: now}
+	series.Buckets, series.Total = fill(rows, from, to, q.Interval)
+
+	s.mu.Lock()
+	for k, v := range s.series {
+		if now.Sub(v.GeneratedAt) >= cacheTTL {
+			delete(s.series, k)
+		}
+	}
+	s.series[key] = series
+	s.mu.Unlock()
+	return series, nil
+}
+
+// RunGauges refreshes the source statistics, and with them the totals reported
+// to UpdateTotals, every interval until ctx is done.
+func (s *Service) RunGauges(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := s.refreshSources(ctx); err != nil && ctx.Err() == nil {
+			slog.Warn("failed to update article and source gauges", slog.Any("error", err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// truncate returns the start of the interval containing t, in UTC. Weeks start on Monday.
+func truncate(t time.Time, interval string) time.Time {
+	t = t.UTC()
+	switch interval {
+	case repository.StatsIntervalHour:
+		return t.Truncate(time.Hour)
+	case repository.StatsIntervalWeek:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// advance moves the interval boundary t by n intervals.
+func advance(t time.Time, interval string, n int) time.Time {
+	switch interval {
+	case repository.StatsIntervalHour:
+		return t.Add(time.Duration(n) * time.Hour)
+	case repository.StatsIntervalWeek:
+		return t.AddDate(0, 0, 7*n)
+	default:
+		return t.AddDate(0, 0, n)
+	}
+}
+
+// countBuckets returns the number of intervals in [from, to).
+func countBuckets(from, to time.Time, interval string) int {
+	hours := int(to.Sub(from) / time.Hour)
+	switch interval {
+	case repository.StatsIntervalHour:
+		return hours
+	case repository.StatsIntervalWeek:
+		return (hours + 7*24 - 1) / (7 * 24)
+	default:
+		return (hours + 23) / 24
+	}
+}
+
+// fill returns a bucket for every interval of [from, to), with the counts of
+// rows and zero for the intervals without articles, and the total count.
+func fill(rows []repository.IngestionBucket, from, to time.Time, interval string) ([]repository.IngestionBucket, int64) {
+	counts := make(map[int64]int64, len(rows))
+	for _, r := range rows {
+		counts[r.Start.Unix()] += r.Count
+	}
+	var buckets []repository.IngestionBucket
+	var total int64
+	for t := from; t.Before(to); t = advance(t, interval, 1) {
+		c := counts[t.Unix()]
+		buckets = append(buckets, repository.IngestionBucket{Start: t, Count: c})
+		total += c
+	}
+	return buckets, total
+}
@@ -0,0 +1,159 @@
+package stats_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	"catchup-feed/internal/usecase/stats"
+)
+
+/* ───────── モック ───────── */
+
+type stubStatsRepo struct {
+	sources     []repository.SourceStats
+	buckets     []repository.IngestionBucket
+	err         error
+	sourceCalls int
+	seriesCalls int
+	gotInterval string
+	gotFrom     time.Time
+	gotTo       time.Time
+}
+
+func (s *stubStatsRepo) SourceStats(context.Context, time.Time, time.Time) ([]repository.SourceStats, error) {
+	s.sourceCalls++
+	return s.sources, s.err
+}
+
+func (s *stubStatsRepo) IngestionSeries(_ context.Context, interval string, from, to time.Time, _ *int64) ([]repository.IngestionBucket, error) {
+	s.seriesCalls++
+	s.gotInterval, s.gotFrom, s.gotTo = interval, from, to
+	return s.buckets, s.err
+}
+
+/* ───────── テスト ───────── */
+
+func TestService_Sources(t *testing.T) {
+	repo := &stubStatsRepo{sources: []repository.SourceStats{
+		{SourceID: 1, ArticlesTotal: 40, ArticlesLastWeek: 9, SummarizeFailuresLastWeek: 1},
+		{SourceID: 2},
+	}}
+	svc := stats.NewService(repo)
+	var gotSources, gotArticles int
+	svc.UpdateTotals = func(sources, articles int) { gotSources, gotArticles = sources, articles }
+
+	got, err := svc.Sources(context.Background())
+	if err != nil {
+		t.Fatalf("Sources err=%v", err)
+	}
+	if len(got.Sources) != 2 || got.Sources[0].SummarizeErrorRate != 0.1 || got.Sources[1].SummarizeErrorRate != 0 {
+		t.Errorf("Sources = %+v", got.Sources)
+	}
+	if gotSources != 2 || gotArticles != 40 {
+		t.Errorf("UpdateTotals(%d, %d), want (2, 40)", gotSources, gotArticles)
+	}
+
+	// 2回目はキャッシュを返す
+	if _, err := svc.Sources(context.Background()); err != nil || repo.sourceCalls != 1 {
+		t.Errorf("cached Sources err=%v, repository calls = %d, want 1", err, repo.sourceCalls)
+	}
+}
+
+func TestService_Sources_Error(t *testing.T) {
+	svc := stats.NewService(&stubStatsRepo{err: errors.New("db down")})
+	if _, err := svc.Sources(context.Background()); err == nil {
+		t.Error("repository error should be returned")
+	}
+}
+
+func TestService_Articles(t *testing.T) {
+	from := time.Date(2025, 6, 1, 10, 30, 0, 0, time.UTC)
+	to := time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC)
+	repo := &stubStatsRepo{buckets: []repository.IngestionBucket{
+		{Start: time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC), Count: 5},
+	}}
+	svc := stats.NewService(repo)
+
+	got, err := svc.Articles(context.Background(), stats.SeriesQuery{From: from, To: to})
+	if err != nil {
+		t.Fatalf("Articles err=%v", err)
+	}
+	// 開始は区間の先頭に切り下げ、空の区間は0件で埋める
+	wantFrom := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	if repo.gotInterval != repository.StatsIntervalDay || !repo.gotFrom.Equal(wantFrom) || !repo.gotTo.Equal(to) {
+		t.Errorf("repo got %s [%v, %v)", repo.gotInterval, repo.gotFrom, repo.gotTo)
+	}
+	if len(got.Buckets) != 3 || got.Buckets[0].Count != 0 || got.Buckets[1].Count != 5 || got.Total != 5 {
+		t.Errorf("Articles = %+v", got)
+	}
+
+	// 同じ区間の問い合わせはキャッシュを返す
+	if _, err := svc.Articles(context.Background(), stats.SeriesQuery{From: wantFrom, To: to}); err != nil || repo.seriesCalls != 1 {
+		t.Errorf("cached Articles err=%v, repository calls = %d, want 1", err, repo.seriesCalls)
+	}
+}
+
+func TestService_Articles_Week(t *testing.T) {
+	repo := &stubStatsRepo{}
+	svc := stats.NewService(repo)
+
+	// 2025-06-04 は水曜日。週は月曜日に揃え、終端は次の週の開始に切り上げる
+	_, err := svc.Articles(context.Background(), stats.SeriesQuery{
+		Interval: repository.StatsIntervalWeek,
+		From:     time.Date(2025, 6, 4, 8, 0, 0, 0, time.UTC),
+		To:       time.Date(2025, 6, 11, 8, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("Articles err=%v", err)
+	}
+	if !repo.gotFrom.Equal(time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)) || !repo.gotTo.Equal(time.Date(2025, 6, 16, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("repo got [%v, %v)", repo.gotFrom, repo.gotTo)
+	}
+}
+
+func TestService_Articles_Defaults(t *testing.T) {
+	repo := &stubStatsRepo{}
+	got, err := stats.NewService(repo).Articles(context.Background(), stats.SeriesQuery{Interval: repository.StatsIntervalHour})
+	if err != nil {
+		t.Fatalf("Articles err=%v", err)
+	}
+	if len(got.Buckets) != 48 || got.To.Before(time.Now()) {
+		t.Errorf("Articles = %d buckets until %v", len(got.Buckets), got.To)
+	}
+}
+
+func TestService_Articles_Invalid(t *testing.T) {
+	at := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	sourceID := int64(0)
+	tests := []struct {
+		name string
+		q    stats.SeriesQuery
+	}{
+		{"unknown interval", stats.SeriesQuery{Interval: "month"}},
+		{"from after to", stats.SeriesQuery{From: at.AddDate(0, 0, 2), To: at}},
+		{"invalid source", stats.SeriesQuery{SourceID: &sourceID}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &stubStatsRepo{}
+			_, err := stats.NewService(repo).Articles(context.Background(), tt.q)
+			var ve *entity.ValidationError
+			if !errors.As(err, &ve) || repo.seriesCalls != 0 {
+				t.Errorf("err = %v, repository calls = %d; want ValidationError and no query", err, repo.seriesCalls)
+			}
+		})
+	}
+
+	t.Run("too many intervals", func(t *testing.T) {
+		_, err := stats.NewService(&stubStatsRepo{}).Articles(context.Background(), stats.SeriesQuery{
+			Interval: repository.StatsIntervalHour, From: at, To: at.AddDate(0, 0, 60),
+		})
+		if !errors.Is(err, stats.ErrTooManyBuckets) {
+			t.Errorf("err = %v, want ErrTooManyBuckets", err)
+		}
+	})
+}
@@ -0,0 +1,116 @@
+// Package stream provides the real-time new-article stream. The worker inserts
+// articles in another process, so a Broker wakes the open streams on each
+// cross-process notification and on a polling interval, and each stream then
+// reads the articles after the last one it sent.
+package stream
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// listenRetryDelay is the wait before restarting a failed Listener.
+const listenRetryDelay = 5 * time.Second
+
+// Listener signals that articles were inserted, possibly by another process.
+// postgres.ArticleListener satisfies it through LISTEN/NOTIFY.
+type Listener interface {
+	// Listen calls notify whenever articles may have been inserted, until ctx is
+	// done (returning nil) or the listener fails.
+	Listen(ctx context.Context, notify func()) error
+}
+
+// Broker wakes the subscribed streams when new articles may be available.
+type Broker struct {
+	listener     Listener
+	pollInterval time.Duration
+	logger       *slog.Logger
+
+	mu   sync.Mutex
+	subs map[chan struct{}]struct{}
+}
+
+// NewBroker creates a Broker that wakes its subscribers on each notification of
+// listener and every pollInterval. listener may be nil (e.g. SQLite), in which
+// case the streams rely on polling alone; with a listener, polling only covers
+// notifications lost while it reconnects.
+func NewBroker(listener Listener, pollInterval time.Duration, logger *slog.Logger) *Broker {
+	return &Broker{
+		listener:     listener,
+		pollInterval: pollInterval,
+		logger:       logger,
+		subs:         make(map[chan struct{}]struct{}),
+	}
+}
+
+// Run dispatches the notifications and polling ticks until ctx is done.
+func (b *Broker) Run(ctx context.Context) {
+	if b.listener != nil {
+		go b.listen(ctx)
+	}
+
+	ticker := time.NewTicker(b.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			b.Notify()
+		}
+	}
+}
+
+// listen runs the listener, restarting it after a failure.
+func (b *Broker) listen(ctx context.Context) {
+	for {
+		err := b.listener.Listen(ctx, b.Notify)
+		if ctx.Err() != nil {
+			return
+		}
+		b.logger.Warn("article listener stopped, falling back to polling until it restarts",
+			slog.Any("error", err),
+			slog.Duration("retry_in", listenRetryDelay))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenRetryDelay):
+		}
+	}
+}
+
+// Notify wakes all subscribers. It never blocks: a subscriber that has not yet
+// handled the previous wake-up gets only one.
+func (b *Broker) Notify() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subs {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a channel that receives a value when new articles may be
+// available, and a function that cancels the subscription.
+func (b *Broker) Subscribe() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	b.mu.Lock()
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+	return ch, func() {
+		b.mu.Lock()
+		delete(b.subs, ch)
+		b.mu.Unlock()
+	}
+}
+
+// Subscribers returns the number of open subscriptions.
+func (b *Broker) Subscribers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subs)
+}
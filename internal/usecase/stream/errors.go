@@ -0,0 +1,10 @@
+package stream
+
+import "errors"
+
+// Sentinel errors for stream use case operations.
+var (
+	// ErrInvalidEventID indicates that the Last-Event-ID to resume from is not a
+	// valid article ID.
+	ErrInvalidEventID = errors.New("invalid last event ID")
+)
@@ -0,0 +1,135 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+
+	"catchup-feed/internal/repository"
+)
+
+// BatchSize is the maximum number of articles read per query. Streams read
+// batches until they have caught up.
+const BatchSize = 100
+
+// Service provides the new-article stream use cases.
+type Service struct {
+	Repo   repository.ArticleStreamRepository
+	Broker *Broker
+}
+
+// Query selects the articles of a stream. Keywords and Filters work as in
+// /articles/search; without them every new article is streamed.
+type Query struct {
+	Keywords []string
+	Filters  repository.ArticleSearchFilters
+}
+
+// Cursor is the read position of a stream.
+type Cursor struct {
+	// After is the last article ID read; Next reads the articles after it.
+	After int64
+	// Pending are the IDs up to After of the articles that were pending batch
+	// summarization, in ascending order. Settled reads them once summarized.
+	Pending []int64
+}
+
+// Start returns the cursor of a new stream. A positive lastEventID resumes a
+// stream after the last article it received; otherwise only articles inserted
+// from now on are streamed. Articles before the position that are still pending
+// are streamed once summarized.
+func (s *Service) Start(ctx context.Context, lastEventID int64) (*Cursor, error) {
+	if lastEventID < 0 {
+		return nil, ErrInvalidEventID
+	}
+	after := lastEventID
+	if after == 0 {
+		latest, err := s.Repo.LatestArticleID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("latest article ID: %w", err)
+		}
+		after = latest
+	}
+	pending, err := s.Repo.ListPendingArticleIDs(ctx, 0)
+	if err != nil {
+		return nil, fmt.Errorf("list pending articles: %w", err)
+	}
+	c := &Cursor{After: after}
+	for _, id := range pending {
+		if id <= after {
+			c.Pending = append(c.Pending, id)
+		}
+	}
+	return c, nil
+}
+
+// Next returns up to BatchSize articles after c.After matching q, in insertion
+// order, and moves c past them. Pending articles are skipped and added to
+// c.Pending. Fewer than BatchSize articles means the stream has caught up.
+func (s *Service) Next(ctx context.Context, q Query, c *Cursor) ([]repository.ArticleWithSource, error) {
+	// 記事より先に取得する: 間に要約が終わった記事は記事に入り、下で除外される
+	pending, err := s.Repo.ListPendingArticleIDs(ctx, c.After)
+	if err != nil {
+		return nil, fmt.Errorf("list pending articles: %w", err)
+	}
+	articles, err := s.Repo.ListArticlesAfter(ctx, q.Keywords, q.Filters, c.After, BatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("list new articles: %w", err)
+	}
+	if len(articles) == 0 {
+		return articles, nil
+	}
+
+	read := make(map[int64]bool, len(articles))
+	for _, a := range articles {
+		read[a.Article.ID] = true
+	}
+	c.After = articles[len(articles)-1].Article.ID
+	for _, id := range pending {
+		if id < c.After && !read[id] {
+			c.Pending = append(c.Pending, id)
+		}
+	}
+	return articles, nil
+}
+
+// Settled returns up to BatchSize articles of c.Pending that have been summarized
+// since and match q, in insertion order, and removes them from c.Pending along
+// with the summarized articles that do not match q and the deleted ones.
+func (s *Service) Settled(ctx context.Context, q Query, c *Cursor) ([]repository.ArticleWithSource, error) {
+	if len(c.Pending) == 0 {
+		return nil, nil
+	}
+	still, err := s.Repo.ListPendingArticleIDs(ctx, c.Pending[0]-1)
+	if err != nil {
+		return nil, fmt.Errorf("list pending articles: %w", err)
+	}
+	stillPending := make(map[int64]bool, len(still))
+	for _, id := range still {
+		stillPending[id] = true
+	}
+
+	var ready, waiting []int64
+	for _, id := range c.Pending {
+		if stillPending[id] || len(ready) == BatchSize {
+			waiting = append(waiting, id)
+		} else {
+			ready = append(ready, id)
+		}
+	}
+	if len(ready) == 0 {
+		return nil, nil
+	}
+
+	articles, err := s.Repo.ListSettledArticles(ctx, q.Keywords, q.Filters, ready)
+	if err != nil {
+		return nil, fmt.Errorf("list summarized articles: %w", err)
+	}
+	c.Pending = waiting
+	return articles, nil
+}
+
+// Subscribe returns a channel that receives a value when new articles may be
+// available, and a function that cancels the subscription.
+func (s *Service) Subscribe() (<-chan struct{}, func()) {
+	return s.Broker.Subscribe()
+}
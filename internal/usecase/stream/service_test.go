@@ -0,0 +1,242 @@
+package stream_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	"catchup-feed/internal/usecase/stream"
+)
+
+/* ───────── モック ───────── */
+
+// stubStreamRepo holds articles in insertion (ID) order.
+type stubStreamRepo struct {
+	articles []*entity.Article
+	gotLimit int
+}
+
+func (s *stubStreamRepo) add(id int64, status string) *entity.Article {
+	a := &entity.Article{ID: id, SummaryStatus: status}
+	s.articles = append(s.articles, a)
+	return a
+}
+
+func (s *stubStreamRepo) LatestArticleID(context.Context) (int64, error) {
+	if len(s.articles) == 0 {
+		return 0, nil
+	}
+	return s.articles[len(s.articles)-1].ID, nil
+}
+
+func (s *stubStreamRepo) ListArticlesAfter(_ context.Context, _ []string, _ repository.ArticleSearchFilters, afterID int64, limit int) ([]repository.ArticleWithSource, error) {
+	s.gotLimit = limit
+	var out []repository.ArticleWithSource
+	for _, a := range s.articles {
+		if a.ID > afterID && a.SummaryStatus != entity.SummaryStatusPending && len(out) < limit {
+			out = append(out, repository.ArticleWithSource{Article: a})
+		}
+	}
+	return out, nil
+}
+
+func (s *stubStreamRepo) ListPendingArticleIDs(_ context.Context, afterID int64) ([]int64, error) {
+	var ids []int64
+	for _, a := range s.articles {
+		if a.ID > afterID && a.SummaryStatus == entity.SummaryStatusPending {
+			ids = append(ids, a.ID)
+		}
+	}
+	return ids, nil
+}
+
+func (s *stubStreamRepo) ListSettledArticles(_ context.Context, _ []string, _ repository.ArticleSearchFilters, ids []int64) ([]repository.ArticleWithSource, error) {
+	var out []repository.ArticleWithSource
+	for _, a := range s.articles {
+		if slices.Contains(ids, a.ID) && a.SummaryStatus != entity.SummaryStatusPending {
+			out = append(out, repository.ArticleWithSource{Article: a})
+		}
+	}
+	return out, nil
+}
+
+func articleIDs(articles []repository.ArticleWithSource) []int64 {
+	var ids []int64
+	for _, a := range articles {
+		ids = append(ids, a.Article.ID)
+	}
+	return ids
+}
+
+// stubListener fails once, then notifies once and waits for cancellation.
+type stubListener struct {
+	calls int
+}
+
+func (l *stubListener) Listen(ctx context.Context, notify func()) error {
+	l.calls++
+	if l.calls == 1 {
+		return errors.New("connection reset")
+	}
+	notify()
+	<-ctx.Done()
+	return nil
+}
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+/* ───────── テスト ───────── */
+
+func TestService_Start(t *testing.T) {
+	repo := &stubStreamRepo{}
+	repo.add(110, entity.SummaryStatusPending)
+	repo.add(125, entity.SummaryStatusPending)
+	repo.add(130, "")
+	svc := stream.Service{Repo: repo}
+
+	tests := []struct {
+		name        string
+		lastEventID int64
+		want        stream.Cursor
+		wantErr     error
+	}{
+		{name: "new stream starts at the latest article", lastEventID: 0, want: stream.Cursor{After: 130, Pending: []int64{110, 125}}},
+		{name: "resume after the last event", lastEventID: 120, want: stream.Cursor{After: 120, Pending: []int64{110}}},
+		{name: "negative event ID", lastEventID: -1, wantErr: stream.ErrInvalidEventID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := svc.Start(context.Background(), tt.lastEventID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && !cmp.Equal(*got, tt.want) {
+				t.Errorf("Start = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_Next_SkipsPendingArticles(t *testing.T) {
+	repo := &stubStreamRepo{}
+	repo.add(121, "")
+	pending := repo.add(122, entity.SummaryStatusPending)
+	repo.add(123, "")
+	svc := stream.Service{Repo: repo}
+	c := &stream.Cursor{After: 120}
+
+	// 要約待ちの記事で止まらずに、後の記事を読む
+	got, err := svc.Next(context.Background(), stream.Query{Keywords: []string{"go"}}, c)
+	if err != nil {
+		t.Fatalf("Next err=%v", err)
+	}
+	if !cmp.Equal(articleIDs(got), []int64{121, 123}) || repo.gotLimit != stream.BatchSize {
+		t.Fatalf("Next = %v (limit %d), want 121 and 123", articleIDs(got), repo.gotLimit)
+	}
+	if c.After != 123 || !cmp.Equal(c.Pending, []int64{122}) {
+		t.Fatalf("cursor = %+v, want after 123 with 122 pending", *c)
+	}
+
+	if got, _ := svc.Settled(context.Background(), stream.Query{}, c); len(got) != 0 {
+		t.Errorf("Settled while pending = %v, want none", articleIDs(got))
+	}
+
+	pending.SummaryStatus = ""
+	got, err = svc.Settled(context.Background(), stream.Query{}, c)
+	if err != nil {
+		t.Fatalf("Settled err=%v", err)
+	}
+	if !cmp.Equal(articleIDs(got), []int64{122}) || len(c.Pending) != 0 {
+		t.Errorf("Settled = %v with %v still pending, want 122 delivered once", articleIDs(got), c.Pending)
+	}
+	if got, _ := svc.Next(context.Background(), stream.Query{}, c); len(got) != 0 {
+		t.Errorf("Next after catching up = %v, want none", articleIDs(got))
+	}
+}
+
+func TestService_Settled_DropsDeletedArticles(t *testing.T) {
+	repo := &stubStreamRepo{}
+	repo.add(5, "")
+	svc := stream.Service{Repo: repo}
+	// 4 は要約に失敗して削除された
+	c := &stream.Cursor{After: 5, Pending: []int64{4, 5}}
+
+	got, err := svc.Settled(context.Background(), stream.Query{}, c)
+	if err != nil {
+		t.Fatalf("Settled err=%v", err)
+	}
+	if !cmp.Equal(articleIDs(got), []int64{5}) || len(c.Pending) != 0 {
+		t.Errorf("Settled = %v with %v still pending, want 5 and nothing left", articleIDs(got), c.Pending)
+	}
+}
+
+func TestBroker_NotifyAndSubscribe(t *testing.T) {
+	b := stream.NewBroker(nil, time.Hour, discardLogger())
+
+	ch, cancel := b.Subscribe()
+	b.Notify()
+	b.Notify() // 未処理の通知はまとめられ、ブロックしない
+	select {
+	case <-ch:
+	default:
+		t.Fatal("subscriber was not notified")
+	}
+	select {
+	case <-ch:
+		t.Fatal("pending notifications were not coalesced")
+	default:
+	}
+
+	cancel()
+	if n := b.Subscribers(); n != 0 {
+		t.Errorf("Subscribers = %d after cancel, want 0", n)
+	}
+}
+
+func TestBroker_Run_PollsAndListens(t *testing.T) {
+	t.Run("polling", func(t *testing.T) {
+		b := stream.NewBroker(nil, 10*time.Millisecond, discardLogger())
+		ch, cancel := b.Subscribe()
+		defer cancel()
+
+		ctx, stop := context.WithCancel(context.Background())
+		defer stop()
+		go b.Run(ctx)
+
+		select {
+		case <-ch:
+		case <-time.After(time.Second):
+			t.Fatal("no wake-up from polling")
+		}
+	})
+
+	t.Run("listener restarts after failure", func(t *testing.T) {
+		if testing.Short() {
+			t.Skip("waits for the listener retry delay")
+		}
+		listener := &stubListener{}
+		b := stream.NewBroker(listener, time.Hour, discardLogger())
+		ch, cancel := b.Subscribe()
+		defer cancel()
+
+		ctx, stop := context.WithCancel(context.Background())
+		defer stop()
+		go b.Run(ctx)
+
+		select {
+		case <-ch:
+		case <-time.After(10 * time.Second):
+			t.Fatal("no wake-up from the restarted listener")
+		}
+	})
+}
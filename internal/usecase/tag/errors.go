@@ -0,0 +1,16 @@
+// Package tag provides use cases for topic tags: automatic tagging of articles
+// from tag rules, feed categories and summarizer suggestions, and the management
+// of the tag rules.
+package tag
+
+import "errors"
+
+// Sentinel errors for tag use case operations.
+var (
+	// ErrInvalidTagRuleID indicates that the provided tag rule ID is invalid.
+	// Tag rule IDs must be positive integers.
+	ErrInvalidTagRuleID = errors.New("invalid tag rule ID")
+
+	// ErrTagRuleNotFound indicates that the requested tag rule was not found.
+	ErrTagRuleNotFound = errors.New("tag rule not found")
+)
@@ -0,0 +1,84 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+// CreateRuleInput represents the input parameters for creating a tag rule.
+type CreateRuleInput struct {
+	Tag     string
+	Pattern string
+	// MatchType is entity.TagMatchKeyword (default when empty) or entity.TagMatchRegex.
+	MatchType string
+}
+
+// Service provides tag and tag rule management use cases.
+type Service struct {
+	Repo     repository.TagRepository
+	RuleRepo repository.TagRuleRepository
+}
+
+// ListTags returns all tags in use with the number of articles carrying each tag,
+// most used first.
+func (s *Service) ListTags(ctx context.Context) ([]repository.TagCount, error) {
+	tags, err := s.Repo.ListTagCounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list tags: %w", err)
+	}
+	return tags, nil
+}
+
+// ListRules returns all tag rules.
+func (s *Service) ListRules(ctx context.Context) ([]*entity.TagRule, error) {
+	rules, err := s.RuleRepo.ListRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list tag rules: %w", err)
+	}
+	return rules, nil
+}
+
+// CreateRule validates and stores a new tag rule. The tag name is normalized
+// (see entity.NormalizeTagName). Rules apply to articles tagged after creation.
+// Returns a ValidationError if the input is invalid.
+func (s *Service) CreateRule(ctx context.Context, in CreateRuleInput) (*entity.TagRule, error) {
+	rule := &entity.TagRule{
+		Tag:       in.Tag,
+		Pattern:   strings.TrimSpace(in.Pattern),
+		MatchType: in.MatchType,
+	}
+	if rule.MatchType == "" {
+		rule.MatchType = entity.TagMatchKeyword
+	}
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+	rule.Tag = entity.NormalizeTagName(rule.Tag)
+
+	if err := s.RuleRepo.CreateRule(ctx, rule); err != nil {
+		return nil, fmt.Errorf("create tag rule: %w", err)
+	}
+	return rule, nil
+}
+
+// DeleteRule removes a tag rule. Tags already attached by the rule are kept.
+// Returns ErrInvalidTagRuleID if the ID is not positive.
+// Returns ErrTagRuleNotFound if the rule does not exist.
+func (s *Service) DeleteRule(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return ErrInvalidTagRuleID
+	}
+
+	deleted, err := s.RuleRepo.DeleteRule(ctx, id)
+	if err != nil {
+		return fmt.Errorf("delete tag rule: %w", err)
+	}
+	if !deleted {
+		return ErrTagRuleNotFound
+	}
+	return nil
+}
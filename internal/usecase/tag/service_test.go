@@ -0,0 +1,158 @@
+package tag_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+	tagUC "catchup-feed/internal/usecase/tag"
+)
+
+/* ───────── モック ───────── */
+
+// addedTags はAddArticleTagsの呼び出し内容
+type addedTags struct {
+	articleID int64
+	names     []string
+	origin    string
+}
+
+// stubTagRepo はTagRepositoryのモック実装
+type stubTagRepo struct {
+	added  []addedTags
+	counts []repository.TagCount
+	err    error
+}
+
+func (s *stubTagRepo) AddArticleTags(_ context.Context, articleID int64, names []string, origin string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.added = append(s.added, addedTags{articleID: articleID, names: names, origin: origin})
+	return nil
+}
+
+func (s *stubTagRepo) ListTagCounts(_ context.Context) ([]repository.TagCount, error) {
+	return s.counts, s.err
+}
+
+// stubRuleRepo はTagRuleRepositoryのモック実装
+type stubRuleRepo struct {
+	rules     []*entity.TagRule
+	listCalls int
+	err       error
+}
+
+func (s *stubRuleRepo) ListRules(_ context.Context) ([]*entity.TagRule, error) {
+	s.listCalls++
+	return s.rules, s.err
+}
+
+func (s *stubRuleRepo) CreateRule(_ context.Context, rule *entity.TagRule) error {
+	if s.err != nil {
+		return s.err
+	}
+	rule.ID = int64(len(s.rules) + 1)
+	rule.CreatedAt = time.Now()
+	s.rules = append(s.rules, rule)
+	return nil
+}
+
+func (s *stubRuleRepo) DeleteRule(_ context.Context, id int64) (bool, error) {
+	if s.err != nil {
+		return false, s.err
+	}
+	for i, r := range s.rules {
+		if r.ID == id {
+			s.rules = append(s.rules[:i], s.rules[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+/* ───────── テストケース ───────── */
+
+func TestService_ListTags(t *testing.T) {
+	repo := &stubTagRepo{counts: []repository.TagCount{{Name: "go", Count: 3}}}
+	svc := tagUC.Service{Repo: repo}
+
+	got, err := svc.ListTags(context.Background())
+	if err != nil {
+		t.Fatalf("ListTags() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Name != "go" || got[0].Count != 3 {
+		t.Errorf("ListTags() = %+v", got)
+	}
+
+	repo.err = errors.New("db down")
+	if _, err := svc.ListTags(context.Background()); err == nil {
+		t.Error("ListTags() error = nil, want error")
+	}
+}
+
+func TestService_CreateRule(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        tagUC.CreateRuleInput
+		wantTag   string
+		wantMatch string
+		wantField string
+	}{
+		{name: "keyword by default", in: tagUC.CreateRuleInput{Tag: " Go ", Pattern: " golang "},
+			wantTag: "go", wantMatch: entity.TagMatchKeyword},
+		{name: "regex", in: tagUC.CreateRuleInput{Tag: "AI", Pattern: `(?i)\bllm\b`, MatchType: entity.TagMatchRegex},
+			wantTag: "ai", wantMatch: entity.TagMatchRegex},
+		{name: "invalid regex", in: tagUC.CreateRuleInput{Tag: "ai", Pattern: "(", MatchType: entity.TagMatchRegex},
+			wantField: "pattern"},
+		{name: "missing tag", in: tagUC.CreateRuleInput{Pattern: "golang"}, wantField: "tag"},
+		{name: "unknown match type", in: tagUC.CreateRuleInput{Tag: "go", Pattern: "go", MatchType: "glob"},
+			wantField: "match_type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ruleRepo := &stubRuleRepo{}
+			svc := tagUC.Service{RuleRepo: ruleRepo}
+
+			rule, err := svc.CreateRule(context.Background(), tt.in)
+			if tt.wantField != "" {
+				var vErr *entity.ValidationError
+				if !errors.As(err, &vErr) || vErr.Field != tt.wantField {
+					t.Fatalf("err = %v, want ValidationError on %q", err, tt.wantField)
+				}
+				if len(ruleRepo.rules) != 0 {
+					t.Error("invalid rule must not be stored")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateRule() error = %v", err)
+			}
+			if rule.ID == 0 || rule.Tag != tt.wantTag || rule.MatchType != tt.wantMatch {
+				t.Errorf("rule = %+v, want tag %q match %q", rule, tt.wantTag, tt.wantMatch)
+			}
+		})
+	}
+}
+
+func TestService_DeleteRule(t *testing.T) {
+	ruleRepo := &stubRuleRepo{rules: []*entity.TagRule{{ID: 1, Tag: "go", Pattern: "go", MatchType: entity.TagMatchKeyword}}}
+	svc := tagUC.Service{RuleRepo: ruleRepo}
+
+	if err := svc.DeleteRule(context.Background(), 0); !errors.Is(err, tagUC.ErrInvalidTagRuleID) {
+		t.Errorf("DeleteRule(0) = %v, want ErrInvalidTagRuleID", err)
+	}
+	if err := svc.DeleteRule(context.Background(), 2); !errors.Is(err, tagUC.ErrTagRuleNotFound) {
+		t.Errorf("DeleteRule(2) = %v, want ErrTagRuleNotFound", err)
+	}
+	if err := svc.DeleteRule(context.Background(), 1); err != nil {
+		t.Errorf("DeleteRule(1) = %v, want nil", err)
+	}
+	if len(ruleRepo.rules) != 0 {
+		t.Errorf("rules = %d, want 0", len(ruleRepo.rules))
+	}
+}
@@ -0,0 +1,156 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"catchup-feed/internal/domain/entity"
+	"catchup-feed/internal/repository"
+)
+
+// ruleCacheTTL is how long the tag rules are reused before being reloaded, so
+// that a crawl does not query the rules once per article.
+const ruleCacheTTL = time.Minute
+
+// compiledRule is a tag rule prepared for matching.
+type compiledRule struct {
+	tag     string
+	keyword string // lower-cased pattern of a keyword rule
+	re      *regexp.Regexp
+}
+
+func (r compiledRule) matches(text, lowerText string) bool {
+	if r.re != nil {
+		return r.re.MatchString(text)
+	}
+	return strings.Contains(lowerText, r.keyword)
+}
+
+// originTags are the tag names found by one tagging mechanism.
+type originTags struct {
+	origin string
+	names  []string
+}
+
+// Tagger attaches topic tags to articles. It implements fetch.Tagger.
+//
+// Tags come from three origins:
+//   - rule: tag rules whose pattern matches the title, summary or content
+//   - feed: the categories of the feed item
+//   - llm: the tags of the structured summary, when UseLLMTags is set
+type Tagger struct {
+	Repo     repository.TagRepository
+	RuleRepo repository.TagRuleRepository
+	// UseLLMTags attaches the tags suggested by the summarizer in structured mode.
+	UseLLMTags bool
+
+	mu       sync.Mutex
+	rules    []compiledRule
+	loadedAt time.Time
+}
+
+// NewTagger creates a Tagger that stores tags through repo and matches the rules of ruleRepo.
+func NewTagger(repo repository.TagRepository, ruleRepo repository.TagRuleRepository, useLLMTags bool) *Tagger {
+	return &Tagger{Repo: repo, RuleRepo: ruleRepo, UseLLMTags: useLLMTags}
+}
+
+// TagArticle attaches the rule, feed and (optionally) LLM tags of art.
+// Tags already attached to the article are kept, so it is safe to call again
+// after the summary changes.
+func (t *Tagger) TagArticle(ctx context.Context, art *entity.Article, content string, categories []string) error {
+	rules, err := t.loadRules(ctx)
+	if err != nil {
+		return err
+	}
+
+	byOrigin := []originTags{
+		{entity.TagOriginRule, matchRules(rules, articleText(art, content))},
+		{entity.TagOriginFeed, entity.NormalizeTagNames(categories)},
+	}
+	if t.UseLLMTags && art.Structured != nil {
+		byOrigin = append(byOrigin, originTags{entity.TagOriginLLM, entity.NormalizeTagNames(art.Structured.Tags)})
+	}
+
+	for _, o := range byOrigin {
+		if len(o.names) == 0 {
+			continue
+		}
+		if err := t.Repo.AddArticleTags(ctx, art.ID, o.names, o.origin); err != nil {
+			return fmt.Errorf("add %s tags: %w", o.origin, err)
+		}
+	}
+	return nil
+}
+
+// loadRules returns the compiled tag rules, reloading them when the cache has expired.
+func (t *Tagger) loadRules(ctx context.Context) ([]compiledRule, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.loadedAt.IsZero() && time.Since(t.loadedAt) < ruleCacheTTL {
+		return t.rules, nil
+	}
+
+	rules, err := t.RuleRepo.ListRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list tag rules: %w", err)
+	}
+
+	compiled := make([]compiledRule, 0, len(rules))
+	for _, r := range rules {
+		c := compiledRule{tag: entity.NormalizeTagName(r.Tag)}
+		if c.tag == "" {
+			continue
+		}
+		switch r.MatchType {
+		case entity.TagMatchRegex:
+			re, err := regexp.Compile(r.Pattern)
+			if err != nil {
+				// 作成時に検証済みのため通常は発生しない
+				slog.Warn("skipping tag rule with invalid regex",
+					slog.Int64("rule_id", r.ID),
+					slog.Any("error", err))
+				continue
+			}
+			c.re = re
+		default:
+			c.keyword = strings.ToLower(r.Pattern)
+		}
+		compiled = append(compiled, c)
+	}
+
+	t.rules = compiled
+	t.loadedAt = time.Now()
+	return compiled, nil
+}
+
+// articleText returns the text tag rules are matched against.
+func articleText(art *entity.Article, content string) string {
+	parts := []string{art.Title, art.Summary}
+	if art.Structured != nil {
+		parts = append(parts, art.Structured.TLDR)
+		parts = append(parts, art.Structured.KeyPoints...)
+	}
+	parts = append(parts, content)
+	return strings.Join(parts, "\n")
+}
+
+// matchRules returns the tags of the rules matching text, without duplicates.
+func matchRules(rules []compiledRule, text string) []string {
+	lower := strings.ToLower(text)
+	var tags []string
+	seen := make(map[string]bool)
+	for _, r := range rules {
+		if seen[r.tag] || !r.matches(text, lower) {
+			continue
+		}
+		seen[r.tag] = true
+		tags = append(tags, r.tag)
+	}
+	return tags
+}
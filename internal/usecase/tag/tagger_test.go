@@ -0,0 +1,112 @@
+package tag_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"catchup-feed/internal/domain/entity"
+	tagUC "catchup-feed/internal/usecase/tag"
+)
+
+func testRules() []*entity.TagRule {
+	return []*entity.TagRule{
+		{ID: 1, Tag: "go", Pattern: "Golang", MatchType: entity.TagMatchKeyword},
+		{ID: 2, Tag: "ai", Pattern: `\bLLM\b`, MatchType: entity.TagMatchRegex},
+		{ID: 3, Tag: "go", Pattern: "go 1.", MatchType: entity.TagMatchKeyword},
+		{ID: 4, Tag: "rust", Pattern: "rust", MatchType: entity.TagMatchKeyword},
+		{ID: 5, Tag: "broken", Pattern: "(", MatchType: entity.TagMatchRegex},
+	}
+}
+
+func TestTagger_TagArticle(t *testing.T) {
+	art := &entity.Article{
+		ID:      7,
+		Title:   "GOLANG release notes",
+		Summary: "The LLM tooling improved",
+		Structured: &entity.StructuredSummary{
+			TLDR: "short", KeyPoints: []string{"a"}, Tags: []string{"Go", "Compilers"},
+		},
+	}
+
+	t.Run("rules and feed categories", func(t *testing.T) {
+		repo := &stubTagRepo{}
+		tagger := tagUC.NewTagger(repo, &stubRuleRepo{rules: testRules()}, false)
+
+		if err := tagger.TagArticle(context.Background(), art, "body", []string{"Release", "release", " "}); err != nil {
+			t.Fatalf("TagArticle() error = %v", err)
+		}
+		want := []addedTags{
+			{articleID: 7, names: []string{"go", "ai"}, origin: entity.TagOriginRule},
+			{articleID: 7, names: []string{"release"}, origin: entity.TagOriginFeed},
+		}
+		if !reflect.DeepEqual(repo.added, want) {
+			t.Errorf("added = %+v, want %+v", repo.added, want)
+		}
+	})
+
+	t.Run("llm tags when enabled", func(t *testing.T) {
+		repo := &stubTagRepo{}
+		tagger := tagUC.NewTagger(repo, &stubRuleRepo{}, true)
+
+		if err := tagger.TagArticle(context.Background(), art, "", nil); err != nil {
+			t.Fatalf("TagArticle() error = %v", err)
+		}
+		want := []addedTags{{articleID: 7, names: []string{"go", "compilers"}, origin: entity.TagOriginLLM}}
+		if !reflect.DeepEqual(repo.added, want) {
+			t.Errorf("added = %+v, want %+v", repo.added, want)
+		}
+	})
+
+	t.Run("content is matched", func(t *testing.T) {
+		repo := &stubTagRepo{}
+		tagger := tagUC.NewTagger(repo, &stubRuleRepo{rules: testRules()}, false)
+
+		if err := tagger.TagArticle(context.Background(), &entity.Article{ID: 8, Title: "t"}, "written in Rust", nil); err != nil {
+			t.Fatalf("TagArticle() error = %v", err)
+		}
+		want := []addedTags{{articleID: 8, names: []string{"rust"}, origin: entity.TagOriginRule}}
+		if !reflect.DeepEqual(repo.added, want) {
+			t.Errorf("added = %+v, want %+v", repo.added, want)
+		}
+	})
+
+	t.Run("no tags", func(t *testing.T) {
+		repo := &stubTagRepo{}
+		tagger := tagUC.NewTagger(repo, &stubRuleRepo{rules: testRules()}, false)
+
+		if err := tagger.TagArticle(context.Background(), &entity.Article{ID: 9, Title: "t"}, "", nil); err != nil {
+			t.Fatalf("TagArticle() error = %v", err)
+		}
+		if len(repo.added) != 0 {
+			t.Errorf("added = %+v, want none", repo.added)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tagger := tagUC.NewTagger(&stubTagRepo{}, &stubRuleRepo{err: errors.New("db down")}, false)
+		if err := tagger.TagArticle(context.Background(), art, "", nil); err == nil {
+			t.Error("rule load error must be returned")
+		}
+
+		tagger = tagUC.NewTagger(&stubTagRepo{err: errors.New("db down")}, &stubRuleRepo{rules: testRules()}, false)
+		if err := tagger.TagArticle(context.Background(), art, "", nil); err == nil {
+			t.Error("store error must be returned")
+		}
+	})
+}
+
+func TestTagger_CachesRules(t *testing.T) {
+	ruleRepo := &stubRuleRepo{rules: testRules()}
+	tagger := tagUC.NewTagger(&stubTagRepo{}, ruleRepo, false)
+
+	for i := 0; i < 3; i++ {
+		if err := tagger.TagArticle(context.Background(), &entity.Article{ID: int64(i + 1)}, "", nil); err != nil {
+			t.Fatalf("TagArticle() error = %v", err)
+		}
+	}
+	if ruleRepo.listCalls != 1 {
+		t.Errorf("ListRules calls = %d, want 1", ruleRepo.listCalls)
+	}
+}
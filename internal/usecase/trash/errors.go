@@ -0,0 +1,31 @@
+// Package trash provides use cases for the trash: articles and sources deleted
+// through the API are kept with a deletion time, can be listed and restored, and
+// are purged permanently after a retention period.
+package trash
+
+import "errors"
+
+// Sentinel errors for trash use case operations.
+var (
+	// ErrInvalidArticleID indicates that the provided article ID is invalid.
+	// Article IDs must be positive integers.
+	ErrInvalidArticleID = errors.New("invalid article ID")
+
+	// ErrInvalidSourceID indicates that the provided source ID is invalid.
+	// Source IDs must be positive integers.
+	ErrInvalidSourceID = errors.New("invalid source ID")
+
+	// ErrArticleNotInTrash indicates that the article does not exist or is not in the trash.
+	ErrArticleNotInTrash = errors.New("article not found in trash")
+
+	// ErrSourceNotInTrash indicates that the source does not exist or is not in the trash.
+	ErrSourceNotInTrash = errors.New("source not found in trash")
+
+	// ErrSourceInTrash indicates that the article cannot be restored on its own
+	// because its source is in the trash. Restoring the source restores the
+	// articles deleted with it.
+	ErrSourceInTrash = errors.New("source of the article is in the trash: restore the source instead")
+
+	// ErrInvalidRetention indicates that the retention period of a purge is not positive.
+	ErrInvalidRetention = errors.New("invalid retention: must be positive")
+)
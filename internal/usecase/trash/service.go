@@ -0,0 +1,102 @@
+package trash
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/repository"
+)
+
+// Service provides the trash use cases.
+type Service struct {
+	Repo repository.TrashRepository
+}
+
+// ArticleListResult is a page of articles in the trash with pagination metadata.
+type ArticleListResult struct {
+	Data       []repository.TrashedArticle
+	Pagination pagination.Metadata
+}
+
+// ListArticles returns a page of the articles in the trash, most recently deleted first.
+func (s *Service) ListArticles(ctx context.Context, params pagination.Params) (*ArticleListResult, error) {
+	total, err := s.Repo.CountTrashedArticles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count trashed articles: %w", err)
+	}
+	articles, err := s.Repo.ListTrashedArticles(ctx, pagination.CalculateOffset(params.Page, params.Limit), params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("list trashed articles: %w", err)
+	}
+	return &ArticleListResult{
+		Data: articles,
+		Pagination: pagination.Metadata{
+			Total:      total,
+			Page:       params.Page,
+			Limit:      params.Limit,
+			TotalPages: pagination.CalculateTotalPages(total, params.Limit),
+		},
+	}, nil
+}
+
+// ListSources returns the sources in the trash, most recently deleted first.
+func (s *Service) ListSources(ctx context.Context) ([]repository.TrashedSource, error) {
+	sources, err := s.Repo.ListTrashedSources(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list trashed sources: %w", err)
+	}
+	return sources, nil
+}
+
+// RestoreArticle takes an article out of the trash.
+// Returns ErrArticleNotInTrash if the article is not in the trash, and
+// ErrSourceInTrash if its source is in the trash too.
+func (s *Service) RestoreArticle(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return ErrInvalidArticleID
+	}
+	result, err := s.Repo.RestoreArticle(ctx, id)
+	if err != nil {
+		return fmt.Errorf("restore article: %w", err)
+	}
+	switch result {
+	case repository.Restored:
+		return nil
+	case repository.RestoreSourceDeleted:
+		return ErrSourceInTrash
+	default:
+		return ErrArticleNotInTrash
+	}
+}
+
+// RestoreSource takes a source out of the trash together with the articles
+// deleted with it. Returns ErrSourceNotInTrash if the source is not in the trash.
+func (s *Service) RestoreSource(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return ErrInvalidSourceID
+	}
+	result, err := s.Repo.RestoreSource(ctx, id)
+	if err != nil {
+		return fmt.Errorf("restore source: %w", err)
+	}
+	if result != repository.Restored {
+		return ErrSourceNotInTrash
+	}
+	return nil
+}
+
+// Purge permanently deletes the articles and sources that have been in the trash
+// for longer than retention at now. The URLs of the purged articles stay known to
+// the crawler, so they are not fetched again.
+func (s *Service) Purge(ctx context.Context, now time.Time, retention time.Duration) (repository.PurgeResult, error) {
+	if retention <= 0 {
+		return repository.PurgeResult{}, ErrInvalidRetention
+	}
+	result, err := s.Repo.Purge(ctx, now.Add(-retention))
+	if err != nil {
+		return repository.PurgeResult{}, fmt.Errorf("purge trash: %w", err)
+	}
+	return result, nil
+}
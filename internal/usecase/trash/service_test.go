@@ -0,0 +1,146 @@
+package trash_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"catchup-feed/internal/common/pagination"
+	"catchup-feed/internal/repository"
+	"catchup-feed/internal/usecase/trash"
+)
+
+/* ───────── モック ───────── */
+
+type stubTrashRepo struct {
+	total     int64
+	articles  []repository.TrashedArticle
+	sources   []repository.TrashedSource
+	result    repository.RestoreResult
+	purged    repository.PurgeResult
+	err       error
+	gotID     int64
+	gotOffset int
+	gotLimit  int
+	gotBefore time.Time
+}
+
+func (s *stubTrashRepo) ListTrashedArticles(_ context.Context, offset, limit int) ([]repository.TrashedArticle, error) {
+	s.gotOffset, s.gotLimit = offset, limit
+	return s.articles, s.err
+}
+
+func (s *stubTrashRepo) CountTrashedArticles(context.Context) (int64, error) {
+	return s.total, s.err
+}
+
+func (s *stubTrashRepo) ListTrashedSources(context.Context) ([]repository.TrashedSource, error) {
+	return s.sources, s.err
+}
+
+func (s *stubTrashRepo) RestoreArticle(_ context.Context, id int64) (repository.RestoreResult, error) {
+	s.gotID = id
+	return s.result, s.err
+}
+
+func (s *stubTrashRepo) RestoreSource(_ context.Context, id int64) (repository.RestoreResult, error) {
+	s.gotID = id
+	return s.result, s.err
+}
+
+func (s *stubTrashRepo) Purge(_ context.Context, before time.Time) (repository.PurgeResult, error) {
+	s.gotBefore = before
+	return s.purged, s.err
+}
+
+/* ───────── テスト ───────── */
+
+func TestService_ListArticles(t *testing.T) {
+	repo := &stubTrashRepo{total: 45, articles: make([]repository.TrashedArticle, 20)}
+	svc := trash.Service{Repo: repo}
+
+	got, err := svc.ListArticles(context.Background(), pagination.Params{Page: 3, Limit: 20})
+	if err != nil {
+		t.Fatalf("ListArticles err=%v", err)
+	}
+	if repo.gotOffset != 40 || repo.gotLimit != 20 {
+		t.Errorf("repo called with offset=%d limit=%d, want 40 and 20", repo.gotOffset, repo.gotLimit)
+	}
+	want := pagination.Metadata{Total: 45, Page: 3, Limit: 20, TotalPages: 3}
+	if got.Pagination != want || len(got.Data) != 20 {
+		t.Errorf("ListArticles = %d articles, %+v; want 20 articles, %+v", len(got.Data), got.Pagination, want)
+	}
+}
+
+func TestService_RestoreArticle(t *testing.T) {
+	dbErr := errors.New("db down")
+
+	tests := []struct {
+		name    string
+		repo    *stubTrashRepo
+		id      int64
+		wantErr error
+	}{
+		{name: "restored", repo: &stubTrashRepo{result: repository.Restored}, id: 7},
+		{name: "not in trash", repo: &stubTrashRepo{result: repository.RestoreNotFound}, id: 7, wantErr: trash.ErrArticleNotInTrash},
+		{name: "source in trash", repo: &stubTrashRepo{result: repository.RestoreSourceDeleted}, id: 7, wantErr: trash.ErrSourceInTrash},
+		{name: "invalid id", repo: &stubTrashRepo{result: repository.Restored}, id: 0, wantErr: trash.ErrInvalidArticleID},
+		{name: "repository error", repo: &stubTrashRepo{err: dbErr}, id: 7, wantErr: dbErr},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := trash.Service{Repo: tt.repo}
+			err := svc.RestoreArticle(context.Background(), tt.id)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_RestoreSource(t *testing.T) {
+	tests := []struct {
+		name    string
+		repo    *stubTrashRepo
+		id      int64
+		wantErr error
+	}{
+		{name: "restored", repo: &stubTrashRepo{result: repository.Restored}, id: 3},
+		{name: "not in trash", repo: &stubTrashRepo{result: repository.RestoreNotFound}, id: 3, wantErr: trash.ErrSourceNotInTrash},
+		{name: "invalid id", repo: &stubTrashRepo{result: repository.Restored}, id: -1, wantErr: trash.ErrInvalidSourceID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := trash.Service{Repo: tt.repo}
+			err := svc.RestoreSource(context.Background(), tt.id)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && tt.repo.gotID != 3 {
+				t.Errorf("repo called with id=%d, want 3", tt.repo.gotID)
+			}
+		})
+	}
+}
+
+func TestService_Purge(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &stubTrashRepo{purged: repository.PurgeResult{Articles: 5, Sources: 1}}
+	svc := trash.Service{Repo: repo}
+
+	got, err := svc.Purge(context.Background(), now, 30*24*time.Hour)
+	if err != nil {
+		t.Fatalf("Purge err=%v", err)
+	}
+	if want := now.AddDate(0, 0, -30); !repo.gotBefore.Equal(want) {
+		t.Errorf("purged before %v, want %v", repo.gotBefore, want)
+	}
+	if got != repo.purged {
+		t.Errorf("Purge = %+v, want %+v", got, repo.purged)
+	}
+
+	if _, err := svc.Purge(context.Background(), now, 0); !errors.Is(err, trash.ErrInvalidRetention) {
+		t.Errorf("Purge with zero retention err = %v, want ErrInvalidRetention", err)
+	}
+}
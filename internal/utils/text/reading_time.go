@@ -0,0 +1,48 @@
+package text
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// wordsPerMinute is the average reading speed for space-delimited languages (e.g. English).
+	wordsPerMinute = 200
+	// cjkCharsPerMinute is the average reading speed for Japanese/Chinese text.
+	cjkCharsPerMinute = 500
+)
+
+// EstimateReadingMinutes estimates how many minutes it takes to read the given text.
+// Japanese and other CJK characters are counted individually (500 chars/min),
+// while the remaining text is counted by words (200 words/min).
+// The result is rounded up and is always at least 1.
+//
+// Examples:
+//
+//	EstimateReadingMinutes("")                            // returns 1
+//	EstimateReadingMinutes(strings.Repeat("word ", 400))  // returns 2
+//	EstimateReadingMinutes(strings.Repeat("あ", 1200))     // returns 3
+func EstimateReadingMinutes(text string) int {
+	cjk := 0
+	var rest strings.Builder
+	for _, r := range text {
+		if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) {
+			cjk++
+			rest.WriteRune(' ')
+			continue
+		}
+		rest.WriteRune(r)
+	}
+	words := len(strings.Fields(rest.String()))
+
+	// 分単位に換算（切り上げ）
+	minutes := float64(cjk)/cjkCharsPerMinute + float64(words)/wordsPerMinute
+	rounded := int(minutes)
+	if float64(rounded) < minutes {
+		rounded++
+	}
+	if rounded < 1 {
+		return 1
+	}
+	return rounded
+}
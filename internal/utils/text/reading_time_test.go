@@ -0,0 +1,33 @@
+package text_test
+
+import (
+	"strings"
+	"testing"
+
+	"catchup-feed/internal/utils/text"
+)
+
+func TestEstimateReadingMinutes(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		{name: "empty text", input: "", expected: 1},
+		{name: "short English", input: "hello world", expected: 1},
+		{name: "exactly 200 words", input: strings.Repeat("word ", 200), expected: 1},
+		{name: "400 words", input: strings.Repeat("word ", 400), expected: 2},
+		{name: "401 words rounds up", input: strings.Repeat("word ", 401), expected: 3},
+		{name: "1200 Japanese chars", input: strings.Repeat("あ", 1200), expected: 3},
+		{name: "mixed text", input: strings.Repeat("漢", 500) + strings.Repeat(" go", 200), expected: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := text.EstimateReadingMinutes(tt.input)
+			if got != tt.expected {
+				t.Errorf("EstimateReadingMinutes() = %d, want %d", got, tt.expected)
+			}
+		})
+	}
+}